@@ -293,6 +293,8 @@ populate_health_stats(struct spdk_nvme_health_information_page *page,
 	dev_state->media_errs = page->media_errors[0];
 	dev_state->err_log_entries = page->num_error_info_log_entries[0];
 	dev_state->temperature = page->temperature;
+	dev_state->avail_spare = page->available_spare;
+	dev_state->avail_spare_thresh = page->available_spare_threshold;
 	dev_state->temp_warn = cw.bits.temperature ? true : false;
 	dev_state->avail_spare_warn = cw.bits.available_spare ? true : false;
 	dev_state->dev_reliability_warn = cw.bits.device_reliability ?
@@ -69,6 +69,11 @@ CRT_RPC_DEFINE(cont_snap_destroy, DAOS_ISEQ_CONT_EPOCH_OP,
 		DAOS_OSEQ_CONT_EPOCH_OP)
 CRT_RPC_DEFINE(cont_tgt_destroy, DAOS_ISEQ_TGT_DESTROY, DAOS_OSEQ_TGT_DESTROY)
 CRT_RPC_DEFINE(cont_tgt_query, DAOS_ISEQ_TGT_QUERY, DAOS_OSEQ_TGT_QUERY)
+CRT_RPC_DEFINE(cont_svc_query, DAOS_ISEQ_CONT_SVC_QUERY,
+		DAOS_OSEQ_CONT_SVC_QUERY)
+CRT_RPC_DEFINE(cont_svc_destroy, DAOS_ISEQ_CONT_SVC_DESTROY,
+		DAOS_OSEQ_CONT_SVC_DESTROY)
+CRT_RPC_DEFINE(cont_tgt_usage, DAOS_ISEQ_TGT_USAGE, DAOS_OSEQ_TGT_USAGE)
 CRT_RPC_DEFINE(cont_tgt_epoch_aggregate, DAOS_ISEQ_CONT_TGT_EPOCH_AGGREGATE,
 		DAOS_OSEQ_CONT_TGT_EPOCH_AGGREGATE)
 CRT_RPC_DEFINE(cont_tgt_snapshot_notify, DAOS_ISEQ_CONT_TGT_SNAPSHOT_NOTIFY,
@@ -97,7 +97,17 @@
 	X(CONT_TGT_SNAPSHOT_NOTIFY,					\
 		0, &CQF_cont_tgt_snapshot_notify,			\
 		ds_cont_tgt_snapshot_notify_handler,			\
-		&ds_cont_tgt_snapshot_notify_co_ops)
+		&ds_cont_tgt_snapshot_notify_co_ops),			\
+	X(CONT_SVC_QUERY,						\
+		0, &CQF_cont_svc_query,					\
+		ds_cont_svc_query_handler, NULL),			\
+	X(CONT_SVC_DESTROY,						\
+		0, &CQF_cont_svc_destroy,				\
+		ds_cont_svc_destroy_handler, NULL),			\
+	X(CONT_TGT_USAGE,						\
+		0, &CQF_cont_tgt_usage,					\
+		ds_cont_tgt_usage_handler,				\
+		&ds_cont_tgt_usage_co_ops)
 
 /* Define for RPC enum population below */
 #define X(a, b, c, d, e) a
@@ -318,6 +328,57 @@ struct cont_tgt_close_rec {
 
 CRT_RPC_DECLARE(cont_tgt_query, DAOS_ISEQ_TGT_QUERY, DAOS_OSEQ_TGT_QUERY)
 
+/*
+ * Container service requests sent by DAOS servers on behalf of the control
+ * plane, which holds no pool or container handles. These are appended to the
+ * server RPCs so that the opcodes of existing RPCs are unchanged.
+ */
+#define DAOS_ISEQ_CONT_SVC_QUERY /* input fields */		 \
+				/* .ci_pool_hdl, .ci_hdl unused */ \
+	((struct cont_op_in)	(csqi_op)		CRT_VAR) \
+	((uuid_t)		(csqi_pool_uuid)	CRT_VAR) \
+	((uint64_t)		(csqi_bits)		CRT_VAR)
+
+#define DAOS_OSEQ_CONT_SVC_QUERY /* output fields */		 \
+	((struct cont_op_out)	(csqo_op)		CRT_VAR) \
+	((daos_prop_t)		(csqo_prop)		CRT_PTR) \
+	((daos_epoch_t)		(csqo_hae)		CRT_VAR) \
+				/* # of objects in all targets */ \
+	((uint64_t)		(csqo_nobjs)		CRT_VAR) \
+				/* # of open handles */		 \
+	((uint32_t)		(csqo_nhandles)		CRT_VAR) \
+	((uint32_t)		(csqo_pad32)		CRT_VAR)
+
+CRT_RPC_DECLARE(cont_svc_query, DAOS_ISEQ_CONT_SVC_QUERY,
+		DAOS_OSEQ_CONT_SVC_QUERY)
+
+#define DAOS_ISEQ_CONT_SVC_DESTROY /* input fields */		 \
+				/* .ci_pool_hdl, .ci_hdl unused */ \
+	((struct cont_op_in)	(csdi_op)		CRT_VAR) \
+	((uuid_t)		(csdi_pool_uuid)	CRT_VAR) \
+				/* evict all handles */		 \
+	((uint32_t)		(csdi_force)		CRT_VAR) \
+	((uint32_t)		(csdi_pad32)		CRT_VAR)
+
+#define DAOS_OSEQ_CONT_SVC_DESTROY /* output fields */		 \
+	((struct cont_op_out)	(csdo_op)		CRT_VAR)
+
+CRT_RPC_DECLARE(cont_svc_destroy, DAOS_ISEQ_CONT_SVC_DESTROY,
+		DAOS_OSEQ_CONT_SVC_DESTROY)
+
+#define DAOS_ISEQ_TGT_USAGE	/* input fields */		 \
+	((uuid_t)		(tui_pool_uuid)		CRT_VAR) \
+	((uuid_t)		(tui_cont_uuid)		CRT_VAR)
+
+#define DAOS_OSEQ_TGT_USAGE	/* output fields */		 \
+	((int32_t)		(tuo_rc)		CRT_VAR) \
+	((int32_t)		(tuo_pad32)		CRT_VAR) \
+	((daos_epoch_t)		(tuo_hae)		CRT_VAR) \
+				/* # of objects, counted from the OI */ \
+	((uint64_t)		(tuo_nobjs)		CRT_VAR)
+
+CRT_RPC_DECLARE(cont_tgt_usage, DAOS_ISEQ_TGT_USAGE, DAOS_OSEQ_TGT_USAGE)
+
 #define DAOS_ISEQ_CONT_TGT_EPOCH_AGGREGATE /* input fields */	 \
 	((uuid_t)		(tai_cont_uuid)		CRT_VAR) \
 	((uuid_t)		(tai_pool_uuid)		CRT_VAR) \
@@ -59,6 +59,11 @@ static struct crt_corpc_ops ds_cont_tgt_query_co_ops = {
 	.co_pre_forward = NULL,
 };
 
+static struct crt_corpc_ops ds_cont_tgt_usage_co_ops = {
+	.co_aggregate   = ds_cont_tgt_usage_aggregator,
+	.co_pre_forward = NULL,
+};
+
 static struct crt_corpc_ops ds_cont_tgt_epoch_aggregate_co_ops = {
 	.co_aggregate   = ds_cont_tgt_epoch_aggregate_aggregator,
 	.co_pre_forward = NULL,
@@ -25,6 +25,54 @@
 #include "srv_internal.h"
 #include "srv_layout.h"
 
+/*
+ * Gather the usage of a container from all targets. The number of objects is
+ * counted from the object index of each target when requested, rather than
+ * being maintained on the I/O path.
+ */
+static int
+cont_usage_bcast(crt_context_t ctx, struct cont *cont,
+		 struct cont_svc_query_out *query_out)
+{
+	struct cont_tgt_usage_in	*in;
+	struct cont_tgt_usage_out	*out;
+	crt_rpc_t			*rpc;
+	int				 rc;
+
+	D_DEBUG(DF_DSMS, DF_CONT": bcasting usage query\n",
+		DP_CONT(cont->c_svc->cs_pool_uuid, cont->c_uuid));
+
+	rc = ds_cont_bcast_create(ctx, cont->c_svc, CONT_TGT_USAGE, &rpc);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+	in = crt_req_get(rpc);
+	uuid_copy(in->tui_pool_uuid, cont->c_svc->cs_pool_uuid);
+	uuid_copy(in->tui_cont_uuid, cont->c_uuid);
+	out = crt_reply_get(rpc);
+	out->tuo_hae = DAOS_EPOCH_MAX;
+
+	rc = dss_rpc_send(rpc);
+	if (rc != 0)
+		D_GOTO(out_rpc, rc);
+
+	out = crt_reply_get(rpc);
+	rc  = out->tuo_rc;
+	if (rc != 0) {
+		D_DEBUG(DF_DSMS, DF_CONT": failed to query %d targets\n",
+			DP_CONT(cont->c_svc->cs_pool_uuid, cont->c_uuid), rc);
+		D_GOTO(out_rpc, rc = -DER_IO);
+	}
+
+	query_out->csqo_hae = out->tuo_hae;
+	query_out->csqo_nobjs = out->tuo_nobjs;
+
+out_rpc:
+	crt_req_decref(rpc);
+out:
+	return rc;
+}
+
 static int
 cont_prop_read(struct rdb_tx *tx, struct cont *cont, uint64_t bits,
 	       daos_prop_t **prop_out);
@@ -953,12 +1001,51 @@ out:
 	return rc;
 }
 
+/*
+ * Evict (or refuse to destroy when !force) the open handles, destroy the
+ * container on all targets and remove its metadata from the container
+ * service. The caller is responsible for any permission checking.
+ */
+static int
+cont_destroy_kvs(struct rdb_tx *tx, struct cont *cont, bool force,
+		 crt_context_t ctx)
+{
+	d_iov_t	key;
+	int	rc;
+
+	rc = evict_hdls(tx, cont, force, ctx);
+	if (rc != 0)
+		return rc;
+
+	rc = cont_destroy_bcast(ctx, cont->c_svc, cont->c_uuid);
+	if (rc != 0)
+		return rc;
+
+	/* Destroy the handle index KVS. */
+	rc = rdb_tx_destroy_kvs(tx, &cont->c_prop, &ds_cont_prop_handles);
+	if (rc != 0)
+		return rc;
+
+	/* Destroy the user attribute KVS. */
+	rc = rdb_tx_destroy_kvs(tx, &cont->c_prop, &ds_cont_attr_user);
+	if (rc != 0)
+		return rc;
+
+	/* Destroy the snapshot KVS. */
+	rc = rdb_tx_destroy_kvs(tx, &cont->c_prop, &ds_cont_prop_snapshots);
+	if (rc != 0)
+		return rc;
+
+	/* Destroy the container attribute KVS. */
+	d_iov_set(&key, cont->c_uuid, sizeof(uuid_t));
+	return rdb_tx_destroy_kvs(tx, &cont->c_svc->cs_conts, &key);
+}
+
 static int
 cont_destroy(struct rdb_tx *tx, struct ds_pool_hdl *pool_hdl,
 	     struct cont *cont, crt_rpc_t *rpc)
 {
 	struct cont_destroy_in *in = crt_req_get(rpc);
-	d_iov_t			key;
 	int			rc;
 	daos_prop_t	       *prop = NULL;
 	struct ownership	owner;
@@ -993,32 +1080,7 @@ cont_destroy(struct rdb_tx *tx, struct ds_pool_hdl *pool_hdl,
 		D_GOTO(out_prop, rc = -DER_NO_PERM);
 	}
 
-	rc = evict_hdls(tx, cont, in->cdi_force, rpc->cr_ctx);
-	if (rc != 0)
-		goto out_prop;
-
-	rc = cont_destroy_bcast(rpc->cr_ctx, cont->c_svc, in->cdi_op.ci_uuid);
-	if (rc != 0)
-		goto out_prop;
-
-	/* Destroy the handle index KVS. */
-	rc = rdb_tx_destroy_kvs(tx, &cont->c_prop, &ds_cont_prop_handles);
-	if (rc != 0)
-		goto out_prop;
-
-	/* Destroy the user attribute KVS. */
-	rc = rdb_tx_destroy_kvs(tx, &cont->c_prop, &ds_cont_attr_user);
-	if (rc != 0)
-		goto out_prop;
-
-	/* Destroy the snapshot KVS. */
-	rc = rdb_tx_destroy_kvs(tx, &cont->c_prop, &ds_cont_prop_snapshots);
-	if (rc != 0)
-		goto out_prop;
-
-	/* Destroy the container attribute KVS. */
-	d_iov_set(&key, in->cdi_op.ci_uuid, sizeof(uuid_t));
-	rc = rdb_tx_destroy_kvs(tx, &cont->c_svc->cs_conts, &key);
+	rc = cont_destroy_kvs(tx, cont, in->cdi_force, rpc->cr_ctx);
 
 out_prop:
 	daos_prop_free(prop);
@@ -3380,6 +3442,330 @@ out:
 	crt_reply_send(rpc);
 }
 
+static int
+count_hdls_cb(daos_handle_t ih, d_iov_t *key, d_iov_t *val, void *arg)
+{
+	uint32_t *nhandles = arg;
+
+	(*nhandles)++;
+	return 0;
+}
+
+void
+ds_cont_svc_query_handler(crt_rpc_t *rpc)
+{
+	struct cont_svc_query_in	*in = crt_req_get(rpc);
+	struct cont_svc_query_out	*out = crt_reply_get(rpc);
+	struct cont_svc			*svc;
+	struct rdb_tx			 tx;
+	struct cont			*cont;
+	daos_prop_t			*prop = NULL;
+	int				 rc;
+
+	D_DEBUG(DF_DSMS, DF_CONT": processing cont svc query rpc %p\n",
+		DP_CONT(in->csqi_pool_uuid, in->csqi_op.ci_uuid), rpc);
+
+	rc = cont_svc_lookup_leader(in->csqi_pool_uuid, 0 /* id */,
+				    &svc, &out->csqo_op.co_hint);
+	if (rc != 0) {
+		D_ERROR(DF_CONT": Failed to look up cont svc: %d\n",
+			DP_CONT(in->csqi_pool_uuid, in->csqi_op.ci_uuid), rc);
+		D_GOTO(out, rc);
+	}
+
+	rc = rdb_tx_begin(svc->cs_rsvc->s_db, svc->cs_rsvc->s_term, &tx);
+	if (rc != 0)
+		D_GOTO(out_svc, rc);
+
+	ABT_rwlock_rdlock(svc->cs_lock);
+
+	rc = cont_lookup(&tx, svc, in->csqi_op.ci_uuid, &cont);
+	if (rc != 0)
+		D_GOTO(out_lock, rc);
+
+	if (in->csqi_bits & DAOS_CO_QUERY_TGT) {
+		rc = cont_usage_bcast(rpc->cr_ctx, cont, out);
+		if (rc != 0)
+			D_GOTO(out_cont, rc);
+
+		out->csqo_nhandles = 0;
+		rc = rdb_tx_iterate(&tx, &cont->c_hdls, false /* !backward */,
+				    count_hdls_cb, &out->csqo_nhandles);
+		if (rc != 0)
+			D_GOTO(out_cont, rc);
+	}
+
+	if (in->csqi_bits & DAOS_CO_QUERY_PROP_ALL) {
+		rc = cont_prop_read(&tx, cont, in->csqi_bits, &prop);
+		out->csqo_prop = prop;
+	}
+
+out_cont:
+	cont_put(cont);
+out_lock:
+	ABT_rwlock_unlock(svc->cs_lock);
+	rdb_tx_end(&tx);
+out_svc:
+	ds_rsvc_set_hint(svc->cs_rsvc, &out->csqo_op.co_hint);
+	cont_svc_put_leader(svc);
+out:
+	D_DEBUG(DF_DSMS, DF_CONT": replying rpc %p: rc=%d\n",
+		DP_CONT(in->csqi_pool_uuid, in->csqi_op.ci_uuid), rpc, rc);
+
+	out->csqo_op.co_rc = rc;
+	crt_reply_send(rpc);
+	daos_prop_free(prop);
+}
+
+void
+ds_cont_svc_destroy_handler(crt_rpc_t *rpc)
+{
+	struct cont_svc_destroy_in	*in = crt_req_get(rpc);
+	struct cont_svc_destroy_out	*out = crt_reply_get(rpc);
+	struct cont_svc			*svc;
+	struct rdb_tx			 tx;
+	struct cont			*cont;
+	int				 rc;
+
+	D_DEBUG(DF_DSMS, DF_CONT": processing cont svc destroy rpc %p: "
+		"force=%u\n", DP_CONT(in->csdi_pool_uuid, in->csdi_op.ci_uuid),
+		rpc, in->csdi_force);
+
+	rc = cont_svc_lookup_leader(in->csdi_pool_uuid, 0 /* id */,
+				    &svc, &out->csdo_op.co_hint);
+	if (rc != 0) {
+		D_ERROR(DF_CONT": Failed to look up cont svc: %d\n",
+			DP_CONT(in->csdi_pool_uuid, in->csdi_op.ci_uuid), rc);
+		D_GOTO(out, rc);
+	}
+
+	rc = rdb_tx_begin(svc->cs_rsvc->s_db, svc->cs_rsvc->s_term, &tx);
+	if (rc != 0)
+		D_GOTO(out_svc, rc);
+
+	ABT_rwlock_wrlock(svc->cs_lock);
+
+	rc = cont_lookup(&tx, svc, in->csdi_op.ci_uuid, &cont);
+	if (rc != 0)
+		D_GOTO(out_lock, rc);
+
+	rc = cont_destroy_kvs(&tx, cont, in->csdi_force, rpc->cr_ctx);
+	cont_put(cont);
+	if (rc != 0)
+		D_GOTO(out_lock, rc);
+
+	rc = rdb_tx_commit(&tx);
+	if (rc != 0)
+		D_ERROR(DF_CONT": Unable to commit RDB transaction\n",
+			DP_CONT(in->csdi_pool_uuid, in->csdi_op.ci_uuid));
+
+out_lock:
+	ABT_rwlock_unlock(svc->cs_lock);
+	rdb_tx_end(&tx);
+out_svc:
+	ds_rsvc_set_hint(svc->cs_rsvc, &out->csdo_op.co_hint);
+	cont_svc_put_leader(svc);
+out:
+	D_DEBUG(DF_DSMS, DF_CONT": replying rpc %p: rc=%d\n",
+		DP_CONT(in->csdi_pool_uuid, in->csdi_op.ci_uuid), rpc, rc);
+
+	out->csdo_op.co_rc = rc;
+	crt_reply_send(rpc);
+}
+
+/* Copy the properties returned in a reply, which is freed with the RPC. */
+static int
+cont_svc_prop_dup(daos_prop_t *prop, daos_prop_t **prop_out)
+{
+	daos_prop_t	*prop_dup;
+	int		 i;
+	int		 rc;
+
+	if (prop == NULL || prop->dpp_nr == 0)
+		return -DER_PROTO;
+
+	prop_dup = daos_prop_alloc(prop->dpp_nr);
+	if (prop_dup == NULL)
+		return -DER_NOMEM;
+
+	for (i = 0; i < prop->dpp_nr; i++) {
+		rc = daos_prop_entry_copy(&prop->dpp_entries[i],
+					  &prop_dup->dpp_entries[i]);
+		if (rc != 0) {
+			daos_prop_free(prop_dup);
+			return rc;
+		}
+	}
+
+	*prop_out = prop_dup;
+	return 0;
+}
+
+/*
+ * Send a query RPC from a DAOS server instance to the container service. The
+ * usage counters are only gathered from the targets when \a info is supplied,
+ * and all properties are returned in \a prop_out when it is non-NULL.
+ */
+int
+ds_cont_svc_query(uuid_t pool_uuid, uuid_t cont_uuid, d_rank_list_t *ranks,
+		  struct ds_cont_svc_info *info, daos_prop_t **prop_out)
+{
+	int				rc;
+	struct rsvc_client		client;
+	crt_endpoint_t			ep;
+	struct dss_module_info		*dmi = dss_get_module_info();
+	crt_rpc_t			*rpc;
+	struct cont_svc_query_in	*in;
+	struct cont_svc_query_out	*out;
+
+	D_DEBUG(DB_MGMT, DF_CONT": Querying container\n",
+		DP_CONT(pool_uuid, cont_uuid));
+
+	rc = rsvc_client_init(&client, ranks);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+rechoose:
+	ep.ep_grp = NULL; /* primary group */
+	rc = rsvc_client_choose(&client, &ep);
+	if (rc != 0) {
+		D_ERROR(DF_CONT": cannot find pool service: "DF_RC"\n",
+			DP_CONT(pool_uuid, cont_uuid), DP_RC(rc));
+		D_GOTO(out_client, rc);
+	}
+
+	rc = cont_req_create(dmi->dmi_ctx, &ep, CONT_SVC_QUERY, &rpc);
+	if (rc != 0) {
+		D_ERROR(DF_CONT": failed to create cont query rpc: %d\n",
+			DP_CONT(pool_uuid, cont_uuid), rc);
+		D_GOTO(out_client, rc);
+	}
+
+	in = crt_req_get(rpc);
+	uuid_clear(in->csqi_op.ci_hdl);
+	uuid_clear(in->csqi_op.ci_pool_hdl);
+	uuid_copy(in->csqi_op.ci_uuid, cont_uuid);
+	uuid_copy(in->csqi_pool_uuid, pool_uuid);
+	in->csqi_bits = 0;
+	if (info != NULL)
+		in->csqi_bits |= DAOS_CO_QUERY_TGT;
+	if (prop_out != NULL)
+		in->csqi_bits |= DAOS_CO_QUERY_PROP_ALL;
+
+	rc = dss_rpc_send(rpc);
+	out = crt_reply_get(rpc);
+	D_ASSERT(out != NULL);
+
+	rc = rsvc_client_complete_rpc(&client, &ep, rc,
+				      out->csqo_op.co_rc,
+				      &out->csqo_op.co_hint);
+	if (rc == RSVC_CLIENT_RECHOOSE) {
+		crt_req_decref(rpc);
+		dss_sleep(1000 /* ms */);
+		D_GOTO(rechoose, rc);
+	}
+
+	rc = out->csqo_op.co_rc;
+	if (rc != 0) {
+		D_ERROR(DF_CONT": failed to query container: %d\n",
+			DP_CONT(pool_uuid, cont_uuid), rc);
+		D_GOTO(out_rpc, rc);
+	}
+
+	if (info != NULL) {
+		info->csi_nobjs = out->csqo_nobjs;
+		info->csi_hae = out->csqo_hae;
+		info->csi_nhandles = out->csqo_nhandles;
+	}
+
+	if (prop_out != NULL) {
+		rc = cont_svc_prop_dup(out->csqo_prop, prop_out);
+		if (rc != 0)
+			D_ERROR(DF_CONT": failed to copy container prop: %d\n",
+				DP_CONT(pool_uuid, cont_uuid), rc);
+	}
+
+out_rpc:
+	crt_req_decref(rpc);
+out_client:
+	rsvc_client_fini(&client);
+out:
+	return rc;
+}
+
+/*
+ * Send a destroy RPC from a DAOS server instance to the container service.
+ * Unless \a force is set, containers with open handles are not destroyed and
+ * -DER_BUSY is returned.
+ */
+int
+ds_cont_svc_destroy(uuid_t pool_uuid, uuid_t cont_uuid, d_rank_list_t *ranks,
+		    bool force)
+{
+	int				rc;
+	struct rsvc_client		client;
+	crt_endpoint_t			ep;
+	struct dss_module_info		*info = dss_get_module_info();
+	crt_rpc_t			*rpc;
+	struct cont_svc_destroy_in	*in;
+	struct cont_svc_destroy_out	*out;
+
+	D_DEBUG(DB_MGMT, DF_CONT": Destroying container, force=%d\n",
+		DP_CONT(pool_uuid, cont_uuid), force);
+
+	rc = rsvc_client_init(&client, ranks);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+rechoose:
+	ep.ep_grp = NULL; /* primary group */
+	rc = rsvc_client_choose(&client, &ep);
+	if (rc != 0) {
+		D_ERROR(DF_CONT": cannot find pool service: "DF_RC"\n",
+			DP_CONT(pool_uuid, cont_uuid), DP_RC(rc));
+		D_GOTO(out_client, rc);
+	}
+
+	rc = cont_req_create(info->dmi_ctx, &ep, CONT_SVC_DESTROY, &rpc);
+	if (rc != 0) {
+		D_ERROR(DF_CONT": failed to create cont destroy rpc: %d\n",
+			DP_CONT(pool_uuid, cont_uuid), rc);
+		D_GOTO(out_client, rc);
+	}
+
+	in = crt_req_get(rpc);
+	uuid_clear(in->csdi_op.ci_hdl);
+	uuid_clear(in->csdi_op.ci_pool_hdl);
+	uuid_copy(in->csdi_op.ci_uuid, cont_uuid);
+	uuid_copy(in->csdi_pool_uuid, pool_uuid);
+	in->csdi_force = force;
+
+	rc = dss_rpc_send(rpc);
+	out = crt_reply_get(rpc);
+	D_ASSERT(out != NULL);
+
+	rc = rsvc_client_complete_rpc(&client, &ep, rc,
+				      out->csdo_op.co_rc,
+				      &out->csdo_op.co_hint);
+	if (rc == RSVC_CLIENT_RECHOOSE) {
+		crt_req_decref(rpc);
+		dss_sleep(1000 /* ms */);
+		D_GOTO(rechoose, rc);
+	}
+
+	rc = out->csdo_op.co_rc;
+	if (rc != 0) {
+		D_ERROR(DF_CONT": failed to destroy container: %d\n",
+			DP_CONT(pool_uuid, cont_uuid), rc);
+	}
+
+	crt_req_decref(rpc);
+out_client:
+	rsvc_client_fini(&client);
+out:
+	return rc;
+}
+
 int
 ds_cont_get_prop(uuid_t pool_uuid, uuid_t cont_uuid, daos_prop_t **prop_out)
 {
@@ -160,6 +160,8 @@ struct cont_iv_key {
  */
 void ds_cont_op_handler(crt_rpc_t *rpc);
 void ds_cont_set_prop_handler(crt_rpc_t *rpc);
+void ds_cont_svc_query_handler(crt_rpc_t *rpc);
+void ds_cont_svc_destroy_handler(crt_rpc_t *rpc);
 int ds_cont_bcast_create(crt_context_t ctx, struct cont_svc *svc,
 			 crt_opcode_t opcode, crt_rpc_t **rpc);
 int ds_cont_oid_fetch_add(uuid_t poh_uuid, uuid_t co_uuid, uuid_t coh_uuid,
@@ -225,6 +227,9 @@ int ds_cont_tgt_close_aggregator(crt_rpc_t *source, crt_rpc_t *result,
 void ds_cont_tgt_query_handler(crt_rpc_t *rpc);
 int ds_cont_tgt_query_aggregator(crt_rpc_t *source, crt_rpc_t *result,
 				 void *priv);
+void ds_cont_tgt_usage_handler(crt_rpc_t *rpc);
+int ds_cont_tgt_usage_aggregator(crt_rpc_t *source, crt_rpc_t *result,
+				 void *priv);
 void ds_cont_tgt_epoch_aggregate_handler(crt_rpc_t *rpc);
 int ds_cont_tgt_epoch_aggregate_aggregator(crt_rpc_t *source, crt_rpc_t *result,
 					   void *priv);
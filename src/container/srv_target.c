@@ -1769,6 +1769,166 @@ ds_cont_tgt_query_aggregator(crt_rpc_t *source, crt_rpc_t *result, void *priv)
 	return 0;
 }
 
+struct xstream_cont_usage {
+	struct cont_tgt_usage_in	*xcu_rpc_in;
+	daos_epoch_t			 xcu_hae;
+	uint64_t			 xcu_nobjs;
+};
+
+/* Yield every so often when counting the objects of a large container */
+#define CONT_USAGE_YIELD_NR	1024
+
+static int
+cont_count_obj_cb(daos_handle_t ih, vos_iter_entry_t *ent,
+		  vos_iter_type_t type, vos_iter_param_t *param, void *data,
+		  unsigned int *acts)
+{
+	uint64_t *nobjs = data;
+
+	(*nobjs)++;
+	if (*nobjs % CONT_USAGE_YIELD_NR == 0)
+		*acts |= VOS_ITER_CB_YIELD;
+	return 0;
+}
+
+/*
+ * Count the objects of the container on this target by iterating its object
+ * index, so that no persistent counter needs to be maintained on the I/O path.
+ */
+static int
+cont_usage_one(void *vin)
+{
+	struct dss_coll_stream_args	*reduce	   = vin;
+	struct dss_stream_arg_type	*streams   = reduce->csa_streams;
+	struct dss_module_info		*info	   = dss_get_module_info();
+	int				 tid	   = info->dmi_tgt_id;
+	struct xstream_cont_usage	*pack_args = streams[tid].st_arg;
+	struct cont_tgt_usage_in	*in	   = pack_args->xcu_rpc_in;
+	struct ds_pool_child		*pool_child;
+	struct vos_iter_anchors		 anchors = {0};
+	vos_iter_param_t		 param = {0};
+	daos_handle_t			 vos_chdl;
+	vos_cont_info_t			 vos_cinfo;
+	int				 rc;
+
+	pool_child = ds_pool_child_lookup(in->tui_pool_uuid);
+	if (pool_child == NULL)
+		return -DER_NO_HDL;
+
+	rc = vos_cont_open(pool_child->spc_hdl, in->tui_cont_uuid, &vos_chdl);
+	if (rc != 0) {
+		D_ERROR(DF_CONT": failed to open VOS container: "DF_RC"\n",
+			DP_CONT(in->tui_pool_uuid, in->tui_cont_uuid),
+			DP_RC(rc));
+		D_GOTO(out_child, rc);
+	}
+
+	rc = vos_cont_query(vos_chdl, &vos_cinfo);
+	if (rc != 0) {
+		D_ERROR(DF_CONT": failed to query VOS container: "DF_RC"\n",
+			DP_CONT(in->tui_pool_uuid, in->tui_cont_uuid),
+			DP_RC(rc));
+		D_GOTO(out_cont, rc);
+	}
+	pack_args->xcu_hae = vos_cinfo.ci_hae;
+
+	param.ip_hdl = vos_chdl;
+	param.ip_epr.epr_lo = 0;
+	param.ip_epr.epr_hi = DAOS_EPOCH_MAX;
+	rc = vos_iterate(&param, VOS_ITER_OBJ, false, &anchors,
+			 cont_count_obj_cb, NULL, &pack_args->xcu_nobjs, NULL);
+	if (rc != 0)
+		D_ERROR(DF_CONT": failed to count objects: "DF_RC"\n",
+			DP_CONT(in->tui_pool_uuid, in->tui_cont_uuid),
+			DP_RC(rc));
+
+out_cont:
+	vos_cont_close(vos_chdl);
+out_child:
+	ds_pool_child_put(pool_child);
+	return rc;
+}
+
+static void
+ds_cont_usage_coll_reduce(void *a_args, void *s_args)
+{
+	struct xstream_cont_usage	*aggregator = a_args;
+	struct xstream_cont_usage	*stream     = s_args;
+
+	aggregator->xcu_hae = MIN(aggregator->xcu_hae, stream->xcu_hae);
+	aggregator->xcu_nobjs += stream->xcu_nobjs;
+}
+
+static int
+ds_cont_usage_stream_alloc(struct dss_stream_arg_type *args, void *a_arg)
+{
+	struct xstream_cont_usage	*rarg = a_arg;
+
+	D_ALLOC(args->st_arg, sizeof(struct xstream_cont_usage));
+	if (args->st_arg == NULL)
+		return -DER_NOMEM;
+	memcpy(args->st_arg, rarg, sizeof(struct xstream_cont_usage));
+
+	return 0;
+}
+
+static void
+ds_cont_usage_stream_free(struct dss_stream_arg_type *c_args)
+{
+	D_ASSERT(c_args->st_arg != NULL);
+	D_FREE(c_args->st_arg);
+}
+
+void
+ds_cont_tgt_usage_handler(crt_rpc_t *rpc)
+{
+	struct cont_tgt_usage_in	*in  = crt_req_get(rpc);
+	struct cont_tgt_usage_out	*out = crt_reply_get(rpc);
+	struct dss_coll_ops		 coll_ops = { 0 };
+	struct dss_coll_args		 coll_args = { 0 };
+	struct xstream_cont_usage	 pack_args;
+	int				 rc;
+
+	coll_ops.co_func		= cont_usage_one;
+	coll_ops.co_reduce		= ds_cont_usage_coll_reduce;
+	coll_ops.co_reduce_arg_alloc	= ds_cont_usage_stream_alloc;
+	coll_ops.co_reduce_arg_free	= ds_cont_usage_stream_free;
+
+	pack_args.xcu_rpc_in		= in;
+	pack_args.xcu_hae		= DAOS_EPOCH_MAX;
+	pack_args.xcu_nobjs		= 0;
+
+	coll_args.ca_aggregator		= &pack_args;
+	coll_args.ca_func_args		= &coll_args.ca_stream_args;
+
+	rc = dss_task_collective_reduce(&coll_ops, &coll_args, 0);
+	if (rc != 0)
+		D_ERROR(DF_CONT": failed to gather usage: "DF_RC"\n",
+			DP_CONT(in->tui_pool_uuid, in->tui_cont_uuid),
+			DP_RC(rc));
+
+	out->tuo_hae	= pack_args.xcu_hae;
+	out->tuo_nobjs	= pack_args.xcu_nobjs;
+	out->tuo_rc	= (rc == 0 ? 0 : 1);
+
+	D_DEBUG(DF_DSMS, DF_CONT": replying rpc %p: %d "DF_RC"\n",
+		DP_CONT(in->tui_pool_uuid, in->tui_cont_uuid), rpc,
+		out->tuo_rc, DP_RC(rc));
+	crt_reply_send(rpc);
+}
+
+int
+ds_cont_tgt_usage_aggregator(crt_rpc_t *source, crt_rpc_t *result, void *priv)
+{
+	struct cont_tgt_usage_out	*out_source = crt_reply_get(source);
+	struct cont_tgt_usage_out	*out_result = crt_reply_get(result);
+
+	out_result->tuo_hae = MIN(out_result->tuo_hae, out_source->tuo_hae);
+	out_result->tuo_nobjs += out_source->tuo_nobjs;
+	out_result->tuo_rc += out_source->tuo_rc;
+	return 0;
+}
+
 struct cont_snap_args {
 	uuid_t		 pool_uuid;
 	uuid_t		 cont_uuid;
@@ -0,0 +1,270 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/build"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+const (
+	agentAdminSockName = "daos_agent_admin.sock"
+
+	// msProbeTimeout bounds the time spent checking MS connectivity when
+	// reporting agent status.
+	msProbeTimeout = 10 * time.Second
+)
+
+// adminSockPath returns the path of the agent's admin socket.
+func adminSockPath(cfg *Config) string {
+	return filepath.Join(cfg.RuntimeDir, agentAdminSockName)
+}
+
+// startAdminServer starts a dRPC server on the agent's admin socket. Access
+// to the socket is restricted to the user running the agent.
+func startAdminServer(ctx context.Context, log logging.Logger, cfg *Config, mods ...drpc.Module) (*drpc.DomainSocketServer, error) {
+	sockPath := adminSockPath(cfg)
+
+	adminServer, err := drpc.NewDomainSocketServer(ctx, log, sockPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create admin socket server")
+	}
+	for _, mod := range mods {
+		adminServer.RegisterRPCModule(mod)
+	}
+
+	if err := adminServer.Start(); err != nil {
+		return nil, errors.Wrapf(err, "unable to start admin socket server on %s", sockPath)
+	}
+	if err := os.Chmod(sockPath, 0600); err != nil {
+		adminServer.Shutdown()
+		return nil, errors.Wrapf(err, "unable to set permissions on %s", sockPath)
+	}
+
+	return adminServer, nil
+}
+
+// adminModule is the daos_agent dRPC module that handles administrative
+// requests received on the agent's admin socket.
+type adminModule struct {
+	log       logging.Logger
+	startTime time.Time
+	systems   *systemSet
+}
+
+func (mod *adminModule) HandleCall(_ *drpc.Session, method drpc.Method, req []byte) ([]byte, error) {
+	ctx := context.TODO()
+
+	switch method {
+	case drpc.MethodAgentCacheRefresh:
+		return mod.handleCacheRefresh(ctx, req)
+	case drpc.MethodAgentStatus:
+		return mod.handleStatus(ctx, req)
+	case drpc.MethodAgentHandles:
+		return mod.handleHandles(ctx, req)
+	case drpc.MethodAgentCacheDump:
+		return mod.handleCacheDump(req)
+	default:
+		return nil, drpc.UnknownMethodFailure()
+	}
+}
+
+func (mod *adminModule) ID() drpc.ModuleID {
+	return drpc.ModuleAgentAdmin
+}
+
+// handleCacheRefresh regenerates the attach info cache and reports the
+// outcome.
+func (mod *adminModule) handleCacheRefresh(ctx context.Context, reqb []byte) ([]byte, error) {
+	pbReq := new(mgmtpb.AgentCacheRefreshReq)
+	if err := proto.Unmarshal(reqb, pbReq); err != nil {
+		return nil, drpc.UnmarshalingPayloadFailure()
+	}
+
+	mgmtMod, err := mod.systems.get(pbReq.Sys)
+	if err != nil {
+		return drpc.Marshal(&mgmtpb.AgentCacheRefreshResp{Error: err.Error()})
+	}
+
+	mod.log.Debugf("attach info cache refresh requested for system %s", mgmtMod.sys)
+	resp, err := mgmtMod.refreshCache(ctx)
+	if err != nil {
+		mod.log.Errorf("requested cache refresh failed: %s", err)
+		return drpc.Marshal(&mgmtpb.AgentCacheRefreshResp{Error: err.Error()})
+	}
+
+	return drpc.Marshal(resp)
+}
+
+// handleStatus reports the state of the agent, including whether the MS can
+// currently be reached.
+func (mod *adminModule) handleStatus(ctx context.Context, reqb []byte) ([]byte, error) {
+	pbReq := new(mgmtpb.AgentStatusReq)
+	if err := proto.Unmarshal(reqb, pbReq); err != nil {
+		return nil, drpc.UnmarshalingPayloadFailure()
+	}
+
+	mgmtMod, err := mod.systems.get(pbReq.Sys)
+	if err != nil {
+		return drpc.Marshal(&mgmtpb.AgentStatusResp{Error: err.Error()})
+	}
+
+	resp, err := mod.getStatus(ctx, mgmtMod)
+	if err != nil {
+		return drpc.Marshal(&mgmtpb.AgentStatusResp{Error: err.Error()})
+	}
+
+	return drpc.Marshal(resp)
+}
+
+func (mod *adminModule) getStatus(ctx context.Context, mgmtMod *mgmtModule) (*mgmtpb.AgentStatusResp, error) {
+	resp := &mgmtpb.AgentStatusResp{
+		Version:        build.DaosVersion,
+		Sys:            mgmtMod.sys,
+		Pid:            int32(os.Getpid()),
+		StartTime:      mod.startTime.Unix(),
+		MapWatchActive: mgmtMod.mapWatchActive.IsTrue(),
+		Systems:        mod.systems.names(),
+		FabricPolicy:   mgmtMod.aiCache.policy.selection(),
+	}
+
+	mgmtMod.mutex.Lock()
+	if mgmtMod.ctlCfg != nil {
+		resp.AccessPoints = append([]string{}, mgmtMod.ctlCfg.HostList...)
+	}
+	mgmtMod.mutex.Unlock()
+
+	probeCtx, cancel := context.WithTimeout(ctx, msProbeTimeout)
+	defer cancel()
+	req := new(control.GetAttachInfoReq)
+	req.SetSystem(mgmtMod.sys)
+	_, probeErr := control.GetAttachInfo(probeCtx, mgmtMod.ctlInvoker, req)
+
+	mgmtMod.mutex.Lock()
+	if probeErr == nil {
+		mgmtMod.lastMSContact = time.Now()
+	}
+	lastContact := mgmtMod.lastMSContact
+	mgmtMod.mutex.Unlock()
+
+	if probeErr != nil {
+		resp.MsError = probeErr.Error()
+	} else {
+		resp.MsReachable = true
+	}
+	if !lastContact.IsZero() {
+		resp.MsLastContact = lastContact.Unix()
+	}
+
+	cache, err := mgmtMod.aiCache.dump()
+	if err != nil {
+		return nil, err
+	}
+	resp.CacheEnabled = cache.Enabled
+	resp.CacheInitialized = cache.Initialized
+	resp.CacheMapVersion = cache.MapVersion
+
+	procs, err := mgmtMod.monitor.GetHandles(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying process monitor")
+	}
+	resp.NumProcs = uint32(len(procs))
+	for _, proc := range procs {
+		for _, pool := range proc.Pools {
+			resp.NumHandles += uint32(len(pool.Handles))
+		}
+	}
+
+	resp.Clients = mgmtMod.clientInterfaces()
+
+	return resp, nil
+}
+
+// handleHandles reports the pool handles held by the monitored processes.
+func (mod *adminModule) handleHandles(ctx context.Context, reqb []byte) ([]byte, error) {
+	pbReq := new(mgmtpb.AgentHandlesReq)
+	if err := proto.Unmarshal(reqb, pbReq); err != nil {
+		return nil, drpc.UnmarshalingPayloadFailure()
+	}
+
+	mgmtMod, err := mod.systems.get(pbReq.Sys)
+	if err != nil {
+		return drpc.Marshal(&mgmtpb.AgentHandlesResp{Error: err.Error()})
+	}
+
+	procs, err := mgmtMod.monitor.GetHandles(ctx)
+	if err != nil {
+		return drpc.Marshal(&mgmtpb.AgentHandlesResp{
+			Error: errors.Wrap(err, "querying process monitor").Error(),
+		})
+	}
+
+	return drpc.Marshal(&mgmtpb.AgentHandlesResp{Processes: procs})
+}
+
+// handleCacheDump reports the contents of the attach info cache.
+func (mod *adminModule) handleCacheDump(reqb []byte) ([]byte, error) {
+	pbReq := new(mgmtpb.AgentCacheDumpReq)
+	if err := proto.Unmarshal(reqb, pbReq); err != nil {
+		return nil, drpc.UnmarshalingPayloadFailure()
+	}
+
+	mgmtMod, err := mod.systems.get(pbReq.Sys)
+	if err != nil {
+		return drpc.Marshal(&mgmtpb.AgentCacheDumpResp{Error: err.Error()})
+	}
+
+	resp, err := mgmtMod.aiCache.dump()
+	if err != nil {
+		return drpc.Marshal(&mgmtpb.AgentCacheDumpResp{Error: err.Error()})
+	}
+
+	return drpc.Marshal(resp)
+}
+
+// callAdminMethod sends the request to the agent's admin socket and
+// unmarshals the reply into the supplied response.
+func callAdminMethod(client drpc.DomainSocketClient, method drpc.Method, req, resp proto.Message) error {
+	if err := client.Connect(); err != nil {
+		return errors.Wrapf(err, "unable to connect to %s (is daos_agent running?)",
+			client.GetSocketPath())
+	}
+	defer client.Close()
+
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return drpc.MarshalingFailure()
+	}
+
+	dResp, err := client.SendMsg(&drpc.Call{
+		Module: method.Module().ID(),
+		Method: method.ID(),
+		Body:   body,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "%s request failed", method)
+	}
+	if dResp.Status != drpc.Status_SUCCESS {
+		return errors.Errorf("%s request failed: bad dRPC response status: %s", method, dResp.Status)
+	}
+
+	if err := proto.Unmarshal(dResp.Body, resp); err != nil {
+		return drpc.UnmarshalingPayloadFailure()
+	}
+	return nil
+}
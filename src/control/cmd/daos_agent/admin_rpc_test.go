@@ -0,0 +1,241 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/lib/netdetect"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func mustMarshal(t *testing.T, msg proto.Message) []byte {
+	t.Helper()
+
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestAgent_adminModule_HandleCall(t *testing.T) {
+	startTime := time.Unix(1618000000, 0)
+	msResp := control.MockMSResponse("host1", nil, &mgmtpb.GetAttachInfoResp{Provider: "ofi+sockets"})
+	loInfo := &mgmtpb.GetAttachInfoResp{
+		Interface:  defaultNetworkDevice,
+		Domain:     defaultDomain,
+		MapVersion: 1,
+	}
+
+	for name, tc := range map[string]struct {
+		method   drpc.Method
+		req      []byte
+		disabled bool
+		msResp   *control.UnaryResponse
+		expResp  proto.Message
+		expErr   error
+	}{
+		"unknown method": {
+			method: drpc.MethodGetAttachInfo,
+			expErr: drpc.UnknownMethodFailure(),
+		},
+		"bad payload": {
+			method: drpc.MethodAgentCacheRefresh,
+			req:    []byte("garbage"),
+			expErr: drpc.UnmarshalingPayloadFailure(),
+		},
+		"unknown system": {
+			method: drpc.MethodAgentCacheRefresh,
+			req:    mustMarshal(t, &mgmtpb.AgentCacheRefreshReq{Sys: "quack"}),
+			expResp: &mgmtpb.AgentCacheRefreshResp{
+				Error: "quack: unknown system name",
+			},
+		},
+		"caching disabled": {
+			method:   drpc.MethodAgentCacheRefresh,
+			req:      mustMarshal(t, &mgmtpb.AgentCacheRefreshReq{}),
+			disabled: true,
+			expResp: &mgmtpb.AgentCacheRefreshResp{
+				Error: "attach info caching is disabled",
+			},
+		},
+		"status; unknown system": {
+			method: drpc.MethodAgentStatus,
+			req:    mustMarshal(t, &mgmtpb.AgentStatusReq{Sys: "quack"}),
+			expResp: &mgmtpb.AgentStatusResp{
+				Error: "quack: unknown system name",
+			},
+		},
+		"status; MS reachable": {
+			method: drpc.MethodAgentStatus,
+			req:    mustMarshal(t, &mgmtpb.AgentStatusReq{}),
+			msResp: msResp,
+			expResp: &mgmtpb.AgentStatusResp{
+				Version:          build.DaosVersion,
+				Sys:              "daos_server",
+				Pid:              int32(os.Getpid()),
+				StartTime:        startTime.Unix(),
+				AccessPoints:     []string{"host1:10001"},
+				MsReachable:      true,
+				CacheEnabled:     true,
+				CacheInitialized: true,
+				CacheMapVersion:  1,
+				Systems:          []string{"daos_server"},
+				FabricPolicy:     policyRoundRobin,
+			},
+		},
+		"status; MS unreachable": {
+			method: drpc.MethodAgentStatus,
+			req:    mustMarshal(t, &mgmtpb.AgentStatusReq{}),
+			msResp: control.MockMSResponse("host1", errors.New("whoops"), nil),
+			expResp: &mgmtpb.AgentStatusResp{
+				Version:          build.DaosVersion,
+				Sys:              "daos_server",
+				Pid:              int32(os.Getpid()),
+				StartTime:        startTime.Unix(),
+				AccessPoints:     []string{"host1:10001"},
+				MsError:          "whoops",
+				CacheEnabled:     true,
+				CacheInitialized: true,
+				CacheMapVersion:  1,
+				Systems:          []string{"daos_server"},
+				FabricPolicy:     policyRoundRobin,
+			},
+		},
+		"handles; unknown system": {
+			method: drpc.MethodAgentHandles,
+			req:    mustMarshal(t, &mgmtpb.AgentHandlesReq{Sys: "quack"}),
+			expResp: &mgmtpb.AgentHandlesResp{
+				Error: "quack: unknown system name",
+			},
+		},
+		"handles; none held": {
+			method:  drpc.MethodAgentHandles,
+			req:     mustMarshal(t, &mgmtpb.AgentHandlesReq{}),
+			expResp: &mgmtpb.AgentHandlesResp{},
+		},
+		"cache dump; unknown system": {
+			method: drpc.MethodAgentCacheDump,
+			req:    mustMarshal(t, &mgmtpb.AgentCacheDumpReq{Sys: "quack"}),
+			expResp: &mgmtpb.AgentCacheDumpResp{
+				Error: "quack: unknown system name",
+			},
+		},
+		"cache dump; caching disabled": {
+			method:   drpc.MethodAgentCacheDump,
+			req:      mustMarshal(t, &mgmtpb.AgentCacheDumpReq{}),
+			disabled: true,
+			expResp:  &mgmtpb.AgentCacheDumpResp{},
+		},
+		"cache dump": {
+			method: drpc.MethodAgentCacheDump,
+			req:    mustMarshal(t, &mgmtpb.AgentCacheDumpReq{}),
+			expResp: &mgmtpb.AgentCacheDumpResp{
+				Enabled:     true,
+				Initialized: true,
+				MapVersion:  1,
+				Entries: []*mgmtpb.AgentCacheDumpResp_Entry{
+					{Info: loInfo},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: tc.msResp,
+			})
+			mgmtMod := newTestMgmtModule(t, log, !tc.disabled, 1, mi)
+			defer netdetect.CleanUp(mgmtMod.netCtx)
+			mgmtMod.ctlCfg = &control.Config{HostList: []string{"host1:10001"}}
+			mgmtMod.monitor.startMonitoring(ctx)
+
+			mod := &adminModule{
+				log:       log,
+				startTime: startTime,
+				systems:   newSystemSet(mgmtMod.sys, mgmtMod),
+			}
+
+			respb, err := mod.HandleCall(nil, tc.method, tc.req)
+			common.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			resp := proto.Clone(tc.expResp)
+			resp.Reset()
+			if err := proto.Unmarshal(respb, resp); err != nil {
+				t.Fatal(err)
+			}
+
+			cmpOpts := append(common.DefaultCmpOpts(),
+				cmpopts.IgnoreFields(mgmtpb.AgentStatusResp{}, "MsLastContact"),
+			)
+			if diff := cmp.Diff(tc.expResp, resp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_startAdminServer(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	tmpDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+
+	cfg := DefaultConfig()
+	cfg.RuntimeDir = tmpDir
+
+	mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+		UnaryResponse: control.MockMSResponse("host1", errors.New("whoops"), nil),
+	})
+	mgmtMod := newTestMgmtModule(t, log, true, 1, mi)
+	defer netdetect.CleanUp(mgmtMod.netCtx)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := startAdminServer(ctx, log, cfg, &adminModule{log: log, systems: newSystemSet(mgmtMod.sys, mgmtMod)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Shutdown()
+
+	fi, err := os.Stat(adminSockPath(cfg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, os.FileMode(0600), fi.Mode().Perm(), "unexpected admin socket permissions")
+
+	resp := new(mgmtpb.AgentCacheRefreshResp)
+	client := drpc.NewClientConnection(adminSockPath(cfg))
+	if err := callAdminMethod(client, drpc.MethodAgentCacheRefresh, &mgmtpb.AgentCacheRefreshReq{}, resp); err != nil {
+		t.Fatal(err)
+	}
+	common.CmpErr(t, errors.New("whoops"), errors.New(resp.Error))
+}
@@ -0,0 +1,128 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/lib/txtfmt"
+)
+
+// cacheCmd is the struct representing the top-level cache subcommand.
+type cacheCmd struct {
+	Refresh cacheRefreshCmd `command:"refresh" description:"Refresh the attach info cache of the running daos_agent"`
+	Dump    cacheDumpCmd    `command:"dump" description:"Dump the attach info cache of the running daos_agent"`
+}
+
+// cacheRefreshCmd asks the running agent to regenerate its attach info cache.
+type cacheRefreshCmd struct {
+	logCmd
+	configCmd
+	jsonOutputCmd
+	sysCmd
+}
+
+func (cmd *cacheRefreshCmd) Execute(_ []string) error {
+	req := &mgmtpb.AgentCacheRefreshReq{Sys: cmd.Sys}
+	resp := new(mgmtpb.AgentCacheRefreshResp)
+
+	client := drpc.NewClientConnection(adminSockPath(cmd.cfg))
+	if err := callAdminMethod(client, drpc.MethodAgentCacheRefresh, req, resp); err != nil {
+		return err
+	}
+	if resp.Error != "" {
+		return errors.Errorf("cache refresh failed: %s", resp.Error)
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(os.Stdout, resp)
+	}
+
+	cmd.log.Infof("attach info cache refreshed (map version %d, provider %s, %d ranks)",
+		resp.MapVersion, resp.Provider, resp.NumRanks)
+	return nil
+}
+
+// cacheDumpCmd displays the attach info cached by the running agent.
+type cacheDumpCmd struct {
+	logCmd
+	configCmd
+	jsonOutputCmd
+	sysCmd
+}
+
+func (cmd *cacheDumpCmd) Execute(_ []string) error {
+	req := &mgmtpb.AgentCacheDumpReq{Sys: cmd.Sys}
+	resp := new(mgmtpb.AgentCacheDumpResp)
+
+	client := drpc.NewClientConnection(adminSockPath(cmd.cfg))
+	if err := callAdminMethod(client, drpc.MethodAgentCacheDump, req, resp); err != nil {
+		return err
+	}
+	if resp.Error != "" {
+		return errors.Errorf("cache dump failed: %s", resp.Error)
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(os.Stdout, resp)
+	}
+
+	var out strings.Builder
+	if err := printAgentCacheDump(&out, resp); err != nil {
+		return err
+	}
+	cmd.log.Info(out.String())
+	return nil
+}
+
+// printAgentCacheDump generates a human-readable representation of the
+// supplied attach info cache contents and writes it to the supplied io.Writer.
+func printAgentCacheDump(out io.Writer, resp *mgmtpb.AgentCacheDumpResp) error {
+	switch {
+	case !resp.Enabled:
+		_, err := fmt.Fprintln(out, "Attach info caching is disabled")
+		return err
+	case !resp.Initialized:
+		_, err := fmt.Fprintln(out, "Attach info cache is empty")
+		return err
+	}
+
+	w := txtfmt.NewErrWriter(out)
+	fmt.Fprintf(w, "Attach info cache (map version %d, default NUMA node %d):\n",
+		resp.MapVersion, resp.DefaultNumaNode)
+
+	numaTitle := "NUMA"
+	idxTitle := "Index"
+	providerTitle := "Provider"
+	ifaceTitle := "Interface"
+	domainTitle := "Domain"
+	ranksTitle := "Rank URIs"
+
+	formatter := txtfmt.NewTableFormatter(numaTitle, idxTitle, providerTitle, ifaceTitle, domainTitle, ranksTitle)
+	var table []txtfmt.TableRow
+
+	for _, entry := range resp.Entries {
+		table = append(table, txtfmt.TableRow{
+			numaTitle:     fmt.Sprintf("%d", entry.NumaNode),
+			idxTitle:      fmt.Sprintf("%d", entry.DeviceIndex),
+			providerTitle: entry.Info.GetProvider(),
+			ifaceTitle:    entry.Info.GetInterface(),
+			domainTitle:   entry.Info.GetDomain(),
+			ranksTitle:    fmt.Sprintf("%d", len(entry.Info.GetRankUris())),
+		})
+	}
+	fmt.Fprint(w, formatter.Format(table))
+
+	return w.Err
+}
@@ -0,0 +1,119 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/events"
+	"github.com/mjmac/soad/src/control/lib/control"
+)
+
+const (
+	// mapWatchRetryInterval is the time to wait before resubscribing to
+	// system map updates if the subscription fails.
+	mapWatchRetryInterval = 30 * time.Second
+)
+
+// refreshCache regenerates the attach info cache from the current MS data,
+// regardless of whether the cached data is believed to be current.
+func (mod *mgmtModule) refreshCache(ctx context.Context) (*mgmtpb.AgentCacheRefreshResp, error) {
+	if mod.aiCache.enabled.IsFalse() {
+		return nil, errors.New("attach info caching is disabled")
+	}
+
+	mod.mutex.Lock()
+	defer mod.mutex.Unlock()
+
+	resp, err := mod.refreshAttachInfoCache(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "refreshing attach info cache")
+	}
+	mod.log.Debugf("attach info cache refreshed (map version %d)", resp.MapVersion)
+
+	return &mgmtpb.AgentCacheRefreshResp{
+		MapVersion: resp.MapVersion,
+		Provider:   resp.Provider,
+		NumRanks:   uint32(len(resp.ServiceRanks)),
+	}, nil
+}
+
+// onMapVersion invalidates the attach info cache if the supplied system map
+// version is newer than that of the cached data.
+func (mod *mgmtModule) onMapVersion(mapVersion uint32) {
+	if mod.aiCache.invalidateIfStale(mapVersion) {
+		mod.log.Infof("system map updated to version %d; attach info cache invalidated", mapVersion)
+	}
+}
+
+// startCacheRefresh starts a goroutine that periodically refreshes the attach
+// info cache, if it has been populated. The existing data is retained if the
+// refresh fails.
+func (mod *mgmtModule) startCacheRefresh(ctx context.Context, interval time.Duration) {
+	if interval <= 0 || mod.aiCache.enabled.IsFalse() {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !mod.aiCache.isCached() {
+					continue
+				}
+				if _, err := mod.refreshCache(ctx); err != nil {
+					mod.log.Errorf("periodic refresh failed (keeping cached data): %s", err)
+				}
+			}
+		}
+	}()
+}
+
+// startMapWatch starts a goroutine that subscribes to the system map update
+// events published by the MS, in order to invalidate the attach info cache as
+// soon as the cached data is out of date.
+func (mod *mgmtModule) startMapWatch(ctx context.Context) {
+	if mod.aiCache.enabled.IsFalse() {
+		return
+	}
+
+	go func() {
+		for {
+			req := &control.SubscribeEventsReq{
+				IDs: []events.RASID{events.RASSystemMapUpdate},
+			}
+			req.SetSystem(mod.sys)
+
+			mod.mapWatchActive.SetTrue()
+			err := control.SubscribeEvents(ctx, mod.ctlInvoker, req, func(evt *control.StreamedEvent) error {
+				mod.onMapVersion(evt.MapVersion)
+				return nil
+			})
+			mod.mapWatchActive.SetFalse()
+			if ctx.Err() != nil {
+				return
+			}
+			mod.log.Debugf("system map update subscription failed (retrying in %s): %v",
+				mapWatchRetryInterval, err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(mapWatchRetryInterval):
+			}
+		}
+	}()
+}
@@ -0,0 +1,162 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/events"
+	"github.com/mjmac/soad/src/control/lib/atm"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/lib/netdetect"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+// newTestMgmtModule returns a mgmtModule whose attach info cache has been
+// populated from a response with the supplied map version.
+func newTestMgmtModule(t *testing.T, log logging.Logger, cacheEnabled bool, mapVersion uint32, invoker control.Invoker) *mgmtModule {
+	t.Helper()
+
+	netCtx, err := netdetect.Init(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mod := &mgmtModule{
+		log:        log,
+		sys:        "daos_server",
+		ctlInvoker: invoker,
+		aiCache:    &attachInfoCache{log: log, enabled: atm.NewBool(cacheEnabled)},
+		netCtx:     netCtx,
+		monitor:    NewProcMon(log, invoker, "daos_server", ""),
+	}
+	if err := mod.aiCache.initResponseCache(netCtx, &mgmtpb.GetAttachInfoResp{MapVersion: mapVersion}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	return mod
+}
+
+func TestAgent_mgmtModule_refreshCache(t *testing.T) {
+	for name, tc := range map[string]struct {
+		disabled      bool
+		msResp        *control.UnaryResponse
+		expMapVersion uint32
+		expErr        error
+	}{
+		"caching disabled": {
+			disabled: true,
+			expErr:   errors.New("disabled"),
+		},
+		"MS failure": {
+			msResp:        control.MockMSResponse("host1", errors.New("whoops"), nil),
+			expMapVersion: 1,
+			expErr:        errors.New("whoops"),
+		},
+		"no provider": {
+			msResp:        control.MockMSResponse("host1", nil, &mgmtpb.GetAttachInfoResp{MapVersion: 2}),
+			expMapVersion: 1,
+			expErr:        errors.New("no provider"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: tc.msResp,
+			})
+			mod := newTestMgmtModule(t, log, !tc.disabled, 1, mi)
+			defer netdetect.CleanUp(mod.netCtx)
+
+			_, gotErr := mod.refreshCache(context.TODO())
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.disabled {
+				return
+			}
+
+			// A failed refresh must not discard the existing data.
+			common.AssertTrue(t, mod.aiCache.isCached(), "cached data discarded")
+			common.AssertEqual(t, tc.expMapVersion, mod.aiCache.mapVersion, "unexpected cached map version")
+		})
+	}
+}
+
+func TestAgent_mgmtModule_startMapWatch(t *testing.T) {
+	pbEvt, err := events.NewSystemMapUpdateEvent("foo", 3).ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		updates        []proto.Message
+		expInvalidated bool
+	}{
+		"no updates": {},
+		"same map version": {
+			updates: []proto.Message{
+				&mgmtpb.SubscribeEventsResp{Event: pbEvt, MapVersion: 2},
+			},
+		},
+		"newer map version": {
+			updates: []proto.Message{
+				&mgmtpb.SubscribeEventsResp{Event: pbEvt, MapVersion: 2},
+				&mgmtpb.SubscribeEventsResp{Event: pbEvt, MapVersion: 3},
+			},
+			expInvalidated: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			// Block the stream after the updates have been delivered,
+			// so that the watcher has finished with them once the
+			// marker message is received.
+			marker := make(chan struct{})
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				StreamResponses: tc.updates,
+			})
+			invoker := &markerInvoker{MockInvoker: mi, done: marker}
+
+			mod := newTestMgmtModule(t, log, true, 2, invoker)
+			defer netdetect.CleanUp(mod.netCtx)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mod.startMapWatch(ctx)
+
+			select {
+			case <-marker:
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for map updates to be processed")
+			}
+
+			common.AssertEqual(t, !tc.expInvalidated, mod.aiCache.isCached(), "unexpected cache state")
+		})
+	}
+}
+
+// markerInvoker signals once the mock stream responses have been delivered.
+type markerInvoker struct {
+	*control.MockInvoker
+	done chan struct{}
+}
+
+func (mi *markerInvoker) InvokeStreamRPC(ctx context.Context, req control.StreamRequest, recv func(proto.Message) error) error {
+	err := mi.MockInvoker.InvokeStreamRPC(ctx, req, recv)
+	close(mi.done)
+	<-ctx.Done()
+	return err
+}
@@ -9,7 +9,9 @@ package main
 import (
 	"fmt"
 	"io/ioutil"
+	"time"
 
+	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
 
 	"github.com/mjmac/soad/src/control/build"
@@ -20,8 +22,19 @@ const (
 	defaultConfigFile = "daos_agent.yml"
 	defaultRuntimeDir = "/var/run/daos_agent"
 	defaultLogFile    = "/tmp/daos_agent.log"
+
+	defaultCacheRefreshInterval = 10 * time.Minute
 )
 
+// SystemConfig defines the parameters used to communicate with a DAOS system
+// served by the agent.
+type SystemConfig struct {
+	Name            string                    `yaml:"name"`
+	AccessPoints    []string                  `yaml:"access_points"`
+	ControlPort     int                       `yaml:"port"`
+	TransportConfig *security.TransportConfig `yaml:"transport_config"`
+}
+
 // Config defines the agent configuration.
 type Config struct {
 	SystemName      string                    `yaml:"name"`
@@ -30,6 +43,66 @@ type Config struct {
 	RuntimeDir      string                    `yaml:"runtime_dir"`
 	LogFile         string                    `yaml:"log_file"`
 	TransportConfig *security.TransportConfig `yaml:"transport_config"`
+	// CacheRefreshInterval is the period between refreshes of the attach
+	// info cache. A value of zero disables periodic refreshes.
+	CacheRefreshInterval time.Duration `yaml:"cache_refresh_interval"`
+	// ExtraSystems are served in addition to the default system defined
+	// by the top-level name, access points, port and transport config.
+	ExtraSystems []*SystemConfig `yaml:"systems"`
+	// FabricPolicy controls the assignment of fabric interfaces to
+	// clients.
+	FabricPolicy FabricPolicy `yaml:"fabric_policy"`
+}
+
+// Systems returns the configuration of each of the systems served by the
+// agent, starting with the default system. Parameters not set for an
+// additional system are inherited from the default system.
+func (cfg *Config) Systems() []*SystemConfig {
+	systems := []*SystemConfig{
+		{
+			Name:            cfg.SystemName,
+			AccessPoints:    cfg.AccessPoints,
+			ControlPort:     cfg.ControlPort,
+			TransportConfig: cfg.TransportConfig,
+		},
+	}
+	for _, extra := range cfg.ExtraSystems {
+		sys := *extra
+		if sys.ControlPort == 0 {
+			sys.ControlPort = cfg.ControlPort
+		}
+		if sys.TransportConfig == nil {
+			sys.TransportConfig = cfg.TransportConfig
+		}
+		systems = append(systems, &sys)
+	}
+
+	return systems
+}
+
+// Validate checks that the systems served by the agent are uniquely named
+// and reachable, and that the fabric policy is well-formed.
+func (cfg *Config) Validate() error {
+	if err := cfg.FabricPolicy.Validate(); err != nil {
+		return errors.Wrap(err, "fabric_policy")
+	}
+
+	seen := make(map[string]struct{})
+	for _, sys := range cfg.Systems() {
+		if sys.Name == "" {
+			return errors.New("system name must not be empty")
+		}
+		if _, dupe := seen[sys.Name]; dupe {
+			return errors.Errorf("system %q is defined more than once", sys.Name)
+		}
+		seen[sys.Name] = struct{}{}
+
+		if len(sys.AccessPoints) == 0 {
+			return errors.Errorf("no access points defined for system %q", sys.Name)
+		}
+	}
+
+	return nil
 }
 
 func LoadConfig(cfgPath string) (*Config, error) {
@@ -42,17 +115,21 @@ func LoadConfig(cfgPath string) (*Config, error) {
 	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
 		return nil, err
 	}
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid configuration in %s", cfgPath)
+	}
 	return cfg, nil
 }
 
 func DefaultConfig() *Config {
 	localServer := fmt.Sprintf("localhost:%d", build.DefaultControlPort)
 	return &Config{
-		SystemName:      build.DefaultSystemName,
-		ControlPort:     build.DefaultControlPort,
-		AccessPoints:    []string{localServer},
-		RuntimeDir:      defaultRuntimeDir,
-		LogFile:         defaultLogFile,
-		TransportConfig: security.DefaultAgentTransportConfig(),
+		SystemName:           build.DefaultSystemName,
+		ControlPort:          build.DefaultControlPort,
+		AccessPoints:         []string{localServer},
+		RuntimeDir:           defaultRuntimeDir,
+		LogFile:              defaultLogFile,
+		TransportConfig:      security.DefaultAgentTransportConfig(),
+		CacheRefreshInterval: defaultCacheRefreshInterval,
 	}
 }
@@ -0,0 +1,160 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/security"
+)
+
+func TestAgent_Config_Systems(t *testing.T) {
+	defTC := &security.TransportConfig{AllowInsecure: true}
+	otherTC := &security.TransportConfig{CertificateConfig: security.CertificateConfig{CARootPath: "/other/ca.crt"}}
+
+	cfg := &Config{
+		SystemName:      "daos_server",
+		AccessPoints:    []string{"host1:10001"},
+		ControlPort:     10001,
+		TransportConfig: defTC,
+		ExtraSystems: []*SystemConfig{
+			{Name: "inherit", AccessPoints: []string{"host2"}},
+			{Name: "override", AccessPoints: []string{"host3"}, ControlPort: 10002, TransportConfig: otherTC},
+		},
+	}
+
+	expSystems := []*SystemConfig{
+		{Name: "daos_server", AccessPoints: []string{"host1:10001"}, ControlPort: 10001, TransportConfig: defTC},
+		{Name: "inherit", AccessPoints: []string{"host2"}, ControlPort: 10001, TransportConfig: defTC},
+		{Name: "override", AccessPoints: []string{"host3"}, ControlPort: 10002, TransportConfig: otherTC},
+	}
+	// Inherited transport configs are shared with the default system.
+	cmpOpts := []cmp.Option{
+		cmp.Comparer(func(x, y *security.TransportConfig) bool { return x == y }),
+	}
+	if diff := cmp.Diff(expSystems, cfg.Systems(), cmpOpts...); diff != "" {
+		t.Fatalf("unexpected systems (-want, +got):\n%s\n", diff)
+	}
+	common.AssertEqual(t, 0, cfg.ExtraSystems[0].ControlPort, "extra system config modified")
+}
+
+func TestAgent_Config_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		extra  []*SystemConfig
+		policy FabricPolicy
+		expErr error
+	}{
+		"default system only": {},
+		"extra system": {
+			extra: []*SystemConfig{{Name: "other", AccessPoints: []string{"host2"}}},
+		},
+		"unnamed system": {
+			extra:  []*SystemConfig{{AccessPoints: []string{"host2"}}},
+			expErr: errors.New("system name must not be empty"),
+		},
+		"duplicate system": {
+			extra:  []*SystemConfig{{Name: "daos_server", AccessPoints: []string{"host2"}}},
+			expErr: errors.New(`system "daos_server" is defined more than once`),
+		},
+		"no access points": {
+			extra:  []*SystemConfig{{Name: "other"}},
+			expErr: errors.New(`no access points defined for system "other"`),
+		},
+		"bad fabric policy": {
+			policy: FabricPolicy{Selection: "random"},
+			expErr: errors.New(`fabric_policy: unknown fabric selection policy "random"`),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.ExtraSystems = tc.extra
+			cfg.FabricPolicy = tc.policy
+
+			common.CmpErr(t, tc.expErr, cfg.Validate())
+		})
+	}
+}
+
+func TestAgent_LoadConfig_Systems(t *testing.T) {
+	tmpDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+
+	cfgPath := filepath.Join(tmpDir, "daos_agent.yml")
+	data := []byte(`
+name: daos_server
+access_points: ["host1"]
+systems:
+- name: other
+  access_points: ["host2"]
+  port: 10002
+`)
+	if err := ioutil.WriteFile(cfgPath, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadConfig(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	systems := cfg.Systems()
+	common.AssertEqual(t, 2, len(systems), "unexpected number of systems")
+	common.AssertEqual(t, "other", systems[1].Name, "unexpected system name")
+	common.AssertEqual(t, []string{"host2"}, systems[1].AccessPoints, "unexpected access points")
+	common.AssertEqual(t, 10002, systems[1].ControlPort, "unexpected port")
+
+	data = append(data, []byte(`- name: other
+  access_points: ["host3"]
+`)...)
+	if err := ioutil.WriteFile(cfgPath, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfig(cfgPath); err == nil {
+		t.Fatal("expected duplicate system to be rejected")
+	}
+}
+
+func TestAgent_LoadConfig_FabricPolicy(t *testing.T) {
+	tmpDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+
+	cfgPath := filepath.Join(tmpDir, "daos_agent.yml")
+	data := []byte(`
+name: daos_server
+access_points: ["host1"]
+fabric_policy:
+  selection: least-loaded
+  exclude: [eth0]
+  numa_interfaces:
+    0: [ib0]
+    1: [ib1, ib0]
+`)
+	if err := ioutil.WriteFile(cfgPath, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadConfig(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expPolicy := FabricPolicy{
+		Selection: policyLeastLoaded,
+		Exclude:   []string{"eth0"},
+		NUMAInterfaces: map[int][]string{
+			0: {"ib0"},
+			1: {"ib1", "ib0"},
+		},
+	}
+	if diff := cmp.Diff(expPolicy, cfg.FabricPolicy); diff != "" {
+		t.Fatalf("unexpected fabric policy (-want, +got):\n%s\n", diff)
+	}
+}
@@ -0,0 +1,174 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+)
+
+const (
+	// policyRoundRobin assigns the interfaces on the client's NUMA node in
+	// turn, falling back to the default NUMA node if there are none.
+	policyRoundRobin = "round-robin"
+	// policyNUMAStrict only assigns interfaces on the client's NUMA node.
+	policyNUMAStrict = "numa-strict"
+	// policyLeastLoaded assigns the interface on the client's NUMA node
+	// with the fewest pool handles held by the clients assigned to it.
+	policyLeastLoaded = "least-loaded"
+)
+
+// FabricPolicy controls how the agent assigns fabric interfaces to clients.
+type FabricPolicy struct {
+	// Selection is the policy used to choose between the interfaces
+	// available to a client.
+	Selection string `yaml:"selection,omitempty"`
+	// Exclude lists interfaces that are never assigned to clients.
+	Exclude []string `yaml:"exclude,omitempty"`
+	// NUMAInterfaces pins the clients bound to a NUMA node to the listed
+	// interfaces, regardless of the NUMA affinity of the interfaces.
+	NUMAInterfaces map[int][]string `yaml:"numa_interfaces,omitempty"`
+}
+
+// selection returns the name of the selection policy in effect.
+func (fp *FabricPolicy) selection() string {
+	if fp.Selection == "" {
+		return policyRoundRobin
+	}
+	return fp.Selection
+}
+
+func (fp *FabricPolicy) isExcluded(iface string) bool {
+	for _, excluded := range fp.Exclude {
+		if excluded == iface {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the policy is well-formed.
+func (fp *FabricPolicy) Validate() error {
+	switch fp.selection() {
+	case policyRoundRobin, policyNUMAStrict, policyLeastLoaded:
+	default:
+		return errors.Errorf("unknown fabric selection policy %q (expected one of %s)", fp.Selection,
+			strings.Join([]string{policyRoundRobin, policyNUMAStrict, policyLeastLoaded}, ", "))
+	}
+
+	for numa, ifaces := range fp.NUMAInterfaces {
+		if numa < 0 {
+			return errors.Errorf("invalid NUMA node %d in fabric interface pinning", numa)
+		}
+		if len(ifaces) == 0 {
+			return errors.Errorf("no fabric interfaces pinned to NUMA node %d", numa)
+		}
+		for _, iface := range ifaces {
+			if fp.isExcluded(iface) {
+				return errors.Errorf("fabric interface %s is both pinned to NUMA node %d and excluded", iface, numa)
+			}
+		}
+	}
+
+	return nil
+}
+
+// clientIface records the fabric interface assigned to a client process.
+type clientIface struct {
+	iface    string
+	numaNode int
+	// inode of /proc/<pid>, used to detect pid reuse
+	inode uint64
+}
+
+// selectInterface chooses a cached GetAttachInfo response for the client
+// according to the fabric policy, and records the interface assigned to the
+// client. Must be called with mod.mutex held.
+func (mod *mgmtModule) selectInterface(pid int32, numaNode int) ([]byte, error) {
+	var loads map[string]int
+	if mod.aiCache.policy.selection() == policyLeastLoaded {
+		loads = mod.interfaceLoads()
+	}
+
+	sel, err := mod.aiCache.selectDevice(numaNode, loads)
+	if err != nil {
+		return nil, err
+	}
+
+	mod.pruneClients()
+	inode, err := getProcPidInode(pid)
+	if err != nil {
+		mod.log.Debugf("unable to identify pid:%d: %s", pid, err)
+	}
+	if mod.clients == nil {
+		mod.clients = make(map[int32]*clientIface)
+	}
+	mod.clients[pid] = &clientIface{iface: sel.iface, numaNode: sel.numaNode, inode: inode}
+	mod.log.Infof("pid:%d (NUMA %d) assigned fabric interface %s from NUMA %d (policy %s)",
+		pid, numaNode, sel.iface, sel.numaNode, mod.aiCache.policy.selection())
+
+	return sel.resp, nil
+}
+
+// pruneClients forgets the interface assignments of processes that have
+// exited. Must be called with mod.mutex held.
+func (mod *mgmtModule) pruneClients() {
+	for pid, client := range mod.clients {
+		if inode, err := getProcPidInode(pid); err != nil || inode != client.inode {
+			delete(mod.clients, pid)
+		}
+	}
+}
+
+// forgetClient removes the interface assignment of an exiting process.
+func (mod *mgmtModule) forgetClient(pid int32) {
+	mod.mutex.Lock()
+	defer mod.mutex.Unlock()
+
+	delete(mod.clients, pid)
+}
+
+// interfaceLoads returns the number of pool handles held by the clients
+// assigned to each interface. Must be called with mod.mutex held.
+func (mod *mgmtModule) interfaceLoads() map[string]int {
+	counts := mod.monitor.HandleCounts()
+
+	loads := make(map[string]int)
+	for pid, client := range mod.clients {
+		loads[client.iface] += counts[pid]
+	}
+	return loads
+}
+
+// clientInterfaces reports the interfaces assigned to the client processes,
+// ordered by pid.
+func (mod *mgmtModule) clientInterfaces() []*mgmtpb.AgentStatusResp_Client {
+	counts := mod.monitor.HandleCounts()
+
+	mod.mutex.Lock()
+	defer mod.mutex.Unlock()
+
+	mod.pruneClients()
+	clients := make([]*mgmtpb.AgentStatusResp_Client, 0, len(mod.clients))
+	for pid, client := range mod.clients {
+		clients = append(clients, &mgmtpb.AgentStatusResp_Client{
+			Pid:       pid,
+			Interface: client.iface,
+			NumaNode:  int32(client.numaNode),
+			Handles:   uint32(counts[pid]),
+		})
+	}
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].Pid < clients[j].Pid
+	})
+
+	return clients
+}
@@ -0,0 +1,116 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/lib/netdetect"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func TestAgent_FabricPolicy_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		policy FabricPolicy
+		expErr error
+	}{
+		"default": {},
+		"numa-strict": {
+			policy: FabricPolicy{Selection: policyNUMAStrict},
+		},
+		"least-loaded with pinning and exclusion": {
+			policy: FabricPolicy{
+				Selection:      policyLeastLoaded,
+				Exclude:        []string{"eth0"},
+				NUMAInterfaces: map[int][]string{0: {"ib0"}, 1: {"ib1"}},
+			},
+		},
+		"unknown selection": {
+			policy: FabricPolicy{Selection: "random"},
+			expErr: errors.New(`unknown fabric selection policy "random"`),
+		},
+		"negative NUMA node": {
+			policy: FabricPolicy{NUMAInterfaces: map[int][]string{-1: {"ib0"}}},
+			expErr: errors.New("invalid NUMA node -1"),
+		},
+		"empty pin list": {
+			policy: FabricPolicy{NUMAInterfaces: map[int][]string{1: {}}},
+			expErr: errors.New("no fabric interfaces pinned to NUMA node 1"),
+		},
+		"pinned interface excluded": {
+			policy: FabricPolicy{
+				Exclude:        []string{"ib0"},
+				NUMAInterfaces: map[int][]string{0: {"ib0"}},
+			},
+			expErr: errors.New("ib0 is both pinned to NUMA node 0 and excluded"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			common.CmpErr(t, tc.expErr, tc.policy.Validate())
+		})
+	}
+}
+
+func TestAgent_mgmtModule_selectInterface(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pid := int32(os.Getpid())
+	mi := control.NewMockInvoker(log, &control.MockInvokerConfig{})
+	mod := newTestMgmtModule(t, log, true, 1, mi)
+	defer netdetect.CleanUp(mod.netCtx)
+	mod.aiCache.policy.Selection = policyLeastLoaded
+	mod.monitor.startMonitoring(ctx)
+
+	mod.monitor.AddPoolHandle(ctx, pid, &mgmtpb.PoolMonitorReq{PoolUUID: "pool1", PoolHandleUUID: "hdl1"})
+	mod.monitor.AddPoolHandle(ctx, pid, &mgmtpb.PoolMonitorReq{PoolUUID: "pool2", PoolHandleUUID: "hdl2"})
+	// Wait for the requests to be handled.
+	if _, err := mod.monitor.GetHandles(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// An assignment to a process that no longer exists is pruned.
+	mod.clients = map[int32]*clientIface{
+		math.MaxInt32: {iface: defaultNetworkDevice},
+	}
+
+	mod.mutex.Lock()
+	_, err := mod.selectInterface(pid, 0)
+	mod.mutex.Unlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotClients := mod.clientInterfaces()
+	expClients := []*mgmtpb.AgentStatusResp_Client{
+		{Pid: pid, Interface: defaultNetworkDevice, Handles: 2},
+	}
+	if diff := cmp.Diff(expClients, gotClients, common.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("unexpected clients (-want, +got):\n%s\n", diff)
+	}
+
+	mod.mutex.Lock()
+	loads := mod.interfaceLoads()
+	mod.mutex.Unlock()
+	common.AssertEqual(t, map[string]int{defaultNetworkDevice: 2}, loads, "unexpected interface loads")
+
+	mod.forgetClient(pid)
+	gotClients = mod.clientInterfaces()
+	common.AssertEqual(t, 0, len(gotClients), "client not forgotten")
+}
@@ -8,6 +8,7 @@ package main
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"sync"
 
@@ -36,36 +37,71 @@ type attachInfoCache struct {
 	initialized atm.Bool
 	// maps NUMA affinity and device index to a response
 	numaDeviceMarshResp map[int]map[int][]byte
+	// maps NUMA affinity and device index to the interface name
+	numaDeviceNames map[int]map[int]string
 	// maps NUMA affinity to a device index
 	currentNumaDevIdx map[int]int
 	mutex             sync.Mutex
 	// specifies what NUMA node to use when there are no devices
 	// associated with the client NUMA node
 	defaultNumaNode int
+	// system map version of the cached responses
+	mapVersion uint32
+	// controls which devices are cached and assigned to clients
+	policy FabricPolicy
 }
 
-// loadBalance is a simple round-robin load balancing scheme
-// to assign network interface adapters to clients
-// on the same NUMA node that have multiple adapters
-// to choose from.  Returns the index of the device to use.
-func (aic *attachInfoCache) loadBalance(numaNode int) int {
-	aic.mutex.Lock()
-	deviceIndex := invalidIndex
+// deviceSelection describes the cached response chosen for a client.
+type deviceSelection struct {
+	numaNode    int
+	deviceIndex int
+	iface       string
+	resp        []byte
+}
+
+// loadBalance chooses which of the devices on the NUMA node to assign to a
+// client. By default this is a simple round-robin scheme; with the
+// least-loaded policy the device with the lowest load is chosen, with ties
+// resolved in round-robin order. Returns the index of the device to use.
+// Must be called with aic.mutex held.
+func (aic *attachInfoCache) loadBalance(numaNode int, loads map[string]int) int {
 	numDevs := len(aic.numaDeviceMarshResp[numaNode])
-	if numDevs > 0 {
-		deviceIndex = aic.currentNumaDevIdx[numaNode]
-		aic.currentNumaDevIdx[numaNode] = (deviceIndex + 1) % numDevs
+	if numDevs == 0 {
+		return invalidIndex
+	}
+
+	start := aic.currentNumaDevIdx[numaNode] % numDevs
+	deviceIndex := start
+	if aic.policy.selection() == policyLeastLoaded {
+		minLoad := -1
+		for i := 0; i < numDevs; i++ {
+			idx := (start + i) % numDevs
+			load := loads[aic.numaDeviceNames[numaNode][idx]]
+			if minLoad < 0 || load < minLoad {
+				deviceIndex, minLoad = idx, load
+			}
+		}
 	}
-	aic.mutex.Unlock()
+	aic.currentNumaDevIdx[numaNode] = (deviceIndex + 1) % numDevs
+
 	return deviceIndex
 }
 
-func (aic *attachInfoCache) getResponse(numaNode int) ([]byte, error) {
-	deviceIndex := aic.loadBalance(numaNode)
+// selectDevice chooses the cached response for a client bound to the supplied
+// NUMA node according to the fabric policy. The loads map the interface names
+// to their current load, and are only used by the least-loaded policy.
+func (aic *attachInfoCache) selectDevice(numaNode int, loads map[string]int) (*deviceSelection, error) {
+	aic.mutex.Lock()
+	defer aic.mutex.Unlock()
+
+	deviceIndex := aic.loadBalance(numaNode, loads)
 	// If there is no response available for the client's actual NUMA node,
 	// use the default NUMA node
 	if deviceIndex == invalidIndex {
-		deviceIndex = aic.loadBalance(aic.defaultNumaNode)
+		if aic.policy.selection() == policyNUMAStrict {
+			return nil, errors.Errorf("No network devices bound to client NUMA node %d (fabric policy %s)", numaNode, policyNUMAStrict)
+		}
+		deviceIndex = aic.loadBalance(aic.defaultNumaNode, loads)
 		if deviceIndex == invalidIndex {
 			return nil, errors.Errorf("No default response found for the default NUMA node %d", aic.defaultNumaNode)
 		}
@@ -73,21 +109,88 @@ func (aic *attachInfoCache) getResponse(numaNode int) ([]byte, error) {
 		numaNode = aic.defaultNumaNode
 	}
 
-	aic.mutex.Lock()
-	defer aic.mutex.Unlock()
 	numaDeviceMarshResp, ok := aic.numaDeviceMarshResp[numaNode][deviceIndex]
 	if !ok {
 		return nil, errors.Errorf("GetAttachInfo entry for numaNode %d device index %d did not exist", numaNode, deviceIndex)
 	}
 
 	aic.log.Debugf("Retrieved response for NUMA %d with device index %d\n", numaNode, deviceIndex)
-	return numaDeviceMarshResp, nil
+	return &deviceSelection{
+		numaNode:    numaNode,
+		deviceIndex: deviceIndex,
+		iface:       aic.numaDeviceNames[numaNode][deviceIndex],
+		resp:        numaDeviceMarshResp,
+	}, nil
+}
+
+func (aic *attachInfoCache) getResponse(numaNode int) ([]byte, error) {
+	sel, err := aic.selectDevice(numaNode, nil)
+	if err != nil {
+		return nil, err
+	}
+	return sel.resp, nil
 }
 
 func (aic *attachInfoCache) isCached() bool {
 	return aic.enabled.IsTrue() && aic.initialized.IsTrue()
 }
 
+// invalidateIfStale marks the cache as uninitialized if the cached responses
+// were generated from a system map older than the supplied version, so that
+// they are regenerated on the next request. Returns true if the cache was
+// invalidated.
+func (aic *attachInfoCache) invalidateIfStale(mapVersion uint32) bool {
+	aic.mutex.Lock()
+	defer aic.mutex.Unlock()
+
+	if !aic.isCached() || mapVersion <= aic.mapVersion {
+		return false
+	}
+
+	aic.log.Debugf("invalidating attach info cache (map version %d < %d)", aic.mapVersion, mapVersion)
+	aic.initialized.SetFalse()
+	return true
+}
+
+// dump returns a description of the cached responses, ordered by NUMA node
+// and device index.
+func (aic *attachInfoCache) dump() (*mgmtpb.AgentCacheDumpResp, error) {
+	aic.mutex.Lock()
+	defer aic.mutex.Unlock()
+
+	resp := &mgmtpb.AgentCacheDumpResp{
+		Enabled:     aic.enabled.IsTrue(),
+		Initialized: aic.isCached(),
+	}
+	if !resp.Initialized {
+		return resp, nil
+	}
+	resp.MapVersion = aic.mapVersion
+	resp.DefaultNumaNode = int32(aic.defaultNumaNode)
+
+	for numa, devices := range aic.numaDeviceMarshResp {
+		for devIdx, marshResp := range devices {
+			info := new(mgmtpb.GetAttachInfoResp)
+			if err := proto.Unmarshal(marshResp, info); err != nil {
+				return nil, errors.Wrapf(err, "cached response for NUMA %d device index %d", numa, devIdx)
+			}
+			resp.Entries = append(resp.Entries, &mgmtpb.AgentCacheDumpResp_Entry{
+				NumaNode:    int32(numa),
+				DeviceIndex: int32(devIdx),
+				Info:        info,
+			})
+		}
+	}
+	sort.Slice(resp.Entries, func(i, j int) bool {
+		if resp.Entries[i].NumaNode != resp.Entries[j].NumaNode {
+			return resp.Entries[i].NumaNode < resp.Entries[j].NumaNode
+		}
+		return resp.Entries[i].DeviceIndex < resp.Entries[j].DeviceIndex
+	})
+
+	return resp, nil
+}
+
 // initResponseCache generates a unique dRPC response corresponding to each device specified
 // in the scanResults.  The responses are differentiated based on the network device NUMA affinity.
 func (aic *attachInfoCache) initResponseCache(ctx context.Context, resp *mgmtpb.GetAttachInfoResp, scanResults []*netdetect.FabricScan) error {
@@ -96,6 +199,8 @@ func (aic *attachInfoCache) initResponseCache(ctx context.Context, resp *mgmtpb.
 
 	// Make a new map each time the cache is initialized
 	aic.numaDeviceMarshResp = make(map[int]map[int][]byte)
+	aic.numaDeviceNames = make(map[int]map[int]string)
+	aic.mapVersion = resp.MapVersion
 
 	// Make a new map just once.
 	// Preserve any previous device index map in order to maintain ability to load balance
@@ -104,6 +209,7 @@ func (aic *attachInfoCache) initResponseCache(ctx context.Context, resp *mgmtpb.
 	}
 
 	var haveDefaultNuma bool
+	var devices []*deviceSelection
 
 	for _, fs := range scanResults {
 		if fs.DeviceName == "lo" {
@@ -116,6 +222,11 @@ func (aic *attachInfoCache) initResponseCache(ctx context.Context, resp *mgmtpb.
 			continue
 		}
 
+		if aic.policy.isExcluded(fs.DeviceName) {
+			aic.log.Debugf("Excluding device: %s from attachInfoCache.  Excluded by fabric policy\n", fs.DeviceName)
+			continue
+		}
+
 		resp.Interface = fs.DeviceName
 		// by default, the domain is the deviceName
 		resp.Domain = fs.DeviceName
@@ -135,11 +246,11 @@ func (aic *attachInfoCache) initResponseCache(ctx context.Context, resp *mgmtpb.
 		if err != nil {
 			return drpc.MarshalingFailure()
 		}
-
-		if _, ok := aic.numaDeviceMarshResp[numa]; !ok {
-			aic.numaDeviceMarshResp[numa] = make(map[int][]byte)
-		}
-		aic.numaDeviceMarshResp[numa][len(aic.numaDeviceMarshResp[numa])] = numaDeviceMarshResp
+		devices = append(devices, &deviceSelection{
+			numaNode: numa,
+			iface:    fs.DeviceName,
+			resp:     numaDeviceMarshResp,
+		})
 
 		// Any client bound to a NUMA node that has no network devices associated with it will
 		// get a response from this defaultNumaNode.
@@ -150,21 +261,57 @@ func (aic *attachInfoCache) initResponseCache(ctx context.Context, resp *mgmtpb.
 			haveDefaultNuma = true
 			aic.log.Debugf("The default NUMA node is: %d", aic.defaultNumaNode)
 		}
+	}
 
-		aic.log.Debugf("Added device %s, domain %s for NUMA %d, device number %d\n", resp.Interface, resp.Domain, numa, len(aic.numaDeviceMarshResp[numa])-1)
+	// Clients on a NUMA node with pinned interfaces are only offered those
+	// interfaces, wherever they are located.
+	pinned := make(map[int][]*deviceSelection)
+	for numa, ifaces := range aic.policy.NUMAInterfaces {
+		for _, iface := range ifaces {
+			var found bool
+			for _, dev := range devices {
+				if dev.iface == iface {
+					pinned[numa] = append(pinned[numa], dev)
+					found = true
+					break
+				}
+			}
+			if !found {
+				aic.log.Infof("Fabric interface %s pinned to NUMA %d is not available\n", iface, numa)
+			}
+		}
+	}
+
+	for _, dev := range devices {
+		if _, isPinned := pinned[dev.numaNode]; isPinned {
+			continue
+		}
+		aic.addDevice(dev.numaNode, dev)
+	}
+	pinnedNodes := make([]int, 0, len(pinned))
+	for numa := range pinned {
+		pinnedNodes = append(pinnedNodes, numa)
+	}
+	sort.Ints(pinnedNodes)
+	for _, numa := range pinnedNodes {
+		for _, dev := range pinned[numa] {
+			aic.addDevice(numa, dev)
+		}
 	}
 
 	// If there were no network devices found, then add a default response to the default NUMA node entry
 	if _, ok := aic.numaDeviceMarshResp[aic.defaultNumaNode]; !ok {
 		aic.log.Info("No network devices detected in fabric scan; default AttachInfo response may be incorrect\n")
-		aic.numaDeviceMarshResp[aic.defaultNumaNode] = make(map[int][]byte)
 		resp.Interface = defaultNetworkDevice
 		resp.Domain = defaultDomain
 		numaDeviceMarshResp, err := proto.Marshal(resp)
 		if err != nil {
 			return drpc.MarshalingFailure()
 		}
-		aic.numaDeviceMarshResp[aic.defaultNumaNode][0] = numaDeviceMarshResp
+		aic.addDevice(aic.defaultNumaNode, &deviceSelection{
+			iface: defaultNetworkDevice,
+			resp:  numaDeviceMarshResp,
+		})
 	}
 
 	// If caching is enabled, the cache is now 'initialized'
@@ -174,3 +321,17 @@ func (aic *attachInfoCache) initResponseCache(ctx context.Context, resp *mgmtpb.
 
 	return nil
 }
+
+// addDevice adds a cached response for the device to the responses available
+// to clients bound to the NUMA node. Must be called with aic.mutex held.
+func (aic *attachInfoCache) addDevice(numa int, dev *deviceSelection) {
+	if _, ok := aic.numaDeviceMarshResp[numa]; !ok {
+		aic.numaDeviceMarshResp[numa] = make(map[int][]byte)
+		aic.numaDeviceNames[numa] = make(map[int]string)
+	}
+	devIdx := len(aic.numaDeviceMarshResp[numa])
+	aic.numaDeviceMarshResp[numa][devIdx] = dev.resp
+	aic.numaDeviceNames[numa][devIdx] = dev.iface
+
+	aic.log.Debugf("Added device %s for NUMA %d, device number %d\n", dev.iface, numa, devIdx)
+}
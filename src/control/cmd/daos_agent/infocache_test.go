@@ -16,6 +16,8 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 
 	"github.com/mjmac/soad/src/control/common"
 	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
@@ -459,3 +461,242 @@ func TestInfoCacheConcurrentAccess(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestInfoCacheInvalidateIfStale(t *testing.T) {
+	for name, tc := range map[string]struct {
+		disabled      bool
+		uninitialized bool
+		mapVersion    uint32
+		expStale      bool
+	}{
+		"caching disabled": {
+			disabled:   true,
+			mapVersion: 3,
+		},
+		"not initialized": {
+			uninitialized: true,
+			mapVersion:    3,
+		},
+		"older version": {
+			mapVersion: 1,
+		},
+		"same version": {
+			mapVersion: 2,
+		},
+		"newer version": {
+			mapVersion: 3,
+			expStale:   true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			aiCache := attachInfoCache{log: log, enabled: atm.NewBool(!tc.disabled)}
+			if !tc.uninitialized {
+				netCtx, err := netdetect.Init(context.Background())
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer netdetect.CleanUp(netCtx)
+
+				resp := &mgmtpb.GetAttachInfoResp{MapVersion: 2}
+				if err := aiCache.initResponseCache(netCtx, resp, nil); err != nil {
+					t.Fatal(err)
+				}
+			}
+			wasCached := aiCache.isCached()
+
+			gotStale := aiCache.invalidateIfStale(tc.mapVersion)
+			common.AssertEqual(t, tc.expStale, gotStale, "unexpected result")
+			common.AssertEqual(t, wasCached && !tc.expStale, aiCache.isCached(), "unexpected cache state")
+		})
+	}
+}
+
+func TestInfoCacheDump(t *testing.T) {
+	scanResults := []*netdetect.FabricScan{
+		{Provider: "ofi+sockets", DeviceName: "eth0", NUMANode: 1, NetDevClass: netdetect.Ether},
+		{Provider: "ofi+sockets", DeviceName: "eth1", NUMANode: 0, NetDevClass: netdetect.Ether},
+		{Provider: "ofi+sockets", DeviceName: "eth2", NUMANode: 1, NetDevClass: netdetect.Ether},
+	}
+	msResp := &mgmtpb.GetAttachInfoResp{
+		Provider:    "ofi+sockets",
+		NetDevClass: netdetect.Ether,
+		MapVersion:  4,
+	}
+	expInfo := func(iface string) *mgmtpb.GetAttachInfoResp {
+		info := proto.Clone(msResp).(*mgmtpb.GetAttachInfoResp)
+		info.Interface = iface
+		info.Domain = iface
+		return info
+	}
+
+	for name, tc := range map[string]struct {
+		disabled      bool
+		uninitialized bool
+		expResp       *mgmtpb.AgentCacheDumpResp
+	}{
+		"caching disabled": {
+			disabled: true,
+			expResp:  &mgmtpb.AgentCacheDumpResp{},
+		},
+		"not initialized": {
+			uninitialized: true,
+			expResp:       &mgmtpb.AgentCacheDumpResp{Enabled: true},
+		},
+		"populated": {
+			expResp: &mgmtpb.AgentCacheDumpResp{
+				Enabled:         true,
+				Initialized:     true,
+				MapVersion:      4,
+				DefaultNumaNode: 1,
+				Entries: []*mgmtpb.AgentCacheDumpResp_Entry{
+					{NumaNode: 0, DeviceIndex: 0, Info: expInfo("eth1")},
+					{NumaNode: 1, DeviceIndex: 0, Info: expInfo("eth0")},
+					{NumaNode: 1, DeviceIndex: 1, Info: expInfo("eth2")},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			aiCache := attachInfoCache{log: log, enabled: atm.NewBool(!tc.disabled)}
+			if !tc.uninitialized {
+				netCtx, err := netdetect.Init(context.Background())
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer netdetect.CleanUp(netCtx)
+
+				resp := proto.Clone(msResp).(*mgmtpb.GetAttachInfoResp)
+				if err := aiCache.initResponseCache(netCtx, resp, scanResults); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotResp, err := aiCache.dump()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected dump (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestInfoCacheFabricPolicy(t *testing.T) {
+	scanResults := []*netdetect.FabricScan{
+		{Provider: "ofi+verbs", DeviceName: "ib0", NUMANode: 0},
+		{Provider: "ofi+verbs", DeviceName: "ib1", NUMANode: 0},
+		{Provider: "ofi+verbs", DeviceName: "ib2", NUMANode: 1},
+		{Provider: "ofi+verbs", DeviceName: "ib3", NUMANode: 1},
+	}
+
+	type request struct {
+		numaNode int
+		loads    map[string]int
+	}
+	for name, tc := range map[string]struct {
+		policy    FabricPolicy
+		requests  []request
+		expIfaces []string
+		expErr    error
+	}{
+		"round-robin": {
+			requests:  []request{{numaNode: 0}, {numaNode: 0}, {numaNode: 0}, {numaNode: 1}},
+			expIfaces: []string{"ib0", "ib1", "ib0", "ib2"},
+		},
+		"round-robin falls back to default NUMA node": {
+			requests:  []request{{numaNode: 2}, {numaNode: 2}},
+			expIfaces: []string{"ib0", "ib1"},
+		},
+		"numa-strict": {
+			policy:    FabricPolicy{Selection: policyNUMAStrict},
+			requests:  []request{{numaNode: 1}, {numaNode: 1}},
+			expIfaces: []string{"ib2", "ib3"},
+		},
+		"numa-strict without local device": {
+			policy:   FabricPolicy{Selection: policyNUMAStrict},
+			requests: []request{{numaNode: 2}},
+			expErr:   errors.New("No network devices bound to client NUMA node 2"),
+		},
+		"least-loaded": {
+			policy: FabricPolicy{Selection: policyLeastLoaded},
+			requests: []request{
+				{numaNode: 0, loads: map[string]int{"ib0": 3, "ib1": 1}},
+				{numaNode: 0, loads: map[string]int{"ib0": 3, "ib1": 2}},
+				{numaNode: 0, loads: map[string]int{"ib0": 3, "ib1": 3}},
+				{numaNode: 0, loads: map[string]int{"ib0": 4, "ib1": 3}},
+			},
+			expIfaces: []string{"ib1", "ib1", "ib0", "ib1"},
+		},
+		"least-loaded ties are round-robin": {
+			policy:    FabricPolicy{Selection: policyLeastLoaded},
+			requests:  []request{{numaNode: 1}, {numaNode: 1}, {numaNode: 1}},
+			expIfaces: []string{"ib2", "ib3", "ib2"},
+		},
+		"excluded interface": {
+			policy:    FabricPolicy{Exclude: []string{"ib0"}},
+			requests:  []request{{numaNode: 0}, {numaNode: 0}},
+			expIfaces: []string{"ib1", "ib1"},
+		},
+		"all local interfaces excluded": {
+			policy:    FabricPolicy{Exclude: []string{"ib0", "ib1"}},
+			requests:  []request{{numaNode: 0}, {numaNode: 0}},
+			expIfaces: []string{"ib2", "ib3"},
+		},
+		"pinned interfaces": {
+			policy: FabricPolicy{
+				NUMAInterfaces: map[int][]string{
+					0: {"ib1"},
+					2: {"ib3", "ib0"},
+				},
+			},
+			requests:  []request{{numaNode: 0}, {numaNode: 0}, {numaNode: 1}, {numaNode: 2}, {numaNode: 2}},
+			expIfaces: []string{"ib1", "ib1", "ib2", "ib3", "ib0"},
+		},
+		"unavailable pinned interface ignored": {
+			policy: FabricPolicy{
+				NUMAInterfaces: map[int][]string{
+					0: {"ib7"},
+				},
+			},
+			requests:  []request{{numaNode: 0}, {numaNode: 0}},
+			expIfaces: []string{"ib0", "ib1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			aiCache := attachInfoCache{log: log, enabled: atm.NewBool(true), policy: tc.policy}
+			netCtx, cleanupFn := initCache(t, scanResults, &aiCache)
+			defer cleanupFn(netCtx)
+
+			var gotIfaces []string
+			for _, req := range tc.requests {
+				sel, err := aiCache.selectDevice(req.numaNode, req.loads)
+				common.CmpErr(t, tc.expErr, err)
+				if tc.expErr != nil {
+					return
+				}
+
+				resp := new(mgmtpb.GetAttachInfoResp)
+				if err := proto.Unmarshal(sel.resp, resp); err != nil {
+					t.Fatal(err)
+				}
+				common.AssertEqual(t, sel.iface, resp.Interface, "selected interface does not match response")
+				gotIfaces = append(gotIfaces, sel.iface)
+			}
+
+			if diff := cmp.Diff(tc.expIfaces, gotIfaces); diff != "" {
+				t.Fatalf("unexpected interfaces (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
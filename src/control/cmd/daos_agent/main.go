@@ -36,6 +36,9 @@ type cliOptions struct {
 	Version    versionCmd        `command:"version" description:"Print daos_agent version"`
 	DumpInfo   dumpAttachInfoCmd `command:"dump-attachinfo" description:"Dump system attachinfo"`
 	NetScan    netScanCmd        `command:"net-scan" description:"Perform local network fabric scan"`
+	Cache      cacheCmd          `command:"cache" description:"Manage the attach info cache of the running daos_agent"`
+	Status     statusCmd         `command:"status" description:"Display the status of the running daos_agent"`
+	Handles    handlesCmd        `command:"handles" description:"List pool handles held by processes monitored by the running daos_agent"`
 }
 
 type (
@@ -52,6 +55,23 @@ func (cmd *ctlInvokerCmd) setInvoker(ctlInvoker control.Invoker) {
 	cmd.ctlInvoker = ctlInvoker
 }
 
+// newControlConfig generates a control config based on the loaded agent config.
+func newControlConfig(cfg *Config) *control.Config {
+	return newSystemControlConfig(cfg.Systems()[0])
+}
+
+// newSystemControlConfig generates a control config for communicating with
+// one of the systems served by the agent.
+func newSystemControlConfig(sys *SystemConfig) *control.Config {
+	ctlCfg := control.DefaultConfig()
+	ctlCfg.TransportConfig = sys.TransportConfig
+	ctlCfg.HostList = sys.AccessPoints
+	ctlCfg.SystemName = sys.Name
+	ctlCfg.ControlPort = sys.ControlPort
+
+	return ctlCfg
+}
+
 type (
 	configSetter interface {
 		setConfig(*Config)
@@ -90,6 +110,11 @@ type (
 	jsonOutputCmd struct {
 		shouldEmitJSON bool
 	}
+
+	// sysCmd selects the system targeted by an admin command.
+	sysCmd struct {
+		Sys string `long:"sys" description:"Name of the system to query (default: the agent's default system)"`
+	}
 )
 
 func (cmd *jsonOutputCmd) enableJsonOutput(emitJson bool) {
@@ -228,19 +253,31 @@ func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *lo
 			return errors.Wrap(err, "Failed to parse config access_points")
 		}
 
+		for _, sys := range cfg.ExtraSystems {
+			port := sys.ControlPort
+			if port == 0 {
+				port = cfg.ControlPort
+			}
+			if sys.AccessPoints, err = common.ParseHostList(sys.AccessPoints, port); err != nil {
+				return errors.Wrapf(err, "Failed to parse access_points for system %q", sys.Name)
+			}
+			if sys.TransportConfig == nil {
+				continue
+			}
+			if opts.Insecure {
+				sys.TransportConfig.AllowInsecure = true
+			}
+			if err := sys.TransportConfig.PreLoadCertData(); err != nil {
+				return errors.Wrapf(err, "Unable to load Certificate Data for system %q", sys.Name)
+			}
+		}
+
 		if cfgCmd, ok := cmd.(configSetter); ok {
 			cfgCmd.setConfig(cfg)
 		}
 
 		if ctlCmd, ok := cmd.(ctlInvoker); ok {
-			// Generate a control config based on the loaded agent config.
-			ctlCfg := control.DefaultConfig()
-			ctlCfg.TransportConfig = cfg.TransportConfig
-			ctlCfg.HostList = cfg.AccessPoints
-			ctlCfg.SystemName = cfg.SystemName
-			ctlCfg.ControlPort = cfg.ControlPort
-
-			invoker.SetConfig(ctlCfg)
+			invoker.SetConfig(newControlConfig(cfg))
 			ctlCmd.setInvoker(invoker)
 		}
 
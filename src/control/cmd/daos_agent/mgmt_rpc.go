@@ -9,6 +9,7 @@ package main
 import (
 	"net"
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
@@ -18,6 +19,7 @@ import (
 	"github.com/mjmac/soad/src/control/common/proto/convert"
 	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
 	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/lib/atm"
 	"github.com/mjmac/soad/src/control/lib/control"
 	"github.com/mjmac/soad/src/control/lib/netdetect"
 	"github.com/mjmac/soad/src/control/logging"
@@ -30,11 +32,18 @@ type mgmtModule struct {
 	log        logging.Logger
 	sys        string
 	ctlInvoker control.Invoker
+	ctlCfg     *control.Config
 	aiCache    *attachInfoCache
 	numaAware  bool
 	netCtx     context.Context
 	mutex      sync.Mutex
 	monitor    *procMon
+	// time of the last successful MS request, guarded by mutex
+	lastMSContact time.Time
+	// is the agent subscribed to system map updates?
+	mapWatchActive atm.Bool
+	// fabric interfaces assigned to client processes, guarded by mutex
+	clients map[int32]*clientIface
 }
 
 func (mod *mgmtModule) HandleCall(session *drpc.Session, method drpc.Method, req []byte) ([]byte, error) {
@@ -83,12 +92,12 @@ func (mod *mgmtModule) ID() drpc.ModuleID {
 // handleGetAttachInfo invokes the GetAttachInfo dRPC.  The agent determines the
 // NUMA node for the client process based on its PID.  Then based on the
 // server's provider, chooses a matching network interface and domain from the
-// client machine that has the same NUMA affinity.  It is considered an error if
-// the client application is bound to a NUMA node that does not have a network
-// device / provider combination with the same NUMA affinity.
+// client machine that has the same NUMA affinity, subject to the agent's
+// fabric policy (see fabric_policy.go).
 //
 // The agent caches the local device data and all possible responses the first
-// time this dRPC is invoked. Subsequent calls receive the cached data.
+// time this dRPC is invoked. Subsequent calls receive the cached data until
+// it is refreshed or invalidated (see cache_refresh.go).
 // The use of cached data may be disabled by exporting
 // "DAOS_AGENT_DISABLE_CACHE=true" in the environment running the daos_agent.
 func (mod *mgmtModule) handleGetAttachInfo(ctx context.Context, reqb []byte, pid int32) ([]byte, error) {
@@ -125,27 +134,52 @@ func (mod *mgmtModule) handleGetAttachInfo(ctx context.Context, reqb []byte, pid
 	mod.mutex.Lock()
 	defer mod.mutex.Unlock()
 
-	if mod.aiCache.isCached() {
-		if !mod.numaAware {
-			numaNode = mod.aiCache.defaultNumaNode
+	if !mod.aiCache.isCached() {
+		if _, err := mod.refreshAttachInfoCache(ctx); err != nil {
+			return nil, errors.Wrapf(err, "GetAttachInfo %+v", pbReq)
 		}
-		return mod.aiCache.getResponse(numaNode)
 	}
 
-	// Ask the MS for _all_ info, regardless of pbReq.AllRanks, so that the
-	// cache can serve future "pbReq.AllRanks == true" requests.
+	if !mod.numaAware {
+		numaNode = mod.aiCache.defaultNumaNode
+	}
+
+	cacheResp, err := mod.selectInterface(pid, numaNode)
+	if err != nil {
+		return nil, err
+	}
+
+	// If pbReq.AllRanks == false, we shouldn't return the rank URIs.
+	// Implementing that may require changing the cache to either hold
+	// unmarshalled responses (more computation work for daos_agent) or
+	// two variants of marshalled responses.
+
+	return cacheResp, err
+}
+
+// refreshAttachInfoCache asks the MS for the current attach info and scans
+// the local fabric in order to regenerate the cached GetAttachInfo responses.
+// The existing responses are retained if the MS cannot be reached. Must be
+// called with mod.mutex held.
+func (mod *mgmtModule) refreshAttachInfoCache(ctx context.Context) (*control.GetAttachInfoResp, error) {
+	// Ask the MS for _all_ info, regardless of the client request, so
+	// that the cache can serve future "AllRanks == true" requests.
 	req := new(control.GetAttachInfoReq)
-	req.SetSystem(pbReq.GetSys())
+	req.SetSystem(mod.sys)
 	req.AllRanks = true
 	resp, err := control.GetAttachInfo(ctx, mod.ctlInvoker, req)
 	if err != nil {
-		return nil, errors.Wrapf(err, "GetAttachInfo %+v", pbReq)
+		return nil, err
 	}
 
+	mod.lastMSContact = time.Now()
+
 	if resp.Provider == "" {
 		return nil, errors.New("GetAttachInfo response contained no provider")
 	}
 
+	mod.updateAccessPoints(resp.MSReplicas)
+
 	// Scan the local fabric to determine what devices are available that match our provider
 	scanResults, err := netdetect.ScanFabric(mod.netCtx, resp.Provider)
 	if err != nil {
@@ -159,26 +193,39 @@ func (mod *mgmtModule) handleGetAttachInfo(ctx context.Context, reqb []byte, pid
 		return nil, errors.Wrap(err, "Failed to convert GetAttachInfo response")
 	}
 
-	err = mod.aiCache.initResponseCache(mod.netCtx, pbResp, scanResults)
-	if err != nil {
+	if err := mod.aiCache.initResponseCache(mod.netCtx, pbResp, scanResults); err != nil {
 		return nil, err
 	}
 
-	if !mod.numaAware {
-		numaNode = mod.aiCache.defaultNumaNode
-	}
+	return resp, nil
+}
 
-	cacheResp, err := mod.aiCache.getResponse(numaNode)
-	if err != nil {
-		return nil, err
+// updateAccessPoints updates the set of access points used by the control
+// API client if the MS replica set has changed since the agent was started.
+func (mod *mgmtModule) updateAccessPoints(replicas []string) {
+	if mod.ctlCfg == nil || len(replicas) == 0 {
+		return
 	}
 
-	// If pbReq.AllRanks == false, we shouldn't return the rank URIs.
-	// Implementing that may require changing the cache to either hold
-	// unmarshalled responses (more computation work for daos_agent) or
-	// two variants of marshalled responses.
+	cur := make(map[string]struct{})
+	for _, ap := range mod.ctlCfg.HostList {
+		cur[ap] = struct{}{}
+	}
+	changed := len(cur) != len(replicas)
+	for _, replica := range replicas {
+		if _, found := cur[replica]; !found {
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
 
-	return cacheResp, err
+	mod.log.Infof("updating access points from %v to %v", mod.ctlCfg.HostList, replicas)
+	ctlCfg := *mod.ctlCfg
+	ctlCfg.HostList = append([]string{}, replicas...)
+	mod.ctlCfg = &ctlCfg
+	mod.ctlInvoker.SetConfig(mod.ctlCfg)
 }
 
 func (mod *mgmtModule) handleNotifyPoolConnect(ctx context.Context, reqb []byte, pid int32) error {
@@ -204,5 +251,6 @@ func (mod *mgmtModule) handleNotifyPoolDisconnect(ctx context.Context, reqb []by
 // cleanly disconnect will inform the control plane of any outstanding handles
 // that the process held open.
 func (mod *mgmtModule) handleNotifyExit(ctx context.Context, pid int32) {
+	mod.forgetClient(pid)
 	mod.monitor.NotifyExit(ctx, pid)
 }
@@ -0,0 +1,64 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func TestAgent_mgmtModule_updateAccessPoints(t *testing.T) {
+	for name, tc := range map[string]struct {
+		hostList []string
+		replicas []string
+		expHosts []string
+	}{
+		"no replicas reported": {
+			hostList: []string{"host1:10001"},
+			expHosts: []string{"host1:10001"},
+		},
+		"unchanged": {
+			hostList: []string{"host1:10001", "host2:10001"},
+			replicas: []string{"host2:10001", "host1:10001"},
+			expHosts: []string{"host1:10001", "host2:10001"},
+		},
+		"replica added": {
+			hostList: []string{"host1:10001"},
+			replicas: []string{"host1:10001", "host2:10001"},
+			expHosts: []string{"host1:10001", "host2:10001"},
+		},
+		"replica replaced": {
+			hostList: []string{"host1:10001", "host2:10001"},
+			replicas: []string{"host1:10001", "host3:10001"},
+			expHosts: []string{"host1:10001", "host3:10001"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			ctlCfg := control.DefaultConfig()
+			ctlCfg.HostList = tc.hostList
+			mod := &mgmtModule{
+				log:        log,
+				ctlInvoker: control.DefaultMockInvoker(log),
+				ctlCfg:     ctlCfg,
+			}
+
+			mod.updateAccessPoints(tc.replicas)
+
+			if diff := cmp.Diff(tc.expHosts, mod.ctlCfg.HostList); diff != "" {
+				t.Fatalf("unexpected host list (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
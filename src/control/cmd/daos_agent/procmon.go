@@ -10,6 +10,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
+	"sync"
 	"syscall"
 	"time"
 
@@ -38,8 +40,10 @@ type procMonResponse struct {
 }
 
 type procInfo struct {
-	log       logging.Logger
-	pid       int32
+	log logging.Logger
+	pid int32
+	// inode of /proc/<pid>, used to detect pid reuse
+	inode     uint64
 	cancelCtx func()
 	response  chan *procMonResponse
 	handles   map[string]map[string]struct{}
@@ -73,13 +77,20 @@ func (p *procInfo) sendResponse(ctx context.Context, pid int32, err error) {
 
 const MonWaitTime = 3 * time.Second
 
+// evictRetryInterval is how often the eviction of leaked pool handles that
+// couldn't be evicted earlier is retried.
+const evictRetryInterval = 30 * time.Second
+
 // monitorProcess is used by procMon to kick off monitoring individual processes
 // under their own child context to allow for terminating individual monitoring routines.
 func (p *procInfo) monitorProcess(ctx context.Context) {
-	Ino, err := getProcPidInode(p.pid)
-	if err != nil {
-		p.sendResponse(ctx, p.pid, err)
-		return
+	Ino := p.inode
+	if Ino == 0 {
+		var err error
+		if Ino, err = getProcPidInode(p.pid); err != nil {
+			p.sendResponse(ctx, p.pid, err)
+			return
+		}
 	}
 
 	p.log.Debugf("Monitoring pid:%d\n", p.pid)
@@ -113,20 +124,40 @@ type procMon struct {
 	procs      map[int32]*procInfo
 	request    chan *procMonRequest
 	response   chan *procMonResponse
+	query      chan chan []*mgmtpb.AgentHandlesResp_Process
 	ctlInvoker control.Invoker
 	systemName string
+	// file in which the handle ownership is persisted, if set
+	stateFile string
+	// leaked pool handles that are still to be evicted, by pool UUID
+	evictions     map[string]map[string]struct{}
+	evicting      bool
+	evictDone     chan map[string][]string
+	evictInterval time.Duration
+	// number of pool handles held by each process, by pid, which can be
+	// read without waiting for the request handling goroutine
+	countsLock   sync.RWMutex
+	handleCounts map[int32]int
 }
 
 // NewProcMon creates a new process monitor struct setting initializing the
-// internal process map and the request channel.
-func NewProcMon(logger logging.Logger, ctlInvoker control.Invoker, systemName string) *procMon {
+// internal process map and the request channel. If stateFile is set, the pool
+// handle ownership is persisted to it so that handles leaked by processes that
+// die while the agent is not running can be cleaned up once it restarts.
+func NewProcMon(logger logging.Logger, ctlInvoker control.Invoker, systemName, stateFile string) *procMon {
 	return &procMon{
 		log:        logger,
 		procs:      make(map[int32]*procInfo),
 		request:    make(chan *procMonRequest),
 		response:   make(chan *procMonResponse),
+		query:      make(chan chan []*mgmtpb.AgentHandlesResp_Process),
 		ctlInvoker: ctlInvoker,
 		systemName: systemName,
+		stateFile:  stateFile,
+		evictions:  make(map[string]map[string]struct{}),
+		evictDone:  make(chan map[string][]string),
+
+		evictInterval: evictRetryInterval,
 	}
 }
 
@@ -158,6 +189,54 @@ func (p *procMon) NotifyExit(ctx context.Context, Pid int32) {
 	p.submitRequest(ctx, req)
 }
 
+// GetHandles returns a snapshot of the pool handles held by each of the
+// monitored processes, ordered by pid.
+func (p *procMon) GetHandles(ctx context.Context) ([]*mgmtpb.AgentHandlesResp_Process, error) {
+	result := make(chan []*mgmtpb.AgentHandlesResp_Process, 1)
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case p.query <- result:
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case procs := <-result:
+		return procs, nil
+	}
+}
+
+// HandleCounts returns the number of pool handles held by each of the
+// monitored processes, by pid. Unlike GetHandles, it doesn't wait for the
+// requests being handled, which may be held up while the MS is unreachable.
+func (p *procMon) HandleCounts() map[int32]int {
+	p.countsLock.RLock()
+	defer p.countsLock.RUnlock()
+
+	counts := make(map[int32]int, len(p.handleCounts))
+	for pid, count := range p.handleCounts {
+		counts[pid] = count
+	}
+	return counts
+}
+
+// updateHandleCounts refreshes the handle counts returned by HandleCounts.
+// Must only be called from the request handling goroutine.
+func (p *procMon) updateHandleCounts() {
+	counts := make(map[int32]int, len(p.procs))
+	for pid, info := range p.procs {
+		for _, handles := range info.handles {
+			counts[pid] += len(handles)
+		}
+	}
+
+	p.countsLock.Lock()
+	defer p.countsLock.Unlock()
+
+	p.handleCounts = counts
+}
+
 func (p *procMon) submitRequest(ctx context.Context, request *procMonRequest) {
 	select {
 	case <-ctx.Done():
@@ -171,17 +250,9 @@ func (p *procMon) handleNotifyPoolConnect(ctx context.Context, request *procMonR
 	info, found := p.procs[request.pid]
 
 	if !found {
-		child, cancel := context.WithCancel(ctx)
-		info = &procInfo{
-			log:       p.log,
-			pid:       request.pid,
-			cancelCtx: cancel,
-			response:  p.response,
-			handles:   make(map[string]map[string]struct{}),
-		}
-
-		p.procs[request.pid] = info
-		go info.monitorProcess(child)
+		// If the inode can't be read, the monitor will report why.
+		inode, _ := getProcPidInode(request.pid)
+		info = p.addProcess(ctx, request.pid, inode)
 	}
 
 	_, found = info.handles[request.poolUUID]
@@ -192,6 +263,25 @@ func (p *procMon) handleNotifyPoolConnect(ctx context.Context, request *procMonR
 
 }
 
+// addProcess starts monitoring the process with the supplied pid and
+// /proc/<pid> inode.
+func (p *procMon) addProcess(ctx context.Context, pid int32, inode uint64) *procInfo {
+	child, cancel := context.WithCancel(ctx)
+	info := &procInfo{
+		log:       p.log,
+		pid:       pid,
+		inode:     inode,
+		cancelCtx: cancel,
+		response:  p.response,
+		handles:   make(map[string]map[string]struct{}),
+	}
+
+	p.procs[pid] = info
+	go info.monitorProcess(child)
+
+	return info
+}
+
 func (p *procMon) handleNotifyPoolDisconnect(request *procMonRequest) {
 	p.log.Debugf("Received request to disconnect pool:%s with handle %s, for pid:%d", request.poolUUID, request.poolHandleUUID, request.pid)
 
@@ -235,20 +325,82 @@ func (p *procMon) cleanupLeakedHandles(ctx context.Context, info *procInfo) {
 	}
 
 	for poolUUID, element := range info.handles {
-		p.log.Debugf("Cleaning up %d leaked handles from Pool UUID: %s\n", len(element), poolUUID)
-
-		handles := handleMapToList(info.handles[poolUUID])
-		req := &control.PoolEvictReq{UUID: poolUUID, Sys: p.systemName, Handles: handles}
-
-		err := control.PoolEvict(ctx, p.ctlInvoker, req)
-		if err != nil {
-			p.log.Debugf("Cleaning Pool %s failed:%s", poolUUID, err)
+		handles := handleMapToList(element)
+		if err := p.evictHandles(ctx, poolUUID, handles); err != nil {
+			p.addEvictions(poolUUID, handles)
 		}
 	}
 
 	delete(p.procs, info.pid)
 }
 
+// evictHandles asks the MS to evict the supplied handles from the pool.
+func (p *procMon) evictHandles(ctx context.Context, poolUUID string, handles []string) error {
+	p.log.Debugf("Cleaning up %d leaked handles from Pool UUID: %s\n", len(handles), poolUUID)
+
+	req := &control.PoolEvictReq{ID: poolUUID, Sys: p.systemName, Handles: handles}
+
+	err := control.PoolEvict(ctx, p.ctlInvoker, req)
+	if err != nil {
+		p.log.Errorf("Cleaning Pool %s failed, will retry: %s", poolUUID, err)
+	}
+	return err
+}
+
+// addEvictions queues leaked pool handles whose eviction is still to be done.
+func (p *procMon) addEvictions(poolUUID string, handles []string) {
+	if _, found := p.evictions[poolUUID]; !found {
+		p.evictions[poolUUID] = make(map[string]struct{})
+	}
+	for _, handle := range handles {
+		p.evictions[poolUUID][handle] = struct{}{}
+	}
+}
+
+// startEvictions evicts the queued pool handles in the background, so that
+// requests aren't held up while the MS is unreachable. The handles that were
+// evicted are reported back on the evictDone channel.
+func (p *procMon) startEvictions(ctx context.Context) {
+	if p.evicting || len(p.evictions) == 0 {
+		return
+	}
+	p.evicting = true
+
+	pending := make(map[string][]string)
+	for poolUUID, handles := range p.evictions {
+		pending[poolUUID] = handleMapToList(handles)
+	}
+
+	go func() {
+		evicted := make(map[string][]string)
+		for poolUUID, handles := range pending {
+			if err := p.evictHandles(ctx, poolUUID, handles); err == nil {
+				evicted[poolUUID] = handles
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+		case p.evictDone <- evicted:
+		}
+	}()
+}
+
+// finishEvictions removes the evicted pool handles from the queue. Those
+// left are retried at the next interval.
+func (p *procMon) finishEvictions(evicted map[string][]string) {
+	p.evicting = false
+
+	for poolUUID, handles := range evicted {
+		for _, handle := range handles {
+			delete(p.evictions[poolUUID], handle)
+		}
+		if len(p.evictions[poolUUID]) == 0 {
+			delete(p.evictions, poolUUID)
+		}
+	}
+}
+
 func (p *procMon) handleNotifyExit(ctx context.Context, request *procMonRequest) {
 	info, found := p.procs[request.pid]
 
@@ -259,7 +411,34 @@ func (p *procMon) handleNotifyExit(ctx context.Context, request *procMonRequest)
 	}
 }
 
+func (p *procMon) handleGetHandles() []*mgmtpb.AgentHandlesResp_Process {
+	procs := make([]*mgmtpb.AgentHandlesResp_Process, 0, len(p.procs))
+	for pid, info := range p.procs {
+		proc := &mgmtpb.AgentHandlesResp_Process{Pid: pid}
+		for poolUUID, handles := range info.handles {
+			list := handleMapToList(handles)
+			sort.Strings(list)
+			proc.Pools = append(proc.Pools, &mgmtpb.AgentHandlesResp_Pool{
+				Uuid:    poolUUID,
+				Handles: list,
+			})
+		}
+		sort.Slice(proc.Pools, func(i, j int) bool {
+			return proc.Pools[i].Uuid < proc.Pools[j].Uuid
+		})
+		procs = append(procs, proc)
+	}
+	sort.Slice(procs, func(i, j int) bool {
+		return procs[i].Pid < procs[j].Pid
+	})
+
+	return procs
+}
+
 func (p *procMon) handleRequests(ctx context.Context) {
+	retry := time.NewTicker(p.evictInterval)
+	defer retry.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -275,19 +454,36 @@ func (p *procMon) handleRequests(ctx context.Context) {
 			default:
 				p.log.Debugf("Received request with invalid action type %s", request.action)
 			}
+			p.updateHandleCounts()
+			p.saveState()
+		case result := <-p.query:
+			result <- p.handleGetHandles()
 		case resp := <-p.response:
 			p.log.Debugf("Received response from Process %d, terminated with %s", resp.pid, resp.err)
 			info, found := p.procs[resp.pid]
 
 			if found {
 				p.cleanupLeakedHandles(ctx, info)
+				p.updateHandleCounts()
+				p.saveState()
 			}
+		case evicted := <-p.evictDone:
+			p.finishEvictions(evicted)
+			p.saveState()
+		case <-retry.C:
+			p.startEvictions(ctx)
 		}
 	}
 }
 
 // startMonitoring is the main driver which starts the process monitor. The
 // passed in context is used to terminate all monitoring in the event of shutdown.
+// Any handle ownership persisted by a previous instance of the agent is
+// reconciled before new requests are handled, but the handles leaked by
+// processes that exited in the meantime are evicted in the background.
 func (p *procMon) startMonitoring(ctx context.Context) {
-	go p.handleRequests(ctx)
+	go func() {
+		p.reconcileState(ctx)
+		p.handleRequests(ctx)
+	}()
 }
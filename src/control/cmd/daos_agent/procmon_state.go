@@ -0,0 +1,165 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+)
+
+const procMonStateFile = "daos_agent_procmon.json"
+
+// procMonStatePath returns the path of the file in which the process monitor
+// for the named system persists pool handle ownership.
+func procMonStatePath(cfg *Config, sys string) string {
+	if sys == cfg.SystemName {
+		return filepath.Join(cfg.RuntimeDir, procMonStateFile)
+	}
+	return filepath.Join(cfg.RuntimeDir, fmt.Sprintf("daos_agent_procmon_%s.json", sys))
+}
+
+type (
+	// procState is the persisted form of a monitored process.
+	procState struct {
+		Pid   int32  `json:"pid"`
+		Inode uint64 `json:"inode"`
+		// Handles maps pool UUIDs to the handle UUIDs held by the process.
+		Handles map[string][]string `json:"handles"`
+	}
+
+	// procMonState is the persisted form of the process monitor.
+	procMonState struct {
+		System    string       `json:"system"`
+		Processes []*procState `json:"processes"`
+		// Evictions maps pool UUIDs to the leaked handles that are still
+		// to be evicted.
+		Evictions map[string][]string `json:"evictions,omitempty"`
+	}
+)
+
+// saveState persists the handle ownership of the monitored processes. Must
+// only be called from the request handling goroutine.
+func (p *procMon) saveState() {
+	if p.stateFile == "" {
+		return
+	}
+
+	state := &procMonState{
+		System:    p.systemName,
+		Processes: make([]*procState, 0, len(p.procs)),
+	}
+	for pid, info := range p.procs {
+		ps := &procState{
+			Pid:     pid,
+			Inode:   info.inode,
+			Handles: make(map[string][]string),
+		}
+		for poolUUID, handles := range info.handles {
+			list := handleMapToList(handles)
+			sort.Strings(list)
+			ps.Handles[poolUUID] = list
+		}
+		state.Processes = append(state.Processes, ps)
+	}
+	sort.Slice(state.Processes, func(i, j int) bool {
+		return state.Processes[i].Pid < state.Processes[j].Pid
+	})
+	if len(p.evictions) > 0 {
+		state.Evictions = make(map[string][]string)
+		for poolUUID, handles := range p.evictions {
+			list := handleMapToList(handles)
+			sort.Strings(list)
+			state.Evictions[poolUUID] = list
+		}
+	}
+
+	data, err := json.Marshal(state)
+	if err == nil {
+		err = common.WriteFileAtomic(p.stateFile, data, 0600)
+	}
+	if err != nil {
+		p.log.Errorf("unable to save process monitor state to %s: %s", p.stateFile, err)
+	}
+}
+
+// loadState reads the handle ownership persisted by a previous instance of
+// the agent. A missing state file is not an error.
+func (p *procMon) loadState() (*procMonState, error) {
+	data, err := ioutil.ReadFile(p.stateFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &procMonState{}, nil
+		}
+		return nil, err
+	}
+
+	state := new(procMonState)
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", p.stateFile)
+	}
+	return state, nil
+}
+
+// reconcileState restores the persisted handle ownership. Processes that are
+// still alive are monitored again, and the handles of those that died (or
+// whose pid has been reused) while the agent was not running are queued for
+// eviction, along with those whose eviction was still pending. The evictions
+// run in the background and are retried until they succeed. Must only be
+// called from the request handling goroutine.
+func (p *procMon) reconcileState(ctx context.Context) {
+	if p.stateFile == "" {
+		return
+	}
+
+	state, err := p.loadState()
+	if err != nil {
+		p.log.Errorf("unable to load process monitor state: %s", err)
+		return
+	}
+	if state.System != "" && state.System != p.systemName {
+		p.log.Infof("ignoring process monitor state for system %q", state.System)
+		state.Processes = nil
+		state.Evictions = nil
+	}
+
+	for poolUUID, handles := range state.Evictions {
+		p.addEvictions(poolUUID, handles)
+	}
+
+	for _, ps := range state.Processes {
+		inode, err := getProcPidInode(ps.Pid)
+		if err == nil && inode == ps.Inode {
+			p.log.Debugf("resuming monitoring of pid:%d", ps.Pid)
+			info := p.addProcess(ctx, ps.Pid, ps.Inode)
+			for poolUUID, handles := range ps.Handles {
+				info.handles[poolUUID] = make(map[string]struct{})
+				for _, handle := range handles {
+					info.handles[poolUUID][handle] = struct{}{}
+				}
+			}
+			continue
+		}
+
+		p.log.Infof("pid:%d exited while the agent was not running; evicting its pool handles", ps.Pid)
+		for poolUUID, handles := range ps.Handles {
+			p.addEvictions(poolUUID, handles)
+		}
+	}
+
+	p.updateHandleCounts()
+	p.saveState()
+	p.startEvictions(ctx)
+}
@@ -0,0 +1,360 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+// evictRecorder records the pool evictions requested via the invoker. The
+// first failures evictions fail, and evictions wait for block to be closed
+// if it is set.
+type evictRecorder struct {
+	*control.MockInvoker
+	sync.Mutex
+	evicted  map[string][]string
+	failures int
+	block    chan struct{}
+}
+
+func (er *evictRecorder) InvokeUnaryRPC(ctx context.Context, req control.UnaryRequest) (*control.UnaryResponse, error) {
+	if evictReq, ok := req.(*control.PoolEvictReq); ok {
+		if er.block != nil {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-er.block:
+			}
+		}
+
+		er.Lock()
+		defer er.Unlock()
+		if er.failures > 0 {
+			er.failures--
+			return nil, errors.New("evict failed")
+		}
+		er.evicted[evictReq.ID] = append(er.evicted[evictReq.ID], evictReq.Handles...)
+		sort.Strings(er.evicted[evictReq.ID])
+	}
+	return er.MockInvoker.InvokeUnaryRPC(ctx, req)
+}
+
+// waitForMatch retries the comparison until it reports no difference, as
+// evictions are done in the background.
+func waitForMatch(t *testing.T, msg string, diffFn func() string) {
+	t.Helper()
+
+	var diff string
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); {
+		if diff = diffFn(); diff == "" {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("%s (-want, +got):\n%s\n", msg, diff)
+}
+
+func TestAgent_procMon_reconcileState(t *testing.T) {
+	pid := int32(os.Getpid())
+	inode, err := getProcPidInode(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handles := map[string][]string{
+		"pool1": {"hdl1", "hdl2"},
+		"pool2": {"hdl3"},
+	}
+
+	for name, tc := range map[string]struct {
+		state      *procMonState
+		stateData  []byte
+		failures   int
+		blockEvict bool
+		expProcs   []*mgmtpb.AgentHandlesResp_Process
+		expEvicted map[string][]string
+		expSaved   *procMonState
+	}{
+		"no state file": {
+			expProcs:   []*mgmtpb.AgentHandlesResp_Process{},
+			expEvicted: map[string][]string{},
+			expSaved: &procMonState{
+				System:    "daos_server",
+				Processes: []*procState{},
+			},
+		},
+		"unparseable state file": {
+			stateData:  []byte("garbage"),
+			expProcs:   []*mgmtpb.AgentHandlesResp_Process{},
+			expEvicted: map[string][]string{},
+		},
+		"live process resumed": {
+			state: &procMonState{
+				System: "daos_server",
+				Processes: []*procState{
+					{Pid: pid, Inode: inode, Handles: handles},
+				},
+			},
+			expProcs: []*mgmtpb.AgentHandlesResp_Process{
+				{
+					Pid: pid,
+					Pools: []*mgmtpb.AgentHandlesResp_Pool{
+						{Uuid: "pool1", Handles: []string{"hdl1", "hdl2"}},
+						{Uuid: "pool2", Handles: []string{"hdl3"}},
+					},
+				},
+			},
+			expEvicted: map[string][]string{},
+			expSaved: &procMonState{
+				System: "daos_server",
+				Processes: []*procState{
+					{Pid: pid, Inode: inode, Handles: handles},
+				},
+			},
+		},
+		"dead process evicted": {
+			state: &procMonState{
+				System: "daos_server",
+				Processes: []*procState{
+					{Pid: math.MaxInt32, Inode: 1, Handles: handles},
+				},
+			},
+			expProcs:   []*mgmtpb.AgentHandlesResp_Process{},
+			expEvicted: handles,
+			expSaved: &procMonState{
+				System:    "daos_server",
+				Processes: []*procState{},
+			},
+		},
+		"failed evictions retried": {
+			state: &procMonState{
+				System: "daos_server",
+				Processes: []*procState{
+					{Pid: math.MaxInt32, Inode: 1, Handles: handles},
+				},
+			},
+			failures:   2,
+			expProcs:   []*mgmtpb.AgentHandlesResp_Process{},
+			expEvicted: handles,
+			expSaved: &procMonState{
+				System:    "daos_server",
+				Processes: []*procState{},
+			},
+		},
+		"failed evictions kept": {
+			state: &procMonState{
+				System: "daos_server",
+				Processes: []*procState{
+					{Pid: math.MaxInt32, Inode: 1, Handles: handles},
+				},
+			},
+			failures:   math.MaxInt32,
+			expProcs:   []*mgmtpb.AgentHandlesResp_Process{},
+			expEvicted: map[string][]string{},
+			expSaved: &procMonState{
+				System:    "daos_server",
+				Processes: []*procState{},
+				Evictions: handles,
+			},
+		},
+		"pending evictions resumed": {
+			state: &procMonState{
+				System:    "daos_server",
+				Evictions: handles,
+			},
+			expProcs:   []*mgmtpb.AgentHandlesResp_Process{},
+			expEvicted: handles,
+			expSaved: &procMonState{
+				System:    "daos_server",
+				Processes: []*procState{},
+			},
+		},
+		"requests handled during evictions": {
+			state: &procMonState{
+				System: "daos_server",
+				Processes: []*procState{
+					{Pid: math.MaxInt32, Inode: 1, Handles: handles},
+				},
+			},
+			blockEvict: true,
+			expProcs:   []*mgmtpb.AgentHandlesResp_Process{},
+			expEvicted: handles,
+			expSaved: &procMonState{
+				System:    "daos_server",
+				Processes: []*procState{},
+			},
+		},
+		"reused pid evicted": {
+			state: &procMonState{
+				System: "daos_server",
+				Processes: []*procState{
+					{Pid: pid, Inode: inode + 1, Handles: handles},
+				},
+			},
+			expProcs:   []*mgmtpb.AgentHandlesResp_Process{},
+			expEvicted: handles,
+			expSaved: &procMonState{
+				System:    "daos_server",
+				Processes: []*procState{},
+			},
+		},
+		"state for other system ignored": {
+			state: &procMonState{
+				System: "other",
+				Processes: []*procState{
+					{Pid: math.MaxInt32, Inode: 1, Handles: handles},
+				},
+			},
+			expProcs:   []*mgmtpb.AgentHandlesResp_Process{},
+			expEvicted: map[string][]string{},
+			expSaved: &procMonState{
+				System:    "daos_server",
+				Processes: []*procState{},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			tmpDir, cleanup := common.CreateTestDir(t)
+			defer cleanup()
+			stateFile := filepath.Join(tmpDir, procMonStateFile)
+
+			stateData := tc.stateData
+			if tc.state != nil {
+				var err error
+				if stateData, err = json.Marshal(tc.state); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if stateData != nil {
+				if err := ioutil.WriteFile(stateFile, stateData, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			invoker := &evictRecorder{
+				MockInvoker: control.NewMockInvoker(log, &control.MockInvokerConfig{
+					UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.PoolEvictResp{}),
+				}),
+				evicted:  make(map[string][]string),
+				failures: tc.failures,
+			}
+			if tc.blockEvict {
+				invoker.block = make(chan struct{})
+			}
+			pm := NewProcMon(log, invoker, "daos_server", stateFile)
+			pm.evictInterval = 10 * time.Millisecond
+			pm.startMonitoring(ctx)
+
+			// Requests are only handled once the processes are restored,
+			// but evictions don't hold them up.
+			gotProcs, err := pm.GetHandles(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if invoker.block != nil {
+				close(invoker.block)
+			}
+
+			if diff := cmp.Diff(tc.expProcs, gotProcs, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected handles (-want, +got):\n%s\n", diff)
+			}
+			waitForMatch(t, "unexpected evictions", func() string {
+				invoker.Lock()
+				defer invoker.Unlock()
+				return cmp.Diff(tc.expEvicted, invoker.evicted)
+			})
+
+			if tc.expSaved == nil {
+				return
+			}
+			waitForMatch(t, "unexpected saved state", func() string {
+				gotSaved, err := pm.loadState()
+				if err != nil {
+					return err.Error()
+				}
+				return cmp.Diff(tc.expSaved, gotSaved)
+			})
+		})
+	}
+}
+
+func TestAgent_procMon_saveState(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	tmpDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+	stateFile := filepath.Join(tmpDir, procMonStateFile)
+
+	pid := int32(os.Getpid())
+	inode, err := getProcPidInode(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mi := control.NewMockInvoker(log, &control.MockInvokerConfig{})
+	pm := NewProcMon(log, mi, "daos_server", stateFile)
+	pm.startMonitoring(ctx)
+
+	pm.AddPoolHandle(ctx, pid, &mgmtpb.PoolMonitorReq{PoolUUID: "pool1", PoolHandleUUID: "hdl1"})
+	pm.AddPoolHandle(ctx, pid, &mgmtpb.PoolMonitorReq{PoolUUID: "pool1", PoolHandleUUID: "hdl2"})
+	pm.RemovePoolHandle(ctx, pid, &mgmtpb.PoolMonitorReq{PoolUUID: "pool1", PoolHandleUUID: "hdl1"})
+
+	// Wait for the requests to be handled.
+	if _, err := pm.GetHandles(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	gotState, err := pm.loadState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expState := &procMonState{
+		System: "daos_server",
+		Processes: []*procState{
+			{
+				Pid:     pid,
+				Inode:   inode,
+				Handles: map[string][]string{"pool1": {"hdl2"}},
+			},
+		},
+	}
+	if diff := cmp.Diff(expState, gotState); diff != "" {
+		t.Fatalf("unexpected saved state (-want, +got):\n%s\n", diff)
+	}
+
+	fi, err := os.Stat(stateFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, os.FileMode(0600), fi.Mode().Perm(), "unexpected state file permissions")
+}
@@ -0,0 +1,141 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func TestAgent_procMon_GetHandles(t *testing.T) {
+	pid := int32(os.Getpid())
+
+	for name, tc := range map[string]struct {
+		connect    []*mgmtpb.PoolMonitorReq
+		disconnect []*mgmtpb.PoolMonitorReq
+		expProcs   []*mgmtpb.AgentHandlesResp_Process
+	}{
+		"no handles": {
+			expProcs: []*mgmtpb.AgentHandlesResp_Process{},
+		},
+		"multiple pools and handles": {
+			connect: []*mgmtpb.PoolMonitorReq{
+				{PoolUUID: "pool2", PoolHandleUUID: "hdl3"},
+				{PoolUUID: "pool1", PoolHandleUUID: "hdl2"},
+				{PoolUUID: "pool1", PoolHandleUUID: "hdl1"},
+			},
+			expProcs: []*mgmtpb.AgentHandlesResp_Process{
+				{
+					Pid: pid,
+					Pools: []*mgmtpb.AgentHandlesResp_Pool{
+						{Uuid: "pool1", Handles: []string{"hdl1", "hdl2"}},
+						{Uuid: "pool2", Handles: []string{"hdl3"}},
+					},
+				},
+			},
+		},
+		"disconnected handle removed": {
+			connect: []*mgmtpb.PoolMonitorReq{
+				{PoolUUID: "pool1", PoolHandleUUID: "hdl1"},
+				{PoolUUID: "pool1", PoolHandleUUID: "hdl2"},
+			},
+			disconnect: []*mgmtpb.PoolMonitorReq{
+				{PoolUUID: "pool1", PoolHandleUUID: "hdl1"},
+			},
+			expProcs: []*mgmtpb.AgentHandlesResp_Process{
+				{
+					Pid: pid,
+					Pools: []*mgmtpb.AgentHandlesResp_Pool{
+						{Uuid: "pool1", Handles: []string{"hdl2"}},
+					},
+				},
+			},
+		},
+		"all handles disconnected": {
+			connect: []*mgmtpb.PoolMonitorReq{
+				{PoolUUID: "pool1", PoolHandleUUID: "hdl1"},
+			},
+			disconnect: []*mgmtpb.PoolMonitorReq{
+				{PoolUUID: "pool1", PoolHandleUUID: "hdl1"},
+			},
+			expProcs: []*mgmtpb.AgentHandlesResp_Process{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{})
+			pm := NewProcMon(log, mi, "daos_server", "")
+			pm.startMonitoring(ctx)
+
+			for _, req := range tc.connect {
+				pm.AddPoolHandle(ctx, pid, req)
+			}
+			for _, req := range tc.disconnect {
+				pm.RemovePoolHandle(ctx, pid, req)
+			}
+
+			gotProcs, err := pm.GetHandles(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expProcs, gotProcs, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected handles (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_procMon_HandleCounts(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pid := int32(os.Getpid())
+	invoker := &evictRecorder{
+		MockInvoker: control.NewMockInvoker(log, &control.MockInvokerConfig{
+			UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.PoolEvictResp{}),
+		}),
+		evicted: make(map[string][]string),
+		block:   make(chan struct{}),
+	}
+	pm := NewProcMon(log, invoker, "daos_server", "")
+	pm.startMonitoring(ctx)
+
+	pm.AddPoolHandle(ctx, pid, &mgmtpb.PoolMonitorReq{PoolUUID: "pool1", PoolHandleUUID: "hdl1"})
+	pm.AddPoolHandle(ctx, pid, &mgmtpb.PoolMonitorReq{PoolUUID: "pool2", PoolHandleUUID: "hdl2"})
+	if _, err := pm.GetHandles(ctx); err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, map[int32]int{pid: 2}, pm.HandleCounts(), "unexpected handle counts")
+
+	// The counts remain available while the request handling goroutine
+	// is waiting for the leaked handles to be evicted.
+	pm.NotifyExit(ctx, pid)
+	common.AssertEqual(t, map[int32]int{pid: 2}, pm.HandleCounts(), "unexpected handle counts")
+
+	close(invoker.block)
+	if _, err := pm.GetHandles(ctx); err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, map[int32]int{}, pm.HandleCounts(), "unexpected handle counts")
+}
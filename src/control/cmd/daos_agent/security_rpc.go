@@ -9,6 +9,9 @@ package main
 import (
 	"net"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
 	"github.com/mjmac/soad/src/control/drpc"
 	"github.com/mjmac/soad/src/control/logging"
 	"github.com/mjmac/soad/src/control/security"
@@ -20,42 +23,75 @@ type SecurityModule struct {
 	log    logging.Logger
 	ext    auth.UserExt
 	config *security.TransportConfig
+	// transport configs of the named systems served by the agent
+	sysConfigs map[string]*security.TransportConfig
 }
 
 //NewSecurityModule creates a new module with the given initialized TransportConfig
 func NewSecurityModule(log logging.Logger, tc *security.TransportConfig) *SecurityModule {
 	mod := SecurityModule{
-		log:    log,
-		config: tc,
+		log:        log,
+		config:     tc,
+		sysConfigs: make(map[string]*security.TransportConfig),
 	}
 	mod.ext = &auth.External{}
 	return &mod
 }
 
+// addSystem registers the TransportConfig used to sign credentials requested
+// for the named system.
+func (m *SecurityModule) addSystem(sys string, tc *security.TransportConfig) {
+	m.sysConfigs[sys] = tc
+}
+
+// transportConfig returns the TransportConfig for the named system. An empty
+// name selects the default system.
+func (m *SecurityModule) transportConfig(sys string) (*security.TransportConfig, error) {
+	if sys == "" {
+		return m.config, nil
+	}
+	if tc, found := m.sysConfigs[sys]; found {
+		return tc, nil
+	}
+	return nil, errors.Errorf("%s: unknown system name", sys)
+}
+
 // HandleCall is the handler for calls to the SecurityModule
 func (m *SecurityModule) HandleCall(session *drpc.Session, method drpc.Method, body []byte) ([]byte, error) {
 	if method != drpc.MethodRequestCredentials {
 		return nil, drpc.UnknownMethodFailure()
 	}
 
-	return m.getCredential(session)
+	// Older clients send an empty request.
+	req := new(auth.GetCredReq)
+	if err := proto.Unmarshal(body, req); err != nil {
+		return nil, drpc.UnmarshalingPayloadFailure()
+	}
+
+	return m.getCredential(session, req.Sys)
 }
 
 // getCredentials generates a signed user credential based on the data attached to
 // the Unix Domain Socket.
-func (m *SecurityModule) getCredential(session *drpc.Session) ([]byte, error) {
+func (m *SecurityModule) getCredential(session *drpc.Session, sys string) ([]byte, error) {
 	uConn, ok := session.Conn.(*net.UnixConn)
 	if !ok {
 		return nil, drpc.NewFailureWithMessage("connection is not a unix socket")
 	}
 
+	tc, err := m.transportConfig(sys)
+	if err != nil {
+		m.log.Errorf("Unable to get credentials: %s", err)
+		return m.credRespWithStatus(drpc.DaosInvalidInput)
+	}
+
 	info, err := security.DomainInfoFromUnixConn(m.log, uConn)
 	if err != nil {
 		m.log.Errorf("Unable to get credentials for client socket: %s", err)
 		return m.credRespWithStatus(drpc.DaosMiscError)
 	}
 
-	signingKey, err := m.config.PrivateKey()
+	signingKey, err := tc.PrivateKey()
 	if err != nil {
 		m.log.Error(err.Error())
 		// something is wrong with the cert config
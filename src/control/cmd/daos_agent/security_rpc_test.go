@@ -186,3 +186,63 @@ func TestAgentSecurityModule_RequestCreds_BadUid(t *testing.T) {
 
 	expectCredResp(t, respBytes, int32(drpc.DaosMiscError), false)
 }
+
+func TestAgentSecurityModule_RequestCreds_BadPayload(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	conn, cleanup := setupTestUnixConn(t)
+	defer cleanup()
+
+	mod := NewSecurityModule(log, defaultTestTransportConfig())
+	_, err := mod.HandleCall(newTestSession(t, log, conn), drpc.MethodRequestCredentials, []byte("garbage"))
+
+	common.CmpErr(t, drpc.UnmarshalingPayloadFailure(), err)
+}
+
+func TestAgentSecurityModule_RequestCreds_System(t *testing.T) {
+	for name, tc := range map[string]struct {
+		sys       string
+		expStatus drpc.DaosStatus
+		expCred   bool
+	}{
+		"default system": {
+			expCred: true,
+		},
+		"known system": {
+			sys:     "other",
+			expCred: true,
+		},
+		"known system with bad config": {
+			sys:       "broken",
+			expStatus: drpc.DaosInvalidInput,
+		},
+		"unknown system": {
+			sys:       "quack",
+			expStatus: drpc.DaosInvalidInput,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			conn, cleanup := setupTestUnixConn(t)
+			defer cleanup()
+
+			mod := NewSecurityModule(log, defaultTestTransportConfig())
+			mod.addSystem("other", defaultTestTransportConfig())
+			mod.addSystem("broken", &security.TransportConfig{})
+
+			reqBytes, err := proto.Marshal(&auth.GetCredReq{Sys: tc.sys})
+			if err != nil {
+				t.Fatal(err)
+			}
+			respBytes, err := mod.HandleCall(newTestSession(t, log, conn), drpc.MethodRequestCredentials, reqBytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expectCredResp(t, respBytes, int32(tc.expStatus), tc.expCred)
+		})
+	}
+}
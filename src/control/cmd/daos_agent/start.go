@@ -11,11 +11,13 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/mjmac/soad/src/control/drpc"
 	"github.com/mjmac/soad/src/control/lib/atm"
+	"github.com/mjmac/soad/src/control/lib/control"
 	"github.com/mjmac/soad/src/control/lib/netdetect"
 )
 
@@ -62,19 +64,45 @@ func (cmd *startCmd) Execute(_ []string) error {
 		cmd.log.Debugf("This system is not NUMA aware.  Any devices found are reported as NUMA node 0.")
 	}
 
-	procmon := NewProcMon(cmd.log, cmd.ctlInvoker, cmd.cfg.SystemName)
-	procmon.startMonitoring(ctx)
-
-	drpcServer.RegisterRPCModule(NewSecurityModule(cmd.log, cmd.cfg.TransportConfig))
-	drpcServer.RegisterRPCModule(&mgmtModule{
-		log:        cmd.log,
-		sys:        cmd.cfg.SystemName,
-		ctlInvoker: cmd.ctlInvoker,
-		aiCache:    &attachInfoCache{log: cmd.log, enabled: enabled},
-		numaAware:  numaAware,
-		netCtx:     netCtx,
-		monitor:    procmon,
-	})
+	secMod := NewSecurityModule(cmd.log, cmd.cfg.TransportConfig)
+	systems := newSystemSet(cmd.cfg.SystemName)
+	for _, sysCfg := range cmd.cfg.Systems() {
+		ctlInvoker := cmd.ctlInvoker
+		if sysCfg.Name != cmd.cfg.SystemName {
+			ctlInvoker = control.NewClient(
+				control.WithClientLogger(cmd.log),
+				control.WithConfig(newSystemControlConfig(sysCfg)),
+			)
+		}
+		secMod.addSystem(sysCfg.Name, sysCfg.TransportConfig)
+
+		procmon := NewProcMon(cmd.log, ctlInvoker, sysCfg.Name, procMonStatePath(cmd.cfg, sysCfg.Name))
+		procmon.startMonitoring(ctx)
+
+		mgmtMod := &mgmtModule{
+			log:        cmd.log,
+			sys:        sysCfg.Name,
+			ctlInvoker: ctlInvoker,
+			ctlCfg:     newSystemControlConfig(sysCfg),
+			aiCache: &attachInfoCache{
+				log:     cmd.log,
+				enabled: atm.NewBool(enabled.IsTrue()),
+				policy:  cmd.cfg.FabricPolicy,
+			},
+			numaAware: numaAware,
+			netCtx:    netCtx,
+			monitor:   procmon,
+		}
+		mgmtMod.startCacheRefresh(ctx, cmd.cfg.CacheRefreshInterval)
+		mgmtMod.startMapWatch(ctx)
+		systems.add(mgmtMod)
+	}
+	if len(systems.mods) > 1 {
+		cmd.log.Infof("serving systems: %s", strings.Join(systems.names(), ", "))
+	}
+
+	drpcServer.RegisterRPCModule(secMod)
+	drpcServer.RegisterRPCModule(&mgmtRouter{log: cmd.log, systems: systems})
 
 	err = drpcServer.Start()
 	if err != nil {
@@ -82,6 +110,16 @@ func (cmd *startCmd) Execute(_ []string) error {
 		return err
 	}
 
+	adminServer, err := startAdminServer(ctx, cmd.log, cmd.cfg, &adminModule{
+		log:       cmd.log,
+		startTime: startedAt,
+		systems:   systems,
+	})
+	if err != nil {
+		cmd.log.Errorf("Unable to start admin socket server: %v", err)
+		return err
+	}
+
 	cmd.log.Debugf("startup complete in %s", time.Since(startedAt))
 	cmd.log.Infof("%s (pid %d) listening on %s", versionString(), os.Getpid(), sockPath)
 
@@ -109,6 +147,7 @@ func (cmd *startCmd) Execute(_ []string) error {
 		}
 	}()
 	<-finish
+	adminServer.Shutdown()
 	drpcServer.Shutdown()
 
 	cmd.log.Debugf("shutdown complete in %s", time.Since(shutdownRcvd))
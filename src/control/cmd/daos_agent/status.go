@@ -0,0 +1,189 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/lib/txtfmt"
+)
+
+// statusCmd reports the state of the running agent.
+type statusCmd struct {
+	logCmd
+	configCmd
+	jsonOutputCmd
+	sysCmd
+}
+
+func (cmd *statusCmd) Execute(_ []string) error {
+	req := &mgmtpb.AgentStatusReq{Sys: cmd.Sys}
+	resp := new(mgmtpb.AgentStatusResp)
+
+	client := drpc.NewClientConnection(adminSockPath(cmd.cfg))
+	if err := callAdminMethod(client, drpc.MethodAgentStatus, req, resp); err != nil {
+		return err
+	}
+	if resp.Error != "" {
+		return errors.Errorf("status request failed: %s", resp.Error)
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(os.Stdout, resp)
+	}
+
+	var out strings.Builder
+	if err := printAgentStatus(&out, resp); err != nil {
+		return err
+	}
+	cmd.log.Info(out.String())
+	return nil
+}
+
+func formatUnixTime(secs int64) string {
+	if secs == 0 {
+		return "never"
+	}
+	return time.Unix(secs, 0).Format(time.RFC3339)
+}
+
+// printAgentStatus generates a human-readable representation of the supplied
+// agent status and writes it to the supplied io.Writer.
+func printAgentStatus(out io.Writer, resp *mgmtpb.AgentStatusResp) error {
+	msStatus := "reachable"
+	if !resp.MsReachable {
+		msStatus = fmt.Sprintf("unreachable (%s)", resp.MsError)
+	}
+
+	mapWatch := "not subscribed"
+	if resp.MapWatchActive {
+		mapWatch = "subscribed"
+	}
+
+	cacheStatus := "disabled"
+	switch {
+	case resp.CacheInitialized:
+		cacheStatus = fmt.Sprintf("populated (map version %d)", resp.CacheMapVersion)
+	case resp.CacheEnabled:
+		cacheStatus = "empty"
+	}
+
+	title := fmt.Sprintf("daos_agent v%s (pid %d)", resp.Version, resp.Pid)
+	rows := []txtfmt.TableRow{
+		{"System": resp.Sys},
+		{"Started": formatUnixTime(resp.StartTime)},
+		{"Access Points": strings.Join(resp.AccessPoints, ",")},
+		{"Management Service": msStatus},
+		{"Last MS Contact": formatUnixTime(resp.MsLastContact)},
+		{"Map Updates": mapWatch},
+		{"Attach Info Cache": cacheStatus},
+		{"Fabric Policy": resp.FabricPolicy},
+		{"Monitored Processes": fmt.Sprintf("%d", resp.NumProcs)},
+		{"Pool Handles": fmt.Sprintf("%d", resp.NumHandles)},
+	}
+	if len(resp.Systems) > 1 {
+		rows = append(rows, txtfmt.TableRow{"Served Systems": strings.Join(resp.Systems, ",")})
+	}
+
+	if _, err := fmt.Fprint(out, txtfmt.FormatEntity(title, rows)); err != nil {
+		return err
+	}
+	if len(resp.Clients) == 0 {
+		return nil
+	}
+
+	pidTitle := "PID"
+	ifaceTitle := "Interface"
+	numaTitle := "NUMA"
+	handlesTitle := "Handles"
+
+	formatter := txtfmt.NewTableFormatter(pidTitle, ifaceTitle, numaTitle, handlesTitle)
+	var table []txtfmt.TableRow
+	for _, client := range resp.Clients {
+		table = append(table, txtfmt.TableRow{
+			pidTitle:     fmt.Sprintf("%d", client.Pid),
+			ifaceTitle:   client.Interface,
+			numaTitle:    fmt.Sprintf("%d", client.NumaNode),
+			handlesTitle: fmt.Sprintf("%d", client.Handles),
+		})
+	}
+
+	_, err := fmt.Fprintf(out, "\n%s", formatter.Format(table))
+	return err
+}
+
+// handlesCmd lists the pool handles held by processes monitored by the
+// running agent.
+type handlesCmd struct {
+	logCmd
+	configCmd
+	jsonOutputCmd
+	sysCmd
+}
+
+func (cmd *handlesCmd) Execute(_ []string) error {
+	req := &mgmtpb.AgentHandlesReq{Sys: cmd.Sys}
+	resp := new(mgmtpb.AgentHandlesResp)
+
+	client := drpc.NewClientConnection(adminSockPath(cmd.cfg))
+	if err := callAdminMethod(client, drpc.MethodAgentHandles, req, resp); err != nil {
+		return err
+	}
+	if resp.Error != "" {
+		return errors.Errorf("handles request failed: %s", resp.Error)
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(os.Stdout, resp)
+	}
+
+	var out strings.Builder
+	if err := printAgentHandles(&out, resp); err != nil {
+		return err
+	}
+	cmd.log.Info(out.String())
+	return nil
+}
+
+// printAgentHandles generates a human-readable representation of the supplied
+// pool handle list and writes it to the supplied io.Writer.
+func printAgentHandles(out io.Writer, resp *mgmtpb.AgentHandlesResp) error {
+	if len(resp.Processes) == 0 {
+		_, err := fmt.Fprintln(out, "No pool handles held by monitored processes")
+		return err
+	}
+
+	pidTitle := "PID"
+	poolTitle := "Pool"
+	handleTitle := "Handle"
+
+	formatter := txtfmt.NewTableFormatter(pidTitle, poolTitle, handleTitle)
+	var table []txtfmt.TableRow
+
+	for _, proc := range resp.Processes {
+		for _, pool := range proc.Pools {
+			for _, handle := range pool.Handles {
+				table = append(table, txtfmt.TableRow{
+					pidTitle:    fmt.Sprintf("%d", proc.Pid),
+					poolTitle:   pool.Uuid,
+					handleTitle: handle,
+				})
+			}
+		}
+	}
+
+	_, err := fmt.Fprint(out, formatter.Format(table))
+	return err
+}
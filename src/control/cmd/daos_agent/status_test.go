@@ -0,0 +1,198 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+)
+
+func TestAgent_printAgentStatus(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *mgmtpb.AgentStatusResp
+		expPrintStr string
+	}{
+		"MS unreachable; cache disabled": {
+			resp: &mgmtpb.AgentStatusResp{
+				Version:      "1.2.3",
+				Sys:          "daos_server",
+				Pid:          42,
+				FabricPolicy: "round-robin",
+				AccessPoints: []string{"host1:10001", "host2:10001"},
+				MsError:      "whoops",
+				NumProcs:     2,
+				NumHandles:   3,
+			},
+			expPrintStr: `
+daos_agent v1.2.3 (pid 42)
+--------------------------
+  System             : daos_server            
+  Started            : never                  
+  Access Points      : host1:10001,host2:10001
+  Management Service : unreachable (whoops)   
+  Last MS Contact    : never                  
+  Map Updates        : not subscribed         
+  Attach Info Cache  : disabled               
+  Fabric Policy      : round-robin            
+  Monitored Processes: 2                      
+  Pool Handles       : 3                      
+`,
+		},
+		"MS reachable; cache populated": {
+			resp: &mgmtpb.AgentStatusResp{
+				Version:          "1.2.3",
+				Sys:              "daos_server",
+				Pid:              42,
+				FabricPolicy:     "least-loaded",
+				AccessPoints:     []string{"host1:10001"},
+				MsReachable:      true,
+				MapWatchActive:   true,
+				CacheEnabled:     true,
+				CacheInitialized: true,
+				CacheMapVersion:  7,
+			},
+			expPrintStr: `
+daos_agent v1.2.3 (pid 42)
+--------------------------
+  System             : daos_server              
+  Started            : never                    
+  Access Points      : host1:10001              
+  Management Service : reachable                
+  Last MS Contact    : never                    
+  Map Updates        : subscribed               
+  Attach Info Cache  : populated (map version 7)
+  Fabric Policy      : least-loaded             
+  Monitored Processes: 0                        
+  Pool Handles       : 0                        
+`,
+		},
+		"clients assigned interfaces": {
+			resp: &mgmtpb.AgentStatusResp{
+				Version:      "1.2.3",
+				Sys:          "daos_server",
+				Pid:          42,
+				FabricPolicy: "least-loaded",
+				AccessPoints: []string{"host1:10001"},
+				MsReachable:  true,
+				Clients: []*mgmtpb.AgentStatusResp_Client{
+					{Pid: 100, Interface: "ib0", NumaNode: 0, Handles: 2},
+					{Pid: 1234, Interface: "ib1", NumaNode: 1},
+				},
+			},
+			expPrintStr: `
+daos_agent v1.2.3 (pid 42)
+--------------------------
+  System             : daos_server        
+  Started            : never              
+  Access Points      : host1:10001        
+  Management Service : reachable          
+  Last MS Contact    : never              
+  Map Updates        : not subscribed     
+  Attach Info Cache  : disabled           
+  Fabric Policy      : least-loaded       
+  Monitored Processes: 0                  
+  Pool Handles       : 0                  
+
+PID  Interface NUMA Handles 
+---  --------- ---- ------- 
+100  ib0       0    2       
+1234 ib1       1    0       
+`,
+		},
+		"multiple systems": {
+			resp: &mgmtpb.AgentStatusResp{
+				Version:      "1.2.3",
+				Sys:          "daos_server",
+				Pid:          42,
+				FabricPolicy: "round-robin",
+				AccessPoints: []string{"host1:10001"},
+				MsReachable:  true,
+				Systems:      []string{"daos_server", "other"},
+			},
+			expPrintStr: `
+daos_agent v1.2.3 (pid 42)
+--------------------------
+  System             : daos_server        
+  Started            : never              
+  Access Points      : host1:10001        
+  Management Service : reachable          
+  Last MS Contact    : never              
+  Map Updates        : not subscribed     
+  Attach Info Cache  : disabled           
+  Fabric Policy      : round-robin        
+  Monitored Processes: 0                  
+  Pool Handles       : 0                  
+  Served Systems     : daos_server,other  
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := printAgentStatus(&bld, tc.resp); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_printAgentHandles(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *mgmtpb.AgentHandlesResp
+		expPrintStr string
+	}{
+		"no handles": {
+			resp: &mgmtpb.AgentHandlesResp{},
+			expPrintStr: `
+No pool handles held by monitored processes
+`,
+		},
+		"multiple processes": {
+			resp: &mgmtpb.AgentHandlesResp{
+				Processes: []*mgmtpb.AgentHandlesResp_Process{
+					{
+						Pid: 123,
+						Pools: []*mgmtpb.AgentHandlesResp_Pool{
+							{Uuid: "pool1", Handles: []string{"hdl1", "hdl2"}},
+						},
+					},
+					{
+						Pid: 4567,
+						Pools: []*mgmtpb.AgentHandlesResp_Pool{
+							{Uuid: "pool2", Handles: []string{"hdl3"}},
+						},
+					},
+				},
+			},
+			expPrintStr: `
+PID  Pool  Handle 
+---  ----  ------ 
+123  pool1 hdl1   
+123  pool1 hdl2   
+4567 pool2 hdl3   
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := printAgentHandles(&bld, tc.resp); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+// systemSet holds the management modules of the systems served by the agent.
+type systemSet struct {
+	defaultSys string
+	mods       map[string]*mgmtModule
+}
+
+func newSystemSet(defaultSys string, mods ...*mgmtModule) *systemSet {
+	ss := &systemSet{
+		defaultSys: defaultSys,
+		mods:       make(map[string]*mgmtModule),
+	}
+	for _, mod := range mods {
+		ss.add(mod)
+	}
+	return ss
+}
+
+func (ss *systemSet) add(mod *mgmtModule) {
+	ss.mods[mod.sys] = mod
+}
+
+// get returns the module for the named system, or for the default system if
+// the name is empty.
+func (ss *systemSet) get(sys string) (*mgmtModule, error) {
+	if sys == "" {
+		sys = ss.defaultSys
+	}
+	mod, found := ss.mods[sys]
+	if !found {
+		return nil, errors.Errorf("%s: unknown system name", sys)
+	}
+	return mod, nil
+}
+
+// names returns the names of the systems, starting with the default system.
+func (ss *systemSet) names() []string {
+	names := make([]string, 0, len(ss.mods))
+	for name := range ss.mods {
+		if name != ss.defaultSys {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if _, found := ss.mods[ss.defaultSys]; found {
+		names = append([]string{ss.defaultSys}, names...)
+	}
+	return names
+}
+
+// mgmtRouter is the daos_agent dRPC module for management requests. It
+// forwards each request to the module of the system named in the request.
+type mgmtRouter struct {
+	log     logging.Logger
+	systems *systemSet
+}
+
+func (r *mgmtRouter) HandleCall(session *drpc.Session, method drpc.Method, req []byte) ([]byte, error) {
+	switch method {
+	case drpc.MethodGetAttachInfo:
+		pbReq := new(mgmtpb.GetAttachInfoReq)
+		if err := proto.Unmarshal(req, pbReq); err != nil {
+			return nil, drpc.UnmarshalingPayloadFailure()
+		}
+		mod, err := r.systems.get(pbReq.Sys)
+		if err != nil {
+			r.log.Errorf("GetAttachInfo: %s", err)
+			return drpc.Marshal(&mgmtpb.GetAttachInfoResp{Status: int32(drpc.DaosInvalidInput)})
+		}
+		return mod.HandleCall(session, method, req)
+	case drpc.MethodNotifyPoolConnect, drpc.MethodNotifyPoolDisconnect:
+		pbReq := new(mgmtpb.PoolMonitorReq)
+		if err := proto.Unmarshal(req, pbReq); err != nil {
+			return nil, drpc.UnmarshalingPayloadFailure()
+		}
+		mod, err := r.systems.get(pbReq.Sys)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s", method)
+		}
+		return mod.HandleCall(session, method, req)
+	case drpc.MethodNotifyExit:
+		// The exiting process may hold handles on any of the systems.
+		for _, name := range r.systems.names() {
+			if _, err := r.systems.mods[name].HandleCall(session, method, req); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	default:
+		return nil, drpc.UnknownMethodFailure()
+	}
+}
+
+func (r *mgmtRouter) ID() drpc.ModuleID {
+	return drpc.ModuleMgmt
+}
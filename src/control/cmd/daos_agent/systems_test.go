@@ -0,0 +1,200 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/lib/netdetect"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func TestAgent_systemSet(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	mods := make([]*mgmtModule, 0, 3)
+	for _, sys := range []string{"zebra", "daos_server", "alpha"} {
+		mods = append(mods, &mgmtModule{log: log, sys: sys})
+	}
+	systems := newSystemSet("daos_server", mods...)
+
+	common.AssertEqual(t, []string{"daos_server", "alpha", "zebra"}, systems.names(),
+		"unexpected system names")
+
+	for name, tc := range map[string]struct {
+		sys    string
+		expSys string
+		expErr error
+	}{
+		"default": {
+			expSys: "daos_server",
+		},
+		"named default": {
+			sys:    "daos_server",
+			expSys: "daos_server",
+		},
+		"other": {
+			sys:    "alpha",
+			expSys: "alpha",
+		},
+		"unknown": {
+			sys:    "quack",
+			expErr: errors.New("quack: unknown system name"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mod, err := systems.get(tc.sys)
+			common.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			common.AssertEqual(t, tc.expSys, mod.sys, "unexpected module")
+		})
+	}
+}
+
+func TestAgent_mgmtRouter_HandleCall(t *testing.T) {
+	pid := int32(os.Getpid())
+	connectReq := func(sys, pool, handle string) *mgmtpb.PoolMonitorReq {
+		return &mgmtpb.PoolMonitorReq{Sys: sys, PoolUUID: pool, PoolHandleUUID: handle}
+	}
+
+	for name, tc := range map[string]struct {
+		connect    []*mgmtpb.PoolMonitorReq
+		exit       bool
+		expErr     error
+		expHandles map[string][]*mgmtpb.AgentHandlesResp_Process
+	}{
+		"connect routed by system": {
+			connect: []*mgmtpb.PoolMonitorReq{
+				connectReq("", "pool1", "hdl1"),
+				connectReq("other", "pool2", "hdl2"),
+			},
+			expHandles: map[string][]*mgmtpb.AgentHandlesResp_Process{
+				"daos_server": {
+					{
+						Pid:   pid,
+						Pools: []*mgmtpb.AgentHandlesResp_Pool{{Uuid: "pool1", Handles: []string{"hdl1"}}},
+					},
+				},
+				"other": {
+					{
+						Pid:   pid,
+						Pools: []*mgmtpb.AgentHandlesResp_Pool{{Uuid: "pool2", Handles: []string{"hdl2"}}},
+					},
+				},
+			},
+		},
+		"connect to unknown system": {
+			connect: []*mgmtpb.PoolMonitorReq{
+				connectReq("quack", "pool1", "hdl1"),
+			},
+			expErr: errors.New("quack: unknown system name"),
+		},
+		"exit notified to all systems": {
+			connect: []*mgmtpb.PoolMonitorReq{
+				connectReq("daos_server", "pool1", "hdl1"),
+				connectReq("other", "pool2", "hdl2"),
+			},
+			exit: true,
+			expHandles: map[string][]*mgmtpb.AgentHandlesResp_Process{
+				"daos_server": {},
+				"other":       {},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conn, cleanup := setupTestUnixConn(t)
+			defer cleanup()
+			session := newTestSession(t, log, conn)
+
+			systems := newSystemSet("daos_server")
+			for _, sys := range []string{"daos_server", "other"} {
+				mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+					UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.PoolEvictResp{}),
+				})
+				mod := newTestMgmtModule(t, log, true, 1, mi)
+				defer netdetect.CleanUp(mod.netCtx)
+				mod.sys = sys
+				mod.monitor = NewProcMon(log, mi, sys, "")
+				mod.monitor.startMonitoring(ctx)
+				systems.add(mod)
+			}
+			router := &mgmtRouter{log: log, systems: systems}
+
+			var err error
+			for _, req := range tc.connect {
+				if _, err = router.HandleCall(session, drpc.MethodNotifyPoolConnect, mustMarshal(t, req)); err != nil {
+					break
+				}
+			}
+			if err == nil && tc.exit {
+				_, err = router.HandleCall(session, drpc.MethodNotifyExit, nil)
+			}
+			common.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			for sys, expProcs := range tc.expHandles {
+				mod, err := systems.get(sys)
+				if err != nil {
+					t.Fatal(err)
+				}
+				gotProcs, err := mod.monitor.GetHandles(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(expProcs, gotProcs, common.DefaultCmpOpts()...); diff != "" {
+					t.Fatalf("unexpected handles for %s (-want, +got):\n%s\n", sys, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAgent_mgmtRouter_GetAttachInfo_UnknownSystem(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	conn, cleanup := setupTestUnixConn(t)
+	defer cleanup()
+
+	mi := control.NewMockInvoker(log, &control.MockInvokerConfig{})
+	mod := newTestMgmtModule(t, log, true, 1, mi)
+	defer netdetect.CleanUp(mod.netCtx)
+	router := &mgmtRouter{log: log, systems: newSystemSet(mod.sys, mod)}
+
+	respb, err := router.HandleCall(newTestSession(t, log, conn), drpc.MethodGetAttachInfo,
+		mustMarshal(t, &mgmtpb.GetAttachInfoReq{Sys: "quack"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := new(mgmtpb.GetAttachInfoResp)
+	if err := proto.Unmarshal(respb, resp); err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, int32(drpc.DaosInvalidInput), resp.Status, "unexpected status")
+}
@@ -0,0 +1,52 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+type dbRestorer func(logging.Logger, *config.Server, io.Reader) (*system.DatabaseBackup, error)
+
+// dbRestoreCmd is the struct representing the command to seed the local
+// MS replica with a system database backup.
+type dbRestoreCmd struct {
+	logCmd
+	cfgCmd
+	restore dbRestorer
+	Input   string `short:"i" long:"input" required:"1" description:"Path of a backup file created by dmg system db-backup"`
+}
+
+func (cmd *dbRestoreCmd) Execute(_ []string) error {
+	if cmd.restore == nil {
+		cmd.restore = server.RestoreDatabase
+	}
+
+	f, err := os.Open(cmd.Input)
+	if err != nil {
+		return errors.Wrap(err, "failed to open backup file")
+	}
+	defer f.Close()
+
+	backup, err := cmd.restore(cmd.log, cmd.config, f)
+	if err != nil {
+		return err
+	}
+
+	cmd.log.Infof("Restored system %q database (map version %d, created %s) from %s",
+		backup.SystemName, backup.MapVersion, backup.Created.Format(time.RFC3339), cmd.Input)
+	return nil
+}
@@ -0,0 +1,79 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+func TestDaosServer_DbRestore(t *testing.T) {
+	testDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+	backupPath := filepath.Join(testDir, "backup.json")
+	if err := ioutil.WriteFile(backupPath, []byte("backup"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		argList    []string
+		restoreErr error
+		expData    string
+		expErr     error
+	}{
+		"missing input": {
+			expErr: errors.New("the required flag `-i, --input' was not specified"),
+		},
+		"nonexistent input": {
+			argList: []string{"-i", filepath.Join(testDir, "missing")},
+			expErr:  errors.New("failed to open backup file"),
+		},
+		"restore fails": {
+			argList:    []string{"-i", backupPath},
+			restoreErr: errors.New("restore failed"),
+			expErr:     errors.New("restore failed"),
+		},
+		"success": {
+			argList: []string{"--input", backupPath},
+			expData: "backup",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			var gotData []byte
+			var opts mainOpts
+			opts.DbRestore.restore = func(_ logging.Logger, _ *config.Server, r io.Reader) (*system.DatabaseBackup, error) {
+				data, err := ioutil.ReadAll(r)
+				if err != nil {
+					t.Fatal(err)
+				}
+				gotData = data
+				return &system.DatabaseBackup{SystemName: "daos_server", MapVersion: 1}, tc.restoreErr
+			}
+			opts.DbRestore.config = genMinimalConfig()
+
+			gotErr := parseOpts(append([]string{"db-restore"}, tc.argList...), &opts, log)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			common.AssertEqual(t, tc.expData, string(gotData), "unexpected backup data")
+		})
+	}
+}
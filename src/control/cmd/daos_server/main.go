@@ -38,10 +38,11 @@ type mainOpts struct {
 	Syslog  bool `long:"syslog" description:"Enable logging to syslog"`
 
 	// Define subcommands
-	Storage storageCmd `command:"storage" description:"Perform tasks related to locally-attached storage"`
-	Start   startCmd   `command:"start" description:"Start daos_server"`
-	Network networkCmd `command:"network" description:"Perform network device scan based on fabric provider"`
-	Version versionCmd `command:"version" description:"Print daos_server version"`
+	Storage   storageCmd   `command:"storage" description:"Perform tasks related to locally-attached storage"`
+	Start     startCmd     `command:"start" description:"Start daos_server"`
+	Network   networkCmd   `command:"network" description:"Perform network device scan based on fabric provider"`
+	Version   versionCmd   `command:"version" description:"Print daos_server version"`
+	DbRestore dbRestoreCmd `command:"db-restore" description:"Seed the local MS replica with a system database backup while daos_server is stopped"`
 }
 
 type versionCmd struct{}
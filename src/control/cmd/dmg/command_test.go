@@ -14,6 +14,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/mjmac/soad/src/control/common"
@@ -72,9 +73,9 @@ func runCmd(t *testing.T, cmd string, log *logging.LeveledLogger, ctlClient cont
 }
 
 // printRequest generates a stable string representation of the
-// supplied UnaryRequest. It only includes exported fields in
+// supplied request. It only includes exported fields in
 // the output.
-func printRequest(t *testing.T, req control.UnaryRequest) string {
+func printRequest(t *testing.T, req interface{}) string {
 	buf, err := json.Marshal(req)
 	if err != nil {
 		t.Fatalf("unable to print %+v: %s", req, err)
@@ -91,6 +92,22 @@ type bridgeConnInvoker struct {
 	conn *testConn
 }
 
+func (bci *bridgeConnInvoker) InvokeStreamRPC(ctx context.Context, sReq control.StreamRequest, recv func(proto.Message) error) error {
+	// Record the request and close the stream, synthesizing a
+	// message as necessary for commands that expect to receive at
+	// least one.
+	bci.conn.appendInvocation(printRequest(bci.t, sReq))
+
+	switch sReq.(type) {
+	case *control.SystemRestartReq:
+		return recv(&mgmtpb.SystemRestartResp{Step: 1, NumSteps: 1, Phase: "done"})
+	case *control.SystemDbBackupReq:
+		return recv(&mgmtpb.SystemDbBackupResp{Data: []byte("{}"), MapVersion: 1, Size: 2})
+	}
+
+	return nil
+}
+
 func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.UnaryRequest) (*control.UnaryResponse, error) {
 	// Use the testConn to fill out the calls slice for compatibility
 	// with old-style Connection tests.
@@ -114,6 +131,8 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 			Property: &mgmtpb.PoolSetPropResp_Name{},
 			Value:    &mgmtpb.PoolSetPropResp_Numval{},
 		})
+	case *control.PoolGetPropReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolGetPropResp{})
 	case *control.SystemStopReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemStopResp{})
 	case *control.SystemResetFormatReq:
@@ -126,8 +145,30 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.LeaderQueryResp{})
 	case *control.ListPoolsReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.ListPoolsResp{})
+	case *control.ListEventsReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.ListEventsResp{})
+	case *control.SystemSetPolicyReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemSetPolicyResp{})
+	case *control.SystemReplicaListReq, *control.SystemReplicaReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemReplicaResp{})
+	case *control.QuotaSetReq, *control.QuotaGetReq, *control.QuotaListReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.QuotaResp{})
 	case *control.ContSetOwnerReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.ContSetOwnerResp{})
+	case *control.ListContainersReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.ListContResp{
+			Containers: []*mgmtpb.ListContResp_Cont{
+				{Uuid: defaultContUUID},
+			},
+		})
+	case *control.ContQueryReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.ContQueryResp{})
+	case *control.ContDestroyReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.ContDestroyResp{})
+	case *control.ContGetPropReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.ContGetPropResp{})
+	case *control.ContSetPropReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.ContSetPropResp{})
 	case *control.PoolResolveIDReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolResolveIDResp{
 			Uuid: defaultPoolUUID,
@@ -8,18 +8,33 @@ package main
 
 import (
 	"context"
+	"strings"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
+	"github.com/mjmac/soad/src/control/cmd/dmg/pretty"
+	"github.com/mjmac/soad/src/control/drpc"
 	"github.com/mjmac/soad/src/control/lib/control"
 )
 
 // ContCmd is the struct representing the top-level container subcommand.
 type ContCmd struct {
+	List     ContListCmd     `command:"list" alias:"l" description:"List the containers in a DAOS pool and their usage"`
+	Query    ContQueryCmd    `command:"query" alias:"q" description:"Query a DAOS container's ownership and usage"`
+	Destroy  ContDestroyCmd  `command:"destroy" alias:"d" description:"Destroy a DAOS container"`
+	GetProp  ContGetPropCmd  `command:"get-prop" alias:"gp" description:"Get DAOS container properties"`
+	SetProp  ContSetPropCmd  `command:"set-prop" alias:"sp" description:"Set a DAOS container property"`
 	SetOwner ContSetOwnerCmd `command:"set-owner" description:"Change the owner for a DAOS container"`
 }
 
+// contCmd is the base struct for all container commands that work with an
+// existing container.
+type contCmd struct {
+	poolCmd
+	ContUUID string `long:"cont" required:"1" description:"UUID of DAOS container"`
+}
+
 // ContSetOwnerCmd is the struct representing the command to change the owner of a DAOS container.
 type ContSetOwnerCmd struct {
 	logCmd
@@ -28,7 +43,7 @@ type ContSetOwnerCmd struct {
 	GroupName string `short:"g" long:"group" description:"New owner-group for the container, format name@domain"`
 	UserName  string `short:"u" long:"user" description:"New owner-user for the container, format name@domain"`
 	ContUUID  string `short:"c" long:"cont" required:"1" description:"UUID of the DAOS container"`
-	PoolUUID  string `short:"p" long:"pool" required:"1" description:"UUID of the DAOS pool for the container"`
+	PoolID    string `short:"p" long:"pool" required:"1" description:"Label or UUID of the DAOS pool for the container"`
 }
 
 // Execute runs the container set-owner command
@@ -42,7 +57,7 @@ func (c *ContSetOwnerCmd) Execute(args []string) error {
 	msg := "SUCCEEDED"
 	req := &control.ContSetOwnerReq{
 		ContUUID: c.ContUUID,
-		PoolUUID: c.PoolUUID,
+		PoolID:   c.PoolID,
 		User:     c.UserName,
 		Group:    c.GroupName,
 	}
@@ -61,3 +76,209 @@ func (c *ContSetOwnerCmd) Execute(args []string) error {
 
 	return err
 }
+
+// ContListCmd is the struct representing the command to list the containers
+// in a DAOS pool.
+type ContListCmd struct {
+	poolCmd
+}
+
+// Execute is run when ContListCmd subcommand is activated.
+func (cmd *ContListCmd) Execute(_ []string) error {
+	if err := cmd.resolveID(); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	resp, err := control.ListContainers(ctx, cmd.ctlInvoker, &control.ListContainersReq{
+		PoolID: cmd.UUID,
+	})
+	if err != nil {
+		if cmd.jsonOutputEnabled() {
+			return cmd.outputJSON(nil, err)
+		}
+		return errors.Wrap(err, "container list failed")
+	}
+
+	conts := make([]*control.ContQueryResp, 0, len(resp.Containers))
+	for _, contUUID := range resp.Containers {
+		qr, err := control.ContQuery(ctx, cmd.ctlInvoker, &control.ContQueryReq{
+			PoolID:   cmd.UUID,
+			ContUUID: contUUID,
+		})
+		if err != nil {
+			// The container may have been destroyed since it was listed.
+			if errors.Cause(err) == drpc.DaosNonexistant {
+				continue
+			}
+			if cmd.jsonOutputEnabled() {
+				return cmd.outputJSON(nil, err)
+			}
+			return errors.Wrapf(err, "container %s query failed", contUUID)
+		}
+		conts = append(conts, qr)
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(conts, nil)
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintContainerList(cmd.ID, &bld, conts...); err != nil {
+		return err
+	}
+	cmd.log.Info(bld.String())
+
+	return nil
+}
+
+// ContQueryCmd is the struct representing the command to query a DAOS container.
+type ContQueryCmd struct {
+	contCmd
+}
+
+// Execute is run when ContQueryCmd subcommand is activated.
+func (cmd *ContQueryCmd) Execute(_ []string) error {
+	if err := cmd.resolveID(); err != nil {
+		return err
+	}
+
+	req := &control.ContQueryReq{
+		PoolID:   cmd.UUID,
+		ContUUID: cmd.ContUUID,
+	}
+
+	ctx := context.Background()
+	resp, err := control.ContQuery(ctx, cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "container query failed")
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintContQueryResponse(resp, &bld); err != nil {
+		return err
+	}
+	cmd.log.Info(bld.String())
+
+	return nil
+}
+
+// ContDestroyCmd is the struct representing the command to destroy a DAOS container.
+type ContDestroyCmd struct {
+	contCmd
+	Force bool `short:"f" long:"force" description:"Evict open handles and destroy the container"`
+}
+
+// Execute is run when ContDestroyCmd subcommand is activated.
+func (cmd *ContDestroyCmd) Execute(_ []string) error {
+	msg := "succeeded"
+
+	if err := cmd.resolveID(); err != nil {
+		return err
+	}
+
+	req := &control.ContDestroyReq{
+		PoolID:   cmd.UUID,
+		ContUUID: cmd.ContUUID,
+		Force:    cmd.Force,
+	}
+
+	ctx := context.Background()
+	err := control.ContDestroy(ctx, cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.errorJSON(err)
+	}
+
+	if err != nil {
+		if errors.Cause(err) == drpc.DaosBusy && !cmd.Force {
+			err = errors.Wrap(err, "container has open handles (use --force to evict them)")
+		}
+		msg = errors.WithMessage(err, "failed").Error()
+	}
+
+	cmd.log.Infof("Container-destroy command %s\n", msg)
+
+	return err
+}
+
+// ContGetPropCmd represents the command to get properties of a DAOS container.
+type ContGetPropCmd struct {
+	contCmd
+	Args struct {
+		Props []string `positional-arg-name:"property"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when ContGetPropCmd subcommand is activated.
+func (cmd *ContGetPropCmd) Execute(_ []string) error {
+	if err := cmd.resolveID(); err != nil {
+		return err
+	}
+
+	req := &control.ContGetPropReq{
+		PoolID:     cmd.UUID,
+		ContUUID:   cmd.ContUUID,
+		Properties: cmd.Args.Props,
+	}
+
+	ctx := context.Background()
+	resp, err := control.ContGetProp(ctx, cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "container get-prop failed")
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintContProperties(cmd.ContUUID, &bld, resp.Properties...); err != nil {
+		return err
+	}
+	cmd.log.Info(bld.String())
+
+	return nil
+}
+
+// ContSetPropCmd represents the command to set a property of a DAOS container.
+type ContSetPropCmd struct {
+	contCmd
+	Property string `short:"n" long:"name" required:"1" description:"Name of property to be set (label, status)"`
+	Value    string `short:"v" long:"value" required:"1" description:"Value of property to be set"`
+}
+
+// Execute is run when ContSetPropCmd subcommand is activated.
+func (cmd *ContSetPropCmd) Execute(_ []string) error {
+	if err := cmd.resolveID(); err != nil {
+		return err
+	}
+
+	req := &control.ContSetPropReq{
+		PoolID:   cmd.UUID,
+		ContUUID: cmd.ContUUID,
+		Properties: map[string]string{
+			cmd.Property: cmd.Value,
+		},
+	}
+
+	ctx := context.Background()
+	err := control.ContSetProp(ctx, cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.errorJSON(err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "container set-prop failed")
+	}
+
+	cmd.log.Infof("container set-prop succeeded (%s=%q)", cmd.Property, cmd.Value)
+	return nil
+}
@@ -12,10 +12,16 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 
+	"github.com/mjmac/soad/src/control/common"
 	"github.com/mjmac/soad/src/control/lib/control"
 )
 
+var (
+	defaultContUUID = common.MockUUID(1)
+)
+
 func TestContSetOwnerCommand(t *testing.T) {
 	testPoolUUID := uuid.New()
 	testContUUID := uuid.New()
@@ -35,7 +41,7 @@ func TestContSetOwnerCommand(t *testing.T) {
 			fmt.Sprintf("cont set-owner --pool=%s --cont=%s --user=%s", testPoolUUID, testContUUID, testUser),
 			strings.Join([]string{
 				printRequest(t, &control.ContSetOwnerReq{
-					PoolUUID: testPoolUUID.String(),
+					PoolID:   testPoolUUID.String(),
 					ContUUID: testContUUID.String(),
 					User:     testUser,
 					Group:    "",
@@ -43,12 +49,24 @@ func TestContSetOwnerCommand(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Set owner with pool label",
+			fmt.Sprintf("cont set-owner --pool=test-label --cont=%s --user=%s", testContUUID, testUser),
+			strings.Join([]string{
+				printRequest(t, &control.ContSetOwnerReq{
+					PoolID:   "test-label",
+					ContUUID: testContUUID.String(),
+					User:     testUser,
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Set owner group",
 			fmt.Sprintf("cont set-owner --pool=%s --cont=%s --group=%s", testPoolUUID, testContUUID, testGroup),
 			strings.Join([]string{
 				printRequest(t, &control.ContSetOwnerReq{
-					PoolUUID: testPoolUUID.String(),
+					PoolID:   testPoolUUID.String(),
 					ContUUID: testContUUID.String(),
 					User:     "",
 					Group:    testGroup,
@@ -62,7 +80,7 @@ func TestContSetOwnerCommand(t *testing.T) {
 				testPoolUUID, testContUUID, testUser, testGroup),
 			strings.Join([]string{
 				printRequest(t, &control.ContSetOwnerReq{
-					PoolUUID: testPoolUUID.String(),
+					PoolID:   testPoolUUID.String(),
 					ContUUID: testContUUID.String(),
 					User:     testUser,
 					Group:    testGroup,
@@ -72,3 +90,124 @@ func TestContSetOwnerCommand(t *testing.T) {
 		},
 	})
 }
+
+func TestContCommands(t *testing.T) {
+	testContUUID := uuid.New().String()
+
+	runCmdTests(t, []cmdTest{
+		{
+			"List containers with missing pool",
+			"cont list",
+			"",
+			errMissingFlag,
+		},
+		{
+			"List containers",
+			fmt.Sprintf("cont list --pool=%s", defaultPoolUUID),
+			strings.Join([]string{
+				printRequest(t, &control.ListContainersReq{
+					PoolID: defaultPoolUUID,
+				}),
+				printRequest(t, &control.ContQueryReq{
+					PoolID:   defaultPoolUUID,
+					ContUUID: defaultContUUID,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"List containers by pool label",
+			"cont list --pool=mypool",
+			strings.Join([]string{
+				printRequest(t, &control.PoolResolveIDReq{
+					HumanID: "mypool",
+				}),
+				printRequest(t, &control.ListContainersReq{
+					PoolID: defaultPoolUUID,
+				}),
+				printRequest(t, &control.ContQueryReq{
+					PoolID:   defaultPoolUUID,
+					ContUUID: defaultContUUID,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Query container with missing container",
+			fmt.Sprintf("cont query --pool=%s", defaultPoolUUID),
+			"",
+			errMissingFlag,
+		},
+		{
+			"Query container",
+			fmt.Sprintf("cont query --pool=%s --cont=%s", defaultPoolUUID, testContUUID),
+			printRequest(t, &control.ContQueryReq{
+				PoolID:   defaultPoolUUID,
+				ContUUID: testContUUID,
+			}),
+			nil,
+		},
+		{
+			"Destroy container",
+			fmt.Sprintf("cont destroy --pool=%s --cont=%s", defaultPoolUUID, testContUUID),
+			printRequest(t, &control.ContDestroyReq{
+				PoolID:   defaultPoolUUID,
+				ContUUID: testContUUID,
+			}),
+			nil,
+		},
+		{
+			"Destroy container with force",
+			fmt.Sprintf("cont destroy --pool=%s --cont=%s --force", defaultPoolUUID, testContUUID),
+			printRequest(t, &control.ContDestroyReq{
+				PoolID:   defaultPoolUUID,
+				ContUUID: testContUUID,
+				Force:    true,
+			}),
+			nil,
+		},
+		{
+			"Get all container properties",
+			fmt.Sprintf("cont get-prop --pool=%s --cont=%s", defaultPoolUUID, testContUUID),
+			printRequest(t, &control.ContGetPropReq{
+				PoolID:   defaultPoolUUID,
+				ContUUID: testContUUID,
+			}),
+			nil,
+		},
+		{
+			"Get selected container properties",
+			fmt.Sprintf("cont get-prop --pool=%s --cont=%s label status", defaultPoolUUID, testContUUID),
+			printRequest(t, &control.ContGetPropReq{
+				PoolID:     defaultPoolUUID,
+				ContUUID:   testContUUID,
+				Properties: []string{"label", "status"},
+			}),
+			nil,
+		},
+		{
+			"Set container property with missing value",
+			fmt.Sprintf("cont set-prop --pool=%s --cont=%s --name=label", defaultPoolUUID, testContUUID),
+			"",
+			errMissingFlag,
+		},
+		{
+			"Set container property",
+			fmt.Sprintf("cont set-prop --pool=%s --cont=%s --name=status --value=healthy", defaultPoolUUID, testContUUID),
+			printRequest(t, &control.ContSetPropReq{
+				PoolID:   defaultPoolUUID,
+				ContUUID: testContUUID,
+				Properties: map[string]string{
+					"status": "healthy",
+				},
+			}),
+			nil,
+		},
+		{
+			"Query container with bad container UUID",
+			fmt.Sprintf("cont query --pool=%s --cont=junk", defaultPoolUUID),
+			"",
+			errors.New("invalid UUID"),
+		},
+	})
+}
@@ -58,10 +58,16 @@ func TestDmg_JsonOutput(t *testing.T) {
 		cmdArgs = append(cmdArgs, cmd)
 	})
 
+	tmpDir, tmpCleanup := common.CreateTestDir(t)
+	defer tmpCleanup()
+
 	aclPath := filepath.Join(os.TempDir(), "testACLFile.txt")
 	createTestFile(t, aclPath, "A::OWNER@:rw\nA::user1@:rw\nA:g:group1@:r\n")
 	defer os.Remove(aclPath)
 
+	manifestPath := filepath.Join(tmpDir, "pools.yaml")
+	createTestFile(t, manifestPath, "pools:\n- label: tank\n  size: 1TB\n")
+
 	for _, args := range cmdArgs {
 		t.Run(strings.Join(args, " "), func(t *testing.T) {
 			testArgs := append([]string{"-i", "--json"}, args...)
@@ -82,20 +88,41 @@ func TestDmg_JsonOutput(t *testing.T) {
 				testArgs = append(testArgs, []string{"--uuid", common.MockUUID()}...)
 			case "pool create":
 				testArgs = append(testArgs, []string{"-s", "1TB"}...)
-			case "pool destroy", "pool evict", "pool query", "pool get-acl":
+			case "pool destroy", "pool evict", "pool query", "pool get-acl",
+				"pool get-prop":
 				testArgs = append(testArgs, []string{"--pool", common.MockUUID()}...)
 			case "pool overwrite-acl", "pool update-acl":
 				testArgs = append(testArgs, []string{"--pool", common.MockUUID(), "-a", aclPath}...)
 			case "pool delete-acl":
 				testArgs = append(testArgs, []string{"--pool", common.MockUUID(), "-p", "foo@"}...)
+			case "pool apply":
+				testArgs = append(testArgs, []string{"-f", manifestPath}...)
 			case "pool set-prop":
 				testArgs = append(testArgs, []string{"--pool", common.MockUUID(), "-n", "foo", "-v", "bar"}...)
+			case "pool rename":
+				testArgs = append(testArgs, []string{"--pool", common.MockUUID(), "-l", "foo"}...)
 			case "pool extend":
 				testArgs = append(testArgs, []string{"--pool", common.MockUUID(), "--ranks", "0", "-s", "1TB"}...)
 			case "pool exclude", "pool drain", "pool reintegrate":
 				testArgs = append(testArgs, []string{"--pool", common.MockUUID(), "--rank", "0"}...)
+			case "system db-backup":
+				testArgs = append(testArgs, []string{"-o", filepath.Join(tmpDir, "backup.json")}...)
+			case "system replica add", "system replica remove":
+				testArgs = append(testArgs, []string{"--addr", "host1"}...)
+			case "system set-policy":
+				testArgs = append(testArgs, []string{"--auto-exclude", "pause"}...)
+			case "quota set":
+				testArgs = append(testArgs, []string{"--user", "foo", "--size", "1TB"}...)
+			case "quota get":
+				testArgs = append(testArgs, []string{"--user", "foo"}...)
 			case "cont set-owner":
 				testArgs = append(testArgs, []string{"--user", "foo", "--pool", common.MockUUID(), "--cont", common.MockUUID()}...)
+			case "cont list":
+				testArgs = append(testArgs, []string{"--pool", common.MockUUID()}...)
+			case "cont query", "cont destroy", "cont get-prop":
+				testArgs = append(testArgs, []string{"--pool", common.MockUUID(), "--cont", common.MockUUID()}...)
+			case "cont set-prop":
+				testArgs = append(testArgs, []string{"--pool", common.MockUUID(), "--cont", common.MockUUID(), "-n", "label", "-v", "foo"}...)
 			}
 
 			// replace os.Stdout so that we can verify the generated output
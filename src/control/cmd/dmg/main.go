@@ -148,6 +148,8 @@ type cliOptions struct {
 	Network        NetCmd     `command:"network" alias:"n" description:"Perform tasks related to network devices attached to remote servers"`
 	Pool           PoolCmd    `command:"pool" alias:"p" description:"Perform tasks related to DAOS pools"`
 	Cont           ContCmd    `command:"cont" alias:"c" description:"Perform tasks related to DAOS containers"`
+	Quota          QuotaCmd   `command:"quota" alias:"q" description:"Manage pool capacity quotas for users and groups"`
+	Server         ServerCmd  `command:"server" alias:"se" description:"Perform tasks related to running DAOS servers"`
 	Version        versionCmd `command:"version" description:"Print dmg version"`
 	firmwareOption            // build with tag "firmware" to enable
 }
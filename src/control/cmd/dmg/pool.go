@@ -40,6 +40,9 @@ type PoolCmd struct {
 	UpdateACL    PoolUpdateACLCmd    `command:"update-acl" alias:"ua" description:"Update entries in a DAOS pool's Access Control List"`
 	DeleteACL    PoolDeleteACLCmd    `command:"delete-acl" alias:"da" description:"Delete an entry from a DAOS pool's Access Control List"`
 	SetProp      PoolSetPropCmd      `command:"set-prop" alias:"sp" description:"Set pool property"`
+	GetProp      PoolGetPropCmd      `command:"get-prop" alias:"gp" description:"Get pool properties"`
+	Rename       PoolRenameCmd       `command:"rename" description:"Change the label of a DAOS pool"`
+	Apply        PoolApplyCmd        `command:"apply" description:"Reconcile DAOS pools with a YAML pool manifest"`
 }
 
 // PoolCreateCmd is the struct representing the command to create a DAOS pool.
@@ -59,6 +62,7 @@ type PoolCreateCmd struct {
 	NVMeSize   string  `short:"n" long:"nvme-size" description:"Per-server NVMe allocation for DAOS pool (manual)"`
 	RankList   string  `short:"r" long:"ranks" description:"Storage server unique identifiers (ranks) for DAOS pool"`
 	Sys        string  `short:"S" long:"sys" default:"daos_server" description:"DAOS system that pool is to be a part of"`
+	DryRun     bool    `long:"dry-run" description:"Show the planned ranks and per-rank sizes without creating the pool"`
 }
 
 // Execute is run when PoolCreateCmd subcommand is activated
@@ -76,6 +80,7 @@ func (cmd *PoolCreateCmd) Execute(args []string) error {
 		UserGroup:  cmd.GroupName,
 		Name:       cmd.PoolName,
 		NumSvcReps: cmd.NumSvcReps,
+		DryRun:     cmd.DryRun,
 	}
 
 	if cmd.ACLFile != "" {
@@ -207,7 +212,7 @@ func (cmd *PoolDestroyCmd) Execute(args []string) error {
 		return err
 	}
 
-	req := &control.PoolDestroyReq{UUID: cmd.UUID, Force: cmd.Force}
+	req := &control.PoolDestroyReq{ID: cmd.UUID, Force: cmd.Force}
 
 	ctx := context.Background()
 	err := control.PoolDestroy(ctx, cmd.ctlInvoker, req)
@@ -239,7 +244,7 @@ func (cmd *PoolEvictCmd) Execute(args []string) error {
 		return err
 	}
 
-	req := &control.PoolEvictReq{UUID: cmd.UUID}
+	req := &control.PoolEvictReq{ID: cmd.UUID}
 	req.SetSystem(cmd.Sys)
 
 	ctx := context.Background()
@@ -278,7 +283,7 @@ func (cmd *PoolExcludeCmd) Execute(args []string) error {
 		return errors.WithMessage(err, "parsing rank list")
 	}
 
-	req := &control.PoolExcludeReq{UUID: cmd.UUID, Rank: system.Rank(cmd.Rank), Targetidx: idxlist}
+	req := &control.PoolExcludeReq{ID: cmd.UUID, Rank: system.Rank(cmd.Rank), Targetidx: idxlist}
 
 	ctx := context.Background()
 	err := control.PoolExclude(ctx, cmd.ctlInvoker, req)
@@ -320,7 +325,7 @@ func (cmd *PoolDrainCmd) Execute(args []string) error {
 		return err
 	}
 
-	req := &control.PoolDrainReq{UUID: cmd.UUID, Rank: system.Rank(cmd.Rank), Targetidx: idxlist}
+	req := &control.PoolDrainReq{ID: cmd.UUID, Rank: system.Rank(cmd.Rank), Targetidx: idxlist}
 
 	ctx := context.Background()
 	err := control.PoolDrain(ctx, cmd.ctlInvoker, req)
@@ -381,7 +386,7 @@ func (cmd *PoolExtendCmd) Execute(args []string) error {
 	}
 
 	req := &control.PoolExtendReq{
-		UUID: cmd.UUID, Ranks: ranks,
+		ID: cmd.UUID, Ranks: ranks,
 		ScmBytes: scmBytes, NvmeBytes: nvmeBytes,
 	}
 	// END TEMP SECTION
@@ -426,7 +431,7 @@ func (cmd *PoolReintegrateCmd) Execute(args []string) error {
 		return err
 	}
 
-	req := &control.PoolReintegrateReq{UUID: cmd.UUID, Rank: system.Rank(cmd.Rank), Targetidx: idxlist}
+	req := &control.PoolReintegrateReq{ID: cmd.UUID, Rank: system.Rank(cmd.Rank), Targetidx: idxlist}
 
 	ctx := context.Background()
 	err := control.PoolReintegrate(ctx, cmd.ctlInvoker, req)
@@ -456,7 +461,7 @@ func (cmd *PoolQueryCmd) Execute(args []string) error {
 	}
 
 	req := &control.PoolQueryReq{
-		UUID: cmd.UUID,
+		ID: cmd.UUID,
 	}
 
 	ctx := context.Background()
@@ -492,7 +497,7 @@ func (cmd *PoolSetPropCmd) Execute(_ []string) error {
 	}
 
 	req := &control.PoolSetPropReq{
-		UUID:     cmd.UUID,
+		ID:       cmd.UUID,
 		Property: cmd.Property,
 	}
 
@@ -516,6 +521,129 @@ func (cmd *PoolSetPropCmd) Execute(_ []string) error {
 	return nil
 }
 
+// PoolRenameCmd represents the command to change the label of a pool.
+type PoolRenameCmd struct {
+	poolCmd
+	Label string `short:"l" long:"label" required:"1" description:"New label for the pool"`
+}
+
+// Execute is run when PoolRenameCmd subcommand is activated.
+func (cmd *PoolRenameCmd) Execute(_ []string) error {
+	if err := cmd.resolveID(); err != nil {
+		return err
+	}
+
+	// The label is applied as a pool property, which the Management
+	// Service checks for uniqueness and records before forwarding to
+	// the pool service.
+	req := &control.PoolSetPropReq{
+		ID:       cmd.UUID,
+		Property: "label",
+	}
+	req.SetString(cmd.Label)
+
+	ctx := context.Background()
+	resp, err := control.PoolSetProp(ctx, cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool rename failed")
+	}
+
+	cmd.log.Infof("pool %s renamed to %q", cmd.UUID, resp.Value)
+	return nil
+}
+
+// PoolGetPropCmd represents the command to get properties of a pool.
+type PoolGetPropCmd struct {
+	poolCmd
+	Args struct {
+		Props []string `positional-arg-name:"property"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when PoolGetPropCmd subcommand is activated.
+func (cmd *PoolGetPropCmd) Execute(_ []string) error {
+	if err := cmd.resolveID(); err != nil {
+		return err
+	}
+
+	req := &control.PoolGetPropReq{
+		ID:         cmd.UUID,
+		Properties: cmd.Args.Props,
+	}
+
+	ctx := context.Background()
+	resp, err := control.PoolGetProp(ctx, cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool get-prop failed")
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintPoolProperties(cmd.ID, &bld, resp.Properties...); err != nil {
+		return err
+	}
+	cmd.log.Info(bld.String())
+
+	return nil
+}
+
+// PoolApplyCmd represents the command to reconcile the pools in the system
+// with a pool manifest.
+type PoolApplyCmd struct {
+	logCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	File  string `short:"f" long:"file" required:"1" description:"YAML pool manifest file"`
+	Plan  bool   `long:"plan" description:"Report the actions that would be taken without modifying any pools"`
+	Prune bool   `long:"prune" description:"Destroy pools that are not in the manifest"`
+}
+
+// Execute is run when PoolApplyCmd subcommand is activated.
+func (cmd *PoolApplyCmd) Execute(_ []string) error {
+	manifest, err := control.ReadPoolManifest(cmd.File)
+	if err != nil {
+		return err
+	}
+
+	req := &control.PoolApplyReq{
+		Manifest: manifest,
+		Plan:     cmd.Plan,
+		Prune:    cmd.Prune,
+	}
+
+	ctx := context.Background()
+	resp, err := control.PoolApply(ctx, cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool apply failed")
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintPoolApplyResponse(resp, &bld); err != nil {
+		return err
+	}
+	cmd.log.Info(bld.String())
+
+	if failed := resp.Failed(); failed > 0 {
+		return errors.Errorf("pool apply failed: %d of %d actions failed", failed, len(resp.Actions))
+	}
+
+	return nil
+}
+
 // PoolGetACLCmd represents the command to fetch an Access Control List of a
 // DAOS pool.
 type PoolGetACLCmd struct {
@@ -531,7 +659,7 @@ func (cmd *PoolGetACLCmd) Execute(args []string) error {
 		return err
 	}
 
-	req := &control.PoolGetACLReq{UUID: cmd.UUID}
+	req := &control.PoolGetACLReq{ID: cmd.UUID}
 
 	ctx := context.Background()
 	resp, err := control.PoolGetACL(ctx, cmd.ctlInvoker, req)
@@ -612,8 +740,8 @@ func (cmd *PoolOverwriteACLCmd) Execute(args []string) error {
 	}
 
 	req := &control.PoolOverwriteACLReq{
-		UUID: cmd.UUID,
-		ACL:  acl,
+		ID:  cmd.UUID,
+		ACL: acl,
 	}
 
 	ctx := context.Background()
@@ -669,8 +797,8 @@ func (cmd *PoolUpdateACLCmd) Execute(args []string) error {
 	}
 
 	req := &control.PoolUpdateACLReq{
-		UUID: cmd.UUID,
-		ACL:  acl,
+		ID:  cmd.UUID,
+		ACL: acl,
 	}
 
 	ctx := context.Background()
@@ -705,7 +833,7 @@ func (cmd *PoolDeleteACLCmd) Execute(args []string) error {
 	}
 
 	req := &control.PoolDeleteACLReq{
-		UUID:      cmd.UUID,
+		ID:        cmd.UUID,
 		Principal: cmd.Principal,
 	}
 
@@ -104,6 +104,10 @@ func TestPoolCommands(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// A pool manifest for pool apply tests
+	testManifestFile := filepath.Join(tmpDir, "pools.yaml")
+	createTestFile(t, testManifestFile, "pools:\n- label: tank\n  size: 1TB\n  user: alice@\n  group: grp@\n")
+
 	runCmdTests(t, []cmdTest{
 		{
 			"Create pool with missing arguments",
@@ -170,6 +174,22 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Create pool dry run",
+			fmt.Sprintf("pool create --size %s --nranks 8 --dry-run", testScmSizeStr),
+			strings.Join([]string{
+				printRequest(t, createWithSystem(&control.PoolCreateReq{
+					TotalBytes: uint64(testScmSize),
+					ScmRatio:   0.06,
+					NumRanks:   8,
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					Ranks:      []system.Rank{},
+					DryRun:     true,
+				}, build.DefaultSystemName)),
+			}, " "),
+			nil,
+		},
 		{
 			"Create pool with all arguments",
 			fmt.Sprintf("pool create --scm-size %s --nsvc 3 --user foo --group bar --nvme-size %s --sys fnord --acl-file %s",
@@ -263,7 +283,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool exclude --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 0 --target-idx 1",
 			strings.Join([]string{
 				printRequest(t, &control.PoolExcludeReq{
-					UUID:      "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Rank:      0,
 					Targetidx: []uint32{1},
 				}),
@@ -275,7 +295,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool exclude --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 0 --target-idx 1,2,3",
 			strings.Join([]string{
 				printRequest(t, &control.PoolExcludeReq{
-					UUID:      "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Rank:      0,
 					Targetidx: []uint32{1, 2, 3},
 				}),
@@ -287,7 +307,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool exclude --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 0",
 			strings.Join([]string{
 				printRequest(t, &control.PoolExcludeReq{
-					UUID:      "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Rank:      0,
 					Targetidx: []uint32{},
 				}),
@@ -299,7 +319,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool drain --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 0 --target-idx 1",
 			strings.Join([]string{
 				printRequest(t, &control.PoolDrainReq{
-					UUID:      "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Rank:      0,
 					Targetidx: []uint32{1},
 				}),
@@ -311,7 +331,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool drain --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 0 --target-idx 1,2,3",
 			strings.Join([]string{
 				printRequest(t, &control.PoolDrainReq{
-					UUID:      "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Rank:      0,
 					Targetidx: []uint32{1, 2, 3},
 				}),
@@ -323,7 +343,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool drain --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 0",
 			strings.Join([]string{
 				printRequest(t, &control.PoolDrainReq{
-					UUID:      "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Rank:      0,
 					Targetidx: []uint32{},
 				}),
@@ -342,7 +362,7 @@ func TestPoolCommands(t *testing.T) {
 			fmt.Sprintf("pool extend --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --ranks=1 --scm-size %s", testScmSizeStr),
 			strings.Join([]string{
 				printRequest(t, &control.PoolExtendReq{
-					UUID:     "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:       "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Ranks:    []system.Rank{1},
 					ScmBytes: uint64(testScmSize),
 				}),
@@ -354,7 +374,7 @@ func TestPoolCommands(t *testing.T) {
 			fmt.Sprintf("pool extend --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --ranks=1,2,3 --scm-size %s", testScmSizeStr),
 			strings.Join([]string{
 				printRequest(t, &control.PoolExtendReq{
-					UUID:     "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:       "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Ranks:    []system.Rank{1, 2, 3},
 					ScmBytes: uint64(testScmSize),
 				}),
@@ -366,7 +386,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool reintegrate --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 0 --target-idx 1",
 			strings.Join([]string{
 				printRequest(t, &control.PoolReintegrateReq{
-					UUID:      "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Rank:      0,
 					Targetidx: []uint32{1},
 				}),
@@ -378,7 +398,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool reintegrate --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 0 --target-idx 1,2,3",
 			strings.Join([]string{
 				printRequest(t, &control.PoolReintegrateReq{
-					UUID:      "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Rank:      0,
 					Targetidx: []uint32{1, 2, 3},
 				}),
@@ -390,7 +410,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool reintegrate --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 0",
 			strings.Join([]string{
 				printRequest(t, &control.PoolReintegrateReq{
-					UUID:      "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Rank:      0,
 					Targetidx: []uint32{},
 				}),
@@ -402,7 +422,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool destroy --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --force",
 			strings.Join([]string{
 				printRequest(t, &control.PoolDestroyReq{
-					UUID:  "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:    "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Force: true,
 				}),
 			}, " "),
@@ -413,7 +433,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool evict --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 			strings.Join([]string{
 				printRequest(t, evictWithSystem(&control.PoolEvictReq{
-					UUID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 				}, build.DefaultSystemName)),
 			}, " "),
 			nil,
@@ -431,7 +451,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool set-prop --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --name reclaim --value lazy",
 			strings.Join([]string{
 				printRequest(t, &control.PoolSetPropReq{
-					UUID:     "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:       "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Property: "reclaim",
 					Value:    "lazy",
 				}),
@@ -443,7 +463,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool set-prop --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --name answer --value 42",
 			strings.Join([]string{
 				printRequest(t, &control.PoolSetPropReq{
-					UUID:     "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID:       "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 					Property: "answer",
 					Value:    42,
 				}),
@@ -456,12 +476,106 @@ func TestPoolCommands(t *testing.T) {
 			"",
 			errors.New("required flag"),
 		},
+		{
+			"Rename pool",
+			"pool rename --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --label new-label",
+			strings.Join([]string{
+				printRequest(t, &control.PoolSetPropReq{
+					ID:       "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Property: "label",
+					Value:    "new-label",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Rename pool by label",
+			"pool rename --pool test-label --label new-label",
+			strings.Join([]string{
+				printRequest(t, &control.PoolResolveIDReq{
+					HumanID: "test-label",
+				}),
+				printRequest(t, &control.PoolSetPropReq{
+					ID:       defaultPoolUUID,
+					Property: "label",
+					Value:    "new-label",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Rename pool missing label",
+			"pool rename --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			"",
+			errors.New("required flag"),
+		},
+		{
+			"Get all pool properties",
+			"pool get-prop --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			strings.Join([]string{
+				printRequest(t, &control.PoolGetPropReq{
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Get selected pool properties",
+			"pool get-prop --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb label space_rb",
+			strings.Join([]string{
+				printRequest(t, &control.PoolGetPropReq{
+					ID:         "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Properties: []string{"label", "space_rb"},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Get pool properties missing pool",
+			"pool get-prop label",
+			"",
+			errors.New("required flag"),
+		},
+		{
+			"Apply pool manifest",
+			fmt.Sprintf("pool apply -f %s", testManifestFile),
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{}),
+				printRequest(t, &control.PoolCreateReq{
+					Name:       "tank",
+					User:       "alice@",
+					UserGroup:  "grp@",
+					TotalBytes: 1000000000000,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Plan pool manifest",
+			fmt.Sprintf("pool apply --plan -f %s", testManifestFile),
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"Apply missing pool manifest",
+			fmt.Sprintf("pool apply -f %s", filepath.Join(tmpDir, "missing.yaml")),
+			"",
+			errors.New("failed to read pool manifest"),
+		},
+		{
+			"Apply pool manifest without file",
+			"pool apply --prune",
+			"",
+			errors.New("required flag"),
+		},
 		{
 			"Get pool ACL",
 			"pool get-acl --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 			strings.Join([]string{
 				printRequest(t, &control.PoolGetACLReq{
-					UUID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 				}),
 			}, " "),
 			nil,
@@ -471,7 +585,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool get-acl --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --verbose",
 			strings.Join([]string{
 				printRequest(t, &control.PoolGetACLReq{
-					UUID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 				}),
 			}, " "),
 			nil,
@@ -481,7 +595,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool get-acl --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --outfile /foo/bar/acl.txt",
 			strings.Join([]string{
 				printRequest(t, &control.PoolGetACLReq{
-					UUID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 				}),
 			}, " "),
 			errors.New("open /foo/bar/acl.txt: no such file or directory"),
@@ -491,7 +605,7 @@ func TestPoolCommands(t *testing.T) {
 			fmt.Sprintf("pool get-acl --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --outfile %s", testExistingFile),
 			strings.Join([]string{
 				printRequest(t, &control.PoolGetACLReq{
-					UUID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 				}),
 			}, " "),
 			errors.New(fmt.Sprintf("file already exists: %s", testExistingFile)),
@@ -501,7 +615,7 @@ func TestPoolCommands(t *testing.T) {
 			fmt.Sprintf("pool get-acl --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --outfile %s", testWriteOnlyFile),
 			strings.Join([]string{
 				printRequest(t, &control.PoolGetACLReq{
-					UUID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 				}),
 			}, " "),
 			errors.New(fmt.Sprintf("file already exists: %s", testWriteOnlyFile)),
@@ -511,7 +625,7 @@ func TestPoolCommands(t *testing.T) {
 			fmt.Sprintf("pool get-acl --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --outfile %s --force", testExistingFile),
 			strings.Join([]string{
 				printRequest(t, &control.PoolGetACLReq{
-					UUID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 				}),
 			}, " "),
 			nil,
@@ -521,7 +635,7 @@ func TestPoolCommands(t *testing.T) {
 			fmt.Sprintf("pool get-acl --pool 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --outfile %s", filepath.Join(testNoPermDir, "out.txt")),
 			strings.Join([]string{
 				printRequest(t, &control.PoolGetACLReq{
-					UUID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 				}),
 			}, " "),
 			errors.New(fmt.Sprintf("open %s: permission denied", filepath.Join(testNoPermDir, "out.txt"))),
@@ -543,8 +657,8 @@ func TestPoolCommands(t *testing.T) {
 			fmt.Sprintf("pool overwrite-acl --pool 12345678-1234-1234-1234-1234567890ab --acl-file %s", testACLFile),
 			strings.Join([]string{
 				printRequest(t, &control.PoolOverwriteACLReq{
-					UUID: "12345678-1234-1234-1234-1234567890ab",
-					ACL:  testACL,
+					ID:  "12345678-1234-1234-1234-1234567890ab",
+					ACL: testACL,
 				}),
 			}, " "),
 			nil,
@@ -578,8 +692,8 @@ func TestPoolCommands(t *testing.T) {
 			fmt.Sprintf("pool update-acl --pool 12345678-1234-1234-1234-1234567890ab --acl-file %s", testACLFile),
 			strings.Join([]string{
 				printRequest(t, &control.PoolUpdateACLReq{
-					UUID: "12345678-1234-1234-1234-1234567890ab",
-					ACL:  testACL,
+					ID:  "12345678-1234-1234-1234-1234567890ab",
+					ACL: testACL,
 				}),
 			}, " "),
 			nil,
@@ -589,8 +703,8 @@ func TestPoolCommands(t *testing.T) {
 			"pool update-acl --pool 12345678-1234-1234-1234-1234567890ab --entry A::user@:rw",
 			strings.Join([]string{
 				printRequest(t, &control.PoolUpdateACLReq{
-					UUID: "12345678-1234-1234-1234-1234567890ab",
-					ACL:  &control.AccessControlList{Entries: []string{"A::user@:rw"}},
+					ID:  "12345678-1234-1234-1234-1234567890ab",
+					ACL: &control.AccessControlList{Entries: []string{"A::user@:rw"}},
 				}),
 			}, " "),
 			nil,
@@ -606,7 +720,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool delete-acl --pool 12345678-1234-1234-1234-1234567890ab --principal OWNER@",
 			strings.Join([]string{
 				printRequest(t, &control.PoolDeleteACLReq{
-					UUID:      "12345678-1234-1234-1234-1234567890ab",
+					ID:        "12345678-1234-1234-1234-1234567890ab",
 					Principal: "OWNER@",
 				}),
 			}, " "),
@@ -617,7 +731,7 @@ func TestPoolCommands(t *testing.T) {
 			"pool query --pool 12345678-1234-1234-1234-1234567890ab",
 			strings.Join([]string{
 				printRequest(t, &control.PoolQueryReq{
-					UUID: "12345678-1234-1234-1234-1234567890ab",
+					ID: "12345678-1234-1234-1234-1234567890ab",
 				}),
 			}, " "),
 			nil,
@@ -630,7 +744,7 @@ func TestPoolCommands(t *testing.T) {
 					HumanID: "test-label",
 				}),
 				printRequest(t, &control.PoolQueryReq{
-					UUID: defaultPoolUUID,
+					ID: defaultPoolUUID,
 				}),
 			}, " "),
 			nil,
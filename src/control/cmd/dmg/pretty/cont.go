@@ -0,0 +1,111 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/lib/txtfmt"
+)
+
+// PrintContainerList generates a human-readable representation of the
+// containers in a pool, including their usage, and writes it to the supplied
+// io.Writer.
+func PrintContainerList(poolID string, out io.Writer, conts ...*control.ContQueryResp) error {
+	w := txtfmt.NewErrWriter(out)
+
+	if len(conts) == 0 {
+		fmt.Fprintf(w, "No containers in pool %s\n", poolID)
+		return w.Err
+	}
+
+	uuidTitle := "UUID"
+	labelTitle := "Label"
+	objTitle := "Objects"
+	hdlTitle := "Open Handles"
+
+	formatter := txtfmt.NewTableFormatter(uuidTitle, labelTitle, objTitle, hdlTitle)
+	var table []txtfmt.TableRow
+
+	for _, cont := range conts {
+		if cont == nil {
+			continue
+		}
+		label := cont.Label
+		if label == "" {
+			label = "-"
+		}
+		table = append(table, txtfmt.TableRow{
+			uuidTitle:  cont.ContUUID,
+			labelTitle: label,
+			objTitle:   fmt.Sprintf("%d", cont.NumObjects),
+			hdlTitle:   fmt.Sprintf("%d", cont.NumHandles),
+		})
+	}
+
+	fmt.Fprint(w, formatter.Format(table))
+	return w.Err
+}
+
+// PrintContQueryResponse generates a human-readable representation of the
+// supplied ContQueryResp struct and writes it to the supplied io.Writer.
+func PrintContQueryResponse(resp *control.ContQueryResp, out io.Writer) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	rows := []txtfmt.TableRow{
+		{"Pool UUID": resp.PoolUUID},
+		{"Label": resp.Label},
+		{"Owner": resp.Owner},
+		{"Group": resp.Group},
+		{"Objects": fmt.Sprintf("%d", resp.NumObjects)},
+		{"Open Handles": fmt.Sprintf("%d", resp.NumHandles)},
+	}
+	_, err := fmt.Fprintln(out, txtfmt.FormatEntity("Container "+resp.ContUUID, rows))
+
+	return err
+}
+
+// PrintContProperties generates a human-readable representation of the supplied
+// container properties and writes it to the supplied io.Writer.
+func PrintContProperties(contID string, out io.Writer, properties ...*control.ContProperty) error {
+	w := txtfmt.NewErrWriter(out)
+
+	fmt.Fprintf(w, "Container %s properties:\n", contID)
+	if len(properties) == 0 {
+		fmt.Fprintln(w, "  No properties found")
+		return w.Err
+	}
+
+	nameTitle := "Name"
+	valueTitle := "Value"
+
+	formatter := txtfmt.NewTableFormatter(nameTitle, valueTitle)
+	var table []txtfmt.TableRow
+
+	for _, prop := range properties {
+		if prop == nil {
+			continue
+		}
+		value := prop.Value
+		if value == "" {
+			value = "not set"
+		}
+		table = append(table, txtfmt.TableRow{
+			nameTitle:  prop.Name,
+			valueTitle: value,
+		})
+	}
+
+	fmt.Fprint(w, formatter.Format(table))
+	return w.Err
+}
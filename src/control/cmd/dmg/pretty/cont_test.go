@@ -0,0 +1,148 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/lib/control"
+)
+
+func TestPretty_PrintContainerList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		conts       []*control.ContQueryResp
+		expPrintStr string
+	}{
+		"no containers": {
+			expPrintStr: `
+No containers in pool foo
+`,
+		},
+		"containers": {
+			conts: []*control.ContQueryResp{
+				{
+					ContUUID:   common.MockUUID(1),
+					Label:      "mycont",
+					NumObjects: 42,
+					NumHandles: 1,
+				},
+				{
+					ContUUID: common.MockUUID(2),
+				},
+			},
+			expPrintStr: `
+UUID                                 Label  Objects Open Handles 
+----                                 -----  ------- ------------ 
+11111111-1111-1111-1111-111111111111 mycont 42      1            
+22222222-2222-2222-2222-222222222222 -      0       0            
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintContainerList("foo", &bld, tc.conts...); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintContQueryResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.ContQueryResp
+		expPrintStr string
+		expErr      error
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"normal response": {
+			resp: &control.ContQueryResp{
+				PoolUUID:   common.MockUUID(0),
+				ContUUID:   common.MockUUID(1),
+				Label:      "mycont",
+				Owner:      "alice@",
+				Group:      "admins@",
+				NumObjects: 42,
+				NumHandles: 1,
+			},
+			expPrintStr: `
+Container 11111111-1111-1111-1111-111111111111
+----------------------------------------------
+  Pool UUID    : 00000000-0000-0000-0000-000000000000
+  Label        : mycont                              
+  Owner        : alice@                              
+  Group        : admins@                             
+  Objects      : 42                                  
+  Open Handles : 1                                   
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintContQueryResponse(tc.resp, &bld)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintContProperties(t *testing.T) {
+	for name, tc := range map[string]struct {
+		props       []*control.ContProperty
+		expPrintStr string
+	}{
+		"empty response": {
+			expPrintStr: `
+Container bar properties:
+  No properties found
+`,
+		},
+		"properties": {
+			props: []*control.ContProperty{
+				{Name: "label", Value: "mycont"},
+				{Name: "cksum", Value: "crc32"},
+				{Name: "owner"},
+			},
+			expPrintStr: `
+Container bar properties:
+Name  Value   
+----  -----   
+label mycont  
+cksum crc32   
+owner not set 
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintContProperties("bar", &bld, tc.props...); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dustin/go-humanize/english"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/lib/txtfmt"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+// PrintListEventsResponse generates a human-readable representation of the
+// supplied ListEventsResp struct and writes it to the supplied io.Writer.
+func PrintListEventsResponse(resp *control.ListEventsResp, out io.Writer, opts ...PrintConfigOption) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	if len(resp.Entries) == 0 {
+		_, err := fmt.Fprintln(out, "No events found")
+		return err
+	}
+
+	fc := getPrintConfig(opts...)
+
+	seqTitle := "Seq"
+	timeTitle := "Time"
+	idTitle := "Event"
+	sevTitle := "Severity"
+	hostTitle := "Host"
+	rankTitle := "Rank"
+	poolTitle := "Pool UUID"
+	msgTitle := "Message"
+
+	titles := []string{seqTitle, timeTitle, idTitle, sevTitle, hostTitle, rankTitle}
+	if fc.Verbose {
+		titles = append(titles, poolTitle)
+	}
+	titles = append(titles, msgTitle)
+
+	formatter := txtfmt.NewTableFormatter(titles...)
+	var table []txtfmt.TableRow
+
+	for _, entry := range resp.Entries {
+		evt := entry.Event
+		if evt == nil {
+			return errors.Errorf("nil event in entry %d", entry.Sequence)
+		}
+
+		row := txtfmt.TableRow{
+			seqTitle:  fmt.Sprintf("%d", entry.Sequence),
+			timeTitle: common.FormatTimeNoMicro(entry.Time),
+			idTitle:   evt.ID.String(),
+			sevTitle:  evt.Severity.String(),
+			hostTitle: evt.Hostname,
+			rankTitle: "-",
+			poolTitle: evt.PoolUUID,
+			msgTitle:  evt.Msg,
+		}
+		if system.Rank(evt.Rank) != system.NilRank {
+			row[rankTitle] = fmt.Sprintf("%d", evt.Rank)
+		}
+
+		table = append(table, row)
+	}
+
+	_, err := fmt.Fprint(out, formatter.Format(table))
+	return err
+}
+
+// PrintStreamedEvent generates a single-line human-readable representation
+// of the supplied StreamedEvent and writes it to the supplied io.Writer. If
+// any events were dropped before this one was received, a notice is written
+// first.
+func PrintStreamedEvent(se *control.StreamedEvent, out io.Writer, opts ...PrintConfigOption) error {
+	if se == nil || se.Event == nil {
+		return errors.Errorf("nil %T", se)
+	}
+
+	fc := getPrintConfig(opts...)
+	evt := se.Event
+
+	if se.Dropped > 0 {
+		if _, err := fmt.Fprintf(out, "(%s dropped)\n",
+			english.Plural(int(se.Dropped), "event", "events")); err != nil {
+			return err
+		}
+	}
+
+	rank := "-"
+	if system.Rank(evt.Rank) != system.NilRank {
+		rank = fmt.Sprintf("%d", evt.Rank)
+	}
+
+	var pool string
+	if fc.Verbose && evt.PoolUUID != "" {
+		pool = " pool=" + evt.PoolUUID
+	}
+
+	_, err := fmt.Fprintf(out, "%s %s %s host=%s rank=%s%s: %s\n",
+		common.FormatTimeNoMicro(se.Time), evt.ID, evt.Severity,
+		evt.Hostname, rank, pool, evt.Msg)
+	return err
+}
@@ -0,0 +1,159 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/events"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+func TestPretty_PrintListEventsResp(t *testing.T) {
+	recorded := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockEntries := []*control.EventLogEntry{
+		{
+			Sequence: 1,
+			Time:     recorded,
+			Event:    events.NewRankDownEvent("foo", 0, 1, common.ExitStatus("test")),
+		},
+		{
+			Sequence: 2,
+			Time:     recorded.Add(time.Minute),
+			Event: events.New(&events.RASEvent{
+				ID:       events.RASSystemStop,
+				Msg:      "System stop",
+				Hostname: "bar",
+				Rank:     uint32(system.NilRank),
+				PoolUUID: common.MockUUID(),
+			}),
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.ListEventsResp
+		verbose     bool
+		expErr      error
+		expPrintStr string
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"no entries": {
+			resp: &control.ListEventsResp{},
+			expPrintStr: `
+No events found
+`,
+		},
+		"entries": {
+			resp: &control.ListEventsResp{Entries: mockEntries},
+			expPrintStr: `
+Seq Time                 Event              Severity Host Rank Message                       
+--- ----                 -----              -------- ---- ---- -------                       
+1   2021-03-01T12:00:00Z engine_status_down ERROR    foo  1    DAOS rank exited unexpectedly 
+2   2021-03-01T12:01:00Z system_action_stop INFO     bar  -    System stop                   
+`,
+		},
+		"verbose": {
+			resp:    &control.ListEventsResp{Entries: mockEntries},
+			verbose: true,
+			expPrintStr: `
+Seq Time                 Event              Severity Host Rank Pool UUID                            Message                       
+--- ----                 -----              -------- ---- ---- ---------                            -------                       
+1   2021-03-01T12:00:00Z engine_status_down ERROR    foo  1                                         DAOS rank exited unexpectedly 
+2   2021-03-01T12:01:00Z system_action_stop INFO     bar  -    11111111-1111-1111-1111-111111111111 System stop                   
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			err := PrintListEventsResponse(tc.resp, &bld, PrintWithVerboseOutput(tc.verbose))
+			common.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintStreamedEvent(t *testing.T) {
+	received := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	psrUpdate := events.NewPoolSvcReplicasUpdateEvent("foo", 1, common.MockUUID(), []uint32{0, 1}, 1)
+	psrUpdate.Msg = "replicas updated"
+	sysStop := events.New(&events.RASEvent{
+		ID:       events.RASSystemStop,
+		Severity: events.RASSeverityInfo,
+		Msg:      "System stop",
+		Hostname: "bar",
+		Rank:     uint32(system.NilRank),
+	})
+
+	for name, tc := range map[string]struct {
+		event       *control.StreamedEvent
+		verbose     bool
+		expErr      error
+		expPrintStr string
+	}{
+		"nil event": {
+			expErr: errors.New("nil"),
+		},
+		"no rank": {
+			event: &control.StreamedEvent{
+				Time:  received,
+				Event: sysStop,
+			},
+			expPrintStr: `
+2021-03-01T12:00:00Z system_action_stop INFO host=bar rank=-: System stop
+`,
+		},
+		"dropped events": {
+			event: &control.StreamedEvent{
+				Time:    received,
+				Event:   psrUpdate,
+				Dropped: 2,
+			},
+			expPrintStr: `
+(2 events dropped)
+2021-03-01T12:00:00Z pool_replicas_updated ERROR host=foo rank=1: replicas updated
+`,
+		},
+		"verbose": {
+			event: &control.StreamedEvent{
+				Time:  received,
+				Event: psrUpdate,
+			},
+			verbose: true,
+			expPrintStr: `
+2021-03-01T12:00:00Z pool_replicas_updated ERROR host=foo rank=1 pool=11111111-1111-1111-1111-111111111111: replicas updated
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			err := PrintStreamedEvent(tc.event, &bld, PrintWithVerboseOutput(tc.verbose))
+			common.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
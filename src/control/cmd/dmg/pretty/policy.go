@@ -0,0 +1,49 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/lib/txtfmt"
+)
+
+// PrintSystemSetPolicyResponse generates a human-readable representation of
+// the supplied SystemSetPolicyResp struct and writes it to the supplied
+// io.Writer.
+func PrintSystemSetPolicyResponse(resp *control.SystemSetPolicyResp, out io.Writer) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	state := "disabled in server config"
+	if resp.AutoExcludeEnabled {
+		state = "active"
+		if resp.AutoExcludePaused {
+			state = "paused"
+		}
+	}
+
+	pending := resp.AutoExcludePending
+	if pending == "" {
+		pending = "none"
+	}
+
+	ew := txtfmt.NewErrWriter(out)
+	fmt.Fprintln(ew, "Automatic pool exclusion policy:")
+	iw := txtfmt.NewIndentWriter(ew)
+	fmt.Fprintf(iw, "State: %s\n", state)
+	fmt.Fprintf(iw, "Grace period: %s\n", time.Duration(resp.AutoExcludeGracePeriod)*time.Second)
+	fmt.Fprintf(iw, "Pending ranks: %s\n", pending)
+
+	return ew.Err
+}
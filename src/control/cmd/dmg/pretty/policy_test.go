@@ -0,0 +1,80 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/lib/control"
+)
+
+func TestPretty_PrintSystemSetPolicyResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.SystemSetPolicyResp
+		expErr      error
+		expPrintStr string
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"disabled": {
+			resp: &control.SystemSetPolicyResp{
+				AutoExcludePaused: true,
+			},
+			expPrintStr: `
+Automatic pool exclusion policy:
+  State: disabled in server config
+  Grace period: 0s
+  Pending ranks: none
+`,
+		},
+		"paused": {
+			resp: &control.SystemSetPolicyResp{
+				AutoExcludeEnabled:     true,
+				AutoExcludePaused:      true,
+				AutoExcludeGracePeriod: 300,
+			},
+			expPrintStr: `
+Automatic pool exclusion policy:
+  State: paused
+  Grace period: 5m0s
+  Pending ranks: none
+`,
+		},
+		"active with pending ranks": {
+			resp: &control.SystemSetPolicyResp{
+				AutoExcludeEnabled:     true,
+				AutoExcludeGracePeriod: 600,
+				AutoExcludePending:     "[1-2]",
+			},
+			expPrintStr: `
+Automatic pool exclusion policy:
+  State: active
+  Grace period: 10m0s
+  Pending ranks: [1-2]
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			err := PrintSystemSetPolicyResponse(tc.resp, &bld)
+			common.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
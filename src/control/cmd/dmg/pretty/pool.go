@@ -74,14 +74,113 @@ func PrintPoolCreateResponse(pcr *control.PoolCreateResp, out io.Writer, opts ..
 
 	numRanks := uint64(len(pcr.TgtRanks))
 	title := fmt.Sprintf("Pool created with %0.2f%%%% SCM/NVMe ratio", ratio*100)
-	_, err := fmt.Fprintln(out, txtfmt.FormatEntity(title, []txtfmt.TableRow{
-		{"UUID": pcr.UUID},
+	rows := []txtfmt.TableRow{{"UUID": pcr.UUID}}
+	if pcr.DryRun {
+		title = fmt.Sprintf("Pool create plan (dry run) with %0.2f%%%% SCM/NVMe ratio", ratio*100)
+		rows = nil
+	}
+	rows = append(rows, []txtfmt.TableRow{
 		{"Service Ranks": FormatRanks(pcr.SvcReps)},
 		{"Storage Ranks": FormatRanks(pcr.TgtRanks)},
 		{"Total Size": humanize.Bytes((pcr.ScmBytes + pcr.NvmeBytes) * numRanks)},
 		{"SCM": fmt.Sprintf("%s (%s / rank)", humanize.Bytes(pcr.ScmBytes*numRanks), humanize.Bytes(pcr.ScmBytes))},
 		{"NVMe": fmt.Sprintf("%s (%s / rank)", humanize.Bytes(pcr.NvmeBytes*numRanks), humanize.Bytes(pcr.NvmeBytes))},
-	}))
+	}...)
+	_, err := fmt.Fprintln(out, txtfmt.FormatEntity(title, rows))
 
 	return err
 }
+
+// PrintPoolProperties generates a human-readable representation of the supplied
+// pool properties and writes it to the supplied io.Writer.
+func PrintPoolProperties(poolID string, out io.Writer, properties ...*control.PoolProperty) error {
+	w := txtfmt.NewErrWriter(out)
+
+	fmt.Fprintf(w, "Pool %s properties:\n", poolID)
+	if len(properties) == 0 {
+		fmt.Fprintln(w, "  No properties found")
+		return w.Err
+	}
+
+	nameTitle := "Name"
+	valueTitle := "Value"
+
+	formatter := txtfmt.NewTableFormatter(nameTitle, valueTitle)
+	var table []txtfmt.TableRow
+
+	for _, prop := range properties {
+		if prop == nil {
+			continue
+		}
+		value := prop.Value
+		if value == "" {
+			value = "not set"
+		}
+		table = append(table, txtfmt.TableRow{
+			nameTitle:  prop.Name,
+			valueTitle: value,
+		})
+	}
+
+	fmt.Fprint(w, formatter.Format(table))
+	return w.Err
+}
+
+// PrintPoolApplyResponse generates a human-readable representation of the
+// actions taken (or planned) by a pool apply request and writes it to the
+// supplied io.Writer.
+func PrintPoolApplyResponse(resp *control.PoolApplyResp, out io.Writer) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+	w := txtfmt.NewErrWriter(out)
+
+	if len(resp.Actions) == 0 {
+		fmt.Fprintln(w, "No pools in manifest or system")
+		return w.Err
+	}
+
+	poolTitle := "Pool"
+	actionTitle := "Action"
+	detailTitle := "Detail"
+	resultTitle := "Result"
+
+	formatter := txtfmt.NewTableFormatter(poolTitle, actionTitle, detailTitle, resultTitle)
+	var table []txtfmt.TableRow
+
+	for _, action := range resp.Actions {
+		pool := action.Label
+		if pool == "" {
+			pool = action.UUID
+		}
+
+		var result string
+		switch {
+		case action.Error != "":
+			result = "failed: " + action.Error
+		case action.Action == control.PoolApplyDrift ||
+			action.Action == control.PoolApplyUnmanaged ||
+			action.Action == control.PoolApplyUnchanged:
+			result = "-"
+		case resp.Plan:
+			result = "planned"
+		default:
+			result = "done"
+		}
+
+		detail := action.Detail
+		if detail == "" {
+			detail = "-"
+		}
+
+		table = append(table, txtfmt.TableRow{
+			poolTitle:   pool,
+			actionTitle: action.Action,
+			detailTitle: detail,
+			resultTitle: result,
+		})
+	}
+
+	fmt.Fprint(w, formatter.Format(table))
+	return w.Err
+}
@@ -177,6 +177,26 @@ Pool created with 100.00%%%% SCM/NVMe ratio
 
 `, common.MockUUID()),
 		},
+		"dry run": {
+			pcr: &control.PoolCreateResp{
+				UUID:      common.MockUUID(),
+				SvcReps:   mockRanks(1, 2, 3),
+				TgtRanks:  mockRanks(0, 1, 2, 3),
+				ScmBytes:  600 * humanize.MByte,
+				NvmeBytes: 10 * humanize.GByte,
+				DryRun:    true,
+			},
+			expPrintStr: `
+Pool create plan (dry run) with 6.00%% SCM/NVMe ratio
+-----------------------------------------------------
+  Service Ranks : [1-3]                 
+  Storage Ranks : [0-3]                 
+  Total Size    : 42 GB                 
+  SCM           : 2.4 GB (600 MB / rank)
+  NVMe          : 40 GB (10 GB / rank)  
+
+`,
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			var bld strings.Builder
@@ -192,3 +212,111 @@ Pool created with 100.00%%%% SCM/NVMe ratio
 		})
 	}
 }
+
+func TestPretty_PrintPoolProperties(t *testing.T) {
+	for name, tc := range map[string]struct {
+		props       []*control.PoolProperty
+		expPrintStr string
+	}{
+		"empty response": {
+			expPrintStr: `
+Pool foo properties:
+  No properties found
+`,
+		},
+		"properties": {
+			props: []*control.PoolProperty{
+				{Name: "label", Value: "foo"},
+				{Name: "reclaim", Value: "lazy"},
+				{Name: "space_rb", Value: "5%", NumValue: 5},
+				{Name: "self_heal", Value: "exclude,rebuild"},
+				{Name: "owner"},
+			},
+			expPrintStr: `
+Pool foo properties:
+Name      Value           
+----      -----           
+label     foo             
+reclaim   lazy            
+space_rb  5%              
+self_heal exclude,rebuild 
+owner     not set         
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintPoolProperties("foo", &bld, tc.props...); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolApplyResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.PoolApplyResp
+		expPrintStr string
+		expErr      error
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"no actions": {
+			resp: &control.PoolApplyResp{},
+			expPrintStr: `
+No pools in manifest or system
+`,
+		},
+		"plan": {
+			resp: &control.PoolApplyResp{
+				Plan: true,
+				Actions: []*control.PoolApplyAction{
+					{Label: "a", Action: control.PoolApplyUnchanged},
+					{Label: "b", Action: control.PoolApplyCreate, Detail: "1.0 TB"},
+					{UUID: "00000000-0000-0000-0000-0000000000ff", Action: control.PoolApplyUnmanaged, Detail: "not in manifest"},
+				},
+			},
+			expPrintStr: `
+Pool                                 Action    Detail          Result  
+----                                 ------    ------          ------  
+a                                    unchanged -               -       
+b                                    create    1.0 TB          planned 
+00000000-0000-0000-0000-0000000000ff unmanaged not in manifest -       
+`,
+		},
+		"apply": {
+			resp: &control.PoolApplyResp{
+				Actions: []*control.PoolApplyAction{
+					{Label: "a", Action: control.PoolApplySetProp, Detail: "reclaim=time"},
+					{Label: "b", Action: control.PoolApplyUpdateACL, Detail: "2 entries", Error: "denied"},
+					{Label: "b", Action: control.PoolApplyDrift, Detail: "size is 1.0 GB, manifest has 2.0 GB"},
+				},
+			},
+			expPrintStr: `
+Pool Action     Detail                              Result         
+---- ------     ------                              ------         
+a    set-prop   reclaim=time                        done           
+b    update-acl 2 entries                           failed: denied 
+b    drift      size is 1.0 GB, manifest has 2.0 GB -              
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintPoolApplyResponse(tc.resp, &bld)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
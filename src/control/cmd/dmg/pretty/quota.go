@@ -0,0 +1,66 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/lib/txtfmt"
+)
+
+// PrintQuotaResponse generates a human-readable representation of the
+// supplied QuotaResp struct and writes it to the supplied io.Writer.
+func PrintQuotaResponse(resp *control.QuotaResp, out io.Writer) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	if len(resp.Quotas) == 0 {
+		_, err := fmt.Fprintln(out, "No quotas set")
+		return err
+	}
+
+	typeTitle := "Type"
+	nameTitle := "Name"
+	limitTitle := "Limit"
+	scmTitle := "SCM Used"
+	nvmeTitle := "NVMe Used"
+	usedTitle := "Total Used"
+	poolsTitle := "Pools"
+
+	formatter := txtfmt.NewTableFormatter(typeTitle, nameTitle, limitTitle,
+		scmTitle, nvmeTitle, usedTitle, poolsTitle)
+	var table []txtfmt.TableRow
+
+	for _, q := range resp.Quotas {
+		row := txtfmt.TableRow{
+			typeTitle:  "user",
+			nameTitle:  q.User,
+			limitTitle: "none",
+			scmTitle:   humanize.Bytes(q.ScmUsed),
+			nvmeTitle:  humanize.Bytes(q.NvmeUsed),
+			usedTitle:  humanize.Bytes(q.Used()),
+			poolsTitle: fmt.Sprintf("%d", q.Pools),
+		}
+		if q.Group != "" {
+			row[typeTitle] = "group"
+			row[nameTitle] = q.Group
+		}
+		if q.Limit > 0 {
+			row[limitTitle] = humanize.Bytes(q.Limit)
+		}
+		table = append(table, row)
+	}
+
+	_, err := fmt.Fprint(out, formatter.Format(table))
+	return err
+}
@@ -0,0 +1,75 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/lib/control"
+)
+
+func TestPretty_PrintQuotaResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.QuotaResp
+		expErr      error
+		expPrintStr string
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"no quotas": {
+			resp: &control.QuotaResp{},
+			expPrintStr: `
+No quotas set
+`,
+		},
+		"user and group": {
+			resp: &control.QuotaResp{
+				Quotas: []*control.Quota{
+					{
+						User:     "foo@",
+						Limit:    100 * humanize.GByte,
+						Pools:    1,
+						ScmUsed:  2 * humanize.GByte,
+						NvmeUsed: 20 * humanize.GByte,
+					},
+					{
+						Group:    "grp@",
+						Pools:    2,
+						ScmUsed:  4 * humanize.GByte,
+						NvmeUsed: 40 * humanize.GByte,
+					},
+				},
+			},
+			expPrintStr: `
+Type  Name Limit  SCM Used NVMe Used Total Used Pools 
+----  ---- -----  -------- --------- ---------- ----- 
+user  foo@ 100 GB 2.0 GB   20 GB     22 GB      1     
+group grp@ none   4.0 GB   40 GB     44 GB      2     
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintQuotaResponse(tc.resp, &bld)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/lib/txtfmt"
+)
+
+// PrintSystemRestartProgress generates a human-readable representation of
+// the supplied SystemRestartProgress struct and writes it to the supplied
+// io.Writer.
+func PrintSystemRestartProgress(srp *control.SystemRestartProgress, out io.Writer) error {
+	if srp == nil {
+		return errors.Errorf("nil %T", srp)
+	}
+
+	ew := txtfmt.NewErrWriter(out)
+
+	step := fmt.Sprintf("Step %d/%d", srp.Step, srp.NumSteps)
+	if srp.Domain != "" {
+		step += fmt.Sprintf(" (%s)", srp.Domain)
+	}
+
+	remaining := srp.Remaining
+	if remaining == "" {
+		remaining = "none"
+	}
+
+	switch {
+	case srp.IsAborted():
+		completed := srp.Completed
+		if completed == "" {
+			completed = "none"
+		}
+		fmt.Fprintf(ew, "%s: restart of ranks %s aborted: %s\n", step, srp.Ranks, srp.Error)
+		iw := txtfmt.NewIndentWriter(ew)
+		fmt.Fprintf(iw, "Restarted ranks: %s\n", completed)
+		fmt.Fprintf(iw, "Remaining ranks: %s\n", remaining)
+	case srp.Phase == "done":
+		fmt.Fprintf(ew, "%s: restart of ranks %s complete (remaining: %s)\n", step, srp.Ranks, remaining)
+	default:
+		fmt.Fprintf(ew, "%s: ranks %s %s\n", step, srp.Ranks, srp.Phase)
+	}
+
+	iw := txtfmt.NewIndentWriter(ew)
+	for _, result := range srp.Results {
+		if !result.Errored {
+			continue
+		}
+		fmt.Fprintf(iw, "rank %d: %s\n", result.Rank, result.Msg)
+	}
+
+	return ew.Err
+}
@@ -0,0 +1,96 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+func TestPretty_PrintSystemRestartProgress(t *testing.T) {
+	for name, tc := range map[string]struct {
+		progress    *control.SystemRestartProgress
+		expErr      error
+		expPrintStr string
+	}{
+		"nil progress": {
+			expErr: errors.New("nil"),
+		},
+		"phase": {
+			progress: &control.SystemRestartProgress{
+				Step:      1,
+				NumSteps:  2,
+				Domain:    "/rack0/host1",
+				Ranks:     "0-1",
+				Phase:     "stop",
+				Remaining: "0-3",
+				Results: system.MemberResults{
+					{Rank: 0, Action: "stop", State: system.MemberStateStopped},
+					{Rank: 1, Action: "stop", State: system.MemberStateStopped},
+				},
+			},
+			expPrintStr: `
+Step 1/2 (/rack0/host1): ranks 0-1 stop
+`,
+		},
+		"step done": {
+			progress: &control.SystemRestartProgress{
+				Step:      2,
+				NumSteps:  2,
+				Ranks:     "2-3",
+				Phase:     "done",
+				Completed: "0-3",
+			},
+			expPrintStr: `
+Step 2/2: restart of ranks 2-3 complete (remaining: none)
+`,
+		},
+		"aborted": {
+			progress: &control.SystemRestartProgress{
+				Step:      2,
+				NumSteps:  2,
+				Domain:    "/rack0/host2",
+				Ranks:     "2-3",
+				Phase:     "aborted",
+				Completed: "0-1",
+				Remaining: "2-3",
+				Error:     "start failed on one or more ranks",
+				Results: system.MemberResults{
+					{Rank: 2, Action: "start", State: system.MemberStateErrored,
+						Errored: true, Msg: "engine failed"},
+					{Rank: 3, Action: "start", State: system.MemberStateReady},
+				},
+			},
+			expPrintStr: `
+Step 2/2 (/rack0/host2): restart of ranks 2-3 aborted: start failed on one or more ranks
+  Restarted ranks: 0-1
+  Remaining ranks: 2-3
+  rank 2: engine failed
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintSystemRestartProgress(tc.progress, &bld)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/lib/txtfmt"
+)
+
+// PrintHostConfigChanges generates a human-readable representation of the
+// server config file changes found on each host during a reload and writes it
+// to the supplied io.Writer.
+func PrintHostConfigChanges(changes []*control.HostConfigChanges, out io.Writer) error {
+	w := txtfmt.NewErrWriter(out)
+
+	if len(changes) == 0 {
+		return w.Err
+	}
+
+	hostTitle := "Host"
+	appliedTitle := "Applied"
+	restartTitle := "Restart Required"
+
+	formatter := txtfmt.NewTableFormatter(hostTitle, appliedTitle, restartTitle)
+	var table []txtfmt.TableRow
+
+	joinOrNone := func(params []string) string {
+		if len(params) == 0 {
+			return "-"
+		}
+		return strings.Join(params, ",")
+	}
+
+	for _, hc := range changes {
+		if hc == nil {
+			continue
+		}
+		table = append(table, txtfmt.TableRow{
+			hostTitle:    hc.Addr,
+			appliedTitle: joinOrNone(hc.Applied),
+			restartTitle: joinOrNone(hc.RestartRequired),
+		})
+	}
+
+	fmt.Fprint(w, formatter.Format(table))
+	return w.Err
+}
@@ -0,0 +1,54 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mjmac/soad/src/control/lib/control"
+)
+
+func TestPretty_PrintHostConfigChanges(t *testing.T) {
+	for name, tc := range map[string]struct {
+		changes     []*control.HostConfigChanges
+		expPrintStr string
+	}{
+		"no results": {},
+		"changes": {
+			changes: []*control.HostConfigChanges{
+				{
+					Addr:            "host1",
+					Applied:         []string{"control_log_mask", "fault_path"},
+					RestartRequired: []string{"nr_hugepages"},
+				},
+				{
+					Addr: "host2",
+				},
+			},
+			expPrintStr: `
+Host  Applied                     Restart Required 
+----  -------                     ---------------- 
+host1 control_log_mask,fault_path nr_hugepages     
+host2 -                           -                
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintHostConfigChanges(tc.changes, &bld); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -263,6 +263,13 @@ func PrintSmdInfoMap(req *control.SmdQueryReq, hsm control.HostStorageMap, out i
 						}
 						fmt.Fprintln(out)
 					}
+					if req.IncludeHistory {
+						iw2 := txtfmt.NewIndentWriter(iw1)
+						if err := printNvmeHealthHistory(device.History, iw2, opts...); err != nil {
+							return err
+						}
+						fmt.Fprintln(out)
+					}
 				}
 			} else {
 				fmt.Fprintln(iw, "No devices found")
@@ -78,6 +78,9 @@ func printNvmeHealth(stat *storage.NvmeHealth, out io.Writer, opts ...PrintConfi
 		fmt.Fprintf(iw, "Checksum Errors:%d\n", uint64(stat.ChecksumErrors))
 	}
 	fmt.Fprintf(iw, "Error Log Entries:%d\n", uint64(stat.ErrorLogEntries))
+	if stat.AvailSpare > 0 || stat.AvailSpareThresh > 0 {
+		fmt.Fprintf(iw, "Available Spare:%d%% (threshold %d%%)\n", stat.AvailSpare, stat.AvailSpareThresh)
+	}
 
 	fmt.Fprintf(out, "Critical Warnings:\n")
 	fmt.Fprintf(iw, "Temperature: ")
@@ -114,6 +117,47 @@ func printNvmeHealth(stat *storage.NvmeHealth, out io.Writer, opts ...PrintConfi
 	return w.Err
 }
 
+func printNvmeHealthHistory(history []*storage.NvmeHealth, out io.Writer, opts ...PrintConfigOption) error {
+	w := txtfmt.NewErrWriter(out)
+
+	if len(history) == 0 {
+		fmt.Fprintln(out, "Health History Unavailable")
+		return w.Err
+	}
+
+	fmt.Fprintln(out, "Health History:")
+
+	timeTitle := "Timestamp"
+	tempTitle := "Temp(C)"
+	spareTitle := "Spare(%)"
+	mediaTitle := "Media Errors"
+	unsafeTitle := "Unsafe Shutdowns"
+	readTitle := "Read Errors"
+	writeTitle := "Write Errors"
+	unmapTitle := "Unmap Errors"
+
+	tablePrint := txtfmt.NewTableFormatter(timeTitle, tempTitle, spareTitle, mediaTitle,
+		unsafeTitle, readTitle, writeTitle, unmapTitle)
+	tablePrint.InitWriter(txtfmt.NewIndentWriter(out))
+	table := []txtfmt.TableRow{}
+
+	for _, stat := range history {
+		table = append(table, txtfmt.TableRow{
+			timeTitle:   getTimestampString(stat.Timestamp),
+			tempTitle:   fmt.Sprintf("%.02f", stat.TempC()),
+			spareTitle:  fmt.Sprintf("%d", stat.AvailSpare),
+			mediaTitle:  fmt.Sprintf("%d", stat.MediaErrors),
+			unsafeTitle: fmt.Sprintf("%d", stat.UnsafeShutdowns),
+			readTitle:   fmt.Sprintf("%d", stat.ReadErrors),
+			writeTitle:  fmt.Sprintf("%d", stat.WriteErrors),
+			unmapTitle:  fmt.Sprintf("%d", stat.UnmapErrors),
+		})
+	}
+
+	tablePrint.Format(table)
+	return w.Err
+}
+
 func printNvmeFormatResults(devices storage.NvmeControllers, out io.Writer, opts ...PrintConfigOption) error {
 	if len(devices) == 0 {
 		fmt.Fprintln(out, "\tNo NVMe devices found")
@@ -1235,6 +1235,70 @@ host1
 				mockController.HealthStats.ErrorLogEntries,
 			),
 		},
+		"device-health with history": {
+			req: &control.SmdQueryReq{
+				OmitPools:      true,
+				IncludeHistory: true,
+			},
+			hsm: mockHostStorageMap(t,
+				&mockHostStorage{
+					"host1",
+					&control.HostStorage{
+						SmdInfo: &control.SmdInfo{
+							Devices: []*storage.SmdDevice{
+								{
+									UUID:      common.MockUUID(0),
+									TargetIDs: []int32{0},
+									Rank:      0,
+									State:     "NORMAL",
+									History: []*storage.NvmeHealth{
+										{
+											Timestamp:   1600000000,
+											Temperature: 308,
+											AvailSpare:  100,
+										},
+										{
+											Timestamp:       1600000600,
+											Temperature:     318,
+											AvailSpare:      98,
+											MediaErrors:     2,
+											UnsafeShutdowns: 1,
+											ReadErrors:      3,
+											WriteErrors:     4,
+											UnmapErrors:     5,
+										},
+									},
+								},
+								{
+									UUID:      common.MockUUID(1),
+									TargetIDs: []int32{1},
+									Rank:      0,
+									State:     "NORMAL",
+								},
+							},
+						},
+					},
+				},
+			),
+			expPrintStr: fmt.Sprintf(`
+-----
+host1
+-----
+  Devices
+    UUID:00000000-0000-0000-0000-000000000000 [TrAddr:]
+      Targets:[0] Rank:0 State:NORMAL
+      Health History:
+        Timestamp                   Temp(C) Spare(%%) Media Errors Unsafe Shutdowns Read Errors Write Errors Unmap Errors 
+        ---------                   ------- -------- ------------ ---------------- ----------- ------------ ------------ 
+        %s 34.85   100      0            0                0           0            0            
+        %s 44.85   98       2            1                3           4            5            
+
+    UUID:11111111-1111-1111-1111-111111111111 [TrAddr:]
+      Targets:[1] Rank:0 State:NORMAL
+      Health History Unavailable
+
+`, common.FormatTime(time.Unix(1600000000, 0)), common.FormatTime(time.Unix(1600000600, 0))),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			var bld strings.Builder
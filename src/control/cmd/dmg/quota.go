@@ -0,0 +1,177 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/cmd/dmg/pretty"
+	"github.com/mjmac/soad/src/control/lib/control"
+)
+
+// QuotaCmd is the struct representing the top-level quota subcommand.
+type QuotaCmd struct {
+	Set  quotaSetCmd  `command:"set" description:"Set the pool capacity quota for a user or group"`
+	Get  quotaGetCmd  `command:"get" description:"Show the pool capacity quota and usage for a user or group"`
+	List quotaListCmd `command:"list" alias:"l" description:"List all pool capacity quotas and their usage"`
+}
+
+// quotaPrincipalCmd provides the flags used to identify the owner that a
+// quota applies to.
+type quotaPrincipalCmd struct {
+	User  string `short:"u" long:"user" description:"User that the quota applies to, format name@domain"`
+	Group string `short:"g" long:"group" description:"Group that the quota applies to, format name@domain"`
+}
+
+func (cmd *quotaPrincipalCmd) checkPrincipal() error {
+	if cmd.User != "" && cmd.Group != "" {
+		return errIncompatFlags("user", "group")
+	}
+	if cmd.User == "" && cmd.Group == "" {
+		return errors.New("either --user or --group must be supplied")
+	}
+	return nil
+}
+
+func (cmd *quotaPrincipalCmd) principal() string {
+	if cmd.Group != "" {
+		return "group " + cmd.Group
+	}
+	return "user " + cmd.User
+}
+
+// quotaSetCmd is the struct representing the command to set the pool
+// capacity quota for a user or group.
+type quotaSetCmd struct {
+	logCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	quotaPrincipalCmd
+	Size string `short:"z" long:"size" required:"1" description:"Total pool capacity (SCM and NVMe) that may be owned; 0 removes the quota"`
+}
+
+// Execute is run when quotaSetCmd activates.
+func (cmd *quotaSetCmd) Execute(_ []string) error {
+	if err := cmd.checkPrincipal(); err != nil {
+		return err
+	}
+
+	limit, err := humanize.ParseBytes(cmd.Size)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse quota size")
+	}
+
+	req := &control.QuotaSetReq{
+		User:  cmd.User,
+		Group: cmd.Group,
+		Limit: limit,
+	}
+	req.SetSystem(cmd.config.SystemName)
+
+	resp, err := control.QuotaSet(context.Background(), cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "Quota-Set command failed")
+	}
+
+	if limit == 0 {
+		cmd.log.Infof("Quota removed for %s\n", cmd.principal())
+	} else {
+		cmd.log.Infof("Quota set for %s\n", cmd.principal())
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintQuotaResponse(resp, &out); err != nil {
+		return err
+	}
+	cmd.log.Info(out.String())
+
+	return nil
+}
+
+// quotaGetCmd is the struct representing the command to show the pool
+// capacity quota and usage for a user or group.
+type quotaGetCmd struct {
+	logCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	quotaPrincipalCmd
+}
+
+// Execute is run when quotaGetCmd activates.
+func (cmd *quotaGetCmd) Execute(_ []string) error {
+	if err := cmd.checkPrincipal(); err != nil {
+		return err
+	}
+
+	req := &control.QuotaGetReq{
+		User:  cmd.User,
+		Group: cmd.Group,
+	}
+	req.SetSystem(cmd.config.SystemName)
+
+	resp, err := control.QuotaGet(context.Background(), cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "Quota-Get command failed")
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintQuotaResponse(resp, &out); err != nil {
+		return err
+	}
+	cmd.log.Info(out.String())
+
+	return nil
+}
+
+// quotaListCmd is the struct representing the command to list all pool
+// capacity quotas.
+type quotaListCmd struct {
+	logCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+}
+
+// Execute is run when quotaListCmd activates.
+func (cmd *quotaListCmd) Execute(_ []string) error {
+	req := new(control.QuotaListReq)
+	req.SetSystem(cmd.config.SystemName)
+
+	resp, err := control.QuotaList(context.Background(), cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "Quota-List command failed")
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintQuotaResponse(resp, &out); err != nil {
+		return err
+	}
+	cmd.log.Info(out.String())
+
+	return nil
+}
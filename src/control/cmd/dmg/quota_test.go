@@ -0,0 +1,100 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/lib/control"
+)
+
+func TestDmg_QuotaCommands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"Set quota without size",
+			"quota set --user foo@",
+			"",
+			errMissingFlag,
+		},
+		{
+			"Set quota without principal",
+			"quota set --size 1TB",
+			"",
+			errors.New("either --user or --group"),
+		},
+		{
+			"Set quota with user and group",
+			"quota set --user foo@ --group grp@ --size 1TB",
+			"",
+			errIncompatFlags("user", "group"),
+		},
+		{
+			"Set quota with invalid size",
+			"quota set --user foo@ --size big",
+			"",
+			errors.New("failed to parse quota size"),
+		},
+		{
+			"Set user quota",
+			"quota set --user foo@ --size 1TB",
+			strings.Join([]string{
+				printRequest(t, func() *control.QuotaSetReq {
+					req := &control.QuotaSetReq{User: "foo@", Limit: 1000000000000}
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"Remove group quota",
+			"quota set --group grp@ --size 0",
+			strings.Join([]string{
+				printRequest(t, func() *control.QuotaSetReq {
+					req := &control.QuotaSetReq{Group: "grp@"}
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"Get quota without principal",
+			"quota get",
+			"",
+			errors.New("either --user or --group"),
+		},
+		{
+			"Get group quota",
+			"quota get --group grp@",
+			strings.Join([]string{
+				printRequest(t, func() *control.QuotaGetReq {
+					req := &control.QuotaGetReq{Group: "grp@"}
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"List quotas",
+			"quota list",
+			strings.Join([]string{
+				printRequest(t, func() *control.QuotaListReq {
+					req := new(control.QuotaListReq)
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+	})
+}
@@ -0,0 +1,131 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/cmd/dmg/pretty"
+	"github.com/mjmac/soad/src/control/lib/control"
+)
+
+// ServerCmd is the struct representing the top-level server subcommand.
+type ServerCmd struct {
+	SetLogMasks serverSetLogMasksCmd `command:"set-logmasks" alias:"slm" description:"Set log level and masks on running servers and engines"`
+	Reload      serverReloadCmd      `command:"reload" description:"Reload server config files and apply changes that do not require a restart"`
+}
+
+// serverSetLogMasksCmd is the struct representing the command to change the
+// control plane log level and engine log masks without a restart.
+type serverSetLogMasksCmd struct {
+	logCmd
+	cfgCmd
+	ctlInvokerCmd
+	hostListCmd
+	jsonOutputCmd
+	Masks       string `short:"m" long:"masks" description:"Engine log masks in D_LOG_MASK format, e.g. ERR,mgmt=DEBUG"`
+	Level       string `long:"level" description:"Control plane log level (ERROR, INFO or DEBUG)"`
+	RevertAfter string `short:"r" long:"revert-after" description:"Restore original settings after duration, e.g. 10m"`
+}
+
+// Execute is run when serverSetLogMasksCmd activates.
+//
+// If neither masks nor level are supplied, the settings in effect at startup
+// are restored.
+func (cmd *serverSetLogMasksCmd) Execute(_ []string) error {
+	req := &control.SetEngineLogMasksReq{
+		Masks: cmd.Masks,
+		Level: cmd.Level,
+	}
+	if cmd.RevertAfter != "" {
+		if cmd.Masks == "" && cmd.Level == "" {
+			return errors.New("--revert-after requires --masks or --level")
+		}
+		d, err := time.ParseDuration(cmd.RevertAfter)
+		if err != nil {
+			return errors.Wrapf(err, "invalid revert period %q", cmd.RevertAfter)
+		}
+		req.RevertAfter = d
+	}
+
+	req.SetHostList(cmd.hostlist)
+
+	cmd.log.Debugf("set log masks req: %+v", req)
+
+	resp, err := control.SetEngineLogMasks(context.Background(), cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &bld); err != nil {
+		return err
+	}
+	if bld.Len() > 0 {
+		cmd.log.Info(bld.String())
+	}
+	if err := resp.Errors(); err != nil {
+		return err
+	}
+
+	switch {
+	case cmd.Masks == "" && cmd.Level == "":
+		cmd.log.Info("Log settings restored")
+	case req.RevertAfter > 0:
+		cmd.log.Infof("Log settings updated, will be restored after %s", req.RevertAfter)
+	default:
+		cmd.log.Info("Log settings updated")
+	}
+
+	return nil
+}
+
+// serverReloadCmd is the struct representing the command to reload the config
+// files of running servers.
+type serverReloadCmd struct {
+	logCmd
+	cfgCmd
+	ctlInvokerCmd
+	hostListCmd
+	jsonOutputCmd
+}
+
+// Execute is run when serverReloadCmd activates.
+func (cmd *serverReloadCmd) Execute(_ []string) error {
+	req := new(control.ReloadServerConfigReq)
+	req.SetHostList(cmd.hostlist)
+
+	resp, err := control.ReloadServerConfig(context.Background(), cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &bld); err != nil {
+		return err
+	}
+	if err := pretty.PrintHostConfigChanges(resp.HostChanges, &bld); err != nil {
+		return err
+	}
+	cmd.log.Info(bld.String())
+
+	return resp.Errors()
+}
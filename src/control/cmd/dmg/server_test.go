@@ -0,0 +1,87 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/lib/control"
+)
+
+func TestServerCommands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"Restore original log settings",
+			"server set-logmasks",
+			strings.Join([]string{
+				printRequest(t, &control.SetEngineLogMasksReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set engine log masks",
+			"server set-logmasks --masks ERR,mgmt=DEBUG",
+			strings.Join([]string{
+				printRequest(t, &control.SetEngineLogMasksReq{
+					Masks: "ERR,mgmt=DEBUG",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set control plane log level with revert (short)",
+			"server set-logmasks --level debug -r 10m",
+			strings.Join([]string{
+				printRequest(t, &control.SetEngineLogMasksReq{
+					Level:       "debug",
+					RevertAfter: 10 * time.Minute,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set log masks and level on selected hosts",
+			"-l host1,host2 server set-logmasks -m DEBUG --level info --revert-after 30s",
+			strings.Join([]string{
+				printRequest(t, func() *control.SetEngineLogMasksReq {
+					req := &control.SetEngineLogMasksReq{
+						Masks:       "DEBUG",
+						Level:       "info",
+						RevertAfter: 30 * time.Second,
+					}
+					req.SetHostList([]string{"host1", "host2"})
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"Revert period without settings",
+			"server set-logmasks --revert-after 10m",
+			"",
+			errors.New("requires --masks or --level"),
+		},
+		{
+			"Reload server config",
+			"server reload",
+			strings.Join([]string{
+				printRequest(t, &control.ReloadServerConfigReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"Invalid revert period",
+			"server set-logmasks -m DEBUG --revert-after soon",
+			"",
+			errors.New("invalid revert period"),
+		},
+	})
+}
@@ -331,15 +331,25 @@ type storageReplaceCmd struct {
 // nvmeReplaceCmd is the struct representing the replace nvme storage subcommand
 type nvmeReplaceCmd struct {
 	smdQueryCmd
-	OldDevUUID string `long:"old-uuid" description:"Device UUID of hot-removed SSD" required:"1"`
-	NewDevUUID string `long:"new-uuid" description:"Device UUID of new device" required:"1"`
+	OldDevUUID string `long:"old-uuid" description:"Device UUID of hot-removed SSD"`
+	NewDevUUID string `long:"new-uuid" description:"Device UUID of new device"`
+	Auto       bool   `long:"auto" description:"Detect the new device inserted in the PCI slot of the FAULTY or hot-removed SSD"`
 	NoReint    bool   `long:"no-reint" description:"Bypass reintegration of device and just bring back online."`
 }
 
 // Execute is run when storageReplaceCmd activates
 // Replace a hot-removed device with a newly plugged device, or reuse a FAULTY device
 func (cmd *nvmeReplaceCmd) Execute(_ []string) error {
-	if cmd.OldDevUUID == cmd.NewDevUUID {
+	switch {
+	case cmd.Auto && cmd.NewDevUUID != "":
+		return errors.New("--new-uuid cannot be used with --auto")
+	case !cmd.Auto && cmd.OldDevUUID == "":
+		return errors.New("--old-uuid must be specified unless --auto is set")
+	case !cmd.Auto && cmd.NewDevUUID == "":
+		return errors.New("--new-uuid must be specified unless --auto is set")
+	}
+
+	if !cmd.Auto && cmd.OldDevUUID == cmd.NewDevUUID {
 		cmd.log.Info("WARNING: Attempting to reuse a previously set FAULTY device!")
 	}
 
@@ -352,6 +362,7 @@ func (cmd *nvmeReplaceCmd) Execute(_ []string) error {
 	req := &control.SmdQueryReq{
 		UUID:        cmd.OldDevUUID,
 		ReplaceUUID: cmd.NewDevUUID,
+		AutoReplace: cmd.Auto,
 		NoReint:     cmd.NoReint,
 	}
 	return cmd.makeRequest(ctx, req)
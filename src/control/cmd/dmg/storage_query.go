@@ -69,7 +69,8 @@ type storageQueryCmd struct {
 
 type devHealthQueryCmd struct {
 	smdQueryCmd
-	UUID string `short:"u" long:"uuid" required:"1" description:"Device UUID"`
+	UUID    string `short:"u" long:"uuid" required:"1" description:"Device UUID"`
+	History bool   `long:"history" description:"Include the device health history sampled by the server"`
 }
 
 func (cmd *devHealthQueryCmd) Execute(_ []string) error {
@@ -77,6 +78,7 @@ func (cmd *devHealthQueryCmd) Execute(_ []string) error {
 	req := &control.SmdQueryReq{
 		OmitPools:        true,
 		IncludeBioHealth: true,
+		IncludeHistory:   cmd.History,
 		Rank:             system.NilRank,
 		UUID:             cmd.UUID,
 	}
@@ -46,6 +46,18 @@ func TestStorageQueryCommands(t *testing.T) {
 			}),
 			nil,
 		},
+		{
+			"per-server metadata device health history query",
+			"storage query device-health --uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d --history",
+			printRequest(t, &control.SmdQueryReq{
+				Rank:             system.NilRank,
+				OmitPools:        true,
+				IncludeBioHealth: true,
+				IncludeHistory:   true,
+				UUID:             "842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			}),
+			nil,
+		},
 		{
 			"per-server metadata device health query (missing uuid)",
 			"storage query device-health",
@@ -191,7 +191,36 @@ func TestStorageCommands(t *testing.T) {
 			"Try to replace a device without a new device UUID specified",
 			"storage replace nvme --old-uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d",
 			"StorageReplaceNvme",
-			errors.New("the required flag `--new-uuid' was not specified"),
+			errors.New("--new-uuid must be specified unless --auto is set"),
+		},
+		{
+			"Try to replace a device without an old device UUID specified",
+			"storage replace nvme --new-uuid 2ccb8afb-5d32-454e-86e3-762ec5dca7be",
+			"StorageReplaceNvme",
+			errors.New("--old-uuid must be specified unless --auto is set"),
+		},
+		{
+			"Replace the FAULTY device with the new device in its PCI slot",
+			"storage replace nvme --auto",
+			printRequest(t, &control.SmdQueryReq{
+				AutoReplace: true,
+			}),
+			nil,
+		},
+		{
+			"Replace a device with the new device in its PCI slot",
+			"storage replace nvme --auto --old-uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			printRequest(t, &control.SmdQueryReq{
+				UUID:        "842c739b-86b5-462f-a7ba-b4a91b674f3d",
+				AutoReplace: true,
+			}),
+			nil,
+		},
+		{
+			"Try to replace a device automatically with a new device UUID specified",
+			"storage replace nvme --auto --new-uuid 2ccb8afb-5d32-454e-86e3-762ec5dca7be",
+			"StorageReplaceNvme",
+			errors.New("--new-uuid cannot be used with --auto"),
 		},
 		{
 			"Identify a device",
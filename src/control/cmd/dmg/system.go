@@ -9,13 +9,24 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
 	"strings"
+	"syscall"
+	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/dustin/go-humanize/english"
 	"github.com/pkg/errors"
 
+	"github.com/mjmac/soad/src/control/build"
 	"github.com/mjmac/soad/src/control/cmd/dmg/pretty"
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/events"
 	"github.com/mjmac/soad/src/control/lib/control"
 	"github.com/mjmac/soad/src/control/lib/hostlist"
 	"github.com/mjmac/soad/src/control/lib/txtfmt"
@@ -30,6 +41,11 @@ type SystemCmd struct {
 	Stop        systemStopCmd      `command:"stop" alias:"s" description:"Perform controlled shutdown of DAOS system"`
 	Start       systemStartCmd     `command:"start" alias:"r" description:"Perform start of stopped DAOS system"`
 	ListPools   systemListPoolsCmd `command:"list-pools" alias:"p" description:"List all pools in the DAOS system"`
+	Events      systemEventsCmd    `command:"events" alias:"e" description:"List entries in the DAOS system event log or follow new events"`
+	SetPolicy   systemSetPolicyCmd `command:"set-policy" description:"Update the runtime state of DAOS system management policies"`
+	Restart     systemRestartCmd   `command:"restart" description:"Perform controlled restart of DAOS system, optionally one fault domain at a time"`
+	DbBackup    systemDbBackupCmd  `command:"db-backup" description:"Write a backup of the DAOS system database to a file"`
+	Replica     systemReplicaCmd   `command:"replica" description:"List or change the set of Management Service replicas"`
 }
 
 type leaderQueryCmd struct {
@@ -341,6 +357,253 @@ func (cmd *systemStopCmd) Execute(_ []string) error {
 		&resp.AbsentHosts, &resp.AbsentRanks)
 }
 
+// systemRestartCmd is the struct representing the command to restart system.
+type systemRestartCmd struct {
+	logCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	rankListCmd
+	Rolling      bool          `long:"rolling" description:"Restart one fault domain at a time, waiting for ranks to rejoin and pools to rebuild between steps"`
+	RanksPerStep uint32        `long:"ranks-per-step" description:"Restart at most this many ranks per step of a rolling restart instead of one fault domain"`
+	StepTimeout  time.Duration `long:"step-timeout" description:"Time allowed for each step to rejoin and rebuild before the restart is aborted (default 10m)"`
+	Force        bool          `long:"force" description:"Force stop DAOS system members"`
+}
+
+// Execute is run when systemRestartCmd activates
+//
+// Progress is displayed as it is reported by the MS. In JSON mode each
+// progress report is output as a separate JSON object.
+func (cmd *systemRestartCmd) Execute(_ []string) error {
+	if cmd.RanksPerStep > 0 && !cmd.Rolling {
+		return errors.New("--ranks-per-step may only be used with --rolling")
+	}
+	if cmd.StepTimeout < 0 {
+		return errors.New("--step-timeout must not be negative")
+	}
+
+	hostSet, rankSet, err := cmd.validateHostsRanks()
+	if err != nil {
+		return err
+	}
+	req := &control.SystemRestartReq{
+		Rolling:      cmd.Rolling,
+		RanksPerStep: cmd.RanksPerStep,
+		StepTimeout:  cmd.StepTimeout,
+		Force:        cmd.Force,
+	}
+	req.Hosts.ReplaceSet(hostSet)
+	req.Ranks.ReplaceSet(rankSet)
+	if cmd.config != nil {
+		req.SetSystem(cmd.config.SystemName)
+	}
+
+	var enc *json.Encoder
+	if cmd.jsonOutputEnabled() {
+		enc = json.NewEncoder(cmd.writer)
+	}
+
+	err = control.SystemRestart(context.Background(), cmd.ctlInvoker, req, func(srp *control.SystemRestartProgress) error {
+		if enc != nil {
+			return enc.Encode(srp)
+		}
+
+		var out strings.Builder
+		if err := pretty.PrintSystemRestartProgress(srp, &out); err != nil {
+			return err
+		}
+		cmd.log.Info(out.String())
+
+		return nil
+	})
+
+	if cmd.jsonOutputEnabled() && err != nil {
+		return cmd.errorJSON(err)
+	}
+
+	return errors.Wrap(err, "System-Restart command failed")
+}
+
+// systemDbBackupCmd is the struct representing the command to back up the
+// system database.
+type systemDbBackupCmd struct {
+	logCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	Output string `short:"o" long:"output" required:"1" description:"Path of the file to write the backup to"`
+}
+
+// Execute is run when systemDbBackupCmd activates
+//
+// The backup is written to a temporary file alongside the output path and
+// only renamed into place once it has been received in full, so that an
+// existing backup is never replaced by a partial one.
+func (cmd *systemDbBackupCmd) Execute(_ []string) error {
+	req := new(control.SystemDbBackupReq)
+	if cmd.config != nil {
+		req.SetSystem(cmd.config.SystemName)
+	}
+
+	resp, err := cmd.writeBackup(req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "System-DbBackup command failed")
+	}
+
+	cmd.log.Infof("Wrote %s system database backup (map version %d) to %s\n",
+		humanize.Bytes(resp.Size), resp.MapVersion, cmd.Output)
+	return nil
+}
+
+func (cmd *systemDbBackupCmd) writeBackup(req *control.SystemDbBackupReq) (*control.SystemDbBackupResp, error) {
+	tmp, err := ioutil.TempFile(filepath.Dir(cmd.Output), filepath.Base(cmd.Output)+".tmp")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create backup file")
+	}
+	defer os.Remove(tmp.Name())
+
+	resp, err := control.SystemDbBackup(context.Background(), cmd.ctlInvoker, req, tmp)
+	if err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to write backup file")
+	}
+	if err := os.Rename(tmp.Name(), cmd.Output); err != nil {
+		return nil, errors.Wrap(err, "failed to write backup file")
+	}
+
+	return resp, nil
+}
+
+// systemReplicaCmd is the struct representing the system replica subcommand.
+type systemReplicaCmd struct {
+	List   systemReplicaListCmd   `command:"list" alias:"l" description:"List the current Management Service replicas"`
+	Add    systemReplicaAddCmd    `command:"add" alias:"a" description:"Add a server to the set of Management Service replicas"`
+	Remove systemReplicaRemoveCmd `command:"remove" alias:"r" description:"Remove a server from the set of Management Service replicas"`
+}
+
+func printReplicaResp(log logging.Logger, resp *control.SystemReplicaResp) {
+	log.Infof("Current Leader: %s\n   Replica Set: %s\n", resp.Leader,
+		strings.Join(resp.Replicas, ", "))
+}
+
+// systemReplicaListCmd is the struct representing the command to list the
+// MS replicas.
+type systemReplicaListCmd struct {
+	logCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+}
+
+// Execute is run when systemReplicaListCmd activates
+func (cmd *systemReplicaListCmd) Execute(_ []string) error {
+	req := new(control.SystemReplicaListReq)
+	if cmd.config != nil {
+		req.SetSystem(cmd.config.SystemName)
+	}
+
+	resp, err := control.SystemReplicaList(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "System-Replica-List command failed")
+	}
+
+	printReplicaResp(cmd.log, resp)
+	return nil
+}
+
+// systemReplicaChangeCmd contains the common options for commands that
+// change the set of MS replicas.
+type systemReplicaChangeCmd struct {
+	logCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	Addr string `short:"a" long:"addr" required:"1" description:"Control address of the server (host[:port])"`
+}
+
+// getRequest returns a request for the replica address, with the
+// configured control port applied if none was specified.
+func (cmd *systemReplicaChangeCmd) getRequest() (*control.SystemReplicaReq, error) {
+	req := new(control.SystemReplicaReq)
+	port := build.DefaultControlPort
+	if cmd.config != nil {
+		req.SetSystem(cmd.config.SystemName)
+		port = cmd.config.ControlPort
+	}
+
+	addrs, err := common.ParseHostList([]string{cmd.Addr}, port)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) != 1 {
+		return nil, errors.Errorf("expected a single replica address, got %q", cmd.Addr)
+	}
+	req.Addr = addrs[0]
+
+	return req, nil
+}
+
+// systemReplicaAddCmd is the struct representing the command to add a MS
+// replica.
+type systemReplicaAddCmd struct {
+	systemReplicaChangeCmd
+}
+
+// Execute is run when systemReplicaAddCmd activates
+//
+// The server must already be running as a member of the system.
+func (cmd *systemReplicaAddCmd) Execute(_ []string) error {
+	req, err := cmd.getRequest()
+	if err != nil {
+		return err
+	}
+
+	resp, err := control.SystemReplicaAdd(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "System-Replica-Add command failed")
+	}
+
+	printReplicaResp(cmd.log, resp)
+	return nil
+}
+
+// systemReplicaRemoveCmd is the struct representing the command to remove a
+// MS replica.
+type systemReplicaRemoveCmd struct {
+	systemReplicaChangeCmd
+}
+
+// Execute is run when systemReplicaRemoveCmd activates
+func (cmd *systemReplicaRemoveCmd) Execute(_ []string) error {
+	req, err := cmd.getRequest()
+	if err != nil {
+		return err
+	}
+
+	resp, err := control.SystemReplicaRemove(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "System-Replica-Remove command failed")
+	}
+
+	printReplicaResp(cmd.log, resp)
+	return nil
+}
+
 // systemStartCmd is the struct representing the command to start system.
 type systemStartCmd struct {
 	logCmd
@@ -432,3 +695,240 @@ func (cmd *systemListPoolsCmd) Execute(_ []string) error {
 	cmd.log.Info(formatter.Format(table))
 	return nil
 }
+
+// parseEventTime parses a time supplied either as an absolute RFC3339
+// timestamp or as a duration relative to the current time (e.g. "12h").
+func parseEventTime(in string) (time.Time, error) {
+	if in == "" {
+		return time.Time{}, nil
+	}
+
+	if d, err := time.ParseDuration(in); err == nil {
+		if d < 0 {
+			return time.Time{}, errors.Errorf("invalid relative time %q", in)
+		}
+		return time.Now().Add(-d), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, in)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid time %q (use RFC3339 or a duration like 12h)", in)
+	}
+
+	return t, nil
+}
+
+// systemEventsCmd is the struct representing the command to list entries in
+// the system event log.
+type systemEventsCmd struct {
+	logCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	Types      string `long:"type" description:"Comma separated list of event types (state_change,info) to match"`
+	IDs        string `long:"id" description:"Comma separated list of RAS event IDs (e.g. engine_status_down) to match"`
+	Severities string `long:"severity" description:"Comma separated list of event severities (fatal,error,warn,info) to match"`
+	Ranks      string `long:"ranks" short:"r" description:"Comma separated ranges or individual system ranks to match"`
+	Hosts      string `long:"hosts" description:"Hostlist representing hosts to match"`
+	PoolUUID   string `long:"pool" short:"p" description:"Pool UUID to match"`
+	Since      string `long:"since" description:"Match events recorded at or after this time (RFC3339 or duration relative to now, e.g. 12h)"`
+	Until      string `long:"until" description:"Match events recorded at or before this time (RFC3339 or duration relative to now, e.g. 1h)"`
+	Limit      int    `long:"limit" short:"n" description:"Maximum number of most recent events to display"`
+	Verbose    bool   `long:"verbose" short:"v" description:"Display more event details"`
+	Follow     bool   `long:"follow" short:"f" description:"Stream new events as they are received by the MS instead of listing the event log"`
+}
+
+// buildRequest validates the command options and uses them to create a
+// request.
+func (cmd *systemEventsCmd) buildRequest() (*control.ListEventsReq, error) {
+	req := &control.ListEventsReq{
+		PoolUUID: cmd.PoolUUID,
+		Limit:    cmd.Limit,
+	}
+
+	if cmd.Limit < 0 {
+		return nil, errors.New("--limit must not be negative")
+	}
+
+	for _, typStr := range splitCommaList(cmd.Types) {
+		typ, err := events.RASTypeFromString(typStr)
+		if err != nil {
+			return nil, err
+		}
+		req.Types = append(req.Types, typ)
+	}
+	for _, idStr := range splitCommaList(cmd.IDs) {
+		id, err := events.RASIDFromString(idStr)
+		if err != nil {
+			return nil, err
+		}
+		req.IDs = append(req.IDs, id)
+	}
+	for _, sevStr := range splitCommaList(cmd.Severities) {
+		sev, err := events.RASSeverityFromString(sevStr)
+		if err != nil {
+			return nil, err
+		}
+		req.Severities = append(req.Severities, sev)
+	}
+
+	rankSet, err := system.CreateRankSet(cmd.Ranks)
+	if err != nil {
+		return nil, err
+	}
+	req.Ranks.ReplaceSet(rankSet)
+	hostSet, err := hostlist.CreateSet(cmd.Hosts)
+	if err != nil {
+		return nil, err
+	}
+	req.Hosts.ReplaceSet(hostSet)
+
+	if req.Since, err = parseEventTime(cmd.Since); err != nil {
+		return nil, err
+	}
+	if req.Until, err = parseEventTime(cmd.Until); err != nil {
+		return nil, err
+	}
+	if !req.Since.IsZero() && !req.Until.IsZero() && req.Until.Before(req.Since) {
+		return nil, errors.New("--until must not be before --since")
+	}
+
+	if cmd.config != nil {
+		req.SetSystem(cmd.config.SystemName)
+	}
+
+	return req, nil
+}
+
+// buildFollowRequest converts the list request built from the command
+// options into a request to subscribe to new events.
+func (cmd *systemEventsCmd) buildFollowRequest(listReq *control.ListEventsReq) (*control.SubscribeEventsReq, error) {
+	if cmd.Since != "" || cmd.Until != "" || cmd.Limit != 0 {
+		return nil, errors.New("--since, --until and --limit may not be used with --follow")
+	}
+
+	req := &control.SubscribeEventsReq{
+		Types:      listReq.Types,
+		IDs:        listReq.IDs,
+		Severities: listReq.Severities,
+		PoolUUID:   listReq.PoolUUID,
+	}
+	req.Ranks.ReplaceSet(&listReq.Ranks)
+	req.Hosts.ReplaceSet(&listReq.Hosts)
+	req.SetSystem(listReq.Sys)
+
+	return req, nil
+}
+
+// follow displays new events as they are received by the MS until the
+// command is interrupted.
+func (cmd *systemEventsCmd) follow(req *control.SubscribeEventsReq) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+	go func() {
+		select {
+		case <-sigCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var enc *json.Encoder
+	if cmd.jsonOutputEnabled() {
+		enc = json.NewEncoder(cmd.writer)
+	}
+
+	err := control.SubscribeEvents(ctx, cmd.ctlInvoker, req, func(se *control.StreamedEvent) error {
+		if enc != nil {
+			return enc.Encode(se)
+		}
+
+		var out strings.Builder
+		if err := pretty.PrintStreamedEvent(se, &out, pretty.PrintWithVerboseOutput(cmd.Verbose)); err != nil {
+			return err
+		}
+		cmd.log.Info(out.String())
+
+		return nil
+	})
+
+	if cmd.jsonOutputEnabled() && err != nil {
+		return cmd.errorJSON(err)
+	}
+
+	return errors.Wrap(err, "System-Events command failed")
+}
+
+// Execute is run when systemEventsCmd activates
+func (cmd *systemEventsCmd) Execute(_ []string) error {
+	req, err := cmd.buildRequest()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Follow {
+		followReq, err := cmd.buildFollowRequest(req)
+		if err != nil {
+			return err
+		}
+		return cmd.follow(followReq)
+	}
+
+	resp, err := control.ListEvents(context.Background(), cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "System-Events command failed")
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintListEventsResponse(resp, &out, pretty.PrintWithVerboseOutput(cmd.Verbose)); err != nil {
+		return err
+	}
+	cmd.log.Info(out.String())
+
+	return nil
+}
+
+// systemSetPolicyCmd is the struct representing the command to update the
+// runtime state of system management policies.
+type systemSetPolicyCmd struct {
+	logCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	AutoExclude string `long:"auto-exclude" choice:"pause" choice:"resume" required:"1" description:"Pause or resume automatic exclusion of dead ranks from pools"`
+}
+
+// Execute is run when systemSetPolicyCmd activates
+func (cmd *systemSetPolicyCmd) Execute(_ []string) error {
+	req := &control.SystemSetPolicyReq{
+		AutoExcludePaused: cmd.AutoExclude == "pause",
+	}
+	req.SetSystem(cmd.config.SystemName)
+
+	resp, err := control.SystemSetPolicy(context.Background(), cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "System-Set-Policy command failed")
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintSystemSetPolicyResponse(resp, &out); err != nil {
+		return err
+	}
+	cmd.log.Info(out.String())
+
+	return nil
+}
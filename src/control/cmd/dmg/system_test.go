@@ -8,15 +8,19 @@ package main
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
 	"github.com/mjmac/soad/src/control/build"
 	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/events"
 	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/lib/hostlist"
 	. "github.com/mjmac/soad/src/control/system"
 )
 
@@ -25,7 +29,16 @@ func listWithSystem(req *control.ListPoolsReq, sysName string) *control.ListPool
 	return req
 }
 
+func eventsWithSystem(req *control.ListEventsReq, sysName string) *control.ListEventsReq {
+	req.SetSystem(sysName)
+	return req
+}
+
 func TestDmg_SystemCommands(t *testing.T) {
+	tmpDir, tmpCleanup := common.CreateTestDir(t)
+	defer tmpCleanup()
+	backupPath := filepath.Join(tmpDir, "backup.json")
+
 	runCmdTests(t, []cmdTest{
 		{
 			"system query with no arguments",
@@ -214,6 +227,256 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system events with no arguments",
+			"system events",
+			strings.Join([]string{
+				printRequest(t, eventsWithSystem(&control.ListEventsReq{}, build.DefaultSystemName)),
+			}, " "),
+			nil,
+		},
+		{
+			"system events with filters",
+			"system events --id engine_status_down,swim_rank_dead --severity error,WARN " +
+				"--ranks 0-3 --hosts foo-[1-2] --pool " + common.MockUUID() + " " +
+				"--since 2021-03-01T00:00:00Z --until 2021-03-02T00:00:00Z --limit 10",
+			strings.Join([]string{
+				printRequest(t, func() *control.ListEventsReq {
+					req := &control.ListEventsReq{
+						IDs:        []events.RASID{events.RASRankDown, events.RASSwimRankDead},
+						Severities: []events.RASSeverityID{events.RASSeverityError, events.RASSeverityWarn},
+						PoolUUID:   common.MockUUID(),
+						Since:      time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+						Until:      time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC),
+						Limit:      10,
+					}
+					req.Ranks.ReplaceSet(MustCreateRankSet("0-3"))
+					req.Hosts.ReplaceSet(hostlist.MustCreateSet("foo-[1-2]"))
+					return eventsWithSystem(req, build.DefaultSystemName)
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system events with type filter",
+			"system events --type state_change",
+			strings.Join([]string{
+				printRequest(t, eventsWithSystem(&control.ListEventsReq{
+					Types: []events.RASTypeID{events.RASTypeStateChange},
+				}, build.DefaultSystemName)),
+			}, " "),
+			nil,
+		},
+		{
+			"system events follow",
+			"system events --follow --type info --id system_action_stop --severity info --ranks 1 --hosts foo",
+			strings.Join([]string{
+				printRequest(t, func() *control.SubscribeEventsReq {
+					req := &control.SubscribeEventsReq{
+						Types:      []events.RASTypeID{events.RASTypeInfoOnly},
+						IDs:        []events.RASID{events.RASSystemStop},
+						Severities: []events.RASSeverityID{events.RASSeverityInfo},
+					}
+					req.Ranks.ReplaceSet(MustCreateRankSet("1"))
+					req.Hosts.ReplaceSet(hostlist.MustCreateSet("foo"))
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system restart",
+			"system restart",
+			strings.Join([]string{
+				printRequest(t, func() *control.SystemRestartReq {
+					req := new(control.SystemRestartReq)
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system restart rolling",
+			"system restart --rolling --ranks-per-step 2 --step-timeout 5m --force --ranks 0-3",
+			strings.Join([]string{
+				printRequest(t, func() *control.SystemRestartReq {
+					req := &control.SystemRestartReq{
+						Rolling:      true,
+						RanksPerStep: 2,
+						StepTimeout:  5 * time.Minute,
+						Force:        true,
+					}
+					req.Ranks.ReplaceSet(MustCreateRankSet("0-3"))
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system db-backup",
+			"system db-backup -o " + backupPath,
+			strings.Join([]string{
+				printRequest(t, func() *control.SystemDbBackupReq {
+					req := new(control.SystemDbBackupReq)
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system db-backup without output",
+			"system db-backup",
+			"",
+			errors.New("the required flag `-o, --output' was not specified"),
+		},
+		{
+			"system db-backup to missing directory",
+			"system db-backup -o " + filepath.Join(tmpDir, "missing", "backup.json"),
+			"",
+			errors.New("failed to create backup file"),
+		},
+		{
+			"system replica list",
+			"system replica list",
+			strings.Join([]string{
+				printRequest(t, func() *control.SystemReplicaListReq {
+					req := new(control.SystemReplicaListReq)
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system replica add with default port",
+			"system replica add --addr host1",
+			strings.Join([]string{
+				printRequest(t, func() *control.SystemReplicaReq {
+					req := &control.SystemReplicaReq{Addr: "host1:10001"}
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system replica remove",
+			"system replica remove -a host2:10002",
+			strings.Join([]string{
+				printRequest(t, func() *control.SystemReplicaReq {
+					req := &control.SystemReplicaReq{Addr: "host2:10002"}
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system replica add without address",
+			"system replica add",
+			"",
+			errors.New("the required flag `-a, --addr' was not specified"),
+		},
+		{
+			"system replica add with multiple addresses",
+			"system replica add --addr host[1-2]",
+			"",
+			errors.New("expected a single replica address"),
+		},
+		{
+			"system restart ranks per step without rolling",
+			"system restart --ranks-per-step 2",
+			"",
+			errors.New("may only be used with --rolling"),
+		},
+		{
+			"system restart with ranks and hosts",
+			"system restart --ranks 0 --rank-hosts foo",
+			"",
+			errors.New("cannot be set together"),
+		},
+		{
+			"system events follow with limit",
+			"system events -f --limit 10",
+			"",
+			errors.New("may not be used with --follow"),
+		},
+		{
+			"system events with unknown type",
+			"system events --type transient",
+			"",
+			errors.New("unknown RAS event type"),
+		},
+		{
+			"system events with unknown id",
+			"system events --id foo_bar",
+			"",
+			errors.New("unknown RAS event ID"),
+		},
+		{
+			"system events with unknown severity",
+			"system events --severity critical",
+			"",
+			errors.New("unknown RAS event severity"),
+		},
+		{
+			"system events with bad time",
+			"system events --since yesterday",
+			"",
+			errors.New("invalid time"),
+		},
+		{
+			"system events with inverted time range",
+			"system events --since 2021-03-02T00:00:00Z --until 2021-03-01T00:00:00Z",
+			"",
+			errors.New("--until must not be before --since"),
+		},
+		{
+			"system events with negative limit",
+			"system events --limit -1",
+			"",
+			errors.New("--limit must not be negative"),
+		},
+		{
+			"system set-policy pause auto-exclude",
+			"system set-policy --auto-exclude pause",
+			strings.Join([]string{
+				printRequest(t, func() *control.SystemSetPolicyReq {
+					req := &control.SystemSetPolicyReq{AutoExcludePaused: true}
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system set-policy resume auto-exclude",
+			"system set-policy --auto-exclude resume",
+			strings.Join([]string{
+				printRequest(t, func() *control.SystemSetPolicyReq {
+					req := &control.SystemSetPolicyReq{}
+					req.SetSystem(build.DefaultSystemName)
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system set-policy without policy",
+			"system set-policy",
+			"",
+			errors.New("required flag"),
+		},
+		{
+			"system set-policy with invalid state",
+			"system set-policy --auto-exclude stop",
+			"",
+			errors.New("Invalid value"),
+		},
 		{
 			"Non-existent subcommand",
 			"system quack",
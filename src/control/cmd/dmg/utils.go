@@ -122,3 +122,15 @@ func errIncompatFlags(key string, incompat ...string) error {
 
 	return errors.Errorf("%s with --%s", base, strings.Join(incompat, " or --"))
 }
+
+// splitCommaList splits a comma separated list into its constituent
+// elements, discarding any surrounding whitespace and empty elements.
+func splitCommaList(in string) (out []string) {
+	for _, elem := range strings.Split(in, ",") {
+		if elem = strings.TrimSpace(elem); elem != "" {
+			out = append(out, elem)
+		}
+	}
+
+	return
+}
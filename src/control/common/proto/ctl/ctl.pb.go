@@ -30,30 +30,34 @@ func init() {
 }
 
 var fileDescriptor_1d50d0bc048a9dfa = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x4f, 0x02, 0x31,
-	0x10, 0xc5, 0x4d, 0x8c, 0x44, 0x6b, 0x40, 0xa8, 0x8a, 0x09, 0x47, 0xcf, 0xc2, 0x46, 0x31, 0x31,
-	0x7a, 0x32, 0x92, 0x70, 0x34, 0x48, 0xe3, 0xc5, 0x5b, 0xe9, 0x56, 0x20, 0x6c, 0xdb, 0x75, 0x3a,
-	0x48, 0xfc, 0x64, 0x7e, 0x3d, 0xd3, 0x3f, 0x1b, 0x76, 0x91, 0xc3, 0xde, 0x66, 0x7e, 0x79, 0x6f,
-	0x5e, 0x99, 0x61, 0x49, 0x53, 0x60, 0x96, 0x08, 0xcc, 0x06, 0x39, 0x18, 0x34, 0xf4, 0x50, 0x60,
-	0xd6, 0xeb, 0x38, 0x66, 0xd1, 0x00, 0x9f, 0xcb, 0xc0, 0x03, 0xd2, 0x12, 0x37, 0x06, 0x56, 0x11,
-	0x51, 0x87, 0x3e, 0x97, 0xa0, 0x36, 0x1c, 0x0a, 0x99, 0x9f, 0x66, 0x55, 0x1a, 0xdb, 0x33, 0xd7,
-	0x02, 0xd7, 0x2b, 0x5b, 0x78, 0xec, 0x82, 0x83, 0x4c, 0xcb, 0xec, 0xee, 0xf7, 0x88, 0x34, 0x46,
-	0x98, 0xb1, 0x6f, 0x41, 0x47, 0xa4, 0xc5, 0x42, 0xec, 0x04, 0x64, 0xce, 0x41, 0xd2, 0xee, 0xc0,
-	0xbd, 0xad, 0x0a, 0xa7, 0xf2, 0xab, 0x77, 0xb5, 0x97, 0xdb, 0xfc, 0xfa, 0x80, 0x3e, 0x91, 0xd3,
-	0xc8, 0x99, 0xe0, 0x9a, 0x9e, 0x97, 0x95, 0x8e, 0x38, 0xfb, 0xc5, 0x7f, 0xe8, 0xbd, 0xcf, 0xa4,
-	0x19, 0xe1, 0xd8, 0x80, 0xe2, 0x48, 0x2f, 0xcb, 0xc2, 0xc0, 0x9c, 0xbf, 0xbb, 0x0f, 0x17, 0xe9,
-	0xaf, 0x61, 0x4d, 0xa5, 0xf4, 0x12, 0xd9, 0xa6, 0x57, 0x60, 0x91, 0x3e, 0x8e, 0xfb, 0x7c, 0x5b,
-	0x4b, 0xf8, 0x89, 0xe9, 0x15, 0xb6, 0x4d, 0xdf, 0xc1, 0x7e, 0xc2, 0x88, 0xb4, 0x0a, 0xfc, 0x9e,
-	0xa7, 0x1c, 0x8b, 0x05, 0x56, 0xe1, 0x76, 0x81, 0xbb, 0xdc, 0x0f, 0xb9, 0x25, 0xc7, 0x4c, 0xa5,
-	0xe1, 0x05, 0xed, 0xf0, 0x43, 0x63, 0xeb, 0x8c, 0x9d, 0x1d, 0xe2, 0x2d, 0xf7, 0xa4, 0xe3, 0x8e,
-	0xc0, 0x16, 0x6b, 0x4c, 0xcd, 0x46, 0x4f, 0xdd, 0x79, 0x69, 0xd3, 0x2b, 0x7d, 0xed, 0x8c, 0xad,
-	0x72, 0xeb, 0x5d, 0x37, 0xe4, 0x84, 0xa1, 0xc9, 0xeb, 0xab, 0x27, 0x4b, 0x3d, 0xaf, 0xa9, 0x1e,
-	0x92, 0xf6, 0x54, 0x5a, 0x89, 0xf1, 0x38, 0xf5, 0x4c, 0x7d, 0x42, 0x18, 0x72, 0xa8, 0x29, 0x7f,
-	0x79, 0xfc, 0x78, 0x98, 0x2f, 0x71, 0xb1, 0x9e, 0x0d, 0x84, 0x51, 0x49, 0xca, 0x8d, 0xed, 0x5b,
-	0xe4, 0x62, 0xe5, 0xcb, 0xc4, 0x82, 0x48, 0x84, 0xd1, 0x08, 0x26, 0x4b, 0x84, 0x51, 0xca, 0xe8,
-	0xc4, 0xff, 0xe1, 0xdd, 0xd7, 0x36, 0x6b, 0xf8, 0x72, 0xf8, 0x17, 0x00, 0x00, 0xff, 0xff, 0x0a,
-	0x90, 0xcd, 0x70, 0x7f, 0x03, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x8f, 0xd3, 0x30,
+	0x10, 0xc5, 0x91, 0x90, 0x2a, 0x30, 0xb4, 0xb4, 0x86, 0x5d, 0xa4, 0x3d, 0x72, 0x66, 0x1b, 0xc1,
+	0x22, 0x21, 0x90, 0x90, 0x56, 0x54, 0xec, 0x09, 0xd0, 0x52, 0x8b, 0x0b, 0x37, 0xaf, 0xe3, 0x4d,
+	0xa3, 0x24, 0x9e, 0x30, 0x9e, 0xb6, 0xe2, 0x9b, 0xf1, 0xf1, 0x90, 0xff, 0xa9, 0x4e, 0xe9, 0x21,
+	0x37, 0xcf, 0x2f, 0xef, 0xcd, 0x73, 0xc6, 0x4e, 0xd8, 0x54, 0x51, 0x5b, 0x28, 0x6a, 0x97, 0x3d,
+	0x02, 0x01, 0x7f, 0xa8, 0xa8, 0xbd, 0x58, 0x38, 0x66, 0x09, 0x50, 0x56, 0x3a, 0xf0, 0x80, 0x8c,
+	0xa6, 0x3d, 0x60, 0x13, 0x11, 0x77, 0xe8, 0xbe, 0xc6, 0x6e, 0x2f, 0x31, 0xc9, 0x7c, 0x37, 0xdb,
+	0x95, 0xb1, 0x7c, 0xe6, 0x4a, 0x94, 0xa6, 0xb1, 0x11, 0xcc, 0xfd, 0x73, 0x8d, 0x3b, 0x8d, 0xa9,
+	0x8b, 0xdd, 0x48, 0xd4, 0x65, 0xae, 0x7a, 0xfb, 0x77, 0xc2, 0x26, 0x2b, 0x6a, 0xc5, 0x4e, 0xf1,
+	0x15, 0x9b, 0x89, 0xb0, 0x91, 0x5b, 0xd4, 0xbd, 0x44, 0xcd, 0xcf, 0x97, 0x6e, 0xb7, 0x43, 0xb8,
+	0xd6, 0xbf, 0x2f, 0x5e, 0x9e, 0xe4, 0xb6, 0x7f, 0xf5, 0x80, 0x7f, 0x64, 0x4f, 0x22, 0x17, 0x4a,
+	0x1a, 0xfe, 0x3c, 0x57, 0x3a, 0xe2, 0xec, 0x2f, 0xfe, 0x87, 0xde, 0x7b, 0xcd, 0xa6, 0x11, 0xde,
+	0x00, 0x76, 0x92, 0xf8, 0x59, 0x2e, 0x0c, 0xcc, 0xf9, 0xcf, 0x4f, 0xe1, 0x94, 0xfe, 0x3d, 0x0c,
+	0x2e, 0x4b, 0xcf, 0xc8, 0x21, 0x7d, 0x00, 0x53, 0xfa, 0x4d, 0x9c, 0xf0, 0x8f, 0xad, 0xc6, 0x3f,
+	0x31, 0x7d, 0xc0, 0x0e, 0xe9, 0x47, 0xd8, 0x77, 0x58, 0xb1, 0x59, 0xc2, 0x3f, 0xfb, 0x52, 0x52,
+	0x1a, 0xe0, 0x10, 0x1e, 0x06, 0x78, 0xcc, 0x7d, 0x93, 0x37, 0xec, 0x91, 0xe8, 0xca, 0xb0, 0x83,
+	0x79, 0x78, 0xd1, 0x58, 0x3a, 0xe3, 0xe2, 0x88, 0x78, 0xcb, 0x3b, 0xb6, 0x70, 0x87, 0x20, 0x36,
+	0x5b, 0x2a, 0x61, 0x6f, 0xd6, 0xee, 0x78, 0xf9, 0xd4, 0x2b, 0xfd, 0xda, 0x19, 0x67, 0x79, 0xe9,
+	0x5d, 0xaf, 0xd9, 0x63, 0x41, 0xd0, 0x8f, 0x57, 0xdf, 0xd6, 0xa6, 0x1a, 0xa9, 0xbe, 0x62, 0xf3,
+	0xb5, 0xb6, 0x9a, 0xe2, 0xe1, 0x8c, 0x33, 0x5d, 0x32, 0x26, 0x48, 0xe2, 0x58, 0xf9, 0x35, 0x5b,
+	0x08, 0x4d, 0x5f, 0x4c, 0x55, 0x1b, 0xfd, 0x15, 0xaa, 0x6f, 0xd2, 0x36, 0x36, 0xdd, 0x37, 0x4d,
+	0x89, 0x64, 0xf7, 0x2d, 0x87, 0xbe, 0xc3, 0x27, 0xf6, 0x74, 0xad, 0x5b, 0x90, 0xe5, 0x0a, 0xcc,
+	0x7d, 0x5d, 0xf1, 0xa0, 0xcb, 0x91, 0x73, 0x9f, 0x9d, 0xa0, 0xce, 0xfe, 0xf9, 0xc3, 0xaf, 0xf7,
+	0x55, 0x4d, 0x9b, 0xed, 0xdd, 0x52, 0x41, 0x57, 0x94, 0x12, 0xec, 0xa5, 0x25, 0xa9, 0x1a, 0xbf,
+	0x2c, 0x2c, 0xaa, 0x42, 0x81, 0x21, 0x84, 0xb6, 0x50, 0xd0, 0x75, 0x60, 0x0a, 0xff, 0xc5, 0xb9,
+	0x1f, 0xc0, 0xdd, 0xc4, 0x2f, 0xaf, 0xfe, 0x0d, 0x00, 0x4f, 0x42, 0x92, 0x6e, 0x12, 0x04, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -92,6 +96,10 @@ type CtlSvcClient interface {
 	ResetFormatRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error)
 	// Start DAOS I/O Engines on a host. (gRPC fanout)
 	StartRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error)
+	// Set log level and masks on a running server and its DAOS I/O Engines
+	SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq, opts ...grpc.CallOption) (*SetLogMasksResp, error)
+	// Reload the server configuration file and apply changes that are safe to make live
+	ReloadConfig(ctx context.Context, in *ReloadConfigReq, opts ...grpc.CallOption) (*ReloadConfigResp, error)
 }
 
 type ctlSvcClient struct {
@@ -210,6 +218,24 @@ func (c *ctlSvcClient) StartRanks(ctx context.Context, in *RanksReq, opts ...grp
 	return out, nil
 }
 
+func (c *ctlSvcClient) SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq, opts ...grpc.CallOption) (*SetLogMasksResp, error) {
+	out := new(SetLogMasksResp)
+	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/SetEngineLogMasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) ReloadConfig(ctx context.Context, in *ReloadConfigReq, opts ...grpc.CallOption) (*ReloadConfigResp, error) {
+	out := new(ReloadConfigResp)
+	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CtlSvcServer is the server API for CtlSvc service.
 type CtlSvcServer interface {
 	// Prepare nonvolatile storage devices for use with DAOS
@@ -236,6 +262,10 @@ type CtlSvcServer interface {
 	ResetFormatRanks(context.Context, *RanksReq) (*RanksResp, error)
 	// Start DAOS I/O Engines on a host. (gRPC fanout)
 	StartRanks(context.Context, *RanksReq) (*RanksResp, error)
+	// Set log level and masks on a running server and its DAOS I/O Engines
+	SetEngineLogMasks(context.Context, *SetLogMasksReq) (*SetLogMasksResp, error)
+	// Reload the server configuration file and apply changes that are safe to make live
+	ReloadConfig(context.Context, *ReloadConfigReq) (*ReloadConfigResp, error)
 }
 
 // UnimplementedCtlSvcServer can be embedded to have forward compatible implementations.
@@ -278,6 +308,12 @@ func (*UnimplementedCtlSvcServer) ResetFormatRanks(ctx context.Context, req *Ran
 func (*UnimplementedCtlSvcServer) StartRanks(ctx context.Context, req *RanksReq) (*RanksResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartRanks not implemented")
 }
+func (*UnimplementedCtlSvcServer) SetEngineLogMasks(ctx context.Context, req *SetLogMasksReq) (*SetLogMasksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEngineLogMasks not implemented")
+}
+func (*UnimplementedCtlSvcServer) ReloadConfig(ctx context.Context, req *ReloadConfigReq) (*ReloadConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
 
 func RegisterCtlSvcServer(s *grpc.Server, srv CtlSvcServer) {
 	s.RegisterService(&_CtlSvc_serviceDesc, srv)
@@ -499,6 +535,42 @@ func _CtlSvc_StartRanks_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_SetEngineLogMasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogMasksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).SetEngineLogMasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ctl.CtlSvc/SetEngineLogMasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).SetEngineLogMasks(ctx, req.(*SetLogMasksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ctl.CtlSvc/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).ReloadConfig(ctx, req.(*ReloadConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CtlSvc_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ctl.CtlSvc",
 	HandlerType: (*CtlSvcServer)(nil),
@@ -551,6 +623,14 @@ var _CtlSvc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StartRanks",
 			Handler:    _CtlSvc_StartRanks_Handler,
 		},
+		{
+			MethodName: "SetEngineLogMasks",
+			Handler:    _CtlSvc_SetEngineLogMasks_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _CtlSvc_ReloadConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ctl/ctl.proto",
@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: ctl/server.proto
+
+package ctl
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type SetLogMasksReq struct {
+	Masks                string   `protobuf:"bytes,1,opt,name=masks,proto3" json:"masks,omitempty"`
+	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	RevertAfter          uint32   `protobuf:"varint,3,opt,name=revert_after,json=revertAfter,proto3" json:"revert_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLogMasksReq) Reset()         { *m = SetLogMasksReq{} }
+func (m *SetLogMasksReq) String() string { return proto.CompactTextString(m) }
+func (*SetLogMasksReq) ProtoMessage()    {}
+func (*SetLogMasksReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f889b72344656b59, []int{0}
+}
+
+func (m *SetLogMasksReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLogMasksReq.Unmarshal(m, b)
+}
+func (m *SetLogMasksReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLogMasksReq.Marshal(b, m, deterministic)
+}
+func (m *SetLogMasksReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogMasksReq.Merge(m, src)
+}
+func (m *SetLogMasksReq) XXX_Size() int {
+	return xxx_messageInfo_SetLogMasksReq.Size(m)
+}
+func (m *SetLogMasksReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogMasksReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogMasksReq proto.InternalMessageInfo
+
+func (m *SetLogMasksReq) GetMasks() string {
+	if m != nil {
+		return m.Masks
+	}
+	return ""
+}
+
+func (m *SetLogMasksReq) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *SetLogMasksReq) GetRevertAfter() uint32 {
+	if m != nil {
+		return m.RevertAfter
+	}
+	return 0
+}
+
+type SetLogMasksResp struct {
+	Errors               []string `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLogMasksResp) Reset()         { *m = SetLogMasksResp{} }
+func (m *SetLogMasksResp) String() string { return proto.CompactTextString(m) }
+func (*SetLogMasksResp) ProtoMessage()    {}
+func (*SetLogMasksResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f889b72344656b59, []int{1}
+}
+
+func (m *SetLogMasksResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLogMasksResp.Unmarshal(m, b)
+}
+func (m *SetLogMasksResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLogMasksResp.Marshal(b, m, deterministic)
+}
+func (m *SetLogMasksResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogMasksResp.Merge(m, src)
+}
+func (m *SetLogMasksResp) XXX_Size() int {
+	return xxx_messageInfo_SetLogMasksResp.Size(m)
+}
+func (m *SetLogMasksResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogMasksResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogMasksResp proto.InternalMessageInfo
+
+func (m *SetLogMasksResp) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type ReloadConfigReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReloadConfigReq) Reset()         { *m = ReloadConfigReq{} }
+func (m *ReloadConfigReq) String() string { return proto.CompactTextString(m) }
+func (*ReloadConfigReq) ProtoMessage()    {}
+func (*ReloadConfigReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f889b72344656b59, []int{2}
+}
+
+func (m *ReloadConfigReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadConfigReq.Unmarshal(m, b)
+}
+func (m *ReloadConfigReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadConfigReq.Marshal(b, m, deterministic)
+}
+func (m *ReloadConfigReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadConfigReq.Merge(m, src)
+}
+func (m *ReloadConfigReq) XXX_Size() int {
+	return xxx_messageInfo_ReloadConfigReq.Size(m)
+}
+func (m *ReloadConfigReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadConfigReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadConfigReq proto.InternalMessageInfo
+
+type ReloadConfigResp struct {
+	Applied              []string `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	RestartRequired      []string `protobuf:"bytes,2,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReloadConfigResp) Reset()         { *m = ReloadConfigResp{} }
+func (m *ReloadConfigResp) String() string { return proto.CompactTextString(m) }
+func (*ReloadConfigResp) ProtoMessage()    {}
+func (*ReloadConfigResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f889b72344656b59, []int{3}
+}
+
+func (m *ReloadConfigResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadConfigResp.Unmarshal(m, b)
+}
+func (m *ReloadConfigResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadConfigResp.Marshal(b, m, deterministic)
+}
+func (m *ReloadConfigResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadConfigResp.Merge(m, src)
+}
+func (m *ReloadConfigResp) XXX_Size() int {
+	return xxx_messageInfo_ReloadConfigResp.Size(m)
+}
+func (m *ReloadConfigResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadConfigResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadConfigResp proto.InternalMessageInfo
+
+func (m *ReloadConfigResp) GetApplied() []string {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+func (m *ReloadConfigResp) GetRestartRequired() []string {
+	if m != nil {
+		return m.RestartRequired
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SetLogMasksReq)(nil), "ctl.SetLogMasksReq")
+	proto.RegisterType((*SetLogMasksResp)(nil), "ctl.SetLogMasksResp")
+	proto.RegisterType((*ReloadConfigReq)(nil), "ctl.ReloadConfigReq")
+	proto.RegisterType((*ReloadConfigResp)(nil), "ctl.ReloadConfigResp")
+}
+
+func init() {
+	proto.RegisterFile("ctl/server.proto", fileDescriptor_f889b72344656b59)
+}
+
+var fileDescriptor_f889b72344656b59 = []byte{
+	// 251 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0xd0, 0xbd, 0x4f, 0xc3, 0x30,
+	0x10, 0x05, 0x70, 0xa5, 0x11, 0x45, 0x35, 0x1f, 0x09, 0x16, 0x42, 0x19, 0x4b, 0xa6, 0x74, 0xa0,
+	0x1e, 0x18, 0x10, 0x23, 0xb0, 0xc2, 0x62, 0x06, 0x24, 0x96, 0xc8, 0x75, 0xae, 0x21, 0xaa, 0x93,
+	0x4b, 0xce, 0xd7, 0xfc, 0xfd, 0x28, 0x1f, 0x1d, 0xba, 0xbd, 0xf7, 0x93, 0xad, 0x27, 0x9d, 0x88,
+	0x2d, 0x3b, 0xe5, 0x81, 0x7a, 0xa0, 0x6d, 0x4b, 0xc8, 0x28, 0x43, 0xcb, 0x2e, 0xcd, 0xc5, 0xed,
+	0x37, 0xf0, 0x27, 0x96, 0x5f, 0xc6, 0x1f, 0xbc, 0x86, 0x4e, 0xde, 0x8b, 0x8b, 0x7a, 0xc8, 0x49,
+	0xb0, 0x0e, 0xb2, 0x95, 0x9e, 0xca, 0xa0, 0x0e, 0x7a, 0x70, 0xc9, 0x62, 0xd2, 0xb1, 0xc8, 0x47,
+	0x71, 0x4d, 0xd0, 0x03, 0x71, 0x6e, 0xf6, 0x0c, 0x94, 0x84, 0xeb, 0x20, 0xbb, 0xd1, 0x57, 0x93,
+	0xbd, 0x0d, 0x94, 0x6e, 0x44, 0x74, 0x36, 0xe0, 0x5b, 0xf9, 0x20, 0x96, 0x40, 0x84, 0x34, 0x4c,
+	0x84, 0xd9, 0x4a, 0xcf, 0x2d, 0xbd, 0x13, 0x91, 0x06, 0x87, 0xa6, 0xf8, 0xc0, 0x66, 0x5f, 0x95,
+	0x1a, 0xba, 0xf4, 0x47, 0xc4, 0xe7, 0xe4, 0x5b, 0x99, 0x88, 0x4b, 0xd3, 0xb6, 0xae, 0x82, 0x62,
+	0xfe, 0x7f, 0xaa, 0x72, 0x23, 0x62, 0x02, 0xcf, 0x86, 0x38, 0x27, 0xe8, 0x8e, 0x15, 0x41, 0x91,
+	0x2c, 0xc6, 0x27, 0xd1, 0xec, 0x7a, 0xe6, 0xf7, 0xd7, 0xdf, 0x97, 0xb2, 0xe2, 0xbf, 0xe3, 0x6e,
+	0x6b, 0xb1, 0x56, 0x85, 0x41, 0xff, 0xe4, 0xd9, 0xd8, 0xc3, 0x18, 0x95, 0x27, 0xab, 0x2c, 0x36,
+	0x4c, 0xe8, 0x94, 0xc5, 0xba, 0xc6, 0x46, 0x8d, 0x37, 0x53, 0x96, 0xdd, 0x6e, 0x39, 0xc6, 0xe7,
+	0xff, 0x01, 0x00, 0xb4, 0xf5, 0xf2, 0x23, 0x52, 0x01, 0x00, 0x00,
+}
@@ -96,6 +96,8 @@ type BioHealthResp struct {
 	// Usage stats
 	TotalBytes           uint64   `protobuf:"varint,25,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
 	AvailBytes           uint64   `protobuf:"varint,26,opt,name=avail_bytes,json=availBytes,proto3" json:"avail_bytes,omitempty"`
+	AvailSpare           uint32   `protobuf:"varint,27,opt,name=avail_spare,json=availSpare,proto3" json:"avail_spare,omitempty"`
+	AvailSpareThresh     uint32   `protobuf:"varint,28,opt,name=avail_spare_thresh,json=availSpareThresh,proto3" json:"avail_spare_thresh,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -287,6 +289,20 @@ func (m *BioHealthResp) GetAvailBytes() uint64 {
 	return 0
 }
 
+func (m *BioHealthResp) GetAvailSpare() uint32 {
+	if m != nil {
+		return m.AvailSpare
+	}
+	return 0
+}
+
+func (m *BioHealthResp) GetAvailSpareThresh() uint32 {
+	if m != nil {
+		return m.AvailSpareThresh
+	}
+	return 0
+}
+
 type SmdDevReq struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -870,6 +886,8 @@ type SmdQueryReq struct {
 	ReplaceUUID          string   `protobuf:"bytes,8,opt,name=replaceUUID,proto3" json:"replaceUUID,omitempty"`
 	NoReint              bool     `protobuf:"varint,9,opt,name=noReint,proto3" json:"noReint,omitempty"`
 	Identify             bool     `protobuf:"varint,10,opt,name=identify,proto3" json:"identify,omitempty"`
+	IncludeHistory       bool     `protobuf:"varint,11,opt,name=includeHistory,proto3" json:"includeHistory,omitempty"`
+	AutoReplace          bool     `protobuf:"varint,12,opt,name=autoReplace,proto3" json:"autoReplace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -970,6 +988,20 @@ func (m *SmdQueryReq) GetIdentify() bool {
 	return false
 }
 
+func (m *SmdQueryReq) GetIncludeHistory() bool {
+	if m != nil {
+		return m.IncludeHistory
+	}
+	return false
+}
+
+func (m *SmdQueryReq) GetAutoReplace() bool {
+	if m != nil {
+		return m.AutoReplace
+	}
+	return false
+}
+
 type SmdQueryResp struct {
 	Status               int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Ranks                []*SmdQueryResp_RankResp `protobuf:"bytes,2,rep,name=ranks,proto3" json:"ranks,omitempty"`
@@ -1018,14 +1050,15 @@ func (m *SmdQueryResp) GetRanks() []*SmdQueryResp_RankResp {
 }
 
 type SmdQueryResp_Device struct {
-	Uuid                 string         `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	TgtIds               []int32        `protobuf:"varint,2,rep,packed,name=tgt_ids,json=tgtIds,proto3" json:"tgt_ids,omitempty"`
-	State                string         `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	TrAddr               string         `protobuf:"bytes,4,opt,name=tr_addr,json=trAddr,proto3" json:"tr_addr,omitempty"`
-	Health               *BioHealthResp `protobuf:"bytes,5,opt,name=health,proto3" json:"health,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Uuid                 string           `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	TgtIds               []int32          `protobuf:"varint,2,rep,packed,name=tgt_ids,json=tgtIds,proto3" json:"tgt_ids,omitempty"`
+	State                string           `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	TrAddr               string           `protobuf:"bytes,4,opt,name=tr_addr,json=trAddr,proto3" json:"tr_addr,omitempty"`
+	Health               *BioHealthResp   `protobuf:"bytes,5,opt,name=health,proto3" json:"health,omitempty"`
+	History              []*BioHealthResp `protobuf:"bytes,6,rep,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *SmdQueryResp_Device) Reset()         { *m = SmdQueryResp_Device{} }
@@ -1088,6 +1121,13 @@ func (m *SmdQueryResp_Device) GetHealth() *BioHealthResp {
 	return nil
 }
 
+func (m *SmdQueryResp_Device) GetHistory() []*BioHealthResp {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 type SmdQueryResp_Pool struct {
 	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	TgtIds               []int32  `protobuf:"varint,2,rep,packed,name=tgt_ids,json=tgtIds,proto3" json:"tgt_ids,omitempty"`
@@ -1225,74 +1265,78 @@ func init() {
 }
 
 var fileDescriptor_c4ae1b46306946b7 = []byte{
-	// 1102 bytes of a gzipped FileDescriptorProto
+	// 1167 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xdd, 0x8e, 0x1b, 0x35,
-	0x14, 0xc7, 0x35, 0xf9, 0xda, 0xe4, 0xe4, 0x63, 0xd3, 0x61, 0x77, 0x3b, 0x4d, 0x41, 0x84, 0xb0,
-	0x42, 0x61, 0x0b, 0x49, 0xb5, 0x5c, 0x20, 0xee, 0x60, 0xd9, 0xa2, 0x6e, 0x05, 0x6a, 0x99, 0xed,
-	0xaa, 0x12, 0x17, 0x8c, 0x9c, 0xb1, 0x9b, 0x58, 0xeb, 0x19, 0xa7, 0xb6, 0x27, 0x51, 0xee, 0x78,
-	0x04, 0x5e, 0x80, 0x67, 0xe0, 0x1a, 0x89, 0x57, 0xe1, 0x5d, 0x90, 0x8f, 0x33, 0xc9, 0x64, 0x2b,
-	0xa5, 0x02, 0x21, 0xee, 0xec, 0xbf, 0x7f, 0x73, 0x7c, 0x3e, 0x7c, 0xec, 0x81, 0x76, 0x6c, 0xc4,
-	0x58, 0x27, 0x74, 0x34, 0x57, 0xd2, 0x48, 0xbf, 0x1c, 0x1b, 0x31, 0xf8, 0x1a, 0x5a, 0x17, 0x5c,
-	0x3e, 0x65, 0x44, 0x98, 0x59, 0xc8, 0xde, 0xf8, 0x0f, 0xa0, 0x4e, 0xd9, 0x22, 0xca, 0x32, 0x4e,
-	0x03, 0xaf, 0xef, 0x0d, 0x1b, 0xe1, 0x01, 0x65, 0x8b, 0x9b, 0x8c, 0x53, 0xff, 0x18, 0x6a, 0x66,
-	0x6a, 0x22, 0x4e, 0x83, 0x12, 0x2e, 0x54, 0xcd, 0xd4, 0x5c, 0xd1, 0xc1, 0x5f, 0x35, 0x68, 0x17,
-	0x4c, 0xe8, 0xb9, 0xff, 0x3e, 0x34, 0x0c, 0x4f, 0x98, 0x36, 0x24, 0x99, 0x07, 0xe5, 0xbe, 0x37,
-	0xac, 0x84, 0x5b, 0xc1, 0x3f, 0x85, 0xce, 0x92, 0xa8, 0x34, 0x32, 0x2c, 0x99, 0x47, 0x56, 0x0e,
-	0xaa, 0x7d, 0x6f, 0xd8, 0x0e, 0x5b, 0x56, 0x7d, 0xc9, 0x92, 0xf9, 0x4b, 0x9e, 0x30, 0x4b, 0xc5,
-	0x8a, 0x9b, 0x02, 0x55, 0x73, 0x94, 0x55, 0x77, 0x28, 0xa3, 0x44, 0x34, 0xc9, 0xf4, 0xca, 0x51,
-	0x07, 0xb8, 0x5d, 0xcb, 0xaa, 0x17, 0x99, 0x5e, 0x21, 0xf5, 0x11, 0xb4, 0xe6, 0x72, 0xc9, 0x54,
-	0x14, 0xaf, 0x62, 0xc1, 0x74, 0x50, 0x47, 0xa6, 0x89, 0xda, 0xb7, 0x28, 0x59, 0x43, 0x0e, 0x91,
-	0x69, 0x34, 0x93, 0x99, 0xd2, 0x41, 0xc3, 0x19, 0x42, 0xf5, 0x79, 0xfa, 0xd4, 0x6a, 0xfe, 0xa7,
-	0xd0, 0xcd, 0x52, 0x4d, 0x5e, 0xb3, 0x48, 0xcf, 0x32, 0x43, 0xe5, 0x32, 0xd5, 0x01, 0x20, 0x77,
-	0xe8, 0xf4, 0xeb, 0x5c, 0xf6, 0x3f, 0x00, 0x48, 0x18, 0xe5, 0x24, 0x62, 0x4a, 0xe9, 0xa0, 0xe9,
-	0x92, 0x80, 0xca, 0x13, 0xa5, 0xb4, 0xff, 0x09, 0x1c, 0x32, 0xa5, 0x22, 0x21, 0xa7, 0x11, 0x4b,
-	0x8d, 0xe2, 0x4c, 0x07, 0x2d, 0x64, 0xda, 0x4c, 0xa9, 0xef, 0xe5, 0xf4, 0x89, 0x13, 0xfd, 0x01,
-	0xb4, 0x27, 0x5c, 0x46, 0x8a, 0x11, 0xea, 0x2c, 0xb5, 0x31, 0x0b, 0xcd, 0x09, 0x97, 0x21, 0x23,
-	0x14, 0x6d, 0x9d, 0x42, 0xc7, 0x32, 0x4b, 0xc5, 0x0d, 0x73, 0x50, 0xc7, 0xa5, 0x6a, 0xc2, 0xe5,
-	0x2b, 0x2b, 0x16, 0xa9, 0x2c, 0x4d, 0xc8, 0xdc, 0x51, 0x87, 0x1b, 0xea, 0xc6, 0x8a, 0x48, 0x7d,
-	0x0c, 0xed, 0x78, 0xc6, 0xe2, 0x5b, 0x9d, 0x25, 0x0e, 0xea, 0xae, 0xb3, 0xbe, 0x16, 0x11, 0xea,
-	0x43, 0xd3, 0x96, 0x85, 0x29, 0x62, 0x32, 0xc5, 0x82, 0x7b, 0xce, 0xa5, 0x82, 0xe4, 0x3f, 0x84,
-	0x06, 0x16, 0xce, 0x96, 0x34, 0xf0, 0xfb, 0xde, 0xb0, 0x1e, 0xd6, 0xad, 0xf0, 0x8a, 0xa8, 0xd4,
-	0x1f, 0x42, 0x97, 0x2c, 0x08, 0x17, 0x91, 0x9e, 0x13, 0xc5, 0x1c, 0xf3, 0x1e, 0x32, 0x1d, 0xd4,
-	0xaf, 0xad, 0x8c, 0xe4, 0x63, 0x38, 0xb2, 0x87, 0x51, 0x31, 0xc1, 0xc9, 0x84, 0x0b, 0x6e, 0x56,
-	0x8e, 0x3e, 0x42, 0xda, 0xa7, 0x6c, 0x11, 0x6e, 0x97, 0xf0, 0x8b, 0x53, 0xe8, 0x60, 0xae, 0x64,
-	0x2a, 0xd6, 0xec, 0x31, 0xb2, 0x2d, 0xab, 0x3e, 0x4f, 0x85, 0xa3, 0xce, 0xe0, 0xde, 0x42, 0x0a,
-	0x62, 0xb8, 0x60, 0x51, 0xc2, 0x12, 0x07, 0x9e, 0x20, 0x78, 0x98, 0x2f, 0xfc, 0xc0, 0x12, 0x64,
-	0x4f, 0xa0, 0xa6, 0x0d, 0x31, 0x99, 0x0e, 0xee, 0xf7, 0xbd, 0x61, 0x35, 0x5c, 0xcf, 0x76, 0x1a,
-	0x25, 0xd8, 0x6d, 0x94, 0x0f, 0xa1, 0x69, 0xa4, 0x21, 0x22, 0x9a, 0xac, 0x0c, 0xd3, 0xc1, 0x03,
-	0x2c, 0x2c, 0xa0, 0x74, 0x61, 0x15, 0x0b, 0xb8, 0x0c, 0x38, 0xa0, 0xe7, 0x00, 0x94, 0x10, 0x78,
-	0x56, 0xa9, 0x7b, 0xdd, 0xd2, 0xb3, 0x4a, 0xbd, 0xd4, 0x2d, 0x0f, 0x9a, 0xd0, 0xb8, 0x4e, 0xe8,
-	0xa5, 0x8d, 0xf5, 0xcd, 0xe0, 0x4f, 0x0f, 0x20, 0x9f, 0xe9, 0x79, 0xc1, 0x39, 0x6f, 0xc7, 0xb9,
-	0xc7, 0x60, 0x9d, 0xe1, 0x31, 0xd3, 0x41, 0xa9, 0x5f, 0x1e, 0x36, 0xcf, 0x4f, 0x46, 0xb1, 0x11,
-	0xa3, 0xed, 0x97, 0xa3, 0x4b, 0x5c, 0x0e, 0x73, 0xac, 0x47, 0xa1, 0xe6, 0x24, 0xdf, 0x87, 0x4a,
-	0xa1, 0xfb, 0x71, 0xec, 0xdf, 0x87, 0x03, 0xd7, 0xfa, 0xce, 0x5e, 0x35, 0xac, 0x61, 0xef, 0x6b,
-	0xff, 0x08, 0xaa, 0x76, 0x4b, 0x86, 0x6d, 0xde, 0x08, 0xdd, 0x04, 0x71, 0x15, 0x11, 0x4a, 0x55,
-	0x50, 0x41, 0xbd, 0x66, 0xd4, 0x37, 0x94, 0xaa, 0x41, 0x0b, 0xbd, 0x7f, 0x21, 0xa5, 0xb0, 0xc1,
-	0xfc, 0xe6, 0x41, 0x73, 0x33, 0xdd, 0x13, 0xcd, 0x23, 0xa8, 0xce, 0xa5, 0x14, 0x79, 0x2c, 0xc7,
-	0x79, 0x2c, 0xf9, 0x87, 0x23, 0x1c, 0x38, 0xa6, 0x77, 0x05, 0x15, 0x3b, 0xfd, 0xc7, 0x61, 0x4c,
-	0x84, 0x9c, 0xe8, 0xa0, 0xdc, 0x2f, 0x0f, 0x2b, 0xa1, 0x9b, 0x0c, 0x86, 0xd0, 0xbc, 0x64, 0x8b,
-	0x6b, 0x1b, 0xd2, 0xfe, 0xab, 0x71, 0xf0, 0x33, 0xb4, 0xb6, 0xe4, 0x9e, 0x48, 0x8a, 0x26, 0x4a,
-	0xbb, 0x87, 0xe6, 0x21, 0x34, 0xec, 0x52, 0x31, 0x9b, 0x96, 0x45, 0x9b, 0x83, 0x04, 0xda, 0x58,
-	0xb8, 0xb9, 0x20, 0x31, 0xfa, 0xd2, 0x87, 0x96, 0x14, 0x34, 0xba, 0xe3, 0x0f, 0x48, 0x61, 0x0b,
-	0x8c, 0xf6, 0xfa, 0xd0, 0x4a, 0xd9, 0x32, 0xba, 0xb3, 0x1d, 0xa4, 0x6c, 0x99, 0x13, 0x01, 0x1c,
-	0xa4, 0x32, 0x64, 0x3c, 0x35, 0xb8, 0x5f, 0x3d, 0xcc, 0xa7, 0x83, 0x29, 0x74, 0x8a, 0xdb, 0xed,
-	0x09, 0xe8, 0xdd, 0xbb, 0xec, 0x8d, 0xeb, 0x11, 0x6e, 0x74, 0x45, 0x59, 0x6a, 0xf8, 0xeb, 0xd5,
-	0x3b, 0x92, 0x4c, 0xe0, 0x70, 0x07, 0xfe, 0xd7, 0x79, 0x16, 0x8c, 0xee, 0xfa, 0x23, 0x18, 0x75,
-	0xfe, 0xfc, 0x5e, 0xc2, 0x13, 0xf9, 0x63, 0xc6, 0xd4, 0xca, 0xa5, 0xb9, 0x29, 0x13, 0x6e, 0x2e,
-	0xd7, 0xbd, 0xe4, 0x61, 0x9a, 0x8a, 0x92, 0x7d, 0xeb, 0xec, 0xf4, 0xc5, 0xfa, 0x7c, 0xda, 0xf5,
-	0xad, 0xe0, 0x9f, 0x41, 0x97, 0xa7, 0xb1, 0xc8, 0x28, 0xdb, 0xbc, 0x90, 0xeb, 0x5c, 0xbf, 0xa5,
-	0x5b, 0x4b, 0x9a, 0x99, 0xef, 0x48, 0x26, 0xcc, 0x0a, 0xdb, 0xa6, 0x1e, 0x6e, 0x85, 0xcd, 0x71,
-	0xae, 0x16, 0x8e, 0xb3, 0x0f, 0x15, 0x45, 0xd2, 0xdb, 0xf5, 0xcb, 0x88, 0x63, 0x9b, 0x11, 0x43,
-	0xd4, 0x94, 0x19, 0x7c, 0x09, 0x6d, 0xe7, 0xe1, 0xcc, 0x46, 0xa2, 0x5c, 0x3d, 0x6f, 0x6e, 0xae,
-	0x2e, 0xf1, 0x09, 0x6c, 0x84, 0x45, 0xa9, 0x78, 0x1c, 0x1a, 0x3b, 0xc7, 0xc1, 0xef, 0x41, 0x9d,
-	0xaf, 0xb3, 0x8e, 0xcf, 0x5d, 0x3d, 0xdc, 0xcc, 0x07, 0x7f, 0x94, 0xa1, 0xb5, 0xcd, 0xd8, 0xde,
-	0x2b, 0xa9, 0x6a, 0x1d, 0xcc, 0x9b, 0xb8, 0x97, 0x37, 0xf1, 0xe6, 0xcb, 0x51, 0x48, 0xd2, 0x5b,
-	0x3b, 0x08, 0x1d, 0xd8, 0xfb, 0xd5, 0xfb, 0x3f, 0xee, 0x24, 0xff, 0x0c, 0x6a, 0x33, 0x57, 0x19,
-	0x9b, 0xdb, 0xe6, 0xb9, 0x8f, 0x9e, 0xed, 0xfc, 0xd1, 0x84, 0x6b, 0xe2, 0x3f, 0xbc, 0x5c, 0x7a,
-	0xbf, 0x78, 0x50, 0xcf, 0x23, 0xde, 0x54, 0xd2, 0x2b, 0x54, 0xf2, 0xfc, 0xee, 0x1d, 0x1e, 0xbc,
-	0x9d, 0xb2, 0x3b, 0xb7, 0xb8, 0xff, 0x59, 0x7e, 0x53, 0x96, 0x77, 0x6f, 0xfd, 0xed, 0x17, 0x85,
-	0xab, 0xf2, 0xe2, 0xab, 0x9f, 0xbe, 0x9c, 0x72, 0x33, 0xcb, 0x26, 0xa3, 0x58, 0x26, 0x63, 0x4a,
-	0xa4, 0xfe, 0x5c, 0x1b, 0x12, 0xdf, 0xe2, 0x70, 0xac, 0x55, 0x3c, 0x8e, 0x65, 0x6a, 0x94, 0x14,
-	0xe3, 0x58, 0x26, 0x89, 0x4c, 0xc7, 0xf8, 0xdf, 0x38, 0x8e, 0x8d, 0x98, 0xd4, 0x70, 0xf8, 0xc5,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa6, 0xec, 0x32, 0xb8, 0x53, 0x0a, 0x00, 0x00,
+	0x14, 0xc7, 0x35, 0x9b, 0xaf, 0xc9, 0xc9, 0xc7, 0xa6, 0xa6, 0x1f, 0xd3, 0xb4, 0x88, 0x10, 0xaa,
+	0x2a, 0xb4, 0x25, 0xa9, 0xca, 0x05, 0xe2, 0x0e, 0x96, 0x2d, 0xea, 0x56, 0xa0, 0x96, 0xd9, 0xae,
+	0x2a, 0x71, 0xc1, 0xc8, 0x99, 0x71, 0x13, 0x6b, 0x3d, 0xe3, 0xd4, 0xf6, 0xec, 0x2a, 0x77, 0xbc,
+	0x08, 0x6f, 0xc0, 0x23, 0x20, 0x5e, 0x87, 0x67, 0xe0, 0x0e, 0xf9, 0x78, 0x26, 0x99, 0x6c, 0x45,
+	0x2a, 0x10, 0xe2, 0xce, 0xfe, 0xfb, 0x37, 0xb6, 0xcf, 0xdf, 0xc7, 0xc7, 0x03, 0xbd, 0xd8, 0x88,
+	0x99, 0x4e, 0x93, 0xe9, 0x4a, 0x49, 0x23, 0x49, 0x2d, 0x36, 0x62, 0xfc, 0x15, 0x74, 0x8f, 0xb8,
+	0x7c, 0xc6, 0xa8, 0x30, 0xcb, 0x90, 0xbd, 0x25, 0xb7, 0xc1, 0x4f, 0xd8, 0x45, 0x94, 0xe7, 0x3c,
+	0x09, 0xbc, 0x91, 0x37, 0x69, 0x87, 0xad, 0x84, 0x5d, 0x9c, 0xe5, 0x3c, 0x21, 0x37, 0xa0, 0x69,
+	0x16, 0x26, 0xe2, 0x49, 0x70, 0x80, 0x03, 0x0d, 0xb3, 0x30, 0x27, 0xc9, 0xf8, 0xd7, 0x16, 0xf4,
+	0x2a, 0x53, 0xe8, 0x15, 0xb9, 0x0b, 0x6d, 0xc3, 0x53, 0xa6, 0x0d, 0x4d, 0x57, 0x41, 0x6d, 0xe4,
+	0x4d, 0xea, 0xe1, 0x56, 0x20, 0xf7, 0xa0, 0x7f, 0x49, 0x55, 0x16, 0x19, 0x96, 0xae, 0x22, 0x2b,
+	0x07, 0x8d, 0x91, 0x37, 0xe9, 0x85, 0x5d, 0xab, 0xbe, 0x62, 0xe9, 0xea, 0x15, 0x4f, 0x99, 0xa5,
+	0x62, 0xc5, 0x4d, 0x85, 0x6a, 0x3a, 0xca, 0xaa, 0x3b, 0x94, 0x51, 0x22, 0x9a, 0xe7, 0x7a, 0xed,
+	0xa8, 0x16, 0x2e, 0xd7, 0xb5, 0xea, 0x51, 0xae, 0xd7, 0x48, 0x7d, 0x0c, 0xdd, 0x95, 0xbc, 0x64,
+	0x2a, 0x8a, 0xd7, 0xb1, 0x60, 0x3a, 0xf0, 0x91, 0xe9, 0xa0, 0xf6, 0x0d, 0x4a, 0x76, 0x22, 0x87,
+	0xc8, 0x2c, 0x5a, 0xca, 0x5c, 0xe9, 0xa0, 0xed, 0x26, 0x42, 0xf5, 0x45, 0xf6, 0xcc, 0x6a, 0xe4,
+	0x53, 0x18, 0xe4, 0x99, 0xa6, 0x6f, 0x58, 0xa4, 0x97, 0xb9, 0x49, 0xe4, 0x65, 0xa6, 0x03, 0x40,
+	0xee, 0xd0, 0xe9, 0xa7, 0xa5, 0x4c, 0x3e, 0x04, 0x48, 0x59, 0xc2, 0x69, 0xc4, 0x94, 0xd2, 0x41,
+	0xc7, 0x99, 0x80, 0xca, 0x53, 0xa5, 0x34, 0xb9, 0x0f, 0x87, 0x4c, 0xa9, 0x48, 0xc8, 0x45, 0xc4,
+	0x32, 0xa3, 0x38, 0xd3, 0x41, 0x17, 0x99, 0x1e, 0x53, 0xea, 0x3b, 0xb9, 0x78, 0xea, 0x44, 0x32,
+	0x86, 0xde, 0x9c, 0xcb, 0x48, 0x31, 0x9a, 0xb8, 0x99, 0x7a, 0xe8, 0x42, 0x67, 0xce, 0x65, 0xc8,
+	0x68, 0x82, 0x73, 0xdd, 0x83, 0xbe, 0x65, 0x2e, 0x15, 0x37, 0xcc, 0x41, 0x7d, 0x67, 0xd5, 0x9c,
+	0xcb, 0xd7, 0x56, 0xac, 0x52, 0x79, 0x96, 0xd2, 0x95, 0xa3, 0x0e, 0x37, 0xd4, 0x99, 0x15, 0x91,
+	0xfa, 0x04, 0x7a, 0xf1, 0x92, 0xc5, 0xe7, 0x3a, 0x4f, 0x1d, 0x34, 0x28, 0x5c, 0x2f, 0x44, 0x84,
+	0x46, 0xd0, 0xb1, 0xc7, 0xc2, 0x14, 0x35, 0xb9, 0x62, 0xc1, 0x35, 0xb7, 0xa5, 0x8a, 0x44, 0xee,
+	0x40, 0x1b, 0x0f, 0xce, 0x1e, 0x69, 0x40, 0x46, 0xde, 0xc4, 0x0f, 0x7d, 0x2b, 0xbc, 0xa6, 0x2a,
+	0x23, 0x13, 0x18, 0xd0, 0x0b, 0xca, 0x45, 0xa4, 0x57, 0x54, 0x31, 0xc7, 0x7c, 0x80, 0x4c, 0x1f,
+	0xf5, 0x53, 0x2b, 0x23, 0xf9, 0x18, 0xae, 0xdb, 0x64, 0x54, 0x4c, 0x70, 0x3a, 0xe7, 0x82, 0x9b,
+	0xb5, 0xa3, 0xaf, 0x23, 0x4d, 0x12, 0x76, 0x11, 0x6e, 0x87, 0xf0, 0x8b, 0x7b, 0xd0, 0x47, 0xaf,
+	0x64, 0x26, 0x0a, 0xf6, 0x06, 0xb2, 0x5d, 0xab, 0xbe, 0xc8, 0x84, 0xa3, 0x1e, 0xc0, 0xb5, 0x0b,
+	0x29, 0xa8, 0xe1, 0x82, 0x45, 0x29, 0x4b, 0x1d, 0x78, 0x13, 0xc1, 0xc3, 0x72, 0xe0, 0x7b, 0x96,
+	0x22, 0x7b, 0x13, 0x9a, 0xda, 0x50, 0x93, 0xeb, 0xe0, 0xd6, 0xc8, 0x9b, 0x34, 0xc2, 0xa2, 0xb7,
+	0x73, 0x51, 0x82, 0xdd, 0x8b, 0xf2, 0x11, 0x74, 0x8c, 0x34, 0x54, 0x44, 0xf3, 0xb5, 0x61, 0x3a,
+	0xb8, 0x8d, 0x07, 0x0b, 0x28, 0x1d, 0x59, 0xc5, 0x02, 0xce, 0x01, 0x07, 0x0c, 0x1d, 0x80, 0xd2,
+	0x15, 0x00, 0x2d, 0x0a, 0xee, 0xa0, 0xc3, 0xb0, 0x75, 0x87, 0x3c, 0x02, 0x52, 0xf5, 0xd0, 0x2c,
+	0x15, 0xd3, 0xcb, 0xe0, 0x2e, 0x72, 0x83, 0x2d, 0xf7, 0x0a, 0xf5, 0xe7, 0x75, 0xdf, 0x1b, 0x1c,
+	0x3c, 0xaf, 0xfb, 0x07, 0x83, 0xda, 0xb8, 0x03, 0xed, 0xd3, 0x34, 0x39, 0xb6, 0xd6, 0xbd, 0x1d,
+	0xff, 0xe6, 0x01, 0x94, 0x3d, 0xbd, 0xaa, 0xc4, 0xea, 0xed, 0xc4, 0xfa, 0x18, 0x6c, 0x6c, 0x3c,
+	0x66, 0x3a, 0x38, 0x18, 0xd5, 0x26, 0x9d, 0x27, 0x37, 0xa7, 0xb1, 0x11, 0xd3, 0xed, 0x97, 0xd3,
+	0x63, 0x1c, 0x0e, 0x4b, 0x6c, 0x98, 0x40, 0xd3, 0x49, 0x84, 0x40, 0xbd, 0x52, 0x4c, 0xb0, 0x4d,
+	0x6e, 0x41, 0xcb, 0x55, 0x12, 0x37, 0x5f, 0x23, 0x6c, 0x62, 0x29, 0xd1, 0xe4, 0x3a, 0x34, 0xec,
+	0x92, 0x0c, 0xab, 0x46, 0x3b, 0x74, 0x1d, 0xc4, 0x55, 0x44, 0x93, 0x44, 0x05, 0x75, 0xd4, 0x9b,
+	0x46, 0x7d, 0x9d, 0x24, 0x6a, 0xdc, 0xc5, 0xdd, 0xbf, 0x94, 0x52, 0xd8, 0x60, 0x7e, 0xf1, 0xa0,
+	0xb3, 0xe9, 0xee, 0x89, 0xe6, 0x21, 0x34, 0x56, 0x52, 0x8a, 0x32, 0x96, 0x1b, 0x65, 0x2c, 0xe5,
+	0x87, 0x53, 0x6c, 0x38, 0x66, 0x78, 0x02, 0x75, 0xdb, 0xfd, 0xc7, 0x61, 0xcc, 0x85, 0x9c, 0xeb,
+	0xa0, 0x36, 0xaa, 0x4d, 0xea, 0xa1, 0xeb, 0x8c, 0x27, 0xd0, 0x39, 0x66, 0x17, 0xa7, 0x36, 0xa4,
+	0xfd, 0x95, 0x76, 0xfc, 0x13, 0x74, 0xb7, 0xe4, 0x9e, 0x48, 0xaa, 0x53, 0x1c, 0xec, 0xe6, 0xe0,
+	0x1d, 0x68, 0xdb, 0xa1, 0xaa, 0x9b, 0x7e, 0x52, 0xcc, 0x39, 0x4e, 0xa1, 0x87, 0x07, 0xb7, 0x12,
+	0x34, 0xc6, 0xbd, 0x8c, 0xa0, 0x2b, 0x45, 0x12, 0x5d, 0xd9, 0x0f, 0x48, 0x91, 0x1c, 0x17, 0xf3,
+	0x8d, 0xa0, 0x9b, 0xb1, 0xcb, 0xe8, 0xca, 0x72, 0x90, 0xb1, 0xcb, 0x92, 0x08, 0xa0, 0x95, 0xc9,
+	0x90, 0xf1, 0xcc, 0xe0, 0x7a, 0x7e, 0x58, 0x76, 0xc7, 0x0b, 0xe8, 0x57, 0x97, 0xdb, 0x13, 0xd0,
+	0xfb, 0x57, 0xd9, 0x1b, 0xd7, 0x43, 0x5c, 0xe8, 0x24, 0x61, 0x99, 0xe1, 0x6f, 0xd6, 0xef, 0x31,
+	0x99, 0xc2, 0xe1, 0x0e, 0xfc, 0xaf, 0x7d, 0x16, 0x2c, 0xd9, 0xdd, 0x8f, 0x60, 0x89, 0xdb, 0xcf,
+	0x9f, 0x07, 0x98, 0x91, 0x3f, 0xe4, 0x4c, 0xad, 0x9d, 0xcd, 0x1d, 0x99, 0x72, 0x73, 0x5c, 0xdc,
+	0x25, 0x0f, 0x6d, 0xaa, 0x4a, 0xf6, 0xe9, 0xb4, 0xdd, 0x97, 0x45, 0x7e, 0xda, 0xf1, 0xad, 0x40,
+	0x1e, 0xc0, 0x80, 0x67, 0xb1, 0xc8, 0x13, 0xb6, 0x79, 0x70, 0x0b, 0xaf, 0xdf, 0xd1, 0xed, 0x4c,
+	0x9a, 0x99, 0x6f, 0x69, 0x2e, 0xcc, 0x1a, 0xaf, 0x8d, 0x1f, 0x6e, 0x85, 0x4d, 0x3a, 0x37, 0x2a,
+	0xe9, 0x4c, 0xa0, 0xae, 0x68, 0x76, 0x5e, 0x3c, 0xb4, 0xd8, 0xb6, 0x8e, 0x18, 0xaa, 0x16, 0xcc,
+	0x04, 0xad, 0xe2, 0xe6, 0x61, 0xcf, 0x46, 0xa2, 0xdc, 0x79, 0x9e, 0x9d, 0x9d, 0x1c, 0xe3, 0x8b,
+	0xda, 0x0e, 0xab, 0x52, 0x35, 0x1d, 0xda, 0x3b, 0xe9, 0x40, 0x86, 0xe0, 0xf3, 0xc2, 0x75, 0x7c,
+	0x3d, 0xfd, 0x70, 0xd3, 0x27, 0xf7, 0xa1, 0x5f, 0x44, 0xf2, 0x8c, 0x6b, 0x23, 0xd5, 0x1a, 0x9f,
+	0x4e, 0x3f, 0xbc, 0xa2, 0xda, 0xf5, 0x69, 0x6e, 0x64, 0x91, 0x53, 0xf8, 0x76, 0xfa, 0x61, 0x55,
+	0x1a, 0xff, 0x51, 0x83, 0xee, 0xd6, 0xfb, 0xbd, 0xc5, 0xad, 0x61, 0x43, 0x2d, 0xcb, 0xc1, 0xb0,
+	0x2c, 0x07, 0x9b, 0x2f, 0xa7, 0x21, 0xcd, 0xce, 0x6d, 0x23, 0x74, 0xe0, 0xf0, 0x77, 0xef, 0xff,
+	0xa8, 0x6e, 0xe4, 0x01, 0x34, 0x97, 0xee, 0x8c, 0xed, 0x29, 0x75, 0x9e, 0x10, 0xdc, 0xd9, 0xce,
+	0xaf, 0x56, 0x58, 0x10, 0xe4, 0x11, 0xb4, 0x96, 0x85, 0x61, 0xcd, 0x51, 0xed, 0x6f, 0xe0, 0x12,
+	0xf9, 0x0f, 0x8b, 0xda, 0xf0, 0x67, 0x0f, 0xfc, 0xd2, 0x9f, 0x4d, 0x06, 0x79, 0x95, 0x0c, 0x7a,
+	0x72, 0xf5, 0xed, 0x08, 0xde, 0x35, 0xf8, 0xca, 0xeb, 0x41, 0x1e, 0x95, 0x15, 0xba, 0xb6, 0xfb,
+	0xda, 0x6c, 0xbf, 0xa8, 0x94, 0xe8, 0xa3, 0x2f, 0x7f, 0xfc, 0x62, 0xc1, 0xcd, 0x32, 0x9f, 0x4f,
+	0x63, 0x99, 0xce, 0x12, 0x2a, 0xf5, 0x67, 0xda, 0xd0, 0xf8, 0x1c, 0x9b, 0x33, 0xad, 0xe2, 0x59,
+	0x2c, 0x33, 0xa3, 0xa4, 0x98, 0xc5, 0x32, 0x4d, 0x65, 0x36, 0xc3, 0xdf, 0xdf, 0x59, 0x6c, 0xc4,
+	0xbc, 0x89, 0xcd, 0xcf, 0xff, 0x1a, 0x00, 0xcf, 0x36, 0x44, 0xd2, 0x1a, 0x0b, 0x00, 0x00,
 }
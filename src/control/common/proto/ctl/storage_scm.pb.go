@@ -209,6 +209,7 @@ type ScmNamespace_Mount struct {
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	TotalBytes           uint64   `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
 	AvailBytes           uint64   `protobuf:"varint,3,opt,name=avail_bytes,json=availBytes,proto3" json:"avail_bytes,omitempty"`
+	Rank                 uint32   `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -260,6 +261,13 @@ func (m *ScmNamespace_Mount) GetAvailBytes() uint64 {
 	return 0
 }
 
+func (m *ScmNamespace_Mount) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
 // ScmModuleResult represents operation state for specific SCM/PM module.
 //
 // TODO: replace identifier with serial when returned in scan
@@ -603,45 +611,45 @@ func init() {
 }
 
 var fileDescriptor_dd3a540aea514928 = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0x13, 0x3d,
-	0x10, 0xd5, 0x66, 0x93, 0x36, 0x3b, 0x69, 0xd3, 0x7e, 0xd6, 0x87, 0x58, 0x05, 0x04, 0xd1, 0x4a,
-	0xa0, 0x15, 0x52, 0x13, 0x51, 0x0e, 0x88, 0x6b, 0x0f, 0xdc, 0x5a, 0x21, 0xe7, 0x06, 0x42, 0x95,
-	0xe3, 0x1d, 0x1a, 0xab, 0x6b, 0x7b, 0x6b, 0x7b, 0x4b, 0xcb, 0x9f, 0xe0, 0x02, 0x3f, 0x83, 0xff,
-	0x88, 0xec, 0xdd, 0x4d, 0x43, 0x7a, 0x80, 0xde, 0xe6, 0xbd, 0x79, 0x3b, 0x7e, 0x33, 0xe3, 0x35,
-	0x3c, 0xe2, 0xae, 0x9c, 0x5b, 0xa7, 0x0d, 0xbb, 0xc0, 0x73, 0xcb, 0xe5, 0xac, 0x32, 0xda, 0x69,
-	0x12, 0x73, 0x57, 0x4e, 0x0e, 0x7d, 0x8e, 0x6b, 0x29, 0xb5, 0x6a, 0xe8, 0xec, 0x57, 0x0f, 0x92,
-	0x05, 0x97, 0xa7, 0xba, 0xa8, 0x4b, 0x24, 0x4f, 0x21, 0xe1, 0x2b, 0xa6, 0x14, 0x96, 0xa2, 0x48,
-	0xa3, 0x69, 0x94, 0xef, 0xd3, 0x3b, 0x82, 0xe4, 0x70, 0xd0, 0x82, 0x4a, 0x5b, 0xe1, 0x84, 0x56,
-	0x69, 0x2f, 0x68, 0xb6, 0x69, 0x92, 0xc1, 0x1e, 0xd7, 0xca, 0x19, 0x5d, 0x96, 0x68, 0x44, 0x91,
-	0xc6, 0x41, 0xf6, 0x07, 0x47, 0x26, 0x30, 0xb4, 0x9a, 0x5f, 0xa2, 0x13, 0x45, 0xda, 0x0f, 0xf9,
-	0x35, 0x26, 0xcf, 0x00, 0xaa, 0xd5, 0xad, 0x15, 0x9c, 0x79, 0x23, 0x83, 0x90, 0xdd, 0x60, 0xfc,
-	0xb7, 0x9c, 0x55, 0x8c, 0x0b, 0x77, 0x9b, 0xee, 0x4c, 0xa3, 0xbc, 0x4f, 0xd7, 0x98, 0x1c, 0x42,
-	0x5c, 0x8b, 0x22, 0xdd, 0x9d, 0x46, 0x79, 0x42, 0x7d, 0x18, 0xaa, 0x31, 0xe3, 0xce, 0x6a, 0xb9,
-	0x44, 0x93, 0x0e, 0x43, 0x62, 0x83, 0x21, 0xaf, 0xe0, 0xf0, 0x8b, 0x30, 0xf2, 0x2b, 0x33, 0x48,
-	0xf1, 0x5a, 0x58, 0xdf, 0x58, 0x12, 0x54, 0xf7, 0xf8, 0xec, 0x7b, 0x0f, 0xf6, 0x16, 0x5c, 0x9e,
-	0x31, 0x89, 0xb6, 0x62, 0x1c, 0x09, 0x81, 0x7e, 0x5d, 0xb7, 0xd3, 0x4a, 0x68, 0x88, 0xbd, 0xbd,
-	0x65, 0xa9, 0xf9, 0x65, 0x81, 0xd7, 0x61, 0x42, 0x09, 0x5d, 0x63, 0x6f, 0xcf, 0xd3, 0x71, 0x63,
-	0xcf, 0x33, 0x4f, 0x20, 0x51, 0xb5, 0x64, 0xe7, 0x4a, 0x17, 0xd8, 0x4d, 0xc2, 0x13, 0x67, 0xba,
-	0x08, 0xe5, 0xad, 0xf8, 0x86, 0x61, 0x06, 0x7d, 0x1a, 0x62, 0x72, 0x04, 0x03, 0xa9, 0x6b, 0xe5,
-	0x42, 0xeb, 0xa3, 0xe3, 0xc7, 0x33, 0xee, 0xca, 0xd9, 0xa6, 0xa9, 0xd9, 0xa9, 0x4f, 0xd3, 0x46,
-	0x35, 0xf9, 0x0c, 0x83, 0x80, 0x7d, 0xad, 0x8a, 0xb9, 0x55, 0x67, 0xd5, 0xc7, 0xe4, 0x39, 0x8c,
-	0x9c, 0x76, 0xac, 0x3c, 0x5f, 0xde, 0x3a, 0xb4, 0xc1, 0x6d, 0x9f, 0x42, 0xa0, 0x4e, 0x3c, 0xe3,
-	0x05, 0xec, 0x9a, 0x89, 0x4e, 0x10, 0x37, 0x82, 0x40, 0x05, 0x41, 0xf6, 0x09, 0x0e, 0xd6, 0x17,
-	0x88, 0xa2, 0xad, 0x4b, 0xb7, 0xb5, 0xbe, 0xe8, 0xde, 0xfa, 0x72, 0x18, 0x58, 0xc7, 0x1c, 0x86,
-	0xe3, 0x46, 0xc7, 0x24, 0x34, 0x40, 0xd1, 0x56, 0x5a, 0x59, 0x5c, 0xf8, 0x0c, 0x6d, 0x04, 0xd9,
-	0x0d, 0x8c, 0x43, 0x71, 0xdf, 0x4e, 0x53, 0x7b, 0x02, 0x43, 0xa9, 0x5c, 0xa5, 0x85, 0x72, 0x6d,
-	0x23, 0x6b, 0xfc, 0xef, 0x75, 0xc9, 0x14, 0x46, 0x42, 0x59, 0xc7, 0x14, 0x47, 0x51, 0xdc, 0xb4,
-	0xf7, 0x73, 0x93, 0xca, 0x5e, 0xc0, 0xfe, 0x07, 0x83, 0x15, 0x33, 0xb8, 0xe0, 0x92, 0xe2, 0x15,
-	0xf9, 0x1f, 0x06, 0x06, 0x2d, 0x36, 0xa7, 0x0e, 0x69, 0x03, 0xb2, 0x9f, 0x11, 0x8c, 0x37, 0x75,
-	0xb6, 0x22, 0xaf, 0x01, 0x54, 0xb7, 0x09, 0x9b, 0x46, 0xd3, 0x38, 0x1f, 0x1d, 0xff, 0x77, 0x6f,
-	0x47, 0x74, 0x43, 0xf4, 0x00, 0xe3, 0x2f, 0x61, 0x6c, 0x70, 0xa9, 0xb5, 0x33, 0x78, 0x55, 0x0b,
-	0x83, 0xcd, 0xbf, 0x35, 0xa4, 0x5b, 0x6c, 0x96, 0x01, 0x2c, 0x38, 0x53, 0x77, 0xde, 0x6b, 0xcb,
-	0x2e, 0xb0, 0xf3, 0x1e, 0x40, 0xf6, 0x23, 0x82, 0xd1, 0x5a, 0x64, 0x2b, 0x92, 0xc3, 0xae, 0x0c,
-	0x6b, 0xec, 0x5c, 0x8f, 0x3b, 0xd7, 0xed, 0x76, 0xbb, 0xf4, 0x56, 0x8b, 0xbd, 0x07, 0xb5, 0x18,
-	0xff, 0x6d, 0xe7, 0x63, 0xd8, 0x7b, 0xaf, 0x8d, 0x64, 0xae, 0x31, 0x7f, 0xf2, 0xee, 0xe3, 0xdb,
-	0x0b, 0xe1, 0x56, 0xf5, 0x72, 0xc6, 0xb5, 0x9c, 0x17, 0x4c, 0xdb, 0x23, 0xeb, 0x18, 0xbf, 0x0c,
-	0xe1, 0xdc, 0x1a, 0x3e, 0x6f, 0xdf, 0x95, 0xf6, 0x65, 0x9b, 0x87, 0x97, 0x6d, 0xce, 0x5d, 0xb9,
-	0xdc, 0x09, 0xe1, 0x9b, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x13, 0xa7, 0xad, 0x14, 0x05,
-	0x00, 0x00,
+	// 632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0x13, 0x3b,
+	0x10, 0xc7, 0xb5, 0xd9, 0xa4, 0xcd, 0x4e, 0xda, 0xb4, 0xcf, 0x7a, 0x4f, 0x6f, 0x15, 0x10, 0x44,
+	0x2b, 0x81, 0x56, 0x48, 0x4d, 0x44, 0x39, 0x20, 0xae, 0x3d, 0x70, 0x6b, 0x85, 0x9c, 0x1b, 0x1c,
+	0x2a, 0xc7, 0x3b, 0x34, 0x56, 0xd6, 0xf6, 0xd6, 0xf6, 0x96, 0x96, 0xcf, 0x01, 0x9f, 0x80, 0x33,
+	0xdf, 0x11, 0xd9, 0xbb, 0x9b, 0x86, 0xf4, 0x00, 0xbd, 0xcd, 0xfc, 0xe7, 0x9f, 0xc9, 0x6f, 0x3c,
+	0x5e, 0xc3, 0x7f, 0xdc, 0x95, 0x73, 0xeb, 0xb4, 0x61, 0x57, 0x78, 0x69, 0xb9, 0x9c, 0x55, 0x46,
+	0x3b, 0x4d, 0x62, 0xee, 0xca, 0xc9, 0xb1, 0xaf, 0x71, 0x2d, 0xa5, 0x56, 0x8d, 0x9c, 0xfd, 0xec,
+	0x41, 0xb2, 0xe0, 0xf2, 0x5c, 0x17, 0x75, 0x89, 0xe4, 0x29, 0x24, 0x7c, 0xc5, 0x94, 0xc2, 0x52,
+	0x14, 0x69, 0x34, 0x8d, 0xf2, 0x43, 0x7a, 0x2f, 0x90, 0x1c, 0x8e, 0xda, 0xa4, 0xd2, 0x56, 0x38,
+	0xa1, 0x55, 0xda, 0x0b, 0x9e, 0x5d, 0x99, 0x64, 0x70, 0xc0, 0xb5, 0x72, 0x46, 0x97, 0x25, 0x1a,
+	0x51, 0xa4, 0x71, 0xb0, 0xfd, 0xa6, 0x91, 0x09, 0x0c, 0xad, 0xe6, 0x6b, 0x74, 0xa2, 0x48, 0xfb,
+	0xa1, 0xbe, 0xc9, 0xc9, 0x33, 0x80, 0x6a, 0x75, 0x67, 0x05, 0x67, 0x1e, 0x64, 0x10, 0xaa, 0x5b,
+	0x8a, 0xff, 0x2d, 0x67, 0x15, 0xe3, 0xc2, 0xdd, 0xa5, 0x7b, 0xd3, 0x28, 0xef, 0xd3, 0x4d, 0x4e,
+	0x8e, 0x21, 0xae, 0x45, 0x91, 0xee, 0x4f, 0xa3, 0x3c, 0xa1, 0x71, 0xdd, 0x76, 0x63, 0xc6, 0x5d,
+	0xd4, 0x72, 0x89, 0x26, 0x1d, 0x86, 0xc2, 0x96, 0x42, 0x5e, 0xc1, 0xf1, 0x67, 0x61, 0xe4, 0x17,
+	0x66, 0x90, 0xe2, 0x8d, 0xb0, 0x7e, 0xb0, 0x24, 0xb8, 0x1e, 0xe8, 0xd9, 0x8f, 0x1e, 0x1c, 0x2c,
+	0xb8, 0xbc, 0x60, 0x12, 0x6d, 0xc5, 0x38, 0x12, 0x02, 0xfd, 0xba, 0x6e, 0x4f, 0x2b, 0xa1, 0x21,
+	0xf6, 0x78, 0xcb, 0x52, 0xf3, 0x75, 0x81, 0x37, 0xe1, 0x84, 0x12, 0xba, 0xc9, 0x3d, 0x9e, 0x97,
+	0xe3, 0x06, 0xcf, 0x2b, 0x4f, 0x20, 0x51, 0xb5, 0x64, 0x97, 0x4a, 0x17, 0xd8, 0x9d, 0x84, 0x17,
+	0x2e, 0x74, 0x11, 0xda, 0x5b, 0xf1, 0x15, 0xc3, 0x19, 0xf4, 0x69, 0x88, 0xc9, 0x09, 0x0c, 0xa4,
+	0xae, 0x95, 0x0b, 0xa3, 0x8f, 0x4e, 0xff, 0x9f, 0x71, 0x57, 0xce, 0xb6, 0xa1, 0x66, 0xe7, 0xbe,
+	0x4c, 0x1b, 0xd7, 0xe4, 0x1a, 0x06, 0x21, 0xf7, 0xbd, 0x2a, 0xe6, 0x56, 0x1d, 0xaa, 0x8f, 0xc9,
+	0x73, 0x18, 0x39, 0xed, 0x58, 0x79, 0xb9, 0xbc, 0x73, 0x68, 0x03, 0x6d, 0x9f, 0x42, 0x90, 0xce,
+	0xbc, 0xe2, 0x0d, 0xec, 0x86, 0x89, 0xce, 0x10, 0x37, 0x86, 0x20, 0x35, 0x06, 0x02, 0x7d, 0xc3,
+	0xd4, 0xba, 0x25, 0x0f, 0x71, 0xf6, 0x09, 0x8e, 0x36, 0x97, 0x8a, 0xa2, 0xad, 0x4b, 0xb7, 0xb3,
+	0xd2, 0xe8, 0xc1, 0x4a, 0x73, 0x18, 0x58, 0xc7, 0x1c, 0x06, 0x84, 0xd1, 0x29, 0x09, 0x43, 0x51,
+	0xb4, 0x95, 0x56, 0x16, 0x17, 0xbe, 0x42, 0x1b, 0x43, 0x76, 0x0b, 0xe3, 0xd0, 0xdc, 0x8f, 0xd8,
+	0xf4, 0x9e, 0xc0, 0x50, 0x2a, 0x57, 0x69, 0xa1, 0x5c, 0x3b, 0xdc, 0x26, 0xff, 0xfb, 0xbe, 0x64,
+	0x0a, 0x23, 0xa1, 0xac, 0x63, 0x8a, 0xa3, 0x28, 0x6e, 0xdb, 0x3b, 0xbb, 0x2d, 0x65, 0x2f, 0xe0,
+	0xf0, 0x83, 0xc1, 0x8a, 0x19, 0x5c, 0x70, 0x49, 0xf1, 0x9a, 0xfc, 0x0b, 0x03, 0x83, 0x16, 0x9b,
+	0x7f, 0x1d, 0xd2, 0x26, 0xc9, 0xbe, 0x47, 0x30, 0xde, 0xf6, 0xd9, 0x8a, 0xbc, 0x06, 0x50, 0xdd,
+	0x76, 0x6c, 0x1a, 0x4d, 0xe3, 0x7c, 0x74, 0xfa, 0xcf, 0x83, 0xbd, 0xd1, 0x2d, 0xd3, 0x23, 0xc0,
+	0x5f, 0xc2, 0xd8, 0xe0, 0x52, 0x6b, 0x67, 0xf0, 0xba, 0x16, 0x06, 0x9b, 0xef, 0x6d, 0x48, 0x77,
+	0xd4, 0x2c, 0x03, 0x58, 0x70, 0xa6, 0xee, 0xd9, 0x6b, 0xcb, 0xae, 0xb0, 0x63, 0x0f, 0x49, 0xf6,
+	0x2d, 0x82, 0xd1, 0xc6, 0x64, 0x2b, 0x92, 0xc3, 0xbe, 0x0c, 0x6b, 0xec, 0xa8, 0xc7, 0x1d, 0x75,
+	0xbb, 0xdd, 0xae, 0xbc, 0x33, 0x62, 0xef, 0x51, 0x23, 0xc6, 0x7f, 0xda, 0xf9, 0x18, 0x0e, 0xde,
+	0x6b, 0x23, 0x99, 0x6b, 0xe0, 0xcf, 0xde, 0x7d, 0x7c, 0x7b, 0x25, 0xdc, 0xaa, 0x5e, 0xce, 0xb8,
+	0x96, 0xf3, 0x82, 0x69, 0x7b, 0x62, 0x1d, 0xe3, 0xeb, 0x10, 0xce, 0xad, 0xe1, 0xf3, 0xf6, 0xad,
+	0x69, 0x5f, 0xbb, 0x79, 0x78, 0xed, 0xe6, 0xdc, 0x95, 0xcb, 0xbd, 0x10, 0xbe, 0xf9, 0x35, 0x00,
+	0x3e, 0x51, 0x71, 0xf0, 0x28, 0x05, 0x00, 0x00,
 }
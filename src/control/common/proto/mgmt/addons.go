@@ -41,47 +41,170 @@ func (r *PoolSetPropReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
 }
 
+// SetUUID sets the request's pool UUID.
+func (r *PoolSetPropReq) SetUUID(id string) {
+	r.Uuid = id
+}
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *PoolGetPropReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetUUID sets the request's pool UUID.
+func (r *PoolGetPropReq) SetUUID(id string) {
+	r.Uuid = id
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *PoolEvictReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
 }
 
+// SetUUID sets the request's pool UUID.
+func (r *PoolEvictReq) SetUUID(id string) {
+	r.Uuid = id
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *PoolExcludeReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
 }
 
+// SetUUID sets the request's pool UUID.
+func (r *PoolExcludeReq) SetUUID(id string) {
+	r.Uuid = id
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *PoolDrainReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
 }
 
+// SetUUID sets the request's pool UUID.
+func (r *PoolDrainReq) SetUUID(id string) {
+	r.Uuid = id
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *PoolReintegrateReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
 }
 
+// SetUUID sets the request's pool UUID.
+func (r *PoolReintegrateReq) SetUUID(id string) {
+	r.Uuid = id
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *PoolExtendReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
 }
 
+// SetUUID sets the request's pool UUID.
+func (r *PoolExtendReq) SetUUID(id string) {
+	r.Uuid = id
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *PoolQueryReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
 }
 
+// SetUUID sets the request's pool UUID.
+func (r *PoolQueryReq) SetUUID(id string) {
+	r.Uuid = id
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *GetACLReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
 }
 
+// SetUUID sets the request's pool UUID.
+func (r *GetACLReq) SetUUID(id string) {
+	r.Uuid = id
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *ModifyACLReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
 }
 
+// SetUUID sets the request's pool UUID.
+func (r *ModifyACLReq) SetUUID(id string) {
+	r.Uuid = id
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *DeleteACLReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
 }
+
+// SetUUID sets the request's pool UUID.
+func (r *DeleteACLReq) SetUUID(id string) {
+	r.Uuid = id
+}
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *ListContReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetUUID sets the request's pool UUID.
+func (r *ListContReq) SetUUID(id string) {
+	r.Uuid = id
+}
+
+// The following set of addons implements the contServiceReq interface
+// in mgmt_cont.go.
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *ContSetOwnerReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetPoolUUID sets the request's pool UUID.
+func (r *ContSetOwnerReq) SetPoolUUID(id string) {
+	r.PoolUUID = id
+}
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *ContQueryReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetPoolUUID sets the request's pool UUID.
+func (r *ContQueryReq) SetPoolUUID(id string) {
+	r.PoolUUID = id
+}
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *ContDestroyReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetPoolUUID sets the request's pool UUID.
+func (r *ContDestroyReq) SetPoolUUID(id string) {
+	r.PoolUUID = id
+}
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *ContGetPropReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetPoolUUID sets the request's pool UUID.
+func (r *ContGetPropReq) SetPoolUUID(id string) {
+	r.PoolUUID = id
+}
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *ContSetPropReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetPoolUUID sets the request's pool UUID.
+func (r *ContSetPropReq) SetPoolUUID(id string) {
+	r.PoolUUID = id
+}
@@ -0,0 +1,837 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: mgmt/agent.proto
+
+package mgmt
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// AgentCacheRefreshReq requests that the attach info cache be regenerated.
+type AgentCacheRefreshReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgentCacheRefreshReq) Reset()         { *m = AgentCacheRefreshReq{} }
+func (m *AgentCacheRefreshReq) String() string { return proto.CompactTextString(m) }
+func (*AgentCacheRefreshReq) ProtoMessage()    {}
+func (*AgentCacheRefreshReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47428c3f7edaadf, []int{0}
+}
+
+func (m *AgentCacheRefreshReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentCacheRefreshReq.Unmarshal(m, b)
+}
+func (m *AgentCacheRefreshReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentCacheRefreshReq.Marshal(b, m, deterministic)
+}
+func (m *AgentCacheRefreshReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentCacheRefreshReq.Merge(m, src)
+}
+func (m *AgentCacheRefreshReq) XXX_Size() int {
+	return xxx_messageInfo_AgentCacheRefreshReq.Size(m)
+}
+func (m *AgentCacheRefreshReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentCacheRefreshReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentCacheRefreshReq proto.InternalMessageInfo
+
+func (m *AgentCacheRefreshReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+// AgentCacheRefreshResp describes the attach info cached following a refresh.
+type AgentCacheRefreshResp struct {
+	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	MapVersion           uint32   `protobuf:"varint,2,opt,name=map_version,json=mapVersion,proto3" json:"map_version,omitempty"`
+	Provider             string   `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	NumRanks             uint32   `protobuf:"varint,4,opt,name=num_ranks,json=numRanks,proto3" json:"num_ranks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgentCacheRefreshResp) Reset()         { *m = AgentCacheRefreshResp{} }
+func (m *AgentCacheRefreshResp) String() string { return proto.CompactTextString(m) }
+func (*AgentCacheRefreshResp) ProtoMessage()    {}
+func (*AgentCacheRefreshResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47428c3f7edaadf, []int{1}
+}
+
+func (m *AgentCacheRefreshResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentCacheRefreshResp.Unmarshal(m, b)
+}
+func (m *AgentCacheRefreshResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentCacheRefreshResp.Marshal(b, m, deterministic)
+}
+func (m *AgentCacheRefreshResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentCacheRefreshResp.Merge(m, src)
+}
+func (m *AgentCacheRefreshResp) XXX_Size() int {
+	return xxx_messageInfo_AgentCacheRefreshResp.Size(m)
+}
+func (m *AgentCacheRefreshResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentCacheRefreshResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentCacheRefreshResp proto.InternalMessageInfo
+
+func (m *AgentCacheRefreshResp) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AgentCacheRefreshResp) GetMapVersion() uint32 {
+	if m != nil {
+		return m.MapVersion
+	}
+	return 0
+}
+
+func (m *AgentCacheRefreshResp) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *AgentCacheRefreshResp) GetNumRanks() uint32 {
+	if m != nil {
+		return m.NumRanks
+	}
+	return 0
+}
+
+// AgentStatusReq requests a summary of the agent's state.
+type AgentStatusReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgentStatusReq) Reset()         { *m = AgentStatusReq{} }
+func (m *AgentStatusReq) String() string { return proto.CompactTextString(m) }
+func (*AgentStatusReq) ProtoMessage()    {}
+func (*AgentStatusReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47428c3f7edaadf, []int{2}
+}
+
+func (m *AgentStatusReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentStatusReq.Unmarshal(m, b)
+}
+func (m *AgentStatusReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentStatusReq.Marshal(b, m, deterministic)
+}
+func (m *AgentStatusReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentStatusReq.Merge(m, src)
+}
+func (m *AgentStatusReq) XXX_Size() int {
+	return xxx_messageInfo_AgentStatusReq.Size(m)
+}
+func (m *AgentStatusReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentStatusReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentStatusReq proto.InternalMessageInfo
+
+func (m *AgentStatusReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+// AgentStatusResp summarizes the state of the agent and its connectivity
+// with the management service.
+type AgentStatusResp struct {
+	Error                string                    `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Version              string                    `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Sys                  string                    `protobuf:"bytes,3,opt,name=sys,proto3" json:"sys,omitempty"`
+	Pid                  int32                     `protobuf:"varint,4,opt,name=pid,proto3" json:"pid,omitempty"`
+	StartTime            int64                     `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	AccessPoints         []string                  `protobuf:"bytes,6,rep,name=access_points,json=accessPoints,proto3" json:"access_points,omitempty"`
+	MsReachable          bool                      `protobuf:"varint,7,opt,name=ms_reachable,json=msReachable,proto3" json:"ms_reachable,omitempty"`
+	MsError              string                    `protobuf:"bytes,8,opt,name=ms_error,json=msError,proto3" json:"ms_error,omitempty"`
+	MsLastContact        int64                     `protobuf:"varint,9,opt,name=ms_last_contact,json=msLastContact,proto3" json:"ms_last_contact,omitempty"`
+	MapWatchActive       bool                      `protobuf:"varint,10,opt,name=map_watch_active,json=mapWatchActive,proto3" json:"map_watch_active,omitempty"`
+	CacheEnabled         bool                      `protobuf:"varint,11,opt,name=cache_enabled,json=cacheEnabled,proto3" json:"cache_enabled,omitempty"`
+	CacheInitialized     bool                      `protobuf:"varint,12,opt,name=cache_initialized,json=cacheInitialized,proto3" json:"cache_initialized,omitempty"`
+	CacheMapVersion      uint32                    `protobuf:"varint,13,opt,name=cache_map_version,json=cacheMapVersion,proto3" json:"cache_map_version,omitempty"`
+	NumProcs             uint32                    `protobuf:"varint,14,opt,name=num_procs,json=numProcs,proto3" json:"num_procs,omitempty"`
+	NumHandles           uint32                    `protobuf:"varint,15,opt,name=num_handles,json=numHandles,proto3" json:"num_handles,omitempty"`
+	Systems              []string                  `protobuf:"bytes,16,rep,name=systems,proto3" json:"systems,omitempty"`
+	FabricPolicy         string                    `protobuf:"bytes,17,opt,name=fabric_policy,json=fabricPolicy,proto3" json:"fabric_policy,omitempty"`
+	Clients              []*AgentStatusResp_Client `protobuf:"bytes,18,rep,name=clients,proto3" json:"clients,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *AgentStatusResp) Reset()         { *m = AgentStatusResp{} }
+func (m *AgentStatusResp) String() string { return proto.CompactTextString(m) }
+func (*AgentStatusResp) ProtoMessage()    {}
+func (*AgentStatusResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47428c3f7edaadf, []int{3}
+}
+
+func (m *AgentStatusResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentStatusResp.Unmarshal(m, b)
+}
+func (m *AgentStatusResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentStatusResp.Marshal(b, m, deterministic)
+}
+func (m *AgentStatusResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentStatusResp.Merge(m, src)
+}
+func (m *AgentStatusResp) XXX_Size() int {
+	return xxx_messageInfo_AgentStatusResp.Size(m)
+}
+func (m *AgentStatusResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentStatusResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentStatusResp proto.InternalMessageInfo
+
+func (m *AgentStatusResp) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AgentStatusResp) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *AgentStatusResp) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *AgentStatusResp) GetPid() int32 {
+	if m != nil {
+		return m.Pid
+	}
+	return 0
+}
+
+func (m *AgentStatusResp) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *AgentStatusResp) GetAccessPoints() []string {
+	if m != nil {
+		return m.AccessPoints
+	}
+	return nil
+}
+
+func (m *AgentStatusResp) GetMsReachable() bool {
+	if m != nil {
+		return m.MsReachable
+	}
+	return false
+}
+
+func (m *AgentStatusResp) GetMsError() string {
+	if m != nil {
+		return m.MsError
+	}
+	return ""
+}
+
+func (m *AgentStatusResp) GetMsLastContact() int64 {
+	if m != nil {
+		return m.MsLastContact
+	}
+	return 0
+}
+
+func (m *AgentStatusResp) GetMapWatchActive() bool {
+	if m != nil {
+		return m.MapWatchActive
+	}
+	return false
+}
+
+func (m *AgentStatusResp) GetCacheEnabled() bool {
+	if m != nil {
+		return m.CacheEnabled
+	}
+	return false
+}
+
+func (m *AgentStatusResp) GetCacheInitialized() bool {
+	if m != nil {
+		return m.CacheInitialized
+	}
+	return false
+}
+
+func (m *AgentStatusResp) GetCacheMapVersion() uint32 {
+	if m != nil {
+		return m.CacheMapVersion
+	}
+	return 0
+}
+
+func (m *AgentStatusResp) GetNumProcs() uint32 {
+	if m != nil {
+		return m.NumProcs
+	}
+	return 0
+}
+
+func (m *AgentStatusResp) GetNumHandles() uint32 {
+	if m != nil {
+		return m.NumHandles
+	}
+	return 0
+}
+
+func (m *AgentStatusResp) GetSystems() []string {
+	if m != nil {
+		return m.Systems
+	}
+	return nil
+}
+
+func (m *AgentStatusResp) GetFabricPolicy() string {
+	if m != nil {
+		return m.FabricPolicy
+	}
+	return ""
+}
+
+func (m *AgentStatusResp) GetClients() []*AgentStatusResp_Client {
+	if m != nil {
+		return m.Clients
+	}
+	return nil
+}
+
+type AgentStatusResp_Client struct {
+	Pid                  int32    `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Interface            string   `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	NumaNode             int32    `protobuf:"varint,3,opt,name=numa_node,json=numaNode,proto3" json:"numa_node,omitempty"`
+	Handles              uint32   `protobuf:"varint,4,opt,name=handles,proto3" json:"handles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgentStatusResp_Client) Reset()         { *m = AgentStatusResp_Client{} }
+func (m *AgentStatusResp_Client) String() string { return proto.CompactTextString(m) }
+func (*AgentStatusResp_Client) ProtoMessage()    {}
+func (*AgentStatusResp_Client) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47428c3f7edaadf, []int{3, 0}
+}
+
+func (m *AgentStatusResp_Client) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentStatusResp_Client.Unmarshal(m, b)
+}
+func (m *AgentStatusResp_Client) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentStatusResp_Client.Marshal(b, m, deterministic)
+}
+func (m *AgentStatusResp_Client) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentStatusResp_Client.Merge(m, src)
+}
+func (m *AgentStatusResp_Client) XXX_Size() int {
+	return xxx_messageInfo_AgentStatusResp_Client.Size(m)
+}
+func (m *AgentStatusResp_Client) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentStatusResp_Client.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentStatusResp_Client proto.InternalMessageInfo
+
+func (m *AgentStatusResp_Client) GetPid() int32 {
+	if m != nil {
+		return m.Pid
+	}
+	return 0
+}
+
+func (m *AgentStatusResp_Client) GetInterface() string {
+	if m != nil {
+		return m.Interface
+	}
+	return ""
+}
+
+func (m *AgentStatusResp_Client) GetNumaNode() int32 {
+	if m != nil {
+		return m.NumaNode
+	}
+	return 0
+}
+
+func (m *AgentStatusResp_Client) GetHandles() uint32 {
+	if m != nil {
+		return m.Handles
+	}
+	return 0
+}
+
+// AgentHandlesReq requests the pool handles held by monitored processes.
+type AgentHandlesReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgentHandlesReq) Reset()         { *m = AgentHandlesReq{} }
+func (m *AgentHandlesReq) String() string { return proto.CompactTextString(m) }
+func (*AgentHandlesReq) ProtoMessage()    {}
+func (*AgentHandlesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47428c3f7edaadf, []int{4}
+}
+
+func (m *AgentHandlesReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentHandlesReq.Unmarshal(m, b)
+}
+func (m *AgentHandlesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentHandlesReq.Marshal(b, m, deterministic)
+}
+func (m *AgentHandlesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentHandlesReq.Merge(m, src)
+}
+func (m *AgentHandlesReq) XXX_Size() int {
+	return xxx_messageInfo_AgentHandlesReq.Size(m)
+}
+func (m *AgentHandlesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentHandlesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentHandlesReq proto.InternalMessageInfo
+
+func (m *AgentHandlesReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+// AgentHandlesResp lists the pool handles held by each monitored process.
+type AgentHandlesResp struct {
+	Error                string                      `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Processes            []*AgentHandlesResp_Process `protobuf:"bytes,2,rep,name=processes,proto3" json:"processes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *AgentHandlesResp) Reset()         { *m = AgentHandlesResp{} }
+func (m *AgentHandlesResp) String() string { return proto.CompactTextString(m) }
+func (*AgentHandlesResp) ProtoMessage()    {}
+func (*AgentHandlesResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47428c3f7edaadf, []int{5}
+}
+
+func (m *AgentHandlesResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentHandlesResp.Unmarshal(m, b)
+}
+func (m *AgentHandlesResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentHandlesResp.Marshal(b, m, deterministic)
+}
+func (m *AgentHandlesResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentHandlesResp.Merge(m, src)
+}
+func (m *AgentHandlesResp) XXX_Size() int {
+	return xxx_messageInfo_AgentHandlesResp.Size(m)
+}
+func (m *AgentHandlesResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentHandlesResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentHandlesResp proto.InternalMessageInfo
+
+func (m *AgentHandlesResp) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AgentHandlesResp) GetProcesses() []*AgentHandlesResp_Process {
+	if m != nil {
+		return m.Processes
+	}
+	return nil
+}
+
+type AgentHandlesResp_Pool struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Handles              []string `protobuf:"bytes,2,rep,name=handles,proto3" json:"handles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgentHandlesResp_Pool) Reset()         { *m = AgentHandlesResp_Pool{} }
+func (m *AgentHandlesResp_Pool) String() string { return proto.CompactTextString(m) }
+func (*AgentHandlesResp_Pool) ProtoMessage()    {}
+func (*AgentHandlesResp_Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47428c3f7edaadf, []int{5, 0}
+}
+
+func (m *AgentHandlesResp_Pool) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentHandlesResp_Pool.Unmarshal(m, b)
+}
+func (m *AgentHandlesResp_Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentHandlesResp_Pool.Marshal(b, m, deterministic)
+}
+func (m *AgentHandlesResp_Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentHandlesResp_Pool.Merge(m, src)
+}
+func (m *AgentHandlesResp_Pool) XXX_Size() int {
+	return xxx_messageInfo_AgentHandlesResp_Pool.Size(m)
+}
+func (m *AgentHandlesResp_Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentHandlesResp_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentHandlesResp_Pool proto.InternalMessageInfo
+
+func (m *AgentHandlesResp_Pool) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *AgentHandlesResp_Pool) GetHandles() []string {
+	if m != nil {
+		return m.Handles
+	}
+	return nil
+}
+
+type AgentHandlesResp_Process struct {
+	Pid                  int32                    `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Pools                []*AgentHandlesResp_Pool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *AgentHandlesResp_Process) Reset()         { *m = AgentHandlesResp_Process{} }
+func (m *AgentHandlesResp_Process) String() string { return proto.CompactTextString(m) }
+func (*AgentHandlesResp_Process) ProtoMessage()    {}
+func (*AgentHandlesResp_Process) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47428c3f7edaadf, []int{5, 1}
+}
+
+func (m *AgentHandlesResp_Process) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentHandlesResp_Process.Unmarshal(m, b)
+}
+func (m *AgentHandlesResp_Process) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentHandlesResp_Process.Marshal(b, m, deterministic)
+}
+func (m *AgentHandlesResp_Process) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentHandlesResp_Process.Merge(m, src)
+}
+func (m *AgentHandlesResp_Process) XXX_Size() int {
+	return xxx_messageInfo_AgentHandlesResp_Process.Size(m)
+}
+func (m *AgentHandlesResp_Process) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentHandlesResp_Process.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentHandlesResp_Process proto.InternalMessageInfo
+
+func (m *AgentHandlesResp_Process) GetPid() int32 {
+	if m != nil {
+		return m.Pid
+	}
+	return 0
+}
+
+func (m *AgentHandlesResp_Process) GetPools() []*AgentHandlesResp_Pool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// AgentCacheDumpReq requests the contents of the attach info cache.
+type AgentCacheDumpReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgentCacheDumpReq) Reset()         { *m = AgentCacheDumpReq{} }
+func (m *AgentCacheDumpReq) String() string { return proto.CompactTextString(m) }
+func (*AgentCacheDumpReq) ProtoMessage()    {}
+func (*AgentCacheDumpReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47428c3f7edaadf, []int{6}
+}
+
+func (m *AgentCacheDumpReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentCacheDumpReq.Unmarshal(m, b)
+}
+func (m *AgentCacheDumpReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentCacheDumpReq.Marshal(b, m, deterministic)
+}
+func (m *AgentCacheDumpReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentCacheDumpReq.Merge(m, src)
+}
+func (m *AgentCacheDumpReq) XXX_Size() int {
+	return xxx_messageInfo_AgentCacheDumpReq.Size(m)
+}
+func (m *AgentCacheDumpReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentCacheDumpReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentCacheDumpReq proto.InternalMessageInfo
+
+func (m *AgentCacheDumpReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+// AgentCacheDumpResp describes the contents of the attach info cache.
+type AgentCacheDumpResp struct {
+	Error                string                      `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Enabled              bool                        `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Initialized          bool                        `protobuf:"varint,3,opt,name=initialized,proto3" json:"initialized,omitempty"`
+	MapVersion           uint32                      `protobuf:"varint,4,opt,name=map_version,json=mapVersion,proto3" json:"map_version,omitempty"`
+	DefaultNumaNode      int32                       `protobuf:"varint,5,opt,name=default_numa_node,json=defaultNumaNode,proto3" json:"default_numa_node,omitempty"`
+	Entries              []*AgentCacheDumpResp_Entry `protobuf:"bytes,6,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *AgentCacheDumpResp) Reset()         { *m = AgentCacheDumpResp{} }
+func (m *AgentCacheDumpResp) String() string { return proto.CompactTextString(m) }
+func (*AgentCacheDumpResp) ProtoMessage()    {}
+func (*AgentCacheDumpResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47428c3f7edaadf, []int{7}
+}
+
+func (m *AgentCacheDumpResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentCacheDumpResp.Unmarshal(m, b)
+}
+func (m *AgentCacheDumpResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentCacheDumpResp.Marshal(b, m, deterministic)
+}
+func (m *AgentCacheDumpResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentCacheDumpResp.Merge(m, src)
+}
+func (m *AgentCacheDumpResp) XXX_Size() int {
+	return xxx_messageInfo_AgentCacheDumpResp.Size(m)
+}
+func (m *AgentCacheDumpResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentCacheDumpResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentCacheDumpResp proto.InternalMessageInfo
+
+func (m *AgentCacheDumpResp) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AgentCacheDumpResp) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *AgentCacheDumpResp) GetInitialized() bool {
+	if m != nil {
+		return m.Initialized
+	}
+	return false
+}
+
+func (m *AgentCacheDumpResp) GetMapVersion() uint32 {
+	if m != nil {
+		return m.MapVersion
+	}
+	return 0
+}
+
+func (m *AgentCacheDumpResp) GetDefaultNumaNode() int32 {
+	if m != nil {
+		return m.DefaultNumaNode
+	}
+	return 0
+}
+
+func (m *AgentCacheDumpResp) GetEntries() []*AgentCacheDumpResp_Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type AgentCacheDumpResp_Entry struct {
+	NumaNode             int32              `protobuf:"varint,1,opt,name=numa_node,json=numaNode,proto3" json:"numa_node,omitempty"`
+	DeviceIndex          int32              `protobuf:"varint,2,opt,name=device_index,json=deviceIndex,proto3" json:"device_index,omitempty"`
+	Info                 *GetAttachInfoResp `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AgentCacheDumpResp_Entry) Reset()         { *m = AgentCacheDumpResp_Entry{} }
+func (m *AgentCacheDumpResp_Entry) String() string { return proto.CompactTextString(m) }
+func (*AgentCacheDumpResp_Entry) ProtoMessage()    {}
+func (*AgentCacheDumpResp_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47428c3f7edaadf, []int{7, 0}
+}
+
+func (m *AgentCacheDumpResp_Entry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentCacheDumpResp_Entry.Unmarshal(m, b)
+}
+func (m *AgentCacheDumpResp_Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentCacheDumpResp_Entry.Marshal(b, m, deterministic)
+}
+func (m *AgentCacheDumpResp_Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentCacheDumpResp_Entry.Merge(m, src)
+}
+func (m *AgentCacheDumpResp_Entry) XXX_Size() int {
+	return xxx_messageInfo_AgentCacheDumpResp_Entry.Size(m)
+}
+func (m *AgentCacheDumpResp_Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentCacheDumpResp_Entry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentCacheDumpResp_Entry proto.InternalMessageInfo
+
+func (m *AgentCacheDumpResp_Entry) GetNumaNode() int32 {
+	if m != nil {
+		return m.NumaNode
+	}
+	return 0
+}
+
+func (m *AgentCacheDumpResp_Entry) GetDeviceIndex() int32 {
+	if m != nil {
+		return m.DeviceIndex
+	}
+	return 0
+}
+
+func (m *AgentCacheDumpResp_Entry) GetInfo() *GetAttachInfoResp {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AgentCacheRefreshReq)(nil), "mgmt.AgentCacheRefreshReq")
+	proto.RegisterType((*AgentCacheRefreshResp)(nil), "mgmt.AgentCacheRefreshResp")
+	proto.RegisterType((*AgentStatusReq)(nil), "mgmt.AgentStatusReq")
+	proto.RegisterType((*AgentStatusResp)(nil), "mgmt.AgentStatusResp")
+	proto.RegisterType((*AgentStatusResp_Client)(nil), "mgmt.AgentStatusResp.Client")
+	proto.RegisterType((*AgentHandlesReq)(nil), "mgmt.AgentHandlesReq")
+	proto.RegisterType((*AgentHandlesResp)(nil), "mgmt.AgentHandlesResp")
+	proto.RegisterType((*AgentHandlesResp_Pool)(nil), "mgmt.AgentHandlesResp.Pool")
+	proto.RegisterType((*AgentHandlesResp_Process)(nil), "mgmt.AgentHandlesResp.Process")
+	proto.RegisterType((*AgentCacheDumpReq)(nil), "mgmt.AgentCacheDumpReq")
+	proto.RegisterType((*AgentCacheDumpResp)(nil), "mgmt.AgentCacheDumpResp")
+	proto.RegisterType((*AgentCacheDumpResp_Entry)(nil), "mgmt.AgentCacheDumpResp.Entry")
+}
+
+func init() {
+	proto.RegisterFile("mgmt/agent.proto", fileDescriptor_f47428c3f7edaadf)
+}
+
+var fileDescriptor_f47428c3f7edaadf = []byte{
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x5f, 0x6f, 0x23, 0xb5,
+	0x17, 0x55, 0xfe, 0x4c, 0x9b, 0xb9, 0x49, 0x9b, 0xd4, 0xda, 0x9f, 0x7e, 0x43, 0x76, 0x81, 0x6c,
+	0x56, 0xa0, 0x68, 0x57, 0x24, 0x62, 0x41, 0x68, 0x85, 0x78, 0x29, 0xa5, 0x82, 0x4a, 0x50, 0x45,
+	0x06, 0x81, 0xc4, 0xcb, 0xc8, 0xf5, 0x38, 0x8d, 0xb5, 0xe3, 0xf1, 0x60, 0x7b, 0x02, 0xe5, 0x0b,
+	0xf0, 0x29, 0x79, 0xe2, 0x95, 0x0f, 0x81, 0x7c, 0x3d, 0xd3, 0x24, 0x25, 0xe5, 0xcd, 0xf7, 0xf8,
+	0x8c, 0x7d, 0x8e, 0xef, 0xb1, 0x07, 0x46, 0xea, 0x56, 0xb9, 0x05, 0xbb, 0x15, 0x85, 0x9b, 0x97,
+	0x46, 0x3b, 0x4d, 0xba, 0x1e, 0x19, 0x9f, 0x22, 0x6e, 0x37, 0x3c, 0xa0, 0xd3, 0x19, 0x3c, 0x39,
+	0xf7, 0xa4, 0x0b, 0xc6, 0xd7, 0x82, 0x8a, 0x95, 0x11, 0x76, 0x4d, 0xc5, 0x2f, 0x64, 0x04, 0x1d,
+	0x7b, 0x67, 0x93, 0xd6, 0xa4, 0x35, 0x8b, 0xa9, 0x1f, 0x4e, 0xff, 0x68, 0xc1, 0xff, 0x0e, 0x50,
+	0x6d, 0x49, 0x9e, 0x40, 0x24, 0x8c, 0xd1, 0xa6, 0x66, 0x87, 0x82, 0xbc, 0x0f, 0x7d, 0xc5, 0xca,
+	0x74, 0x23, 0x8c, 0x95, 0xba, 0x48, 0xda, 0x93, 0xd6, 0xec, 0x84, 0x82, 0x62, 0xe5, 0x8f, 0x01,
+	0x21, 0x63, 0xe8, 0x95, 0x46, 0x6f, 0x64, 0x26, 0x4c, 0xd2, 0xc1, 0x2f, 0xef, 0x6b, 0xf2, 0x14,
+	0xe2, 0xa2, 0x52, 0xa9, 0x61, 0xc5, 0x5b, 0x9b, 0x74, 0xf1, 0xd3, 0x5e, 0x51, 0x29, 0xea, 0xeb,
+	0xe9, 0x14, 0x4e, 0x51, 0xc8, 0xf7, 0x8e, 0xb9, 0xca, 0x1e, 0x56, 0xfb, 0x67, 0x04, 0xc3, 0x3d,
+	0xd2, 0xa3, 0x3a, 0x13, 0x38, 0xde, 0xd5, 0x18, 0xd3, 0xa6, 0x6c, 0x56, 0xed, 0xdc, 0xaf, 0xea,
+	0x91, 0x52, 0x66, 0x28, 0x28, 0xa2, 0x7e, 0x48, 0xde, 0x05, 0xb0, 0x8e, 0x19, 0x97, 0x3a, 0xa9,
+	0x44, 0x12, 0x4d, 0x5a, 0xb3, 0x0e, 0x8d, 0x11, 0xf9, 0x41, 0x2a, 0x41, 0x5e, 0xc0, 0x09, 0xe3,
+	0x5c, 0x58, 0x9b, 0x96, 0x5a, 0x16, 0xce, 0x26, 0x47, 0x93, 0xce, 0x2c, 0xa6, 0x83, 0x00, 0x2e,
+	0x11, 0x23, 0xcf, 0x61, 0xa0, 0x6c, 0x6a, 0x04, 0xe3, 0x6b, 0x76, 0x93, 0x8b, 0xe4, 0x78, 0xd2,
+	0x9a, 0xf5, 0x68, 0x5f, 0x59, 0xda, 0x40, 0xe4, 0x1d, 0xe8, 0x29, 0x9b, 0x06, 0xf5, 0xbd, 0xa0,
+	0x52, 0xd9, 0x4b, 0xd4, 0xff, 0x21, 0x0c, 0x95, 0x4d, 0x73, 0x66, 0x5d, 0xca, 0x75, 0xe1, 0x18,
+	0x77, 0x49, 0x8c, 0x32, 0x4e, 0x94, 0xfd, 0x96, 0x59, 0x77, 0x11, 0x40, 0x32, 0x83, 0x91, 0xef,
+	0xc7, 0xaf, 0xcc, 0xf1, 0x75, 0xca, 0xb8, 0x93, 0x1b, 0x91, 0x00, 0xee, 0x74, 0xaa, 0x58, 0xf9,
+	0x93, 0x87, 0xcf, 0x11, 0xf5, 0xa2, 0xb9, 0xef, 0x71, 0x2a, 0x0a, 0xbf, 0x79, 0x96, 0xf4, 0x91,
+	0x36, 0x40, 0xf0, 0x32, 0x60, 0xe4, 0x15, 0x9c, 0x05, 0x92, 0x2c, 0xa4, 0x93, 0x2c, 0x97, 0xbf,
+	0x8b, 0x2c, 0x19, 0x20, 0x71, 0x84, 0x13, 0x57, 0x5b, 0x9c, 0xbc, 0x6c, 0xc8, 0xbb, 0x89, 0x38,
+	0xc1, 0xb6, 0x0e, 0x71, 0xe2, 0xbb, 0x6d, 0x2c, 0xea, 0xd6, 0x97, 0x46, 0x73, 0x9b, 0x9c, 0xde,
+	0xb7, 0x7e, 0xe9, 0x6b, 0x1f, 0x2a, 0x3f, 0xb9, 0x66, 0x45, 0x96, 0x0b, 0x9b, 0x0c, 0x71, 0x1a,
+	0x8a, 0x4a, 0x7d, 0x13, 0x10, 0xdf, 0x4d, 0x7b, 0x67, 0x9d, 0x50, 0x36, 0x19, 0xe1, 0x51, 0x37,
+	0xa5, 0x77, 0xb5, 0x62, 0x37, 0x46, 0xf2, 0xb4, 0xd4, 0xb9, 0xe4, 0x77, 0xc9, 0x19, 0x9e, 0xe3,
+	0x20, 0x80, 0x4b, 0xc4, 0xc8, 0x67, 0x70, 0xcc, 0x73, 0x29, 0x7c, 0xa7, 0xc8, 0xa4, 0x33, 0xeb,
+	0xbf, 0x7e, 0x36, 0xf7, 0x17, 0x66, 0xfe, 0x20, 0x4a, 0xf3, 0x0b, 0x24, 0xd1, 0x86, 0x3c, 0xd6,
+	0x70, 0x14, 0xa0, 0x26, 0x22, 0xad, 0x6d, 0x44, 0x9e, 0x41, 0x2c, 0x0b, 0x27, 0xcc, 0x8a, 0x71,
+	0x51, 0x47, 0x6c, 0x0b, 0xd4, 0x76, 0x59, 0x5a, 0xe8, 0x4c, 0x60, 0xd4, 0x22, 0xb4, 0xcb, 0xae,
+	0x75, 0x26, 0xbc, 0x9b, 0xc6, 0x6a, 0xb8, 0x04, 0x4d, 0x39, 0x7d, 0x51, 0xc7, 0xbb, 0xf6, 0x7d,
+	0xf8, 0x12, 0xfc, 0xdd, 0x82, 0xd1, 0x3e, 0xeb, 0xd1, 0x5b, 0xf0, 0x05, 0xc4, 0xfe, 0xc4, 0x85,
+	0xb5, 0xc2, 0x26, 0x6d, 0xb4, 0xfe, 0xde, 0x8e, 0xf5, 0x9d, 0x05, 0xe6, 0xcb, 0xc0, 0xa3, 0xdb,
+	0x0f, 0xc6, 0x9f, 0x42, 0x77, 0xa9, 0x75, 0x4e, 0x08, 0x74, 0xab, 0xaa, 0x76, 0x1f, 0x53, 0x1c,
+	0xef, 0x7a, 0x68, 0x87, 0x8e, 0xd4, 0xe5, 0xf8, 0x1a, 0x8e, 0xeb, 0xb5, 0x0e, 0x9c, 0xda, 0xc7,
+	0x10, 0x95, 0x5a, 0xe7, 0x8d, 0x98, 0xa7, 0x8f, 0x89, 0xd1, 0x3a, 0xa7, 0x81, 0x39, 0xfd, 0x00,
+	0xce, 0xb6, 0x0f, 0xd4, 0x57, 0x95, 0x2a, 0x0f, 0x9f, 0xca, 0x5f, 0x6d, 0x20, 0x0f, 0x79, 0xff,
+	0xf5, 0x3a, 0x34, 0xb7, 0xa0, 0x8d, 0xe1, 0x6e, 0x4a, 0x32, 0x81, 0xfe, 0x6e, 0xf4, 0x3b, 0xe1,
+	0xd2, 0xee, 0x40, 0x0f, 0x5f, 0xc0, 0xee, 0xbf, 0x5e, 0xc0, 0x97, 0x70, 0x96, 0x89, 0x15, 0xab,
+	0x72, 0x97, 0x6e, 0x33, 0x10, 0xe1, 0x19, 0x0c, 0xeb, 0x89, 0xeb, 0x26, 0x0a, 0x6f, 0xbc, 0x10,
+	0x67, 0xa4, 0x08, 0x6f, 0xc8, 0x7e, 0x7b, 0xf6, 0x9c, 0xcc, 0x2f, 0x0b, 0x67, 0xee, 0x68, 0x43,
+	0x1f, 0x3b, 0x88, 0x10, 0xd9, 0x8f, 0x5a, 0xeb, 0x41, 0xd4, 0x9e, 0xc3, 0x20, 0x13, 0x1b, 0xc9,
+	0xfd, 0x85, 0xce, 0xc4, 0x6f, 0xe8, 0x36, 0xa2, 0xfd, 0x80, 0x5d, 0x79, 0x88, 0xbc, 0x82, 0xae,
+	0x2c, 0x56, 0x1a, 0xad, 0xf6, 0x5f, 0xff, 0x3f, 0xec, 0xff, 0xb5, 0x70, 0xe7, 0xce, 0x31, 0xbe,
+	0xbe, 0x2a, 0x56, 0xda, 0x6f, 0x4f, 0x91, 0xf4, 0xe5, 0xe7, 0x3f, 0xbf, 0xb9, 0x95, 0x6e, 0x5d,
+	0xdd, 0xcc, 0xb9, 0x56, 0x8b, 0x8c, 0x69, 0xfb, 0x91, 0x75, 0x8c, 0xbf, 0xc5, 0xe1, 0xc2, 0x1a,
+	0xbe, 0xf0, 0xaf, 0x95, 0xd1, 0xf9, 0x82, 0x6b, 0xa5, 0x74, 0xb1, 0xc0, 0xff, 0xd1, 0xc2, 0xaf,
+	0x79, 0x73, 0x84, 0xe3, 0x4f, 0xfe, 0x19, 0x00, 0x6b, 0x94, 0xc2, 0x8a, 0xc5, 0x06, 0x00, 0x00,
+}
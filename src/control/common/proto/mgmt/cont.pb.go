@@ -140,9 +140,578 @@ func (m *ContSetOwnerResp) GetStatus() int32 {
 	return 0
 }
 
+// ContProperty represents a container property and its value.
+type ContProperty struct {
+	Number               uint32   `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Strval               string   `protobuf:"bytes,2,opt,name=strval,proto3" json:"strval,omitempty"`
+	Numval               uint64   `protobuf:"varint,3,opt,name=numval,proto3" json:"numval,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContProperty) Reset()         { *m = ContProperty{} }
+func (m *ContProperty) String() string { return proto.CompactTextString(m) }
+func (*ContProperty) ProtoMessage()    {}
+func (*ContProperty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02bc2c97f8edceaa, []int{2}
+}
+
+func (m *ContProperty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContProperty.Unmarshal(m, b)
+}
+func (m *ContProperty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContProperty.Marshal(b, m, deterministic)
+}
+func (m *ContProperty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContProperty.Merge(m, src)
+}
+func (m *ContProperty) XXX_Size() int {
+	return xxx_messageInfo_ContProperty.Size(m)
+}
+func (m *ContProperty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContProperty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContProperty proto.InternalMessageInfo
+
+func (m *ContProperty) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *ContProperty) GetStrval() string {
+	if m != nil {
+		return m.Strval
+	}
+	return ""
+}
+
+func (m *ContProperty) GetNumval() uint64 {
+	if m != nil {
+		return m.Numval
+	}
+	return 0
+}
+
+func (m *ContProperty) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// ContQueryReq represents a request to query a container without a handle.
+type ContQueryReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	ContUUID             string   `protobuf:"bytes,2,opt,name=contUUID,proto3" json:"contUUID,omitempty"`
+	PoolUUID             string   `protobuf:"bytes,3,opt,name=poolUUID,proto3" json:"poolUUID,omitempty"`
+	SvcRanks             []uint32 `protobuf:"varint,4,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContQueryReq) Reset()         { *m = ContQueryReq{} }
+func (m *ContQueryReq) String() string { return proto.CompactTextString(m) }
+func (*ContQueryReq) ProtoMessage()    {}
+func (*ContQueryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02bc2c97f8edceaa, []int{3}
+}
+
+func (m *ContQueryReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContQueryReq.Unmarshal(m, b)
+}
+func (m *ContQueryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContQueryReq.Marshal(b, m, deterministic)
+}
+func (m *ContQueryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContQueryReq.Merge(m, src)
+}
+func (m *ContQueryReq) XXX_Size() int {
+	return xxx_messageInfo_ContQueryReq.Size(m)
+}
+func (m *ContQueryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContQueryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContQueryReq proto.InternalMessageInfo
+
+func (m *ContQueryReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *ContQueryReq) GetContUUID() string {
+	if m != nil {
+		return m.ContUUID
+	}
+	return ""
+}
+
+func (m *ContQueryReq) GetPoolUUID() string {
+	if m != nil {
+		return m.PoolUUID
+	}
+	return ""
+}
+
+func (m *ContQueryReq) GetSvcRanks() []uint32 {
+	if m != nil {
+		return m.SvcRanks
+	}
+	return nil
+}
+
+// ContQueryResp returns container ownership and usage.
+type ContQueryResp struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Owneruser            string   `protobuf:"bytes,3,opt,name=owneruser,proto3" json:"owneruser,omitempty"`
+	Ownergroup           string   `protobuf:"bytes,4,opt,name=ownergroup,proto3" json:"ownergroup,omitempty"`
+	NumHandles           uint32   `protobuf:"varint,5,opt,name=num_handles,json=numHandles,proto3" json:"num_handles,omitempty"`
+	NumObjects           uint64   `protobuf:"varint,6,opt,name=num_objects,json=numObjects,proto3" json:"num_objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContQueryResp) Reset()         { *m = ContQueryResp{} }
+func (m *ContQueryResp) String() string { return proto.CompactTextString(m) }
+func (*ContQueryResp) ProtoMessage()    {}
+func (*ContQueryResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02bc2c97f8edceaa, []int{4}
+}
+
+func (m *ContQueryResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContQueryResp.Unmarshal(m, b)
+}
+func (m *ContQueryResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContQueryResp.Marshal(b, m, deterministic)
+}
+func (m *ContQueryResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContQueryResp.Merge(m, src)
+}
+func (m *ContQueryResp) XXX_Size() int {
+	return xxx_messageInfo_ContQueryResp.Size(m)
+}
+func (m *ContQueryResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContQueryResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContQueryResp proto.InternalMessageInfo
+
+func (m *ContQueryResp) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ContQueryResp) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ContQueryResp) GetOwneruser() string {
+	if m != nil {
+		return m.Owneruser
+	}
+	return ""
+}
+
+func (m *ContQueryResp) GetOwnergroup() string {
+	if m != nil {
+		return m.Ownergroup
+	}
+	return ""
+}
+
+func (m *ContQueryResp) GetNumHandles() uint32 {
+	if m != nil {
+		return m.NumHandles
+	}
+	return 0
+}
+
+func (m *ContQueryResp) GetNumObjects() uint64 {
+	if m != nil {
+		return m.NumObjects
+	}
+	return 0
+}
+
+// ContDestroyReq represents a request to destroy a container.
+type ContDestroyReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	ContUUID             string   `protobuf:"bytes,2,opt,name=contUUID,proto3" json:"contUUID,omitempty"`
+	PoolUUID             string   `protobuf:"bytes,3,opt,name=poolUUID,proto3" json:"poolUUID,omitempty"`
+	Force                bool     `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	SvcRanks             []uint32 `protobuf:"varint,5,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContDestroyReq) Reset()         { *m = ContDestroyReq{} }
+func (m *ContDestroyReq) String() string { return proto.CompactTextString(m) }
+func (*ContDestroyReq) ProtoMessage()    {}
+func (*ContDestroyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02bc2c97f8edceaa, []int{5}
+}
+
+func (m *ContDestroyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContDestroyReq.Unmarshal(m, b)
+}
+func (m *ContDestroyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContDestroyReq.Marshal(b, m, deterministic)
+}
+func (m *ContDestroyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContDestroyReq.Merge(m, src)
+}
+func (m *ContDestroyReq) XXX_Size() int {
+	return xxx_messageInfo_ContDestroyReq.Size(m)
+}
+func (m *ContDestroyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContDestroyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContDestroyReq proto.InternalMessageInfo
+
+func (m *ContDestroyReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *ContDestroyReq) GetContUUID() string {
+	if m != nil {
+		return m.ContUUID
+	}
+	return ""
+}
+
+func (m *ContDestroyReq) GetPoolUUID() string {
+	if m != nil {
+		return m.PoolUUID
+	}
+	return ""
+}
+
+func (m *ContDestroyReq) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *ContDestroyReq) GetSvcRanks() []uint32 {
+	if m != nil {
+		return m.SvcRanks
+	}
+	return nil
+}
+
+// ContDestroyResp returns the result of destroying a container.
+type ContDestroyResp struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContDestroyResp) Reset()         { *m = ContDestroyResp{} }
+func (m *ContDestroyResp) String() string { return proto.CompactTextString(m) }
+func (*ContDestroyResp) ProtoMessage()    {}
+func (*ContDestroyResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02bc2c97f8edceaa, []int{6}
+}
+
+func (m *ContDestroyResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContDestroyResp.Unmarshal(m, b)
+}
+func (m *ContDestroyResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContDestroyResp.Marshal(b, m, deterministic)
+}
+func (m *ContDestroyResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContDestroyResp.Merge(m, src)
+}
+func (m *ContDestroyResp) XXX_Size() int {
+	return xxx_messageInfo_ContDestroyResp.Size(m)
+}
+func (m *ContDestroyResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContDestroyResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContDestroyResp proto.InternalMessageInfo
+
+func (m *ContDestroyResp) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+// ContGetPropReq represents a request to get container properties.
+type ContGetPropReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	ContUUID             string   `protobuf:"bytes,2,opt,name=contUUID,proto3" json:"contUUID,omitempty"`
+	PoolUUID             string   `protobuf:"bytes,3,opt,name=poolUUID,proto3" json:"poolUUID,omitempty"`
+	Names                []string `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty"`
+	Numbers              []uint32 `protobuf:"varint,5,rep,packed,name=numbers,proto3" json:"numbers,omitempty"`
+	SvcRanks             []uint32 `protobuf:"varint,6,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContGetPropReq) Reset()         { *m = ContGetPropReq{} }
+func (m *ContGetPropReq) String() string { return proto.CompactTextString(m) }
+func (*ContGetPropReq) ProtoMessage()    {}
+func (*ContGetPropReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02bc2c97f8edceaa, []int{7}
+}
+
+func (m *ContGetPropReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContGetPropReq.Unmarshal(m, b)
+}
+func (m *ContGetPropReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContGetPropReq.Marshal(b, m, deterministic)
+}
+func (m *ContGetPropReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContGetPropReq.Merge(m, src)
+}
+func (m *ContGetPropReq) XXX_Size() int {
+	return xxx_messageInfo_ContGetPropReq.Size(m)
+}
+func (m *ContGetPropReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContGetPropReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContGetPropReq proto.InternalMessageInfo
+
+func (m *ContGetPropReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *ContGetPropReq) GetContUUID() string {
+	if m != nil {
+		return m.ContUUID
+	}
+	return ""
+}
+
+func (m *ContGetPropReq) GetPoolUUID() string {
+	if m != nil {
+		return m.PoolUUID
+	}
+	return ""
+}
+
+func (m *ContGetPropReq) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *ContGetPropReq) GetNumbers() []uint32 {
+	if m != nil {
+		return m.Numbers
+	}
+	return nil
+}
+
+func (m *ContGetPropReq) GetSvcRanks() []uint32 {
+	if m != nil {
+		return m.SvcRanks
+	}
+	return nil
+}
+
+// ContGetPropResp represents the result of getting container properties.
+type ContGetPropResp struct {
+	Status               int32           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Properties           []*ContProperty `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ContGetPropResp) Reset()         { *m = ContGetPropResp{} }
+func (m *ContGetPropResp) String() string { return proto.CompactTextString(m) }
+func (*ContGetPropResp) ProtoMessage()    {}
+func (*ContGetPropResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02bc2c97f8edceaa, []int{8}
+}
+
+func (m *ContGetPropResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContGetPropResp.Unmarshal(m, b)
+}
+func (m *ContGetPropResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContGetPropResp.Marshal(b, m, deterministic)
+}
+func (m *ContGetPropResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContGetPropResp.Merge(m, src)
+}
+func (m *ContGetPropResp) XXX_Size() int {
+	return xxx_messageInfo_ContGetPropResp.Size(m)
+}
+func (m *ContGetPropResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContGetPropResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContGetPropResp proto.InternalMessageInfo
+
+func (m *ContGetPropResp) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ContGetPropResp) GetProperties() []*ContProperty {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+// ContSetPropReq represents a request to set container properties.
+type ContSetPropReq struct {
+	Sys                  string          `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	ContUUID             string          `protobuf:"bytes,2,opt,name=contUUID,proto3" json:"contUUID,omitempty"`
+	PoolUUID             string          `protobuf:"bytes,3,opt,name=poolUUID,proto3" json:"poolUUID,omitempty"`
+	Properties           []*ContProperty `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty"`
+	SvcRanks             []uint32        `protobuf:"varint,5,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ContSetPropReq) Reset()         { *m = ContSetPropReq{} }
+func (m *ContSetPropReq) String() string { return proto.CompactTextString(m) }
+func (*ContSetPropReq) ProtoMessage()    {}
+func (*ContSetPropReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02bc2c97f8edceaa, []int{9}
+}
+
+func (m *ContSetPropReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContSetPropReq.Unmarshal(m, b)
+}
+func (m *ContSetPropReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContSetPropReq.Marshal(b, m, deterministic)
+}
+func (m *ContSetPropReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContSetPropReq.Merge(m, src)
+}
+func (m *ContSetPropReq) XXX_Size() int {
+	return xxx_messageInfo_ContSetPropReq.Size(m)
+}
+func (m *ContSetPropReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContSetPropReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContSetPropReq proto.InternalMessageInfo
+
+func (m *ContSetPropReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *ContSetPropReq) GetContUUID() string {
+	if m != nil {
+		return m.ContUUID
+	}
+	return ""
+}
+
+func (m *ContSetPropReq) GetPoolUUID() string {
+	if m != nil {
+		return m.PoolUUID
+	}
+	return ""
+}
+
+func (m *ContSetPropReq) GetProperties() []*ContProperty {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *ContSetPropReq) GetSvcRanks() []uint32 {
+	if m != nil {
+		return m.SvcRanks
+	}
+	return nil
+}
+
+// ContSetPropResp represents the result of setting container properties.
+type ContSetPropResp struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContSetPropResp) Reset()         { *m = ContSetPropResp{} }
+func (m *ContSetPropResp) String() string { return proto.CompactTextString(m) }
+func (*ContSetPropResp) ProtoMessage()    {}
+func (*ContSetPropResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02bc2c97f8edceaa, []int{10}
+}
+
+func (m *ContSetPropResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContSetPropResp.Unmarshal(m, b)
+}
+func (m *ContSetPropResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContSetPropResp.Marshal(b, m, deterministic)
+}
+func (m *ContSetPropResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContSetPropResp.Merge(m, src)
+}
+func (m *ContSetPropResp) XXX_Size() int {
+	return xxx_messageInfo_ContSetPropResp.Size(m)
+}
+func (m *ContSetPropResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContSetPropResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContSetPropResp proto.InternalMessageInfo
+
+func (m *ContSetPropResp) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ContSetOwnerReq)(nil), "mgmt.ContSetOwnerReq")
 	proto.RegisterType((*ContSetOwnerResp)(nil), "mgmt.ContSetOwnerResp")
+	proto.RegisterType((*ContProperty)(nil), "mgmt.ContProperty")
+	proto.RegisterType((*ContQueryReq)(nil), "mgmt.ContQueryReq")
+	proto.RegisterType((*ContQueryResp)(nil), "mgmt.ContQueryResp")
+	proto.RegisterType((*ContDestroyReq)(nil), "mgmt.ContDestroyReq")
+	proto.RegisterType((*ContDestroyResp)(nil), "mgmt.ContDestroyResp")
+	proto.RegisterType((*ContGetPropReq)(nil), "mgmt.ContGetPropReq")
+	proto.RegisterType((*ContGetPropResp)(nil), "mgmt.ContGetPropResp")
+	proto.RegisterType((*ContSetPropReq)(nil), "mgmt.ContSetPropReq")
+	proto.RegisterType((*ContSetPropResp)(nil), "mgmt.ContSetPropResp")
 }
 
 func init() {
@@ -150,21 +719,36 @@ func init() {
 }
 
 var fileDescriptor_02bc2c97f8edceaa = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x4f, 0x4b, 0x03, 0x31,
-	0x10, 0xc5, 0x59, 0xb7, 0x5d, 0xba, 0x03, 0xd2, 0x92, 0x83, 0x04, 0x15, 0x29, 0x3d, 0x15, 0xc1,
-	0xe6, 0xe0, 0x45, 0x3c, 0xaa, 0x17, 0x4f, 0x42, 0xa4, 0x17, 0x2f, 0xb2, 0x8d, 0xcb, 0x2a, 0x6d,
-	0x32, 0x6b, 0x66, 0x52, 0xf1, 0x8b, 0xf9, 0xf9, 0x24, 0x53, 0xff, 0xde, 0xde, 0xef, 0xfd, 0x48,
-	0x18, 0x1e, 0x8c, 0x7d, 0xe7, 0xd9, 0x38, 0x0c, 0xbc, 0xe8, 0x23, 0x32, 0xaa, 0x41, 0x2e, 0x66,
-	0x1f, 0x05, 0x8c, 0xaf, 0x31, 0xf0, 0x7d, 0xcb, 0x77, 0x6f, 0xa1, 0x8d, 0xb6, 0x7d, 0x55, 0x13,
-	0x28, 0xe9, 0x9d, 0x74, 0x31, 0x2d, 0xe6, 0xb5, 0xcd, 0x51, 0x1d, 0xc2, 0x28, 0xbf, 0x5c, 0x2e,
-	0x6f, 0x6f, 0xf4, 0x9e, 0xd4, 0x3f, 0x9c, 0x5d, 0x8f, 0xb8, 0x11, 0x57, 0xee, 0xdc, 0x37, 0xab,
-	0x63, 0xa8, 0x31, 0xff, 0x9a, 0xa8, 0x8d, 0x7a, 0x20, 0xf2, 0xb7, 0x50, 0x27, 0x00, 0x02, 0x5d,
-	0xc4, 0xd4, 0xeb, 0xa1, 0xe8, 0x3f, 0x8d, 0x3a, 0x82, 0x9a, 0xb6, 0xee, 0x31, 0x36, 0x61, 0x4d,
-	0xba, 0x9a, 0x96, 0xf3, 0x7d, 0x3b, 0xa2, 0xad, 0xb3, 0x99, 0x67, 0xa7, 0x30, 0xf9, 0x7f, 0x37,
-	0xf5, 0xea, 0x00, 0x2a, 0xe2, 0x86, 0xd3, 0xee, 0xf6, 0xa1, 0xfd, 0xa2, 0xab, 0xcb, 0x87, 0x8b,
-	0xee, 0x85, 0x9f, 0xd3, 0x6a, 0xe1, 0xd0, 0x9b, 0xa7, 0x06, 0xe9, 0x8c, 0xb8, 0x71, 0x6b, 0x89,
-	0x86, 0xa2, 0x93, 0x5d, 0x22, 0x6e, 0x8c, 0x43, 0xef, 0x31, 0x18, 0x59, 0xc8, 0xe4, 0x81, 0x56,
-	0x95, 0xe4, 0xf3, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xd1, 0x1d, 0x77, 0x40, 0x01, 0x00,
-	0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x95, 0x6b, 0x3b, 0x24, 0x53, 0x42, 0xaa, 0x55, 0x85, 0x2c, 0x40, 0x10, 0xf9, 0x14, 0x90,
+	0x88, 0xa5, 0x72, 0x41, 0x1c, 0xa1, 0x12, 0x70, 0x2a, 0x6c, 0xd4, 0x0b, 0x12, 0xaa, 0xec, 0xed,
+	0x92, 0x7e, 0x78, 0x77, 0xcd, 0x7e, 0x04, 0xe5, 0x47, 0xf0, 0x3b, 0x38, 0x70, 0xe7, 0xc6, 0x7f,
+	0x43, 0xfb, 0x61, 0x88, 0x8b, 0x30, 0x3d, 0xa4, 0xb7, 0x79, 0x33, 0x63, 0xef, 0x9b, 0xf7, 0x66,
+	0x17, 0x26, 0x6c, 0xc9, 0x74, 0x41, 0x04, 0xd7, 0xf3, 0x46, 0x0a, 0x2d, 0x50, 0x62, 0x13, 0xf9,
+	0x8f, 0x08, 0x26, 0xaf, 0x04, 0xd7, 0x0b, 0xaa, 0x8f, 0xbe, 0x70, 0x2a, 0x31, 0xfd, 0x8c, 0xf6,
+	0x20, 0x56, 0x6b, 0x95, 0x45, 0xd3, 0x68, 0x36, 0xc2, 0x36, 0x44, 0xf7, 0x60, 0x68, 0xbf, 0x3c,
+	0x3e, 0x7e, 0x7b, 0x98, 0xed, 0xb8, 0xf4, 0x6f, 0x6c, 0x6b, 0x8d, 0x10, 0xb5, 0xab, 0xc5, 0xbe,
+	0xd6, 0x62, 0xf4, 0x00, 0x46, 0xc2, 0xfe, 0xd5, 0x28, 0x2a, 0xb3, 0xc4, 0x15, 0xff, 0x24, 0xd0,
+	0x43, 0x00, 0x07, 0x96, 0x52, 0x98, 0x26, 0x4b, 0x5d, 0x79, 0x23, 0x83, 0xee, 0xc3, 0x48, 0xad,
+	0xc8, 0x89, 0x2c, 0xf9, 0xa5, 0xca, 0x06, 0xd3, 0x78, 0x36, 0xc6, 0x43, 0xb5, 0x22, 0xd8, 0xe2,
+	0xfc, 0x09, 0xec, 0x75, 0x79, 0xab, 0x06, 0xdd, 0x85, 0x81, 0xd2, 0xa5, 0x36, 0x9e, 0x7b, 0x8a,
+	0x03, 0xca, 0x2f, 0xe0, 0xb6, 0xed, 0x7d, 0x27, 0x45, 0x43, 0xa5, 0x5e, 0xdb, 0x3e, 0x6e, 0x58,
+	0x45, 0xa5, 0xeb, 0x1b, 0xe3, 0x80, 0xfc, 0xf7, 0x72, 0x55, 0xd6, 0x61, 0xc8, 0x80, 0x42, 0xbf,
+	0xcd, 0xdb, 0x01, 0x13, 0x1c, 0x10, 0x42, 0x90, 0xf0, 0x92, 0xd1, 0x30, 0x99, 0x8b, 0x73, 0xe3,
+	0xcf, 0x7a, 0x6f, 0xa8, 0x5c, 0x6f, 0x57, 0xcc, 0x8e, 0x1c, 0xc9, 0x15, 0x39, 0x7e, 0x46, 0x30,
+	0xde, 0x38, 0xf7, 0xdf, 0x62, 0xa0, 0x7d, 0x48, 0xeb, 0xb2, 0xa2, 0xed, 0x8c, 0x1e, 0x74, 0x9d,
+	0x8a, 0xfb, 0x9d, 0x4a, 0xfe, 0x72, 0xea, 0x11, 0xec, 0x72, 0xc3, 0x4e, 0xce, 0x4a, 0x7e, 0x5a,
+	0x53, 0xe5, 0xac, 0x1c, 0x63, 0xe0, 0x86, 0xbd, 0xf1, 0x99, 0xb6, 0x41, 0x54, 0x17, 0x94, 0x68,
+	0x6b, 0xa6, 0x95, 0xd1, 0x36, 0x1c, 0xf9, 0x4c, 0xfe, 0x35, 0x82, 0x3b, 0x96, 0xff, 0x21, 0x55,
+	0x5a, 0x8a, 0x2d, 0x2b, 0xb7, 0x0f, 0xe9, 0x27, 0x21, 0x89, 0x37, 0x6a, 0x88, 0x3d, 0xe8, 0xea,
+	0x99, 0x5e, 0xd1, 0xf3, 0x31, 0x4c, 0x3a, 0x74, 0x7a, 0xb6, 0xeb, 0x5b, 0xa0, 0xfe, 0x9a, 0xba,
+	0x0d, 0xdb, 0x3a, 0x75, 0xbb, 0x56, 0xde, 0xf0, 0x11, 0xf6, 0x00, 0x65, 0x70, 0xcb, 0xaf, 0x6c,
+	0x4b, 0xbc, 0x85, 0xfd, 0x77, 0xe6, 0x23, 0x4c, 0x3a, 0x44, 0x7b, 0xb6, 0xe4, 0x00, 0xa0, 0xf1,
+	0xd7, 0xe5, 0x9c, 0xaa, 0x6c, 0x67, 0x1a, 0xcf, 0x76, 0x0f, 0xd0, 0xdc, 0x3e, 0x19, 0xf3, 0xcd,
+	0xab, 0x84, 0x37, 0xba, 0xf2, 0xef, 0x41, 0x88, 0xc5, 0x4d, 0x08, 0xd1, 0x25, 0x94, 0x5c, 0x87,
+	0xd0, 0xb5, 0x1c, 0x5e, 0xfc, 0x5f, 0x8c, 0x97, 0x2f, 0x3e, 0x3c, 0x5f, 0x9e, 0xeb, 0x33, 0x53,
+	0xcd, 0x89, 0x60, 0xc5, 0x69, 0x29, 0xd4, 0x53, 0xa5, 0x4b, 0x72, 0xe9, 0xc2, 0x42, 0x49, 0xe2,
+	0xde, 0x55, 0x29, 0xea, 0x82, 0x08, 0xc6, 0x04, 0x2f, 0xdc, 0x0b, 0x5b, 0x58, 0x72, 0xd5, 0xc0,
+	0xc5, 0xcf, 0x7e, 0x0d, 0x00, 0x8c, 0x01, 0xa0, 0x31, 0x80, 0x05, 0x00, 0x00,
 }
@@ -30,46 +30,60 @@ func init() {
 }
 
 var fileDescriptor_73035302c7c60874 = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4d, 0x6f, 0xd4, 0x3c,
-	0x10, 0xc7, 0x9f, 0x43, 0xf5, 0x20, 0xdc, 0x37, 0xea, 0x96, 0xb6, 0x2c, 0x37, 0x2e, 0x9c, 0x48,
-	0x24, 0x40, 0xbc, 0x09, 0x09, 0xd1, 0xdd, 0x05, 0x8a, 0x5a, 0x5a, 0x1a, 0x71, 0xe1, 0xe6, 0x26,
-	0xd3, 0xdd, 0x88, 0x24, 0x0e, 0xf6, 0x6c, 0xda, 0xfd, 0xd4, 0x7c, 0x05, 0x34, 0x76, 0x92, 0x9d,
-	0x64, 0x77, 0x0f, 0x5c, 0x22, 0xfb, 0x37, 0xf3, 0xf7, 0x8c, 0xc7, 0xe3, 0x58, 0xec, 0xe6, 0x93,
-	0x1c, 0x43, 0xfa, 0x04, 0xa5, 0xd1, 0xa8, 0xe5, 0x06, 0x8d, 0x07, 0xd2, 0x4e, 0x95, 0x81, 0x24,
-	0x84, 0x0a, 0x8a, 0xda, 0x32, 0xf0, 0xae, 0xa5, 0xd6, 0x59, 0x07, 0xc4, 0xba, 0xf5, 0xd8, 0x71,
-	0xc0, 0x56, 0x71, 0x67, 0xae, 0xe2, 0x46, 0xb0, 0xe7, 0xed, 0x73, 0x8b, 0x90, 0x7b, 0xf4, 0xfc,
-	0xcf, 0xa6, 0xb8, 0x77, 0x3e, 0xc9, 0x31, 0xaa, 0x62, 0xf9, 0x54, 0x6c, 0x7c, 0xd5, 0x69, 0x21,
-	0xb7, 0x03, 0x97, 0x0f, 0x8d, 0xaf, 0xe0, 0xf7, 0x60, 0x87, 0x4f, 0x6d, 0xf9, 0xe4, 0x3f, 0x39,
-	0x14, 0x5b, 0xc3, 0x6c, 0x66, 0x11, 0xcc, 0x98, 0xf2, 0x93, 0x47, 0x81, 0x4f, 0x37, 0xe0, 0x94,
-	0xa4, 0xc7, 0xab, 0x0d, 0x6e, 0x91, 0xf7, 0x62, 0xf3, 0x0c, 0x54, 0x02, 0xe6, 0xfb, 0x0c, 0xcc,
-	0x5c, 0x1e, 0xf8, 0x28, 0x0c, 0xd1, 0x02, 0x0f, 0x57, 0x50, 0xa7, 0x7e, 0x2b, 0xc4, 0xa5, 0xd6,
-	0xd9, 0xd0, 0x80, 0x42, 0x90, 0xfb, 0xde, 0x6d, 0x41, 0x48, 0x7b, 0xb0, 0x0c, 0x9d, 0xf4, 0x44,
-	0x6c, 0x13, 0xbb, 0x02, 0xab, 0xb3, 0x0a, 0x4e, 0x47, 0xf2, 0x70, 0xe1, 0xd8, 0x42, 0x5a, 0xe0,
-	0x68, 0x25, 0x6f, 0x92, 0x27, 0x3c, 0x02, 0x8b, 0x46, 0xb7, 0xc9, 0x33, 0xc4, 0x92, 0xef, 0x50,
-	0xa7, 0x7e, 0x25, 0xee, 0x13, 0x1c, 0x57, 0x69, 0x8c, 0x52, 0x2e, 0xbc, 0x1c, 0x20, 0xe5, 0xfe,
-	0x12, 0xe3, 0x51, 0xc7, 0x77, 0x71, 0x36, 0x4b, 0x80, 0x47, 0xad, 0x51, 0x2f, 0x6a, 0x4b, 0x79,
-	0xd4, 0x91, 0x51, 0x69, 0xc1, 0xa3, 0x3a, 0xd0, 0x8b, 0x5a, 0x33, 0x5e, 0xea, 0xf1, 0x1d, 0x42,
-	0x91, 0xf0, 0x52, 0x7b, 0xd2, 0x2b, 0x75, 0x03, 0x9d, 0xf4, 0x8b, 0xd8, 0xf5, 0xd5, 0x4b, 0x0b,
-	0x84, 0x89, 0xa1, 0xa3, 0x3a, 0xe6, 0x45, 0x6d, 0x31, 0x2d, 0xf2, 0x68, 0x8d, 0x85, 0x27, 0xef,
-	0x7b, 0x85, 0x25, 0xdf, 0x76, 0xca, 0xfe, 0x12, 0xe3, 0x25, 0x8b, 0x00, 0x2f, 0x8d, 0x2e, 0x79,
-	0xc9, 0x6a, 0xd4, 0x2b, 0x59, 0x4b, 0x9d, 0x3a, 0xf0, 0x5b, 0xff, 0x0c, 0xf8, 0x71, 0x78, 0x26,
-	0x77, 0xbd, 0x9b, 0x9f, 0x91, 0xae, 0xbe, 0x28, 0x6e, 0xe6, 0xfc, 0x5f, 0x8b, 0x07, 0xe4, 0x7f,
-	0x51, 0x81, 0xb9, 0x35, 0x29, 0x02, 0xa9, 0xea, 0x64, 0xcf, 0x75, 0x92, 0xde, 0xcc, 0xd7, 0x09,
-	0x5f, 0xfa, 0x9e, 0xfc, 0x51, 0x26, 0xea, 0xdf, 0x55, 0x23, 0xc8, 0xa0, 0xa3, 0x6a, 0xc1, 0x4a,
-	0xd5, 0x89, 0xd8, 0xa6, 0x2d, 0x20, 0xaa, 0x78, 0x7a, 0x5a, 0xdc, 0xe8, 0xa6, 0xff, 0x3b, 0x90,
-	0xf5, 0x7f, 0x8f, 0x37, 0xc7, 0x71, 0x96, 0x5a, 0xa4, 0xe8, 0xb6, 0x89, 0xda, 0x02, 0x76, 0x1c,
-	0x8c, 0xd5, 0xbd, 0xb4, 0x43, 0x68, 0xa8, 0x0b, 0x54, 0x69, 0x01, 0xc6, 0xca, 0xbd, 0x85, 0x23,
-	0x51, 0xd2, 0xca, 0x3e, 0x72, 0xd2, 0x0f, 0x62, 0x8b, 0x66, 0x11, 0xe0, 0xc5, 0x6d, 0x01, 0x46,
-	0xd6, 0x87, 0xc6, 0x19, 0x89, 0x0f, 0x57, 0xe1, 0xa6, 0x15, 0x22, 0xf7, 0xeb, 0xeb, 0xfc, 0x70,
-	0x18, 0x62, 0xad, 0xd0, 0xa1, 0xcd, 0x2d, 0xf0, 0x30, 0x42, 0x5d, 0x36, 0xb7, 0x60, 0x41, 0xd8,
-	0x2d, 0xe0, 0xd0, 0x49, 0xbf, 0x89, 0x3d, 0xcf, 0xae, 0xc0, 0x02, 0x7e, 0xd2, 0x26, 0x57, 0x28,
-	0x07, 0xdc, 0x99, 0x19, 0x68, 0xa1, 0xc7, 0x6b, 0x6d, 0xdd, 0x8d, 0x44, 0xa8, 0x0c, 0xca, 0x5e,
-	0x58, 0x65, 0x70, 0x69, 0x23, 0x35, 0x25, 0xf5, 0xc9, 0xbb, 0x9f, 0x6f, 0x26, 0x29, 0x4e, 0x67,
-	0xd7, 0x41, 0xac, 0xf3, 0x30, 0x51, 0xda, 0x3e, 0xb3, 0xa8, 0xe2, 0x5f, 0x6e, 0x18, 0x5a, 0x13,
-	0xbb, 0x17, 0xc5, 0xe8, 0x2c, 0x8c, 0x75, 0x9e, 0xeb, 0x22, 0x74, 0x0f, 0x85, 0x7b, 0xa2, 0xae,
-	0xff, 0x77, 0xe3, 0x17, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x71, 0x80, 0x81, 0x9b, 0xb6, 0x06,
-	0x00, 0x00,
+	// 845 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x4b, 0x8f, 0xdb, 0x36,
+	0x10, 0xc7, 0x5b, 0x20, 0x68, 0x1b, 0x76, 0x77, 0x9d, 0xa5, 0xb7, 0xbb, 0xa9, 0x7b, 0xeb, 0xa5,
+	0xa7, 0xda, 0x41, 0x5a, 0xf4, 0x85, 0x02, 0x41, 0xfc, 0xa8, 0x92, 0xc5, 0xa6, 0xd9, 0xac, 0xd0,
+	0x4b, 0x6f, 0xb4, 0x34, 0xf1, 0x0a, 0x91, 0x44, 0x95, 0xa4, 0x9d, 0xf8, 0x83, 0xf5, 0xfb, 0x15,
+	0xc3, 0x87, 0x3c, 0xa2, 0xe4, 0x02, 0xed, 0xc5, 0x10, 0x7f, 0x33, 0xff, 0xe1, 0x70, 0x38, 0x1a,
+	0x8b, 0x8d, 0xaa, 0x4d, 0x65, 0x66, 0xf8, 0x33, 0x6d, 0x94, 0x34, 0x92, 0x3f, 0xc0, 0xe7, 0x09,
+	0xd7, 0xf7, 0x42, 0x41, 0x3e, 0x83, 0x1d, 0xd4, 0xde, 0x32, 0x71, 0xae, 0x8d, 0x94, 0x65, 0x07,
+	0x64, 0xb2, 0xf5, 0x38, 0xb3, 0x40, 0xef, 0xb2, 0xce, 0x5a, 0x64, 0x41, 0x70, 0xee, 0xec, 0x7b,
+	0x6d, 0xa0, 0x72, 0xe8, 0xe9, 0xdf, 0x17, 0xec, 0xd3, 0x57, 0x9b, 0xca, 0xa4, 0xbb, 0x8c, 0x7f,
+	0xc3, 0x1e, 0x5c, 0xcb, 0xa2, 0xe6, 0xa7, 0x53, 0x9b, 0x0f, 0x3e, 0xdf, 0xc1, 0x5f, 0x93, 0x33,
+	0xba, 0xd4, 0xcd, 0xd7, 0x1f, 0xf1, 0x05, 0x3b, 0x59, 0x94, 0x5b, 0x6d, 0x40, 0xad, 0x30, 0x3f,
+	0x7e, 0x35, 0x75, 0xe9, 0x4e, 0x29, 0x45, 0xe9, 0xe3, 0x61, 0x83, 0x0d, 0xf2, 0x2b, 0xfb, 0xfc,
+	0x06, 0x44, 0x0e, 0xea, 0xcd, 0x16, 0xd4, 0x9e, 0x5f, 0xb8, 0x5d, 0x08, 0xc2, 0x00, 0x5f, 0x0c,
+	0x50, 0xab, 0xfe, 0x99, 0xb1, 0x5b, 0x29, 0xcb, 0x85, 0x02, 0x61, 0x80, 0x8f, 0x9d, 0xdb, 0x81,
+	0xa0, 0xf6, 0xa2, 0x0f, 0xad, 0x74, 0xce, 0x4e, 0x91, 0xdd, 0x81, 0x96, 0xe5, 0x0e, 0x5e, 0x2e,
+	0xf9, 0xe5, 0xc1, 0xb1, 0x85, 0x18, 0xe0, 0x6a, 0x90, 0x87, 0xe4, 0x11, 0x2f, 0x41, 0x1b, 0x25,
+	0xdb, 0xe4, 0x09, 0x22, 0xc9, 0x77, 0xa8, 0x55, 0xff, 0xc0, 0x1e, 0x22, 0x5c, 0xed, 0x8a, 0xcc,
+	0x70, 0x7e, 0xf0, 0xb2, 0x00, 0x95, 0xe3, 0x1e, 0xa3, 0xbb, 0xae, 0x3e, 0x64, 0xe5, 0x36, 0x07,
+	0xba, 0xab, 0x47, 0xd1, 0xae, 0x2d, 0xa5, 0xbb, 0x2e, 0x95, 0x28, 0x6a, 0xba, 0xab, 0x05, 0xd1,
+	0xae, 0x9e, 0xd1, 0x52, 0xaf, 0x3e, 0x18, 0xa8, 0x73, 0x5a, 0x6a, 0x47, 0xa2, 0x52, 0x07, 0x68,
+	0xa5, 0x2f, 0xd8, 0xc8, 0x55, 0xaf, 0xa8, 0x0d, 0x6c, 0x14, 0x5e, 0xd5, 0x63, 0x5a, 0xd4, 0x16,
+	0x63, 0x90, 0x2f, 0x8f, 0x58, 0x68, 0xf2, 0xae, 0x57, 0x48, 0xf2, 0x6d, 0xa7, 0x8c, 0x7b, 0x8c,
+	0x96, 0x2c, 0x05, 0x73, 0xab, 0x64, 0x43, 0x4b, 0xe6, 0x51, 0x54, 0xb2, 0x96, 0x52, 0x75, 0xd2,
+	0x57, 0x27, 0x83, 0xea, 0xa4, 0xa3, 0x9e, 0xba, 0xc2, 0x25, 0x60, 0x9e, 0x2f, 0x6e, 0xf8, 0xc8,
+	0xb9, 0xb9, 0x15, 0xea, 0xfc, 0x6b, 0x66, 0x57, 0xd6, 0xff, 0x47, 0xf6, 0x08, 0xfd, 0x5f, 0xef,
+	0x40, 0xbd, 0x57, 0x85, 0x01, 0x54, 0xf9, 0xa3, 0xbe, 0x92, 0x79, 0xf1, 0x76, 0x7f, 0x4c, 0xf8,
+	0xbd, 0xeb, 0xe8, 0x3f, 0x9a, 0x5c, 0xfc, 0x77, 0xd5, 0x12, 0x4a, 0xe8, 0xa8, 0x5a, 0x30, 0xa8,
+	0x9a, 0xb3, 0x53, 0x3c, 0x82, 0x31, 0x22, 0xbb, 0x7f, 0x59, 0xbf, 0x95, 0xe1, 0xed, 0xe9, 0x40,
+	0xf2, 0xf6, 0x44, 0x3c, 0x5c, 0xe6, 0x4d, 0xa1, 0x0d, 0xee, 0xae, 0xc3, 0xae, 0x2d, 0x20, 0x97,
+	0x49, 0x98, 0xef, 0xc4, 0x33, 0x44, 0x0b, 0x59, 0x1b, 0x51, 0xd4, 0xa0, 0x34, 0x3f, 0x3f, 0x38,
+	0x22, 0x45, 0x2d, 0x8f, 0x91, 0x95, 0x3e, 0x63, 0x27, 0xb8, 0x4a, 0xc1, 0xbc, 0x7e, 0x5f, 0x83,
+	0xe2, 0xfe, 0xd2, 0x28, 0x43, 0xf1, 0xe5, 0x10, 0x0e, 0x39, 0x23, 0xed, 0x34, 0x60, 0x0b, 0x48,
+	0xce, 0x84, 0x85, 0x16, 0x42, 0x14, 0x4d, 0x0a, 0x82, 0x48, 0x0b, 0x75, 0x28, 0x55, 0x47, 0x0d,
+	0x48, 0x50, 0xa4, 0x4e, 0xe2, 0xf6, 0xf5, 0x27, 0x89, 0xd5, 0xe9, 0xa0, 0xba, 0xd7, 0xfc, 0xa9,
+	0xfd, 0xab, 0xe8, 0x0c, 0x68, 0x82, 0x88, 0xba, 0x43, 0xc3, 0xd4, 0x70, 0x30, 0x35, 0xb2, 0x09,
+	0x53, 0xe3, 0x40, 0xc8, 0xd4, 0xa0, 0xd0, 0x4a, 0x7f, 0x67, 0xe7, 0x8e, 0xdd, 0x81, 0x06, 0xf3,
+	0x9b, 0x54, 0x95, 0x30, 0x7c, 0x42, 0x9d, 0x89, 0x01, 0x03, 0x7d, 0x75, 0xd4, 0xd6, 0x3d, 0x48,
+	0x6a, 0x84, 0x32, 0x3c, 0xda, 0x56, 0x28, 0xd3, 0x3b, 0x88, 0xa7, 0xe1, 0x20, 0xd8, 0x4b, 0xf6,
+	0xaf, 0x4b, 0x73, 0xd2, 0x99, 0x8e, 0x90, 0x83, 0x50, 0x68, 0xa5, 0xd7, 0x6c, 0x94, 0x6e, 0xd7,
+	0x3a, 0x53, 0xc5, 0x1a, 0xbc, 0xde, 0x8f, 0xbf, 0x08, 0x93, 0xf1, 0xd7, 0xb3, 0x60, 0xa4, 0x27,
+	0x1f, 0xe3, 0x28, 0xf5, 0xb9, 0x81, 0xb9, 0x95, 0x65, 0x91, 0xed, 0xdb, 0x58, 0x5d, 0x4c, 0x63,
+	0xc5, 0x16, 0x9b, 0xd5, 0x92, 0x9d, 0xb6, 0x95, 0xb2, 0x05, 0xb9, 0x8c, 0xca, 0x17, 0x4a, 0x72,
+	0x35, 0xc8, 0x7d, 0x3e, 0x09, 0x3b, 0x73, 0x86, 0xe5, 0x7a, 0x2e, 0xb2, 0x77, 0xdb, 0x86, 0x77,
+	0xdc, 0x03, 0x75, 0x5f, 0x01, 0x83, 0x06, 0x1f, 0xe8, 0xfa, 0x70, 0xdb, 0x4d, 0x59, 0x64, 0x02,
+	0xab, 0x18, 0xdf, 0x76, 0x6b, 0x18, 0x48, 0xcb, 0xda, 0xfc, 0xd1, 0x56, 0xec, 0x51, 0x07, 0x3f,
+	0xcf, 0xf3, 0xf8, 0x74, 0xde, 0xfd, 0x5f, 0xc3, 0xbc, 0x60, 0xe3, 0x08, 0x57, 0x72, 0x07, 0xff,
+	0x27, 0xd2, 0x33, 0x76, 0xe2, 0x81, 0xeb, 0x3d, 0xdf, 0x65, 0x94, 0x91, 0xb1, 0xd3, 0xc5, 0x36,
+	0xc0, 0x13, 0xf6, 0xd9, 0x9b, 0xad, 0x34, 0x22, 0x05, 0x13, 0x86, 0x5d, 0x58, 0xa3, 0x70, 0x44,
+	0x50, 0xa4, 0x48, 0x22, 0x45, 0x72, 0x54, 0xf1, 0x94, 0x3d, 0xb4, 0x4b, 0x5b, 0x79, 0x4e, 0xec,
+	0xa1, 0xe2, 0x7d, 0xcd, 0xfc, 0x97, 0x3f, 0x7f, 0xda, 0x14, 0xe6, 0x7e, 0xbb, 0x9e, 0x66, 0xb2,
+	0x9a, 0xe5, 0x42, 0xea, 0x6f, 0xb5, 0x11, 0xd9, 0x3b, 0xfb, 0x38, 0xd3, 0x2a, 0xb3, 0xdf, 0xa5,
+	0x4a, 0x96, 0xb3, 0x4c, 0x56, 0x95, 0xac, 0x67, 0xf6, 0x73, 0xd3, 0x7e, 0xe8, 0xae, 0x3f, 0xb1,
+	0xcf, 0xdf, 0xfd, 0x33, 0x00, 0x53, 0xe1, 0x35, 0xf9, 0xfc, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -111,6 +125,8 @@ type MgmtSvcClient interface {
 	PoolQuery(ctx context.Context, in *PoolQueryReq, opts ...grpc.CallOption) (*PoolQueryResp, error)
 	// Set a DAOS pool property.
 	PoolSetProp(ctx context.Context, in *PoolSetPropReq, opts ...grpc.CallOption) (*PoolSetPropResp, error)
+	// Get DAOS pool properties.
+	PoolGetProp(ctx context.Context, in *PoolGetPropReq, opts ...grpc.CallOption) (*PoolGetPropResp, error)
 	// Fetch the Access Control List for a DAOS pool.
 	PoolGetACL(ctx context.Context, in *GetACLReq, opts ...grpc.CallOption) (*ACLResp, error)
 	// Overwrite the Access Control List for a DAOS pool with a new one.
@@ -127,6 +143,14 @@ type MgmtSvcClient interface {
 	ListContainers(ctx context.Context, in *ListContReq, opts ...grpc.CallOption) (*ListContResp, error)
 	// Change the owner of a DAOS container
 	ContSetOwner(ctx context.Context, in *ContSetOwnerReq, opts ...grpc.CallOption) (*ContSetOwnerResp, error)
+	// Query a DAOS container's ownership and usage
+	ContQuery(ctx context.Context, in *ContQueryReq, opts ...grpc.CallOption) (*ContQueryResp, error)
+	// Destroy a DAOS container
+	ContDestroy(ctx context.Context, in *ContDestroyReq, opts ...grpc.CallOption) (*ContDestroyResp, error)
+	// Get properties of a DAOS container
+	ContGetProp(ctx context.Context, in *ContGetPropReq, opts ...grpc.CallOption) (*ContGetPropResp, error)
+	// Set properties of a DAOS container
+	ContSetProp(ctx context.Context, in *ContSetPropReq, opts ...grpc.CallOption) (*ContSetPropResp, error)
 	// Query DAOS system status
 	SystemQuery(ctx context.Context, in *SystemQueryReq, opts ...grpc.CallOption) (*SystemQueryResp, error)
 	// Stop DAOS system (shutdown data-plane instances)
@@ -135,6 +159,30 @@ type MgmtSvcClient interface {
 	SystemResetFormat(ctx context.Context, in *SystemResetFormatReq, opts ...grpc.CallOption) (*SystemResetFormatResp, error)
 	// Start DAOS system (restart data-plane instances)
 	SystemStart(ctx context.Context, in *SystemStartReq, opts ...grpc.CallOption) (*SystemStartResp, error)
+	// List entries recorded in the DAOS system event log
+	ListEvents(ctx context.Context, in *ListEventsReq, opts ...grpc.CallOption) (*ListEventsResp, error)
+	// Subscribe to a live stream of RAS events received by the MS
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsReq, opts ...grpc.CallOption) (MgmtSvc_SubscribeEventsClient, error)
+	// Update the runtime state of DAOS system management policies
+	SystemSetPolicy(ctx context.Context, in *SystemSetPolicyReq, opts ...grpc.CallOption) (*SystemSetPolicyResp, error)
+	// Restart DAOS system ranks, optionally one fault domain at a time
+	SystemRestart(ctx context.Context, in *SystemRestartReq, opts ...grpc.CallOption) (MgmtSvc_SystemRestartClient, error)
+	// Stream a backup of the system database from the MS leader
+	SystemDbBackup(ctx context.Context, in *SystemDbBackupReq, opts ...grpc.CallOption) (MgmtSvc_SystemDbBackupClient, error)
+	// List the MS replicas
+	SystemReplicaList(ctx context.Context, in *SystemReplicaListReq, opts ...grpc.CallOption) (*SystemReplicaResp, error)
+	// Add a MS replica without restarting the system
+	SystemReplicaAdd(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicaResp, error)
+	// Remove a MS replica without restarting the system
+	SystemReplicaRemove(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicaResp, error)
+	// Start a MS replica on a server being added to the replica set
+	ReplicaStart(ctx context.Context, in *ReplicaStartReq, opts ...grpc.CallOption) (*ReplicaStartResp, error)
+	// Set the limit on pool capacity owned by a user or group
+	QuotaSet(ctx context.Context, in *QuotaSetReq, opts ...grpc.CallOption) (*QuotaResp, error)
+	// Get the pool capacity quota and usage of a user or group
+	QuotaGet(ctx context.Context, in *QuotaGetReq, opts ...grpc.CallOption) (*QuotaResp, error)
+	// List all pool capacity quotas and their usage
+	QuotaList(ctx context.Context, in *QuotaListReq, opts ...grpc.CallOption) (*QuotaResp, error)
 }
 
 type mgmtSvcClient struct {
@@ -262,6 +310,15 @@ func (c *mgmtSvcClient) PoolSetProp(ctx context.Context, in *PoolSetPropReq, opt
 	return out, nil
 }
 
+func (c *mgmtSvcClient) PoolGetProp(ctx context.Context, in *PoolGetPropReq, opts ...grpc.CallOption) (*PoolGetPropResp, error) {
+	out := new(PoolGetPropResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolGetProp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) PoolGetACL(ctx context.Context, in *GetACLReq, opts ...grpc.CallOption) (*ACLResp, error) {
 	out := new(ACLResp)
 	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolGetACL", in, out, opts...)
@@ -334,6 +391,42 @@ func (c *mgmtSvcClient) ContSetOwner(ctx context.Context, in *ContSetOwnerReq, o
 	return out, nil
 }
 
+func (c *mgmtSvcClient) ContQuery(ctx context.Context, in *ContQueryReq, opts ...grpc.CallOption) (*ContQueryResp, error) {
+	out := new(ContQueryResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/ContQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) ContDestroy(ctx context.Context, in *ContDestroyReq, opts ...grpc.CallOption) (*ContDestroyResp, error) {
+	out := new(ContDestroyResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/ContDestroy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) ContGetProp(ctx context.Context, in *ContGetPropReq, opts ...grpc.CallOption) (*ContGetPropResp, error) {
+	out := new(ContGetPropResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/ContGetProp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) ContSetProp(ctx context.Context, in *ContSetPropReq, opts ...grpc.CallOption) (*ContSetPropResp, error) {
+	out := new(ContSetPropResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/ContSetProp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) SystemQuery(ctx context.Context, in *SystemQueryReq, opts ...grpc.CallOption) (*SystemQueryResp, error) {
 	out := new(SystemQueryResp)
 	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemQuery", in, out, opts...)
@@ -370,6 +463,183 @@ func (c *mgmtSvcClient) SystemStart(ctx context.Context, in *SystemStartReq, opt
 	return out, nil
 }
 
+func (c *mgmtSvcClient) ListEvents(ctx context.Context, in *ListEventsReq, opts ...grpc.CallOption) (*ListEventsResp, error) {
+	out := new(ListEventsResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsReq, opts ...grpc.CallOption) (MgmtSvc_SubscribeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MgmtSvc_serviceDesc.Streams[0], "/mgmt.MgmtSvc/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mgmtSvcSubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MgmtSvc_SubscribeEventsClient interface {
+	Recv() (*SubscribeEventsResp, error)
+	grpc.ClientStream
+}
+
+type mgmtSvcSubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mgmtSvcSubscribeEventsClient) Recv() (*SubscribeEventsResp, error) {
+	m := new(SubscribeEventsResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mgmtSvcClient) SystemSetPolicy(ctx context.Context, in *SystemSetPolicyReq, opts ...grpc.CallOption) (*SystemSetPolicyResp, error) {
+	out := new(SystemSetPolicyResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemSetPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemRestart(ctx context.Context, in *SystemRestartReq, opts ...grpc.CallOption) (MgmtSvc_SystemRestartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MgmtSvc_serviceDesc.Streams[1], "/mgmt.MgmtSvc/SystemRestart", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mgmtSvcSystemRestartClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MgmtSvc_SystemRestartClient interface {
+	Recv() (*SystemRestartResp, error)
+	grpc.ClientStream
+}
+
+type mgmtSvcSystemRestartClient struct {
+	grpc.ClientStream
+}
+
+func (x *mgmtSvcSystemRestartClient) Recv() (*SystemRestartResp, error) {
+	m := new(SystemRestartResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mgmtSvcClient) SystemDbBackup(ctx context.Context, in *SystemDbBackupReq, opts ...grpc.CallOption) (MgmtSvc_SystemDbBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MgmtSvc_serviceDesc.Streams[2], "/mgmt.MgmtSvc/SystemDbBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mgmtSvcSystemDbBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MgmtSvc_SystemDbBackupClient interface {
+	Recv() (*SystemDbBackupResp, error)
+	grpc.ClientStream
+}
+
+type mgmtSvcSystemDbBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *mgmtSvcSystemDbBackupClient) Recv() (*SystemDbBackupResp, error) {
+	m := new(SystemDbBackupResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mgmtSvcClient) SystemReplicaList(ctx context.Context, in *SystemReplicaListReq, opts ...grpc.CallOption) (*SystemReplicaResp, error) {
+	out := new(SystemReplicaResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemReplicaList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemReplicaAdd(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicaResp, error) {
+	out := new(SystemReplicaResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemReplicaAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemReplicaRemove(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicaResp, error) {
+	out := new(SystemReplicaResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemReplicaRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) ReplicaStart(ctx context.Context, in *ReplicaStartReq, opts ...grpc.CallOption) (*ReplicaStartResp, error) {
+	out := new(ReplicaStartResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/ReplicaStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) QuotaSet(ctx context.Context, in *QuotaSetReq, opts ...grpc.CallOption) (*QuotaResp, error) {
+	out := new(QuotaResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/QuotaSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) QuotaGet(ctx context.Context, in *QuotaGetReq, opts ...grpc.CallOption) (*QuotaResp, error) {
+	out := new(QuotaResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/QuotaGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) QuotaList(ctx context.Context, in *QuotaListReq, opts ...grpc.CallOption) (*QuotaResp, error) {
+	out := new(QuotaResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/QuotaList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MgmtSvcServer is the server API for MgmtSvc service.
 type MgmtSvcServer interface {
 	// Join the server described by JoinReq to the system.
@@ -399,6 +669,8 @@ type MgmtSvcServer interface {
 	PoolQuery(context.Context, *PoolQueryReq) (*PoolQueryResp, error)
 	// Set a DAOS pool property.
 	PoolSetProp(context.Context, *PoolSetPropReq) (*PoolSetPropResp, error)
+	// Get DAOS pool properties.
+	PoolGetProp(context.Context, *PoolGetPropReq) (*PoolGetPropResp, error)
 	// Fetch the Access Control List for a DAOS pool.
 	PoolGetACL(context.Context, *GetACLReq) (*ACLResp, error)
 	// Overwrite the Access Control List for a DAOS pool with a new one.
@@ -415,6 +687,14 @@ type MgmtSvcServer interface {
 	ListContainers(context.Context, *ListContReq) (*ListContResp, error)
 	// Change the owner of a DAOS container
 	ContSetOwner(context.Context, *ContSetOwnerReq) (*ContSetOwnerResp, error)
+	// Query a DAOS container's ownership and usage
+	ContQuery(context.Context, *ContQueryReq) (*ContQueryResp, error)
+	// Destroy a DAOS container
+	ContDestroy(context.Context, *ContDestroyReq) (*ContDestroyResp, error)
+	// Get properties of a DAOS container
+	ContGetProp(context.Context, *ContGetPropReq) (*ContGetPropResp, error)
+	// Set properties of a DAOS container
+	ContSetProp(context.Context, *ContSetPropReq) (*ContSetPropResp, error)
 	// Query DAOS system status
 	SystemQuery(context.Context, *SystemQueryReq) (*SystemQueryResp, error)
 	// Stop DAOS system (shutdown data-plane instances)
@@ -423,6 +703,30 @@ type MgmtSvcServer interface {
 	SystemResetFormat(context.Context, *SystemResetFormatReq) (*SystemResetFormatResp, error)
 	// Start DAOS system (restart data-plane instances)
 	SystemStart(context.Context, *SystemStartReq) (*SystemStartResp, error)
+	// List entries recorded in the DAOS system event log
+	ListEvents(context.Context, *ListEventsReq) (*ListEventsResp, error)
+	// Subscribe to a live stream of RAS events received by the MS
+	SubscribeEvents(*SubscribeEventsReq, MgmtSvc_SubscribeEventsServer) error
+	// Update the runtime state of DAOS system management policies
+	SystemSetPolicy(context.Context, *SystemSetPolicyReq) (*SystemSetPolicyResp, error)
+	// Restart DAOS system ranks, optionally one fault domain at a time
+	SystemRestart(*SystemRestartReq, MgmtSvc_SystemRestartServer) error
+	// Stream a backup of the system database from the MS leader
+	SystemDbBackup(*SystemDbBackupReq, MgmtSvc_SystemDbBackupServer) error
+	// List the MS replicas
+	SystemReplicaList(context.Context, *SystemReplicaListReq) (*SystemReplicaResp, error)
+	// Add a MS replica without restarting the system
+	SystemReplicaAdd(context.Context, *SystemReplicaReq) (*SystemReplicaResp, error)
+	// Remove a MS replica without restarting the system
+	SystemReplicaRemove(context.Context, *SystemReplicaReq) (*SystemReplicaResp, error)
+	// Start a MS replica on a server being added to the replica set
+	ReplicaStart(context.Context, *ReplicaStartReq) (*ReplicaStartResp, error)
+	// Set the limit on pool capacity owned by a user or group
+	QuotaSet(context.Context, *QuotaSetReq) (*QuotaResp, error)
+	// Get the pool capacity quota and usage of a user or group
+	QuotaGet(context.Context, *QuotaGetReq) (*QuotaResp, error)
+	// List all pool capacity quotas and their usage
+	QuotaList(context.Context, *QuotaListReq) (*QuotaResp, error)
 }
 
 // UnimplementedMgmtSvcServer can be embedded to have forward compatible implementations.
@@ -468,6 +772,9 @@ func (*UnimplementedMgmtSvcServer) PoolQuery(ctx context.Context, req *PoolQuery
 func (*UnimplementedMgmtSvcServer) PoolSetProp(ctx context.Context, req *PoolSetPropReq) (*PoolSetPropResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolSetProp not implemented")
 }
+func (*UnimplementedMgmtSvcServer) PoolGetProp(ctx context.Context, req *PoolGetPropReq) (*PoolGetPropResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolGetProp not implemented")
+}
 func (*UnimplementedMgmtSvcServer) PoolGetACL(ctx context.Context, req *GetACLReq) (*ACLResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolGetACL not implemented")
 }
@@ -492,6 +799,18 @@ func (*UnimplementedMgmtSvcServer) ListContainers(ctx context.Context, req *List
 func (*UnimplementedMgmtSvcServer) ContSetOwner(ctx context.Context, req *ContSetOwnerReq) (*ContSetOwnerResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContSetOwner not implemented")
 }
+func (*UnimplementedMgmtSvcServer) ContQuery(ctx context.Context, req *ContQueryReq) (*ContQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContQuery not implemented")
+}
+func (*UnimplementedMgmtSvcServer) ContDestroy(ctx context.Context, req *ContDestroyReq) (*ContDestroyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContDestroy not implemented")
+}
+func (*UnimplementedMgmtSvcServer) ContGetProp(ctx context.Context, req *ContGetPropReq) (*ContGetPropResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContGetProp not implemented")
+}
+func (*UnimplementedMgmtSvcServer) ContSetProp(ctx context.Context, req *ContSetPropReq) (*ContSetPropResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContSetProp not implemented")
+}
 func (*UnimplementedMgmtSvcServer) SystemQuery(ctx context.Context, req *SystemQueryReq) (*SystemQueryResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemQuery not implemented")
 }
@@ -504,6 +823,42 @@ func (*UnimplementedMgmtSvcServer) SystemResetFormat(ctx context.Context, req *S
 func (*UnimplementedMgmtSvcServer) SystemStart(ctx context.Context, req *SystemStartReq) (*SystemStartResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemStart not implemented")
 }
+func (*UnimplementedMgmtSvcServer) ListEvents(ctx context.Context, req *ListEventsReq) (*ListEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (*UnimplementedMgmtSvcServer) SubscribeEvents(req *SubscribeEventsReq, srv MgmtSvc_SubscribeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
+func (*UnimplementedMgmtSvcServer) SystemSetPolicy(ctx context.Context, req *SystemSetPolicyReq) (*SystemSetPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemSetPolicy not implemented")
+}
+func (*UnimplementedMgmtSvcServer) SystemRestart(req *SystemRestartReq, srv MgmtSvc_SystemRestartServer) error {
+	return status.Errorf(codes.Unimplemented, "method SystemRestart not implemented")
+}
+func (*UnimplementedMgmtSvcServer) SystemDbBackup(req *SystemDbBackupReq, srv MgmtSvc_SystemDbBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method SystemDbBackup not implemented")
+}
+func (*UnimplementedMgmtSvcServer) SystemReplicaList(ctx context.Context, req *SystemReplicaListReq) (*SystemReplicaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemReplicaList not implemented")
+}
+func (*UnimplementedMgmtSvcServer) SystemReplicaAdd(ctx context.Context, req *SystemReplicaReq) (*SystemReplicaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemReplicaAdd not implemented")
+}
+func (*UnimplementedMgmtSvcServer) SystemReplicaRemove(ctx context.Context, req *SystemReplicaReq) (*SystemReplicaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemReplicaRemove not implemented")
+}
+func (*UnimplementedMgmtSvcServer) ReplicaStart(ctx context.Context, req *ReplicaStartReq) (*ReplicaStartResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicaStart not implemented")
+}
+func (*UnimplementedMgmtSvcServer) QuotaSet(ctx context.Context, req *QuotaSetReq) (*QuotaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotaSet not implemented")
+}
+func (*UnimplementedMgmtSvcServer) QuotaGet(ctx context.Context, req *QuotaGetReq) (*QuotaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotaGet not implemented")
+}
+func (*UnimplementedMgmtSvcServer) QuotaList(ctx context.Context, req *QuotaListReq) (*QuotaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotaList not implemented")
+}
 
 func RegisterMgmtSvcServer(s *grpc.Server, srv MgmtSvcServer) {
 	s.RegisterService(&_MgmtSvc_serviceDesc, srv)
@@ -743,6 +1098,24 @@ func _MgmtSvc_PoolSetProp_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_PoolGetProp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolGetPropReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolGetProp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolGetProp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolGetProp(ctx, req.(*PoolGetPropReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_PoolGetACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetACLReq)
 	if err := dec(in); err != nil {
@@ -887,6 +1260,78 @@ func _MgmtSvc_ContSetOwner_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_ContQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContQueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).ContQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/ContQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).ContQuery(ctx, req.(*ContQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_ContDestroy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContDestroyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).ContDestroy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/ContDestroy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).ContDestroy(ctx, req.(*ContDestroyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_ContGetProp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContGetPropReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).ContGetProp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/ContGetProp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).ContGetProp(ctx, req.(*ContGetPropReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_ContSetProp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContSetPropReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).ContSetProp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/ContSetProp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).ContSetProp(ctx, req.(*ContSetPropReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_SystemQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SystemQueryReq)
 	if err := dec(in); err != nil {
@@ -959,6 +1404,231 @@ func _MgmtSvc_SystemStart_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).ListEvents(ctx, req.(*ListEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).SubscribeEvents(m, &mgmtSvcSubscribeEventsServer{stream})
+}
+
+type MgmtSvc_SubscribeEventsServer interface {
+	Send(*SubscribeEventsResp) error
+	grpc.ServerStream
+}
+
+type mgmtSvcSubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *mgmtSvcSubscribeEventsServer) Send(m *SubscribeEventsResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MgmtSvc_SystemSetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemSetPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemSetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemSetPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemSetPolicy(ctx, req.(*SystemSetPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemRestart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SystemRestartReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).SystemRestart(m, &mgmtSvcSystemRestartServer{stream})
+}
+
+type MgmtSvc_SystemRestartServer interface {
+	Send(*SystemRestartResp) error
+	grpc.ServerStream
+}
+
+type mgmtSvcSystemRestartServer struct {
+	grpc.ServerStream
+}
+
+func (x *mgmtSvcSystemRestartServer) Send(m *SystemRestartResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MgmtSvc_SystemDbBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SystemDbBackupReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).SystemDbBackup(m, &mgmtSvcSystemDbBackupServer{stream})
+}
+
+type MgmtSvc_SystemDbBackupServer interface {
+	Send(*SystemDbBackupResp) error
+	grpc.ServerStream
+}
+
+type mgmtSvcSystemDbBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *mgmtSvcSystemDbBackupServer) Send(m *SystemDbBackupResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MgmtSvc_SystemReplicaList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemReplicaListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemReplicaList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemReplicaList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemReplicaList(ctx, req.(*SystemReplicaListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemReplicaAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemReplicaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemReplicaAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemReplicaAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemReplicaAdd(ctx, req.(*SystemReplicaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemReplicaRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemReplicaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemReplicaRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemReplicaRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemReplicaRemove(ctx, req.(*SystemReplicaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_ReplicaStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicaStartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).ReplicaStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/ReplicaStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).ReplicaStart(ctx, req.(*ReplicaStartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_QuotaSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotaSetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).QuotaSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/QuotaSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).QuotaSet(ctx, req.(*QuotaSetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_QuotaGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotaGetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).QuotaGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/QuotaGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).QuotaGet(ctx, req.(*QuotaGetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_QuotaList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotaListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).QuotaList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/QuotaList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).QuotaList(ctx, req.(*QuotaListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MgmtSvc_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mgmt.MgmtSvc",
 	HandlerType: (*MgmtSvcServer)(nil),
@@ -1015,6 +1685,10 @@ var _MgmtSvc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PoolSetProp",
 			Handler:    _MgmtSvc_PoolSetProp_Handler,
 		},
+		{
+			MethodName: "PoolGetProp",
+			Handler:    _MgmtSvc_PoolGetProp_Handler,
+		},
 		{
 			MethodName: "PoolGetACL",
 			Handler:    _MgmtSvc_PoolGetACL_Handler,
@@ -1047,6 +1721,22 @@ var _MgmtSvc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ContSetOwner",
 			Handler:    _MgmtSvc_ContSetOwner_Handler,
 		},
+		{
+			MethodName: "ContQuery",
+			Handler:    _MgmtSvc_ContQuery_Handler,
+		},
+		{
+			MethodName: "ContDestroy",
+			Handler:    _MgmtSvc_ContDestroy_Handler,
+		},
+		{
+			MethodName: "ContGetProp",
+			Handler:    _MgmtSvc_ContGetProp_Handler,
+		},
+		{
+			MethodName: "ContSetProp",
+			Handler:    _MgmtSvc_ContSetProp_Handler,
+		},
 		{
 			MethodName: "SystemQuery",
 			Handler:    _MgmtSvc_SystemQuery_Handler,
@@ -1063,7 +1753,59 @@ var _MgmtSvc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SystemStart",
 			Handler:    _MgmtSvc_SystemStart_Handler,
 		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _MgmtSvc_ListEvents_Handler,
+		},
+		{
+			MethodName: "SystemSetPolicy",
+			Handler:    _MgmtSvc_SystemSetPolicy_Handler,
+		},
+		{
+			MethodName: "SystemReplicaList",
+			Handler:    _MgmtSvc_SystemReplicaList_Handler,
+		},
+		{
+			MethodName: "SystemReplicaAdd",
+			Handler:    _MgmtSvc_SystemReplicaAdd_Handler,
+		},
+		{
+			MethodName: "SystemReplicaRemove",
+			Handler:    _MgmtSvc_SystemReplicaRemove_Handler,
+		},
+		{
+			MethodName: "ReplicaStart",
+			Handler:    _MgmtSvc_ReplicaStart_Handler,
+		},
+		{
+			MethodName: "QuotaSet",
+			Handler:    _MgmtSvc_QuotaSet_Handler,
+		},
+		{
+			MethodName: "QuotaGet",
+			Handler:    _MgmtSvc_QuotaGet_Handler,
+		},
+		{
+			MethodName: "QuotaList",
+			Handler:    _MgmtSvc_QuotaList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _MgmtSvc_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SystemRestart",
+			Handler:       _MgmtSvc_SystemRestart_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SystemDbBackup",
+			Handler:       _MgmtSvc_SystemDbBackup_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "mgmt/mgmt.proto",
 }
@@ -121,6 +121,7 @@ type PoolCreateReq struct {
 	Ranks                []uint32       `protobuf:"varint,12,rep,packed,name=ranks,proto3" json:"ranks,omitempty"`
 	Scmbytes             uint64         `protobuf:"varint,13,opt,name=scmbytes,proto3" json:"scmbytes,omitempty"`
 	Nvmebytes            uint64         `protobuf:"varint,14,opt,name=nvmebytes,proto3" json:"nvmebytes,omitempty"`
+	Dryrun               bool           `protobuf:"varint,15,opt,name=dryrun,proto3" json:"dryrun,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -249,6 +250,13 @@ func (m *PoolCreateReq) GetNvmebytes() uint64 {
 	return 0
 }
 
+func (m *PoolCreateReq) GetDryrun() bool {
+	if m != nil {
+		return m.Dryrun
+	}
+	return false
+}
+
 // PoolCreateResp returns created pool uuid and ranks.
 type PoolCreateResp struct {
 	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -1953,6 +1961,190 @@ func (*PoolSetPropResp) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// PoolProperty represents a pool property and its value.
+type PoolProperty struct {
+	Number               uint32   `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Strval               string   `protobuf:"bytes,2,opt,name=strval,proto3" json:"strval,omitempty"`
+	Numval               uint64   `protobuf:"varint,3,opt,name=numval,proto3" json:"numval,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PoolProperty) Reset()         { *m = PoolProperty{} }
+func (m *PoolProperty) String() string { return proto.CompactTextString(m) }
+func (*PoolProperty) ProtoMessage()    {}
+func (*PoolProperty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b098146a86574629, []int{27}
+}
+
+func (m *PoolProperty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolProperty.Unmarshal(m, b)
+}
+func (m *PoolProperty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolProperty.Marshal(b, m, deterministic)
+}
+func (m *PoolProperty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolProperty.Merge(m, src)
+}
+func (m *PoolProperty) XXX_Size() int {
+	return xxx_messageInfo_PoolProperty.Size(m)
+}
+func (m *PoolProperty) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolProperty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolProperty proto.InternalMessageInfo
+
+func (m *PoolProperty) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *PoolProperty) GetStrval() string {
+	if m != nil {
+		return m.Strval
+	}
+	return ""
+}
+
+func (m *PoolProperty) GetNumval() uint64 {
+	if m != nil {
+		return m.Numval
+	}
+	return 0
+}
+
+func (m *PoolProperty) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// PoolGetPropReq represents a request to get pool properties.
+type PoolGetPropReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Uuid                 string   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Names                []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	Numbers              []uint32 `protobuf:"varint,4,rep,packed,name=numbers,proto3" json:"numbers,omitempty"`
+	SvcRanks             []uint32 `protobuf:"varint,5,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PoolGetPropReq) Reset()         { *m = PoolGetPropReq{} }
+func (m *PoolGetPropReq) String() string { return proto.CompactTextString(m) }
+func (*PoolGetPropReq) ProtoMessage()    {}
+func (*PoolGetPropReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b098146a86574629, []int{28}
+}
+
+func (m *PoolGetPropReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolGetPropReq.Unmarshal(m, b)
+}
+func (m *PoolGetPropReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolGetPropReq.Marshal(b, m, deterministic)
+}
+func (m *PoolGetPropReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolGetPropReq.Merge(m, src)
+}
+func (m *PoolGetPropReq) XXX_Size() int {
+	return xxx_messageInfo_PoolGetPropReq.Size(m)
+}
+func (m *PoolGetPropReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolGetPropReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolGetPropReq proto.InternalMessageInfo
+
+func (m *PoolGetPropReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *PoolGetPropReq) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *PoolGetPropReq) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *PoolGetPropReq) GetNumbers() []uint32 {
+	if m != nil {
+		return m.Numbers
+	}
+	return nil
+}
+
+func (m *PoolGetPropReq) GetSvcRanks() []uint32 {
+	if m != nil {
+		return m.SvcRanks
+	}
+	return nil
+}
+
+// PoolGetPropResp represents the result of getting pool properties.
+type PoolGetPropResp struct {
+	Status               int32           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Properties           []*PoolProperty `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PoolGetPropResp) Reset()         { *m = PoolGetPropResp{} }
+func (m *PoolGetPropResp) String() string { return proto.CompactTextString(m) }
+func (*PoolGetPropResp) ProtoMessage()    {}
+func (*PoolGetPropResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b098146a86574629, []int{29}
+}
+
+func (m *PoolGetPropResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolGetPropResp.Unmarshal(m, b)
+}
+func (m *PoolGetPropResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolGetPropResp.Marshal(b, m, deterministic)
+}
+func (m *PoolGetPropResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolGetPropResp.Merge(m, src)
+}
+func (m *PoolGetPropResp) XXX_Size() int {
+	return xxx_messageInfo_PoolGetPropResp.Size(m)
+}
+func (m *PoolGetPropResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolGetPropResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolGetPropResp proto.InternalMessageInfo
+
+func (m *PoolGetPropResp) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PoolGetPropResp) GetProperties() []*PoolProperty {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("mgmt.PoolRebuildStatus_State", PoolRebuildStatus_State_name, PoolRebuildStatus_State_value)
 	proto.RegisterType((*FaultDomain)(nil), "mgmt.FaultDomain")
@@ -1984,6 +2176,9 @@ func init() {
 	proto.RegisterType((*PoolQueryResp)(nil), "mgmt.PoolQueryResp")
 	proto.RegisterType((*PoolSetPropReq)(nil), "mgmt.PoolSetPropReq")
 	proto.RegisterType((*PoolSetPropResp)(nil), "mgmt.PoolSetPropResp")
+	proto.RegisterType((*PoolProperty)(nil), "mgmt.PoolProperty")
+	proto.RegisterType((*PoolGetPropReq)(nil), "mgmt.PoolGetPropReq")
+	proto.RegisterType((*PoolGetPropResp)(nil), "mgmt.PoolGetPropResp")
 }
 
 func init() {
@@ -1991,80 +2186,86 @@ func init() {
 }
 
 var fileDescriptor_b098146a86574629 = []byte{
-	// 1188 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x5f, 0x6f, 0xe3, 0x44,
-	0x10, 0x3f, 0x37, 0x76, 0x93, 0x4c, 0x9b, 0xfe, 0x31, 0x15, 0x98, 0x1e, 0x87, 0x22, 0x0b, 0x74,
-	0x39, 0xa1, 0x4b, 0x44, 0x4f, 0x27, 0x10, 0x0f, 0x3c, 0xf4, 0x52, 0xd4, 0x4a, 0x27, 0x68, 0xb7,
-	0xba, 0x07, 0x78, 0xa9, 0x36, 0xf6, 0x36, 0x35, 0xb5, 0xbd, 0x61, 0x77, 0x1d, 0xb5, 0xe2, 0x11,
-	0xf1, 0x80, 0x84, 0xf8, 0x04, 0x3c, 0xf1, 0x35, 0xf8, 0x0a, 0x7c, 0x01, 0xbe, 0x0d, 0x9a, 0xdd,
-	0x75, 0x12, 0xb7, 0x89, 0x75, 0x87, 0x4e, 0xba, 0xa7, 0xcc, 0xcc, 0xce, 0xae, 0x7f, 0xf3, 0x9b,
-	0xd9, 0xd9, 0x09, 0x6c, 0x67, 0xe3, 0x4c, 0x0d, 0x26, 0x9c, 0xa7, 0xfd, 0x89, 0xe0, 0x8a, 0xfb,
-	0x2e, 0x1a, 0xc2, 0x33, 0xd8, 0xf8, 0x86, 0x16, 0xa9, 0x1a, 0xf2, 0x8c, 0x26, 0xb9, 0xff, 0x3e,
-	0xac, 0xc7, 0x5a, 0x0a, 0x9c, 0xae, 0xd3, 0x6b, 0x13, 0xab, 0xf9, 0x5b, 0xb0, 0x96, 0xc4, 0xc1,
-	0x5a, 0xd7, 0xe9, 0x75, 0xc8, 0x5a, 0x12, 0xfb, 0xfb, 0xd0, 0x8a, 0xae, 0x92, 0x34, 0x16, 0x2c,
-	0x0f, 0x1a, 0xdd, 0x46, 0xaf, 0x43, 0x66, 0x7a, 0xf8, 0x7b, 0x03, 0x3a, 0xa7, 0x9c, 0xa7, 0x2f,
-	0x04, 0xa3, 0x8a, 0x11, 0xf6, 0x93, 0xef, 0x83, 0x5b, 0x14, 0x49, 0x6c, 0xcf, 0xd4, 0x32, 0xda,
-	0x72, 0x9a, 0x31, 0x7d, 0x66, 0x9b, 0x68, 0xd9, 0xdf, 0x81, 0x86, 0xbc, 0x95, 0x41, 0x43, 0x9b,
-	0x50, 0xd4, 0x3b, 0x25, 0x13, 0x81, 0x6b, 0x77, 0x4a, 0x26, 0xfc, 0x8f, 0xa0, 0x8d, 0xbf, 0x63,
-	0xc1, 0x8b, 0x49, 0xe0, 0xe9, 0x85, 0xb9, 0x01, 0xcf, 0xa0, 0x51, 0x1a, 0xac, 0x77, 0x1b, 0x78,
-	0x06, 0x8d, 0x52, 0xff, 0x39, 0x6c, 0x5e, 0xce, 0x43, 0x94, 0x41, 0xb3, 0xdb, 0xe8, 0x6d, 0x1c,
-	0xec, 0xf6, 0x31, 0xfe, 0xfe, 0x42, 0xf0, 0xa4, 0xe2, 0xe6, 0x7f, 0x0c, 0x90, 0x17, 0x99, 0x9c,
-	0x46, 0x82, 0x4d, 0x64, 0xd0, 0xd2, 0xa1, 0x2f, 0x58, 0x70, 0x5d, 0x71, 0x45, 0xd3, 0xd1, 0xad,
-	0x62, 0x32, 0x68, 0x77, 0x9d, 0x9e, 0x4b, 0x16, 0x2c, 0x48, 0x91, 0x8c, 0x32, 0x41, 0x55, 0xc2,
-	0x03, 0xe8, 0x3a, 0x3d, 0x87, 0xcc, 0x74, 0x5c, 0xcb, 0x8b, 0x4c, 0xd0, 0xfc, 0x5a, 0x06, 0x1b,
-	0xfa, 0xe4, 0x99, 0xee, 0xef, 0x81, 0x67, 0x16, 0x36, 0x35, 0xaf, 0x46, 0xb1, 0xa7, 0x99, 0x6f,
-	0x75, 0xf4, 0xb7, 0x66, 0x3a, 0x12, 0x92, 0x4f, 0x33, 0x66, 0x16, 0xb7, 0xf4, 0xe2, 0xdc, 0x10,
-	0xfe, 0xe9, 0xc0, 0xd6, 0x62, 0x3a, 0xe4, 0x04, 0xb3, 0x2c, 0x15, 0x55, 0x85, 0xd4, 0x19, 0xf1,
-	0x88, 0xd5, 0xfc, 0x0f, 0xa1, 0x25, 0xa7, 0xd1, 0x85, 0x0e, 0x78, 0x4d, 0x7f, 0xbd, 0x29, 0xa7,
-	0x11, 0xc1, 0x68, 0x1f, 0x42, 0x5b, 0x8d, 0xd5, 0x85, 0x41, 0x66, 0x33, 0xae, 0xc6, 0x8a, 0x68,
-	0x70, 0x0f, 0xa1, 0x2d, 0xa3, 0xec, 0xc2, 0x00, 0x70, 0x67, 0xe8, 0x0e, 0x35, 0xba, 0x47, 0x00,
-	0x08, 0xc6, 0xae, 0x7a, 0x73, 0x78, 0x7a, 0x39, 0x4c, 0x0c, 0xba, 0x21, 0x93, 0x4a, 0xf0, 0x5b,
-	0xac, 0x16, 0x5b, 0x05, 0x4e, 0xb5, 0x0a, 0x0a, 0x5b, 0x7f, 0x65, 0xfd, 0xec, 0x81, 0x77, 0xc9,
-	0x45, 0xc4, 0x74, 0xb5, 0xb4, 0x88, 0x51, 0x34, 0x12, 0x8c, 0x40, 0xc3, 0x74, 0x0d, 0x4c, 0x0c,
-	0x01, 0xf5, 0xf0, 0x09, 0x6c, 0x57, 0x3e, 0xb5, 0x9a, 0x89, 0xf0, 0x1a, 0x36, 0xd1, 0xf5, 0x68,
-	0x9a, 0x44, 0xea, 0xf5, 0x31, 0x55, 0xbe, 0xde, 0xa8, 0x7e, 0xdd, 0x0f, 0xa0, 0x79, 0x45, 0xf3,
-	0x38, 0x65, 0x06, 0x58, 0x9b, 0x94, 0x6a, 0xf8, 0xd8, 0xdc, 0x17, 0xfb, 0xb1, 0x1a, 0x54, 0xbf,
-	0xda, 0x54, 0x1e, 0xdd, 0x44, 0x69, 0x11, 0xb3, 0xd7, 0x07, 0xe6, 0x83, 0x8b, 0xa0, 0x34, 0x57,
-	0x1d, 0xa2, 0x65, 0xac, 0x1a, 0x45, 0xc5, 0x98, 0xa9, 0x24, 0xbe, 0xb1, 0x54, 0xcd, 0x0d, 0xd5,
-	0x50, 0xbc, 0xe5, 0x44, 0xce, 0x60, 0xd4, 0x40, 0xfe, 0xc5, 0x31, 0x4c, 0x0e, 0x05, 0xde, 0xb0,
-	0x77, 0x05, 0xd8, 0x32, 0x6c, 0x41, 0xd4, 0xc0, 0xfd, 0xd7, 0xb1, 0xb9, 0xb8, 0x51, 0x2c, 0x8f,
-	0xdf, 0xa8, 0x1a, 0x17, 0xb3, 0x6e, 0x2f, 0x6d, 0x5d, 0x35, 0x56, 0x6e, 0xb4, 0x57, 0x77, 0xa3,
-	0xd7, 0xef, 0xdc, 0xe8, 0xff, 0xd9, 0xd0, 0xc2, 0x5e, 0x59, 0x3c, 0x26, 0xb4, 0x1a, 0x16, 0x7e,
-	0x73, 0xc0, 0x47, 0x57, 0xc2, 0x92, 0x5c, 0xb1, 0xb1, 0xb0, 0x6d, 0xfc, 0x9d, 0xa4, 0xee, 0x29,
-	0xbc, 0x77, 0x0f, 0x4a, 0x0d, 0xf4, 0x2e, 0x6c, 0xbe, 0x4c, 0xa4, 0xc2, 0x2d, 0x72, 0x29, 0xe6,
-	0xf0, 0x0f, 0x07, 0x3a, 0x0b, 0x2e, 0x35, 0xed, 0xb0, 0x0f, 0x1e, 0xbe, 0x97, 0xa6, 0x17, 0x6e,
-	0x1c, 0x04, 0x86, 0xe0, 0xca, 0xde, 0xbe, 0xc6, 0x66, 0xdc, 0xf6, 0x9f, 0x83, 0x8b, 0xea, 0xd2,
-	0xe7, 0x6e, 0x75, 0x6b, 0x0d, 0xbf, 0x86, 0x1d, 0x13, 0xa1, 0xe4, 0xe9, 0x94, 0x9d, 0x0c, 0x97,
-	0x53, 0x8d, 0xed, 0xa3, 0xc8, 0x68, 0x7e, 0x32, 0xb4, 0x6c, 0x97, 0x6a, 0xf8, 0x18, 0x76, 0xef,
-	0xec, 0x97, 0x93, 0x65, 0x18, 0xc2, 0x53, 0xd8, 0x40, 0xf0, 0x2f, 0x78, 0xfe, 0x96, 0x7a, 0x5a,
-	0xf8, 0xb3, 0x61, 0xdb, 0x9c, 0x58, 0xc3, 0xe4, 0x17, 0x00, 0x11, 0xcf, 0x15, 0x4d, 0x72, 0x26,
-	0x4a, 0x3a, 0x3f, 0x98, 0xd3, 0x59, 0xee, 0xef, 0x6b, 0x61, 0xc1, 0x75, 0x7f, 0x1f, 0x5c, 0xb4,
-	0x2d, 0x0d, 0xe7, 0xcc, 0x74, 0x96, 0xb3, 0x82, 0x89, 0xdb, 0xb7, 0x14, 0x4f, 0x01, 0xbb, 0xe7,
-	0x8a, 0x0b, 0x3a, 0x66, 0xaf, 0x24, 0x1d, 0xb3, 0x73, 0x45, 0x95, 0x7e, 0x90, 0xf5, 0xb3, 0xae,
-	0x4f, 0x76, 0x89, 0x51, 0xf0, 0xec, 0x4b, 0xc1, 0xcc, 0xfc, 0xe2, 0x12, 0x2d, 0x23, 0x82, 0x2c,
-	0xc9, 0x75, 0xe5, 0xbb, 0x04, 0x45, 0x6d, 0xa1, 0x37, 0xf6, 0x4d, 0x44, 0x11, 0xf7, 0x65, 0x8c,
-	0xe6, 0xf6, 0xca, 0x6b, 0x39, 0xfc, 0xdb, 0x29, 0x53, 0x38, 0x2a, 0x92, 0x34, 0x3e, 0x37, 0xa4,
-	0xad, 0x22, 0xf3, 0x19, 0x78, 0x28, 0x99, 0x4f, 0x6f, 0x1d, 0x3c, 0x32, 0x3c, 0xde, 0xdb, 0xdf,
-	0xc7, 0x1f, 0x46, 0x8c, 0x2f, 0x96, 0x0f, 0x1f, 0xfd, 0xc8, 0x22, 0x25, 0x2d, 0xbc, 0x52, 0xc5,
-	0x15, 0xc1, 0x22, 0x2e, 0xe2, 0xf2, 0xe9, 0x2e, 0xd5, 0xf0, 0x53, 0xf0, 0xf4, 0x19, 0x7e, 0x0b,
-	0xdc, 0x93, 0xe1, 0xcb, 0xa3, 0x9d, 0x07, 0x28, 0x0d, 0xbf, 0xfb, 0xf6, 0x68, 0xc7, 0x41, 0xe9,
-	0xf0, 0xd5, 0xf9, 0xf7, 0x3b, 0x6b, 0xb3, 0x79, 0xcf, 0x26, 0xa2, 0xa6, 0x0c, 0x96, 0xe5, 0x23,
-	0x84, 0x4d, 0x4d, 0xa8, 0x69, 0x07, 0xd2, 0xb6, 0x8d, 0x8a, 0xcd, 0xff, 0x04, 0x3a, 0x34, 0x52,
-	0xc9, 0x94, 0x95, 0x4e, 0xae, 0x76, 0xaa, 0x1a, 0xfd, 0x1e, 0x6c, 0xc7, 0x89, 0xa4, 0xa3, 0x94,
-	0xc5, 0xa5, 0x9f, 0xa7, 0xfd, 0xee, 0x9a, 0xfd, 0xcf, 0x31, 0x64, 0x4d, 0x95, 0x6e, 0xae, 0xb3,
-	0x5a, 0xbc, 0xc7, 0x21, 0x29, 0xfd, 0xfc, 0x27, 0xd0, 0x90, 0x51, 0x16, 0x34, 0x17, 0xdd, 0xef,
-	0x95, 0x0a, 0x41, 0x1f, 0xff, 0x33, 0x70, 0xb1, 0x57, 0xeb, 0x91, 0xb1, 0xc6, 0x57, 0x3b, 0xcd,
-	0xa6, 0xc8, 0x9c, 0xc7, 0x76, 0x8a, 0xec, 0x90, 0x05, 0x0b, 0x66, 0x67, 0xca, 0x84, 0x4c, 0x78,
-	0xae, 0x87, 0xc8, 0x0e, 0x29, 0x55, 0x24, 0x39, 0x65, 0x34, 0x66, 0xc2, 0x4e, 0x90, 0x56, 0x0b,
-	0xff, 0xb1, 0x43, 0xc2, 0x39, 0x53, 0xa7, 0x82, 0x4f, 0xde, 0xe4, 0x0d, 0x33, 0x13, 0xb9, 0x1e,
-	0xbf, 0x8f, 0x1f, 0xd8, 0x99, 0x3c, 0x80, 0xf5, 0xbc, 0xc8, 0x46, 0x76, 0x06, 0xef, 0x1c, 0x3f,
-	0x20, 0x56, 0xc7, 0x15, 0xa9, 0xc4, 0x94, 0xa6, 0x66, 0x08, 0x3f, 0x76, 0x88, 0xd5, 0xed, 0x1e,
-	0x5c, 0xd1, 0x6f, 0x17, 0xae, 0x18, 0xbd, 0x7a, 0xfb, 0x9a, 0xd5, 0xdb, 0x77, 0x08, 0xd0, 0x9a,
-	0x08, 0x3e, 0x61, 0x42, 0xdd, 0x1e, 0x36, 0xc1, 0x9b, 0xd2, 0xb4, 0x60, 0xe1, 0x5f, 0x8e, 0x19,
-	0x36, 0x66, 0xe1, 0xd4, 0xd4, 0xd7, 0xde, 0xe2, 0x7f, 0x8a, 0x25, 0x11, 0x34, 0x56, 0x46, 0xe0,
-	0xae, 0x8c, 0xc0, 0xab, 0x46, 0xb0, 0x14, 0xe4, 0xe1, 0x57, 0x3f, 0x7c, 0x39, 0x4e, 0xd4, 0x55,
-	0x31, 0xea, 0x47, 0x3c, 0x1b, 0xc4, 0x94, 0xcb, 0xa7, 0x52, 0xd1, 0xe8, 0x5a, 0x8b, 0x03, 0x29,
-	0xa2, 0x01, 0xf6, 0x34, 0xc1, 0xd3, 0x41, 0xc4, 0xb3, 0x8c, 0xe7, 0x03, 0xfd, 0x17, 0x6c, 0x80,
-	0x95, 0x31, 0x5a, 0xd7, 0xf2, 0xb3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xdf, 0xaf, 0x6e,
-	0xa1, 0x0d, 0x00, 0x00,
+	// 1281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x51, 0x8f, 0xdb, 0x44,
+	0x10, 0xae, 0x2f, 0xf6, 0x5d, 0x32, 0x97, 0x5c, 0xae, 0xa6, 0x2a, 0xe6, 0x4a, 0x51, 0x64, 0x81,
+	0x9a, 0x0a, 0x35, 0x11, 0x57, 0x55, 0x20, 0x1e, 0x78, 0xb8, 0xe6, 0xa0, 0x95, 0x2a, 0xb8, 0xee,
+	0xa9, 0x0f, 0x20, 0xa1, 0x6a, 0x63, 0x6f, 0x53, 0xb7, 0xb6, 0x37, 0xec, 0xae, 0xa3, 0x8b, 0x78,
+	0xac, 0x78, 0x40, 0x42, 0xfc, 0x02, 0x9e, 0x10, 0xff, 0x82, 0xbf, 0xc0, 0x1f, 0xe0, 0xdf, 0xa0,
+	0xd9, 0x5d, 0x27, 0x76, 0x2f, 0x67, 0xf5, 0x50, 0xa5, 0x3e, 0x79, 0x66, 0x76, 0x76, 0xf7, 0x9b,
+	0x6f, 0x66, 0x67, 0xd7, 0xd0, 0xcf, 0x66, 0x99, 0x1a, 0xcf, 0x39, 0x4f, 0x47, 0x73, 0xc1, 0x15,
+	0xf7, 0x5d, 0x34, 0x84, 0x8f, 0x61, 0xf7, 0x6b, 0x5a, 0xa4, 0x6a, 0xc2, 0x33, 0x9a, 0xe4, 0xfe,
+	0x75, 0xd8, 0x8e, 0xb5, 0x14, 0x38, 0x03, 0x67, 0xd8, 0x21, 0x56, 0xf3, 0xf7, 0x60, 0x2b, 0x89,
+	0x83, 0xad, 0x81, 0x33, 0xec, 0x91, 0xad, 0x24, 0xf6, 0x0f, 0xa0, 0x1d, 0x3d, 0x4f, 0xd2, 0x58,
+	0xb0, 0x3c, 0x68, 0x0d, 0x5a, 0xc3, 0x1e, 0x59, 0xe9, 0xe1, 0x5f, 0x2d, 0xe8, 0x9d, 0x70, 0x9e,
+	0xde, 0x17, 0x8c, 0x2a, 0x46, 0xd8, 0x4f, 0xbe, 0x0f, 0x6e, 0x51, 0x24, 0xb1, 0x5d, 0x53, 0xcb,
+	0x68, 0xcb, 0x69, 0xc6, 0xf4, 0x9a, 0x1d, 0xa2, 0x65, 0x7f, 0x1f, 0x5a, 0x72, 0x29, 0x83, 0x96,
+	0x36, 0xa1, 0xa8, 0x67, 0x4a, 0x26, 0x02, 0xd7, 0xce, 0x94, 0x4c, 0xf8, 0x1f, 0x42, 0x07, 0xbf,
+	0x33, 0xc1, 0x8b, 0x79, 0xe0, 0xe9, 0x81, 0xb5, 0x01, 0xd7, 0xa0, 0x51, 0x1a, 0x6c, 0x0f, 0x5a,
+	0xb8, 0x06, 0x8d, 0x52, 0xff, 0x1e, 0x74, 0x9f, 0xad, 0x43, 0x94, 0xc1, 0xce, 0xa0, 0x35, 0xdc,
+	0x3d, 0xbc, 0x3a, 0xc2, 0xf8, 0x47, 0x95, 0xe0, 0x49, 0xcd, 0xcd, 0xff, 0x08, 0x20, 0x2f, 0x32,
+	0xb9, 0x88, 0x04, 0x9b, 0xcb, 0xa0, 0xad, 0x43, 0xaf, 0x58, 0x70, 0x5c, 0x71, 0x45, 0xd3, 0xe9,
+	0x52, 0x31, 0x19, 0x74, 0x06, 0xce, 0xd0, 0x25, 0x15, 0x0b, 0x52, 0x24, 0xa3, 0x4c, 0x50, 0x95,
+	0xf0, 0x00, 0x06, 0xce, 0xd0, 0x21, 0x2b, 0x1d, 0xc7, 0xf2, 0x22, 0x13, 0x34, 0x7f, 0x29, 0x83,
+	0x5d, 0xbd, 0xf2, 0x4a, 0xf7, 0xaf, 0x81, 0x67, 0x06, 0xba, 0x9a, 0x57, 0xa3, 0xd8, 0xd5, 0xcc,
+	0x5e, 0x3d, 0xbd, 0xd7, 0x4a, 0x47, 0x42, 0xf2, 0x45, 0xc6, 0xcc, 0xe0, 0x9e, 0x1e, 0x5c, 0x1b,
+	0x74, 0x4a, 0xc5, 0x52, 0x14, 0x79, 0xd0, 0x1f, 0x38, 0xc3, 0x36, 0xb1, 0x5a, 0xf8, 0x87, 0x03,
+	0x7b, 0xd5, 0x34, 0xc9, 0x39, 0xba, 0x4a, 0x45, 0x55, 0x21, 0x75, 0xa6, 0x3c, 0x62, 0x35, 0xff,
+	0x03, 0x68, 0xcb, 0x45, 0xf4, 0x54, 0x13, 0xb1, 0xa5, 0x51, 0xed, 0xc8, 0x45, 0x44, 0x90, 0x85,
+	0x1b, 0xd0, 0x51, 0x33, 0xf5, 0xd4, 0x20, 0xb6, 0x95, 0xa0, 0x66, 0x8a, 0x68, 0xd0, 0x37, 0xa0,
+	0x23, 0xa3, 0xec, 0xa9, 0x01, 0xe6, 0xae, 0x50, 0x1f, 0x69, 0x5c, 0x37, 0x01, 0x10, 0xa4, 0x1d,
+	0xf5, 0xd6, 0xb0, 0xf5, 0x70, 0x98, 0x18, 0x74, 0x13, 0x26, 0x95, 0xe0, 0x4b, 0xac, 0x22, 0x5b,
+	0x1d, 0x4e, 0xbd, 0x3a, 0x0a, 0x5b, 0x97, 0x65, 0x5d, 0x5d, 0x03, 0xef, 0x19, 0x17, 0x11, 0xd3,
+	0x55, 0xd4, 0x26, 0x46, 0xd1, 0x48, 0x30, 0x02, 0x0d, 0xd3, 0x35, 0x30, 0x31, 0x04, 0xd4, 0xc3,
+	0xdb, 0xd0, 0xaf, 0x6d, 0x75, 0x31, 0x13, 0xe1, 0x4b, 0xe8, 0xa2, 0xeb, 0xf1, 0x22, 0x89, 0xd4,
+	0x9b, 0x63, 0xaa, 0xed, 0xde, 0xaa, 0xef, 0xee, 0x07, 0xb0, 0xf3, 0x9c, 0xe6, 0x71, 0xca, 0x0c,
+	0xb0, 0x0e, 0x29, 0xd5, 0xf0, 0x16, 0xf4, 0x2a, 0x9b, 0x35, 0xa0, 0xfa, 0xc5, 0xa6, 0xf2, 0xf8,
+	0x2c, 0x4a, 0x8b, 0x98, 0xbd, 0x39, 0x30, 0x1f, 0x5c, 0x04, 0xa5, 0xb9, 0xea, 0x11, 0x2d, 0x63,
+	0x35, 0x29, 0x2a, 0x66, 0x4c, 0x25, 0xf1, 0x99, 0xa5, 0x6a, 0x6d, 0xa8, 0x87, 0xe2, 0x6d, 0x26,
+	0x72, 0x05, 0xa3, 0x01, 0xf2, 0x2b, 0xc7, 0x30, 0x39, 0x11, 0x78, 0xf2, 0xde, 0x15, 0x60, 0xcb,
+	0xb0, 0x05, 0xd1, 0x00, 0xf7, 0x5f, 0xc7, 0xe6, 0xe2, 0x4c, 0xb1, 0x3c, 0xbe, 0x54, 0x35, 0x56,
+	0xb3, 0x6e, 0x94, 0xc6, 0x6a, 0xac, 0x9d, 0x74, 0xaf, 0xe9, 0xa4, 0x6f, 0xbf, 0x7e, 0xd2, 0xff,
+	0x5f, 0xa3, 0x0b, 0x87, 0xb0, 0x57, 0x0d, 0xad, 0x81, 0x85, 0x5f, 0x1d, 0xf0, 0xd1, 0x95, 0xb0,
+	0x24, 0x57, 0x6c, 0x26, 0x6c, 0x7b, 0x7f, 0x27, 0xa9, 0xbb, 0x03, 0xef, 0x9d, 0x83, 0xd2, 0x00,
+	0x7d, 0x00, 0xdd, 0x47, 0x89, 0x54, 0x38, 0x45, 0x6e, 0xc4, 0x1c, 0xfe, 0xee, 0x40, 0xaf, 0xe2,
+	0xd2, 0xd0, 0x0e, 0x47, 0xe0, 0xe1, 0x3d, 0x6a, 0x7a, 0xe1, 0xee, 0x61, 0x60, 0x08, 0xae, 0xcd,
+	0x1d, 0x69, 0x6c, 0xc6, 0xed, 0xe0, 0x1e, 0xb8, 0xa8, 0x6e, 0xbc, 0x06, 0x2f, 0x6e, 0xad, 0xe1,
+	0x57, 0xb0, 0x6f, 0x22, 0x94, 0x3c, 0x5d, 0xb0, 0x87, 0x93, 0xcd, 0x54, 0x63, 0xfb, 0x28, 0x32,
+	0x9a, 0x3f, 0x9c, 0x58, 0xb6, 0x4b, 0x35, 0xbc, 0x05, 0x57, 0x5f, 0x9b, 0x2f, 0xe7, 0x9b, 0x30,
+	0x84, 0x27, 0xb0, 0x8b, 0xe0, 0xef, 0xf3, 0xfc, 0x2d, 0xf5, 0xb4, 0xf0, 0x67, 0xe8, 0xae, 0x57,
+	0x6c, 0x60, 0xf2, 0x73, 0x80, 0x88, 0xe7, 0x8a, 0x26, 0x39, 0x13, 0x25, 0x9d, 0xef, 0xaf, 0xe9,
+	0x2c, 0xe7, 0x8f, 0xb4, 0x50, 0x71, 0x3d, 0x38, 0x00, 0x17, 0x6d, 0x1b, 0xc3, 0x79, 0x6c, 0x3a,
+	0xcb, 0xe3, 0x82, 0x89, 0xe5, 0x5b, 0x8a, 0xa7, 0x80, 0xab, 0xa7, 0x8a, 0x0b, 0x3a, 0x63, 0x4f,
+	0x24, 0x9d, 0xb1, 0x53, 0x45, 0x95, 0xbe, 0xa8, 0xf5, 0x75, 0xaf, 0x57, 0x76, 0x89, 0x51, 0x70,
+	0xed, 0x67, 0x82, 0x99, 0x77, 0x8d, 0x4b, 0xb4, 0x8c, 0x08, 0xb2, 0x24, 0xd7, 0x95, 0xef, 0x12,
+	0x14, 0xb5, 0x85, 0x9e, 0xd9, 0x3b, 0x11, 0x45, 0x9c, 0x97, 0x31, 0x9a, 0xdb, 0x23, 0xaf, 0xe5,
+	0xf0, 0x6f, 0xa7, 0x4c, 0xe1, 0xb4, 0x48, 0xd2, 0xf8, 0xd4, 0x90, 0x76, 0x11, 0x99, 0x77, 0xc1,
+	0x43, 0xc9, 0x6c, 0xbd, 0x77, 0x78, 0xd3, 0xf0, 0x78, 0x6e, 0xfe, 0x08, 0x3f, 0x8c, 0x18, 0x5f,
+	0x2c, 0x1f, 0x3e, 0x7d, 0xc1, 0x22, 0x25, 0x2d, 0xbc, 0x52, 0xc5, 0x11, 0xc1, 0x22, 0x2e, 0xe2,
+	0xf2, 0xea, 0x2e, 0xd5, 0xf0, 0x13, 0xf0, 0xf4, 0x1a, 0x7e, 0x1b, 0xdc, 0x87, 0x93, 0x47, 0xc7,
+	0xfb, 0x57, 0x50, 0x9a, 0x7c, 0xf7, 0xed, 0xf1, 0xbe, 0x83, 0xd2, 0xd1, 0x93, 0xd3, 0xef, 0xf7,
+	0xb7, 0xc2, 0xdf, 0xec, 0x3b, 0xd0, 0x26, 0xa2, 0xa1, 0x0c, 0x36, 0xe5, 0x23, 0x84, 0xae, 0x26,
+	0xd4, 0xb4, 0x03, 0x69, 0xdb, 0x46, 0xcd, 0xe6, 0x7f, 0x0c, 0x3d, 0x1a, 0xa9, 0x64, 0xc1, 0x4a,
+	0x27, 0x57, 0x3b, 0xd5, 0x8d, 0xfe, 0x10, 0xfa, 0x71, 0x22, 0xe9, 0x34, 0x65, 0x71, 0xe9, 0xe7,
+	0x69, 0xbf, 0xd7, 0xcd, 0xfe, 0x67, 0x18, 0xb2, 0xa6, 0x4a, 0x37, 0xd7, 0x55, 0x2d, 0x9e, 0xe3,
+	0x90, 0x94, 0x7e, 0xfe, 0x6d, 0x68, 0xc9, 0x28, 0x0b, 0x76, 0xaa, 0xee, 0xe7, 0x4a, 0x85, 0xa0,
+	0x8f, 0xff, 0x29, 0xb8, 0xd8, 0xab, 0x83, 0x76, 0xb3, 0xaf, 0x76, 0x5a, 0xbd, 0x2e, 0x73, 0x1e,
+	0xdb, 0xd7, 0x65, 0x8f, 0x54, 0x2c, 0x98, 0x9d, 0x05, 0x13, 0x32, 0xe1, 0xb9, 0x7e, 0x5c, 0xf6,
+	0x48, 0xa9, 0x22, 0xc9, 0x29, 0xa3, 0x31, 0x13, 0xf6, 0x65, 0x69, 0xb5, 0xf0, 0x1f, 0xfb, 0x48,
+	0x38, 0x65, 0xea, 0x44, 0xf0, 0xf9, 0x65, 0xee, 0x30, 0xf3, 0x52, 0xd7, 0xcf, 0xf2, 0x07, 0x57,
+	0xec, 0x5b, 0x3d, 0x80, 0xed, 0xbc, 0xc8, 0xa6, 0xf6, 0x6d, 0xde, 0x7b, 0x70, 0x85, 0x58, 0x1d,
+	0x47, 0xa4, 0x12, 0x0b, 0x9a, 0x9a, 0xc7, 0xf9, 0x03, 0x87, 0x58, 0xdd, 0xce, 0xc1, 0x11, 0x7d,
+	0x77, 0xe1, 0x88, 0xd1, 0xeb, 0xa7, 0x6f, 0xa7, 0x7e, 0xfa, 0x8e, 0x00, 0xda, 0x73, 0xc1, 0xe7,
+	0x4c, 0xa8, 0xe5, 0xd1, 0x0e, 0x78, 0x0b, 0x9a, 0x16, 0x2c, 0xfc, 0xd3, 0x81, 0x7e, 0x2d, 0x9c,
+	0x86, 0xfa, 0xba, 0x56, 0xfd, 0xd7, 0xd8, 0x10, 0x41, 0xeb, 0xc2, 0x08, 0xdc, 0x0b, 0x23, 0xf0,
+	0xea, 0x11, 0x6c, 0x06, 0xf9, 0xc2, 0xb4, 0xa2, 0x13, 0x3b, 0x80, 0x00, 0xed, 0x96, 0x8e, 0xc9,
+	0x8d, 0xdd, 0xf0, 0xfa, 0x6a, 0x43, 0x43, 0x7c, 0xb9, 0xdd, 0xf5, 0xd5, 0x76, 0xe6, 0x70, 0x5a,
+	0x6d, 0xf5, 0xf3, 0xe4, 0xae, 0x7f, 0x9e, 0xc2, 0x57, 0x36, 0xbf, 0xdf, 0x5c, 0x3e, 0xbf, 0x1e,
+	0x2e, 0x60, 0xba, 0x5e, 0x87, 0x18, 0x05, 0x0b, 0xcc, 0x80, 0x2b, 0x5f, 0x28, 0xa5, 0xda, 0x7c,
+	0x2d, 0xff, 0x08, 0xfd, 0x1a, 0x88, 0x86, 0xac, 0x1c, 0x02, 0x58, 0xc6, 0x12, 0x56, 0x36, 0x7f,
+	0x7f, 0x7d, 0xe0, 0x4a, 0xd2, 0x48, 0xc5, 0xeb, 0xe8, 0xcb, 0x1f, 0xbe, 0x98, 0x25, 0xea, 0x79,
+	0x31, 0x1d, 0x45, 0x3c, 0x1b, 0xc7, 0x94, 0xcb, 0x3b, 0x52, 0xd1, 0xe8, 0xa5, 0x16, 0xc7, 0x52,
+	0x44, 0x63, 0xbc, 0x24, 0x04, 0x4f, 0xc7, 0x11, 0xcf, 0x32, 0x9e, 0x8f, 0xf5, 0xbf, 0xee, 0x18,
+	0x17, 0x9d, 0x6e, 0x6b, 0xf9, 0xee, 0x7f, 0x03, 0x00, 0xc0, 0x01, 0xf5, 0x5a, 0x0a, 0x0f, 0x00,
+	0x00,
 }
@@ -530,6 +530,8 @@ type GetAttachInfoResp struct {
 	NetDevClass     uint32 `protobuf:"varint,8,opt,name=net_dev_class,json=netDevClass,proto3" json:"net_dev_class,omitempty"`
 	// I/O Engine network interface
 	MsRanks              []uint32 `protobuf:"varint,9,rep,packed,name=ms_ranks,json=msRanks,proto3" json:"ms_ranks,omitempty"`
+	MsReplicas           []string `protobuf:"bytes,10,rep,name=ms_replicas,json=msReplicas,proto3" json:"ms_replicas,omitempty"`
+	MapVersion           uint32   `protobuf:"varint,11,opt,name=map_version,json=mapVersion,proto3" json:"map_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -623,6 +625,20 @@ func (m *GetAttachInfoResp) GetMsRanks() []uint32 {
 	return nil
 }
 
+func (m *GetAttachInfoResp) GetMsReplicas() []string {
+	if m != nil {
+		return m.MsReplicas
+	}
+	return nil
+}
+
+func (m *GetAttachInfoResp) GetMapVersion() uint32 {
+	if m != nil {
+		return m.MapVersion
+	}
+	return 0
+}
+
 type GetAttachInfoResp_RankUri struct {
 	Rank                 uint32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
 	Uri                  string   `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
@@ -850,6 +866,45 @@ func (m *PoolMonitorReq) GetJobid() string {
 	return ""
 }
 
+type SetLogMasksReq struct {
+	Masks                string   `protobuf:"bytes,1,opt,name=masks,proto3" json:"masks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLogMasksReq) Reset()         { *m = SetLogMasksReq{} }
+func (m *SetLogMasksReq) String() string { return proto.CompactTextString(m) }
+func (*SetLogMasksReq) ProtoMessage()    {}
+func (*SetLogMasksReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314b26c93482b8d7, []int{13}
+}
+
+func (m *SetLogMasksReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLogMasksReq.Unmarshal(m, b)
+}
+func (m *SetLogMasksReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLogMasksReq.Marshal(b, m, deterministic)
+}
+func (m *SetLogMasksReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogMasksReq.Merge(m, src)
+}
+func (m *SetLogMasksReq) XXX_Size() int {
+	return xxx_messageInfo_SetLogMasksReq.Size(m)
+}
+func (m *SetLogMasksReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogMasksReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogMasksReq proto.InternalMessageInfo
+
+func (m *SetLogMasksReq) GetMasks() string {
+	if m != nil {
+		return m.Masks
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("mgmt.JoinResp_State", JoinResp_State_name, JoinResp_State_value)
 	proto.RegisterType((*DaosResp)(nil), "mgmt.DaosResp")
@@ -867,6 +922,7 @@ func init() {
 	proto.RegisterType((*PingRankReq)(nil), "mgmt.PingRankReq")
 	proto.RegisterType((*SetRankReq)(nil), "mgmt.SetRankReq")
 	proto.RegisterType((*PoolMonitorReq)(nil), "mgmt.PoolMonitorReq")
+	proto.RegisterType((*SetLogMasksReq)(nil), "mgmt.SetLogMasksReq")
 }
 
 func init() {
@@ -874,52 +930,54 @@ func init() {
 }
 
 var fileDescriptor_314b26c93482b8d7 = []byte{
-	// 741 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6f, 0xeb, 0x34,
-	0x18, 0xa6, 0xed, 0xda, 0xa6, 0x6f, 0xd5, 0x0f, 0xac, 0x23, 0x14, 0x76, 0x90, 0x56, 0x22, 0x40,
-	0x05, 0x44, 0x22, 0x1d, 0x84, 0x84, 0x10, 0x37, 0x63, 0x85, 0x31, 0xc4, 0xc7, 0x70, 0x57, 0x2e,
-	0xb8, 0x89, 0x3c, 0xc7, 0x6b, 0xcd, 0x12, 0x3b, 0xd8, 0x4e, 0xe9, 0x24, 0x7e, 0x08, 0x7f, 0x05,
-	0x89, 0x3f, 0xc6, 0x1d, 0xb2, 0x9d, 0x75, 0x6b, 0xe9, 0xa6, 0x73, 0xf7, 0xbe, 0x8f, 0x9f, 0x3c,
-	0x7e, 0xde, 0x0f, 0x07, 0x86, 0xc5, 0xb2, 0x30, 0x89, 0x5e, 0xd3, 0xb8, 0x54, 0xd2, 0x48, 0x74,
-	0x64, 0xf3, 0x28, 0x82, 0x60, 0x46, 0xa4, 0xc6, 0x4c, 0x97, 0xe8, 0x2d, 0xe8, 0x68, 0x43, 0x4c,
-	0xa5, 0xc3, 0xc6, 0xa4, 0x31, 0x6d, 0xe3, 0x3a, 0x8b, 0xfe, 0x6a, 0xc0, 0xf0, 0x5c, 0xc9, 0xaa,
-	0x5c, 0x94, 0x19, 0x31, 0x0c, 0xb3, 0xdf, 0xd1, 0x09, 0xf4, 0x0b, 0x52, 0xa6, 0x6b, 0xa6, 0x34,
-	0x97, 0xc2, 0xf1, 0x07, 0x18, 0x0a, 0x52, 0xfe, 0xe2, 0x11, 0xf4, 0x19, 0x74, 0x99, 0x58, 0x72,
-	0xc1, 0x74, 0xd8, 0x9c, 0xb4, 0xa6, 0xfd, 0x57, 0x2f, 0x63, 0x7b, 0x5f, 0xbc, 0xab, 0x13, 0x7f,
-	0xed, 0x38, 0xf8, 0x9e, 0x7b, 0x1c, 0x43, 0xc7, 0x43, 0x08, 0xc1, 0x91, 0x22, 0xe2, 0xb6, 0x96,
-	0x76, 0x31, 0x1a, 0x43, 0xab, 0x52, 0x3c, 0x6c, 0x4e, 0x1a, 0xd3, 0x1e, 0xb6, 0x61, 0xf4, 0x21,
-	0x8c, 0x76, 0x14, 0x9f, 0xa9, 0xe2, 0xef, 0x06, 0x74, 0xbf, 0x93, 0x5c, 0x58, 0xfb, 0x63, 0x68,
-	0xe9, 0x3b, 0x4f, 0xe8, 0x61, 0x1b, 0xda, 0xeb, 0xaa, 0x8a, 0x67, 0xb5, 0xb6, 0x8b, 0xb7, 0x16,
-	0x5a, 0xff, 0xb7, 0x70, 0xb4, 0xb5, 0x80, 0x5e, 0x40, 0x5b, 0x50, 0xb3, 0xd1, 0x61, 0xdb, 0xd1,
-	0x7c, 0x62, 0xbf, 0x25, 0x59, 0xa6, 0xc2, 0x8e, 0xd7, 0xb3, 0x31, 0xfa, 0x00, 0x86, 0x5a, 0xad,
-	0xbf, 0x21, 0x55, 0x6e, 0x66, 0xb2, 0x20, 0x5c, 0x84, 0x5d, 0x77, 0xba, 0x87, 0xda, 0x3b, 0x78,
-	0xb6, 0x09, 0x03, 0xa7, 0x67, 0xc3, 0xe8, 0x9f, 0x06, 0x04, 0xde, 0xfb, 0xd3, 0x05, 0x6e, 0xed,
-	0x36, 0x1f, 0xd9, 0xfd, 0x08, 0xda, 0xf6, 0x94, 0xb9, 0x1a, 0x86, 0xaf, 0x5e, 0xf8, 0x21, 0xdc,
-	0x4b, 0xc5, 0x73, 0x7b, 0x86, 0x3d, 0x05, 0x4d, 0xa0, 0x7f, 0xf3, 0xc8, 0x9b, 0x2f, 0xf1, 0x31,
-	0x84, 0xde, 0x81, 0x5e, 0x2e, 0x29, 0xc9, 0xed, 0xf7, 0xae, 0xdc, 0x00, 0x3f, 0x00, 0x51, 0x08,
-	0x6d, 0xa7, 0x87, 0x3a, 0xd0, 0xbc, 0xf8, 0x71, 0xfc, 0x06, 0xea, 0x42, 0xeb, 0xa7, 0xc5, 0xd5,
-	0xb8, 0x11, 0x45, 0x30, 0xfc, 0x9e, 0x91, 0x8c, 0xa9, 0x9f, 0x2b, 0xa6, 0xee, 0x0e, 0x0e, 0x20,
-	0x9a, 0xc3, 0x68, 0x87, 0xa3, 0x4b, 0xf4, 0x1e, 0x0c, 0x68, 0xa5, 0x14, 0x13, 0xc6, 0x9f, 0xd4,
-	0xf4, 0x5d, 0x10, 0x1d, 0x43, 0xa0, 0x58, 0x99, 0x73, 0x4a, 0xfc, 0xaa, 0xf5, 0xf0, 0x36, 0x8f,
-	0x4e, 0x61, 0x7c, 0xce, 0xcc, 0xa9, 0x31, 0x84, 0xae, 0x2e, 0xc4, 0x8d, 0x3c, 0x3c, 0xfb, 0x97,
-	0xd0, 0x23, 0x79, 0x9e, 0xda, 0x86, 0x69, 0xd7, 0xbd, 0x00, 0x07, 0x24, 0xcf, 0xb1, 0xcd, 0xa3,
-	0x7f, 0x9b, 0xf0, 0xe6, 0x9e, 0xc6, 0x33, 0x33, 0xf8, 0x12, 0x7a, 0x56, 0x26, 0xad, 0x14, 0xbf,
-	0x5f, 0xfc, 0x93, 0x7a, 0xf1, 0xf7, 0x35, 0x62, 0xab, 0xbf, 0x50, 0x1c, 0x07, 0xca, 0x07, 0xda,
-	0x96, 0x52, 0x2a, 0xb9, 0xe6, 0xb6, 0xd6, 0x96, 0xf3, 0xb7, 0xcd, 0x6d, 0xef, 0xb9, 0x30, 0x4c,
-	0xdd, 0x10, 0xca, 0xea, 0xd9, 0x3c, 0x00, 0xd6, 0x4f, 0xe6, 0xc7, 0xd6, 0x76, 0x47, 0x75, 0x86,
-	0x3e, 0x06, 0x44, 0x95, 0x49, 0xa9, 0xd9, 0xa4, 0x7a, 0x45, 0x14, 0x4b, 0xb7, 0x4b, 0x39, 0xc0,
-	0x23, 0xaa, 0xcc, 0x99, 0xd9, 0xcc, 0x2d, 0x7e, 0x6a, 0xf7, 0xf3, 0x04, 0xfa, 0x96, 0x6c, 0x78,
-	0xc1, 0x64, 0x65, 0xdc, 0x72, 0x0e, 0x30, 0x50, 0x65, 0xae, 0x3c, 0x82, 0x22, 0x18, 0x08, 0x66,
-	0xd2, 0x8c, 0xad, 0x53, 0x9a, 0x13, 0xad, 0xeb, 0x15, 0xed, 0x0b, 0x66, 0x66, 0x6c, 0x7d, 0x66,
-	0x21, 0xf4, 0x36, 0x04, 0x85, 0xae, 0x7b, 0xd9, 0x9b, 0xb4, 0xa6, 0x03, 0xdc, 0x2d, 0xb4, 0x6b,
-	0xe5, 0x71, 0x02, 0xdd, 0xba, 0xe6, 0xd7, 0x7c, 0xdd, 0xef, 0xc3, 0xe8, 0x52, 0xb1, 0x72, 0xbe,
-	0xaa, 0x4c, 0x26, 0xff, 0x70, 0x2f, 0xf7, 0xc0, 0x87, 0xd1, 0xbb, 0xd0, 0xbf, 0xe4, 0x62, 0x69,
-	0xb5, 0x9f, 0xa2, 0x4c, 0x00, 0xe6, 0xcc, 0x3c, 0xc7, 0xf8, 0x13, 0x86, 0x97, 0x52, 0xe6, 0x3f,
-	0x48, 0xc1, 0x8d, 0x54, 0x87, 0x17, 0xc5, 0xce, 0x47, 0xca, 0x7c, 0xb1, 0xb8, 0x98, 0xd5, 0x36,
-	0xb7, 0xb9, 0x7d, 0xdc, 0x36, 0xfe, 0x96, 0x88, 0x2c, 0x67, 0x8e, 0xe1, 0x27, 0xb8, 0x87, 0xda,
-	0xdf, 0xc5, 0x6f, 0xf2, 0x9a, 0x67, 0xf5, 0x0c, 0x7d, 0xf2, 0xd5, 0x17, 0xbf, 0x7e, 0xbe, 0xe4,
-	0x66, 0x55, 0x5d, 0xc7, 0x54, 0x16, 0x49, 0x46, 0xa4, 0xfe, 0x44, 0x1b, 0x42, 0x6f, 0x5d, 0x98,
-	0x68, 0x45, 0x13, 0x2a, 0x85, 0x51, 0x32, 0x4f, 0xa8, 0x2c, 0x0a, 0x29, 0x12, 0xf7, 0x0f, 0x4f,
-	0xec, 0x66, 0x5d, 0x77, 0x5c, 0xfc, 0xe9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x84, 0x88, 0x81,
-	0x05, 0xe1, 0x05, 0x00, 0x00,
+	// 780 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x51, 0x6f, 0x23, 0x35,
+	0x10, 0x26, 0x4d, 0x93, 0x6c, 0x26, 0x4a, 0x1a, 0xac, 0x0a, 0x2d, 0x3d, 0xa4, 0x86, 0x15, 0x9c,
+	0x0a, 0x88, 0x5d, 0xe9, 0x10, 0x12, 0x42, 0xbc, 0x94, 0x2b, 0x1c, 0x45, 0x77, 0x50, 0x9c, 0x0b,
+	0x0f, 0xbc, 0xac, 0x5c, 0xaf, 0x9b, 0x9a, 0xee, 0xda, 0x8b, 0xed, 0x0d, 0x3d, 0x89, 0x1f, 0xc2,
+	0x5f, 0x41, 0xf0, 0xe3, 0xd0, 0xd8, 0xdb, 0xbd, 0x36, 0xf4, 0x2a, 0xde, 0x66, 0x3e, 0x7f, 0xfb,
+	0x79, 0xc6, 0xf3, 0x65, 0x02, 0xb3, 0x6a, 0x5d, 0xb9, 0xcc, 0x6e, 0x78, 0x5a, 0x1b, 0xed, 0x34,
+	0xd9, 0xc5, 0x3c, 0x49, 0x20, 0x3a, 0x61, 0xda, 0x52, 0x61, 0x6b, 0xf2, 0x0e, 0x0c, 0xad, 0x63,
+	0xae, 0xb1, 0x71, 0x6f, 0xd1, 0x3b, 0x1a, 0xd0, 0x36, 0x4b, 0xfe, 0xec, 0xc1, 0xec, 0x99, 0xd1,
+	0x4d, 0xbd, 0xaa, 0x0b, 0xe6, 0x04, 0x15, 0xbf, 0x91, 0x43, 0x98, 0x54, 0xac, 0xce, 0x37, 0xc2,
+	0x58, 0xa9, 0x95, 0xe7, 0x4f, 0x29, 0x54, 0xac, 0xfe, 0x39, 0x20, 0xe4, 0x73, 0x18, 0x09, 0xb5,
+	0x96, 0x4a, 0xd8, 0x78, 0x67, 0xd1, 0x3f, 0x9a, 0x3c, 0x79, 0x94, 0xe2, 0x7d, 0xe9, 0x5d, 0x9d,
+	0xf4, 0x1b, 0xcf, 0xa1, 0x37, 0xdc, 0x83, 0x14, 0x86, 0x01, 0x22, 0x04, 0x76, 0x0d, 0x53, 0x57,
+	0xad, 0xb4, 0x8f, 0xc9, 0x1c, 0xfa, 0x8d, 0x91, 0xf1, 0xce, 0xa2, 0x77, 0x34, 0xa6, 0x18, 0x26,
+	0x1f, 0xc1, 0xde, 0x1d, 0xc5, 0x07, 0xba, 0xf8, 0xab, 0x07, 0xa3, 0xef, 0xb5, 0x54, 0x58, 0xfe,
+	0x1c, 0xfa, 0xf6, 0x55, 0x20, 0x8c, 0x29, 0x86, 0x78, 0x5d, 0xd3, 0xc8, 0xa2, 0xd5, 0xf6, 0x71,
+	0x57, 0x42, 0xff, 0xbf, 0x25, 0xec, 0x76, 0x25, 0x90, 0x7d, 0x18, 0x28, 0xee, 0xae, 0x6d, 0x3c,
+	0xf0, 0xb4, 0x90, 0xe0, 0xb7, 0xac, 0x28, 0x4c, 0x3c, 0x0c, 0x7a, 0x18, 0x93, 0xc7, 0x30, 0xb3,
+	0x66, 0xf3, 0x2d, 0x6b, 0x4a, 0x77, 0xa2, 0x2b, 0x26, 0x55, 0x3c, 0xf2, 0xa7, 0x5b, 0x28, 0xde,
+	0x21, 0x8b, 0xeb, 0x38, 0xf2, 0x7a, 0x18, 0x26, 0xff, 0xf4, 0x20, 0x0a, 0xb5, 0xbf, 0xb9, 0xc1,
+	0xae, 0xdc, 0x9d, 0x5b, 0xe5, 0x7e, 0x0c, 0x03, 0x3c, 0x15, 0xbe, 0x87, 0xd9, 0x93, 0xfd, 0x30,
+	0x84, 0x1b, 0xa9, 0x74, 0x89, 0x67, 0x34, 0x50, 0xc8, 0x02, 0x26, 0x17, 0xb7, 0x6a, 0x0b, 0x2d,
+	0xde, 0x86, 0xc8, 0x7b, 0x30, 0x2e, 0x35, 0x67, 0x25, 0x7e, 0xef, 0xdb, 0x8d, 0xe8, 0x6b, 0x20,
+	0x89, 0x61, 0xe0, 0xf5, 0xc8, 0x10, 0x76, 0x4e, 0x7f, 0x98, 0xbf, 0x45, 0x46, 0xd0, 0xff, 0x71,
+	0xf5, 0x72, 0xde, 0x4b, 0x12, 0x98, 0x3d, 0x17, 0xac, 0x10, 0xe6, 0xa7, 0x46, 0x98, 0x57, 0xf7,
+	0x0e, 0x20, 0x59, 0xc2, 0xde, 0x1d, 0x8e, 0xad, 0xc9, 0x07, 0x30, 0xe5, 0x8d, 0x31, 0x42, 0xb9,
+	0x70, 0xd2, 0xd2, 0xef, 0x82, 0xe4, 0x00, 0x22, 0x23, 0xea, 0x52, 0x72, 0x16, 0xac, 0x36, 0xa6,
+	0x5d, 0x9e, 0x1c, 0xc3, 0xfc, 0x99, 0x70, 0xc7, 0xce, 0x31, 0x7e, 0x79, 0xaa, 0x2e, 0xf4, 0xfd,
+	0xb3, 0x7f, 0x04, 0x63, 0x56, 0x96, 0x39, 0x3e, 0x98, 0xf5, 0xaf, 0x17, 0xd1, 0x88, 0x95, 0x25,
+	0xc5, 0x3c, 0xf9, 0xbb, 0x0f, 0x6f, 0x6f, 0x69, 0x3c, 0x30, 0x83, 0xaf, 0x60, 0x8c, 0x32, 0x79,
+	0x63, 0xe4, 0x8d, 0xf1, 0x0f, 0x5b, 0xe3, 0x6f, 0x6b, 0xa4, 0xa8, 0xbf, 0x32, 0x92, 0x46, 0x26,
+	0x04, 0x16, 0x5b, 0xa9, 0x8d, 0xde, 0x48, 0xec, 0xb5, 0xef, 0xeb, 0xeb, 0x72, 0x7c, 0x7b, 0xa9,
+	0x9c, 0x30, 0x17, 0x8c, 0x8b, 0x76, 0x36, 0xaf, 0x01, 0xac, 0xa7, 0x08, 0x63, 0x1b, 0xf8, 0xa3,
+	0x36, 0x23, 0x9f, 0x00, 0xe1, 0xc6, 0xe5, 0xdc, 0x5d, 0xe7, 0xf6, 0x92, 0x19, 0x91, 0x77, 0xa6,
+	0x9c, 0xd2, 0x3d, 0x6e, 0xdc, 0x53, 0x77, 0xbd, 0x44, 0xfc, 0x18, 0xfd, 0x79, 0x08, 0x13, 0x24,
+	0x3b, 0x59, 0x09, 0xdd, 0x38, 0x6f, 0xce, 0x29, 0x05, 0x6e, 0xdc, 0xcb, 0x80, 0x90, 0x04, 0xa6,
+	0x4a, 0xb8, 0xbc, 0x10, 0x9b, 0x9c, 0x97, 0xcc, 0xda, 0xd6, 0xa2, 0x13, 0x25, 0xdc, 0x89, 0xd8,
+	0x3c, 0x45, 0x88, 0xbc, 0x0b, 0x51, 0x65, 0xdb, 0xb7, 0x1c, 0x2f, 0xfa, 0x47, 0x53, 0x3a, 0xaa,
+	0xac, 0x7f, 0x4a, 0xbf, 0x34, 0x6c, 0xde, 0x0d, 0x0b, 0xfc, 0xb0, 0xa0, 0xb2, 0xb4, 0x45, 0xb6,
+	0xb7, 0xca, 0x64, 0x7b, 0xab, 0x1c, 0x64, 0x30, 0x6a, 0x5f, 0xed, 0x7f, 0xee, 0x87, 0x0f, 0x61,
+	0xef, 0xcc, 0x88, 0x7a, 0x79, 0xd9, 0xb8, 0x42, 0xff, 0xee, 0x7f, 0xfb, 0xf7, 0x7c, 0x98, 0xbc,
+	0x0f, 0x93, 0x33, 0xa9, 0xd6, 0xa8, 0xfd, 0x26, 0xca, 0x02, 0x60, 0x29, 0xdc, 0x43, 0x8c, 0x3f,
+	0x60, 0x76, 0xa6, 0x75, 0xf9, 0x42, 0x2b, 0xe9, 0xb4, 0xb9, 0xdf, 0x6a, 0x38, 0x61, 0xad, 0xcb,
+	0xd5, 0xea, 0xf4, 0xa4, 0x2d, 0xb3, 0xcb, 0x71, 0x3d, 0x60, 0xfc, 0x1d, 0x53, 0x45, 0x29, 0x3c,
+	0x23, 0x78, 0x60, 0x0b, 0xc5, 0x85, 0xf3, 0xab, 0x3e, 0x97, 0x45, 0xeb, 0x82, 0x90, 0x24, 0x8f,
+	0x61, 0xb6, 0x14, 0xee, 0xb9, 0x5e, 0xbf, 0x60, 0xf6, 0xca, 0xe2, 0xed, 0xfb, 0x30, 0xa8, 0x30,
+	0x6e, 0xef, 0x0f, 0xc9, 0xd7, 0x5f, 0xfe, 0xf2, 0xc5, 0x5a, 0xba, 0xcb, 0xe6, 0x3c, 0xe5, 0xba,
+	0xca, 0x0a, 0xa6, 0xed, 0xa7, 0xd6, 0x31, 0x7e, 0xe5, 0xc3, 0xcc, 0x1a, 0x9e, 0x71, 0xad, 0x9c,
+	0xd1, 0x65, 0xc6, 0x75, 0x55, 0x69, 0x95, 0xf9, 0x7f, 0x8b, 0x0c, 0x3d, 0x7c, 0x3e, 0xf4, 0xf1,
+	0x67, 0xff, 0x0e, 0x00, 0x26, 0x89, 0x07, 0x5c, 0x4b, 0x06, 0x00, 0x00,
 }
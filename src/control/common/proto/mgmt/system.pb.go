@@ -594,6 +594,1269 @@ func (m *SystemQueryResp) GetAbsenthosts() string {
 	return ""
 }
 
+// ListEventsReq supplies the criteria used to select entries from the
+// system event log.
+type ListEventsReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Ids                  []uint32 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Severities           []uint32 `protobuf:"varint,3,rep,packed,name=severities,proto3" json:"severities,omitempty"`
+	Ranks                string   `protobuf:"bytes,4,opt,name=ranks,proto3" json:"ranks,omitempty"`
+	Hosts                string   `protobuf:"bytes,5,opt,name=hosts,proto3" json:"hosts,omitempty"`
+	PoolUuid             string   `protobuf:"bytes,6,opt,name=pool_uuid,json=poolUuid,proto3" json:"pool_uuid,omitempty"`
+	Since                int64    `protobuf:"varint,7,opt,name=since,proto3" json:"since,omitempty"`
+	Until                int64    `protobuf:"varint,8,opt,name=until,proto3" json:"until,omitempty"`
+	Limit                uint32   `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	Types                []uint32 `protobuf:"varint,10,rep,packed,name=types,proto3" json:"types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEventsReq) Reset()         { *m = ListEventsReq{} }
+func (m *ListEventsReq) String() string { return proto.CompactTextString(m) }
+func (*ListEventsReq) ProtoMessage()    {}
+func (*ListEventsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{9}
+}
+
+func (m *ListEventsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsReq.Unmarshal(m, b)
+}
+func (m *ListEventsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsReq.Marshal(b, m, deterministic)
+}
+func (m *ListEventsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsReq.Merge(m, src)
+}
+func (m *ListEventsReq) XXX_Size() int {
+	return xxx_messageInfo_ListEventsReq.Size(m)
+}
+func (m *ListEventsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEventsReq proto.InternalMessageInfo
+
+func (m *ListEventsReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *ListEventsReq) GetIds() []uint32 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *ListEventsReq) GetSeverities() []uint32 {
+	if m != nil {
+		return m.Severities
+	}
+	return nil
+}
+
+func (m *ListEventsReq) GetRanks() string {
+	if m != nil {
+		return m.Ranks
+	}
+	return ""
+}
+
+func (m *ListEventsReq) GetHosts() string {
+	if m != nil {
+		return m.Hosts
+	}
+	return ""
+}
+
+func (m *ListEventsReq) GetPoolUuid() string {
+	if m != nil {
+		return m.PoolUuid
+	}
+	return ""
+}
+
+func (m *ListEventsReq) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *ListEventsReq) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *ListEventsReq) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListEventsReq) GetTypes() []uint32 {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+// EventLogEntry describes a RAS event recorded in the system event log.
+type EventLogEntry struct {
+	Sequence             uint64           `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Time                 int64            `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Event                *shared.RASEvent `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EventLogEntry) Reset()         { *m = EventLogEntry{} }
+func (m *EventLogEntry) String() string { return proto.CompactTextString(m) }
+func (*EventLogEntry) ProtoMessage()    {}
+func (*EventLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{10}
+}
+
+func (m *EventLogEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventLogEntry.Unmarshal(m, b)
+}
+func (m *EventLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventLogEntry.Marshal(b, m, deterministic)
+}
+func (m *EventLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLogEntry.Merge(m, src)
+}
+func (m *EventLogEntry) XXX_Size() int {
+	return xxx_messageInfo_EventLogEntry.Size(m)
+}
+func (m *EventLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLogEntry proto.InternalMessageInfo
+
+func (m *EventLogEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *EventLogEntry) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *EventLogEntry) GetEvent() *shared.RASEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+// ListEventsResp returns the selected system event log entries.
+type ListEventsResp struct {
+	Entries              []*EventLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListEventsResp) Reset()         { *m = ListEventsResp{} }
+func (m *ListEventsResp) String() string { return proto.CompactTextString(m) }
+func (*ListEventsResp) ProtoMessage()    {}
+func (*ListEventsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{11}
+}
+
+func (m *ListEventsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsResp.Unmarshal(m, b)
+}
+func (m *ListEventsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsResp.Marshal(b, m, deterministic)
+}
+func (m *ListEventsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsResp.Merge(m, src)
+}
+func (m *ListEventsResp) XXX_Size() int {
+	return xxx_messageInfo_ListEventsResp.Size(m)
+}
+func (m *ListEventsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEventsResp proto.InternalMessageInfo
+
+func (m *ListEventsResp) GetEntries() []*EventLogEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// SubscribeEventsReq supplies the criteria used to select RAS events to be
+// streamed to the subscriber as they are received by the MS leader.
+type SubscribeEventsReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Types                []uint32 `protobuf:"varint,2,rep,packed,name=types,proto3" json:"types,omitempty"`
+	Severities           []uint32 `protobuf:"varint,3,rep,packed,name=severities,proto3" json:"severities,omitempty"`
+	Ids                  []uint32 `protobuf:"varint,4,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Ranks                string   `protobuf:"bytes,5,opt,name=ranks,proto3" json:"ranks,omitempty"`
+	Hosts                string   `protobuf:"bytes,6,opt,name=hosts,proto3" json:"hosts,omitempty"`
+	PoolUuid             string   `protobuf:"bytes,7,opt,name=pool_uuid,json=poolUuid,proto3" json:"pool_uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeEventsReq) Reset()         { *m = SubscribeEventsReq{} }
+func (m *SubscribeEventsReq) String() string { return proto.CompactTextString(m) }
+func (*SubscribeEventsReq) ProtoMessage()    {}
+func (*SubscribeEventsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{12}
+}
+
+func (m *SubscribeEventsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeEventsReq.Unmarshal(m, b)
+}
+func (m *SubscribeEventsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeEventsReq.Marshal(b, m, deterministic)
+}
+func (m *SubscribeEventsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeEventsReq.Merge(m, src)
+}
+func (m *SubscribeEventsReq) XXX_Size() int {
+	return xxx_messageInfo_SubscribeEventsReq.Size(m)
+}
+func (m *SubscribeEventsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeEventsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeEventsReq proto.InternalMessageInfo
+
+func (m *SubscribeEventsReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *SubscribeEventsReq) GetTypes() []uint32 {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *SubscribeEventsReq) GetSeverities() []uint32 {
+	if m != nil {
+		return m.Severities
+	}
+	return nil
+}
+
+func (m *SubscribeEventsReq) GetIds() []uint32 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *SubscribeEventsReq) GetRanks() string {
+	if m != nil {
+		return m.Ranks
+	}
+	return ""
+}
+
+func (m *SubscribeEventsReq) GetHosts() string {
+	if m != nil {
+		return m.Hosts
+	}
+	return ""
+}
+
+func (m *SubscribeEventsReq) GetPoolUuid() string {
+	if m != nil {
+		return m.PoolUuid
+	}
+	return ""
+}
+
+// SubscribeEventsResp delivers a single RAS event to the subscriber.
+type SubscribeEventsResp struct {
+	Time                 int64            `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Event                *shared.RASEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Dropped              uint64           `protobuf:"varint,3,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	MapVersion           uint32           `protobuf:"varint,4,opt,name=map_version,json=mapVersion,proto3" json:"map_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SubscribeEventsResp) Reset()         { *m = SubscribeEventsResp{} }
+func (m *SubscribeEventsResp) String() string { return proto.CompactTextString(m) }
+func (*SubscribeEventsResp) ProtoMessage()    {}
+func (*SubscribeEventsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{13}
+}
+
+func (m *SubscribeEventsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeEventsResp.Unmarshal(m, b)
+}
+func (m *SubscribeEventsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeEventsResp.Marshal(b, m, deterministic)
+}
+func (m *SubscribeEventsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeEventsResp.Merge(m, src)
+}
+func (m *SubscribeEventsResp) XXX_Size() int {
+	return xxx_messageInfo_SubscribeEventsResp.Size(m)
+}
+func (m *SubscribeEventsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeEventsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeEventsResp proto.InternalMessageInfo
+
+func (m *SubscribeEventsResp) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *SubscribeEventsResp) GetEvent() *shared.RASEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *SubscribeEventsResp) GetDropped() uint64 {
+	if m != nil {
+		return m.Dropped
+	}
+	return 0
+}
+
+func (m *SubscribeEventsResp) GetMapVersion() uint32 {
+	if m != nil {
+		return m.MapVersion
+	}
+	return 0
+}
+
+// SystemSetPolicyReq supplies the runtime state to be applied to DAOS system
+// management policies.
+type SystemSetPolicyReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	AutoExcludePaused    bool     `protobuf:"varint,2,opt,name=auto_exclude_paused,json=autoExcludePaused,proto3" json:"auto_exclude_paused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemSetPolicyReq) Reset()         { *m = SystemSetPolicyReq{} }
+func (m *SystemSetPolicyReq) String() string { return proto.CompactTextString(m) }
+func (*SystemSetPolicyReq) ProtoMessage()    {}
+func (*SystemSetPolicyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{14}
+}
+
+func (m *SystemSetPolicyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemSetPolicyReq.Unmarshal(m, b)
+}
+func (m *SystemSetPolicyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemSetPolicyReq.Marshal(b, m, deterministic)
+}
+func (m *SystemSetPolicyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemSetPolicyReq.Merge(m, src)
+}
+func (m *SystemSetPolicyReq) XXX_Size() int {
+	return xxx_messageInfo_SystemSetPolicyReq.Size(m)
+}
+func (m *SystemSetPolicyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemSetPolicyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemSetPolicyReq proto.InternalMessageInfo
+
+func (m *SystemSetPolicyReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *SystemSetPolicyReq) GetAutoExcludePaused() bool {
+	if m != nil {
+		return m.AutoExcludePaused
+	}
+	return false
+}
+
+// SystemSetPolicyResp returns the resulting state of DAOS system management
+// policies.
+type SystemSetPolicyResp struct {
+	AutoExcludeEnabled     bool     `protobuf:"varint,1,opt,name=auto_exclude_enabled,json=autoExcludeEnabled,proto3" json:"auto_exclude_enabled,omitempty"`
+	AutoExcludePaused      bool     `protobuf:"varint,2,opt,name=auto_exclude_paused,json=autoExcludePaused,proto3" json:"auto_exclude_paused,omitempty"`
+	AutoExcludeGracePeriod uint64   `protobuf:"varint,3,opt,name=auto_exclude_grace_period,json=autoExcludeGracePeriod,proto3" json:"auto_exclude_grace_period,omitempty"`
+	AutoExcludePending     string   `protobuf:"bytes,4,opt,name=auto_exclude_pending,json=autoExcludePending,proto3" json:"auto_exclude_pending,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *SystemSetPolicyResp) Reset()         { *m = SystemSetPolicyResp{} }
+func (m *SystemSetPolicyResp) String() string { return proto.CompactTextString(m) }
+func (*SystemSetPolicyResp) ProtoMessage()    {}
+func (*SystemSetPolicyResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{15}
+}
+
+func (m *SystemSetPolicyResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemSetPolicyResp.Unmarshal(m, b)
+}
+func (m *SystemSetPolicyResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemSetPolicyResp.Marshal(b, m, deterministic)
+}
+func (m *SystemSetPolicyResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemSetPolicyResp.Merge(m, src)
+}
+func (m *SystemSetPolicyResp) XXX_Size() int {
+	return xxx_messageInfo_SystemSetPolicyResp.Size(m)
+}
+func (m *SystemSetPolicyResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemSetPolicyResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemSetPolicyResp proto.InternalMessageInfo
+
+func (m *SystemSetPolicyResp) GetAutoExcludeEnabled() bool {
+	if m != nil {
+		return m.AutoExcludeEnabled
+	}
+	return false
+}
+
+func (m *SystemSetPolicyResp) GetAutoExcludePaused() bool {
+	if m != nil {
+		return m.AutoExcludePaused
+	}
+	return false
+}
+
+func (m *SystemSetPolicyResp) GetAutoExcludeGracePeriod() uint64 {
+	if m != nil {
+		return m.AutoExcludeGracePeriod
+	}
+	return 0
+}
+
+func (m *SystemSetPolicyResp) GetAutoExcludePending() string {
+	if m != nil {
+		return m.AutoExcludePending
+	}
+	return ""
+}
+
+// SystemRestartReq supplies system restart parameters.
+type SystemRestartReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Ranks                string   `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`
+	Hosts                string   `protobuf:"bytes,3,opt,name=hosts,proto3" json:"hosts,omitempty"`
+	Rolling              bool     `protobuf:"varint,4,opt,name=rolling,proto3" json:"rolling,omitempty"`
+	RanksPerStep         uint32   `protobuf:"varint,5,opt,name=ranks_per_step,json=ranksPerStep,proto3" json:"ranks_per_step,omitempty"`
+	Force                bool     `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`
+	StepTimeout          uint64   `protobuf:"varint,7,opt,name=step_timeout,json=stepTimeout,proto3" json:"step_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemRestartReq) Reset()         { *m = SystemRestartReq{} }
+func (m *SystemRestartReq) String() string { return proto.CompactTextString(m) }
+func (*SystemRestartReq) ProtoMessage()    {}
+func (*SystemRestartReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{16}
+}
+
+func (m *SystemRestartReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemRestartReq.Unmarshal(m, b)
+}
+func (m *SystemRestartReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemRestartReq.Marshal(b, m, deterministic)
+}
+func (m *SystemRestartReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemRestartReq.Merge(m, src)
+}
+func (m *SystemRestartReq) XXX_Size() int {
+	return xxx_messageInfo_SystemRestartReq.Size(m)
+}
+func (m *SystemRestartReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemRestartReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemRestartReq proto.InternalMessageInfo
+
+func (m *SystemRestartReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *SystemRestartReq) GetRanks() string {
+	if m != nil {
+		return m.Ranks
+	}
+	return ""
+}
+
+func (m *SystemRestartReq) GetHosts() string {
+	if m != nil {
+		return m.Hosts
+	}
+	return ""
+}
+
+func (m *SystemRestartReq) GetRolling() bool {
+	if m != nil {
+		return m.Rolling
+	}
+	return false
+}
+
+func (m *SystemRestartReq) GetRanksPerStep() uint32 {
+	if m != nil {
+		return m.RanksPerStep
+	}
+	return 0
+}
+
+func (m *SystemRestartReq) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *SystemRestartReq) GetStepTimeout() uint64 {
+	if m != nil {
+		return m.StepTimeout
+	}
+	return 0
+}
+
+// SystemRestartResp reports the progress of a system restart.
+type SystemRestartResp struct {
+	Step                 uint32               `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	NumSteps             uint32               `protobuf:"varint,2,opt,name=num_steps,json=numSteps,proto3" json:"num_steps,omitempty"`
+	Domain               string               `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	Ranks                string               `protobuf:"bytes,4,opt,name=ranks,proto3" json:"ranks,omitempty"`
+	Phase                string               `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	Results              []*shared.RankResult `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`
+	Completed            string               `protobuf:"bytes,7,opt,name=completed,proto3" json:"completed,omitempty"`
+	Remaining            string               `protobuf:"bytes,8,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Error                string               `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SystemRestartResp) Reset()         { *m = SystemRestartResp{} }
+func (m *SystemRestartResp) String() string { return proto.CompactTextString(m) }
+func (*SystemRestartResp) ProtoMessage()    {}
+func (*SystemRestartResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{17}
+}
+
+func (m *SystemRestartResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemRestartResp.Unmarshal(m, b)
+}
+func (m *SystemRestartResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemRestartResp.Marshal(b, m, deterministic)
+}
+func (m *SystemRestartResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemRestartResp.Merge(m, src)
+}
+func (m *SystemRestartResp) XXX_Size() int {
+	return xxx_messageInfo_SystemRestartResp.Size(m)
+}
+func (m *SystemRestartResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemRestartResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemRestartResp proto.InternalMessageInfo
+
+func (m *SystemRestartResp) GetStep() uint32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+func (m *SystemRestartResp) GetNumSteps() uint32 {
+	if m != nil {
+		return m.NumSteps
+	}
+	return 0
+}
+
+func (m *SystemRestartResp) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *SystemRestartResp) GetRanks() string {
+	if m != nil {
+		return m.Ranks
+	}
+	return ""
+}
+
+func (m *SystemRestartResp) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *SystemRestartResp) GetResults() []*shared.RankResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SystemRestartResp) GetCompleted() string {
+	if m != nil {
+		return m.Completed
+	}
+	return ""
+}
+
+func (m *SystemRestartResp) GetRemaining() string {
+	if m != nil {
+		return m.Remaining
+	}
+	return ""
+}
+
+func (m *SystemRestartResp) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// SystemDbBackupReq requests a backup of the system database.
+type SystemDbBackupReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemDbBackupReq) Reset()         { *m = SystemDbBackupReq{} }
+func (m *SystemDbBackupReq) String() string { return proto.CompactTextString(m) }
+func (*SystemDbBackupReq) ProtoMessage()    {}
+func (*SystemDbBackupReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{18}
+}
+
+func (m *SystemDbBackupReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemDbBackupReq.Unmarshal(m, b)
+}
+func (m *SystemDbBackupReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemDbBackupReq.Marshal(b, m, deterministic)
+}
+func (m *SystemDbBackupReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemDbBackupReq.Merge(m, src)
+}
+func (m *SystemDbBackupReq) XXX_Size() int {
+	return xxx_messageInfo_SystemDbBackupReq.Size(m)
+}
+func (m *SystemDbBackupReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemDbBackupReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemDbBackupReq proto.InternalMessageInfo
+
+func (m *SystemDbBackupReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+// SystemDbBackupResp contains a chunk of a system database backup.
+type SystemDbBackupResp struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	MapVersion           uint32   `protobuf:"varint,2,opt,name=map_version,json=mapVersion,proto3" json:"map_version,omitempty"`
+	Size                 uint64   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemDbBackupResp) Reset()         { *m = SystemDbBackupResp{} }
+func (m *SystemDbBackupResp) String() string { return proto.CompactTextString(m) }
+func (*SystemDbBackupResp) ProtoMessage()    {}
+func (*SystemDbBackupResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{19}
+}
+
+func (m *SystemDbBackupResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemDbBackupResp.Unmarshal(m, b)
+}
+func (m *SystemDbBackupResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemDbBackupResp.Marshal(b, m, deterministic)
+}
+func (m *SystemDbBackupResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemDbBackupResp.Merge(m, src)
+}
+func (m *SystemDbBackupResp) XXX_Size() int {
+	return xxx_messageInfo_SystemDbBackupResp.Size(m)
+}
+func (m *SystemDbBackupResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemDbBackupResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemDbBackupResp proto.InternalMessageInfo
+
+func (m *SystemDbBackupResp) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SystemDbBackupResp) GetMapVersion() uint32 {
+	if m != nil {
+		return m.MapVersion
+	}
+	return 0
+}
+
+func (m *SystemDbBackupResp) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+// SystemReplicaListReq requests the current set of MS replicas.
+type SystemReplicaListReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemReplicaListReq) Reset()         { *m = SystemReplicaListReq{} }
+func (m *SystemReplicaListReq) String() string { return proto.CompactTextString(m) }
+func (*SystemReplicaListReq) ProtoMessage()    {}
+func (*SystemReplicaListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{20}
+}
+
+func (m *SystemReplicaListReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemReplicaListReq.Unmarshal(m, b)
+}
+func (m *SystemReplicaListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemReplicaListReq.Marshal(b, m, deterministic)
+}
+func (m *SystemReplicaListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemReplicaListReq.Merge(m, src)
+}
+func (m *SystemReplicaListReq) XXX_Size() int {
+	return xxx_messageInfo_SystemReplicaListReq.Size(m)
+}
+func (m *SystemReplicaListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemReplicaListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemReplicaListReq proto.InternalMessageInfo
+
+func (m *SystemReplicaListReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+// SystemReplicaReq requests the addition or removal of a MS replica.
+type SystemReplicaReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemReplicaReq) Reset()         { *m = SystemReplicaReq{} }
+func (m *SystemReplicaReq) String() string { return proto.CompactTextString(m) }
+func (*SystemReplicaReq) ProtoMessage()    {}
+func (*SystemReplicaReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{21}
+}
+
+func (m *SystemReplicaReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemReplicaReq.Unmarshal(m, b)
+}
+func (m *SystemReplicaReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemReplicaReq.Marshal(b, m, deterministic)
+}
+func (m *SystemReplicaReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemReplicaReq.Merge(m, src)
+}
+func (m *SystemReplicaReq) XXX_Size() int {
+	return xxx_messageInfo_SystemReplicaReq.Size(m)
+}
+func (m *SystemReplicaReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemReplicaReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemReplicaReq proto.InternalMessageInfo
+
+func (m *SystemReplicaReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *SystemReplicaReq) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+// SystemReplicaResp contains the resulting set of MS replicas.
+type SystemReplicaResp struct {
+	Leader               string   `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`
+	Replicas             []string `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemReplicaResp) Reset()         { *m = SystemReplicaResp{} }
+func (m *SystemReplicaResp) String() string { return proto.CompactTextString(m) }
+func (*SystemReplicaResp) ProtoMessage()    {}
+func (*SystemReplicaResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{22}
+}
+
+func (m *SystemReplicaResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemReplicaResp.Unmarshal(m, b)
+}
+func (m *SystemReplicaResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemReplicaResp.Marshal(b, m, deterministic)
+}
+func (m *SystemReplicaResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemReplicaResp.Merge(m, src)
+}
+func (m *SystemReplicaResp) XXX_Size() int {
+	return xxx_messageInfo_SystemReplicaResp.Size(m)
+}
+func (m *SystemReplicaResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemReplicaResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemReplicaResp proto.InternalMessageInfo
+
+func (m *SystemReplicaResp) GetLeader() string {
+	if m != nil {
+		return m.Leader
+	}
+	return ""
+}
+
+func (m *SystemReplicaResp) GetReplicas() []string {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+// ReplicaStartReq requests that a server start a MS replica.
+type ReplicaStartReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Replicas             []string `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicaStartReq) Reset()         { *m = ReplicaStartReq{} }
+func (m *ReplicaStartReq) String() string { return proto.CompactTextString(m) }
+func (*ReplicaStartReq) ProtoMessage()    {}
+func (*ReplicaStartReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{23}
+}
+
+func (m *ReplicaStartReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaStartReq.Unmarshal(m, b)
+}
+func (m *ReplicaStartReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaStartReq.Marshal(b, m, deterministic)
+}
+func (m *ReplicaStartReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaStartReq.Merge(m, src)
+}
+func (m *ReplicaStartReq) XXX_Size() int {
+	return xxx_messageInfo_ReplicaStartReq.Size(m)
+}
+func (m *ReplicaStartReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaStartReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaStartReq proto.InternalMessageInfo
+
+func (m *ReplicaStartReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *ReplicaStartReq) GetReplicas() []string {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+// ReplicaStartResp is returned once the MS replica has been started.
+type ReplicaStartResp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicaStartResp) Reset()         { *m = ReplicaStartResp{} }
+func (m *ReplicaStartResp) String() string { return proto.CompactTextString(m) }
+func (*ReplicaStartResp) ProtoMessage()    {}
+func (*ReplicaStartResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{24}
+}
+
+func (m *ReplicaStartResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaStartResp.Unmarshal(m, b)
+}
+func (m *ReplicaStartResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaStartResp.Marshal(b, m, deterministic)
+}
+func (m *ReplicaStartResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaStartResp.Merge(m, src)
+}
+func (m *ReplicaStartResp) XXX_Size() int {
+	return xxx_messageInfo_ReplicaStartResp.Size(m)
+}
+func (m *ReplicaStartResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaStartResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaStartResp proto.InternalMessageInfo
+
+// QuotaSetReq sets the limit on the total pool capacity that may be owned
+// by a user or group. Exactly one of user or group must be supplied.
+type QuotaSetReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	User                 string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Group                string   `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	Limit                uint64   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotaSetReq) Reset()         { *m = QuotaSetReq{} }
+func (m *QuotaSetReq) String() string { return proto.CompactTextString(m) }
+func (*QuotaSetReq) ProtoMessage()    {}
+func (*QuotaSetReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{25}
+}
+
+func (m *QuotaSetReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaSetReq.Unmarshal(m, b)
+}
+func (m *QuotaSetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaSetReq.Marshal(b, m, deterministic)
+}
+func (m *QuotaSetReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaSetReq.Merge(m, src)
+}
+func (m *QuotaSetReq) XXX_Size() int {
+	return xxx_messageInfo_QuotaSetReq.Size(m)
+}
+func (m *QuotaSetReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaSetReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaSetReq proto.InternalMessageInfo
+
+func (m *QuotaSetReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *QuotaSetReq) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *QuotaSetReq) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *QuotaSetReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QuotaGetReq requests the quota and usage for a user or group. Exactly
+// one of user or group must be supplied.
+type QuotaGetReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	User                 string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Group                string   `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotaGetReq) Reset()         { *m = QuotaGetReq{} }
+func (m *QuotaGetReq) String() string { return proto.CompactTextString(m) }
+func (*QuotaGetReq) ProtoMessage()    {}
+func (*QuotaGetReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{26}
+}
+
+func (m *QuotaGetReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaGetReq.Unmarshal(m, b)
+}
+func (m *QuotaGetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaGetReq.Marshal(b, m, deterministic)
+}
+func (m *QuotaGetReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaGetReq.Merge(m, src)
+}
+func (m *QuotaGetReq) XXX_Size() int {
+	return xxx_messageInfo_QuotaGetReq.Size(m)
+}
+func (m *QuotaGetReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaGetReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaGetReq proto.InternalMessageInfo
+
+func (m *QuotaGetReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+func (m *QuotaGetReq) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *QuotaGetReq) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+// QuotaListReq requests all quotas and their usage.
+type QuotaListReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotaListReq) Reset()         { *m = QuotaListReq{} }
+func (m *QuotaListReq) String() string { return proto.CompactTextString(m) }
+func (*QuotaListReq) ProtoMessage()    {}
+func (*QuotaListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{27}
+}
+
+func (m *QuotaListReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaListReq.Unmarshal(m, b)
+}
+func (m *QuotaListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaListReq.Marshal(b, m, deterministic)
+}
+func (m *QuotaListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaListReq.Merge(m, src)
+}
+func (m *QuotaListReq) XXX_Size() int {
+	return xxx_messageInfo_QuotaListReq.Size(m)
+}
+func (m *QuotaListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaListReq proto.InternalMessageInfo
+
+func (m *QuotaListReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+// Quota describes the limit on and current usage of the pool capacity
+// owned by a user or group.
+type Quota struct {
+	User                 string   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Limit                uint64   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Pools                uint32   `protobuf:"varint,4,opt,name=pools,proto3" json:"pools,omitempty"`
+	ScmUsed              uint64   `protobuf:"varint,5,opt,name=scm_used,json=scmUsed,proto3" json:"scm_used,omitempty"`
+	NvmeUsed             uint64   `protobuf:"varint,6,opt,name=nvme_used,json=nvmeUsed,proto3" json:"nvme_used,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Quota) Reset()         { *m = Quota{} }
+func (m *Quota) String() string { return proto.CompactTextString(m) }
+func (*Quota) ProtoMessage()    {}
+func (*Quota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{28}
+}
+
+func (m *Quota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Quota.Unmarshal(m, b)
+}
+func (m *Quota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Quota.Marshal(b, m, deterministic)
+}
+func (m *Quota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quota.Merge(m, src)
+}
+func (m *Quota) XXX_Size() int {
+	return xxx_messageInfo_Quota.Size(m)
+}
+func (m *Quota) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quota proto.InternalMessageInfo
+
+func (m *Quota) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Quota) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *Quota) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *Quota) GetPools() uint32 {
+	if m != nil {
+		return m.Pools
+	}
+	return 0
+}
+
+func (m *Quota) GetScmUsed() uint64 {
+	if m != nil {
+		return m.ScmUsed
+	}
+	return 0
+}
+
+func (m *Quota) GetNvmeUsed() uint64 {
+	if m != nil {
+		return m.NvmeUsed
+	}
+	return 0
+}
+
+// QuotaResp returns a set of quotas.
+type QuotaResp struct {
+	Quotas               []*Quota `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotaResp) Reset()         { *m = QuotaResp{} }
+func (m *QuotaResp) String() string { return proto.CompactTextString(m) }
+func (*QuotaResp) ProtoMessage()    {}
+func (*QuotaResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9530a22a210a9bd, []int{29}
+}
+
+func (m *QuotaResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaResp.Unmarshal(m, b)
+}
+func (m *QuotaResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaResp.Marshal(b, m, deterministic)
+}
+func (m *QuotaResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaResp.Merge(m, src)
+}
+func (m *QuotaResp) XXX_Size() int {
+	return xxx_messageInfo_QuotaResp.Size(m)
+}
+func (m *QuotaResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaResp proto.InternalMessageInfo
+
+func (m *QuotaResp) GetQuotas() []*Quota {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SystemMember)(nil), "mgmt.SystemMember")
 	proto.RegisterType((*SystemStopReq)(nil), "mgmt.SystemStopReq")
@@ -604,6 +1867,27 @@ func init() {
 	proto.RegisterType((*SystemStartResp)(nil), "mgmt.SystemStartResp")
 	proto.RegisterType((*SystemQueryReq)(nil), "mgmt.SystemQueryReq")
 	proto.RegisterType((*SystemQueryResp)(nil), "mgmt.SystemQueryResp")
+	proto.RegisterType((*ListEventsReq)(nil), "mgmt.ListEventsReq")
+	proto.RegisterType((*EventLogEntry)(nil), "mgmt.EventLogEntry")
+	proto.RegisterType((*ListEventsResp)(nil), "mgmt.ListEventsResp")
+	proto.RegisterType((*SubscribeEventsReq)(nil), "mgmt.SubscribeEventsReq")
+	proto.RegisterType((*SubscribeEventsResp)(nil), "mgmt.SubscribeEventsResp")
+	proto.RegisterType((*SystemSetPolicyReq)(nil), "mgmt.SystemSetPolicyReq")
+	proto.RegisterType((*SystemSetPolicyResp)(nil), "mgmt.SystemSetPolicyResp")
+	proto.RegisterType((*SystemRestartReq)(nil), "mgmt.SystemRestartReq")
+	proto.RegisterType((*SystemRestartResp)(nil), "mgmt.SystemRestartResp")
+	proto.RegisterType((*SystemDbBackupReq)(nil), "mgmt.SystemDbBackupReq")
+	proto.RegisterType((*SystemDbBackupResp)(nil), "mgmt.SystemDbBackupResp")
+	proto.RegisterType((*SystemReplicaListReq)(nil), "mgmt.SystemReplicaListReq")
+	proto.RegisterType((*SystemReplicaReq)(nil), "mgmt.SystemReplicaReq")
+	proto.RegisterType((*SystemReplicaResp)(nil), "mgmt.SystemReplicaResp")
+	proto.RegisterType((*ReplicaStartReq)(nil), "mgmt.ReplicaStartReq")
+	proto.RegisterType((*ReplicaStartResp)(nil), "mgmt.ReplicaStartResp")
+	proto.RegisterType((*QuotaSetReq)(nil), "mgmt.QuotaSetReq")
+	proto.RegisterType((*QuotaGetReq)(nil), "mgmt.QuotaGetReq")
+	proto.RegisterType((*QuotaListReq)(nil), "mgmt.QuotaListReq")
+	proto.RegisterType((*Quota)(nil), "mgmt.Quota")
+	proto.RegisterType((*QuotaResp)(nil), "mgmt.QuotaResp")
 }
 
 func init() {
@@ -611,34 +1895,84 @@ func init() {
 }
 
 var fileDescriptor_d9530a22a210a9bd = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xcf, 0x8a, 0x13, 0x41,
-	0x10, 0xc6, 0x99, 0x9d, 0xec, 0x6e, 0xd2, 0xd9, 0x3f, 0xda, 0xac, 0x30, 0x08, 0x42, 0xcc, 0xc5,
-	0x1c, 0x74, 0x06, 0xf4, 0x22, 0x1e, 0x55, 0xbc, 0x29, 0xd8, 0xab, 0x17, 0x2f, 0x4b, 0xcf, 0x4c,
-	0x67, 0x33, 0x64, 0x7a, 0x7a, 0xec, 0xea, 0x01, 0x73, 0xd5, 0x93, 0xf8, 0x90, 0xbe, 0x8a, 0x54,
-	0xd5, 0xac, 0x99, 0x85, 0x1c, 0x03, 0xb9, 0x7d, 0xf5, 0x55, 0x51, 0x5d, 0xbf, 0x6a, 0x28, 0xf1,
-	0xd0, 0xde, 0xda, 0x90, 0xc1, 0x06, 0x82, 0xb1, 0x69, 0xeb, 0x5d, 0x70, 0x72, 0x84, 0xd6, 0x63,
-	0x09, 0x2b, 0xed, 0x4d, 0x99, 0x79, 0xdd, 0xac, 0x81, 0x33, 0xf3, 0xbf, 0x91, 0x38, 0xbb, 0xa6,
-	0xd2, 0x8f, 0xc6, 0xe6, 0xc6, 0x4b, 0x29, 0x46, 0xba, 0x2c, 0x7d, 0x12, 0xcd, 0xa2, 0xc5, 0x44,
-	0x91, 0x46, 0xaf, 0xeb, 0xaa, 0x32, 0x39, 0x62, 0x0f, 0x35, 0x7a, 0xd8, 0x27, 0x89, 0x67, 0xd1,
-	0xe2, 0x5c, 0x91, 0x96, 0x57, 0xe2, 0x18, 0x82, 0x0e, 0x26, 0x19, 0x51, 0x21, 0x07, 0xf2, 0x89,
-	0x10, 0x4b, 0x9d, 0xfb, 0xaa, 0xb8, 0xe9, 0x7c, 0x95, 0x1c, 0x53, 0x6a, 0xc2, 0xce, 0x57, 0x5f,
-	0xc9, 0x67, 0xe2, 0xb2, 0x4f, 0x17, 0xae, 0x09, 0xe6, 0x47, 0x80, 0xe4, 0x84, 0x7a, 0x5e, 0xb0,
-	0xfd, 0xae, 0x77, 0xf1, 0xc5, 0xaa, 0x59, 0xba, 0xe4, 0x94, 0xa7, 0x40, 0x2d, 0x9f, 0x8a, 0xb3,
-	0xa5, 0xee, 0xea, 0x70, 0x53, 0x3a, 0xab, 0xab, 0x26, 0x19, 0x53, 0x6e, 0x4a, 0xde, 0x7b, 0xb2,
-	0xe6, 0x7f, 0x22, 0x71, 0xce, 0x84, 0xd7, 0xc1, 0xb5, 0xca, 0x7c, 0x97, 0x0f, 0x44, 0x0c, 0x1b,
-	0xe8, 0x09, 0x51, 0x62, 0xeb, 0xd6, 0x9b, 0x96, 0x00, 0xc7, 0x8a, 0x34, 0x7a, 0xeb, 0xaa, 0xae,
-	0x09, 0x70, 0xac, 0x48, 0x23, 0xe0, 0xd2, 0xf9, 0x82, 0x01, 0xc7, 0x8a, 0x03, 0x74, 0x69, 0xa5,
-	0x3d, 0x1b, 0x07, 0xe8, 0xae, 0x1c, 0xf4, 0x34, 0x13, 0xc5, 0xc1, 0xfc, 0x67, 0x24, 0x2e, 0x86,
-	0xd3, 0x40, 0x2b, 0x9f, 0x8b, 0x53, 0x6f, 0xa0, 0xab, 0x03, 0x8e, 0x14, 0x2f, 0xa6, 0x2f, 0x65,
-	0xca, 0x1f, 0x95, 0x2a, 0xdd, 0xac, 0x15, 0xa5, 0xd4, 0x5d, 0x89, 0x9c, 0x89, 0xa9, 0xce, 0xc1,
-	0x34, 0x81, 0x9f, 0xe4, 0x2f, 0x19, 0x5a, 0xdb, 0x0a, 0x7e, 0x3e, 0x1e, 0x56, 0xf0, 0x10, 0x5f,
-	0xc4, 0x15, 0xcf, 0xa0, 0x0c, 0x98, 0xf0, 0xc1, 0x79, 0xab, 0xc3, 0xee, 0xc5, 0xfc, 0x47, 0x3b,
-	0xda, 0x89, 0x16, 0x0f, 0xd1, 0x7e, 0x47, 0xe2, 0xd1, 0x8e, 0xb6, 0x07, 0x21, 0xfc, 0xb4, 0xdd,
-	0xb2, 0xf6, 0x7b, 0x60, 0xfb, 0x15, 0x89, 0xcb, 0x7b, 0x0d, 0x0f, 0x4b, 0xf5, 0xb9, 0x33, 0x7e,
-	0xb3, 0x4f, 0xaa, 0xbe, 0x21, 0x53, 0x59, 0xba, 0x04, 0x5b, 0x2a, 0x3c, 0x1e, 0xe9, 0xf0, 0x48,
-	0xa8, 0xbb, 0x92, 0x7d, 0x50, 0xbd, 0x7d, 0xf3, 0xed, 0xf5, 0x6d, 0x15, 0x56, 0x5d, 0x9e, 0x16,
-	0xce, 0x66, 0xa5, 0x76, 0xf0, 0x02, 0x82, 0x2e, 0xd6, 0x24, 0x33, 0xf0, 0x45, 0x86, 0x87, 0xc1,
-	0xbb, 0x3a, 0x2b, 0x9c, 0xb5, 0xae, 0xc9, 0xe8, 0x72, 0x65, 0x38, 0x55, 0x7e, 0x42, 0xfa, 0xd5,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0x5a, 0x5c, 0x18, 0xf4, 0x04, 0x00, 0x00,
+	// 1250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0x1b, 0x37,
+	0x10, 0xc6, 0x4a, 0xb2, 0x2c, 0x8d, 0x2c, 0xc7, 0x66, 0xd2, 0x40, 0x49, 0xff, 0x9c, 0xed, 0x9f,
+	0x0f, 0x8d, 0x15, 0xa4, 0x97, 0xb4, 0x97, 0xa0, 0x69, 0xdc, 0xa0, 0x40, 0x5a, 0x38, 0x54, 0x92,
+	0x43, 0x81, 0x42, 0xa0, 0x76, 0x69, 0x79, 0xe1, 0xdd, 0x25, 0x4d, 0x72, 0x8d, 0xb8, 0xc7, 0xf6,
+	0xd2, 0xa2, 0xbd, 0xf6, 0x59, 0xfa, 0x0c, 0x7d, 0x8a, 0x5e, 0xfa, 0x08, 0x7d, 0x80, 0x62, 0x86,
+	0xbb, 0xd2, 0x4a, 0x91, 0x1d, 0x04, 0x35, 0x90, 0xdb, 0xcc, 0x37, 0xb3, 0xc3, 0xf9, 0x66, 0xc8,
+	0x21, 0x17, 0xb6, 0xb3, 0x69, 0xe6, 0x86, 0xf6, 0xcc, 0x3a, 0x99, 0xed, 0x69, 0xa3, 0x9c, 0x62,
+	0x2d, 0x84, 0x6e, 0x32, 0x7b, 0x24, 0x8c, 0x8c, 0x87, 0x46, 0xe4, 0xc7, 0xd6, 0x5b, 0x66, 0x98,
+	0x3c, 0x95, 0xb9, 0xf3, 0x58, 0xf8, 0x77, 0x00, 0x1b, 0x23, 0xfa, 0xfc, 0x5b, 0x99, 0x4d, 0xa4,
+	0x61, 0x0c, 0x5a, 0x22, 0x8e, 0xcd, 0x20, 0xd8, 0x09, 0x76, 0xbb, 0x9c, 0x64, 0xc4, 0x8a, 0x22,
+	0x89, 0x07, 0x0d, 0x8f, 0xa1, 0x8c, 0x18, 0xc6, 0x1e, 0x34, 0x77, 0x82, 0xdd, 0x3e, 0x27, 0x99,
+	0x5d, 0x83, 0x35, 0xeb, 0x84, 0x93, 0x83, 0x16, 0x39, 0x7a, 0x85, 0xbd, 0x0b, 0x70, 0x28, 0x26,
+	0x26, 0x89, 0xc6, 0x85, 0x49, 0x06, 0x6b, 0x64, 0xea, 0x7a, 0xe4, 0x99, 0x49, 0xd8, 0x27, 0x70,
+	0xa5, 0x34, 0x47, 0x2a, 0x77, 0xf2, 0x85, 0xb3, 0x83, 0x36, 0xc5, 0xdc, 0xf4, 0xf0, 0x57, 0x25,
+	0x8a, 0x2b, 0x26, 0xf9, 0xa1, 0x1a, 0xac, 0xfb, 0x2c, 0x50, 0x66, 0xb7, 0x60, 0xe3, 0x50, 0x14,
+	0xa9, 0x1b, 0xc7, 0x2a, 0x13, 0x49, 0x3e, 0xe8, 0x90, 0xad, 0x47, 0xd8, 0x43, 0x82, 0xc2, 0xdf,
+	0x02, 0xe8, 0x7b, 0x86, 0x23, 0xa7, 0x34, 0x97, 0x27, 0x6c, 0x0b, 0x9a, 0xf6, 0xcc, 0x96, 0x0c,
+	0x51, 0xc4, 0xd0, 0xda, 0x48, 0x4d, 0x04, 0x3b, 0x9c, 0x64, 0xc4, 0x8e, 0x93, 0x34, 0x25, 0x82,
+	0x1d, 0x4e, 0x32, 0x12, 0x3c, 0x54, 0x26, 0xf2, 0x04, 0x3b, 0xdc, 0x2b, 0x88, 0x52, 0x99, 0x4b,
+	0x6e, 0x5e, 0x41, 0xf4, 0x48, 0xd9, 0x92, 0x4d, 0x97, 0x7b, 0x25, 0xfc, 0x29, 0x80, 0xcd, 0x7a,
+	0x36, 0x56, 0xb3, 0x4f, 0x61, 0xdd, 0x48, 0x5b, 0xa4, 0x0e, 0x53, 0x6a, 0xee, 0xf6, 0xee, 0xb2,
+	0x3d, 0xdf, 0xa8, 0x3d, 0x2e, 0xf2, 0x63, 0x4e, 0x26, 0x5e, 0xb9, 0xb0, 0x1d, 0xe8, 0x89, 0x89,
+	0x95, 0xb9, 0xf3, 0x4b, 0xfa, 0x96, 0xd4, 0xa1, 0xb9, 0x87, 0x5f, 0xbe, 0x59, 0xf7, 0xf0, 0x49,
+	0x3c, 0x85, 0x6b, 0x3e, 0x07, 0x2e, 0xad, 0x74, 0x5f, 0x2b, 0x93, 0x09, 0xb7, 0xba, 0x30, 0x33,
+	0x6a, 0x8d, 0x95, 0xd4, 0x9a, 0x75, 0x6a, 0xbf, 0x06, 0xf0, 0xd6, 0x8a, 0xb0, 0x6f, 0x84, 0xe1,
+	0x77, 0xf3, 0x2a, 0x0b, 0x73, 0x09, 0xdc, 0x7e, 0x0e, 0xe0, 0xca, 0x42, 0xc0, 0x37, 0xcb, 0xea,
+	0x49, 0x21, 0xcd, 0xd9, 0x65, 0xb2, 0x2a, 0x03, 0x7a, 0x56, 0x19, 0x4d, 0x82, 0x39, 0x2b, 0x1c,
+	0x28, 0x7b, 0xf5, 0x21, 0xc1, 0x2b, 0x97, 0x4b, 0x61, 0xf5, 0x6f, 0x00, 0xfd, 0xc7, 0x89, 0x75,
+	0xfb, 0x38, 0x96, 0xec, 0x6a, 0x56, 0x5b, 0xd0, 0x4c, 0x62, 0x8c, 0xdf, 0xdc, 0xed, 0x73, 0x14,
+	0xd9, 0x7b, 0x00, 0x56, 0x9e, 0x4a, 0x93, 0xb8, 0x44, 0x62, 0x58, 0x34, 0xd4, 0x90, 0x79, 0x1d,
+	0x5a, 0x2b, 0xeb, 0xb0, 0x56, 0xab, 0x03, 0x7b, 0x1b, 0xba, 0x5a, 0xa9, 0x74, 0x4c, 0x43, 0xce,
+	0x1f, 0xd7, 0x0e, 0x02, 0xcf, 0x70, 0xd0, 0xe1, 0x50, 0x4b, 0xf2, 0x48, 0xd2, 0xdc, 0x69, 0x72,
+	0xaf, 0x20, 0x5a, 0xe4, 0x2e, 0x49, 0x69, 0xe2, 0x34, 0xb9, 0x57, 0x10, 0x4d, 0x93, 0x2c, 0x71,
+	0x83, 0x2e, 0x4d, 0x30, 0xaf, 0x20, 0xea, 0xce, 0xb4, 0xb4, 0x03, 0xa0, 0x2c, 0xbd, 0x12, 0x4e,
+	0xa1, 0x4f, 0x8c, 0x1f, 0xab, 0xe9, 0x7e, 0xee, 0xcc, 0x19, 0xbb, 0x09, 0x1d, 0x2b, 0x4f, 0x0a,
+	0x89, 0x6b, 0x21, 0xf5, 0x16, 0x9f, 0xe9, 0x38, 0x8c, 0x5c, 0x92, 0x49, 0x2a, 0x70, 0x93, 0x93,
+	0xcc, 0x3e, 0x86, 0x35, 0x9a, 0xe4, 0x54, 0xd3, 0xde, 0xdd, 0xad, 0xd9, 0xee, 0xfb, 0x72, 0x44,
+	0x81, 0xb9, 0x37, 0x87, 0xf7, 0x61, 0xb3, 0x5e, 0x5e, 0xab, 0xd9, 0x6d, 0x58, 0xc7, 0xfe, 0x24,
+	0xb2, 0xea, 0xf1, 0x55, 0xdf, 0xe3, 0x85, 0x7c, 0x78, 0xe5, 0x13, 0xfe, 0x19, 0x00, 0x1b, 0x15,
+	0x13, 0x1b, 0x99, 0x64, 0x22, 0x2f, 0xea, 0xd2, 0x8c, 0x68, 0xa3, 0x46, 0xf4, 0x95, 0x9d, 0x2a,
+	0x7b, 0xdb, 0x9a, 0xf7, 0xf6, 0x35, 0x06, 0xea, 0x62, 0xef, 0xd6, 0x17, 0x7b, 0x17, 0xfe, 0x1e,
+	0xc0, 0xd5, 0x97, 0x32, 0xb7, 0x7a, 0x56, 0xce, 0x60, 0x55, 0x39, 0x1b, 0x17, 0x96, 0x93, 0x0d,
+	0x60, 0x3d, 0x36, 0x4a, 0x6b, 0x19, 0x53, 0xe1, 0x5b, 0xbc, 0x52, 0xd9, 0xfb, 0xd0, 0xcb, 0x84,
+	0x1e, 0x9f, 0x4a, 0x63, 0x13, 0x95, 0xd3, 0xc6, 0xeb, 0x73, 0xc8, 0x84, 0x7e, 0xee, 0x91, 0xf0,
+	0x39, 0xb0, 0x72, 0x88, 0x48, 0x77, 0xa0, 0xd2, 0x24, 0x3a, 0xe7, 0x0c, 0xef, 0xc1, 0x55, 0x51,
+	0x38, 0x35, 0x96, 0x2f, 0xa2, 0xb4, 0x88, 0xe5, 0x58, 0x8b, 0xc2, 0xca, 0xb8, 0xbc, 0x9d, 0xb6,
+	0xd1, 0xb4, 0xef, 0x2d, 0x07, 0x64, 0x08, 0xff, 0x41, 0x9a, 0xcb, 0x81, 0xad, 0x66, 0x77, 0xe0,
+	0xda, 0x42, 0x1c, 0x99, 0x8b, 0x49, 0x2a, 0x63, 0x5a, 0xaa, 0xc3, 0x59, 0x2d, 0xd0, 0xbe, 0xb7,
+	0xbc, 0xee, 0xca, 0xec, 0x73, 0xb8, 0xb1, 0xe0, 0x3f, 0x35, 0x22, 0x92, 0x63, 0x2d, 0x4d, 0xa2,
+	0xaa, 0xf2, 0x5c, 0xaf, 0x7d, 0xf5, 0x08, 0xcd, 0x07, 0x64, 0x7d, 0x29, 0x39, 0x2d, 0xf3, 0x38,
+	0xc9, 0xa7, 0xe5, 0x79, 0xad, 0x27, 0x77, 0xe0, 0x2d, 0xe1, 0x5f, 0x01, 0x6c, 0xcd, 0x2e, 0x98,
+	0x4b, 0x99, 0xeb, 0xd8, 0x4c, 0xa3, 0xd2, 0xb4, 0x5a, 0xb7, 0xc3, 0x2b, 0x95, 0x7d, 0x08, 0x9b,
+	0xf4, 0x21, 0x92, 0x19, 0x5b, 0x27, 0x35, 0x6d, 0xc6, 0x3e, 0xdf, 0x20, 0xf4, 0x40, 0x9a, 0x91,
+	0x93, 0x7a, 0xfe, 0x20, 0x68, 0xd7, 0x1f, 0x04, 0xb7, 0x60, 0x03, 0xbf, 0x18, 0xe3, 0xbe, 0x52,
+	0x85, 0xa3, 0x6d, 0xd9, 0xe2, 0x3d, 0xc4, 0x9e, 0x7a, 0x28, 0xfc, 0xa5, 0x01, 0xdb, 0x4b, 0x5c,
+	0xfc, 0xbe, 0xa4, 0xa5, 0x02, 0xff, 0xa8, 0x42, 0x19, 0x37, 0x78, 0x5e, 0x64, 0x94, 0x82, 0xa7,
+	0xd4, 0xe7, 0x9d, 0xbc, 0xc8, 0x70, 0x79, 0xcb, 0xae, 0x43, 0xbb, 0x7c, 0xf9, 0x78, 0x5a, 0xa5,
+	0x76, 0xfe, 0xf4, 0xd3, 0x47, 0xc2, 0xca, 0xea, 0x5c, 0x91, 0x52, 0xbf, 0xc7, 0xda, 0xaf, 0xbe,
+	0xc7, 0xde, 0x81, 0x6e, 0xa4, 0x32, 0x9d, 0x4a, 0x27, 0xab, 0xf3, 0x36, 0x07, 0xd0, 0x6a, 0x24,
+	0x66, 0x80, 0x15, 0xf5, 0x8f, 0xb1, 0x39, 0x80, 0xeb, 0x4b, 0x63, 0x94, 0xa1, 0xf1, 0xd8, 0xe5,
+	0x5e, 0x09, 0x3f, 0xaa, 0x2a, 0xf1, 0x70, 0xf2, 0x40, 0x44, 0xc7, 0xc5, 0xea, 0x37, 0x5a, 0xf8,
+	0x03, 0xb0, 0x65, 0x37, 0x5f, 0xb1, 0x58, 0x38, 0x41, 0x8e, 0x1b, 0x9c, 0xe4, 0xe5, 0x73, 0xd8,
+	0x58, 0x3e, 0x87, 0x54, 0xe6, 0xe4, 0x47, 0x59, 0x6e, 0x50, 0x92, 0xc3, 0xdd, 0xf9, 0x9b, 0x48,
+	0xa7, 0x49, 0x24, 0x70, 0x64, 0xae, 0x4e, 0xe4, 0x1e, 0x6c, 0x2d, 0x78, 0x9e, 0xfb, 0xa4, 0xa4,
+	0x77, 0x74, 0x63, 0xfe, 0x8e, 0x0e, 0x1f, 0xc1, 0xf6, 0xd2, 0x97, 0x56, 0x63, 0x0b, 0x53, 0x29,
+	0x62, 0x59, 0x3d, 0xb9, 0x4b, 0x0d, 0xaf, 0x03, 0xe3, 0xdd, 0xfc, 0x3c, 0xed, 0xf2, 0x99, 0x1e,
+	0xde, 0x87, 0x2b, 0x65, 0x88, 0x0b, 0xde, 0x37, 0x17, 0x05, 0x60, 0xb0, 0xb5, 0x18, 0xc0, 0xea,
+	0x70, 0x0c, 0xbd, 0x27, 0x85, 0x72, 0x62, 0x24, 0xdd, 0xb9, 0x94, 0x0a, 0x2b, 0x67, 0x94, 0x50,
+	0xc6, 0x96, 0x4e, 0x8d, 0x2a, 0x74, 0x75, 0xac, 0x48, 0x99, 0xdf, 0x83, 0x2d, 0xaa, 0xb0, 0x57,
+	0xc2, 0x6f, 0xca, 0x05, 0x1e, 0xfd, 0xef, 0x05, 0xc2, 0x1d, 0xd8, 0xa0, 0x50, 0xe7, 0x77, 0xe9,
+	0x8f, 0x00, 0xd6, 0xc8, 0x65, 0x16, 0x35, 0x58, 0x15, 0xb5, 0xb1, 0x32, 0xed, 0x66, 0x2d, 0x6d,
+	0x44, 0xf1, 0x42, 0xb1, 0xe5, 0x40, 0xf7, 0x0a, 0xbb, 0x01, 0x1d, 0x1b, 0x65, 0x63, 0x1a, 0x8f,
+	0x6b, 0xfe, 0x1e, 0xb0, 0x51, 0xf6, 0x0c, 0x87, 0x22, 0x9e, 0xd8, 0xd3, 0x4c, 0x7a, 0x5b, 0xdb,
+	0xdf, 0xe4, 0x08, 0xa0, 0x31, 0xbc, 0x03, 0x5d, 0x4a, 0x8b, 0x7a, 0xff, 0x01, 0xb4, 0x4f, 0x50,
+	0xa9, 0xee, 0xe1, 0x9e, 0xbf, 0x87, 0xbd, 0x43, 0x69, 0x7a, 0xf0, 0xc5, 0xf7, 0xf7, 0xa6, 0x89,
+	0x3b, 0x2a, 0x26, 0x7b, 0x91, 0xca, 0x86, 0xb1, 0x50, 0xf6, 0xb6, 0x75, 0x22, 0x3a, 0x26, 0x71,
+	0x68, 0x4d, 0x34, 0xc4, 0x1f, 0x27, 0xa3, 0xd2, 0x61, 0xa4, 0xb2, 0x4c, 0xe5, 0x43, 0xfa, 0xb3,
+	0x1b, 0x62, 0xa4, 0x49, 0x9b, 0xe4, 0xcf, 0xfe, 0x1b, 0x00, 0xc1, 0xdc, 0x8a, 0xaf, 0x28, 0x0e,
+	0x00, 0x00,
 }
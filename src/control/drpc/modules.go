@@ -29,6 +29,7 @@ func (id ModuleID) String() string {
 		ModuleMgmt:          "Management",
 		ModuleSrv:           "Server",
 		ModuleSecurity:      "Security",
+		ModuleAgentAdmin:    "Agent Administration",
 	}[id]; ok {
 		return name
 	}
@@ -42,6 +43,7 @@ func (id ModuleID) GetMethod(methodID int32) (Method, error) {
 		ModuleMgmt:          MgmtMethod(methodID),
 		ModuleSrv:           srvMethod(methodID),
 		ModuleSecurity:      securityMethod(methodID),
+		ModuleAgentAdmin:    agentAdminMethod(methodID),
 	}[id]; ok {
 		if !m.IsValid() {
 			return nil, errors.Errorf("invalid method %d for module %s",
@@ -66,6 +68,8 @@ const (
 	ModuleSrv ModuleID = C.DRPC_MODULE_SRV
 	// ModuleSecurity is the dRPC module for security tasks in DAOS server
 	ModuleSecurity ModuleID = C.DRPC_MODULE_SEC
+	// ModuleAgentAdmin is the dRPC module for administration of the DAOS agent
+	ModuleAgentAdmin ModuleID = C.DRPC_MODULE_AGENT_ADMIN
 )
 
 type Method interface {
@@ -137,7 +141,13 @@ func (m MgmtMethod) String() string {
 		MethodPoolReintegrate: "PoolReintegrate",
 		MethodPoolQuery:       "PoolQuery",
 		MethodPoolSetProp:     "PoolSetProp",
+		MethodPoolGetProp:     "PoolGetProp",
 		MethodListPools:       "ListPools",
+		MethodContQuery:       "ContQuery",
+		MethodContDestroy:     "ContDestroy",
+		MethodContGetProp:     "ContGetProp",
+		MethodContSetProp:     "ContSetProp",
+		MethodSetLogMasks:     "SetLogMasks",
 	}[m]; ok {
 		return s
 	}
@@ -215,8 +225,20 @@ const (
 	MethodPoolQuery MgmtMethod = C.DRPC_METHOD_MGMT_POOL_QUERY
 	// MethodPoolSetProp defines a method for setting a pool property
 	MethodPoolSetProp MgmtMethod = C.DRPC_METHOD_MGMT_POOL_SET_PROP
+	// MethodPoolGetProp defines a method for getting pool properties
+	MethodPoolGetProp MgmtMethod = C.DRPC_METHOD_MGMT_POOL_GET_PROP
 	// MethodContSetOwner defines a method for setting the container's owner
 	MethodContSetOwner MgmtMethod = C.DRPC_METHOD_MGMT_CONT_SET_OWNER
+	// MethodContQuery defines a method for querying a container
+	MethodContQuery MgmtMethod = C.DRPC_METHOD_MGMT_CONT_QUERY
+	// MethodContDestroy defines a method for destroying a container
+	MethodContDestroy MgmtMethod = C.DRPC_METHOD_MGMT_CONT_DESTROY
+	// MethodContGetProp defines a method for getting container properties
+	MethodContGetProp MgmtMethod = C.DRPC_METHOD_MGMT_CONT_GET_PROP
+	// MethodContSetProp defines a method for setting container properties
+	MethodContSetProp MgmtMethod = C.DRPC_METHOD_MGMT_CONT_SET_PROP
+	// MethodSetLogMasks defines a method for setting engine log masks
+	MethodSetLogMasks MgmtMethod = C.DRPC_METHOD_MGMT_SET_LOG_MASKS
 	// MethodGroupUpdate defines a method for updating the group map
 	MethodGroupUpdate MgmtMethod = C.DRPC_METHOD_MGMT_GROUP_UPDATE
 	// MethodNotifyPoolConnect defines a method to indicate a successful pool connect call
@@ -309,6 +331,51 @@ const (
 	MethodValidateCredentials securityMethod = C.DRPC_METHOD_SEC_VALIDATE_CREDS
 )
 
+type agentAdminMethod int32
+
+func (m agentAdminMethod) Module() ModuleID {
+	return ModuleAgentAdmin
+}
+
+func (m agentAdminMethod) ID() int32 {
+	return int32(m)
+}
+
+func (m agentAdminMethod) String() string {
+	if s, ok := map[agentAdminMethod]string{
+		MethodAgentCacheRefresh: "refresh attach info cache",
+		MethodAgentStatus:       "agent status",
+		MethodAgentHandles:      "list pool handles",
+		MethodAgentCacheDump:    "dump attach info cache",
+	}[m]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("%s:%d", m.Module(), m.ID())
+}
+
+// IsValid sanity checks the Method ID is within expected bounds.
+func (m agentAdminMethod) IsValid() bool {
+	startMethodID := int32(m.Module()) * moduleMethodOffset
+
+	if m.ID() <= startMethodID || m.ID() >= int32(C.NUM_DRPC_AGENT_ADMIN_METHODS) {
+		return false
+	}
+
+	return true
+}
+
+const (
+	// MethodAgentCacheRefresh is a ModuleAgentAdmin method
+	MethodAgentCacheRefresh agentAdminMethod = C.DRPC_METHOD_AGENT_ADMIN_CACHE_REFRESH
+	// MethodAgentStatus is a ModuleAgentAdmin method
+	MethodAgentStatus agentAdminMethod = C.DRPC_METHOD_AGENT_ADMIN_STATUS
+	// MethodAgentHandles is a ModuleAgentAdmin method
+	MethodAgentHandles agentAdminMethod = C.DRPC_METHOD_AGENT_ADMIN_HANDLES
+	// MethodAgentCacheDump is a ModuleAgentAdmin method
+	MethodAgentCacheDump agentAdminMethod = C.DRPC_METHOD_AGENT_ADMIN_CACHE_DUMP
+)
+
 // Marshal is a utility function that can be used by dRPC method handlers to
 // marshal their method-specific response to be passed back to the ModuleService.
 func Marshal(message proto.Message) ([]byte, error) {
@@ -45,3 +45,131 @@ const (
 	// PoolSelfHealingAutoRebuild sets the self-healing strategy to auto-rebuild.
 	PoolSelfHealingAutoRebuild = C.DAOS_SELF_HEAL_AUTO_REBUILD
 )
+
+const (
+	// ContPropertyLabel is a string that a user can associate with a container.
+	ContPropertyLabel = C.DAOS_PROP_CO_LABEL
+	// ContPropertyLayoutType is the type of the container layout (e.g. POSIX).
+	ContPropertyLayoutType = C.DAOS_PROP_CO_LAYOUT_TYPE
+	// ContPropertyLayoutVersion is the version of the container layout.
+	ContPropertyLayoutVersion = C.DAOS_PROP_CO_LAYOUT_VER
+	// ContPropertyChecksum is the checksum type used by the container.
+	ContPropertyChecksum = C.DAOS_PROP_CO_CSUM
+	// ContPropertyChecksumSize is the checksum chunk size.
+	ContPropertyChecksumSize = C.DAOS_PROP_CO_CSUM_CHUNK_SIZE
+	// ContPropertyServerChecksum defines whether checksums are verified on the server.
+	ContPropertyServerChecksum = C.DAOS_PROP_CO_CSUM_SERVER_VERIFY
+	// ContPropertyRedundancyFactor is the redundancy factor of the container.
+	ContPropertyRedundancyFactor = C.DAOS_PROP_CO_REDUN_FAC
+	// ContPropertyRedundancyLevel is the fault domain level used for redundancy.
+	ContPropertyRedundancyLevel = C.DAOS_PROP_CO_REDUN_LVL
+	// ContPropertySnapshotMax is the maximum number of snapshots to retain.
+	ContPropertySnapshotMax = C.DAOS_PROP_CO_SNAPSHOT_MAX
+	// ContPropertyACL is the Access Control List for a container.
+	ContPropertyACL = C.DAOS_PROP_CO_ACL
+	// ContPropertyCompression is the compression type used by the container.
+	ContPropertyCompression = C.DAOS_PROP_CO_COMPRESS
+	// ContPropertyEncryption is the encryption type used by the container.
+	ContPropertyEncryption = C.DAOS_PROP_CO_ENCRYPT
+	// ContPropertyOwner is the user who acts as the owner of the container.
+	ContPropertyOwner = C.DAOS_PROP_CO_OWNER
+	// ContPropertyOwnerGroup is the group that acts as the owner of the container.
+	ContPropertyOwnerGroup = C.DAOS_PROP_CO_OWNER_GROUP
+	// ContPropertyDedup defines whether deduplication is enabled.
+	ContPropertyDedup = C.DAOS_PROP_CO_DEDUP
+	// ContPropertyDedupThreshold is the deduplication threshold size.
+	ContPropertyDedupThreshold = C.DAOS_PROP_CO_DEDUP_THRESHOLD
+	// ContPropertyRoots are the first citizen objects of the container.
+	ContPropertyRoots = C.DAOS_PROP_CO_ROOTS
+	// ContPropertyStatus is the health status of the container.
+	ContPropertyStatus = C.DAOS_PROP_CO_STATUS
+	// ContPropertyAllocatedOID is the OID value to start allocation from.
+	ContPropertyAllocatedOID = C.DAOS_PROP_CO_ALLOCED_OID
+)
+
+const (
+	// ContStatusHealthy indicates that data protection works as expected.
+	ContStatusHealthy = C.DAOS_PROP_CO_HEALTHY
+	// ContStatusUnclean indicates that data protection may not work.
+	ContStatusUnclean = C.DAOS_PROP_CO_UNCLEAN
+)
+
+const (
+	// ContLayoutUnknown indicates an unknown container layout.
+	ContLayoutUnknown = C.DAOS_PROP_CO_LAYOUT_UNKOWN
+	// ContLayoutPOSIX indicates a POSIX container layout.
+	ContLayoutPOSIX = C.DAOS_PROP_CO_LAYOUT_POSIX
+	// ContLayoutHDF5 indicates an HDF5 container layout.
+	ContLayoutHDF5 = C.DAOS_PROP_CO_LAYOUT_HDF5
+)
+
+const (
+	// ContChecksumOff disables container checksums.
+	ContChecksumOff = C.DAOS_PROP_CO_CSUM_OFF
+	// ContChecksumCRC16 selects the CRC16 checksum type.
+	ContChecksumCRC16 = C.DAOS_PROP_CO_CSUM_CRC16
+	// ContChecksumCRC32 selects the CRC32 checksum type.
+	ContChecksumCRC32 = C.DAOS_PROP_CO_CSUM_CRC32
+	// ContChecksumCRC64 selects the CRC64 checksum type.
+	ContChecksumCRC64 = C.DAOS_PROP_CO_CSUM_CRC64
+	// ContChecksumSHA1 selects the SHA1 checksum type.
+	ContChecksumSHA1 = C.DAOS_PROP_CO_CSUM_SHA1
+	// ContChecksumSHA256 selects the SHA256 checksum type.
+	ContChecksumSHA256 = C.DAOS_PROP_CO_CSUM_SHA256
+	// ContChecksumSHA512 selects the SHA512 checksum type.
+	ContChecksumSHA512 = C.DAOS_PROP_CO_CSUM_SHA512
+	// ContChecksumAdler32 selects the Adler32 checksum type.
+	ContChecksumAdler32 = C.DAOS_PROP_CO_CSUM_ADLER32
+)
+
+const (
+	// ContDedupOff disables deduplication.
+	ContDedupOff = C.DAOS_PROP_CO_DEDUP_OFF
+	// ContDedupMemcmp enables deduplication with memory comparison.
+	ContDedupMemcmp = C.DAOS_PROP_CO_DEDUP_MEMCMP
+	// ContDedupHash enables deduplication with hash comparison.
+	ContDedupHash = C.DAOS_PROP_CO_DEDUP_HASH
+)
+
+const (
+	// ContCompressOff disables compression.
+	ContCompressOff = C.DAOS_PROP_CO_COMPRESS_OFF
+	// ContCompressLZ4 selects LZ4 compression.
+	ContCompressLZ4 = C.DAOS_PROP_CO_COMPRESS_LZ4
+	// ContCompressDeflate selects deflate compression at the default level.
+	ContCompressDeflate = C.DAOS_PROP_CO_COMPRESS_DEFLATE
+	// ContCompressDeflate1 selects deflate compression at level 1.
+	ContCompressDeflate1 = C.DAOS_PROP_CO_COMPRESS_DEFLATE1
+	// ContCompressDeflate2 selects deflate compression at level 2.
+	ContCompressDeflate2 = C.DAOS_PROP_CO_COMPRESS_DEFLATE2
+	// ContCompressDeflate3 selects deflate compression at level 3.
+	ContCompressDeflate3 = C.DAOS_PROP_CO_COMPRESS_DEFLATE3
+	// ContCompressDeflate4 selects deflate compression at level 4.
+	ContCompressDeflate4 = C.DAOS_PROP_CO_COMPRESS_DEFLATE4
+)
+
+const (
+	// ContEncryptOff disables encryption.
+	ContEncryptOff = C.DAOS_PROP_CO_ENCRYPT_OFF
+	// ContEncryptAESXTS128 selects AES-XTS-128 encryption.
+	ContEncryptAESXTS128 = C.DAOS_PROP_CO_ENCRYPT_AES_XTS128
+	// ContEncryptAESXTS256 selects AES-XTS-256 encryption.
+	ContEncryptAESXTS256 = C.DAOS_PROP_CO_ENCRYPT_AES_XTS256
+	// ContEncryptAESCBC128 selects AES-CBC-128 encryption.
+	ContEncryptAESCBC128 = C.DAOS_PROP_CO_ENCRYPT_AES_CBC128
+	// ContEncryptAESCBC192 selects AES-CBC-192 encryption.
+	ContEncryptAESCBC192 = C.DAOS_PROP_CO_ENCRYPT_AES_CBC192
+	// ContEncryptAESCBC256 selects AES-CBC-256 encryption.
+	ContEncryptAESCBC256 = C.DAOS_PROP_CO_ENCRYPT_AES_CBC256
+	// ContEncryptAESGCM128 selects AES-GCM-128 encryption.
+	ContEncryptAESGCM128 = C.DAOS_PROP_CO_ENCRYPT_AES_GCM128
+	// ContEncryptAESGCM256 selects AES-GCM-256 encryption.
+	ContEncryptAESGCM256 = C.DAOS_PROP_CO_ENCRYPT_AES_GCM256
+)
+
+const (
+	// ContRedunLevelRack places redundant data in different racks.
+	ContRedunLevelRack = C.DAOS_PROP_CO_REDUN_RACK
+	// ContRedunLevelNode places redundant data on different nodes.
+	ContRedunLevelNode = C.DAOS_PROP_CO_REDUN_NODE
+)
@@ -0,0 +1,62 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"github.com/mjmac/soad/src/control/lib/atm"
+)
+
+// NewNvmeHealthWarningEvent creates an event indicating that a health
+// statistic of an NVMe device has crossed its configured threshold. The
+// device UUID is recorded as the hardware ID of the event.
+func NewNvmeHealthWarningEvent(hostname string, rank uint32, devUUID, detail string) *RASEvent {
+	return New(&RASEvent{
+		Msg:          "NVMe device health threshold exceeded",
+		ID:           RASNvmeHealthWarning,
+		Hostname:     hostname,
+		Rank:         rank,
+		HWID:         devUUID,
+		Type:         RASTypeStateChange,
+		Severity:     RASSeverityWarn,
+		ExtendedInfo: NewStrInfo(detail),
+		forwarded:    atm.NewBool(false),
+	})
+}
+
+// NewNvmeFailurePredictedEvent creates an event indicating that the trend of
+// a health statistic of an NVMe device predicts that it will cross its
+// configured threshold, and that the device is therefore likely to fail.
+func NewNvmeFailurePredictedEvent(hostname string, rank uint32, devUUID, detail string) *RASEvent {
+	return New(&RASEvent{
+		Msg:          "NVMe device failure predicted",
+		ID:           RASNvmeFailurePredicted,
+		Hostname:     hostname,
+		Rank:         rank,
+		HWID:         devUUID,
+		Type:         RASTypeStateChange,
+		Severity:     RASSeverityWarn,
+		ExtendedInfo: NewStrInfo(detail),
+		forwarded:    atm.NewBool(false),
+	})
+}
+
+// NewNvmeAutoFaultyEvent creates an event indicating that an NVMe device has
+// been automatically marked FAULTY by the control plane and should be
+// replaced.
+func NewNvmeAutoFaultyEvent(hostname string, rank uint32, devUUID, detail string) *RASEvent {
+	return New(&RASEvent{
+		Msg:          "NVMe device automatically set FAULTY",
+		ID:           RASNvmeAutoFaulty,
+		Hostname:     hostname,
+		Rank:         rank,
+		HWID:         devUUID,
+		Type:         RASTypeStateChange,
+		Severity:     RASSeverityError,
+		ExtendedInfo: NewStrInfo(detail),
+		forwarded:    atm.NewBool(false),
+	})
+}
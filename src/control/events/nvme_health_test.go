@@ -0,0 +1,70 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mjmac/soad/src/control/common"
+)
+
+func TestEvents_NvmeHealthEvents(t *testing.T) {
+	for name, tc := range map[string]struct {
+		newEvent    func(string, uint32, string, string) *RASEvent
+		expID       RASID
+		expMsg      string
+		expSeverity RASSeverityID
+	}{
+		"health warning": {
+			newEvent:    NewNvmeHealthWarningEvent,
+			expID:       RASNvmeHealthWarning,
+			expMsg:      "NVMe device health threshold exceeded",
+			expSeverity: RASSeverityWarn,
+		},
+		"failure predicted": {
+			newEvent:    NewNvmeFailurePredictedEvent,
+			expID:       RASNvmeFailurePredicted,
+			expMsg:      "NVMe device failure predicted",
+			expSeverity: RASSeverityWarn,
+		},
+		"automatically set faulty": {
+			newEvent:    NewNvmeAutoFaultyEvent,
+			expID:       RASNvmeAutoFaulty,
+			expMsg:      "NVMe device automatically set FAULTY",
+			expSeverity: RASSeverityError,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			event := tc.newEvent("foo", 1, common.MockUUID(), "media errors 2 >= 1")
+
+			common.AssertEqual(t, tc.expID, event.ID, "unexpected event ID")
+			common.AssertEqual(t, tc.expMsg, event.Msg, "unexpected message")
+			common.AssertEqual(t, tc.expSeverity, event.Severity, "unexpected severity")
+			common.AssertEqual(t, RASTypeStateChange, event.Type, "unexpected type")
+			common.AssertEqual(t, common.MockUUID(), event.HWID, "unexpected hardware ID")
+			if diff := cmp.Diff(NewStrInfo("media errors 2 >= 1"), event.GetStrInfo()); diff != "" {
+				t.Fatalf("unexpected extended info (-want, +got):\n%s\n", diff)
+			}
+
+			pbEvent, err := event.ToProto()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			returnedEvent := new(RASEvent)
+			if err := returnedEvent.FromProto(pbEvent); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(event, returnedEvent, defEvtCmpOpts...); diff != "" {
+				t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
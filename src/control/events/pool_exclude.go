@@ -0,0 +1,38 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"fmt"
+
+	"github.com/mjmac/soad/src/control/lib/atm"
+)
+
+// NewPoolAutoExcludeEvent creates an event recording the outcome of an
+// automatic exclusion of a dead rank from a pool. If the exclusion failed,
+// the supplied error is included in the event details.
+func NewPoolAutoExcludeEvent(hostname string, rank uint32, poolUUID string, excludeErr error) *RASEvent {
+	evt := &RASEvent{
+		Msg:       fmt.Sprintf("DAOS rank %d automatically excluded from pool", rank),
+		ID:        RASPoolAutoExclude,
+		Hostname:  hostname,
+		Rank:      rank,
+		PoolUUID:  poolUUID,
+		Type:      RASTypeInfoOnly,
+		Severity:  RASSeverityWarn,
+		forwarded: atm.NewBool(false),
+	}
+
+	if excludeErr != nil {
+		evt.Msg = fmt.Sprintf("failed to automatically exclude DAOS rank %d from pool", rank)
+		evt.ID = RASPoolAutoExcludeFailed
+		evt.Severity = RASSeverityError
+		evt.ExtendedInfo = NewStrInfo(excludeErr.Error())
+	}
+
+	return New(evt)
+}
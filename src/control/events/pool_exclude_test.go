@@ -0,0 +1,61 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+)
+
+func TestEvents_NewPoolAutoExcludeEvent(t *testing.T) {
+	for name, tc := range map[string]struct {
+		excludeErr error
+		expID      RASID
+		expSev     RASSeverityID
+		expInfo    *StrInfo
+	}{
+		"success": {
+			expID:  RASPoolAutoExclude,
+			expSev: RASSeverityWarn,
+		},
+		"failure": {
+			excludeErr: errors.New("exclude failed"),
+			expID:      RASPoolAutoExcludeFailed,
+			expSev:     RASSeverityError,
+			expInfo:    NewStrInfo("exclude failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			event := NewPoolAutoExcludeEvent("foo", 1, common.MockUUID(), tc.excludeErr)
+
+			common.AssertEqual(t, tc.expID, event.ID, "unexpected event ID")
+			common.AssertEqual(t, tc.expSev, event.Severity, "unexpected severity")
+			common.AssertEqual(t, RASTypeInfoOnly, event.Type, "unexpected type")
+			if diff := cmp.Diff(tc.expInfo, event.GetStrInfo()); diff != "" {
+				t.Fatalf("unexpected extended info (-want, +got):\n%s\n", diff)
+			}
+
+			pbEvent, err := event.ToProto()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			returnedEvent := new(RASEvent)
+			if err := returnedEvent.FromProto(pbEvent); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(event, returnedEvent, defEvtCmpOpts...); diff != "" {
+				t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -53,6 +53,13 @@ const (
 	RASSwimRankDead   RASID = C.RAS_SWIM_RANK_DEAD
 	RASSystemStop     RASID = C.RAS_SYSTEM_STOP
 	RASSystemStart    RASID = C.RAS_SYSTEM_START
+
+	RASPoolAutoExclude       RASID = C.RAS_POOL_AUTO_EXCLUDE
+	RASPoolAutoExcludeFailed RASID = C.RAS_POOL_AUTO_EXCLUDE_FAILED
+	RASSystemMapUpdate       RASID = C.RAS_SYSTEM_MAP_UPDATE
+	RASNvmeHealthWarning     RASID = C.RAS_NVME_HEALTH_WARNING
+	RASNvmeFailurePredicted  RASID = C.RAS_NVME_FAILURE_PREDICTED
+	RASNvmeAutoFaulty        RASID = C.RAS_NVME_AUTO_FAULTY
 )
 
 func (id RASID) String() string {
@@ -64,6 +71,26 @@ func (id RASID) Uint32() uint32 {
 	return uint32(id)
 }
 
+// RASIDFromString returns the RASID matching the supplied event identifier
+// string (e.g. "engine_status_down").
+func RASIDFromString(in string) (RASID, error) {
+	// NB: ras_event2str() returns this string for values that are
+	// outside of the range of defined event IDs.
+	unknownStr := C.GoString(C.ras_event2str(C.ras_event_t(^uint32(0))))
+
+	for id := RASUnknownEvent + 1; ; id++ {
+		str := id.String()
+		if str == unknownStr {
+			break
+		}
+		if str == in {
+			return id, nil
+		}
+	}
+
+	return RASUnknownEvent, errors.Errorf("unknown RAS event ID %q", in)
+}
+
 // RASTypeID identifies the type of a given RAS event.
 type RASTypeID uint32
 
@@ -83,6 +110,18 @@ func (typ RASTypeID) Uint32() uint32 {
 	return uint32(typ)
 }
 
+// RASTypeFromString returns the RASTypeID matching the supplied
+// (case-insensitive) event type string.
+func RASTypeFromString(in string) (RASTypeID, error) {
+	for _, typ := range []RASTypeID{RASTypeStateChange, RASTypeInfoOnly} {
+		if strings.EqualFold(typ.String(), in) {
+			return typ, nil
+		}
+	}
+
+	return RASTypeAny, errors.Errorf("unknown RAS event type %q", in)
+}
+
 // RASSeverityID identifies the severity of a given RAS event.
 type RASSeverityID uint32
 
@@ -104,6 +143,20 @@ func (sev RASSeverityID) Uint32() uint32 {
 	return uint32(sev)
 }
 
+// RASSeverityFromString returns the RASSeverityID matching the supplied
+// (case-insensitive) severity string.
+func RASSeverityFromString(in string) (RASSeverityID, error) {
+	for _, sev := range []RASSeverityID{
+		RASSeverityFatal, RASSeverityWarn, RASSeverityError, RASSeverityInfo,
+	} {
+		if strings.EqualFold(sev.String(), in) {
+			return sev, nil
+		}
+	}
+
+	return RASSeverityUnknown, errors.Errorf("unknown RAS event severity %q", in)
+}
+
 // RASEvent describes details of a specific RAS event.
 type RASEvent struct {
 	ID           RASID           `json:"id"`
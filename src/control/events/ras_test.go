@@ -141,3 +141,105 @@ func TestEvents_HandleClusterEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestEvents_RASIDFromString(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     string
+		expID  RASID
+		expErr error
+	}{
+		"empty": {
+			expErr: errors.New("unknown RAS event ID"),
+		},
+		"unknown": {
+			in:     "foo_bar",
+			expErr: errors.New("unknown RAS event ID"),
+		},
+		"rank down": {
+			in:    "engine_status_down",
+			expID: RASRankDown,
+		},
+		"swim rank dead": {
+			in:    RASSwimRankDead.String(),
+			expID: RASSwimRankDead,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			id, err := RASIDFromString(tc.in)
+			common.CmpErr(t, tc.expErr, err)
+			if err != nil {
+				return
+			}
+
+			common.AssertEqual(t, tc.expID, id, "unexpected event ID")
+		})
+	}
+}
+
+func TestEvents_RASTypeFromString(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in      string
+		expType RASTypeID
+		expErr  error
+	}{
+		"empty": {
+			expErr: errors.New("unknown RAS event type"),
+		},
+		"unknown": {
+			in:     "transient",
+			expErr: errors.New("unknown RAS event type"),
+		},
+		"upper case": {
+			in:      "STATE_CHANGE",
+			expType: RASTypeStateChange,
+		},
+		"lower case": {
+			in:      "info",
+			expType: RASTypeInfoOnly,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			typ, err := RASTypeFromString(tc.in)
+			common.CmpErr(t, tc.expErr, err)
+			if err != nil {
+				return
+			}
+
+			common.AssertEqual(t, tc.expType, typ, "unexpected type")
+		})
+	}
+}
+
+func TestEvents_RASSeverityFromString(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     string
+		expSev RASSeverityID
+		expErr error
+	}{
+		"empty": {
+			expErr: errors.New("unknown RAS event severity"),
+		},
+		"unknown": {
+			in:     "critical",
+			expErr: errors.New("unknown RAS event severity"),
+		},
+		"upper case": {
+			in:     "ERROR",
+			expSev: RASSeverityError,
+		},
+		"lower case": {
+			in:     "warn",
+			expSev: RASSeverityWarn,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sev, err := RASSeverityFromString(tc.in)
+			common.CmpErr(t, tc.expErr, err)
+			if err != nil {
+				return
+			}
+
+			common.AssertEqual(t, tc.expSev, sev, "unexpected severity")
+		})
+	}
+}
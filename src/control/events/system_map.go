@@ -0,0 +1,28 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/mjmac/soad/src/control/lib/atm"
+)
+
+// NewSystemMapUpdateEvent creates an event indicating that a new version of
+// the system group map has been distributed to the DAOS engines.
+func NewSystemMapUpdateEvent(hostname string, mapVersion uint32) *RASEvent {
+	return New(&RASEvent{
+		Msg:       fmt.Sprintf("DAOS system map updated to version %d", mapVersion),
+		ID:        RASSystemMapUpdate,
+		Hostname:  hostname,
+		Rank:      math.MaxUint32, // not associated with a rank
+		Type:      RASTypeInfoOnly,
+		Severity:  RASSeverityInfo,
+		forwarded: atm.NewBool(false),
+	})
+}
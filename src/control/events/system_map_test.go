@@ -0,0 +1,38 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mjmac/soad/src/control/common"
+)
+
+func TestEvents_NewSystemMapUpdateEvent(t *testing.T) {
+	event := NewSystemMapUpdateEvent("foo", 42)
+
+	common.AssertEqual(t, RASSystemMapUpdate, event.ID, "unexpected event ID")
+	common.AssertEqual(t, RASSeverityInfo, event.Severity, "unexpected severity")
+	common.AssertEqual(t, RASTypeInfoOnly, event.Type, "unexpected type")
+	common.AssertEqual(t, "DAOS system map updated to version 42", event.Msg, "unexpected message")
+
+	pbEvent, err := event.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	returnedEvent := new(RASEvent)
+	if err := returnedEvent.FromProto(pbEvent); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(event, returnedEvent, defEvtCmpOpts...); diff != "" {
+		t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
+	}
+}
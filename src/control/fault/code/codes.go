@@ -109,6 +109,9 @@ const (
 	ServerInstancesNotStopped
 	ServerConfigInvalidNetDevClass
 	ServerVfioDisabled
+	ServerPoolInsufficientCapacity
+	ServerPoolQuotaExceeded
+	ServerPoolInvalidLabel
 
 	// server config fault codes
 	ServerConfigUnknown Code = iota + 700
@@ -130,6 +133,12 @@ const (
 	ServerConfigFaultCallbackFailed
 	ServerConfigBothFaultPathAndCb
 	ServerConfigFaultCallbackEmpty
+	ServerConfigBadAutoExcludeGracePeriod
+	ServerConfigBadMetricsAddress
+	ServerConfigBadNvmeHealth
+	ServerConfigBadNvmeAutoFaultyErrorWindow
+	ServerConfigBadNvmeEmulation
+	ServerConfigBadScmEmulation
 
 	// SPDK library bindings codes
 	SpdkUnknown Code = iota + 800
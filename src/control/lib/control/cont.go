@@ -8,12 +8,14 @@ package control
 
 import (
 	"context"
+	"sort"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 
 	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
 )
 
 // ContSetOwnerReq contains the parameters for the set owner request
@@ -21,7 +23,7 @@ type ContSetOwnerReq struct {
 	msRequest
 	unaryRequest
 	ContUUID string // Container UUID
-	PoolUUID string // UUID of the pool for the container
+	PoolID   string // Label or UUID of the pool for the container
 	User     string // User to own the container, or empty if none
 	Group    string // Group to own the container, or empty if none
 }
@@ -36,7 +38,7 @@ func ContSetOwner(ctx context.Context, rpcClient UnaryInvoker, req *ContSetOwner
 		return err
 	}
 
-	if err := checkUUID(req.PoolUUID); err != nil {
+	if err := checkPoolID(req.PoolID); err != nil {
 		return err
 	}
 
@@ -48,7 +50,7 @@ func ContSetOwner(ctx context.Context, rpcClient UnaryInvoker, req *ContSetOwner
 		return mgmtpb.NewMgmtSvcClient(conn).ContSetOwner(ctx, &mgmtpb.ContSetOwnerReq{
 			Sys:        req.getSystem(),
 			ContUUID:   req.ContUUID,
-			PoolUUID:   req.PoolUUID,
+			PoolUUID:   req.PoolID,
 			Owneruser:  req.User,
 			Ownergroup: req.Group,
 		})
@@ -69,3 +71,342 @@ func ContSetOwner(ctx context.Context, rpcClient UnaryInvoker, req *ContSetOwner
 
 	return nil
 }
+
+// ListContainersReq contains the parameters for the list containers request.
+type ListContainersReq struct {
+	msRequest
+	unaryRequest
+	PoolID string // Label or UUID of the pool to list containers for
+}
+
+// ListContainersResp contains the UUIDs of the containers in a pool.
+type ListContainersResp struct {
+	PoolUUID   string   `json:"pool_uuid"`
+	Containers []string `json:"containers"`
+}
+
+// ListContainers retrieves the UUIDs of the containers in a DAOS pool.
+func ListContainers(ctx context.Context, rpcClient UnaryInvoker, req *ListContainersReq) (*ListContainersResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if err := checkPoolID(req.PoolID); err != nil {
+		return nil, err
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ListContainers(ctx, &mgmtpb.ListContReq{
+			Sys:  req.getSystem(),
+			Uuid: req.PoolID,
+		})
+	})
+
+	rpcClient.Debugf("List DAOS containers request: %+v\n", req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "container list failed")
+	}
+	rpcClient.Debugf("List DAOS containers response: %+v\n", msResp)
+
+	pbResp, ok := msResp.(*mgmtpb.ListContResp)
+	if !ok {
+		return nil, errors.New("unable to extract ListContResp from MS response")
+	}
+	if pbResp.GetStatus() != 0 {
+		return nil, drpc.DaosStatus(pbResp.GetStatus())
+	}
+
+	resp := &ListContainersResp{
+		PoolUUID:   req.PoolID,
+		Containers: make([]string, 0, len(pbResp.GetContainers())),
+	}
+	for _, cont := range pbResp.GetContainers() {
+		resp.Containers = append(resp.Containers, cont.GetUuid())
+	}
+
+	return resp, nil
+}
+
+// ContQueryReq contains the parameters for the container query request.
+type ContQueryReq struct {
+	msRequest
+	unaryRequest
+	ContUUID string // Container UUID
+	PoolID   string // Label or UUID of the pool for the container
+}
+
+// ContQueryResp contains the ownership and usage of a container.
+//
+// Usage is reported as the number of objects stored in the container across
+// all pool targets; per-container byte usage is not tracked by the engine.
+type ContQueryResp struct {
+	PoolUUID   string `json:"pool_uuid"`
+	ContUUID   string `json:"uuid"`
+	Label      string `json:"label"`
+	Owner      string `json:"owner"`
+	Group      string `json:"group"`
+	NumHandles uint32 `json:"num_handles"`
+	NumObjects uint64 `json:"num_objects"`
+}
+
+// ContQuery retrieves the ownership and usage of a DAOS container.
+func ContQuery(ctx context.Context, rpcClient UnaryInvoker, req *ContQueryReq) (*ContQueryResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if err := checkUUID(req.ContUUID); err != nil {
+		return nil, err
+	}
+	if err := checkPoolID(req.PoolID); err != nil {
+		return nil, err
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ContQuery(ctx, &mgmtpb.ContQueryReq{
+			Sys:      req.getSystem(),
+			ContUUID: req.ContUUID,
+			PoolUUID: req.PoolID,
+		})
+	})
+
+	rpcClient.Debugf("Query DAOS container request: %+v\n", req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "container query failed")
+	}
+	rpcClient.Debugf("Query DAOS container response: %+v\n", msResp)
+
+	pbResp, ok := msResp.(*mgmtpb.ContQueryResp)
+	if !ok {
+		return nil, errors.New("unable to extract ContQueryResp from MS response")
+	}
+	if pbResp.GetStatus() != 0 {
+		return nil, drpc.DaosStatus(pbResp.GetStatus())
+	}
+
+	return &ContQueryResp{
+		PoolUUID:   req.PoolID,
+		ContUUID:   req.ContUUID,
+		Label:      pbResp.GetLabel(),
+		Owner:      pbResp.GetOwneruser(),
+		Group:      pbResp.GetOwnergroup(),
+		NumHandles: pbResp.GetNumHandles(),
+		NumObjects: pbResp.GetNumObjects(),
+	}, nil
+}
+
+// ContDestroyReq contains the parameters for the container destroy request.
+type ContDestroyReq struct {
+	msRequest
+	unaryRequest
+	ContUUID string // Container UUID
+	PoolID   string // Label or UUID of the pool for the container
+	Force    bool   // Evict open handles before destroying the container
+}
+
+// ContDestroy destroys a DAOS container. Unless Force is set, the request
+// fails if the container has open handles.
+func ContDestroy(ctx context.Context, rpcClient UnaryInvoker, req *ContDestroyReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if err := checkUUID(req.ContUUID); err != nil {
+		return err
+	}
+	if err := checkPoolID(req.PoolID); err != nil {
+		return err
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ContDestroy(ctx, &mgmtpb.ContDestroyReq{
+			Sys:      req.getSystem(),
+			ContUUID: req.ContUUID,
+			PoolUUID: req.PoolID,
+			Force:    req.Force,
+		})
+	})
+
+	rpcClient.Debugf("Destroy DAOS container request: %+v\n", req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return errors.Wrap(err, "container destroy failed")
+	}
+	rpcClient.Debugf("Destroy DAOS container response: %+v\n", msResp)
+
+	pbResp, ok := msResp.(*mgmtpb.ContDestroyResp)
+	if !ok {
+		return errors.New("unable to extract ContDestroyResp from MS response")
+	}
+	if pbResp.GetStatus() != 0 {
+		return drpc.DaosStatus(pbResp.GetStatus())
+	}
+
+	return nil
+}
+
+// ContProperty contains the name and value of a single container property.
+type ContProperty struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Number   uint32 `json:"number"`
+	NumValue uint64 `json:"numval,omitempty"`
+}
+
+// ContGetPropReq contains the parameters for the container get-prop request.
+type ContGetPropReq struct {
+	msRequest
+	unaryRequest
+	ContUUID string // Container UUID
+	PoolID   string // Label or UUID of the pool for the container
+	// Properties is an optional list of property names to retrieve.
+	// All supported properties are retrieved if the list is empty.
+	Properties []string
+}
+
+// ContGetPropResp contains the response to a container get-prop operation.
+type ContGetPropResp struct {
+	ContUUID   string          `json:"uuid"`
+	Properties []*ContProperty `json:"properties"`
+}
+
+// ContGetProp retrieves the properties of a DAOS container.
+func ContGetProp(ctx context.Context, rpcClient UnaryInvoker, req *ContGetPropReq) (*ContGetPropResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if err := checkUUID(req.ContUUID); err != nil {
+		return nil, err
+	}
+	if err := checkPoolID(req.PoolID); err != nil {
+		return nil, err
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ContGetProp(ctx, &mgmtpb.ContGetPropReq{
+			Sys:      req.getSystem(),
+			ContUUID: req.ContUUID,
+			PoolUUID: req.PoolID,
+			Names:    req.Properties,
+		})
+	})
+
+	rpcClient.Debugf("DAOS container get-prop request: %+v\n", req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	pbResp, ok := msResp.(*mgmtpb.ContGetPropResp)
+	if !ok {
+		return nil, errors.New("unable to extract ContGetPropResp from MS response")
+	}
+	if pbResp.GetStatus() != 0 {
+		return nil, drpc.DaosStatus(pbResp.GetStatus())
+	}
+
+	resp := &ContGetPropResp{
+		ContUUID:   req.ContUUID,
+		Properties: make([]*ContProperty, 0, len(pbResp.GetProperties())),
+	}
+	for _, prop := range pbResp.GetProperties() {
+		resp.Properties = append(resp.Properties, &ContProperty{
+			Name:     prop.GetName(),
+			Value:    prop.GetStrval(),
+			Number:   prop.GetNumber(),
+			NumValue: prop.GetNumval(),
+		})
+	}
+
+	return resp, nil
+}
+
+// ContSetPropReq contains the parameters for the container set-prop request.
+type ContSetPropReq struct {
+	msRequest
+	unaryRequest
+	ContUUID string // Container UUID
+	PoolID   string // Label or UUID of the pool for the container
+	// Properties maps property names to their new values.
+	Properties map[string]string
+}
+
+// ContSetProp sets one or more properties on a DAOS container.
+func ContSetProp(ctx context.Context, rpcClient UnaryInvoker, req *ContSetPropReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if err := checkUUID(req.ContUUID); err != nil {
+		return err
+	}
+	if err := checkPoolID(req.PoolID); err != nil {
+		return err
+	}
+	if len(req.Properties) == 0 {
+		return errors.New("no container properties specified")
+	}
+
+	names := make([]string, 0, len(req.Properties))
+	for name := range req.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	props := make([]*mgmtpb.ContProperty, 0, len(names))
+	for _, name := range names {
+		props = append(props, &mgmtpb.ContProperty{
+			Name:   name,
+			Strval: req.Properties[name],
+		})
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ContSetProp(ctx, &mgmtpb.ContSetPropReq{
+			Sys:        req.getSystem(),
+			ContUUID:   req.ContUUID,
+			PoolUUID:   req.PoolID,
+			Properties: props,
+		})
+	})
+
+	rpcClient.Debugf("DAOS container set-prop request: %+v\n", req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return errors.Wrap(err, "container set-prop failed")
+	}
+	rpcClient.Debugf("DAOS container set-prop response: %+v\n", msResp)
+
+	pbResp, ok := msResp.(*mgmtpb.ContSetPropResp)
+	if !ok {
+		return errors.New("unable to extract ContSetPropResp from MS response")
+	}
+	if pbResp.GetStatus() != 0 {
+		return drpc.DaosStatus(pbResp.GetStatus())
+	}
+
+	return nil
+}
@@ -10,11 +10,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	"github.com/mjmac/soad/src/control/common"
 	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
 	"github.com/mjmac/soad/src/control/logging"
 )
 
@@ -23,7 +25,7 @@ func TestControl_ContSetOwner(t *testing.T) {
 	testContUUID := uuid.New().String()
 
 	validReq := &ContSetOwnerReq{
-		PoolUUID: testPoolUUID,
+		PoolID:   testPoolUUID,
 		ContUUID: testContUUID,
 		User:     "someuser@",
 		Group:    "somegroup@",
@@ -40,33 +42,26 @@ func TestControl_ContSetOwner(t *testing.T) {
 		},
 		"bad container UUID": {
 			req: &ContSetOwnerReq{
-				PoolUUID: testPoolUUID,
+				PoolID:   testPoolUUID,
 				ContUUID: "junk",
 			},
 			expErr: errors.New("invalid UUID"),
 		},
-		"bad pool UUID": {
-			req: &ContSetOwnerReq{
-				PoolUUID: "garbage",
-				ContUUID: testContUUID,
-			},
-			expErr: errors.New("invalid UUID"),
-		},
 		"no container UUID": {
 			req: &ContSetOwnerReq{
-				PoolUUID: testPoolUUID,
+				PoolID: testPoolUUID,
 			},
 			expErr: errors.New("invalid UUID"),
 		},
-		"no pool UUID": {
+		"no pool ID": {
 			req: &ContSetOwnerReq{
 				ContUUID: testContUUID,
 			},
-			expErr: errors.New("invalid UUID"),
+			expErr: errors.New("no pool label or UUID"),
 		},
 		"no user or group": {
 			req: &ContSetOwnerReq{
-				PoolUUID: testPoolUUID,
+				PoolID:   testPoolUUID,
 				ContUUID: testContUUID,
 			},
 			expErr: errors.New("no user or group specified"),
@@ -81,7 +76,7 @@ func TestControl_ContSetOwner(t *testing.T) {
 		},
 		"user-only success": {
 			req: &ContSetOwnerReq{
-				PoolUUID: testPoolUUID,
+				PoolID:   testPoolUUID,
 				ContUUID: testContUUID,
 				User:     "someuser@",
 			},
@@ -93,7 +88,7 @@ func TestControl_ContSetOwner(t *testing.T) {
 		},
 		"group-only success": {
 			req: &ContSetOwnerReq{
-				PoolUUID: testPoolUUID,
+				PoolID:   testPoolUUID,
 				ContUUID: testContUUID,
 				Group:    "somegroup@",
 			},
@@ -135,3 +130,367 @@ func TestControl_ContSetOwner(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_ListContainers(t *testing.T) {
+	testPoolUUID := uuid.New().String()
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *ListContainersReq
+		expResp *ListContainersResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.ListContainersReq request"),
+		},
+		"missing pool ID": {
+			req:    &ListContainersReq{},
+			expErr: errors.New("no pool label or UUID"),
+		},
+		"local failure": {
+			req: &ListContainersReq{PoolID: testPoolUUID},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"DAOS failure": {
+			req: &ListContainersReq{PoolID: testPoolUUID},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ListContResp{Status: int32(drpc.DaosNonexistant)},
+				),
+			},
+			expErr: drpc.DaosNonexistant,
+		},
+		"no containers": {
+			req: &ListContainersReq{PoolID: testPoolUUID},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ListContResp{},
+				),
+			},
+			expResp: &ListContainersResp{
+				PoolUUID:   testPoolUUID,
+				Containers: []string{},
+			},
+		},
+		"success": {
+			req: &ListContainersReq{PoolID: testPoolUUID},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ListContResp{
+						Containers: []*mgmtpb.ListContResp_Cont{
+							{Uuid: common.MockUUID(1)},
+							{Uuid: common.MockUUID(2)},
+						},
+					},
+				),
+			},
+			expResp: &ListContainersResp{
+				PoolUUID:   testPoolUUID,
+				Containers: []string{common.MockUUID(1), common.MockUUID(2)},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := ListContainers(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ContQuery(t *testing.T) {
+	testPoolUUID := uuid.New().String()
+	testContUUID := uuid.New().String()
+	validReq := &ContQueryReq{
+		PoolID:   testPoolUUID,
+		ContUUID: testContUUID,
+	}
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *ContQueryReq
+		expResp *ContQueryResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.ContQueryReq request"),
+		},
+		"bad container UUID": {
+			req:    &ContQueryReq{PoolID: testPoolUUID, ContUUID: "junk"},
+			expErr: errors.New("invalid UUID"),
+		},
+		"missing pool ID": {
+			req:    &ContQueryReq{ContUUID: testContUUID},
+			expErr: errors.New("no pool label or UUID"),
+		},
+		"remote failure": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"DAOS failure": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ContQueryResp{Status: int32(drpc.DaosNonexistant)},
+				),
+			},
+			expErr: drpc.DaosNonexistant,
+		},
+		"success": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ContQueryResp{
+						Label:      "mycont",
+						Owneruser:  "alice@",
+						Ownergroup: "admins@",
+						NumHandles: 3,
+						NumObjects: 1024,
+					},
+				),
+			},
+			expResp: &ContQueryResp{
+				PoolUUID:   testPoolUUID,
+				ContUUID:   testContUUID,
+				Label:      "mycont",
+				Owner:      "alice@",
+				Group:      "admins@",
+				NumHandles: 3,
+				NumObjects: 1024,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := ContQuery(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ContDestroy(t *testing.T) {
+	testPoolUUID := uuid.New().String()
+	testContUUID := uuid.New().String()
+	validReq := &ContDestroyReq{
+		PoolID:   testPoolUUID,
+		ContUUID: testContUUID,
+	}
+
+	for name, tc := range map[string]struct {
+		mic    *MockInvokerConfig
+		req    *ContDestroyReq
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.ContDestroyReq request"),
+		},
+		"bad container UUID": {
+			req:    &ContDestroyReq{PoolID: testPoolUUID, ContUUID: "junk"},
+			expErr: errors.New("invalid UUID"),
+		},
+		"open handles": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ContDestroyResp{Status: int32(drpc.DaosBusy)},
+				),
+			},
+			expErr: drpc.DaosBusy,
+		},
+		"success": {
+			req: &ContDestroyReq{
+				PoolID:   testPoolUUID,
+				ContUUID: testContUUID,
+				Force:    true,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ContDestroyResp{},
+				),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+			mi := NewMockInvoker(log, mic)
+
+			gotErr := ContDestroy(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_ContGetProp(t *testing.T) {
+	testPoolUUID := uuid.New().String()
+	testContUUID := uuid.New().String()
+	validReq := &ContGetPropReq{
+		PoolID:   testPoolUUID,
+		ContUUID: testContUUID,
+	}
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *ContGetPropReq
+		expResp *ContGetPropResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.ContGetPropReq request"),
+		},
+		"missing pool ID": {
+			req:    &ContGetPropReq{ContUUID: testContUUID},
+			expErr: errors.New("no pool label or UUID"),
+		},
+		"DAOS failure": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ContGetPropResp{Status: int32(drpc.DaosNoPermission)},
+				),
+			},
+			expErr: drpc.DaosNoPermission,
+		},
+		"success": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ContGetPropResp{
+						Properties: []*mgmtpb.ContProperty{
+							{Name: "label", Number: drpc.ContPropertyLabel, Strval: "mycont"},
+							{Name: "cksum", Number: drpc.ContPropertyChecksum, Numval: drpc.ContChecksumCRC32, Strval: "crc32"},
+						},
+					},
+				),
+			},
+			expResp: &ContGetPropResp{
+				ContUUID: testContUUID,
+				Properties: []*ContProperty{
+					{Name: "label", Number: drpc.ContPropertyLabel, Value: "mycont"},
+					{Name: "cksum", Number: drpc.ContPropertyChecksum, NumValue: drpc.ContChecksumCRC32, Value: "crc32"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := ContGetProp(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ContSetProp(t *testing.T) {
+	testPoolUUID := uuid.New().String()
+	testContUUID := uuid.New().String()
+	validReq := &ContSetPropReq{
+		PoolID:     testPoolUUID,
+		ContUUID:   testContUUID,
+		Properties: map[string]string{"label": "newlabel"},
+	}
+
+	for name, tc := range map[string]struct {
+		mic    *MockInvokerConfig
+		req    *ContSetPropReq
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.ContSetPropReq request"),
+		},
+		"bad container UUID": {
+			req:    &ContSetPropReq{PoolID: testPoolUUID, ContUUID: "junk"},
+			expErr: errors.New("invalid UUID"),
+		},
+		"no properties": {
+			req:    &ContSetPropReq{PoolID: testPoolUUID, ContUUID: testContUUID},
+			expErr: errors.New("no container properties"),
+		},
+		"DAOS failure": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ContSetPropResp{Status: int32(drpc.DaosNoPermission)},
+				),
+			},
+			expErr: drpc.DaosNoPermission,
+		},
+		"success": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ContSetPropResp{},
+				),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+			mi := NewMockInvoker(log, mic)
+
+			gotErr := ContSetProp(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
@@ -8,6 +8,7 @@ package control
 
 import (
 	"context"
+	"io"
 	"strings"
 
 	"google.golang.org/grpc"
@@ -35,19 +36,41 @@ func connErrToFault(st *status.Status, target string) error {
 	}
 }
 
+// unwrapStreamError attempts to resolve an error received on a stream to
+// the original error returned by the server.
+func unwrapStreamError(err error, target string) error {
+	st := status.Convert(err)
+	uErr := proto.UnwrapError(st)
+	if uErr.Error() != st.Err().Error() {
+		return uErr
+	}
+	return connErrToFault(st, target)
+}
+
+// errorUnwrappingStream wraps a grpc.ClientStream in order to return
+// unwrapped errors for messages received on the stream.
+type errorUnwrappingStream struct {
+	grpc.ClientStream
+	target string
+}
+
+// RecvMsg implements grpc.ClientStream.
+func (s *errorUnwrappingStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil || err == io.EOF {
+		return err
+	}
+	return unwrapStreamError(err, s.target)
+}
+
 // streamErrorInterceptor calls the specified streaming RPC and returns any unwrapped errors.
 func streamErrorInterceptor() grpc.DialOption {
 	return grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
 		cs, err := streamer(ctx, desc, cc, method, opts...)
 		if err != nil {
-			st := status.Convert(err)
-			err = proto.UnwrapError(st)
-			if err.Error() != st.Err().Error() {
-				return cs, err
-			}
-			return cs, connErrToFault(st, cc.Target())
+			return cs, unwrapStreamError(err, cc.Target())
 		}
-		return cs, nil
+		return &errorUnwrappingStream{ClientStream: cs, target: cc.Target()}, nil
 	})
 }
 
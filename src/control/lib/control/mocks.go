@@ -37,6 +37,8 @@ type (
 		UnaryResponse    *UnaryResponse
 		UnaryResponseSet []*UnaryResponse
 		HostResponses    HostResponseChan
+		StreamResponses  []proto.Message
+		StreamError      error
 	}
 
 	// MockInvoker implements the Invoker interface in order
@@ -115,6 +117,19 @@ func (mi *MockInvoker) InvokeUnaryRPCAsync(ctx context.Context, uReq UnaryReques
 	return responses, nil
 }
 
+func (mi *MockInvoker) InvokeStreamRPC(ctx context.Context, sReq StreamRequest, recv func(proto.Message) error) error {
+	for _, msg := range mi.cfg.StreamResponses {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := recv(msg); err != nil {
+			return err
+		}
+	}
+
+	return mi.cfg.StreamError
+}
+
 func (mi *MockInvoker) SetConfig(_ *Config) {}
 
 // DefaultMockInvokerConfig returns the default MockInvoker
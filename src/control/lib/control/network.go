@@ -220,6 +220,8 @@ type (
 		CrtTimeout      uint32   `json:"crt_timeout"`
 		NetDevClass     uint32   `json:"net_dev_class"`
 		MSRanks         []uint32 `json:"ms_ranks"`
+		MSReplicas      []string `json:"ms_replicas"`
+		MapVersion      uint32   `json:"map_version"`
 	}
 )
 
@@ -229,10 +231,11 @@ func (gair *GetAttachInfoResp) String() string {
 	rankURI := fmt.Sprintf("%d:%s", gair.ServiceRanks[0].Rank, gair.ServiceRanks[0].Uri)
 
 	// Condensed format for debugging...
-	return fmt.Sprintf("p=%s i=%s d=%s a=%d t=%d c=%d, rus(%d)=%s, mss=%v",
+	return fmt.Sprintf("p=%s i=%s d=%s a=%d t=%d c=%d, rus(%d)=%s, mss=%v, msr=%v, v=%d",
 		gair.Provider, gair.Interface, gair.Domain,
 		gair.CrtCtxShareAddr, gair.CrtTimeout, gair.NetDevClass,
-		len(gair.ServiceRanks), rankURI, gair.MSRanks,
+		len(gair.ServiceRanks), rankURI, gair.MSRanks, gair.MSReplicas,
+		gair.MapVersion,
 	)
 }
 
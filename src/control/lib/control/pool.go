@@ -45,6 +45,16 @@ type (
 	}
 )
 
+// checkPoolID is a helper function for validating that a pool
+// identifier (label or UUID) has been supplied. Labels are resolved
+// into UUIDs by the Management Service.
+func checkPoolID(id string) error {
+	if id == "" {
+		return errors.New("no pool label or UUID supplied")
+	}
+	return nil
+}
+
 // checkUUID is a helper function for validating that the supplied
 // UUID string parses as a valid UUID.
 func checkUUID(uuidStr string) error {
@@ -135,6 +145,8 @@ type (
 		Ranks     []system.Rank
 		ScmBytes  uint64
 		NvmeBytes uint64
+		// plan placement without creating the pool
+		DryRun bool
 	}
 
 	// PoolCreateResp contains the response from a pool create request.
@@ -144,6 +156,7 @@ type (
 		TgtRanks  []uint32 `json:"tgt_ranks"`
 		ScmBytes  uint64   `json:"scm_bytes"`
 		NvmeBytes uint64   `json:"nvme_bytes"`
+		DryRun    bool     `json:"dry_run"`
 	}
 )
 
@@ -196,6 +209,7 @@ func PoolCreate(ctx context.Context, rpcClient UnaryInvoker, req *PoolCreateReq)
 
 	pcr := new(PoolCreateResp)
 	pcr.UUID = pbReq.Uuid
+	pcr.DryRun = pbReq.Dryrun
 	return pcr, convert.Types(pbPcr, pcr)
 }
 
@@ -243,19 +257,19 @@ func PoolResolveID(ctx context.Context, rpcClient UnaryInvoker, req *PoolResolve
 type PoolDestroyReq struct {
 	msRequest
 	unaryRequest
-	UUID  string
+	ID    string // pool label or UUID
 	Force bool
 }
 
 // PoolDestroy performs a pool destroy operation on a DAOS Management Server instance.
 func PoolDestroy(ctx context.Context, rpcClient UnaryInvoker, req *PoolDestroyReq) error {
-	if err := checkUUID(req.UUID); err != nil {
+	if err := checkPoolID(req.ID); err != nil {
 		return err
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolDestroy(ctx, &mgmtpb.PoolDestroyReq{
 			Sys:   req.getSystem(),
-			Uuid:  req.UUID,
+			Uuid:  req.ID,
 			Force: req.Force,
 		})
 	})
@@ -279,20 +293,20 @@ func PoolDestroy(ctx context.Context, rpcClient UnaryInvoker, req *PoolDestroyRe
 type PoolEvictReq struct {
 	msRequest
 	unaryRequest
-	UUID    string
+	ID      string // pool label or UUID
 	Sys     string
 	Handles []string
 }
 
 // PoolEvict performs a pool connection evict operation on a DAOS Management Server instance.
 func PoolEvict(ctx context.Context, rpcClient UnaryInvoker, req *PoolEvictReq) error {
-	if err := checkUUID(req.UUID); err != nil {
+	if err := checkPoolID(req.ID); err != nil {
 		return err
 	}
 
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolEvict(ctx, &mgmtpb.PoolEvictReq{
-			Uuid:    req.UUID,
+			Uuid:    req.ID,
 			Sys:     req.getSystem(),
 			Handles: req.Handles,
 		})
@@ -318,7 +332,7 @@ type (
 	PoolQueryReq struct {
 		msRequest
 		unaryRequest
-		UUID string
+		ID string // pool label or UUID
 	}
 
 	// StorageUsageStats represents DAOS storage usage statistics.
@@ -378,13 +392,13 @@ func (prs PoolRebuildState) String() string {
 // PoolQuery performs a pool query operation for the specified pool UUID on a
 // DAOS Management Server instance.
 func PoolQuery(ctx context.Context, rpcClient UnaryInvoker, req *PoolQueryReq) (*PoolQueryResp, error) {
-	if err := checkUUID(req.UUID); err != nil {
+	if err := checkPoolID(req.ID); err != nil {
 		return nil, err
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolQuery(ctx, &mgmtpb.PoolQueryReq{
 			Sys:  req.getSystem(),
-			Uuid: req.UUID,
+			Uuid: req.ID,
 		})
 	})
 
@@ -402,8 +416,9 @@ func PoolQuery(ctx context.Context, rpcClient UnaryInvoker, req *PoolQueryReq) (
 type PoolSetPropReq struct {
 	msRequest
 	unaryRequest
-	// UUID identifies the pool for which this property should be set.
-	UUID string
+	// ID identifies the pool (by label or UUID) for which this property
+	// should be set.
+	ID string
 	// Property is always a string representation of the pool property.
 	// It will be resolved into the C representation prior to being
 	// forwarded over dRPC.
@@ -433,7 +448,7 @@ type PoolSetPropResp struct {
 
 // PoolSetProp sends a pool set-prop request to the pool service leader.
 func PoolSetProp(ctx context.Context, rpcClient UnaryInvoker, req *PoolSetPropReq) (*PoolSetPropResp, error) {
-	if err := checkUUID(req.UUID); err != nil {
+	if err := checkPoolID(req.ID); err != nil {
 		return nil, err
 	}
 
@@ -443,7 +458,7 @@ func PoolSetProp(ctx context.Context, rpcClient UnaryInvoker, req *PoolSetPropRe
 
 	pbReq := &mgmtpb.PoolSetPropReq{
 		Sys:  req.getSystem(),
-		Uuid: req.UUID,
+		Uuid: req.ID,
 	}
 	pbReq.SetPropertyName(req.Property)
 
@@ -477,7 +492,7 @@ func PoolSetProp(ctx context.Context, rpcClient UnaryInvoker, req *PoolSetPropRe
 	}
 
 	pspr := &PoolSetPropResp{
-		UUID:     req.UUID,
+		UUID:     req.ID,
 		Property: pbResp.GetName(),
 	}
 
@@ -493,11 +508,89 @@ func PoolSetProp(ctx context.Context, rpcClient UnaryInvoker, req *PoolSetPropRe
 	return pspr, nil
 }
 
+// PoolGetPropReq contains pool get-prop parameters.
+type PoolGetPropReq struct {
+	msRequest
+	unaryRequest
+	// ID identifies the pool (by label or UUID) for which the properties
+	// should be retrieved.
+	ID string
+	// Properties is an optional list of property names to retrieve.
+	// All supported properties are retrieved if the list is empty.
+	Properties []string
+}
+
+// PoolProperty contains the name and value of a single pool property.
+type PoolProperty struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Number   uint32 `json:"number"`
+	NumValue uint64 `json:"numval,omitempty"`
+}
+
+// PoolGetPropResp contains the response to a pool get-prop operation.
+type PoolGetPropResp struct {
+	UUID       string          `json:"uuid"`
+	Properties []*PoolProperty `json:"properties"`
+}
+
+// PoolGetProp sends a pool get-prop request to the pool service leader.
+func PoolGetProp(ctx context.Context, rpcClient UnaryInvoker, req *PoolGetPropReq) (*PoolGetPropResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if err := checkPoolID(req.ID); err != nil {
+		return nil, err
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolGetProp(ctx, &mgmtpb.PoolGetPropReq{
+			Sys:   req.getSystem(),
+			Uuid:  req.ID,
+			Names: req.Properties,
+		})
+	})
+
+	rpcClient.Debugf("DAOS pool get-prop request: %+v\n", req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	pbResp, ok := msResp.(*mgmtpb.PoolGetPropResp)
+	if !ok {
+		return nil, errors.New("unable to extract PoolGetPropResp from MS response")
+	}
+	if pbResp.GetStatus() != 0 {
+		return nil, drpc.DaosStatus(pbResp.GetStatus())
+	}
+
+	resp := &PoolGetPropResp{
+		UUID:       req.ID,
+		Properties: make([]*PoolProperty, 0, len(pbResp.GetProperties())),
+	}
+	for _, prop := range pbResp.GetProperties() {
+		resp.Properties = append(resp.Properties, &PoolProperty{
+			Name:     prop.GetName(),
+			Value:    prop.GetStrval(),
+			Number:   prop.GetNumber(),
+			NumValue: prop.GetNumval(),
+		})
+	}
+
+	return resp, nil
+}
+
 // PoolExcludeReq struct contains request
 type PoolExcludeReq struct {
 	unaryRequest
 	msRequest
-	UUID      string
+	ID        string // pool label or UUID
 	Rank      system.Rank
 	Targetidx []uint32
 }
@@ -508,13 +601,13 @@ type PoolExcludeReq struct {
 // This should automatically start the rebuildiing process.
 // Returns an error (including any DER code from DAOS).
 func PoolExclude(ctx context.Context, rpcClient UnaryInvoker, req *PoolExcludeReq) error {
-	if err := checkUUID(req.UUID); err != nil {
+	if err := checkPoolID(req.ID); err != nil {
 		return err
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolExclude(ctx, &mgmtpb.PoolExcludeReq{
 			Sys:       req.getSystem(),
-			Uuid:      req.UUID,
+			Uuid:      req.ID,
 			Rank:      req.Rank.Uint32(),
 			Targetidx: req.Targetidx,
 		})
@@ -540,7 +633,7 @@ func PoolExclude(ctx context.Context, rpcClient UnaryInvoker, req *PoolExcludeRe
 type PoolDrainReq struct {
 	unaryRequest
 	msRequest
-	UUID      string
+	ID        string // pool label or UUID
 	Rank      system.Rank
 	Targetidx []uint32
 }
@@ -551,13 +644,13 @@ type PoolDrainReq struct {
 // This should automatically start the rebuildiing process.
 // Returns an error (including any DER code from DAOS).
 func PoolDrain(ctx context.Context, rpcClient UnaryInvoker, req *PoolDrainReq) error {
-	if err := checkUUID(req.UUID); err != nil {
+	if err := checkPoolID(req.ID); err != nil {
 		return err
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolDrain(ctx, &mgmtpb.PoolDrainReq{
 			Sys:       req.getSystem(),
-			Uuid:      req.UUID,
+			Uuid:      req.ID,
 			Rank:      req.Rank.Uint32(),
 			Targetidx: req.Targetidx,
 		})
@@ -593,7 +686,7 @@ func genPoolExtendRequest(in *PoolExtendReq) (out *mgmtpb.PoolExtendReq, err err
 type PoolExtendReq struct {
 	unaryRequest
 	msRequest
-	UUID  string
+	ID    string // pool label or UUID
 	Ranks []system.Rank
 	// TEMP SECTION
 	ScmBytes  uint64
@@ -610,7 +703,7 @@ func PoolExtend(ctx context.Context, rpcClient UnaryInvoker, req *PoolExtendReq)
 		return errors.Wrap(err, "failed to generate PoolExtend request")
 	}
 
-	if err := checkUUID(req.UUID); err != nil {
+	if err := checkPoolID(req.ID); err != nil {
 		return err
 	}
 
@@ -637,7 +730,7 @@ func PoolExtend(ctx context.Context, rpcClient UnaryInvoker, req *PoolExtendReq)
 type PoolReintegrateReq struct {
 	unaryRequest
 	msRequest
-	UUID      string
+	ID        string // pool label or UUID
 	Rank      system.Rank
 	Targetidx []uint32
 }
@@ -648,13 +741,13 @@ type PoolReintegrateReq struct {
 // This should automatically start the reintegration process.
 // Returns an error (including any DER code from DAOS).
 func PoolReintegrate(ctx context.Context, rpcClient UnaryInvoker, req *PoolReintegrateReq) error {
-	if err := checkUUID(req.UUID); err != nil {
+	if err := checkPoolID(req.ID); err != nil {
 		return err
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolReintegrate(ctx, &mgmtpb.PoolReintegrateReq{
 			Sys:       req.getSystem(),
-			Uuid:      req.UUID,
+			Uuid:      req.ID,
 			Rank:      req.Rank.Uint32(),
 			Targetidx: req.Targetidx,
 		})
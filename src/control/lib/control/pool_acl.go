@@ -20,7 +20,7 @@ import (
 type PoolGetACLReq struct {
 	unaryRequest
 	msRequest
-	UUID string // pool UUID
+	ID string // pool label or UUID
 }
 
 // PoolGetACLResp contains the output results for PoolGetACL
@@ -30,13 +30,13 @@ type PoolGetACLResp struct {
 
 // PoolGetACL gets the Access Control List for the pool.
 func PoolGetACL(ctx context.Context, rpcClient UnaryInvoker, req *PoolGetACLReq) (*PoolGetACLResp, error) {
-	if err := checkUUID(req.UUID); err != nil {
+	if err := checkPoolID(req.ID); err != nil {
 		return nil, err
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolGetACL(ctx, &mgmtpb.GetACLReq{
 			Sys:  req.getSystem(),
-			Uuid: req.UUID,
+			Uuid: req.ID,
 		})
 	})
 
@@ -57,8 +57,8 @@ func PoolGetACL(ctx context.Context, rpcClient UnaryInvoker, req *PoolGetACLReq)
 type PoolOverwriteACLReq struct {
 	unaryRequest
 	msRequest
-	UUID string             // pool UUID
-	ACL  *AccessControlList // new ACL for the pool
+	ID  string             // pool label or UUID
+	ACL *AccessControlList // new ACL for the pool
 }
 
 // PoolOverwriteACLResp returns the updated ACL for the pool
@@ -70,7 +70,7 @@ type PoolOverwriteACLResp struct {
 // with a new one. If it succeeds, it returns the updated ACL. If not, it returns
 // an error.
 func PoolOverwriteACL(ctx context.Context, rpcClient UnaryInvoker, req *PoolOverwriteACLReq) (*PoolOverwriteACLResp, error) {
-	if err := checkUUID(req.UUID); err != nil {
+	if err := checkPoolID(req.ID); err != nil {
 		return nil, err
 	}
 	if req.ACL.Empty() {
@@ -80,7 +80,7 @@ func PoolOverwriteACL(ctx context.Context, rpcClient UnaryInvoker, req *PoolOver
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolOverwriteACL(ctx, &mgmtpb.ModifyACLReq{
 			Sys:  req.getSystem(),
-			Uuid: req.UUID,
+			Uuid: req.ID,
 			ACL:  req.ACL.Entries,
 		})
 	})
@@ -103,8 +103,8 @@ func PoolOverwriteACL(ctx context.Context, rpcClient UnaryInvoker, req *PoolOver
 type PoolUpdateACLReq struct {
 	unaryRequest
 	msRequest
-	UUID string             // pool UUID
-	ACL  *AccessControlList // ACL entries to add to the pool
+	ID  string             // pool label or UUID
+	ACL *AccessControlList // ACL entries to add to the pool
 }
 
 // PoolUpdateACLResp returns the updated ACL for the pool
@@ -116,7 +116,7 @@ type PoolUpdateACLResp struct {
 // in a pool's Access Control List. If it succeeds, it returns the updated ACL.
 // If not, it returns an error.
 func PoolUpdateACL(ctx context.Context, rpcClient UnaryInvoker, req *PoolUpdateACLReq) (*PoolUpdateACLResp, error) {
-	if err := checkUUID(req.UUID); err != nil {
+	if err := checkPoolID(req.ID); err != nil {
 		return nil, err
 	}
 	if req.ACL.Empty() {
@@ -126,7 +126,7 @@ func PoolUpdateACL(ctx context.Context, rpcClient UnaryInvoker, req *PoolUpdateA
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolUpdateACL(ctx, &mgmtpb.ModifyACLReq{
 			Sys:  req.getSystem(),
-			Uuid: req.UUID,
+			Uuid: req.ID,
 			ACL:  req.ACL.Entries,
 		})
 	})
@@ -148,7 +148,7 @@ func PoolUpdateACL(ctx context.Context, rpcClient UnaryInvoker, req *PoolUpdateA
 type PoolDeleteACLReq struct {
 	unaryRequest
 	msRequest
-	UUID      string // UUID of the pool
+	ID        string // pool label or UUID
 	Principal string // Principal whose entry will be removed
 }
 
@@ -165,14 +165,14 @@ func PoolDeleteACL(ctx context.Context, rpcClient UnaryInvoker, req *PoolDeleteA
 		return nil, errors.New("no principal provided")
 	}
 
-	if err := checkUUID(req.UUID); err != nil {
+	if err := checkPoolID(req.ID); err != nil {
 		return nil, err
 	}
 
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolDeleteACL(ctx, &mgmtpb.DeleteACLReq{
 			Sys:       req.getSystem(),
-			Uuid:      req.UUID,
+			Uuid:      req.ID,
 			Principal: req.Principal,
 		})
 	})
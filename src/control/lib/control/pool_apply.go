@@ -0,0 +1,622 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/mjmac/soad/src/control/common"
+)
+
+// poolSizeDriftPct is the difference, in percent, between the requested and
+// actual size of a pool above which the size is reported as having drifted.
+// Pool sizes are rounded during creation, so an exact match is not expected.
+const poolSizeDriftPct = 1
+
+// Pool properties that may be set from a pool manifest.
+var manifestPoolProperties = []string{"reclaim", "space_rb", "self_heal"}
+
+// PoolApplyAction types.
+const (
+	// PoolApplyCreate indicates that a pool will be created.
+	PoolApplyCreate = "create"
+	// PoolApplyUpdateACL indicates that a pool's ACL will be overwritten.
+	PoolApplyUpdateACL = "update-acl"
+	// PoolApplySetProp indicates that a pool property will be set.
+	PoolApplySetProp = "set-prop"
+	// PoolApplyDestroy indicates that a pool not in the manifest will be
+	// destroyed.
+	PoolApplyDestroy = "destroy"
+	// PoolApplyDrift indicates a difference between the manifest and
+	// the pool which can not be reconciled automatically.
+	PoolApplyDrift = "drift"
+	// PoolApplyUnmanaged indicates a pool that is not in the manifest.
+	PoolApplyUnmanaged = "unmanaged"
+	// PoolApplyUnchanged indicates a pool that matches the manifest.
+	PoolApplyUnchanged = "unchanged"
+)
+
+type (
+	// PoolSpec describes the desired state of a single pool in a pool
+	// manifest. Pools are identified by their label.
+	PoolSpec struct {
+		Label      string            `yaml:"label"`
+		Size       string            `yaml:"size,omitempty"`
+		ScmSize    string            `yaml:"scm_size,omitempty"`
+		NvmeSize   string            `yaml:"nvme_size,omitempty"`
+		NumSvcReps uint32            `yaml:"nsvc,omitempty"`
+		User       string            `yaml:"user,omitempty"`
+		Group      string            `yaml:"group,omitempty"`
+		ACL        []string          `yaml:"acl,omitempty"`
+		Properties map[string]string `yaml:"properties,omitempty"`
+
+		totalBytes uint64
+		scmBytes   uint64
+		nvmeBytes  uint64
+		acl        *AccessControlList
+	}
+
+	// PoolManifest describes the desired set of pools in a DAOS system.
+	PoolManifest struct {
+		Pools []*PoolSpec `yaml:"pools"`
+	}
+)
+
+func parseManifestSize(name, size string) (uint64, error) {
+	if size == "" {
+		return 0, nil
+	}
+	bytes, err := humanize.ParseBytes(size)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s %q", name, size)
+	}
+	if bytes == 0 {
+		return 0, errors.Errorf("invalid %s %q", name, size)
+	}
+	return bytes, nil
+}
+
+func formatPrincipal(name string) string {
+	if name != "" && !strings.Contains(name, "@") {
+		return name + "@"
+	}
+	return name
+}
+
+// Validate checks the pool specification and resolves its sizes and ACL.
+func (ps *PoolSpec) Validate() error {
+	if ps.Label == "" {
+		return errors.New("pool has no label")
+	}
+
+	var err error
+	if ps.totalBytes, err = parseManifestSize("size", ps.Size); err != nil {
+		return err
+	}
+	if ps.scmBytes, err = parseManifestSize("scm_size", ps.ScmSize); err != nil {
+		return err
+	}
+	if ps.nvmeBytes, err = parseManifestSize("nvme_size", ps.NvmeSize); err != nil {
+		return err
+	}
+	switch {
+	case ps.totalBytes > 0 && (ps.scmBytes > 0 || ps.nvmeBytes > 0):
+		return errors.New("size may not be combined with scm_size or nvme_size")
+	case ps.totalBytes == 0 && ps.scmBytes == 0:
+		return errors.New("either size or scm_size must be set")
+	}
+
+	ps.User = formatPrincipal(ps.User)
+	ps.Group = formatPrincipal(ps.Group)
+
+	if len(ps.ACL) > 0 {
+		ps.acl, err = ParseACL(strings.NewReader(strings.Join(ps.ACL, "\n")))
+		if err != nil {
+			return err
+		}
+	}
+
+	for name := range ps.Properties {
+		switch name {
+		case "label":
+			return errors.New("set the pool label with the label field, not as a property")
+		case "owner", "group":
+			return errors.Errorf("set the pool %s with the user and group fields, not as a property", name)
+		}
+		found := false
+		for _, known := range manifestPoolProperties {
+			if name == known {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("unsupported pool property %q (supported: %s)",
+				name, strings.Join(manifestPoolProperties, ", "))
+		}
+	}
+
+	return nil
+}
+
+// Validate checks each pool in the manifest and ensures that pool labels
+// are unique.
+func (pm *PoolManifest) Validate() error {
+	if pm == nil {
+		return errors.New("nil pool manifest")
+	}
+
+	labels := make(map[string]struct{})
+	for i, ps := range pm.Pools {
+		if ps == nil {
+			return errors.Errorf("pool %d: empty pool specification", i)
+		}
+		if err := ps.Validate(); err != nil {
+			if ps.Label == "" {
+				return errors.Wrapf(err, "pool %d", i)
+			}
+			return errors.Wrapf(err, "pool %q", ps.Label)
+		}
+		if _, dupe := labels[ps.Label]; dupe {
+			return errors.Errorf("duplicate pool label %q", ps.Label)
+		}
+		labels[ps.Label] = struct{}{}
+	}
+
+	return nil
+}
+
+// ParsePoolManifest parses and validates a YAML pool manifest.
+func ParsePoolManifest(data []byte) (*PoolManifest, error) {
+	pm := new(PoolManifest)
+	if err := yaml.UnmarshalStrict(data, pm); err != nil {
+		return nil, errors.Wrap(err, "failed to parse pool manifest")
+	}
+	if err := pm.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid pool manifest")
+	}
+
+	return pm, nil
+}
+
+// ReadPoolManifest reads a YAML pool manifest from the given file.
+func ReadPoolManifest(path string) (*PoolManifest, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read pool manifest")
+	}
+
+	return ParsePoolManifest(data)
+}
+
+type (
+	// PoolApplyReq contains the parameters for a pool apply request.
+	PoolApplyReq struct {
+		Manifest *PoolManifest
+		// Plan reports the actions that would be taken without
+		// modifying any pools.
+		Plan bool
+		// Prune destroys pools that are not in the manifest.
+		Prune bool
+	}
+
+	// PoolApplyAction describes an action taken (or planned) in order
+	// to reconcile a pool with the manifest.
+	PoolApplyAction struct {
+		Label  string `json:"label"`
+		UUID   string `json:"uuid,omitempty"`
+		Action string `json:"action"`
+		Detail string `json:"detail,omitempty"`
+		Error  string `json:"error,omitempty"`
+	}
+
+	// PoolApplyResp contains the results of a pool apply request.
+	PoolApplyResp struct {
+		Plan    bool               `json:"plan"`
+		Actions []*PoolApplyAction `json:"actions"`
+	}
+
+	// existingPool records what is known about a pool in the system.
+	existingPool struct {
+		uuid  string
+		label string
+		props map[string]*PoolProperty
+	}
+)
+
+// Failed returns the number of actions that failed.
+func (par *PoolApplyResp) Failed() int {
+	failed := 0
+	for _, a := range par.Actions {
+		if a.Error != "" {
+			failed++
+		}
+	}
+	return failed
+}
+
+// listExistingPools returns the pools in the system with their properties.
+func listExistingPools(ctx context.Context, rpcClient UnaryInvoker) ([]*existingPool, error) {
+	lpr, err := ListPools(ctx, rpcClient, &ListPoolsReq{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list pools")
+	}
+
+	pools := make([]*existingPool, 0, len(lpr.Pools))
+	for _, p := range lpr.Pools {
+		gpr, err := PoolGetProp(ctx, rpcClient, &PoolGetPropReq{ID: p.UUID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get properties of pool %s", p.UUID)
+		}
+		ep := &existingPool{
+			uuid:  p.UUID,
+			props: make(map[string]*PoolProperty),
+		}
+		for _, prop := range gpr.Properties {
+			ep.props[prop.Name] = prop
+		}
+		if label, found := ep.props["label"]; found {
+			ep.label = label.Value
+		}
+		pools = append(pools, ep)
+	}
+
+	return pools, nil
+}
+
+// poolPropMatches returns true if the property has the desired value.
+// Percentages may be given without the trailing "%".
+func poolPropMatches(prop *PoolProperty, value string) bool {
+	if prop == nil {
+		return false
+	}
+	return strings.EqualFold(prop.Value, value) || strings.EqualFold(prop.Value, value+"%")
+}
+
+func sizeDrifted(want, got uint64) bool {
+	var diff uint64
+	if want > got {
+		diff = want - got
+	} else {
+		diff = got - want
+	}
+	return diff*100 > want*poolSizeDriftPct
+}
+
+// normalizeACE parses an ACE in short string format and returns its
+// principal along with a canonical form of the ACE in which the characters
+// of the type, flags and permissions fields are sorted, so that equivalent
+// ACEs compare equal. Malformed ACEs are compared verbatim.
+func normalizeACE(ace string) (principal, norm string) {
+	ace = strings.TrimSpace(ace)
+	fields := strings.Split(ace, ":")
+	if len(fields) != 4 {
+		return ace, ace
+	}
+
+	sortChars := func(field string) string {
+		chars := common.DedupeStringSlice(strings.Split(field, ""))
+		sort.Strings(chars)
+		return strings.Join(chars, "")
+	}
+	fields[0] = sortChars(fields[0])
+	fields[1] = sortChars(fields[1])
+	fields[3] = sortChars(fields[3])
+
+	return fields[2], strings.Join(fields, ":")
+}
+
+// aclMatches compares the ACL in a pool specification with the ACL of an
+// existing pool as a set of normalized ACEs. The OWNER@ and GROUP@ entries
+// added to every pool by default are ignored unless the specification
+// includes an entry for the same principal.
+func aclMatches(want, got *AccessControlList) bool {
+	if got == nil {
+		return false
+	}
+
+	wantACEs := make(map[string]bool)
+	wantPrincipals := make(map[string]bool)
+	for _, ace := range want.Entries {
+		principal, norm := normalizeACE(ace)
+		wantACEs[norm] = true
+		wantPrincipals[principal] = true
+	}
+
+	gotACEs := make(map[string]bool)
+	for _, ace := range got.Entries {
+		principal, norm := normalizeACE(ace)
+		if (principal == "OWNER@" || principal == "GROUP@") && !wantPrincipals[principal] {
+			continue
+		}
+		gotACEs[norm] = true
+	}
+
+	if len(wantACEs) != len(gotACEs) {
+		return false
+	}
+	for ace := range wantACEs {
+		if !gotACEs[ace] {
+			return false
+		}
+	}
+	return true
+}
+
+// planExisting determines the actions needed to reconcile an existing pool
+// with its specification.
+func planExisting(ctx context.Context, rpcClient UnaryInvoker, ps *PoolSpec, ep *existingPool) ([]*PoolApplyAction, error) {
+	var actions []*PoolApplyAction
+	addAction := func(action, detail string) {
+		actions = append(actions, &PoolApplyAction{
+			Label:  ps.Label,
+			UUID:   ep.uuid,
+			Action: action,
+			Detail: detail,
+		})
+	}
+
+	for _, owner := range []struct {
+		name  string
+		value string
+	}{
+		{"owner", ps.User},
+		{"group", ps.Group},
+	} {
+		if owner.value == "" {
+			continue
+		}
+		if prop := ep.props[owner.name]; prop == nil || prop.Value != owner.value {
+			cur := ""
+			if prop != nil {
+				cur = prop.Value
+			}
+			addAction(PoolApplyDrift, fmt.Sprintf("%s is %q, manifest has %q", owner.name, cur, owner.value))
+		}
+	}
+
+	pqr, err := PoolQuery(ctx, rpcClient, &PoolQueryReq{ID: ep.uuid})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query pool %s", ep.uuid)
+	}
+	var scmTotal, nvmeTotal uint64
+	if pqr.Scm != nil {
+		scmTotal = pqr.Scm.Total
+	}
+	if pqr.Nvme != nil {
+		nvmeTotal = pqr.Nvme.Total
+	}
+	// A pool created with a total size gets that much NVMe along with an
+	// additional SCM allocation, or only SCM if there is no NVMe, so the
+	// size is compared with the tier that the total was allocated from.
+	sizeTotal := nvmeTotal
+	if sizeTotal == 0 {
+		sizeTotal = scmTotal
+	}
+	for _, size := range []struct {
+		name      string
+		want, got uint64
+	}{
+		{"size", ps.totalBytes, sizeTotal},
+		{"scm_size", ps.scmBytes, scmTotal},
+		{"nvme_size", ps.nvmeBytes, nvmeTotal},
+	} {
+		if size.want > 0 && sizeDrifted(size.want, size.got) {
+			addAction(PoolApplyDrift, fmt.Sprintf("%s is %s, manifest has %s", size.name,
+				humanize.Bytes(size.got), humanize.Bytes(size.want)))
+		}
+	}
+
+	if ps.acl != nil {
+		gar, err := PoolGetACL(ctx, rpcClient, &PoolGetACLReq{ID: ep.uuid})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get ACL of pool %s", ep.uuid)
+		}
+		if !aclMatches(ps.acl, gar.ACL) {
+			addAction(PoolApplyUpdateACL, fmt.Sprintf("%d entries", len(ps.acl.Entries)))
+		}
+	}
+
+	for _, name := range manifestPoolProperties {
+		value, found := ps.Properties[name]
+		if !found || poolPropMatches(ep.props[name], value) {
+			continue
+		}
+		addAction(PoolApplySetProp, name+"="+value)
+	}
+
+	if len(actions) == 0 {
+		addAction(PoolApplyUnchanged, "")
+	}
+
+	return actions, nil
+}
+
+// planPoolApply determines the actions needed to reconcile the system with
+// the manifest.
+func planPoolApply(ctx context.Context, rpcClient UnaryInvoker, req *PoolApplyReq) ([]*PoolApplyAction, error) {
+	existing, err := listExistingPools(ctx, rpcClient)
+	if err != nil {
+		return nil, err
+	}
+	byLabel := make(map[string]*existingPool)
+	for _, ep := range existing {
+		if ep.label != "" {
+			byLabel[ep.label] = ep
+		}
+	}
+
+	var actions []*PoolApplyAction
+	inManifest := make(map[string]struct{})
+	for _, ps := range req.Manifest.Pools {
+		inManifest[ps.Label] = struct{}{}
+
+		ep, found := byLabel[ps.Label]
+		if found {
+			epActions, err := planExisting(ctx, rpcClient, ps, ep)
+			if err != nil {
+				return nil, err
+			}
+			actions = append(actions, epActions...)
+			continue
+		}
+
+		size := humanize.Bytes(ps.totalBytes)
+		if ps.totalBytes == 0 {
+			size = fmt.Sprintf("%s SCM, %s NVMe", humanize.Bytes(ps.scmBytes),
+				humanize.Bytes(ps.nvmeBytes))
+		}
+		actions = append(actions, &PoolApplyAction{
+			Label:  ps.Label,
+			Action: PoolApplyCreate,
+			Detail: size,
+		})
+		for _, name := range manifestPoolProperties {
+			if value, found := ps.Properties[name]; found {
+				actions = append(actions, &PoolApplyAction{
+					Label:  ps.Label,
+					Action: PoolApplySetProp,
+					Detail: name + "=" + value,
+				})
+			}
+		}
+	}
+
+	for _, ep := range existing {
+		if _, found := inManifest[ep.label]; found && ep.label != "" {
+			continue
+		}
+		action := &PoolApplyAction{
+			Label:  ep.label,
+			UUID:   ep.uuid,
+			Action: PoolApplyUnmanaged,
+			Detail: "not in manifest",
+		}
+		if req.Prune {
+			action.Action = PoolApplyDestroy
+		}
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+// applyPoolAction performs a single planned action.
+func applyPoolAction(ctx context.Context, rpcClient UnaryInvoker, specs map[string]*PoolSpec, action *PoolApplyAction) error {
+	ps := specs[action.Label]
+
+	switch action.Action {
+	case PoolApplyCreate:
+		resp, err := PoolCreate(ctx, rpcClient, &PoolCreateReq{
+			Name:       ps.Label,
+			User:       ps.User,
+			UserGroup:  ps.Group,
+			ACL:        ps.acl,
+			NumSvcReps: ps.NumSvcReps,
+			TotalBytes: ps.totalBytes,
+			ScmBytes:   ps.scmBytes,
+			NvmeBytes:  ps.nvmeBytes,
+		})
+		if err != nil {
+			return err
+		}
+		action.UUID = resp.UUID
+	case PoolApplyUpdateACL:
+		_, err := PoolOverwriteACL(ctx, rpcClient, &PoolOverwriteACLReq{
+			ID:  action.UUID,
+			ACL: ps.acl,
+		})
+		return err
+	case PoolApplySetProp:
+		kv := strings.SplitN(action.Detail, "=", 2)
+		req := &PoolSetPropReq{
+			ID:       action.UUID,
+			Property: kv[0],
+		}
+		req.SetString(kv[1])
+		if numVal, err := strconv.ParseUint(kv[1], 10, 64); err == nil {
+			req.SetNumber(numVal)
+		}
+		_, err := PoolSetProp(ctx, rpcClient, req)
+		return err
+	case PoolApplyDestroy:
+		return PoolDestroy(ctx, rpcClient, &PoolDestroyReq{ID: action.UUID})
+	}
+
+	return nil
+}
+
+// PoolApply reconciles the pools in the system with the supplied manifest.
+// Missing pools are created, and the ACLs and properties of existing pools
+// are updated to match the manifest. Differences that can not be reconciled
+// (e.g. pool size or ownership) are reported as drift. Pools that are not
+// in the manifest are only destroyed if Prune is set. If Plan is set, the
+// actions are reported without modifying any pools.
+//
+// An action that fails is recorded in the response, and any further actions
+// on the same pool are skipped.
+func PoolApply(ctx context.Context, rpcClient UnaryInvoker, req *PoolApplyReq) (*PoolApplyResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if err := req.Manifest.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid pool manifest")
+	}
+
+	actions, err := planPoolApply(ctx, rpcClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &PoolApplyResp{
+		Plan:    req.Plan,
+		Actions: actions,
+	}
+	if req.Plan {
+		return resp, nil
+	}
+
+	specs := make(map[string]*PoolSpec)
+	for _, ps := range req.Manifest.Pools {
+		specs[ps.Label] = ps
+	}
+
+	failed := make(map[string]struct{})
+	var lastUUID string
+	for _, action := range resp.Actions {
+		key := action.Label
+		if key == "" {
+			key = action.UUID
+		}
+		if _, skip := failed[key]; skip {
+			action.Error = "skipped after earlier failure"
+			continue
+		}
+
+		// Actions planned for a new pool apply to the pool created by
+		// the preceding create action.
+		if action.UUID == "" && action.Action != PoolApplyCreate {
+			action.UUID = lastUUID
+		}
+		if err := applyPoolAction(ctx, rpcClient, specs, action); err != nil {
+			action.Error = err.Error()
+			failed[key] = struct{}{}
+		}
+		lastUUID = action.UUID
+	}
+
+	return resp, nil
+}
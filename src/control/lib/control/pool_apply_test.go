@@ -0,0 +1,435 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func TestControl_ParsePoolManifest(t *testing.T) {
+	for name, tc := range map[string]struct {
+		manifest    string
+		expManifest *PoolManifest
+		expErr      error
+	}{
+		"bad yaml": {
+			manifest: "pools: [",
+			expErr:   errors.New("failed to parse"),
+		},
+		"unknown field": {
+			manifest: "pools:\n- label: a\n  size: 1GB\n  color: red\n",
+			expErr:   errors.New("field color not found"),
+		},
+		"missing label": {
+			manifest: "pools:\n- size: 1GB\n",
+			expErr:   errors.New("pool 0: pool has no label"),
+		},
+		"duplicate label": {
+			manifest: "pools:\n- label: a\n  size: 1GB\n- label: a\n  size: 2GB\n",
+			expErr:   errors.New("duplicate pool label \"a\""),
+		},
+		"missing size": {
+			manifest: "pools:\n- label: a\n",
+			expErr:   errors.New("either size or scm_size must be set"),
+		},
+		"mixed sizes": {
+			manifest: "pools:\n- label: a\n  size: 1GB\n  scm_size: 1GB\n",
+			expErr:   errors.New("may not be combined"),
+		},
+		"bad size": {
+			manifest: "pools:\n- label: a\n  size: lots\n",
+			expErr:   errors.New("invalid size \"lots\""),
+		},
+		"label property": {
+			manifest: "pools:\n- label: a\n  size: 1GB\n  properties:\n    label: b\n",
+			expErr:   errors.New("label field"),
+		},
+		"unsupported property": {
+			manifest: "pools:\n- label: a\n  size: 1GB\n  properties:\n    color: red\n",
+			expErr:   errors.New("unsupported pool property \"color\""),
+		},
+		"valid manifest": {
+			manifest: `
+pools:
+- label: a
+  size: 10GB
+  user: alice
+  group: proj@
+  acl:
+  - A::OWNER@:rw
+  - "# comment"
+  properties:
+    reclaim: time
+    space_rb: 5
+- label: b
+  scm_size: 1GB
+  nvme_size: 10GB
+  nsvc: 3
+`,
+			expManifest: &PoolManifest{
+				Pools: []*PoolSpec{
+					{
+						Label: "a",
+						Size:  "10GB",
+						User:  "alice@",
+						Group: "proj@",
+						ACL:   []string{"A::OWNER@:rw", "# comment"},
+						Properties: map[string]string{
+							"reclaim":  "time",
+							"space_rb": "5",
+						},
+						totalBytes: 10 * humanize.GByte,
+						acl:        &AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+					},
+					{
+						Label:      "b",
+						ScmSize:    "1GB",
+						NvmeSize:   "10GB",
+						NumSvcReps: 3,
+						scmBytes:   humanize.GByte,
+						nvmeBytes:  10 * humanize.GByte,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotManifest, gotErr := ParsePoolManifest([]byte(tc.manifest))
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.AllowUnexported(PoolSpec{}),
+			}
+			if diff := cmp.Diff(tc.expManifest, gotManifest, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected manifest (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolApply(t *testing.T) {
+	const (
+		uuidA   = "00000000-0000-0000-0000-00000000000a"
+		uuidOld = "00000000-0000-0000-0000-0000000000ff"
+	)
+
+	manifest := func() *PoolManifest {
+		pm, err := ParsePoolManifest([]byte(`
+pools:
+- label: a
+  size: 10GB
+  user: bob
+  acl:
+  - A::OWNER@:rw
+  properties:
+    reclaim: time
+    space_rb: 5
+- label: c
+  size: 1TB
+  properties:
+    space_rb: 10
+`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pm
+	}
+
+	listResp := MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{Uuid: uuidA},
+			{Uuid: uuidOld},
+		},
+	})
+	propResp := func(label, owner, reclaim string, spaceRb uint64) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.PoolGetPropResp{
+			Properties: []*mgmtpb.PoolProperty{
+				{Name: "label", Number: drpc.PoolPropertyLabel, Strval: label},
+				{Name: "reclaim", Number: drpc.PoolPropertySpaceReclaim, Strval: reclaim},
+				{Name: "space_rb", Number: drpc.PoolPropertyReservedSpace, Numval: spaceRb, Strval: "5%"},
+				{Name: "owner", Number: drpc.PoolPropertyOwner, Strval: owner},
+			},
+		})
+	}
+	queryResp := func(scm, nvme uint64) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+			Uuid: uuidA,
+			Scm:  &mgmtpb.StorageUsageStats{Total: scm},
+			Nvme: &mgmtpb.StorageUsageStats{Total: nvme},
+		})
+	}
+	aclResp := func(entries ...string) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.ACLResp{ACL: entries})
+	}
+	setPropResp := MockMSResponse("host1", nil, &mgmtpb.PoolSetPropResp{
+		Property: &mgmtpb.PoolSetPropResp_Name{Name: "prop"},
+		Value:    &mgmtpb.PoolSetPropResp_Strval{Strval: "val"},
+	})
+
+	planResponses := []*UnaryResponse{
+		listResp,
+		propResp("a", "alice@", "lazy", 5),
+		propResp("old", "alice@", "lazy", 0),
+		queryResp(humanize.GByte, 5*humanize.GByte),
+		aclResp("A::OWNER@:r"),
+	}
+
+	for name, tc := range map[string]struct {
+		mic        *MockInvokerConfig
+		req        *PoolApplyReq
+		expResp    *PoolApplyResp
+		expErr     error
+		expCreated bool
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.PoolApplyReq request"),
+		},
+		"nil manifest": {
+			req:    &PoolApplyReq{},
+			expErr: errors.New("nil pool manifest"),
+		},
+		"list pools fails": {
+			req: &PoolApplyReq{Manifest: manifest()},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("failed to list pools"),
+		},
+		"plan": {
+			req: &PoolApplyReq{Manifest: manifest(), Plan: true},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: planResponses,
+			},
+			expResp: &PoolApplyResp{
+				Plan: true,
+				Actions: []*PoolApplyAction{
+					{Label: "a", UUID: uuidA, Action: PoolApplyDrift, Detail: "owner is \"alice@\", manifest has \"bob@\""},
+					{Label: "a", UUID: uuidA, Action: PoolApplyDrift, Detail: "size is 5.0 GB, manifest has 10 GB"},
+					{Label: "a", UUID: uuidA, Action: PoolApplyUpdateACL, Detail: "1 entries"},
+					{Label: "a", UUID: uuidA, Action: PoolApplySetProp, Detail: "reclaim=time"},
+					{Label: "c", Action: PoolApplyCreate, Detail: "1.0 TB"},
+					{Label: "c", Action: PoolApplySetProp, Detail: "space_rb=10"},
+					{Label: "old", UUID: uuidOld, Action: PoolApplyUnmanaged, Detail: "not in manifest"},
+				},
+			},
+		},
+		"unchanged": {
+			req: &PoolApplyReq{
+				Manifest: func() *PoolManifest {
+					pm := manifest()
+					pm.Pools = pm.Pools[:1]
+					pm.Pools[0].User = "alice@"
+					delete(pm.Pools[0].Properties, "reclaim")
+					return pm
+				}(),
+				Plan: true,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+						Pools: []*mgmtpb.ListPoolsResp_Pool{{Uuid: uuidA}},
+					}),
+					propResp("a", "alice@", "lazy", 5),
+					// 10GB of NVMe plus the default 6% of SCM
+					queryResp(600*humanize.MByte, 10*humanize.GByte),
+					aclResp("A::OWNER@:rw"),
+				},
+			},
+			expResp: &PoolApplyResp{
+				Plan: true,
+				Actions: []*PoolApplyAction{
+					{Label: "a", UUID: uuidA, Action: PoolApplyUnchanged},
+				},
+			},
+		},
+		"unchanged without nvme": {
+			req: &PoolApplyReq{
+				Manifest: func() *PoolManifest {
+					pm := manifest()
+					pm.Pools = pm.Pools[:1]
+					pm.Pools[0].User = "alice@"
+					delete(pm.Pools[0].Properties, "reclaim")
+					return pm
+				}(),
+				Plan: true,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+						Pools: []*mgmtpb.ListPoolsResp_Pool{{Uuid: uuidA}},
+					}),
+					propResp("a", "alice@", "lazy", 5),
+					queryResp(10*humanize.GByte, 0),
+					aclResp("A::OWNER@:rw"),
+				},
+			},
+			expResp: &PoolApplyResp{
+				Plan: true,
+				Actions: []*PoolApplyAction{
+					{Label: "a", UUID: uuidA, Action: PoolApplyUnchanged},
+				},
+			},
+		},
+		"apply with prune": {
+			req: &PoolApplyReq{Manifest: manifest(), Prune: true},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: append(append([]*UnaryResponse{}, planResponses...),
+					aclResp("A::OWNER@:rw"),
+					setPropResp,
+					MockMSResponse("host1", nil, &mgmtpb.PoolCreateResp{}),
+					setPropResp,
+					MockMSResponse("host1", nil, &mgmtpb.PoolDestroyResp{}),
+				),
+			},
+			expCreated: true,
+			expResp: &PoolApplyResp{
+				Actions: []*PoolApplyAction{
+					{Label: "a", UUID: uuidA, Action: PoolApplyDrift, Detail: "owner is \"alice@\", manifest has \"bob@\""},
+					{Label: "a", UUID: uuidA, Action: PoolApplyDrift, Detail: "size is 5.0 GB, manifest has 10 GB"},
+					{Label: "a", UUID: uuidA, Action: PoolApplyUpdateACL, Detail: "1 entries"},
+					{Label: "a", UUID: uuidA, Action: PoolApplySetProp, Detail: "reclaim=time"},
+					{Label: "c", Action: PoolApplyCreate, Detail: "1.0 TB"},
+					{Label: "c", Action: PoolApplySetProp, Detail: "space_rb=10"},
+					{Label: "old", UUID: uuidOld, Action: PoolApplyDestroy, Detail: "not in manifest"},
+				},
+			},
+		},
+		"failure skips pool actions": {
+			req: &PoolApplyReq{Manifest: manifest()},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: append(append([]*UnaryResponse{}, planResponses...),
+					MockMSResponse("host1", errors.New("acl failed"), nil),
+					MockMSResponse("host1", nil, &mgmtpb.PoolCreateResp{}),
+					setPropResp,
+				),
+			},
+			expCreated: true,
+			expResp: &PoolApplyResp{
+				Actions: []*PoolApplyAction{
+					{Label: "a", UUID: uuidA, Action: PoolApplyDrift, Detail: "owner is \"alice@\", manifest has \"bob@\""},
+					{Label: "a", UUID: uuidA, Action: PoolApplyDrift, Detail: "size is 5.0 GB, manifest has 10 GB"},
+					{Label: "a", UUID: uuidA, Action: PoolApplyUpdateACL, Detail: "1 entries", Error: "acl failed"},
+					{Label: "a", UUID: uuidA, Action: PoolApplySetProp, Detail: "reclaim=time", Error: "skipped after earlier failure"},
+					{Label: "c", Action: PoolApplyCreate, Detail: "1.0 TB"},
+					{Label: "c", Action: PoolApplySetProp, Detail: "space_rb=10"},
+					{Label: "old", UUID: uuidOld, Action: PoolApplyUnmanaged, Detail: "not in manifest"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := PoolApply(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			// The UUID of a created pool is generated by the client.
+			for _, action := range gotResp.Actions {
+				if action.Label != "c" {
+					continue
+				}
+				if tc.expCreated && action.UUID == "" {
+					t.Fatalf("expected UUID for created pool action %+v", action)
+				}
+				action.UUID = ""
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_aclMatches(t *testing.T) {
+	for name, tc := range map[string]struct {
+		want     []string
+		got      []string
+		nilGot   bool
+		expMatch bool
+	}{
+		"nil ACL": {
+			want:   []string{"A::OWNER@:rw"},
+			nilGot: true,
+		},
+		"identical": {
+			want:     []string{"A::OWNER@:rw", "A::bob@:r"},
+			got:      []string{"A::OWNER@:rw", "A::bob@:r"},
+			expMatch: true,
+		},
+		"different order": {
+			want:     []string{"A::bob@:r", "A:G:admins@:rw"},
+			got:      []string{"A:G:admins@:rw", "A::bob@:r"},
+			expMatch: true,
+		},
+		"permissions in different order": {
+			want:     []string{"A::bob@:wr"},
+			got:      []string{"A::bob@:rw"},
+			expMatch: true,
+		},
+		"duplicate entries": {
+			want:     []string{"A::bob@:r", " A::bob@:r"},
+			got:      []string{"A::bob@:r"},
+			expMatch: true,
+		},
+		"server default owner entries ignored": {
+			want:     []string{"A::bob@:r"},
+			got:      []string{"A::OWNER@:rw", "A:G:GROUP@:rw", "A::bob@:r"},
+			expMatch: true,
+		},
+		"specified owner entry compared": {
+			want: []string{"A::OWNER@:r", "A::bob@:r"},
+			got:  []string{"A::OWNER@:rw", "A:G:GROUP@:rw", "A::bob@:r"},
+		},
+		"different permissions": {
+			want: []string{"A::bob@:r"},
+			got:  []string{"A::bob@:rw"},
+		},
+		"missing entry": {
+			want: []string{"A::bob@:r", "A::alice@:r"},
+			got:  []string{"A::bob@:r"},
+		},
+		"extra entry": {
+			want: []string{"A::bob@:r"},
+			got:  []string{"A::bob@:r", "A::EVERYONE@:r"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got := &AccessControlList{Entries: tc.got}
+			if tc.nilGot {
+				got = nil
+			}
+
+			gotMatch := aclMatches(&AccessControlList{Entries: tc.want}, got)
+			common.AssertEqual(t, tc.expMatch, gotMatch, "unexpected match result")
+		})
+	}
+}
@@ -29,7 +29,7 @@ func TestControl_PoolDestroy(t *testing.T) {
 	}{
 		"local failure": {
 			req: &PoolDestroyReq{
-				UUID: common.MockUUID(),
+				ID: common.MockUUID(),
 			},
 			mic: &MockInvokerConfig{
 				UnaryError: errors.New("local failed"),
@@ -38,22 +38,20 @@ func TestControl_PoolDestroy(t *testing.T) {
 		},
 		"remote failure": {
 			req: &PoolDestroyReq{
-				UUID: common.MockUUID(),
+				ID: common.MockUUID(),
 			},
 			mic: &MockInvokerConfig{
 				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
 			},
 			expErr: errors.New("remote failed"),
 		},
-		"invalid UUID": {
-			req: &PoolDestroyReq{
-				UUID: "bad",
-			},
-			expErr: errors.New("invalid UUID"),
+		"missing pool ID": {
+			req:    &PoolDestroyReq{},
+			expErr: errors.New("no pool label or UUID"),
 		},
 		"success": {
 			req: &PoolDestroyReq{
-				UUID: common.MockUUID(),
+				ID: common.MockUUID(),
 			},
 			mic: &MockInvokerConfig{
 				UnaryResponse: MockMSResponse("host1", nil,
@@ -91,7 +89,7 @@ func TestControl_PoolDrain(t *testing.T) {
 	}{
 		"local failure": {
 			req: &PoolDrainReq{
-				UUID:      common.MockUUID(),
+				ID:        common.MockUUID(),
 				Rank:      2,
 				Targetidx: []uint32{1, 2, 3},
 			},
@@ -102,7 +100,7 @@ func TestControl_PoolDrain(t *testing.T) {
 		},
 		"remote failure": {
 			req: &PoolDrainReq{
-				UUID:      common.MockUUID(),
+				ID:        common.MockUUID(),
 				Rank:      2,
 				Targetidx: []uint32{1, 2, 3},
 			},
@@ -111,17 +109,16 @@ func TestControl_PoolDrain(t *testing.T) {
 			},
 			expErr: errors.New("remote failed"),
 		},
-		"invalid UUID": {
+		"missing pool ID": {
 			req: &PoolDrainReq{
-				UUID:      "bad",
 				Rank:      2,
 				Targetidx: []uint32{1, 2, 3},
 			},
-			expErr: errors.New("invalid UUID"),
+			expErr: errors.New("no pool label or UUID"),
 		},
 		"success": {
 			req: &PoolDrainReq{
-				UUID:      common.MockUUID(),
+				ID:        common.MockUUID(),
 				Rank:      2,
 				Targetidx: []uint32{1, 2, 3},
 			},
@@ -161,7 +158,7 @@ func TestControl_PoolEvict(t *testing.T) {
 	}{
 		"local failure": {
 			req: &PoolEvictReq{
-				UUID: common.MockUUID(),
+				ID: common.MockUUID(),
 			},
 			mic: &MockInvokerConfig{
 				UnaryError: errors.New("local failed"),
@@ -170,22 +167,20 @@ func TestControl_PoolEvict(t *testing.T) {
 		},
 		"remote failure": {
 			req: &PoolEvictReq{
-				UUID: common.MockUUID(),
+				ID: common.MockUUID(),
 			},
 			mic: &MockInvokerConfig{
 				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
 			},
 			expErr: errors.New("remote failed"),
 		},
-		"invalid UUID": {
-			req: &PoolEvictReq{
-				UUID: "bad",
-			},
-			expErr: errors.New("invalid UUID"),
+		"missing pool ID": {
+			req:    &PoolEvictReq{},
+			expErr: errors.New("no pool label or UUID"),
 		},
 		"success": {
 			req: &PoolEvictReq{
-				UUID: common.MockUUID(),
+				ID: common.MockUUID(),
 			},
 			mic: &MockInvokerConfig{
 				UnaryResponse: MockMSResponse("host1", nil,
@@ -301,6 +296,26 @@ func TestControl_PoolCreate(t *testing.T) {
 				TgtRanks: []uint32{0, 1, 2},
 			},
 		},
+		"dry run": {
+			req: &PoolCreateReq{TotalBytes: 10, DryRun: true},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolCreateResp{
+						SvcReps:   []uint32{1},
+						TgtRanks:  []uint32{0, 1},
+						ScmBytes:  5,
+						NvmeBytes: 10,
+					},
+				),
+			},
+			expResp: &PoolCreateResp{
+				SvcReps:   []uint32{1},
+				TgtRanks:  []uint32{0, 1},
+				ScmBytes:  5,
+				NvmeBytes: 10,
+				DryRun:    true,
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -347,11 +362,9 @@ func TestControl_PoolQuery(t *testing.T) {
 			},
 			expErr: errors.New("remote failed"),
 		},
-		"invalid UUID": {
-			req: &PoolQueryReq{
-				UUID: "bad",
-			},
-			expErr: errors.New("invalid UUID"),
+		"missing pool ID": {
+			req:    &PoolQueryReq{},
+			expErr: errors.New("no pool label or UUID"),
 		},
 		"query succeeds": {
 			mic: &MockInvokerConfig{
@@ -419,7 +432,7 @@ func TestControl_PoolQuery(t *testing.T) {
 			req := tc.req
 			if req == nil {
 				req = &PoolQueryReq{
-					UUID: common.MockUUID(),
+					ID: common.MockUUID(),
 				}
 			}
 			mic := tc.mic
@@ -443,6 +456,99 @@ func TestControl_PoolQuery(t *testing.T) {
 	}
 }
 
+func TestControl_PoolGetProp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolGetPropReq
+		expResp *PoolGetPropResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.PoolGetPropReq request"),
+		},
+		"missing pool ID": {
+			req:    &PoolGetPropReq{},
+			expErr: errors.New("no pool label or UUID"),
+		},
+		"local failure": {
+			req: &PoolGetPropReq{ID: common.MockUUID()},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolGetPropReq{ID: common.MockUUID()},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"wrong response message": {
+			req: &PoolGetPropReq{ID: common.MockUUID()},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &MockMessage{}),
+			},
+			expErr: errors.New("unable to extract"),
+		},
+		"engine failure": {
+			req: &PoolGetPropReq{ID: common.MockUUID()},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolGetPropResp{Status: int32(drpc.DaosNonexistant)},
+				),
+			},
+			expErr: drpc.DaosNonexistant,
+		},
+		"success": {
+			req: &PoolGetPropReq{
+				ID:         common.MockUUID(),
+				Properties: []string{"label", "space_rb"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolGetPropResp{
+						Properties: []*mgmtpb.PoolProperty{
+							{Name: "label", Number: drpc.PoolPropertyLabel, Strval: "foo"},
+							{Name: "space_rb", Number: drpc.PoolPropertyReservedSpace, Numval: 5, Strval: "5%"},
+						},
+					},
+				),
+			},
+			expResp: &PoolGetPropResp{
+				UUID: common.MockUUID(),
+				Properties: []*PoolProperty{
+					{Name: "label", Number: drpc.PoolPropertyLabel, Value: "foo"},
+					{Name: "space_rb", Number: drpc.PoolPropertyReservedSpace, NumValue: 5, Value: "5%"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := context.TODO()
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := PoolGetProp(ctx, mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("Unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestPoolSetProp(t *testing.T) {
 	const (
 		testPropName          = "test-prop"
@@ -450,7 +556,7 @@ func TestPoolSetProp(t *testing.T) {
 		testPropValNum uint64 = 42
 	)
 	defaultReq := &PoolSetPropReq{
-		UUID:     common.MockUUID(),
+		ID:       common.MockUUID(),
 		Property: testPropName,
 		Value:    testPropValStr,
 	}
@@ -473,22 +579,20 @@ func TestPoolSetProp(t *testing.T) {
 			},
 			expErr: errors.New("remote failed"),
 		},
-		"invalid UUID": {
-			req: &PoolSetPropReq{
-				UUID: "bad",
-			},
-			expErr: errors.New("invalid UUID"),
+		"missing pool ID": {
+			req:    &PoolSetPropReq{},
+			expErr: errors.New("no pool label or UUID"),
 		},
 		"empty request property": {
 			req: &PoolSetPropReq{
-				UUID:     common.MockUUID(),
+				ID:       common.MockUUID(),
 				Property: "",
 			},
 			expErr: errors.New("invalid property name"),
 		},
 		"invalid request value": {
 			req: &PoolSetPropReq{
-				UUID:     common.MockUUID(),
+				ID:       common.MockUUID(),
 				Property: testPropName,
 			},
 			expErr: errors.New("unhandled property value"),
@@ -537,7 +641,7 @@ func TestPoolSetProp(t *testing.T) {
 				),
 			},
 			req: &PoolSetPropReq{
-				UUID:     common.MockUUID(),
+				ID:       common.MockUUID(),
 				Property: testPropName,
 				Value:    testPropValStr,
 			},
@@ -561,7 +665,7 @@ func TestPoolSetProp(t *testing.T) {
 				),
 			},
 			req: &PoolSetPropReq{
-				UUID:     common.MockUUID(),
+				ID:       common.MockUUID(),
 				Property: testPropName,
 				Value:    testPropValNum,
 			},
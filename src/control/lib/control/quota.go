@@ -0,0 +1,167 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+)
+
+type (
+	// Quota describes the limit on, and current usage of, the total pool
+	// capacity owned by a user or group. A zero limit indicates that no
+	// quota has been set.
+	Quota struct {
+		User     string `json:"user,omitempty"`
+		Group    string `json:"group,omitempty"`
+		Limit    uint64 `json:"limit"`
+		Pools    uint32 `json:"pools"`
+		ScmUsed  uint64 `json:"scm_used"`
+		NvmeUsed uint64 `json:"nvme_used"`
+	}
+
+	// QuotaResp contains a set of quotas.
+	QuotaResp struct {
+		Quotas []*Quota `json:"quotas"`
+	}
+
+	// QuotaSetReq contains the parameters for a quota set request.
+	QuotaSetReq struct {
+		unaryRequest
+		msRequest
+		User  string
+		Group string
+		Limit uint64 // zero removes the quota
+	}
+
+	// QuotaGetReq contains the parameters for a quota get request.
+	QuotaGetReq struct {
+		unaryRequest
+		msRequest
+		User  string
+		Group string
+	}
+
+	// QuotaListReq contains the parameters for a quota list request.
+	QuotaListReq struct {
+		unaryRequest
+		msRequest
+	}
+)
+
+// Used returns the total pool capacity in use.
+func (q *Quota) Used() uint64 {
+	return q.ScmUsed + q.NvmeUsed
+}
+
+// formatQuotaPrincipal checks that exactly one of user or group is set and
+// formats it in the same way as pool owners are formatted.
+func formatQuotaPrincipal(user, group string) (string, string, error) {
+	if (user == "") == (group == "") {
+		return "", "", errors.New("exactly one of user or group must be specified")
+	}
+
+	for _, name := range []*string{&user, &group} {
+		if *name != "" && !strings.Contains(*name, "@") {
+			*name += "@"
+		}
+	}
+
+	return user, group, nil
+}
+
+// QuotaSet sets the limit on the total pool capacity that may be owned by
+// a user or group. Pool create and extend requests that would exceed the
+// limit are rejected. A zero limit removes the quota.
+func QuotaSet(ctx context.Context, rpcClient UnaryInvoker, req *QuotaSetReq) (*QuotaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	user, group, err := formatQuotaPrincipal(req.User, req.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	pbReq := &mgmtpb.QuotaSetReq{
+		Sys:   req.getSystem(),
+		User:  user,
+		Group: group,
+		Limit: req.Limit,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).QuotaSet(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS quota set request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(QuotaResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// QuotaGet returns the quota and current pool capacity usage of a user
+// or group.
+func QuotaGet(ctx context.Context, rpcClient UnaryInvoker, req *QuotaGetReq) (*QuotaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	user, group, err := formatQuotaPrincipal(req.User, req.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	pbReq := &mgmtpb.QuotaGetReq{
+		Sys:   req.getSystem(),
+		User:  user,
+		Group: group,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).QuotaGet(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS quota get request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(QuotaResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// QuotaList returns all quotas along with the current pool capacity usage
+// of each user or group.
+func QuotaList(ctx context.Context, rpcClient UnaryInvoker, req *QuotaListReq) (*QuotaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.QuotaListReq{Sys: req.getSystem()}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).QuotaList(ctx, pbReq)
+	})
+	rpcClient.Debug("DAOS quota list request")
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(QuotaResp)
+	return resp, convertMSResponse(ur, resp)
+}
@@ -0,0 +1,240 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func TestControl_formatQuotaPrincipal(t *testing.T) {
+	for name, tc := range map[string]struct {
+		user     string
+		group    string
+		expUser  string
+		expGroup string
+		expErr   error
+	}{
+		"neither": {
+			expErr: errors.New("exactly one of user or group"),
+		},
+		"both": {
+			user:   "foo",
+			group:  "grp",
+			expErr: errors.New("exactly one of user or group"),
+		},
+		"user": {
+			user:    "foo",
+			expUser: "foo@",
+		},
+		"user with domain": {
+			user:    "foo@example.com",
+			expUser: "foo@example.com",
+		},
+		"group": {
+			group:    "grp",
+			expGroup: "grp@",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotUser, gotGroup, gotErr := formatQuotaPrincipal(tc.user, tc.group)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			common.AssertEqual(t, tc.expUser, gotUser, "unexpected user")
+			common.AssertEqual(t, tc.expGroup, gotGroup, "unexpected group")
+		})
+	}
+}
+
+func TestControl_QuotaSet(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *QuotaSetReq
+		uErr    error
+		uResp   *UnaryResponse
+		expResp *QuotaResp
+		expErr  error
+	}{
+		"nil req": {
+			req:    nil,
+			expErr: errors.New("nil *control.QuotaSetReq request"),
+		},
+		"no principal": {
+			req:    &QuotaSetReq{Limit: 1},
+			expErr: errors.New("exactly one of user or group"),
+		},
+		"local failure": {
+			req:    &QuotaSetReq{User: "foo", Limit: 1},
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req:    &QuotaSetReq{User: "foo", Limit: 1},
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &QuotaSetReq{User: "foo", Limit: 100},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.QuotaResp{
+				Quotas: []*mgmtpb.Quota{
+					{User: "foo@", Limit: 100, Pools: 1, ScmUsed: 2, NvmeUsed: 20},
+				},
+			}),
+			expResp: &QuotaResp{
+				Quotas: []*Quota{
+					{User: "foo@", Limit: 100, Pools: 1, ScmUsed: 2, NvmeUsed: 20},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := QuotaSet(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_QuotaGet(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *QuotaGetReq
+		uErr    error
+		uResp   *UnaryResponse
+		expResp *QuotaResp
+		expErr  error
+	}{
+		"nil req": {
+			req:    nil,
+			expErr: errors.New("nil *control.QuotaGetReq request"),
+		},
+		"both principals": {
+			req:    &QuotaGetReq{User: "foo", Group: "grp"},
+			expErr: errors.New("exactly one of user or group"),
+		},
+		"local failure": {
+			req:    &QuotaGetReq{Group: "grp"},
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"success": {
+			req: &QuotaGetReq{Group: "grp"},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.QuotaResp{
+				Quotas: []*mgmtpb.Quota{
+					{Group: "grp@", Pools: 2, ScmUsed: 4, NvmeUsed: 40},
+				},
+			}),
+			expResp: &QuotaResp{
+				Quotas: []*Quota{
+					{Group: "grp@", Pools: 2, ScmUsed: 4, NvmeUsed: 40},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := QuotaGet(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_QuotaList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *QuotaListReq
+		uErr    error
+		uResp   *UnaryResponse
+		expResp *QuotaResp
+		expErr  error
+	}{
+		"nil req": {
+			req:    nil,
+			expErr: errors.New("nil *control.QuotaListReq request"),
+		},
+		"remote failure": {
+			req:    new(QuotaListReq),
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"no quotas": {
+			req:     new(QuotaListReq),
+			uResp:   MockMSResponse("host1", nil, &mgmtpb.QuotaResp{}),
+			expResp: &QuotaResp{},
+		},
+		"success": {
+			req: new(QuotaListReq),
+			uResp: MockMSResponse("host1", nil, &mgmtpb.QuotaResp{
+				Quotas: []*mgmtpb.Quota{
+					{User: "foo@", Limit: 100, Pools: 1, ScmUsed: 2, NvmeUsed: 20},
+					{Group: "grp@", Limit: 1000, Pools: 2, ScmUsed: 4, NvmeUsed: 40},
+				},
+			}),
+			expResp: &QuotaResp{
+				Quotas: []*Quota{
+					{User: "foo@", Limit: 100, Pools: 1, ScmUsed: 2, NvmeUsed: 20},
+					{Group: "grp@", Limit: 1000, Pools: 2, ScmUsed: 4, NvmeUsed: 40},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := QuotaList(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
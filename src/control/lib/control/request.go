@@ -68,6 +68,21 @@ type (
 		retryer
 		unaryRPCGetter
 	}
+
+	// streamRetryer defines an interface to be implemented by
+	// stream requests that may be safely re-invoked after the
+	// stream has been opened.
+	streamRetryer interface {
+		canRetryStream() bool
+	}
+
+	// StreamRequest defines an interface to be implemented by
+	// server-streaming request types (N responses to 1 request).
+	StreamRequest interface {
+		targetChooser
+		streamRetryer
+		streamRPCGetter
+	}
 )
 
 // request is an embeddable struct to provide basic functionality
@@ -128,6 +143,13 @@ func (r *request) isMSRequest() bool {
 	return false
 }
 
+// canRetryStream implements the streamRetryer interface and always
+// returns false, indicating that the default stream request is not
+// idempotent and must not be re-invoked once it has been accepted.
+func (r *request) canRetryStream() bool {
+	return false
+}
+
 // canRetry implements the retryer interface and always
 // returns false, indicating that the default request implementation
 // is not retryable.
@@ -166,6 +188,18 @@ func (r *msRequest) isMSRequest() bool {
 	return true
 }
 
+// retryableStreamRequest is an embeddable struct to implement the
+// streamRetryer interface and will always return true. Should only be
+// embedded in stream request types which are idempotent, i.e. for which
+// re-opening the stream has no side effects (e.g. event subscriptions).
+type retryableStreamRequest struct{}
+
+// canRetryStream implements the streamRetryer interface, and will
+// always return true for a retryableStreamRequest.
+func (r *retryableStreamRequest) canRetryStream() bool {
+	return true
+}
+
 // retryableRequest is the default implementation of the retryer interface.
 type retryableRequest struct {
 	// retryTimeout sets an optional timeout for each retry.
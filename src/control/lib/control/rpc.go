@@ -49,6 +49,17 @@ type (
 		getRPC() unaryRPC
 	}
 
+	// streamRPC defines the function signature for a closure that invokes
+	// a server-streaming gRPC method and passes each received protobuf
+	// message to the supplied callback.
+	streamRPC func(context.Context, *grpc.ClientConn, func(proto.Message) error) error
+
+	// streamRPCGetter defines the interface to be implemented by
+	// requests that can invoke a server-streaming gRPC method.
+	streamRPCGetter interface {
+		getStreamRPC() streamRPC
+	}
+
 	// UnaryInvoker defines an interface to be implemented by clients
 	// capable of invoking a unary RPC (1 response for 1 request).
 	UnaryInvoker interface {
@@ -57,11 +68,18 @@ type (
 		InvokeUnaryRPCAsync(ctx context.Context, req UnaryRequest) (HostResponseChan, error)
 	}
 
+	// StreamInvoker defines an interface to be implemented by clients
+	// capable of invoking a server-streaming RPC (N responses for 1 request).
+	StreamInvoker interface {
+		debugLogger
+		InvokeStreamRPC(ctx context.Context, req StreamRequest, recv func(proto.Message) error) error
+	}
+
 	// Invoker defines an interface to be implemented by clients
 	// capable of invoking unary or stream RPCs.
 	Invoker interface {
 		UnaryInvoker
-		//TODO: StreamInvoker
+		InvokeStreamRPC(ctx context.Context, req StreamRequest, recv func(proto.Message) error) error
 		SetConfig(*Config)
 	}
 )
@@ -83,12 +101,30 @@ func (r *unaryRequest) setRPC(rpc unaryRPC) {
 	r.rpc = rpc
 }
 
+// streamRequest is an embeddable struct to be used by requests which
+// implement the StreamRequest interface.
+type streamRequest struct {
+	request
+	rpc streamRPC
+}
+
+// getStreamRPC returns the request's stream RPC closure.
+func (r *streamRequest) getStreamRPC() streamRPC {
+	return r.rpc
+}
+
+// setStreamRPC sets the request's stream RPC closure.
+func (r *streamRequest) setStreamRPC(rpc streamRPC) {
+	r.rpc = rpc
+}
+
 type (
 	// Client implements the Invoker interface and should be provided to
 	// API methods to invoke RPCs.
 	Client struct {
-		config *Config
-		log    debugLogger
+		cfgMutex sync.RWMutex
+		config   *Config
+		log      debugLogger
 	}
 
 	// ClientOption defines the signature for functional Client options.
@@ -139,9 +175,19 @@ func DefaultClient() *Client {
 // SetConfig sets the client configuration for an
 // existing Client.
 func (c *Client) SetConfig(cfg *Config) {
+	c.cfgMutex.Lock()
+	defer c.cfgMutex.Unlock()
 	c.config = cfg
 }
 
+// getConfig returns the current client configuration, which may be
+// replaced while RPCs are being invoked.
+func (c *Client) getConfig() *Config {
+	c.cfgMutex.RLock()
+	defer c.cfgMutex.RUnlock()
+	return c.config
+}
+
 func (c *Client) Debug(msg string) {
 	c.log.Debug(msg)
 }
@@ -159,7 +205,7 @@ func (c *Client) dialOptions() ([]grpc.DialOption, error) {
 		grpc.FailOnNonTempDialError(true),
 	}
 
-	creds, err := security.DialOptionForTransportConfig(c.config.TransportConfig)
+	creds, err := security.DialOptionForTransportConfig(c.getConfig().TransportConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -188,7 +234,7 @@ func setDeadlineIfUnset(parent context.Context, req UnaryRequest) (context.Conte
 // provides access to a stream of HostResponse items as they are received, and
 // is closed when no more responses are expected.
 func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (HostResponseChan, error) {
-	hosts, err := getRequestHosts(c.config, req)
+	hosts, err := getRequestHosts(c.getConfig(), req)
 	if err != nil {
 		return nil, err
 	}
@@ -392,5 +438,99 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 // items which represent the success or failure of the RPC invocation for each host
 // in the request.
 func (c *Client) InvokeUnaryRPC(ctx context.Context, req UnaryRequest) (*UnaryResponse, error) {
-	return invokeUnaryRPC(ctx, c.log, c, req, c.config.HostList)
+	return invokeUnaryRPC(ctx, c.log, c, req, c.getConfig().HostList)
+}
+
+// invokeStreamRPC dials the given host and invokes the request's stream RPC.
+func (c *Client) invokeStreamRPC(ctx context.Context, hostAddr string, req StreamRequest, recv func(proto.Message) error) error {
+	opts, err := c.dialOptions()
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.DialContext(ctx, hostAddr, opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return req.getStreamRPC()(ctx, conn, recv)
+}
+
+// InvokeStreamRPC performs a synchronous (blocking) invocation of the request's
+// server-streaming RPC, passing each received message to the supplied callback
+// until the stream is closed, the context is canceled, or the callback returns
+// an error.
+//
+// MS requests which are rejected because the host is not the MS leader are
+// redirected to the current MS leader. Only requests which are safe to
+// re-invoke (e.g. event subscriptions) are retried after a connection error
+// or once the first response has been received, so that the stream is resumed
+// if MS leadership changes while it is open. Messages sent while the stream is
+// being re-established are not received. For all other requests, such errors
+// are returned to the caller, as the request may already have taken effect.
+func (c *Client) InvokeStreamRPC(ctx context.Context, req StreamRequest, recv func(proto.Message) error) error {
+	allHosts, err := getRequestHosts(c.getConfig(), req)
+	if err != nil {
+		return err
+	}
+	hosts := allHosts
+
+	var received bool
+	recvFn := func(msg proto.Message) error {
+		received = true
+		return recv(msg)
+	}
+
+	var try uint = 0
+	for {
+		hostAddr := hosts[int(try)%len(hosts)]
+		c.Debugf("stream request host: %s", hostAddr)
+
+		err := c.invokeStreamRPC(ctx, hostAddr, req, recvFn)
+		if err == nil || ctx.Err() != nil || !req.isMSRequest() {
+			return err
+		}
+		if received && !req.canRetryStream() {
+			return err
+		}
+
+		switch e := errors.Cause(err).(type) {
+		case *system.ErrNotLeader:
+			candidates := e.Replicas
+			if e.LeaderHint != "" {
+				candidates = []string{e.LeaderHint}
+			}
+			if len(candidates) > 0 {
+				if hosts, err = common.ParseHostList(candidates, c.getConfig().ControlPort); err != nil {
+					return err
+				}
+			}
+		case *system.ErrNotReplica:
+			if len(e.Replicas) > 0 {
+				if hosts, err = common.ParseHostList(e.Replicas, c.getConfig().ControlPort); err != nil {
+					return err
+				}
+			}
+		default:
+			// If the host could not be reached (e.g. because the MS
+			// leader went down), start again with the full list.
+			if !req.canRetryStream() {
+				return err
+			}
+			if !IsConnectionError(err) && !system.IsUnavailable(err) {
+				return err
+			}
+			hosts = allHosts
+		}
+
+		backoff := common.ExpBackoff(baseMSBackoff, uint64(try), maxMSBackoffFactor)
+		c.Debugf("MS stream error: %v; retrying after %s", err, backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		try++
+	}
 }
@@ -68,6 +68,34 @@ func (tr *testRequest) getRPC() unaryRPC {
 	return rpcFn
 }
 
+type testStreamRequest struct {
+	request
+	rpcFn     streamRPC
+	toMS      bool
+	retryable bool
+	HostList  []string
+}
+
+func (tr *testStreamRequest) isMSRequest() bool {
+	return tr.toMS
+}
+
+func (tr *testStreamRequest) canRetryStream() bool {
+	return tr.retryable
+}
+
+func (tr *testStreamRequest) SetHostList(hl []string) {
+	tr.HostList = hl
+}
+
+func (tr *testStreamRequest) getHostList() []string {
+	return tr.HostList
+}
+
+func (tr *testStreamRequest) getStreamRPC() streamRPC {
+	return tr.rpcFn
+}
+
 type ctxCancel struct {
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -473,3 +501,114 @@ func TestControl_InvokeUnaryRPC(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_InvokeStreamRPC(t *testing.T) {
+	clientCfg := DefaultConfig()
+	clientCfg.TransportConfig.AllowInsecure = true
+	clientCfg.HostList = []string{"host01:10001", "host02:10001", "host03:10001"}
+
+	leaderHost := "host03:10001"
+	errNotLeader := &system.ErrNotLeader{
+		LeaderHint: leaderHost,
+	}
+
+	// genRpcFn returns a stream RPC which sends the given number of
+	// messages on each call before returning the error for that call.
+	genRpcFn := func(calls *int, msgs []int, errs []error) streamRPC {
+		return func(_ context.Context, cc *grpc.ClientConn, recv func(proto.Message) error) error {
+			call := *calls
+			*calls++
+			if errs[call] == errNotLeader && cc.Target() == leaderHost {
+				return nil
+			}
+			for i := 0; i < msgs[call]; i++ {
+				if err := recv(defaultMessage); err != nil {
+					return err
+				}
+			}
+			return errs[call]
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		toMS      bool
+		retryable bool
+		msgs      []int
+		errs      []error
+		expCalls  int
+		expMsgs   int
+		expErr    error
+	}{
+		"non-MS request is not retried": {
+			msgs:     []int{0},
+			errs:     []error{system.ErrRaftUnavail},
+			expCalls: 1,
+			expErr:   system.ErrRaftUnavail,
+		},
+		"MS request is redirected to leader": {
+			toMS:     true,
+			msgs:     []int{0, 0},
+			errs:     []error{errNotLeader, errNotLeader},
+			expCalls: 2,
+		},
+		"MS request is not retried when unavailable": {
+			toMS:     true,
+			msgs:     []int{0},
+			errs:     []error{system.ErrRaftUnavail},
+			expCalls: 1,
+			expErr:   system.ErrRaftUnavail,
+		},
+		"MS request is not retried after first response": {
+			toMS:     true,
+			msgs:     []int{2},
+			errs:     []error{errNotLeader},
+			expCalls: 1,
+			expMsgs:  2,
+			expErr:   errNotLeader,
+		},
+		"retryable MS request is resumed after first response": {
+			toMS:      true,
+			retryable: true,
+			msgs:      []int{2, 1},
+			errs:      []error{system.ErrRaftUnavail, nil},
+			expCalls:  2,
+			expMsgs:   3,
+		},
+		"retryable MS request is not retried on other errors": {
+			toMS:      true,
+			retryable: true,
+			msgs:      []int{1},
+			errs:      []error{errors.New("whoops")},
+			expCalls:  1,
+			expMsgs:   1,
+			expErr:    errors.New("whoops"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer common.ShowBufferOnFailure(t, buf)
+
+			client := NewClient(
+				WithConfig(clientCfg),
+				WithClientLogger(log),
+			)
+
+			var calls int
+			req := &testStreamRequest{
+				toMS:      tc.toMS,
+				retryable: tc.retryable,
+				rpcFn:     genRpcFn(&calls, tc.msgs, tc.errs),
+			}
+
+			var gotMsgs int
+			gotErr := client.InvokeStreamRPC(context.TODO(), req, func(_ proto.Message) error {
+				gotMsgs++
+				return nil
+			})
+			common.CmpErr(t, tc.expErr, gotErr)
+
+			common.AssertEqual(t, tc.expCalls, calls, "unexpected number of stream invocations")
+			common.AssertEqual(t, tc.expMsgs, gotMsgs, "unexpected number of messages received")
+		})
+	}
+}
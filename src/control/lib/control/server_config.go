@@ -0,0 +1,85 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+)
+
+type (
+	// ReloadServerConfigReq contains the inputs for the reload server
+	// config request.
+	ReloadServerConfigReq struct {
+		unaryRequest
+	}
+
+	// HostConfigChanges describes the server config file changes found on
+	// a host during a reload.
+	HostConfigChanges struct {
+		Addr            string   `json:"addr"`
+		Applied         []string `json:"applied"`
+		RestartRequired []string `json:"restart_required"`
+	}
+
+	// ReloadServerConfigResp contains the results of a reload server
+	// config request.
+	ReloadServerConfigResp struct {
+		HostErrorsResp
+		HostChanges []*HostConfigChanges `json:"host_changes"`
+	}
+)
+
+// ReloadServerConfig requests that the servers on all hosts supplied in the
+// request's hostlist, or all configured hosts if not explicitly specified,
+// re-read their config files and apply changes that are safe to make without
+// a restart. Changes that require a restart are reported for each host.
+func ReloadServerConfig(ctx context.Context, rpcClient UnaryInvoker, req *ReloadServerConfigReq) (*ReloadServerConfigResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).ReloadConfig(ctx, &ctlpb.ReloadConfigReq{})
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(ReloadServerConfigResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hostResp.Message.(*ctlpb.ReloadConfigResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hostResp.Message)
+		}
+		resp.HostChanges = append(resp.HostChanges, &HostConfigChanges{
+			Addr:            hostResp.Addr,
+			Applied:         pbResp.Applied,
+			RestartRequired: pbResp.RestartRequired,
+		})
+	}
+	sort.Slice(resp.HostChanges, func(i, j int) bool {
+		return resp.HostChanges[i].Addr < resp.HostChanges[j].Addr
+	})
+
+	return resp, nil
+}
@@ -0,0 +1,109 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func TestControl_ReloadServerConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *ReloadServerConfigReq
+		mic     *MockInvokerConfig
+		expResp *ReloadServerConfigResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"local failure": {
+			req: &ReloadServerConfigReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"bad message": {
+			req: &ReloadServerConfigReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &ctlpb.SetLogMasksResp{}),
+			},
+			expErr: errors.New("unable to unpack"),
+		},
+		"mixed results": {
+			req: &ReloadServerConfigReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host2",
+							Message: &ctlpb.ReloadConfigResp{
+								Applied: []string{"control_log_mask"},
+							},
+						},
+						{
+							Addr:  "host3",
+							Error: errors.New("validation failed"),
+						},
+						{
+							Addr: "host1",
+							Message: &ctlpb.ReloadConfigResp{
+								Applied:         []string{"fault_path"},
+								RestartRequired: []string{"nr_hugepages"},
+							},
+						},
+					},
+				},
+			},
+			expResp: &ReloadServerConfigResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host3", "validation failed"}),
+				HostChanges: []*HostConfigChanges{
+					{
+						Addr:            "host1",
+						Applied:         []string{"fault_path"},
+						RestartRequired: []string{"nr_hugepages"},
+					},
+					{
+						Addr:    "host2",
+						Applied: []string{"control_log_mask"},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := context.TODO()
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := ReloadServerConfig(ctx, mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
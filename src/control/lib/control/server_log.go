@@ -0,0 +1,88 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+)
+
+type (
+	// SetEngineLogMasksReq contains the inputs for the set engine log
+	// masks request. If neither Masks nor Level are set, the settings in
+	// effect at startup are restored.
+	SetEngineLogMasksReq struct {
+		unaryRequest
+		Masks       string        // engine log masks in D_LOG_MASK format
+		Level       string        // control plane log level
+		RevertAfter time.Duration // restore original settings after duration
+	}
+
+	// SetEngineLogMasksResp contains the results of a set engine log
+	// masks request.
+	SetEngineLogMasksResp struct {
+		HostErrorsResp
+	}
+)
+
+// SetEngineLogMasks sets the control plane log level and/or the log masks of
+// running DAOS I/O Engines on all hosts supplied in the request's hostlist,
+// or all configured hosts if not explicitly specified.
+func SetEngineLogMasks(ctx context.Context, rpcClient UnaryInvoker, req *SetEngineLogMasksReq) (*SetEngineLogMasksResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if req.RevertAfter < 0 {
+		return nil, errors.New("revert period must not be negative")
+	}
+	if req.RevertAfter > 0 && req.RevertAfter < time.Second {
+		return nil, errors.New("revert period must be at least one second")
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).SetEngineLogMasks(ctx, &ctlpb.SetLogMasksReq{
+			Masks:       req.Masks,
+			Level:       req.Level,
+			RevertAfter: uint32(req.RevertAfter / time.Second),
+		})
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SetEngineLogMasksResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hostResp.Message.(*ctlpb.SetLogMasksResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hostResp.Message)
+		}
+		if len(pbResp.Errors) > 0 {
+			hostErr := errors.New(strings.Join(pbResp.Errors, "; "))
+			if err := resp.addHostError(hostResp.Addr, hostErr); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return resp, nil
+}
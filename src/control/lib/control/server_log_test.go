@@ -0,0 +1,121 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func TestControl_SetEngineLogMasks(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SetEngineLogMasksReq
+		mic     *MockInvokerConfig
+		expResp *SetEngineLogMasksResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"negative revert period": {
+			req:    &SetEngineLogMasksReq{RevertAfter: -time.Second},
+			expErr: errors.New("must not be negative"),
+		},
+		"sub-second revert period": {
+			req:    &SetEngineLogMasksReq{RevertAfter: time.Millisecond},
+			expErr: errors.New("at least one second"),
+		},
+		"local failure": {
+			req: &SetEngineLogMasksReq{Masks: "DEBUG"},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &SetEngineLogMasksReq{Masks: "DEBUG"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr:  "host1",
+							Error: errors.New("remote failed"),
+						},
+					},
+				},
+			},
+			expResp: &SetEngineLogMasksResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host1", "remote failed"}),
+			},
+		},
+		"engine failures": {
+			req: &SetEngineLogMasksReq{Masks: "DEBUG"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+							Message: &ctlpb.SetLogMasksResp{
+								Errors: []string{"engine 0: bad", "engine 1: worse"},
+							},
+						},
+						{
+							Addr:    "host2",
+							Message: &ctlpb.SetLogMasksResp{},
+						},
+					},
+				},
+			},
+			expResp: &SetEngineLogMasksResp{
+				HostErrorsResp: MockHostErrorsResp(t,
+					&MockHostError{"host1", "engine 0: bad; engine 1: worse"}),
+			},
+		},
+		"success": {
+			req: &SetEngineLogMasksReq{
+				Masks:       "DEBUG",
+				Level:       "debug",
+				RevertAfter: time.Minute,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &ctlpb.SetLogMasksResp{}),
+			},
+			expResp: &SetEngineLogMasksResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := context.TODO()
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := SetEngineLogMasks(ctx, mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
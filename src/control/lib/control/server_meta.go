@@ -44,11 +44,13 @@ type (
 		OmitDevices      bool
 		OmitPools        bool
 		IncludeBioHealth bool
+		IncludeHistory   bool // include BIO health history of devices
 		SetFaulty        bool
 		UUID             string // UUID of pool or device for single result
 		Rank             system.Rank
 		Target           string
 		ReplaceUUID      string // UUID of new device to replace storage
+		AutoReplace      bool   // detect new device to replace storage
 		NoReint          bool   // for device replacement
 		Identify         bool   // for VMD LED device identification
 	}
@@ -130,6 +132,9 @@ func SmdQuery(ctx context.Context, rpcClient UnaryInvoker, req *SmdQueryReq) (*S
 		if err := checkUUID(req.ReplaceUUID); err != nil {
 			return nil, errors.Wrap(err, "bad new device UUID for replacement")
 		}
+		if req.AutoReplace {
+			return nil, errors.New("new device UUID cannot be specified for automatic replacement")
+		}
 	}
 
 	pbReq := new(ctlpb.SmdQueryReq)
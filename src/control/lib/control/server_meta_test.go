@@ -85,6 +85,14 @@ func TestControl_SmdQuery(t *testing.T) {
 			},
 			expErr: errors.New("invalid UUID"),
 		},
+		"auto replace with new device UUID": {
+			req: &SmdQueryReq{
+				UUID:        common.MockUUID(0),
+				ReplaceUUID: common.MockUUID(1),
+				AutoReplace: true,
+			},
+			expErr: errors.New("cannot be specified for automatic replacement"),
+		},
 		"set-faulty with > 1 host": {
 			req: &SmdQueryReq{
 				unaryRequest: unaryRequest{
@@ -276,6 +284,54 @@ func TestControl_SmdQuery(t *testing.T) {
 				}),
 			},
 		},
+		"device health history": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host-0",
+							Message: &ctlpb.SmdQueryResp{
+								Ranks: []*ctlpb.SmdQueryResp_RankResp{
+									{
+										Rank: 0,
+										Devices: []*ctlpb.SmdQueryResp_Device{
+											{
+												Uuid:   common.MockUUID(0),
+												TgtIds: []int32{0},
+												History: []*ctlpb.BioHealthResp{
+													{Timestamp: 1, Temperature: 300, AvailSpare: 100},
+													{Timestamp: 2, Temperature: 310, AvailSpare: 99, MediaErrs: 1},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			req: &SmdQueryReq{IncludeHistory: true},
+			expResp: &SmdQueryResp{
+				HostStorage: mockSmdQueryMap(t, &mockSmdQueryResp{
+					Hosts: "host-0",
+					SmdInfo: &SmdInfo{
+						Devices: []*storage.SmdDevice{
+							{
+								UUID:      common.MockUUID(0),
+								Rank:      system.Rank(0),
+								TargetIDs: []int32{0},
+								History: []*storage.NvmeHealth{
+									{Timestamp: 1, Temperature: 300, AvailSpare: 100},
+									{Timestamp: 2, Temperature: 310, AvailSpare: 99, MediaErrors: 1},
+								},
+							},
+						},
+						Pools: make(map[string][]*SmdPool),
+					},
+				}),
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -10,7 +10,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/dustin/go-humanize/english"
@@ -213,18 +215,31 @@ func SystemNotify(ctx context.Context, rpcClient UnaryInvoker, req *SystemNotify
 // EventForwarder implements the events.Handler interface, increments sequence
 // number for each event forwarded and distributes requests to MS access points.
 type EventForwarder struct {
+	sync.RWMutex
 	seq       <-chan uint64
 	client    UnaryInvoker
 	accessPts []string
 }
 
+// SetAccessPoints updates the MS access points that events are forwarded to.
+func (ef *EventForwarder) SetAccessPoints(accessPts []string) {
+	ef.Lock()
+	defer ef.Unlock()
+
+	ef.accessPts = accessPts
+}
+
 // OnEvent implements the events.Handler interface.
 func (ef *EventForwarder) OnEvent(ctx context.Context, evt *events.RASEvent) {
+	ef.RLock()
+	accessPts := ef.accessPts
+	ef.RUnlock()
+
 	switch {
 	case evt == nil:
 		ef.client.Debug("skip event forwarding, nil event")
 		return
-	case len(ef.accessPts) == 0:
+	case len(accessPts) == 0:
 		ef.client.Debug("skip event forwarding, missing access points")
 		return
 	}
@@ -233,9 +248,9 @@ func (ef *EventForwarder) OnEvent(ctx context.Context, evt *events.RASEvent) {
 		Sequence: <-ef.seq,
 		Event:    evt,
 	}
-	req.SetHostList(ef.accessPts)
+	req.SetHostList(accessPts)
 	ef.client.Debugf("forwarding %s event to MS access points %v (seq: %d)",
-		evt.ID, ef.accessPts, req.Sequence)
+		evt.ID, accessPts, req.Sequence)
 
 	if _, err := SystemNotify(ctx, ef.client, req); err != nil {
 		ef.client.Debugf("failed to forward event to MS: %s", err)
@@ -659,6 +674,585 @@ func ListPools(ctx context.Context, rpcClient UnaryInvoker, req *ListPoolsReq) (
 	return resp, convertMSResponse(ur, resp)
 }
 
+// ListEventsReq contains the inputs for the list events request.
+type ListEventsReq struct {
+	unaryRequest
+	msRequest
+	sysRequest
+	Types      []events.RASTypeID
+	IDs        []events.RASID
+	Severities []events.RASSeverityID
+	PoolUUID   string
+	Since      time.Time
+	Until      time.Time
+	Limit      int
+}
+
+// EventLogEntry describes a RAS event recorded in the system event log.
+type EventLogEntry struct {
+	Sequence uint64           `json:"sequence"`
+	Time     time.Time        `json:"time"`
+	Event    *events.RASEvent `json:"event"`
+}
+
+// ListEventsResp contains the entries selected from the system event log.
+type ListEventsResp struct {
+	Entries []*EventLogEntry `json:"entries"`
+}
+
+// ListEvents fetches the entries matching the request criteria from the
+// system event log maintained by the management service.
+func ListEvents(ctx context.Context, rpcClient UnaryInvoker, req *ListEventsReq) (*ListEventsResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.ListEventsReq{
+		Sys:      req.getSystem(),
+		Ranks:    req.Ranks.String(),
+		Hosts:    req.Hosts.String(),
+		PoolUuid: req.PoolUUID,
+		Limit:    uint32(req.Limit),
+	}
+	for _, typ := range req.Types {
+		pbReq.Types = append(pbReq.Types, typ.Uint32())
+	}
+	for _, id := range req.IDs {
+		pbReq.Ids = append(pbReq.Ids, id.Uint32())
+	}
+	for _, sev := range req.Severities {
+		pbReq.Severities = append(pbReq.Severities, sev.Uint32())
+	}
+	if !req.Since.IsZero() {
+		pbReq.Since = req.Since.UnixNano()
+	}
+	if !req.Until.IsZero() {
+		pbReq.Until = req.Until.UnixNano()
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ListEvents(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS system list-events request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "list events failed")
+	}
+	pbResp, ok := msResp.(*mgmtpb.ListEventsResp)
+	if !ok {
+		return nil, errors.New("unable to extract ListEventsResp from MS response")
+	}
+
+	resp := new(ListEventsResp)
+	for _, pbEntry := range pbResp.GetEntries() {
+		evt, err := events.NewFromProto(pbEntry.GetEvent())
+		if err != nil {
+			return nil, errors.Wrapf(err, "converting event %d", pbEntry.GetSequence())
+		}
+		resp.Entries = append(resp.Entries, &EventLogEntry{
+			Sequence: pbEntry.GetSequence(),
+			Time:     time.Unix(0, pbEntry.GetTime()),
+			Event:    evt,
+		})
+	}
+
+	return resp, nil
+}
+
+// SubscribeEventsReq contains the inputs for the subscribe events request.
+type SubscribeEventsReq struct {
+	streamRequest
+	msRequest
+	retryableStreamRequest
+	sysRequest
+	Types      []events.RASTypeID
+	IDs        []events.RASID
+	Severities []events.RASSeverityID
+	PoolUUID   string
+}
+
+// StreamedEvent describes a RAS event received on a live event stream.
+type StreamedEvent struct {
+	Time       time.Time        `json:"time"`
+	Event      *events.RASEvent `json:"event"`
+	Dropped    uint64           `json:"dropped"`
+	MapVersion uint32           `json:"map_version"`
+}
+
+// SubscribeEvents opens a live stream of the RAS events matching the request
+// criteria as they are received by the management service, and calls the
+// supplied handler for each one. The Dropped field of each streamed event
+// reports how many matching events were discarded because the subscriber
+// was not keeping up, and the MapVersion field reports the system map version
+// at the time the event was sent.
+//
+// The call blocks until the context is canceled or the handler returns
+// an error.
+func SubscribeEvents(ctx context.Context, rpcClient StreamInvoker, req *SubscribeEventsReq, handler func(*StreamedEvent) error) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if handler == nil {
+		return errors.New("nil event handler")
+	}
+
+	pbReq := &mgmtpb.SubscribeEventsReq{
+		Sys:      req.getSystem(),
+		Ranks:    req.Ranks.String(),
+		Hosts:    req.Hosts.String(),
+		PoolUuid: req.PoolUUID,
+	}
+	for _, typ := range req.Types {
+		pbReq.Types = append(pbReq.Types, typ.Uint32())
+	}
+	for _, id := range req.IDs {
+		pbReq.Ids = append(pbReq.Ids, id.Uint32())
+	}
+	for _, sev := range req.Severities {
+		pbReq.Severities = append(pbReq.Severities, sev.Uint32())
+	}
+
+	req.setStreamRPC(func(ctx context.Context, conn *grpc.ClientConn, recv func(proto.Message) error) error {
+		stream, err := mgmtpb.NewMgmtSvcClient(conn).SubscribeEvents(ctx, pbReq)
+		if err != nil {
+			return err
+		}
+
+		for {
+			msg, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := recv(msg); err != nil {
+				return err
+			}
+		}
+	})
+	rpcClient.Debugf("DAOS system subscribe-events request: %+v", pbReq)
+
+	err := rpcClient.InvokeStreamRPC(ctx, req, func(msg proto.Message) error {
+		pbResp, ok := msg.(*mgmtpb.SubscribeEventsResp)
+		if !ok {
+			return errors.Errorf("unexpected stream message type %T", msg)
+		}
+
+		evt, err := events.NewFromProto(pbResp.GetEvent())
+		if err != nil {
+			return errors.Wrap(err, "converting streamed event")
+		}
+
+		return handler(&StreamedEvent{
+			Time:       time.Unix(0, pbResp.GetTime()),
+			Event:      evt,
+			Dropped:    pbResp.GetDropped(),
+			MapVersion: pbResp.GetMapVersion(),
+		})
+	})
+	if errors.Cause(err) == context.Canceled {
+		// The caller has finished with the stream.
+		return nil
+	}
+
+	return errors.Wrap(err, "subscribe events failed")
+}
+
+// SystemRestartReq contains the inputs for the system restart request.
+type SystemRestartReq struct {
+	streamRequest
+	msRequest
+	sysRequest
+	Rolling      bool
+	RanksPerStep uint32
+	Force        bool
+	StepTimeout  time.Duration
+}
+
+// SystemRestartProgress describes the progress of a system restart as
+// reported after each phase of each restart step.
+type SystemRestartProgress struct {
+	Step      uint32               `json:"step"`
+	NumSteps  uint32               `json:"num_steps"`
+	Domain    string               `json:"domain"`
+	Ranks     string               `json:"ranks"`
+	Phase     string               `json:"phase"`
+	Results   system.MemberResults `json:"results"`
+	Completed string               `json:"completed"`
+	Remaining string               `json:"remaining"`
+	Error     string               `json:"error"`
+}
+
+// IsAborted indicates whether the progress report is the final report of
+// a restart that has been aborted.
+func (srp *SystemRestartProgress) IsAborted() bool {
+	return srp.Error != ""
+}
+
+// SystemRestart stops and restarts the selected ranks (or all ranks if none
+// are selected). A rolling restart works through the system one fault domain
+// (or a fixed number of ranks) at a time, waiting for the ranks in each step
+// to rejoin the system and for any pool rebuilds to complete before moving
+// on to the next step. The supplied handler is called with each progress
+// report received from the management service.
+//
+// An error is returned if the restart was aborted before all selected ranks
+// were restarted.
+func SystemRestart(ctx context.Context, rpcClient StreamInvoker, req *SystemRestartReq, handler func(*SystemRestartProgress) error) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if handler == nil {
+		return errors.New("nil progress handler")
+	}
+
+	pbReq := &mgmtpb.SystemRestartReq{
+		Sys:          req.getSystem(),
+		Ranks:        req.Ranks.String(),
+		Hosts:        req.Hosts.String(),
+		Rolling:      req.Rolling,
+		RanksPerStep: req.RanksPerStep,
+		Force:        req.Force,
+		StepTimeout:  uint64(req.StepTimeout.Seconds()),
+	}
+
+	req.setStreamRPC(func(ctx context.Context, conn *grpc.ClientConn, recv func(proto.Message) error) error {
+		stream, err := mgmtpb.NewMgmtSvcClient(conn).SystemRestart(ctx, pbReq)
+		if err != nil {
+			return err
+		}
+
+		for {
+			msg, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := recv(msg); err != nil {
+				return err
+			}
+		}
+	})
+	rpcClient.Debugf("DAOS system restart request: %+v", pbReq)
+
+	var aborted *SystemRestartProgress
+	err := rpcClient.InvokeStreamRPC(ctx, req, func(msg proto.Message) error {
+		pbResp, ok := msg.(*mgmtpb.SystemRestartResp)
+		if !ok {
+			return errors.Errorf("unexpected stream message type %T", msg)
+		}
+
+		progress := &SystemRestartProgress{
+			Step:      pbResp.GetStep(),
+			NumSteps:  pbResp.GetNumSteps(),
+			Domain:    pbResp.GetDomain(),
+			Ranks:     pbResp.GetRanks(),
+			Phase:     pbResp.GetPhase(),
+			Completed: pbResp.GetCompleted(),
+			Remaining: pbResp.GetRemaining(),
+			Error:     pbResp.GetError(),
+		}
+		if err := convert.Types(pbResp.GetResults(), &progress.Results); err != nil {
+			return errors.Wrap(err, "converting rank results")
+		}
+		if progress.IsAborted() {
+			aborted = progress
+		}
+
+		return handler(progress)
+	})
+	if err != nil {
+		return errors.Wrap(err, "system restart failed")
+	}
+	if aborted != nil {
+		return errors.Errorf("system restart aborted at step %d/%d: %s",
+			aborted.Step, aborted.NumSteps, aborted.Error)
+	}
+
+	return nil
+}
+
+// SystemDbBackupReq contains the inputs for the system db-backup request.
+type SystemDbBackupReq struct {
+	streamRequest
+	msRequest
+}
+
+// SystemDbBackupResp describes a system database backup written by
+// SystemDbBackup.
+type SystemDbBackupResp struct {
+	MapVersion uint32 `json:"map_version"`
+	Size       uint64 `json:"size"`
+}
+
+// SystemDbBackup requests a consistent backup of the system database from
+// the MS leader and writes it to the supplied writer. The backup may be
+// used to seed a new MS replica with "daos_server db-restore" in the event
+// that all replicas are lost.
+//
+// The request is not retried once backup data has been written, as a new
+// backup stream would be appended to the partial output. The caller should
+// discard the output if an error is returned.
+func SystemDbBackup(ctx context.Context, rpcClient StreamInvoker, req *SystemDbBackupReq, out io.Writer) (*SystemDbBackupResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if out == nil {
+		return nil, errors.New("nil backup writer")
+	}
+
+	pbReq := &mgmtpb.SystemDbBackupReq{
+		Sys: req.getSystem(),
+	}
+
+	req.setStreamRPC(func(ctx context.Context, conn *grpc.ClientConn, recv func(proto.Message) error) error {
+		stream, err := mgmtpb.NewMgmtSvcClient(conn).SystemDbBackup(ctx, pbReq)
+		if err != nil {
+			return err
+		}
+
+		for {
+			msg, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := recv(msg); err != nil {
+				return err
+			}
+		}
+	})
+	rpcClient.Debugf("DAOS system db-backup request: %+v", pbReq)
+
+	resp := new(SystemDbBackupResp)
+	var written uint64
+	err := rpcClient.InvokeStreamRPC(ctx, req, func(msg proto.Message) error {
+		pbResp, ok := msg.(*mgmtpb.SystemDbBackupResp)
+		if !ok {
+			return errors.Errorf("unexpected stream message type %T", msg)
+		}
+
+		// All messages must belong to the same backup; refuse to
+		// append data from a different one to the partial output.
+		if written > 0 && (pbResp.GetMapVersion() != resp.MapVersion ||
+			pbResp.GetSize() != resp.Size) {
+			return errors.Errorf("backup changed mid-stream (map version %d, %d bytes -> map version %d, %d bytes)",
+				resp.MapVersion, resp.Size, pbResp.GetMapVersion(), pbResp.GetSize())
+		}
+		if written+uint64(len(pbResp.GetData())) > pbResp.GetSize() {
+			return errors.Errorf("received more than the expected %d bytes",
+				pbResp.GetSize())
+		}
+
+		resp.MapVersion = pbResp.GetMapVersion()
+		resp.Size = pbResp.GetSize()
+		n, err := out.Write(pbResp.GetData())
+		written += uint64(n)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "system db-backup failed")
+	}
+	if written == 0 {
+		return nil, errors.New("system db-backup failed: no backup data received")
+	}
+	if written != resp.Size {
+		return nil, errors.Errorf("system db-backup failed: received %d/%d bytes",
+			written, resp.Size)
+	}
+
+	return resp, nil
+}
+
+// SystemSetPolicyReq contains the inputs for the system set-policy request.
+type SystemSetPolicyReq struct {
+	unaryRequest
+	msRequest
+	sysRequest
+	AutoExcludePaused bool
+}
+
+// SystemSetPolicyResp contains the resulting state of the system management
+// policies.
+type SystemSetPolicyResp struct {
+	AutoExcludeEnabled     bool   `json:"auto_exclude_enabled"`
+	AutoExcludePaused      bool   `json:"auto_exclude_paused"`
+	AutoExcludeGracePeriod uint64 `json:"auto_exclude_grace_period"`
+	AutoExcludePending     string `json:"auto_exclude_pending"`
+}
+
+// SystemSetPolicy updates the runtime state of DAOS system management
+// policies, e.g. pausing or resuming the automatic exclusion of dead ranks
+// from pools, and returns the resulting state.
+func SystemSetPolicy(ctx context.Context, rpcClient UnaryInvoker, req *SystemSetPolicyReq) (*SystemSetPolicyResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemSetPolicyReq{
+		Sys:               req.getSystem(),
+		AutoExcludePaused: req.AutoExcludePaused,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemSetPolicy(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS system set-policy request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemSetPolicyResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// SystemReplicaListReq contains the inputs for the system replica list
+// request.
+type SystemReplicaListReq struct {
+	unaryRequest
+	msRequest
+}
+
+// SystemReplicaReq contains the inputs for the system replica add and
+// remove requests.
+type SystemReplicaReq struct {
+	unaryRequest
+	msRequest
+	Addr string
+}
+
+// SystemReplicaResp contains the resulting set of MS replicas.
+type SystemReplicaResp struct {
+	Leader   string   `json:"leader"`
+	Replicas []string `json:"replicas"`
+}
+
+// SystemReplicaList returns the current MS leader and the control
+// addresses of the MS replicas.
+func SystemReplicaList(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicaListReq) (*SystemReplicaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemReplicaListReq{Sys: req.getSystem()}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemReplicaList(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS system replica list request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemReplicaResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// SystemReplicaAdd adds the server with the given control address to the
+// set of MS replicas without restarting the system, and returns the
+// resulting set of replicas. The server must already be a system member.
+func SystemReplicaAdd(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicaReq) (*SystemReplicaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Addr == "" {
+		return nil, errors.New("no replica address specified")
+	}
+
+	pbReq := &mgmtpb.SystemReplicaReq{Sys: req.getSystem(), Addr: req.Addr}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemReplicaAdd(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS system replica add request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemReplicaResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// SystemReplicaRemove removes the server with the given control address
+// from the set of MS replicas without restarting the system, and returns
+// the resulting set of replicas.
+func SystemReplicaRemove(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicaReq) (*SystemReplicaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Addr == "" {
+		return nil, errors.New("no replica address specified")
+	}
+
+	pbReq := &mgmtpb.SystemReplicaReq{Sys: req.getSystem(), Addr: req.Addr}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemReplicaRemove(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS system replica remove request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemReplicaResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// ReplicaStartReq contains the parameters for a request to start a MS
+// replica on a server.
+type ReplicaStartReq struct {
+	unaryRequest
+	Replicas []string
+}
+
+// ReplicaStart requests that the server in the request's hostlist start a
+// MS replica using the supplied replica set.
+//
+// This is called by the MS leader when adding a replica, before the
+// server is added to the raft configuration.
+func ReplicaStart(ctx context.Context, rpcClient UnaryInvoker, req *ReplicaStartReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.ReplicaStartReq{
+		Sys:      req.getSystem(),
+		Replicas: req.Replicas,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ReplicaStart(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS replica start request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			return errors.Wrap(hr.Error, hr.Addr)
+		}
+	}
+
+	return nil
+}
+
 // RanksReq contains the parameters for a system ranks request.
 type RanksReq struct {
 	unaryRequest
@@ -7,10 +7,14 @@
 package control
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
@@ -1127,6 +1131,7 @@ func TestControl_EventForwarder_OnEvent(t *testing.T) {
 
 	for name, tc := range map[string]struct {
 		aps            []string
+		updatedAps     []string
 		event          *events.RASEvent
 		nilClient      bool
 		expInvokeCount int
@@ -1142,6 +1147,11 @@ func TestControl_EventForwarder_OnEvent(t *testing.T) {
 			aps:            []string{"192.168.1.1"},
 			expInvokeCount: 2,
 		},
+		"access points updated": {
+			event:          rasEventRankDown,
+			updatedAps:     []string{"192.168.1.2"},
+			expInvokeCount: 2,
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -1160,6 +1170,9 @@ func TestControl_EventForwarder_OnEvent(t *testing.T) {
 			}
 
 			ef := NewEventForwarder(mi, tc.aps)
+			if tc.updatedAps != nil {
+				ef.SetAccessPoints(tc.updatedAps)
+			}
 			for i := 0; i < callCount; i++ {
 				ef.OnEvent(context.TODO(), tc.event)
 			}
@@ -1205,3 +1218,711 @@ func TestControl_EventLogger_OnEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_ListEvents(t *testing.T) {
+	rankDown := events.NewRankDownEvent("foo", 0, 1, common.ExitStatus("test"))
+	pbRankDown, err := rankDown.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded := time.Unix(0, time.Now().UnixNano())
+
+	for name, tc := range map[string]struct {
+		req     *ListEventsReq
+		uErr    error
+		uResp   *UnaryResponse
+		expResp *ListEventsResp
+		expErr  error
+	}{
+		"nil req": {
+			req:    nil,
+			expErr: errors.New("nil *control.ListEventsReq request"),
+		},
+		"local failure": {
+			req:    new(ListEventsReq),
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req:    new(ListEventsReq),
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"no entries": {
+			req:     new(ListEventsReq),
+			uResp:   MockMSResponse("host1", nil, &mgmtpb.ListEventsResp{}),
+			expResp: &ListEventsResp{},
+		},
+		"entries": {
+			req: &ListEventsReq{
+				IDs:   []events.RASID{events.RASRankDown},
+				Limit: 1,
+			},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.ListEventsResp{
+				Entries: []*mgmtpb.EventLogEntry{
+					{
+						Sequence: 42,
+						Time:     recorded.UnixNano(),
+						Event:    pbRankDown,
+					},
+				},
+			}),
+			expResp: &ListEventsResp{
+				Entries: []*EventLogEntry{
+					{
+						Sequence: 42,
+						Time:     recorded,
+						Event:    rankDown,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := ListEvents(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(events.RASEvent{}),
+				cmp.Comparer(func(x, y error) bool {
+					return x.Error() == y.Error()
+				}),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SubscribeEvents(t *testing.T) {
+	rankDown := events.NewRankDownEvent("foo", 0, 1, common.ExitStatus("test"))
+	pbRankDown, err := rankDown.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := time.Unix(0, time.Now().UnixNano())
+
+	for name, tc := range map[string]struct {
+		req        *SubscribeEventsReq
+		nilHandler bool
+		handlerErr error
+		sResps     []proto.Message
+		sErr       error
+		expEvents  []*StreamedEvent
+		expErr     error
+	}{
+		"nil req": {
+			req:    nil,
+			expErr: errors.New("nil *control.SubscribeEventsReq request"),
+		},
+		"nil handler": {
+			req:        new(SubscribeEventsReq),
+			nilHandler: true,
+			expErr:     errors.New("nil event handler"),
+		},
+		"stream failure": {
+			req:    new(SubscribeEventsReq),
+			sErr:   errors.New("remote failed"),
+			expErr: errors.New("remote failed"),
+		},
+		"canceled by caller": {
+			req:  new(SubscribeEventsReq),
+			sErr: context.Canceled,
+		},
+		"unexpected message": {
+			req:    new(SubscribeEventsReq),
+			sResps: []proto.Message{&mgmtpb.ListEventsResp{}},
+			expErr: errors.New("unexpected stream message type"),
+		},
+		"handler failure": {
+			req: new(SubscribeEventsReq),
+			sResps: []proto.Message{
+				&mgmtpb.SubscribeEventsResp{Event: pbRankDown},
+			},
+			handlerErr: errors.New("handler failed"),
+			expErr:     errors.New("handler failed"),
+		},
+		"events": {
+			req: &SubscribeEventsReq{
+				Types: []events.RASTypeID{events.RASTypeStateChange},
+				IDs:   []events.RASID{events.RASRankDown},
+			},
+			sResps: []proto.Message{
+				&mgmtpb.SubscribeEventsResp{
+					Time:  received.UnixNano(),
+					Event: pbRankDown,
+				},
+				&mgmtpb.SubscribeEventsResp{
+					Time:       received.UnixNano(),
+					Event:      pbRankDown,
+					Dropped:    3,
+					MapVersion: 7,
+				},
+			},
+			expEvents: []*StreamedEvent{
+				{
+					Time:  received,
+					Event: rankDown,
+				},
+				{
+					Time:       received,
+					Event:      rankDown,
+					Dropped:    3,
+					MapVersion: 7,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				StreamResponses: tc.sResps,
+				StreamError:     tc.sErr,
+			})
+
+			var gotEvents []*StreamedEvent
+			handler := func(se *StreamedEvent) error {
+				gotEvents = append(gotEvents, se)
+				return tc.handlerErr
+			}
+			if tc.nilHandler {
+				handler = nil
+			}
+
+			gotErr := SubscribeEvents(context.TODO(), mi, tc.req, handler)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(events.RASEvent{}),
+				cmp.Comparer(func(x, y error) bool {
+					return x.Error() == y.Error()
+				}),
+			}
+			if diff := cmp.Diff(tc.expEvents, gotEvents, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemRestart(t *testing.T) {
+	stopResult := &sharedpb.RankResult{Rank: 0, Action: "stop", State: "stopped"}
+	stepDone := &mgmtpb.SystemRestartResp{
+		Step:      1,
+		NumSteps:  2,
+		Domain:    "/host1",
+		Ranks:     "0",
+		Phase:     "done",
+		Completed: "0",
+		Remaining: "1",
+	}
+
+	for name, tc := range map[string]struct {
+		req         *SystemRestartReq
+		nilHandler  bool
+		handlerErr  error
+		sResps      []proto.Message
+		sErr        error
+		expProgress []*SystemRestartProgress
+		expErr      error
+	}{
+		"nil req": {
+			req:    nil,
+			expErr: errors.New("nil *control.SystemRestartReq request"),
+		},
+		"nil handler": {
+			req:        new(SystemRestartReq),
+			nilHandler: true,
+			expErr:     errors.New("nil progress handler"),
+		},
+		"stream failure": {
+			req:    new(SystemRestartReq),
+			sErr:   errors.New("remote failed"),
+			expErr: errors.New("remote failed"),
+		},
+		"unexpected message": {
+			req:    new(SystemRestartReq),
+			sResps: []proto.Message{&mgmtpb.SubscribeEventsResp{}},
+			expErr: errors.New("unexpected stream message type"),
+		},
+		"handler failure": {
+			req:        new(SystemRestartReq),
+			sResps:     []proto.Message{stepDone},
+			handlerErr: errors.New("handler failed"),
+			expErr:     errors.New("handler failed"),
+		},
+		"aborted": {
+			req: &SystemRestartReq{Rolling: true},
+			sResps: []proto.Message{
+				stepDone,
+				&mgmtpb.SystemRestartResp{
+					Step:      2,
+					NumSteps:  2,
+					Domain:    "/host2",
+					Ranks:     "1",
+					Phase:     "aborted",
+					Completed: "0",
+					Remaining: "1",
+					Error:     "start failed on one or more ranks",
+				},
+			},
+			expProgress: []*SystemRestartProgress{
+				{
+					Step:      1,
+					NumSteps:  2,
+					Domain:    "/host1",
+					Ranks:     "0",
+					Phase:     "done",
+					Completed: "0",
+					Remaining: "1",
+				},
+				{
+					Step:      2,
+					NumSteps:  2,
+					Domain:    "/host2",
+					Ranks:     "1",
+					Phase:     "aborted",
+					Completed: "0",
+					Remaining: "1",
+					Error:     "start failed on one or more ranks",
+				},
+			},
+			expErr: errors.New("aborted at step 2/2: start failed"),
+		},
+		"success": {
+			req: &SystemRestartReq{Rolling: true},
+			sResps: []proto.Message{
+				&mgmtpb.SystemRestartResp{
+					Step:      1,
+					NumSteps:  2,
+					Domain:    "/host1",
+					Ranks:     "0",
+					Phase:     "stop",
+					Results:   []*sharedpb.RankResult{stopResult},
+					Remaining: "0-1",
+				},
+				stepDone,
+			},
+			expProgress: []*SystemRestartProgress{
+				{
+					Step:     1,
+					NumSteps: 2,
+					Domain:   "/host1",
+					Ranks:    "0",
+					Phase:    "stop",
+					Results: system.MemberResults{
+						{Rank: 0, Action: "stop", State: system.MemberStateStopped},
+					},
+					Remaining: "0-1",
+				},
+				{
+					Step:      1,
+					NumSteps:  2,
+					Domain:    "/host1",
+					Ranks:     "0",
+					Phase:     "done",
+					Completed: "0",
+					Remaining: "1",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				StreamResponses: tc.sResps,
+				StreamError:     tc.sErr,
+			})
+
+			var gotProgress []*SystemRestartProgress
+			handler := func(srp *SystemRestartProgress) error {
+				gotProgress = append(gotProgress, srp)
+				return tc.handlerErr
+			}
+			if tc.nilHandler {
+				handler = nil
+			}
+
+			gotErr := SystemRestart(context.TODO(), mi, tc.req, handler)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expProgress == nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expProgress, gotProgress); diff != "" {
+				t.Fatalf("unexpected progress (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemSetPolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemSetPolicyReq
+		uErr    error
+		uResp   *UnaryResponse
+		expResp *SystemSetPolicyResp
+		expErr  error
+	}{
+		"nil req": {
+			req:    nil,
+			expErr: errors.New("nil *control.SystemSetPolicyReq request"),
+		},
+		"local failure": {
+			req:    new(SystemSetPolicyReq),
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req:    new(SystemSetPolicyReq),
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"paused": {
+			req: &SystemSetPolicyReq{AutoExcludePaused: true},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.SystemSetPolicyResp{
+				AutoExcludeEnabled:     true,
+				AutoExcludePaused:      true,
+				AutoExcludeGracePeriod: 300,
+				AutoExcludePending:     "[1-2]",
+			}),
+			expResp: &SystemSetPolicyResp{
+				AutoExcludeEnabled:     true,
+				AutoExcludePaused:      true,
+				AutoExcludeGracePeriod: 300,
+				AutoExcludePending:     "[1-2]",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := SystemSetPolicy(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemDbBackup(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemDbBackupReq
+		nilOut  bool
+		sResps  []proto.Message
+		sErr    error
+		expData string
+		expResp *SystemDbBackupResp
+		expErr  error
+	}{
+		"nil req": {
+			req:    nil,
+			expErr: errors.New("nil *control.SystemDbBackupReq request"),
+		},
+		"nil writer": {
+			req:    new(SystemDbBackupReq),
+			nilOut: true,
+			expErr: errors.New("nil backup writer"),
+		},
+		"stream failure": {
+			req:    new(SystemDbBackupReq),
+			sErr:   errors.New("remote failed"),
+			expErr: errors.New("remote failed"),
+		},
+		"unexpected message": {
+			req:    new(SystemDbBackupReq),
+			sResps: []proto.Message{&mgmtpb.SubscribeEventsResp{}},
+			expErr: errors.New("unexpected stream message type"),
+		},
+		"no data": {
+			req:    new(SystemDbBackupReq),
+			expErr: errors.New("no backup data received"),
+		},
+		"short backup": {
+			req: new(SystemDbBackupReq),
+			sResps: []proto.Message{
+				&mgmtpb.SystemDbBackupResp{Data: []byte("abc"), MapVersion: 2, Size: 6},
+			},
+			expErr: errors.New("received 3/6 bytes"),
+		},
+		"stream failure after partial backup": {
+			req: new(SystemDbBackupReq),
+			sResps: []proto.Message{
+				&mgmtpb.SystemDbBackupResp{Data: []byte("abc"), MapVersion: 2, Size: 6},
+			},
+			sErr:   system.ErrRaftUnavail,
+			expErr: system.ErrRaftUnavail,
+		},
+		"restarted backup": {
+			req: new(SystemDbBackupReq),
+			sResps: []proto.Message{
+				&mgmtpb.SystemDbBackupResp{Data: []byte("abc"), MapVersion: 2, Size: 6},
+				&mgmtpb.SystemDbBackupResp{Data: []byte("abcd"), MapVersion: 3, Size: 8},
+			},
+			expErr: errors.New("backup changed mid-stream"),
+		},
+		"too much data": {
+			req: new(SystemDbBackupReq),
+			sResps: []proto.Message{
+				&mgmtpb.SystemDbBackupResp{Data: []byte("abc"), MapVersion: 2, Size: 6},
+				&mgmtpb.SystemDbBackupResp{Data: []byte("abcd"), MapVersion: 2, Size: 6},
+			},
+			expErr: errors.New("more than the expected 6 bytes"),
+		},
+		"success": {
+			req: new(SystemDbBackupReq),
+			sResps: []proto.Message{
+				&mgmtpb.SystemDbBackupResp{Data: []byte("abc"), MapVersion: 2, Size: 6},
+				&mgmtpb.SystemDbBackupResp{Data: []byte("def"), MapVersion: 2, Size: 6},
+			},
+			expData: "abcdef",
+			expResp: &SystemDbBackupResp{MapVersion: 2, Size: 6},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				StreamResponses: tc.sResps,
+				StreamError:     tc.sErr,
+			})
+
+			var out bytes.Buffer
+			var w io.Writer = &out
+			if tc.nilOut {
+				w = nil
+			}
+
+			gotResp, gotErr := SystemDbBackup(context.TODO(), mi, tc.req, w)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			common.AssertEqual(t, tc.expData, out.String(), "unexpected backup data")
+		})
+	}
+}
+
+func TestControl_SystemReplicaList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemReplicaListReq
+		uErr    error
+		uResp   *UnaryResponse
+		expResp *SystemReplicaResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil *control.SystemReplicaListReq request"),
+		},
+		"local failure": {
+			req:    new(SystemReplicaListReq),
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req:    new(SystemReplicaListReq),
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: new(SystemReplicaListReq),
+			uResp: MockMSResponse("host1", nil, &mgmtpb.SystemReplicaResp{
+				Leader:   "host1:10001",
+				Replicas: []string{"host1:10001", "host2:10001"},
+			}),
+			expResp: &SystemReplicaResp{
+				Leader:   "host1:10001",
+				Replicas: []string{"host1:10001", "host2:10001"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := SystemReplicaList(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemReplicaAddRemove(t *testing.T) {
+	for name, tc := range map[string]struct {
+		remove  bool
+		req     *SystemReplicaReq
+		uErr    error
+		uResp   *UnaryResponse
+		expResp *SystemReplicaResp
+		expErr  error
+	}{
+		"add nil req": {
+			expErr: errors.New("nil *control.SystemReplicaReq request"),
+		},
+		"add without address": {
+			req:    new(SystemReplicaReq),
+			expErr: errors.New("no replica address"),
+		},
+		"add local failure": {
+			req:    &SystemReplicaReq{Addr: "host2:10001"},
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"add remote failure": {
+			req:    &SystemReplicaReq{Addr: "host2:10001"},
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"add": {
+			req: &SystemReplicaReq{Addr: "host2:10001"},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.SystemReplicaResp{
+				Leader:   "host1:10001",
+				Replicas: []string{"host1:10001", "host2:10001"},
+			}),
+			expResp: &SystemReplicaResp{
+				Leader:   "host1:10001",
+				Replicas: []string{"host1:10001", "host2:10001"},
+			},
+		},
+		"remove nil req": {
+			remove: true,
+			expErr: errors.New("nil *control.SystemReplicaReq request"),
+		},
+		"remove without address": {
+			remove: true,
+			req:    new(SystemReplicaReq),
+			expErr: errors.New("no replica address"),
+		},
+		"remove": {
+			remove: true,
+			req:    &SystemReplicaReq{Addr: "host2:10001"},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.SystemReplicaResp{
+				Leader:   "host1:10001",
+				Replicas: []string{"host1:10001"},
+			}),
+			expResp: &SystemReplicaResp{
+				Leader:   "host1:10001",
+				Replicas: []string{"host1:10001"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			replicaFn := SystemReplicaAdd
+			if tc.remove {
+				replicaFn = SystemReplicaRemove
+			}
+
+			gotResp, gotErr := replicaFn(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ReplicaStart(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *ReplicaStartReq
+		uErr   error
+		uResp  *UnaryResponse
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil *control.ReplicaStartReq request"),
+		},
+		"local failure": {
+			req:    new(ReplicaStartReq),
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: new(ReplicaStartReq),
+			uResp: &UnaryResponse{
+				Responses: []*HostResponse{
+					{Addr: "host2", Error: errors.New("remote failed")},
+				},
+			},
+			expErr: errors.New("host2: remote failed"),
+		},
+		"success": {
+			req: &ReplicaStartReq{Replicas: []string{"host1:10001", "host2:10001"}},
+			uResp: &UnaryResponse{
+				Responses: []*HostResponse{
+					{Addr: "host2", Message: &mgmtpb.ReplicaStartResp{}},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotErr := ReplicaStart(context.TODO(), mi, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
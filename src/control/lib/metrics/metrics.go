@@ -0,0 +1,187 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+// Package metrics provides a minimal implementation of the Prometheus text
+// exposition format, suitable for exporting control plane metrics to a
+// Prometheus or OpenMetrics compatible scraper.
+package metrics
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+const (
+	// TypeGauge indicates a metric that may go up or down.
+	TypeGauge Type = "gauge"
+	// TypeCounter indicates a metric that only increases.
+	TypeCounter Type = "counter"
+
+	// ContentType is the content type of the exposition format.
+	ContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+type (
+	// Type describes the type of a metric family.
+	Type string
+
+	// Labels is a set of label name/value pairs identifying a sample.
+	Labels map[string]string
+
+	// Sample is a single labeled value of a metric.
+	Sample struct {
+		Labels Labels
+		Value  float64
+	}
+
+	// Family is a named collection of samples of the same type.
+	Family struct {
+		Name    string
+		Help    string
+		Type    Type
+		Samples []*Sample
+	}
+
+	// Collector is implemented by types that gather metric families
+	// on demand.
+	Collector interface {
+		Collect(context.Context) ([]*Family, error)
+	}
+
+	// CollectorFunc is an adapter to allow an ordinary function to be
+	// used as a Collector.
+	CollectorFunc func(context.Context) ([]*Family, error)
+)
+
+// Collect implements the Collector interface.
+func (fn CollectorFunc) Collect(ctx context.Context) ([]*Family, error) {
+	return fn(ctx)
+}
+
+// NewGauge returns an empty gauge family.
+func NewGauge(name, help string) *Family {
+	return &Family{Name: name, Help: help, Type: TypeGauge}
+}
+
+// NewCounter returns an empty counter family.
+func NewCounter(name, help string) *Family {
+	return &Family{Name: name, Help: help, Type: TypeCounter}
+}
+
+// Add adds a sample with the supplied value and labels to the family.
+func (f *Family) Add(value float64, labels Labels) *Family {
+	f.Samples = append(f.Samples, &Sample{Labels: labels, Value: value})
+	return f
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+var helpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func formatLabels(labels Labels) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", name, labelEscaper.Replace(labels[name])))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// Write writes the supplied families to the writer in the text exposition
+// format. Families are written in name order; families without samples are
+// omitted.
+func Write(out io.Writer, families []*Family) error {
+	sorted := make([]*Family, len(families))
+	copy(sorted, families)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	w := bufio.NewWriter(out)
+	for _, f := range sorted {
+		if len(f.Samples) == 0 {
+			continue
+		}
+		if f.Help != "" {
+			fmt.Fprintf(w, "# HELP %s %s\n", f.Name, helpEscaper.Replace(f.Help))
+		}
+		fmt.Fprintf(w, "# TYPE %s %s\n", f.Name, f.Type)
+		for _, s := range f.Samples {
+			fmt.Fprintf(w, "%s%s %s\n", f.Name, formatLabels(s.Labels), formatValue(s.Value))
+		}
+	}
+
+	return w.Flush()
+}
+
+// Handler is an http.Handler that serves the metrics gathered from a set of
+// collectors.
+type Handler struct {
+	log        logging.Logger
+	collectors []Collector
+}
+
+// NewHandler returns an initialized Handler.
+func NewHandler(log logging.Logger, collectors ...Collector) *Handler {
+	return &Handler{
+		log:        log,
+		collectors: collectors,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+//
+// A collector that fails does not prevent the metrics from the other
+// collectors from being served.
+func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var families []*Family
+	for _, c := range h.collectors {
+		collected, err := c.Collect(req.Context())
+		if err != nil {
+			h.log.Errorf("metrics collection failed: %s", err)
+		}
+		families = append(families, collected...)
+	}
+
+	rw.Header().Set("Content-Type", ContentType)
+	if err := Write(rw, families); err != nil {
+		h.log.Errorf("failed to write metrics: %s", err)
+	}
+}
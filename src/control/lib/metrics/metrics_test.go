@@ -0,0 +1,121 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package metrics
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func TestMetrics_Write(t *testing.T) {
+	for name, tc := range map[string]struct {
+		families []*Family
+		expOut   string
+	}{
+		"no families": {},
+		"empty family omitted": {
+			families: []*Family{NewGauge("empty", "no samples")},
+		},
+		"sorted families and labels": {
+			families: []*Family{
+				NewGauge("zeta", "last").Add(1, nil),
+				NewCounter("alpha", "first").
+					Add(3, Labels{"rank": "1", "instance": "0"}).
+					Add(4.5, Labels{"rank": "2", "instance": "1"}),
+			},
+			expOut: `
+# HELP alpha first
+# TYPE alpha counter
+alpha{instance="0",rank="1"} 3
+alpha{instance="1",rank="2"} 4.5
+# HELP zeta last
+# TYPE zeta gauge
+zeta 1
+`,
+		},
+		"escaping and special values": {
+			families: []*Family{
+				NewGauge("odd", "back\\slash\nnewline").
+					Add(math.NaN(), Labels{"v": "a \"quoted\"\nvalue"}).
+					Add(math.Inf(1), nil).
+					Add(math.Inf(-1), nil),
+			},
+			expOut: `
+# HELP odd back\\slash\nnewline
+# TYPE odd gauge
+odd{v="a \"quoted\"\nvalue"} NaN
+odd +Inf
+odd -Inf
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			if err := Write(&out, tc.families); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestMetrics_Handler(t *testing.T) {
+	good := CollectorFunc(func(_ context.Context) ([]*Family, error) {
+		return []*Family{NewGauge("good", "").Add(1, nil)}, nil
+	})
+	bad := CollectorFunc(func(_ context.Context) ([]*Family, error) {
+		return []*Family{NewGauge("partial", "").Add(2, nil)}, errors.New("collector failed")
+	})
+
+	for name, tc := range map[string]struct {
+		method     string
+		collectors []Collector
+		expStatus  int
+		expBody    string
+	}{
+		"bad method": {
+			method:    http.MethodPost,
+			expStatus: http.StatusMethodNotAllowed,
+			expBody:   "method not allowed\n",
+		},
+		"collector failure": {
+			method:     http.MethodGet,
+			collectors: []Collector{bad, good},
+			expStatus:  http.StatusOK,
+			expBody:    "# TYPE good gauge\ngood 1\n# TYPE partial gauge\npartial 2\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			rec := httptest.NewRecorder()
+			NewHandler(log, tc.collectors...).ServeHTTP(rec,
+				httptest.NewRequest(tc.method, "/metrics", nil))
+
+			common.AssertEqual(t, tc.expStatus, rec.Code, "unexpected status")
+			common.AssertEqual(t, tc.expBody, rec.Body.String(), "unexpected body")
+			if tc.expStatus == http.StatusOK {
+				common.AssertEqual(t, ContentType, rec.Header().Get("Content-Type"),
+					"unexpected content type")
+			}
+		})
+	}
+}
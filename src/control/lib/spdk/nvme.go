@@ -164,20 +164,22 @@ func c2GoController(ctrlr *C.struct_ctrlr_t) *storage.NvmeController {
 // c2GoDeviceHealth is a private translation function.
 func c2GoDeviceHealth(health *C.struct_nvme_stats) *storage.NvmeHealth {
 	return &storage.NvmeHealth{
-		TempWarnTime:    uint32(health.warn_temp_time),
-		TempCritTime:    uint32(health.crit_temp_time),
-		CtrlBusyTime:    uint64(health.ctrl_busy_time),
-		PowerCycles:     uint64(health.power_cycles),
-		PowerOnHours:    uint64(health.power_on_hours),
-		UnsafeShutdowns: uint64(health.unsafe_shutdowns),
-		MediaErrors:     uint64(health.media_errs),
-		ErrorLogEntries: uint64(health.err_log_entries),
-		Temperature:     uint32(health.temperature),
-		TempWarn:        bool(health.temp_warn),
-		AvailSpareWarn:  bool(health.avail_spare_warn),
-		ReliabilityWarn: bool(health.dev_reliability_warn),
-		ReadOnlyWarn:    bool(health.read_only_warn),
-		VolatileWarn:    bool(health.volatile_mem_warn),
+		TempWarnTime:     uint32(health.warn_temp_time),
+		TempCritTime:     uint32(health.crit_temp_time),
+		CtrlBusyTime:     uint64(health.ctrl_busy_time),
+		PowerCycles:      uint64(health.power_cycles),
+		PowerOnHours:     uint64(health.power_on_hours),
+		UnsafeShutdowns:  uint64(health.unsafe_shutdowns),
+		MediaErrors:      uint64(health.media_errs),
+		ErrorLogEntries:  uint64(health.err_log_entries),
+		Temperature:      uint32(health.temperature),
+		AvailSpare:       uint32(health.avail_spare),
+		AvailSpareThresh: uint32(health.avail_spare_thresh),
+		TempWarn:         bool(health.temp_warn),
+		AvailSpareWarn:   bool(health.avail_spare_warn),
+		ReliabilityWarn:  bool(health.dev_reliability_warn),
+		ReadOnlyWarn:     bool(health.read_only_warn),
+		VolatileWarn:     bool(health.volatile_mem_warn),
 	}
 }
 
@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
-// source: auth.proto
+// source: security/auth.proto
 
 package auth
 
@@ -43,7 +43,7 @@ func (x Flavor) String() string {
 }
 
 func (Flavor) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{0}
+	return fileDescriptor_c38ccbbe611799f4, []int{0}
 }
 
 type Token struct {
@@ -58,7 +58,7 @@ func (m *Token) Reset()         { *m = Token{} }
 func (m *Token) String() string { return proto.CompactTextString(m) }
 func (*Token) ProtoMessage()    {}
 func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{0}
+	return fileDescriptor_c38ccbbe611799f4, []int{0}
 }
 
 func (m *Token) XXX_Unmarshal(b []byte) error {
@@ -110,7 +110,7 @@ func (m *Sys) Reset()         { *m = Sys{} }
 func (m *Sys) String() string { return proto.CompactTextString(m) }
 func (*Sys) ProtoMessage()    {}
 func (*Sys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{1}
+	return fileDescriptor_c38ccbbe611799f4, []int{1}
 }
 
 func (m *Sys) XXX_Unmarshal(b []byte) error {
@@ -187,7 +187,7 @@ func (m *Credential) Reset()         { *m = Credential{} }
 func (m *Credential) String() string { return proto.CompactTextString(m) }
 func (*Credential) ProtoMessage()    {}
 func (*Credential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{2}
+	return fileDescriptor_c38ccbbe611799f4, []int{2}
 }
 
 func (m *Credential) XXX_Unmarshal(b []byte) error {
@@ -229,6 +229,46 @@ func (m *Credential) GetOrigin() string {
 	return ""
 }
 
+// GetCredReq represents a request to fetch authentication credentials.
+type GetCredReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCredReq) Reset()         { *m = GetCredReq{} }
+func (m *GetCredReq) String() string { return proto.CompactTextString(m) }
+func (*GetCredReq) ProtoMessage()    {}
+func (*GetCredReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c38ccbbe611799f4, []int{3}
+}
+
+func (m *GetCredReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredReq.Unmarshal(m, b)
+}
+func (m *GetCredReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredReq.Marshal(b, m, deterministic)
+}
+func (m *GetCredReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredReq.Merge(m, src)
+}
+func (m *GetCredReq) XXX_Size() int {
+	return xxx_messageInfo_GetCredReq.Size(m)
+}
+func (m *GetCredReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredReq proto.InternalMessageInfo
+
+func (m *GetCredReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
 // GetCredResp represents the result of a request to fetch authentication
 // credentials.
 type GetCredResp struct {
@@ -243,7 +283,7 @@ func (m *GetCredResp) Reset()         { *m = GetCredResp{} }
 func (m *GetCredResp) String() string { return proto.CompactTextString(m) }
 func (*GetCredResp) ProtoMessage()    {}
 func (*GetCredResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{3}
+	return fileDescriptor_c38ccbbe611799f4, []int{4}
 }
 
 func (m *GetCredResp) XXX_Unmarshal(b []byte) error {
@@ -291,7 +331,7 @@ func (m *ValidateCredReq) Reset()         { *m = ValidateCredReq{} }
 func (m *ValidateCredReq) String() string { return proto.CompactTextString(m) }
 func (*ValidateCredReq) ProtoMessage()    {}
 func (*ValidateCredReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{4}
+	return fileDescriptor_c38ccbbe611799f4, []int{5}
 }
 
 func (m *ValidateCredReq) XXX_Unmarshal(b []byte) error {
@@ -333,7 +373,7 @@ func (m *ValidateCredResp) Reset()         { *m = ValidateCredResp{} }
 func (m *ValidateCredResp) String() string { return proto.CompactTextString(m) }
 func (*ValidateCredResp) ProtoMessage()    {}
 func (*ValidateCredResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{5}
+	return fileDescriptor_c38ccbbe611799f4, []int{6}
 }
 
 func (m *ValidateCredResp) XXX_Unmarshal(b []byte) error {
@@ -373,40 +413,42 @@ func init() {
 	proto.RegisterType((*Token)(nil), "auth.Token")
 	proto.RegisterType((*Sys)(nil), "auth.Sys")
 	proto.RegisterType((*Credential)(nil), "auth.Credential")
+	proto.RegisterType((*GetCredReq)(nil), "auth.GetCredReq")
 	proto.RegisterType((*GetCredResp)(nil), "auth.GetCredResp")
 	proto.RegisterType((*ValidateCredReq)(nil), "auth.ValidateCredReq")
 	proto.RegisterType((*ValidateCredResp)(nil), "auth.ValidateCredResp")
 }
 
 func init() {
-	proto.RegisterFile("auth.proto", fileDescriptor_8bbd6f3875b0e874)
-}
-
-var fileDescriptor_8bbd6f3875b0e874 = []byte{
-	// 392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xdd, 0xca, 0xd3, 0x40,
-	0x10, 0x35, 0x5f, 0x93, 0xd0, 0x4e, 0xaa, 0x86, 0x45, 0x24, 0x97, 0x31, 0x54, 0x2c, 0x82, 0x0d,
-	0xd4, 0x0b, 0x91, 0x5e, 0x55, 0xf1, 0x07, 0xc4, 0x0a, 0xdb, 0x2a, 0xe8, 0x8d, 0x6c, 0x37, 0xdb,
-	0x76, 0x69, 0x92, 0x8d, 0xbb, 0x9b, 0x62, 0x9f, 0xc4, 0xd7, 0x95, 0x9d, 0x84, 0xfa, 0x03, 0x5f,
-	0x6f, 0xc2, 0x39, 0x73, 0x66, 0xe6, 0x9c, 0x09, 0x0b, 0xc0, 0x5a, 0x7b, 0x98, 0x35, 0x5a, 0x59,
-	0x45, 0x7c, 0x87, 0xb3, 0x25, 0x04, 0x1b, 0x75, 0x14, 0x35, 0x99, 0x40, 0xb8, 0x2b, 0xd9, 0x49,
-	0xe9, 0xc4, 0x4b, 0xbd, 0xe9, 0xbd, 0xf9, 0x78, 0x86, 0xbd, 0x6f, 0xb1, 0x46, 0x7b, 0x8d, 0x10,
-	0xf0, 0x0b, 0x66, 0x59, 0x72, 0x93, 0x7a, 0xd3, 0x31, 0x45, 0x9c, 0xfd, 0xf2, 0x60, 0xb0, 0x3e,
-	0x1b, 0xf2, 0x00, 0x02, 0x63, 0x59, 0xd5, 0xe0, 0x02, 0x9f, 0x76, 0x84, 0xa4, 0x10, 0x55, 0x8c,
-	0x1f, 0x64, 0x2d, 0x6a, 0x56, 0x09, 0x1c, 0x1c, 0xd1, 0xbf, 0x4b, 0x6e, 0x67, 0x6b, 0x84, 0x4e,
-	0x06, 0x28, 0x21, 0x76, 0xbb, 0xf6, 0x5a, 0xb5, 0x4d, 0xe2, 0x63, 0xb1, 0x23, 0xe4, 0x21, 0x84,
-	0x08, 0x4c, 0x12, 0xa4, 0x83, 0xe9, 0x88, 0xf6, 0xcc, 0xd5, 0x8d, 0xe0, 0xdc, 0xfe, 0x4c, 0x42,
-	0x6c, 0xef, 0x59, 0xd6, 0x00, 0xbc, 0xd6, 0xa2, 0x10, 0xb5, 0x95, 0xac, 0x24, 0x8f, 0x20, 0xb0,
-	0xee, 0x54, 0xcc, 0x17, 0xcd, 0xa3, 0xee, 0x40, 0xbc, 0x9e, 0x76, 0x0a, 0x79, 0x02, 0xc3, 0x93,
-	0xd0, 0x72, 0x27, 0x85, 0xc6, 0xa4, 0xff, 0x75, 0x5d, 0x44, 0xe7, 0xa8, 0xb4, 0xdc, 0xcb, 0xba,
-	0x4f, 0xdd, 0xb3, 0xec, 0x03, 0x44, 0xef, 0x84, 0x75, 0xa6, 0x54, 0x18, 0x0c, 0x6c, 0x2c, 0xb3,
-	0xad, 0x41, 0xcf, 0x80, 0xf6, 0x8c, 0x4c, 0xc0, 0xe7, 0x5a, 0x14, 0xbd, 0x47, 0xdc, 0x79, 0xfc,
-	0x89, 0x4a, 0x51, 0xcd, 0x5e, 0xc0, 0xfd, 0x2f, 0xac, 0x94, 0x05, 0xb3, 0xa2, 0xdb, 0xf8, 0xe3,
-	0x32, 0xe8, 0x5d, 0x1d, 0xfc, 0x08, 0xf1, 0xbf, 0x83, 0x57, 0xa2, 0x5c, 0xfe, 0xca, 0xcd, 0x6d,
-	0x7f, 0xe5, 0xe9, 0x63, 0x08, 0xbb, 0x67, 0x40, 0xee, 0xc2, 0x68, 0xf9, 0x79, 0xf3, 0xfe, 0xfb,
-	0xea, 0xd3, 0xea, 0x4d, 0x7c, 0x87, 0x8c, 0x61, 0x88, 0x74, 0xfd, 0x75, 0x1d, 0x7b, 0xaf, 0x16,
-	0xdf, 0x5e, 0xee, 0xa5, 0x3d, 0xb4, 0xdb, 0x19, 0x57, 0x55, 0x5e, 0x30, 0x65, 0x9e, 0x19, 0xcb,
-	0xf8, 0x11, 0x61, 0x6e, 0x34, 0xcf, 0xb9, 0xaa, 0xad, 0x56, 0x65, 0x6e, 0x04, 0x6f, 0xb5, 0xb4,
-	0xe7, 0xdc, 0x79, 0x2d, 0xdc, 0x67, 0x1b, 0xe2, 0xa3, 0x7c, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0x4d, 0x98, 0xdc, 0xef, 0xa2, 0x02, 0x00, 0x00,
+	proto.RegisterFile("security/auth.proto", fileDescriptor_c38ccbbe611799f4)
+}
+
+var fileDescriptor_c38ccbbe611799f4 = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x5d, 0x8b, 0xd3, 0x40,
+	0x14, 0x35, 0xcd, 0x07, 0x9b, 0x9b, 0xaa, 0x61, 0x14, 0xc9, 0x93, 0xc4, 0xb0, 0x62, 0x11, 0x6c,
+	0x60, 0x7d, 0x10, 0xd9, 0xa7, 0x55, 0xfc, 0x00, 0x71, 0x85, 0xe9, 0x2a, 0xe8, 0x8b, 0xcc, 0x4e,
+	0x66, 0xdb, 0xa1, 0x49, 0x26, 0xce, 0x4c, 0x8a, 0xfd, 0x25, 0xfe, 0x5d, 0x99, 0x9b, 0xb4, 0x5a,
+	0xc4, 0xbe, 0x0c, 0xe7, 0xdc, 0x73, 0xbf, 0xce, 0x70, 0xe1, 0x9e, 0x11, 0xbc, 0xd7, 0xd2, 0x6e,
+	0x4b, 0xd6, 0xdb, 0xd5, 0xbc, 0xd3, 0xca, 0x2a, 0x12, 0x38, 0x5c, 0x5c, 0x40, 0x78, 0xa5, 0xd6,
+	0xa2, 0x25, 0xa7, 0x10, 0xdd, 0xd4, 0x6c, 0xa3, 0x74, 0xe6, 0xe5, 0xde, 0xec, 0xce, 0xd9, 0x74,
+	0x8e, 0xb9, 0x6f, 0x31, 0x46, 0x47, 0x8d, 0x10, 0x08, 0x2a, 0x66, 0x59, 0x36, 0xc9, 0xbd, 0xd9,
+	0x94, 0x22, 0x2e, 0x7e, 0x79, 0xe0, 0x2f, 0xb6, 0x86, 0xdc, 0x87, 0xd0, 0x58, 0xd6, 0x74, 0xd8,
+	0x20, 0xa0, 0x03, 0x21, 0x39, 0x24, 0x0d, 0xe3, 0x2b, 0xd9, 0x8a, 0x96, 0x35, 0x02, 0x0b, 0x63,
+	0xfa, 0x77, 0xc8, 0xf5, 0xec, 0x8d, 0xd0, 0x99, 0x8f, 0x12, 0x62, 0xd7, 0x6b, 0xa9, 0x55, 0xdf,
+	0x65, 0x01, 0x06, 0x07, 0x42, 0x1e, 0x40, 0x84, 0xc0, 0x64, 0x61, 0xee, 0xcf, 0x62, 0x3a, 0x32,
+	0x17, 0x37, 0x82, 0x73, 0xfb, 0x33, 0x8b, 0x30, 0x7d, 0x64, 0x45, 0x07, 0xf0, 0x5a, 0x8b, 0x4a,
+	0xb4, 0x56, 0xb2, 0x9a, 0x3c, 0x82, 0xd0, 0x3a, 0xab, 0xb8, 0x5f, 0x72, 0x96, 0x0c, 0x06, 0xd1,
+	0x3d, 0x1d, 0x14, 0xf2, 0x04, 0x4e, 0x36, 0x42, 0xcb, 0x1b, 0x29, 0x74, 0x36, 0xf9, 0x37, 0x6b,
+	0x2f, 0xba, 0x89, 0x4a, 0xcb, 0xa5, 0x6c, 0xc7, 0xad, 0x47, 0x56, 0x3c, 0x04, 0x78, 0x27, 0xac,
+	0x1b, 0x4a, 0xc5, 0x0f, 0x92, 0x82, 0x6f, 0xb6, 0x06, 0xe7, 0xc5, 0xd4, 0xc1, 0xe2, 0x03, 0x24,
+	0x7b, 0xdd, 0xa0, 0x21, 0x63, 0x99, 0xed, 0x87, 0x9c, 0x90, 0x8e, 0x8c, 0x9c, 0x42, 0xc0, 0xb5,
+	0xa8, 0xc6, 0x1d, 0xd2, 0x61, 0x87, 0x3f, 0x56, 0x28, 0xaa, 0xc5, 0x0b, 0xb8, 0xfb, 0x85, 0xd5,
+	0xb2, 0x62, 0x56, 0xec, 0x26, 0xee, 0x0a, 0xbd, 0xa3, 0x85, 0x1f, 0x21, 0x3d, 0x2c, 0x3c, 0xb2,
+	0xca, 0xfe, 0xd7, 0x26, 0xff, 0xfb, 0xb5, 0xa7, 0x8f, 0x21, 0x1a, 0xce, 0x84, 0xdc, 0x86, 0xf8,
+	0xe2, 0xf3, 0xd5, 0xfb, 0xef, 0x97, 0x9f, 0x2e, 0xdf, 0xa4, 0xb7, 0xc8, 0x14, 0x4e, 0x90, 0x2e,
+	0xbe, 0x2e, 0x52, 0xef, 0xd5, 0xf9, 0xb7, 0x97, 0x4b, 0x69, 0x57, 0xfd, 0xf5, 0x9c, 0xab, 0xa6,
+	0xac, 0x98, 0x32, 0xcf, 0x8c, 0x65, 0x7c, 0x8d, 0xb0, 0x34, 0x9a, 0x97, 0x5c, 0xb5, 0x56, 0xab,
+	0xba, 0x3c, 0x38, 0xd7, 0x73, 0xf7, 0x5c, 0x47, 0x78, 0xb4, 0xcf, 0x7f, 0x0f, 0x00, 0xac, 0xd5,
+	0x9d, 0x1a, 0xcb, 0x02, 0x00, 0x00,
 }
@@ -11,6 +11,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"sync"
 
 	"github.com/pkg/errors"
 )
@@ -32,7 +33,9 @@ const (
 // TransportConfig contains all the information on whether or not to use
 // certificates and their location if their use is specified.
 type TransportConfig struct {
-	AllowInsecure     bool `yaml:"allow_insecure"`
+	AllowInsecure     bool           `yaml:"allow_insecure"`
+	RoleMappings      []*RoleMapping `yaml:"role_mappings,omitempty"`
+	AccessPolicyPath  string         `yaml:"access_policy,omitempty"`
 	CertificateConfig `yaml:",inline"`
 }
 
@@ -51,6 +54,7 @@ type CertificateConfig struct {
 	PrivateKeyPath  string           `yaml:"key"`
 	tlsKeypair      *tls.Certificate `yaml:"-"`
 	caPool          *x509.CertPool   `yaml:"-"`
+	certLock        sync.RWMutex
 }
 
 // DefaultAgentTransportConfig provides a default transport config disabling
@@ -107,6 +111,37 @@ func DefaultServerTransportConfig() *TransportConfig {
 	}
 }
 
+// loadCertData reads the certificate files in and parses them into a TLS key
+// pair and Certificate pool without modifying the TransportConfig.
+func (tc *TransportConfig) loadCertData() (*tls.Certificate, *x509.CertPool, error) {
+	certificate, certPool, err := loadCertWithCustomCA(tc.CARootPath, tc.CertificatePath, tc.PrivateKeyPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Pre-parse the Leaf Certificate
+	certificate.Leaf, err = x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return certificate, certPool, nil
+}
+
+// certData returns the currently loaded TLS key pair and Certificate pool.
+func (tc *TransportConfig) certData() (*tls.Certificate, *x509.CertPool) {
+	tc.certLock.RLock()
+	defer tc.certLock.RUnlock()
+
+	return tc.tlsKeypair, tc.caPool
+}
+
+// certDataLoaded indicates whether certificate data has been loaded.
+func (tc *TransportConfig) certDataLoaded() bool {
+	keypair, pool := tc.certData()
+	return keypair != nil && pool != nil
+}
+
 // PreLoadCertData reads the certificate files in and parses them into TLS key
 // pair and Certificate pool to provide a mechanism for detecting certificate/
 // error before first use.
@@ -114,49 +149,49 @@ func (tc *TransportConfig) PreLoadCertData() error {
 	if tc == nil {
 		return errors.New("nil TransportConfig")
 	}
-	if tc.tlsKeypair != nil && tc.caPool != nil || tc.AllowInsecure {
+	if tc.AllowInsecure || tc.certDataLoaded() {
 		// In this case the data is already preloaded.
-		// In order to reload data use ReloadCertDatA
+		// In order to reload data use ReloadCertData
 		return nil
 	}
-	certificate, certPool, err := loadCertWithCustomCA(tc.CARootPath, tc.CertificatePath, tc.PrivateKeyPath)
+
+	return tc.ReloadCertData()
+}
+
+// ReloadCertData reloads and stores the certificate data in the case when
+// certificate data has changed since initial loading. The previously loaded
+// data is retained if the new data cannot be loaded, and connections
+// established after a successful reload use the new data.
+func (tc *TransportConfig) ReloadCertData() error {
+	if tc == nil {
+		return errors.New("nil TransportConfig")
+	}
+
+	certificate, certPool, err := tc.loadCertData()
 	if err != nil {
 		return err
 	}
 
+	tc.certLock.Lock()
+	defer tc.certLock.Unlock()
+
 	tc.tlsKeypair = certificate
 	tc.caPool = certPool
 
-	// Pre-parse the Leaf Certificate
-	tc.tlsKeypair.Leaf, err = x509.ParseCertificate(tc.tlsKeypair.Certificate[0])
-	if err != nil {
-		return err
-	}
-
 	return nil
 }
 
-// ReloadCertData reloads and stores the certificate data in the case when
-// certificate data has changed since initial loading.
-func (tc *TransportConfig) ReloadCertData() error {
-	tc.tlsKeypair = nil
-	tc.caPool = nil
-	return tc.PreLoadCertData()
-}
-
 // PrivateKey returns the private key stored in the certificates loaded into the TransportConfig
 func (tc *TransportConfig) PrivateKey() (crypto.PrivateKey, error) {
 	if tc.AllowInsecure {
 		return nil, nil
 	}
 	// If we don't have our keys loaded attempt to load them.
-	if tc.tlsKeypair == nil || tc.caPool == nil {
-		err := tc.ReloadCertData()
-		if err != nil {
-			return nil, err
-		}
+	if err := tc.PreLoadCertData(); err != nil {
+		return nil, err
 	}
-	return tc.tlsKeypair.PrivateKey, nil
+	keypair, _ := tc.certData()
+	return keypair.PrivateKey, nil
 }
 
 // PublicKey returns the private key stored in the certificates loaded into the TransportConfig
@@ -165,11 +200,9 @@ func (tc *TransportConfig) PublicKey() (crypto.PublicKey, error) {
 		return nil, nil
 	}
 	// If we don't have our keys loaded attempt to load them.
-	if tc.tlsKeypair == nil || tc.caPool == nil {
-		err := tc.ReloadCertData()
-		if err != nil {
-			return nil, err
-		}
+	if err := tc.PreLoadCertData(); err != nil {
+		return nil, err
 	}
-	return tc.tlsKeypair.Leaf.PublicKey, nil
+	keypair, _ := tc.certData()
+	return keypair.Leaf.PublicKey, nil
 }
@@ -159,6 +159,63 @@ func TestReloadCertData(t *testing.T) {
 	}
 }
 
+func TestReloadCertData_Failure(t *testing.T) {
+	serverTC := ServerTC()
+	badTC := BadTC()
+
+	SetupTCFilePerms(t, serverTC)
+	SetupTCFilePerms(t, badTC)
+
+	if err := serverTC.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+	beforeCert := serverTC.tlsKeypair.Certificate[0]
+
+	serverTC.CertificatePath = badTC.CertificatePath
+	serverTC.PrivateKeyPath = badTC.PrivateKeyPath
+
+	if err := serverTC.ReloadCertData(); err == nil {
+		t.Fatal("expected reload of bad certificate to fail")
+	}
+
+	ValidateGood(t, serverTC, nil)
+	if !bytes.Equal(beforeCert, serverTC.tlsKeypair.Certificate[0]) {
+		t.Fatal("cert data changed after failed reload")
+	}
+}
+
+func TestServerTLSConfig_Reload(t *testing.T) {
+	serverTC := ServerTC()
+	agentTC := AgentTC()
+
+	SetupTCFilePerms(t, serverTC)
+	SetupTCFilePerms(t, agentTC)
+
+	if err := serverTC.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+	tlsCfg := serverTLSConfig(serverTC)
+
+	getCert := func() []byte {
+		connCfg, err := tlsCfg.GetConfigForClient(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return connCfg.Certificates[0].Certificate[0]
+	}
+	beforeCert := getCert()
+
+	serverTC.CertificatePath = agentTC.CertificatePath
+	serverTC.PrivateKeyPath = agentTC.PrivateKeyPath
+	if err := serverTC.ReloadCertData(); err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Equal(beforeCert, getCert()) {
+		t.Fatal("server TLS config did not pick up reloaded cert")
+	}
+}
+
 func ValidateInsecurePrivateKey(t *testing.T, key crypto.PrivateKey, err error) {
 	if err != nil {
 		t.Fatalf("Unable to Load PrivateKey from TransportConfig: %s", err)
@@ -22,43 +22,62 @@ func (c Component) String() string {
 
 // methodAuthorizations is the map for checking which components are authorized to make the specific method call.
 var methodAuthorizations = map[string][]Component{
-	"/ctl.CtlSvc/StoragePrepare":      {ComponentAdmin},
-	"/ctl.CtlSvc/StorageScan":         {ComponentAdmin},
-	"/ctl.CtlSvc/StorageFormat":       {ComponentAdmin},
-	"/ctl.CtlSvc/NetworkScan":         {ComponentAdmin},
-	"/ctl.CtlSvc/FirmwareQuery":       {ComponentAdmin},
-	"/ctl.CtlSvc/FirmwareUpdate":      {ComponentAdmin},
-	"/ctl.CtlSvc/SmdQuery":            {ComponentAdmin},
-	"/ctl.CtlSvc/PrepShutdownRanks":   {ComponentServer},
-	"/ctl.CtlSvc/StopRanks":           {ComponentServer},
-	"/ctl.CtlSvc/PingRanks":           {ComponentServer},
-	"/ctl.CtlSvc/ResetFormatRanks":    {ComponentServer},
-	"/ctl.CtlSvc/StartRanks":          {ComponentServer},
-	"/mgmt.MgmtSvc/Join":              {ComponentServer},
-	"/mgmt.MgmtSvc/ClusterEvent":      {ComponentServer},
-	"/mgmt.MgmtSvc/LeaderQuery":       {ComponentAdmin},
-	"/mgmt.MgmtSvc/SystemQuery":       {ComponentAdmin},
-	"/mgmt.MgmtSvc/SystemResetFormat": {ComponentAdmin},
-	"/mgmt.MgmtSvc/SystemStart":       {ComponentAdmin},
-	"/mgmt.MgmtSvc/SystemStop":        {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolCreate":        {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolDestroy":       {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolResolveID":     {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolQuery":         {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolSetProp":       {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolGetACL":        {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolOverwriteACL":  {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolUpdateACL":     {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolDeleteACL":     {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolExclude":       {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolDrain":         {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolReintegrate":   {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolEvict":         {ComponentAdmin, ComponentAgent},
-	"/mgmt.MgmtSvc/PoolExtend":        {ComponentAdmin},
-	"/mgmt.MgmtSvc/GetAttachInfo":     {ComponentAgent},
-	"/mgmt.MgmtSvc/ListPools":         {ComponentAdmin},
-	"/mgmt.MgmtSvc/ListContainers":    {ComponentAdmin},
-	"/mgmt.MgmtSvc/ContSetOwner":      {ComponentAdmin},
+	"/ctl.CtlSvc/StoragePrepare":        {ComponentAdmin},
+	"/ctl.CtlSvc/StorageScan":           {ComponentAdmin},
+	"/ctl.CtlSvc/StorageFormat":         {ComponentAdmin},
+	"/ctl.CtlSvc/NetworkScan":           {ComponentAdmin},
+	"/ctl.CtlSvc/FirmwareQuery":         {ComponentAdmin},
+	"/ctl.CtlSvc/FirmwareUpdate":        {ComponentAdmin},
+	"/ctl.CtlSvc/SmdQuery":              {ComponentAdmin},
+	"/ctl.CtlSvc/PrepShutdownRanks":     {ComponentServer},
+	"/ctl.CtlSvc/StopRanks":             {ComponentServer},
+	"/ctl.CtlSvc/PingRanks":             {ComponentServer},
+	"/ctl.CtlSvc/ResetFormatRanks":      {ComponentServer},
+	"/ctl.CtlSvc/StartRanks":            {ComponentServer},
+	"/ctl.CtlSvc/SetEngineLogMasks":     {ComponentAdmin},
+	"/ctl.CtlSvc/ReloadConfig":          {ComponentAdmin},
+	"/mgmt.MgmtSvc/Join":                {ComponentServer},
+	"/mgmt.MgmtSvc/ClusterEvent":        {ComponentServer},
+	"/mgmt.MgmtSvc/LeaderQuery":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemQuery":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/ListEvents":          {ComponentAdmin},
+	"/mgmt.MgmtSvc/SubscribeEvents":     {ComponentAdmin, ComponentAgent},
+	"/mgmt.MgmtSvc/SystemSetPolicy":     {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemRestart":       {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemDbBackup":      {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemReplicaList":   {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemReplicaAdd":    {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemReplicaRemove": {ComponentAdmin},
+	"/mgmt.MgmtSvc/ReplicaStart":        {ComponentServer},
+	"/mgmt.MgmtSvc/QuotaSet":            {ComponentAdmin},
+	"/mgmt.MgmtSvc/QuotaGet":            {ComponentAdmin},
+	"/mgmt.MgmtSvc/QuotaList":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemResetFormat":   {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemStart":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemStop":          {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolCreate":          {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolDestroy":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolResolveID":       {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolQuery":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolSetProp":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolGetProp":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolGetACL":          {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolOverwriteACL":    {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolUpdateACL":       {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolDeleteACL":       {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolExclude":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolDrain":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolReintegrate":     {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolEvict":           {ComponentAdmin, ComponentAgent},
+	"/mgmt.MgmtSvc/PoolExtend":          {ComponentAdmin},
+	"/mgmt.MgmtSvc/GetAttachInfo":       {ComponentAgent},
+	"/mgmt.MgmtSvc/ListPools":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/ListContainers":      {ComponentAdmin},
+	"/mgmt.MgmtSvc/ContSetOwner":        {ComponentAdmin},
+	"/mgmt.MgmtSvc/ContQuery":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/ContDestroy":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/ContGetProp":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/ContSetProp":         {ComponentAdmin},
 }
 
 // HasAccess check if the given component has access to method given in FullMethod
@@ -28,10 +28,22 @@ import (
 // validate the certificate chain.
 
 func serverTLSConfig(cfg *TransportConfig) *tls.Config {
+	return &tls.Config{
+		// Build the configuration for each new connection so that
+		// reloaded certificate data is used without a restart.
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return serverConnTLSConfig(cfg), nil
+		},
+	}
+}
+
+func serverConnTLSConfig(cfg *TransportConfig) *tls.Config {
+	keypair, caPool := cfg.certData()
 	return &tls.Config{
 		ClientAuth:               tls.RequireAndVerifyClientCert,
-		Certificates:             []tls.Certificate{*cfg.tlsKeypair},
-		ClientCAs:                cfg.caPool,
+		Certificates:             []tls.Certificate{*keypair},
+		ClientCAs:                caPool,
+		NextProtos:               []string{"h2"},
 		MinVersion:               tls.VersionTLS12,
 		MaxVersion:               tls.VersionTLS12,
 		PreferServerCipherSuites: true,
@@ -40,7 +52,7 @@ func serverTLSConfig(cfg *TransportConfig) *tls.Config {
 		},
 		VerifyConnection: func(cs tls.ConnectionState) error {
 			opts := x509.VerifyOptions{
-				Roots:         cfg.caPool,
+				Roots:         caPool,
 				Intermediates: x509.NewCertPool(),
 				KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
 			}
@@ -56,9 +68,10 @@ func serverTLSConfig(cfg *TransportConfig) *tls.Config {
 const ServerCommonName = "server"
 
 func clientTLSConfig(cfg *TransportConfig) *tls.Config {
+	keypair, caPool := cfg.certData()
 	return &tls.Config{
-		Certificates:             []tls.Certificate{*cfg.tlsKeypair},
-		RootCAs:                  cfg.caPool,
+		Certificates:             []tls.Certificate{*keypair},
+		RootCAs:                  caPool,
 		MinVersion:               tls.VersionTLS12,
 		MaxVersion:               tls.VersionTLS12,
 		PreferServerCipherSuites: true,
@@ -79,7 +92,7 @@ func clientTLSConfig(cfg *TransportConfig) *tls.Config {
 		// communicating with a DAOS server.
 		VerifyConnection: func(cs tls.ConnectionState) error {
 			opts := x509.VerifyOptions{
-				Roots:         cfg.caPool,
+				Roots:         caPool,
 				Intermediates: x509.NewCertPool(),
 			}
 			for _, cert := range cs.PeerCertificates[1:] {
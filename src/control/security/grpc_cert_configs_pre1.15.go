@@ -11,10 +11,22 @@ package security
 import "crypto/tls"
 
 func serverTLSConfig(cfg *TransportConfig) *tls.Config {
+	return &tls.Config{
+		// Build the configuration for each new connection so that
+		// reloaded certificate data is used without a restart.
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return serverConnTLSConfig(cfg), nil
+		},
+	}
+}
+
+func serverConnTLSConfig(cfg *TransportConfig) *tls.Config {
+	keypair, caPool := cfg.certData()
 	return &tls.Config{
 		ClientAuth:               tls.RequireAndVerifyClientCert,
-		Certificates:             []tls.Certificate{*cfg.tlsKeypair},
-		ClientCAs:                cfg.caPool,
+		Certificates:             []tls.Certificate{*keypair},
+		ClientCAs:                caPool,
+		NextProtos:               []string{"h2"},
 		MinVersion:               tls.VersionTLS12,
 		MaxVersion:               tls.VersionTLS12,
 		PreferServerCipherSuites: true,
@@ -25,10 +37,11 @@ func serverTLSConfig(cfg *TransportConfig) *tls.Config {
 }
 
 func clientTLSConfig(cfg *TransportConfig) *tls.Config {
+	keypair, caPool := cfg.certData()
 	return &tls.Config{
 		ServerName:               cfg.ServerName,
-		Certificates:             []tls.Certificate{*cfg.tlsKeypair},
-		RootCAs:                  cfg.caPool,
+		Certificates:             []tls.Certificate{*keypair},
+		RootCAs:                  caPool,
 		MinVersion:               tls.VersionTLS12,
 		MaxVersion:               tls.VersionTLS12,
 		PreferServerCipherSuites: true,
@@ -17,7 +17,7 @@ func GetServerTransportCredentials(cfg *TransportConfig) (credentials.TransportC
 		return nil, errors.New("nil TransportConfig")
 	}
 
-	if cfg.tlsKeypair == nil || cfg.caPool == nil {
+	if !cfg.certDataLoaded() {
 		err := cfg.PreLoadCertData()
 		if err != nil {
 			return nil, err
@@ -33,7 +33,7 @@ func GetClientTransportCredentials(cfg *TransportConfig) (credentials.TransportC
 		return nil, errors.New("nil TransportConfig")
 	}
 
-	if cfg.tlsKeypair == nil || cfg.caPool == nil {
+	if !cfg.certDataLoaded() {
 		err := cfg.PreLoadCertData()
 		if err != nil {
 			return nil, err
@@ -0,0 +1,255 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/x509"
+	"io/ioutil"
+	"path"
+	"sort"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Built-in roles which may be granted to administrative certificates.
+const (
+	// RoleOperator may only call methods that do not modify the system.
+	RoleOperator = "operator"
+	// RolePoolAdmin may additionally manage pools and containers.
+	RolePoolAdmin = "pool-admin"
+	// RoleSystemAdmin may call all administrative methods.
+	RoleSystemAdmin = "system-admin"
+)
+
+var operatorMethods = []string{
+	"/ctl.CtlSvc/StorageScan",
+	"/ctl.CtlSvc/NetworkScan",
+	"/ctl.CtlSvc/FirmwareQuery",
+	"/ctl.CtlSvc/SmdQuery",
+	"/mgmt.MgmtSvc/LeaderQuery",
+	"/mgmt.MgmtSvc/SystemQuery",
+	"/mgmt.MgmtSvc/ListEvents",
+	"/mgmt.MgmtSvc/SubscribeEvents",
+	"/mgmt.MgmtSvc/SystemReplicaList",
+	"/mgmt.MgmtSvc/QuotaGet",
+	"/mgmt.MgmtSvc/QuotaList",
+	"/mgmt.MgmtSvc/PoolResolveID",
+	"/mgmt.MgmtSvc/PoolQuery",
+	"/mgmt.MgmtSvc/PoolGetACL",
+	"/mgmt.MgmtSvc/PoolGetProp",
+	"/mgmt.MgmtSvc/ListPools",
+	"/mgmt.MgmtSvc/ListContainers",
+	"/mgmt.MgmtSvc/ContQuery",
+	"/mgmt.MgmtSvc/ContGetProp",
+}
+
+// defaultRoles returns the method patterns allowed for each built-in role.
+func defaultRoles() map[string][]string {
+	return map[string][]string{
+		RoleOperator: append([]string{}, operatorMethods...),
+		RolePoolAdmin: append([]string{
+			"/mgmt.MgmtSvc/Pool*",
+			"/mgmt.MgmtSvc/Cont*",
+		}, operatorMethods...),
+		RoleSystemAdmin: {
+			"/ctl.CtlSvc/*",
+			"/mgmt.MgmtSvc/*",
+		},
+	}
+}
+
+// RoleMapping grants a role to administrative certificates whose attributes
+// match all of the non-empty fields in the mapping.
+type RoleMapping struct {
+	Role               string `yaml:"role"`
+	CommonName         string `yaml:"cn,omitempty"`
+	OrganizationalUnit string `yaml:"ou,omitempty"`
+	SubjectAltName     string `yaml:"san,omitempty"` // DNS name, email address, URI or IP
+}
+
+// Matches returns true if the certificate has the attributes required by
+// the mapping.
+func (rm *RoleMapping) Matches(cert *x509.Certificate) bool {
+	if cert == nil {
+		return false
+	}
+	if rm.CommonName == "" && rm.OrganizationalUnit == "" && rm.SubjectAltName == "" {
+		return false
+	}
+
+	if rm.CommonName != "" && rm.CommonName != cert.Subject.CommonName {
+		return false
+	}
+	if rm.OrganizationalUnit != "" && !containsString(cert.Subject.OrganizationalUnit, rm.OrganizationalUnit) {
+		return false
+	}
+	if rm.SubjectAltName != "" && !containsString(certSANs(cert), rm.SubjectAltName) {
+		return false
+	}
+
+	return true
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func certSANs(cert *x509.Certificate) []string {
+	sans := append([]string{}, cert.DNSNames...)
+	sans = append(sans, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	return sans
+}
+
+// accessPolicyFile is the format of the per-method access policy file.
+type accessPolicyFile struct {
+	Roles map[string][]string `yaml:"roles"`
+}
+
+// Authorizer determines whether the holder of a certificate may call a
+// gRPC method. Server and agent certificates are authorized by component.
+// If no role mappings are configured, administrative certificates may call
+// all administrative methods. Otherwise, a certificate is authorized by
+// the roles that it maps to and may only call administrative methods that
+// are allowed for one of those roles.
+type Authorizer struct {
+	mappings []*RoleMapping
+	roles    map[string][]string
+}
+
+// NewAuthorizer creates an Authorizer from the role mappings and access
+// policy file in the supplied TransportConfig. Roles defined in the policy
+// file are added to, or replace, the built-in roles.
+func NewAuthorizer(cfg *TransportConfig) (*Authorizer, error) {
+	if cfg == nil {
+		return nil, errors.New("nil TransportConfig")
+	}
+
+	roles := defaultRoles()
+	if cfg.AccessPolicyPath != "" {
+		data, err := ioutil.ReadFile(cfg.AccessPolicyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read access policy")
+		}
+		var policy accessPolicyFile
+		if err := yaml.UnmarshalStrict(data, &policy); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse access policy %s", cfg.AccessPolicyPath)
+		}
+		for role, patterns := range policy.Roles {
+			if err := validateRolePatterns(role, patterns); err != nil {
+				return nil, errors.Wrapf(err, "invalid access policy %s", cfg.AccessPolicyPath)
+			}
+			roles[role] = patterns
+		}
+	}
+
+	for _, rm := range cfg.RoleMappings {
+		if rm == nil {
+			continue
+		}
+		if _, found := roles[rm.Role]; !found {
+			return nil, errors.Errorf("role mapping refers to unknown role %q", rm.Role)
+		}
+		if rm.CommonName == "" && rm.OrganizationalUnit == "" && rm.SubjectAltName == "" {
+			return nil, errors.Errorf("role mapping for %q does not specify any certificate attributes", rm.Role)
+		}
+	}
+
+	return &Authorizer{
+		mappings: cfg.RoleMappings,
+		roles:    roles,
+	}, nil
+}
+
+// validateRolePatterns checks that each method pattern for a role is valid
+// and matches at least one administrative method.
+func validateRolePatterns(role string, patterns []string) error {
+	for _, pattern := range patterns {
+		matched := false
+		for method := range methodAuthorizations {
+			ok, err := path.Match(pattern, method)
+			if err != nil {
+				return errors.Wrapf(err, "role %q: bad method pattern %q", role, pattern)
+			}
+			if ok && ComponentAdmin.HasAccess(method) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return errors.Errorf("role %q: method pattern %q matches no administrative methods", role, pattern)
+		}
+	}
+	return nil
+}
+
+// RBACEnabled returns true if administrative access is controlled by roles.
+func (a *Authorizer) RBACEnabled() bool {
+	return a != nil && len(a.mappings) > 0
+}
+
+// Roles returns the sorted set of roles granted to the certificate.
+func (a *Authorizer) Roles(cert *x509.Certificate) []string {
+	if a == nil {
+		return nil
+	}
+
+	roleSet := make(map[string]struct{})
+	for _, rm := range a.mappings {
+		if rm != nil && rm.Matches(cert) {
+			roleSet[rm.Role] = struct{}{}
+		}
+	}
+
+	roles := make([]string, 0, len(roleSet))
+	for role := range roleSet {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	return roles
+}
+
+// HasAccess returns true if the holder of the certificate may call the
+// method given in fullMethod.
+func (a *Authorizer) HasAccess(cert *x509.Certificate, fullMethod string) bool {
+	if cert == nil {
+		return false
+	}
+
+	comp := CommonNameToComponent(cert.Subject.CommonName)
+	if comp == ComponentAgent || comp == ComponentServer || !a.RBACEnabled() {
+		return comp.HasAccess(fullMethod)
+	}
+
+	// Roles can never grant access to methods reserved for other
+	// components.
+	if !ComponentAdmin.HasAccess(fullMethod) {
+		return false
+	}
+
+	for _, role := range a.Roles(cert) {
+		for _, pattern := range a.roles[role] {
+			if ok, _ := path.Match(pattern, fullMethod); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
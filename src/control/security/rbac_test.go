@@ -0,0 +1,279 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+)
+
+func mockCert(cn string, ous []string, dnsNames ...string) *x509.Certificate {
+	return &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:         cn,
+			OrganizationalUnit: ous,
+		},
+		DNSNames: dnsNames,
+	}
+}
+
+func TestSecurity_RoleMapping_Matches(t *testing.T) {
+	uri, err := url.Parse("spiffe://daos/ops")
+	if err != nil {
+		t.Fatal(err)
+	}
+	uriCert := mockCert("admin", nil)
+	uriCert.URIs = []*url.URL{uri}
+
+	for name, tc := range map[string]struct {
+		mapping  RoleMapping
+		cert     *x509.Certificate
+		expMatch bool
+	}{
+		"nil cert": {
+			mapping: RoleMapping{CommonName: "admin"},
+		},
+		"no attributes": {
+			mapping: RoleMapping{Role: RoleOperator},
+			cert:    mockCert("admin", nil),
+		},
+		"cn match": {
+			mapping:  RoleMapping{CommonName: "admin"},
+			cert:     mockCert("admin", nil),
+			expMatch: true,
+		},
+		"ou match": {
+			mapping:  RoleMapping{OrganizationalUnit: "storage"},
+			cert:     mockCert("admin", []string{"hpc", "storage"}),
+			expMatch: true,
+		},
+		"ou mismatch": {
+			mapping: RoleMapping{OrganizationalUnit: "storage"},
+			cert:    mockCert("admin", []string{"hpc"}),
+		},
+		"dns san match": {
+			mapping:  RoleMapping{SubjectAltName: "ops.example.com"},
+			cert:     mockCert("admin", nil, "ops.example.com"),
+			expMatch: true,
+		},
+		"uri san match": {
+			mapping:  RoleMapping{SubjectAltName: "spiffe://daos/ops"},
+			cert:     uriCert,
+			expMatch: true,
+		},
+		"all attributes required": {
+			mapping: RoleMapping{CommonName: "admin", OrganizationalUnit: "storage"},
+			cert:    mockCert("admin", []string{"hpc"}),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			common.AssertEqual(t, tc.expMatch, tc.mapping.Matches(tc.cert), "unexpected match result")
+		})
+	}
+}
+
+func TestSecurity_NewAuthorizer(t *testing.T) {
+	tmpDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+
+	writePolicy := func(t *testing.T, name, content string) string {
+		t.Helper()
+		policyPath := filepath.Join(tmpDir, name)
+		if err := ioutil.WriteFile(policyPath, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return policyPath
+	}
+
+	for name, tc := range map[string]struct {
+		policy   string
+		mappings []*RoleMapping
+		expErr   error
+	}{
+		"defaults": {},
+		"built-in role": {
+			mappings: []*RoleMapping{{Role: RoleOperator, OrganizationalUnit: "ops"}},
+		},
+		"unknown role": {
+			mappings: []*RoleMapping{{Role: "janitor", OrganizationalUnit: "ops"}},
+			expErr:   errors.New("unknown role \"janitor\""),
+		},
+		"mapping without attributes": {
+			mappings: []*RoleMapping{{Role: RoleOperator}},
+			expErr:   errors.New("does not specify any certificate attributes"),
+		},
+		"custom role": {
+			policy:   "roles:\n  janitor:\n  - /mgmt.MgmtSvc/PoolEvict\n",
+			mappings: []*RoleMapping{{Role: "janitor", OrganizationalUnit: "ops"}},
+		},
+		"bad yaml": {
+			policy: "roles: [",
+			expErr: errors.New("failed to parse access policy"),
+		},
+		"unknown method": {
+			policy: "roles:\n  janitor:\n  - /mgmt.MgmtSvc/Sweep\n",
+			expErr: errors.New("matches no administrative methods"),
+		},
+		"non-admin method": {
+			policy: "roles:\n  janitor:\n  - /mgmt.MgmtSvc/Join\n",
+			expErr: errors.New("matches no administrative methods"),
+		},
+		"bad pattern": {
+			policy: "roles:\n  janitor:\n  - /mgmt.MgmtSvc/[\n",
+			expErr: errors.New("bad method pattern"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := &TransportConfig{RoleMappings: tc.mappings}
+			if tc.policy != "" {
+				cfg.AccessPolicyPath = writePolicy(t, "policy.yml", tc.policy)
+				defer os.Remove(cfg.AccessPolicyPath)
+			}
+
+			_, gotErr := NewAuthorizer(cfg)
+			common.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestSecurity_Authorizer_HasAccess(t *testing.T) {
+	tmpDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+
+	policyPath := filepath.Join(tmpDir, "policy.yml")
+	policy := "roles:\n  evictor:\n  - /mgmt.MgmtSvc/PoolEvict\n  - /mgmt.MgmtSvc/ListPools\n"
+	if err := ioutil.WriteFile(policyPath, []byte(policy), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	rbacCfg := &TransportConfig{
+		AccessPolicyPath: policyPath,
+		RoleMappings: []*RoleMapping{
+			{Role: RoleOperator, OrganizationalUnit: "ops"},
+			{Role: RolePoolAdmin, OrganizationalUnit: "pools"},
+			{Role: RoleSystemAdmin, CommonName: "admin", OrganizationalUnit: "root"},
+			{Role: "evictor", SubjectAltName: "evict.example.com"},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		cfg       *TransportConfig
+		cert      *x509.Certificate
+		method    string
+		expAccess bool
+		expRoles  []string
+	}{
+		"legacy admin": {
+			cfg:       &TransportConfig{},
+			cert:      mockCert("admin", nil),
+			method:    "/mgmt.MgmtSvc/SystemResetFormat",
+			expAccess: true,
+			expRoles:  []string{},
+		},
+		"legacy agent": {
+			cfg:       &TransportConfig{},
+			cert:      mockCert("agent", nil),
+			method:    "/mgmt.MgmtSvc/GetAttachInfo",
+			expAccess: true,
+			expRoles:  []string{},
+		},
+		"nil cert": {
+			cfg:    &TransportConfig{},
+			method: "/mgmt.MgmtSvc/SystemQuery",
+		},
+		"admin without role": {
+			cfg:      rbacCfg,
+			cert:     mockCert("admin", nil),
+			method:   "/mgmt.MgmtSvc/SystemQuery",
+			expRoles: []string{},
+		},
+		"server unaffected by roles": {
+			cfg:       rbacCfg,
+			cert:      mockCert("server", nil),
+			method:    "/mgmt.MgmtSvc/Join",
+			expAccess: true,
+			expRoles:  []string{},
+		},
+		"operator may query": {
+			cfg:       rbacCfg,
+			cert:      mockCert("admin", []string{"ops"}),
+			method:    "/mgmt.MgmtSvc/SystemQuery",
+			expAccess: true,
+			expRoles:  []string{RoleOperator},
+		},
+		"operator may not destroy pool": {
+			cfg:      rbacCfg,
+			cert:     mockCert("admin", []string{"ops"}),
+			method:   "/mgmt.MgmtSvc/PoolDestroy",
+			expRoles: []string{RoleOperator},
+		},
+		"pool admin may destroy pool": {
+			cfg:       rbacCfg,
+			cert:      mockCert("admin", []string{"pools"}),
+			method:    "/mgmt.MgmtSvc/PoolDestroy",
+			expAccess: true,
+			expRoles:  []string{RolePoolAdmin},
+		},
+		"pool admin may not format": {
+			cfg:      rbacCfg,
+			cert:     mockCert("admin", []string{"pools"}),
+			method:   "/ctl.CtlSvc/StorageFormat",
+			expRoles: []string{RolePoolAdmin},
+		},
+		"system admin may format": {
+			cfg:       rbacCfg,
+			cert:      mockCert("admin", []string{"root", "ops"}),
+			method:    "/ctl.CtlSvc/StorageFormat",
+			expAccess: true,
+			expRoles:  []string{RoleOperator, RoleSystemAdmin},
+		},
+		"system admin may not stop ranks": {
+			cfg:      rbacCfg,
+			cert:     mockCert("admin", []string{"root"}),
+			method:   "/ctl.CtlSvc/StopRanks",
+			expRoles: []string{RoleSystemAdmin},
+		},
+		"custom role": {
+			cfg:       rbacCfg,
+			cert:      mockCert("evict-bot", nil, "evict.example.com"),
+			method:    "/mgmt.MgmtSvc/PoolEvict",
+			expAccess: true,
+			expRoles:  []string{"evictor"},
+		},
+		"custom role denied": {
+			cfg:      rbacCfg,
+			cert:     mockCert("evict-bot", nil, "evict.example.com"),
+			method:   "/mgmt.MgmtSvc/PoolQuery",
+			expRoles: []string{"evictor"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			auth, err := NewAuthorizer(tc.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			common.AssertEqual(t, tc.expAccess, auth.HasAccess(tc.cert, tc.method), "unexpected access result")
+			if tc.cert == nil {
+				return
+			}
+			if diff := cmp.Diff(tc.expRoles, auth.Roles(tc.cert)); diff != "" {
+				t.Fatalf("unexpected roles (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -80,8 +80,44 @@ var (
 		"fault domain callback executed but did not generate output",
 		"specify a valid fault domain callback script ('fault_cb' parameter) and restart the control server",
 	)
+	FaultConfigBadAutoExcludeGracePeriod = serverConfigFault(
+		code.ServerConfigBadAutoExcludeGracePeriod,
+		"invalid automatic pool exclusion grace period in configuration",
+		"specify a non-negative duration (e.g. 5m) in configuration ('auto_exclude' 'grace_period' parameter) and restart the control server",
+	)
+	FaultConfigBadMetricsAddress = serverConfigFault(
+		code.ServerConfigBadMetricsAddress,
+		"invalid metrics listener address in configuration",
+		"specify a valid host:port (e.g. 0.0.0.0:9191) in configuration ('metrics_address' parameter) and restart the control server",
+	)
+	FaultConfigBadNvmeHealth = serverConfigFault(
+		code.ServerConfigBadNvmeHealth,
+		"invalid NVMe health monitoring parameters in configuration",
+		"specify non-negative durations, a positive history_length and a spare threshold of at most 100% in configuration ('nvme_health' section) and restart the control server",
+	)
+	FaultConfigBadNvmeAutoFaultyErrorWindow = serverConfigFault(
+		code.ServerConfigBadNvmeAutoFaultyErrorWindow,
+		"invalid NVMe automatic FAULTY error window in configuration",
+		"specify a non-negative duration (e.g. 1h) in configuration ('nvme_auto_faulty' 'error_window' parameter) and restart the control server",
+	)
 )
 
+func FaultConfigBadNvmeEmulation(err error) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadNvmeEmulation,
+		fmt.Sprintf("invalid NVMe emulation parameters in configuration: %s", err),
+		"specify an absolute emulation_dir and positive namespace_count and namespace_size in configuration ('nvme_emulation' section) and restart the control server",
+	)
+}
+
+func FaultConfigBadScmEmulation(err error) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadScmEmulation,
+		fmt.Sprintf("invalid SCM emulation parameters in configuration: %s", err),
+		"specify an absolute state_dir, a module_count that is a multiple of socket_count and a positive module_size in configuration ('scm_emulation' section) and restart the control server",
+	)
+}
+
 func FaultConfigDuplicateFabric(curIdx, seenIdx int) *fault.Fault {
 	return serverConfigFault(
 		code.ServerConfigDuplicateFabric,
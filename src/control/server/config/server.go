@@ -14,6 +14,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 	yaml "gopkg.in/yaml.v2"
@@ -25,6 +26,7 @@ import (
 	"github.com/mjmac/soad/src/control/logging"
 	"github.com/mjmac/soad/src/control/security"
 	"github.com/mjmac/soad/src/control/server/engine"
+	"github.com/mjmac/soad/src/control/server/storage"
 )
 
 const (
@@ -32,6 +34,23 @@ const (
 	defaultConfigPath   = "../etc/daos_server.yml"
 	configOut           = ".daos_server.active.yml"
 	relConfExamplesPath = "../utils/config/examples/"
+
+	defaultAutoExcludeGracePeriod = 5 * time.Minute
+
+	defaultNvmeHealthSampleInterval    = 10 * time.Minute
+	defaultNvmeHealthHistoryLength     = 1008 // one week of samples at the default interval
+	defaultNvmeHealthPredictionHorizon = 7 * 24 * time.Hour
+	defaultNvmeTemperatureThreshold    = 70 // degrees Celsius
+	defaultNvmeMediaErrorsThreshold    = 1
+	defaultNvmeBioErrorsThreshold      = 10
+
+	defaultNvmeAutoFaultyErrorWindow = time.Hour
+
+	defaultNvmeEmulationNamespaceCount = 1
+	defaultNvmeEmulationNamespaceSize  = 8 // GB
+
+	defaultScmEmulationSocketCount = 1
+	defaultScmEmulationModuleSize  = 128 // GiB
 )
 
 type networkProviderValidation func(context.Context, string, string) error
@@ -47,6 +66,55 @@ type ClientNetworkCfg struct {
 	NetDevClass     uint32
 }
 
+// AutoExcludeConfig describes the policy used by the MS leader to
+// automatically exclude dead ranks from pools.
+type AutoExcludeConfig struct {
+	Enabled     bool          `yaml:"enabled"`
+	GracePeriod time.Duration `yaml:"grace_period,omitempty"`
+}
+
+// NvmeHealthConfig describes how the health of the NVMe devices used by the
+// I/O Engines is sampled and when RAS events are raised about it. A zero
+// threshold disables the corresponding check, except for the spare capacity
+// threshold, where zero selects the threshold reported by the device.
+type NvmeHealthConfig struct {
+	SampleInterval           time.Duration `yaml:"sample_interval"`
+	HistoryLength            int           `yaml:"history_length,omitempty"`
+	PredictionHorizon        time.Duration `yaml:"prediction_horizon,omitempty"`
+	TemperatureThreshold     uint32        `yaml:"temperature_threshold"`
+	AvailSpareThreshold      uint32        `yaml:"avail_spare_threshold"`
+	MediaErrorsThreshold     uint64        `yaml:"media_errors_threshold"`
+	UnsafeShutdownsThreshold uint64        `yaml:"unsafe_shutdowns_threshold"`
+	BioErrorsThreshold       uint64        `yaml:"bio_errors_threshold"`
+}
+
+// Validate checks that the sampling parameters are usable.
+func (nhc *NvmeHealthConfig) Validate() error {
+	switch {
+	case nhc.SampleInterval < 0, nhc.PredictionHorizon < 0:
+		return FaultConfigBadNvmeHealth
+	case nhc.SampleInterval > 0 && nhc.HistoryLength < 1:
+		return FaultConfigBadNvmeHealth
+	case nhc.AvailSpareThreshold > 100:
+		return FaultConfigBadNvmeHealth
+	}
+	return nil
+}
+
+// NvmeAutoFaultyConfig describes the policy used to automatically mark NVMe
+// devices FAULTY. A device is marked FAULTY when the number of BIO errors of a
+// given type reported for its targets within the error window reaches the
+// threshold for that type, or, if enabled, when one of its health statistics
+// other than the temperature reaches the threshold set in NvmeHealthConfig.
+// A zero threshold disables the corresponding check.
+type NvmeAutoFaultyConfig struct {
+	ReadErrorsThreshold  uint32        `yaml:"read_errors_threshold"`
+	WriteErrorsThreshold uint32        `yaml:"write_errors_threshold"`
+	UnmapErrorsThreshold uint32        `yaml:"unmap_errors_threshold"`
+	ErrorWindow          time.Duration `yaml:"error_window,omitempty"`
+	OnHealthWarning      bool          `yaml:"on_health_warning"`
+}
+
 // Server describes configuration options for DAOS control plane.
 // See utils/config/daos_server.yml for parameter descriptions.
 type Server struct {
@@ -54,21 +122,27 @@ type Server struct {
 	ControlPort     int                       `yaml:"port"`
 	TransportConfig *security.TransportConfig `yaml:"transport_config"`
 	// support both "engines:" and "servers:" for backward compatibility
-	Servers             []*engine.Config `yaml:"servers"`
-	Engines             []*engine.Config `yaml:"engines"`
-	BdevInclude         []string         `yaml:"bdev_include,omitempty"`
-	BdevExclude         []string         `yaml:"bdev_exclude,omitempty"`
-	DisableVFIO         bool             `yaml:"disable_vfio"`
-	DisableVMD          bool             `yaml:"disable_vmd"`
-	NrHugepages         int              `yaml:"nr_hugepages"`
-	SetHugepages        bool             `yaml:"set_hugepages"`
-	ControlLogMask      ControlLogLevel  `yaml:"control_log_mask"`
-	ControlLogFile      string           `yaml:"control_log_file"`
-	ControlLogJSON      bool             `yaml:"control_log_json,omitempty"`
-	HelperLogFile       string           `yaml:"helper_log_file"`
-	FWHelperLogFile     string           `yaml:"firmware_helper_log_file"`
-	RecreateSuperblocks bool             `yaml:"recreate_superblocks"`
-	FaultPath           string           `yaml:"fault_path"`
+	Servers             []*engine.Config            `yaml:"servers"`
+	Engines             []*engine.Config            `yaml:"engines"`
+	BdevInclude         []string                    `yaml:"bdev_include,omitempty"`
+	BdevExclude         []string                    `yaml:"bdev_exclude,omitempty"`
+	DisableVFIO         bool                        `yaml:"disable_vfio"`
+	DisableVMD          bool                        `yaml:"disable_vmd"`
+	NrHugepages         int                         `yaml:"nr_hugepages"`
+	SetHugepages        bool                        `yaml:"set_hugepages"`
+	ControlLogMask      ControlLogLevel             `yaml:"control_log_mask"`
+	ControlLogFile      string                      `yaml:"control_log_file"`
+	ControlLogJSON      bool                        `yaml:"control_log_json,omitempty"`
+	HelperLogFile       string                      `yaml:"helper_log_file"`
+	FWHelperLogFile     string                      `yaml:"firmware_helper_log_file"`
+	RecreateSuperblocks bool                        `yaml:"recreate_superblocks"`
+	FaultPath           string                      `yaml:"fault_path"`
+	AutoExclude         AutoExcludeConfig           `yaml:"auto_exclude"`
+	MetricsAddress      string                      `yaml:"metrics_address,omitempty"`
+	NvmeHealth          NvmeHealthConfig            `yaml:"nvme_health"`
+	NvmeAutoFaulty      NvmeAutoFaultyConfig        `yaml:"nvme_auto_faulty"`
+	NvmeEmulation       storage.BdevEmulationConfig `yaml:"nvme_emulation"`
+	ScmEmulation        storage.ScmEmulationConfig  `yaml:"scm_emulation"`
 
 	// duplicated in engine.Config
 	SystemName string              `yaml:"name"`
@@ -245,6 +319,47 @@ func (c *Server) WithFaultCb(cb string) *Server {
 	return c
 }
 
+// WithAutoExclude enables or disables automatic exclusion of dead ranks
+// from pools after the supplied grace period.
+func (c *Server) WithAutoExclude(enabled bool, gracePeriod time.Duration) *Server {
+	c.AutoExclude.Enabled = enabled
+	c.AutoExclude.GracePeriod = gracePeriod
+	return c
+}
+
+// WithMetricsAddress sets the address of the metrics listener. The listener
+// is disabled if the address is empty.
+func (c *Server) WithMetricsAddress(address string) *Server {
+	c.MetricsAddress = address
+	return c
+}
+
+// WithNvmeHealth sets the NVMe device health monitoring configuration.
+func (c *Server) WithNvmeHealth(cfg NvmeHealthConfig) *Server {
+	c.NvmeHealth = cfg
+	return c
+}
+
+// WithNvmeAutoFaulty sets the policy used to automatically mark NVMe devices
+// FAULTY.
+func (c *Server) WithNvmeAutoFaulty(cfg NvmeAutoFaultyConfig) *Server {
+	c.NvmeAutoFaulty = cfg
+	return c
+}
+
+// WithNvmeEmulation sets the configuration of the NVMe controllers emulated
+// with files.
+func (c *Server) WithNvmeEmulation(cfg storage.BdevEmulationConfig) *Server {
+	c.NvmeEmulation = cfg
+	return c
+}
+
+// WithScmEmulation sets the configuration of the emulated SCM modules.
+func (c *Server) WithScmEmulation(cfg storage.ScmEmulationConfig) *Server {
+	c.ScmEmulation = cfg
+	return c
+}
+
 // WithBdevExclude sets the block device exclude list.
 func (c *Server) WithBdevExclude(bList ...string) *Server {
 	c.BdevExclude = bList
@@ -330,6 +445,28 @@ func DefaultServer() *Server {
 		validateNUMAFn:     netdetect.ValidateNUMAStub,
 		GetDeviceClassFn:   netdetect.GetDeviceClass,
 		DisableVMD:         true, // support currently unstable
+		AutoExclude: AutoExcludeConfig{
+			GracePeriod: defaultAutoExcludeGracePeriod,
+		},
+		NvmeHealth: NvmeHealthConfig{
+			SampleInterval:       defaultNvmeHealthSampleInterval,
+			HistoryLength:        defaultNvmeHealthHistoryLength,
+			PredictionHorizon:    defaultNvmeHealthPredictionHorizon,
+			TemperatureThreshold: defaultNvmeTemperatureThreshold,
+			MediaErrorsThreshold: defaultNvmeMediaErrorsThreshold,
+			BioErrorsThreshold:   defaultNvmeBioErrorsThreshold,
+		},
+		NvmeAutoFaulty: NvmeAutoFaultyConfig{
+			ErrorWindow: defaultNvmeAutoFaultyErrorWindow,
+		},
+		NvmeEmulation: storage.BdevEmulationConfig{
+			NamespaceCount: defaultNvmeEmulationNamespaceCount,
+			NamespaceSize:  defaultNvmeEmulationNamespaceSize,
+		},
+		ScmEmulation: storage.ScmEmulationConfig{
+			SocketCount: defaultScmEmulationSocketCount,
+			ModuleSize:  defaultScmEmulationModuleSize,
+		},
 	}
 }
 
@@ -344,7 +481,12 @@ func (c *Server) Load() error {
 		return errors.WithMessage(err, "reading file")
 	}
 
-	if err = yaml.UnmarshalStrict(bytes, c); err != nil {
+	return c.Parse(bytes)
+}
+
+// Parse reads the serialized configuration from the supplied bytes.
+func (c *Server) Parse(bytes []byte) error {
+	if err := yaml.UnmarshalStrict(bytes, c); err != nil {
 		return errors.WithMessage(err, "parse failed; config contains invalid "+
 			"parameters and may be out of date, see server config examples")
 	}
@@ -424,6 +566,32 @@ func (c *Server) Validate(log logging.Logger) (err error) {
 	}
 	c.Servers = nil
 
+	if c.AutoExclude.GracePeriod < 0 {
+		return FaultConfigBadAutoExcludeGracePeriod
+	}
+
+	if c.MetricsAddress != "" {
+		if _, err := net.ResolveTCPAddr("tcp", c.MetricsAddress); err != nil {
+			return FaultConfigBadMetricsAddress
+		}
+	}
+
+	if err := c.NvmeHealth.Validate(); err != nil {
+		return err
+	}
+
+	if c.NvmeAutoFaulty.ErrorWindow < 0 {
+		return FaultConfigBadNvmeAutoFaultyErrorWindow
+	}
+
+	if err := c.NvmeEmulation.Validate(); err != nil {
+		return FaultConfigBadNvmeEmulation(err)
+	}
+
+	if err := c.ScmEmulation.Validate(); err != nil {
+		return FaultConfigBadScmEmulation(err)
+	}
+
 	// config without engines is valid when initially discovering hardware
 	// prior to adding per-engine sections with device allocations
 	if len(c.Engines) == 0 {
@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -24,6 +25,7 @@ import (
 	"github.com/mjmac/soad/src/control/logging"
 	"github.com/mjmac/soad/src/control/security"
 	"github.com/mjmac/soad/src/control/server/engine"
+	"github.com/mjmac/soad/src/control/server/storage"
 )
 
 const (
@@ -222,7 +224,46 @@ func TestServerConfig_Constructed(t *testing.T) {
 		WithAccessPoints("hostname1").
 		WithFaultCb("./.daos/fd_callback").
 		WithFaultPath("/vcdu0/rack1/hostname").
+		WithAutoExclude(true, 10*time.Minute).
+		WithMetricsAddress("0.0.0.0:9191").
+		WithNvmeHealth(NvmeHealthConfig{
+			SampleInterval:           5 * time.Minute,
+			HistoryLength:            2016,
+			PredictionHorizon:        72 * time.Hour,
+			TemperatureThreshold:     65,
+			AvailSpareThreshold:      20,
+			MediaErrorsThreshold:     5,
+			UnsafeShutdownsThreshold: 100,
+			BioErrorsThreshold:       50,
+		}).
+		WithNvmeAutoFaulty(NvmeAutoFaultyConfig{
+			ReadErrorsThreshold:  10,
+			WriteErrorsThreshold: 10,
+			UnmapErrorsThreshold: 100,
+			ErrorWindow:          30 * time.Minute,
+			OnHealthWarning:      true,
+		}).
+		WithNvmeEmulation(storage.BdevEmulationConfig{
+			Dir:             "/tmp/daos_nvme",
+			ControllerCount: 2,
+			NamespaceCount:  2,
+			NamespaceSize:   4,
+		}).
+		WithScmEmulation(storage.ScmEmulationConfig{
+			StateDir:    "/tmp/daos_scm",
+			ModuleCount: 4,
+			SocketCount: 2,
+			ModuleSize:  256,
+		}).
 		WithHyperthreads(true). // hyper-threads disabled by default
+		WithTransportConfig(func() *security.TransportConfig {
+			tc := security.DefaultServerTransportConfig()
+			tc.RoleMappings = []*security.RoleMapping{
+				{Role: security.RoleOperator, OrganizationalUnit: "ops"},
+			}
+			tc.AccessPolicyPath = "/etc/daos/access_policy.yml"
+			return tc
+		}()).
 		WithProviderValidator(netdetect.ValidateProviderStub).
 		WithNUMAValidator(netdetect.ValidateNUMAStub).
 		WithGetNetworkDeviceClass(getDeviceClassStub).
@@ -390,6 +431,93 @@ func TestServerConfig_Validation(t *testing.T) {
 			},
 			expErr: FaultConfigBadControlPort,
 		},
+		"negative auto exclude grace period": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithAutoExclude(true, -time.Minute)
+			},
+			expErr: FaultConfigBadAutoExcludeGracePeriod,
+		},
+		"metrics address": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithMetricsAddress("0.0.0.0:9191")
+			},
+		},
+		"bad metrics address": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithMetricsAddress("localhost")
+			},
+			expErr: FaultConfigBadMetricsAddress,
+		},
+		"nvme health monitoring disabled": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealth(NvmeHealthConfig{})
+			},
+		},
+		"negative nvme health sample interval": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealth(NvmeHealthConfig{SampleInterval: -time.Minute, HistoryLength: 1})
+			},
+			expErr: FaultConfigBadNvmeHealth,
+		},
+		"empty nvme health history": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealth(NvmeHealthConfig{SampleInterval: time.Minute})
+			},
+			expErr: FaultConfigBadNvmeHealth,
+		},
+		"bad nvme spare threshold": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealth(NvmeHealthConfig{
+					SampleInterval:      time.Minute,
+					HistoryLength:       1,
+					AvailSpareThreshold: 101,
+				})
+			},
+			expErr: FaultConfigBadNvmeHealth,
+		},
+		"negative nvme auto faulty error window": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeAutoFaulty(NvmeAutoFaultyConfig{ErrorWindow: -time.Minute})
+			},
+			expErr: FaultConfigBadNvmeAutoFaultyErrorWindow,
+		},
+		"nvme emulation with relative directory": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeEmulation(storage.BdevEmulationConfig{
+					Dir:             "daos_nvme",
+					ControllerCount: 1,
+					NamespaceCount:  1,
+					NamespaceSize:   1,
+				})
+			},
+			expErr: FaultConfigBadNvmeEmulation(errors.New("emulation_dir \"daos_nvme\" must be an absolute path")),
+		},
+		"nvme emulation without namespaces": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeEmulation(storage.BdevEmulationConfig{
+					Dir:             "/tmp/daos_nvme",
+					ControllerCount: 1,
+					NamespaceSize:   1,
+				})
+			},
+			expErr: FaultConfigBadNvmeEmulation(errors.New("namespace_count must be greater than 0")),
+		},
+		"scm emulation with uneven module distribution": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithScmEmulation(storage.ScmEmulationConfig{
+					StateDir:    "/tmp/daos_scm",
+					ModuleCount: 3,
+					SocketCount: 2,
+					ModuleSize:  1,
+				})
+			},
+			expErr: FaultConfigBadScmEmulation(errors.New("module_count 3 must be a multiple of socket_count 2")),
+		},
+		"nvme emulation disabled without directory": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeEmulation(storage.BdevEmulationConfig{})
+			},
+		},
 		"use legacy servers conf directive rather than engines": {
 			setServers: true,
 		},
@@ -0,0 +1,139 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+// levelSetter is implemented by loggers whose level can be changed at runtime.
+type levelSetter interface {
+	Level() logging.LogLevel
+	SetLevel(logging.LogLevel)
+}
+
+// logSettings tracks runtime changes to log settings so that they can be
+// reverted, either on request or after a timeout.
+type logSettings struct {
+	sync.Mutex
+	origLevel   *logging.LogLevel // level in effect before first change
+	revertTimer *time.Timer
+	generation  uint64
+}
+
+// setEngineLogMasks sends the supplied log masks to each running engine and
+// returns a list of per-engine errors.
+func (svc *ControlService) setEngineLogMasks(ctx context.Context, masks string) []string {
+	var errs []string
+	for _, srv := range svc.harness.Instances() {
+		if !srv.isReady() {
+			svc.log.Debugf("skipping not-ready instance %d", srv.Index())
+			continue
+		}
+
+		if err := srv.setLogMasks(ctx, masks); err != nil {
+			errs = append(errs, fmt.Sprintf("engine %d: %s", srv.Index(), err))
+		}
+	}
+
+	return errs
+}
+
+// restoreLogSettings reverts the control plane log level and engine log masks
+// to the values in effect before any runtime changes. The logSettings lock
+// must be held by the caller.
+func (svc *ControlService) restoreLogSettings(ctx context.Context) []string {
+	if svc.logSettings.origLevel != nil {
+		if ls, ok := svc.log.(levelSetter); ok {
+			ls.SetLevel(*svc.logSettings.origLevel)
+			svc.log.Infof("control plane log level restored to %s", *svc.logSettings.origLevel)
+		}
+		svc.logSettings.origLevel = nil
+	}
+
+	return svc.setEngineLogMasks(ctx, "")
+}
+
+// SetEngineLogMasks implements the method defined for the Management Service.
+//
+// Set the control plane log level and/or the log masks of running engines.
+// If neither is supplied, the original settings are restored. If a revert
+// period is supplied, the original settings are restored after it elapses.
+func (svc *ControlService) SetEngineLogMasks(ctx context.Context, req *ctlpb.SetLogMasksReq) (*ctlpb.SetLogMasksResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	var newLevel logging.LogLevel
+	if req.GetLevel() != "" {
+		if err := newLevel.SetString(req.GetLevel()); err != nil {
+			return nil, err
+		}
+		if _, ok := svc.log.(levelSetter); !ok {
+			return nil, errors.New("control plane logger does not support level changes")
+		}
+	}
+
+	svc.logSettings.Lock()
+	defer svc.logSettings.Unlock()
+
+	svc.logSettings.generation++
+	if svc.logSettings.revertTimer != nil {
+		svc.logSettings.revertTimer.Stop()
+		svc.logSettings.revertTimer = nil
+	}
+
+	resp := new(ctlpb.SetLogMasksResp)
+	if req.GetLevel() == "" && req.GetMasks() == "" {
+		resp.Errors = svc.restoreLogSettings(ctx)
+		return resp, nil
+	}
+
+	if req.GetLevel() != "" {
+		ls := svc.log.(levelSetter)
+		if svc.logSettings.origLevel == nil {
+			origLevel := ls.Level()
+			svc.logSettings.origLevel = &origLevel
+		}
+		ls.SetLevel(newLevel)
+		svc.log.Infof("control plane log level set to %s", newLevel)
+	}
+
+	if req.GetMasks() != "" {
+		resp.Errors = svc.setEngineLogMasks(ctx, req.GetMasks())
+	}
+
+	if req.GetRevertAfter() > 0 {
+		gen := svc.logSettings.generation
+		revertAfter := time.Duration(req.GetRevertAfter()) * time.Second
+		svc.logSettings.revertTimer = time.AfterFunc(revertAfter, func() {
+			svc.logSettings.Lock()
+			defer svc.logSettings.Unlock()
+
+			// settings were changed again after this timer was armed
+			if svc.logSettings.generation != gen {
+				return
+			}
+			svc.logSettings.revertTimer = nil
+
+			svc.log.Infof("restoring log settings after %s", revertAfter)
+			for _, msg := range svc.restoreLogSettings(context.Background()) {
+				svc.log.Errorf("failed to restore engine log masks: %s", msg)
+			}
+		})
+	}
+
+	return resp, nil
+}
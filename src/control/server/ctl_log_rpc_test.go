@@ -0,0 +1,171 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/server/engine"
+)
+
+func TestServer_CtlSvc_SetEngineLogMasks(t *testing.T) {
+	for name, tc := range map[string]struct {
+		prevLevel    logging.LogLevel // level set by an earlier request
+		req          *ctlpb.SetLogMasksReq
+		drpcResps    []*mockDrpcResponse
+		engineNotRdy bool
+		expLevel     logging.LogLevel
+		expMasks     []string // masks sent to engines, in order
+		expResp      *ctlpb.SetLogMasksResp
+		expErr       error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"invalid level": {
+			req:    &ctlpb.SetLogMasksReq{Level: "LOUD"},
+			expErr: errors.New("LOUD"),
+		},
+		"set level only": {
+			req:      &ctlpb.SetLogMasksReq{Level: "ERROR"},
+			expLevel: logging.LogLevelError,
+			expResp:  &ctlpb.SetLogMasksResp{},
+		},
+		"set masks": {
+			req: &ctlpb.SetLogMasksReq{Masks: "ERR,mgmt=DEBUG"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.DaosResp{}},
+			},
+			expLevel: logging.LogLevelDebug,
+			expMasks: []string{"ERR,mgmt=DEBUG"},
+			expResp:  &ctlpb.SetLogMasksResp{},
+		},
+		"set masks and level": {
+			req: &ctlpb.SetLogMasksReq{Masks: "DEBUG", Level: "info"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.DaosResp{}},
+			},
+			expLevel: logging.LogLevelInfo,
+			expMasks: []string{"DEBUG"},
+			expResp:  &ctlpb.SetLogMasksResp{},
+		},
+		"engine rejects masks": {
+			req: &ctlpb.SetLogMasksReq{Masks: "bogus=DEBUG"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.DaosResp{Status: int32(drpc.DaosInvalidInput)}},
+			},
+			expLevel: logging.LogLevelDebug,
+			expMasks: []string{"bogus=DEBUG"},
+			expResp: &ctlpb.SetLogMasksResp{
+				Errors: []string{"engine 0: setLogMasks failed: " + drpc.DaosInvalidInput.Error()},
+			},
+		},
+		"engine not ready": {
+			req:          &ctlpb.SetLogMasksReq{Masks: "DEBUG"},
+			engineNotRdy: true,
+			expLevel:     logging.LogLevelDebug,
+			expResp:      &ctlpb.SetLogMasksResp{},
+		},
+		"restore original settings": {
+			prevLevel: logging.LogLevelError,
+			req:       &ctlpb.SetLogMasksReq{},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.DaosResp{}},
+			},
+			expLevel: logging.LogLevelDebug,
+			expMasks: []string{""},
+			expResp:  &ctlpb.SetLogMasksResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().WithEngines(engine.NewConfig().WithTargetCount(1))
+			svc := mockControlService(t, log, cfg, nil, nil, nil)
+
+			if tc.prevLevel != logging.LogLevelDisabled {
+				if _, err := svc.SetEngineLogMasks(context.TODO(), &ctlpb.SetLogMasksReq{
+					Level: tc.prevLevel.String(),
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			dcc := new(mockDrpcClientConfig)
+			dcc.setSendMsgResponseList(t, tc.drpcResps...)
+			mdc := newMockDrpcClient(dcc)
+			srv := svc.harness.instances[0]
+			srv.setDrpcClient(mdc)
+			srv.ready.SetTrue()
+			if tc.engineNotRdy {
+				srv.ready.SetFalse()
+			}
+
+			gotResp, gotErr := svc.SetEngineLogMasks(context.TODO(), tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			common.AssertEqual(t, tc.expLevel, log.Level(), "unexpected log level")
+
+			var gotMasks []string
+			for _, call := range mdc.calls {
+				common.AssertEqual(t, drpc.MethodSetLogMasks, call.Method, "unexpected method")
+				req := new(mgmtpb.SetLogMasksReq)
+				if err := proto.Unmarshal(call.Body, req); err != nil {
+					t.Fatal(err)
+				}
+				gotMasks = append(gotMasks, req.Masks)
+			}
+			if diff := cmp.Diff(tc.expMasks, gotMasks); diff != "" {
+				t.Fatalf("unexpected masks sent (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_CtlSvc_SetEngineLogMasks_RevertAfter(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	cfg := config.DefaultServer().WithEngines(engine.NewConfig().WithTargetCount(1))
+	svc := mockControlService(t, log, cfg, nil, nil, nil)
+
+	if _, err := svc.SetEngineLogMasks(context.TODO(), &ctlpb.SetLogMasksReq{
+		Level:       "ERROR",
+		RevertAfter: 1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, logging.LogLevelError, log.Level(), "level not changed")
+
+	deadline := time.Now().Add(5 * time.Second)
+	for log.Level() != logging.LogLevelDebug {
+		if time.Now().After(deadline) {
+			t.Fatal("log level not reverted before deadline")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
@@ -0,0 +1,354 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/pbin"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+const transportConfigParam = "transport_config"
+
+// liveConfigParams are the server config file parameters that can be changed
+// without restarting the server.
+var liveConfigParams = map[string]bool{
+	"control_log_mask":         true,
+	"helper_log_file":          true,
+	"firmware_helper_log_file": true,
+	"fault_path":               true,
+	"fault_cb":                 true,
+	"access_points":            true,
+}
+
+// liveTransportParams are the transport_config parameters that can be changed
+// without restarting the server.
+var liveTransportParams = map[string]bool{
+	"ca_cert": true,
+	"cert":    true,
+	"key":     true,
+}
+
+// configReloader holds the state used to reload the server config file.
+type configReloader struct {
+	sync.RWMutex
+	startupData           []byte // config file contents at startup
+	appliedData           []byte // config file contents at the last reload
+	accessPoints          []string
+	onAccessPointsChanged []func([]string)
+}
+
+// enableConfigReload records the contents of the config file at startup so
+// that later changes to the file can be identified and applied.
+func (svc *ControlService) enableConfigReload(startupData []byte) {
+	svc.reloader.Lock()
+	defer svc.reloader.Unlock()
+
+	svc.reloader.startupData = startupData
+}
+
+// OnAccessPointsChanged registers a callback to be run with the new list of
+// access points when they are changed by a config reload.
+func (svc *ControlService) OnAccessPointsChanged(fn func([]string)) {
+	svc.reloader.Lock()
+	defer svc.reloader.Unlock()
+
+	svc.reloader.onAccessPointsChanged = append(svc.reloader.onAccessPointsChanged, fn)
+}
+
+// accessPoints returns the current list of MS access points.
+func (svc *ControlService) accessPoints() []string {
+	svc.reloader.RLock()
+	defer svc.reloader.RUnlock()
+
+	if svc.reloader.accessPoints != nil || svc.srvCfg == nil {
+		return svc.reloader.accessPoints
+	}
+	return svc.srvCfg.AccessPoints
+}
+
+// configParams returns the top-level parameters of the supplied config as
+// they would appear in the config file.
+func configParams(cfg *config.Server) (map[string]interface{}, error) {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]interface{})
+	if err := yaml.Unmarshal(data, &params); err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+// diffParams returns the sorted names of parameters that differ between the
+// two maps.
+func diffParams(prefix string, a, b map[string]interface{}) []string {
+	names := make(map[string]struct{})
+	for name := range a {
+		names[name] = struct{}{}
+	}
+	for name := range b {
+		names[name] = struct{}{}
+	}
+
+	var changed []string
+	for name := range names {
+		if !reflect.DeepEqual(a[name], b[name]) {
+			changed = append(changed, prefix+name)
+		}
+	}
+	sort.Strings(changed)
+
+	return changed
+}
+
+// stringKeyMap converts a decoded YAML mapping to a map with string keys.
+func stringKeyMap(in interface{}) map[string]interface{} {
+	out := make(map[string]interface{})
+	if m, ok := in.(map[interface{}]interface{}); ok {
+		for k, v := range m {
+			out[fmt.Sprintf("%v", k)] = v
+		}
+	}
+	return out
+}
+
+// diffConfigs returns the sorted names of config file parameters that differ
+// between the two configs. Changed transport_config parameters are reported
+// individually.
+func diffConfigs(oldCfg, newCfg *config.Server) ([]string, error) {
+	oldParams, err := configParams(oldCfg)
+	if err != nil {
+		return nil, err
+	}
+	newParams, err := configParams(newCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	for _, name := range diffParams("", oldParams, newParams) {
+		if name != transportConfigParam {
+			changed = append(changed, name)
+			continue
+		}
+		changed = append(changed, diffParams(transportConfigParam+".",
+			stringKeyMap(oldParams[name]), stringKeyMap(newParams[name]))...)
+	}
+	sort.Strings(changed)
+
+	return changed, nil
+}
+
+// isLiveParam indicates whether a change to the named parameter can be
+// applied without restarting the server.
+func isLiveParam(name string) bool {
+	if strings.HasPrefix(name, transportConfigParam+".") {
+		return liveTransportParams[strings.TrimPrefix(name, transportConfigParam+".")]
+	}
+	return liveConfigParams[name]
+}
+
+// setConfiguredLogLevel applies a log level change from the config file. If a
+// runtime log level change is in effect, the new level is applied when that
+// change is reverted.
+func (svc *ControlService) setConfiguredLogLevel(level logging.LogLevel) {
+	svc.logSettings.Lock()
+	defer svc.logSettings.Unlock()
+
+	if svc.logSettings.origLevel != nil {
+		svc.logSettings.origLevel = &level
+		return
+	}
+	if ls, ok := svc.log.(levelSetter); ok {
+		ls.SetLevel(level)
+	}
+}
+
+// reloadCerts reloads the certificate data used by the control plane from the
+// paths in the new config, restoring the previous paths on failure.
+func (svc *ControlService) reloadCerts(newCfg *config.Server) error {
+	tc := svc.srvCfg.TransportConfig
+	if tc == nil || tc.AllowInsecure || newCfg.TransportConfig == nil {
+		return nil
+	}
+
+	oldCA, oldCert, oldKey := tc.CARootPath, tc.CertificatePath, tc.PrivateKeyPath
+	tc.CARootPath = newCfg.TransportConfig.CARootPath
+	tc.CertificatePath = newCfg.TransportConfig.CertificatePath
+	tc.PrivateKeyPath = newCfg.TransportConfig.PrivateKeyPath
+
+	if err := tc.ReloadCertData(); err != nil {
+		tc.CARootPath, tc.CertificatePath, tc.PrivateKeyPath = oldCA, oldCert, oldKey
+		return errors.Wrap(err, "reloading certificates")
+	}
+
+	return nil
+}
+
+func setEnvOrUnset(name, value string) error {
+	if value == "" {
+		return os.Unsetenv(name)
+	}
+	return os.Setenv(name, value)
+}
+
+// reloadConfig re-reads the server config file, applies changes that are safe
+// to make while the server is running and reports those that require a
+// restart. Live changes are identified relative to the last applied config,
+// so that reverting a parameter to its startup value is applied too, while
+// changes requiring a restart are identified relative to the config file
+// contents at startup. Certificates are only reloaded when the TLS config
+// differs from the last applied config.
+func (svc *ControlService) reloadConfig() (*ctlpb.ReloadConfigResp, error) {
+	svc.reloader.Lock()
+	defer svc.reloader.Unlock()
+
+	if svc.reloader.startupData == nil || svc.srvCfg == nil {
+		return nil, errors.New("config reload not enabled")
+	}
+
+	parseConfig := func(data []byte) (*config.Server, error) {
+		cfg := config.DefaultServer()
+		cfg.Path = svc.srvCfg.Path
+		return cfg, cfg.Parse(data)
+	}
+
+	startCfg, err := parseConfig(svc.reloader.startupData)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing startup config")
+	}
+	appliedCfg := startCfg
+	if svc.reloader.appliedData != nil {
+		if appliedCfg, err = parseConfig(svc.reloader.appliedData); err != nil {
+			return nil, errors.Wrap(err, "parsing applied config")
+		}
+	}
+
+	newData, err := ioutil.ReadFile(svc.srvCfg.Path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s: reload failed", svc.srvCfg.Path)
+	}
+	newCfg, err := parseConfig(newData)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s: reload failed", newCfg.Path)
+	}
+
+	sinceStartup, err := diffConfigs(startCfg, newCfg)
+	if err != nil {
+		return nil, err
+	}
+	sinceApplied, err := diffConfigs(appliedCfg, newCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := newCfg.Validate(svc.log); err != nil {
+		return nil, errors.Wrapf(err, "%s: validation failed", newCfg.Path)
+	}
+
+	resp := new(ctlpb.ReloadConfigResp)
+	applied := make(map[string]bool)
+	for _, name := range sinceApplied {
+		if isLiveParam(name) {
+			resp.Applied = append(resp.Applied, name)
+			applied[name] = true
+		}
+	}
+	for _, name := range sinceStartup {
+		if !isLiveParam(name) {
+			resp.RestartRequired = append(resp.RestartRequired, name)
+		}
+	}
+
+	// Resolve the fault domain before anything is applied, as running the
+	// fault domain callback may fail.
+	faultDomainChanged := applied["fault_path"] || applied["fault_cb"]
+	var newFaultDomain *system.FaultDomain
+	if faultDomainChanged {
+		newFaultDomain, err = getFaultDomain(newCfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tlsChanged := false
+	for name := range applied {
+		if strings.HasPrefix(name, transportConfigParam+".") {
+			tlsChanged = true
+		}
+	}
+	if tlsChanged {
+		if err := svc.reloadCerts(newCfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if applied["control_log_mask"] {
+		svc.setConfiguredLogLevel(logging.LogLevel(newCfg.ControlLogMask))
+	}
+	if applied["helper_log_file"] {
+		if err := setEnvOrUnset(pbin.DaosAdminLogFileEnvVar, newCfg.HelperLogFile); err != nil {
+			return nil, errors.Wrap(err, "unable to configure privileged helper logging")
+		}
+	}
+	if applied["firmware_helper_log_file"] {
+		if err := setEnvOrUnset(pbin.DaosFWLogFileEnvVar, newCfg.FWHelperLogFile); err != nil {
+			return nil, errors.Wrap(err, "unable to configure privileged firmware helper logging")
+		}
+	}
+	if faultDomainChanged {
+		svc.harness.setFaultDomain(newFaultDomain)
+		svc.log.Debugf("fault domain: %s", newFaultDomain)
+	}
+	if applied["access_points"] {
+		svc.reloader.accessPoints = newCfg.AccessPoints
+		for _, fn := range svc.reloader.onAccessPointsChanged {
+			fn(newCfg.AccessPoints)
+		}
+	}
+	svc.reloader.appliedData = newData
+
+	if len(resp.Applied) > 0 {
+		svc.log.Infof("config reload applied changes to: %s", strings.Join(resp.Applied, ", "))
+	}
+	if len(resp.RestartRequired) > 0 {
+		svc.log.Infof("config reload found changes requiring restart: %s",
+			strings.Join(resp.RestartRequired, ", "))
+	}
+
+	return resp, nil
+}
+
+// ReloadConfig implements the method defined for the Management Service.
+//
+// Reload the server config file and apply changes that are safe to make
+// without restarting the server.
+func (svc *ControlService) ReloadConfig(ctx context.Context, req *ctlpb.ReloadConfigReq) (*ctlpb.ReloadConfigResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	return svc.reloadConfig()
+}
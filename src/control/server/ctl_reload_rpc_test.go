@@ -0,0 +1,356 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+const testStartupConfig = `
+name: daos_server
+port: 10001
+access_points: ["hostA:10001"]
+transport_config:
+  allow_insecure: true
+control_log_mask: DEBUG
+fault_path: /rack0/pdu0
+nr_hugepages: 4096
+`
+
+func TestServer_CtlSvc_ReloadConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		noStartup   bool
+		newConfig   string
+		expResp     *ctlpb.ReloadConfigResp
+		expLevel    logging.LogLevel
+		expFD       *system.FaultDomain
+		expAPUpdate []string
+		expErr      error
+	}{
+		"reload not enabled": {
+			noStartup: true,
+			newConfig: testStartupConfig,
+			expErr:    errors.New("not enabled"),
+		},
+		"no changes": {
+			newConfig: testStartupConfig,
+			expResp:   &ctlpb.ReloadConfigResp{},
+			expLevel:  logging.LogLevelDebug,
+			expFD:     system.MustCreateFaultDomainFromString("/rack0/pdu0"),
+		},
+		"invalid config": {
+			newConfig: testStartupConfig + "bad_param: true\n",
+			expErr:    errors.New("reload failed"),
+		},
+		"invalid config values": {
+			newConfig: testStartupConfig + "metrics_address: \"not an address\"\n",
+			expErr:    errors.New("validation failed"),
+		},
+		"live changes applied": {
+			newConfig: `
+name: daos_server
+port: 10001
+access_points: ["hostB:10001"]
+transport_config:
+  allow_insecure: true
+control_log_mask: ERROR
+fault_path: /rack1/pdu2
+nr_hugepages: 4096
+`,
+			expResp: &ctlpb.ReloadConfigResp{
+				Applied: []string{"access_points", "control_log_mask", "fault_path"},
+			},
+			expLevel:    logging.LogLevelError,
+			expFD:       system.MustCreateFaultDomainFromString("/rack1/pdu2"),
+			expAPUpdate: []string{"hostB:10001"},
+		},
+		"changes requiring restart": {
+			newConfig: `
+name: daos_server
+port: 10001
+access_points: ["hostA:10001"]
+transport_config:
+  allow_insecure: false
+control_log_mask: DEBUG
+fault_path: /rack0/pdu0
+nr_hugepages: 8192
+bdev_exclude: ["0000:81:00.0"]
+`,
+			expResp: &ctlpb.ReloadConfigResp{
+				RestartRequired: []string{
+					"bdev_exclude",
+					"nr_hugepages",
+					"transport_config.allow_insecure",
+				},
+			},
+			expLevel: logging.LogLevelDebug,
+			expFD:    system.MustCreateFaultDomainFromString("/rack0/pdu0"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := common.CreateTestDir(t)
+			defer cleanup()
+
+			cfgPath := filepath.Join(testDir, "daos_server.yml")
+			if err := ioutil.WriteFile(cfgPath, []byte(testStartupConfig), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg := config.DefaultServer()
+			if err := cfg.SetPath(cfgPath); err != nil {
+				t.Fatal(err)
+			}
+			if err := cfg.Load(); err != nil {
+				t.Fatal(err)
+			}
+			startFD, err := getFaultDomain(cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			svc := mockControlService(t, log, nil, nil, nil, nil)
+			svc.srvCfg = cfg
+			svc.harness.setFaultDomain(startFD)
+			if !tc.noStartup {
+				svc.enableConfigReload([]byte(testStartupConfig))
+			}
+
+			var gotAPUpdate []string
+			svc.OnAccessPointsChanged(func(aps []string) {
+				gotAPUpdate = aps
+			})
+
+			if err := ioutil.WriteFile(cfgPath, []byte(tc.newConfig), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			gotResp, gotErr := svc.ReloadConfig(context.TODO(), &ctlpb.ReloadConfigReq{})
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			common.AssertEqual(t, tc.expLevel, log.Level(), "unexpected log level")
+			for _, srv := range svc.harness.Instances() {
+				if diff := cmp.Diff(tc.expFD, srv.getHostFaultDomain()); diff != "" {
+					t.Fatalf("unexpected fault domain (-want, +got)\n%s\n", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.expAPUpdate, gotAPUpdate); diff != "" {
+				t.Fatalf("unexpected access points update (-want, +got)\n%s\n", diff)
+			}
+			if tc.expAPUpdate != nil {
+				if diff := cmp.Diff(tc.expAPUpdate, svc.accessPoints()); diff != "" {
+					t.Fatalf("unexpected access points (-want, +got)\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_CtlSvc_ReloadConfig_Revert(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+
+	cfgPath := filepath.Join(testDir, "daos_server.yml")
+	if err := ioutil.WriteFile(cfgPath, []byte(testStartupConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.DefaultServer()
+	if err := cfg.SetPath(cfgPath); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	svc := mockControlService(t, log, nil, nil, nil, nil)
+	svc.srvCfg = cfg
+	svc.harness.setFaultDomain(system.MustCreateFaultDomainFromString("/rack0/pdu0"))
+	svc.enableConfigReload([]byte(testStartupConfig))
+
+	var gotAPUpdate []string
+	svc.OnAccessPointsChanged(func(aps []string) {
+		gotAPUpdate = aps
+	})
+
+	reload := func(t *testing.T, data string, expResp *ctlpb.ReloadConfigResp) {
+		t.Helper()
+
+		if err := ioutil.WriteFile(cfgPath, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		gotResp, err := svc.ReloadConfig(context.TODO(), &ctlpb.ReloadConfigReq{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+			t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+		}
+	}
+
+	reload(t, `
+name: daos_server
+port: 10001
+access_points: ["hostB:10001"]
+transport_config:
+  allow_insecure: true
+control_log_mask: ERROR
+fault_path: /rack1/pdu2
+nr_hugepages: 8192
+`, &ctlpb.ReloadConfigResp{
+		Applied:         []string{"access_points", "control_log_mask", "fault_path"},
+		RestartRequired: []string{"nr_hugepages"},
+	})
+
+	// Unchanged live parameters aren't applied again, but changes requiring
+	// a restart are still reported until the server is restarted.
+	reload(t, `
+name: daos_server
+port: 10001
+access_points: ["hostB:10001"]
+transport_config:
+  allow_insecure: true
+control_log_mask: ERROR
+fault_path: /rack1/pdu2
+nr_hugepages: 8192
+`, &ctlpb.ReloadConfigResp{
+		RestartRequired: []string{"nr_hugepages"},
+	})
+
+	// Reverting to the startup values applies the live parameters again.
+	reload(t, testStartupConfig, &ctlpb.ReloadConfigResp{
+		Applied: []string{"access_points", "control_log_mask", "fault_path"},
+	})
+
+	common.AssertEqual(t, logging.LogLevelDebug, log.Level(), "unexpected log level")
+	expFD := system.MustCreateFaultDomainFromString("/rack0/pdu0")
+	for _, srv := range svc.harness.Instances() {
+		if diff := cmp.Diff(expFD, srv.getHostFaultDomain()); diff != "" {
+			t.Fatalf("unexpected fault domain (-want, +got)\n%s\n", diff)
+		}
+	}
+	expAPs := []string{"hostA:10001"}
+	if diff := cmp.Diff(expAPs, gotAPUpdate); diff != "" {
+		t.Fatalf("unexpected access points update (-want, +got)\n%s\n", diff)
+	}
+	if diff := cmp.Diff(expAPs, svc.accessPoints()); diff != "" {
+		t.Fatalf("unexpected access points (-want, +got)\n%s\n", diff)
+	}
+}
+
+func TestServer_CtlSvc_ReloadConfig_Certs(t *testing.T) {
+	for name, tc := range map[string]struct {
+		certName string
+		expResp  *ctlpb.ReloadConfigResp
+		expErr   error
+	}{
+		"unchanged tls config not reloaded": {
+			certName: "server.crt",
+			expResp:  &ctlpb.ReloadConfigResp{},
+		},
+		"changed tls config reloaded": {
+			certName: "renewed.crt",
+			expErr:   errors.New("reloading certificates"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := common.CreateTestDir(t)
+			defer cleanup()
+
+			// The certificate files don't exist, so any attempt to
+			// reload them fails.
+			secureConfig := func(certName string) string {
+				return `
+name: daos_server
+port: 10001
+access_points: ["hostA:10001"]
+transport_config:
+  allow_insecure: false
+  ca_cert: ` + filepath.Join(testDir, "daosCA.crt") + `
+  cert: ` + filepath.Join(testDir, certName) + `
+  key: ` + filepath.Join(testDir, "server.key") + `
+`
+			}
+			startupData := []byte(secureConfig("server.crt"))
+
+			cfgPath := filepath.Join(testDir, "daos_server.yml")
+			if err := ioutil.WriteFile(cfgPath, startupData, 0644); err != nil {
+				t.Fatal(err)
+			}
+			cfg := config.DefaultServer()
+			if err := cfg.SetPath(cfgPath); err != nil {
+				t.Fatal(err)
+			}
+			if err := cfg.Load(); err != nil {
+				t.Fatal(err)
+			}
+
+			svc := mockControlService(t, log, nil, nil, nil, nil)
+			svc.srvCfg = cfg
+			svc.enableConfigReload(startupData)
+
+			if err := ioutil.WriteFile(cfgPath, []byte(secureConfig(tc.certName)), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			gotResp, gotErr := svc.ReloadConfig(context.TODO(), &ctlpb.ReloadConfigReq{})
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_CtlSvc_ReloadConfig_KeepsRuntimeLogLevel(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	svc := mockControlService(t, log, nil, nil, nil, nil)
+
+	if _, err := svc.SetEngineLogMasks(context.TODO(), &ctlpb.SetLogMasksReq{Level: "INFO"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// config file change is deferred until the runtime change is reverted
+	svc.setConfiguredLogLevel(logging.LogLevelError)
+	common.AssertEqual(t, logging.LogLevelInfo, log.Level(), "runtime level not kept")
+
+	if _, err := svc.SetEngineLogMasks(context.TODO(), &ctlpb.SetLogMasksReq{}); err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, logging.LogLevelError, log.Level(), "config level not restored")
+}
@@ -20,6 +20,13 @@ import (
 	"github.com/mjmac/soad/src/control/system"
 )
 
+// BIO device states reported in SMD device listings.
+const (
+	smdDevStateFaulty = "FAULTY"
+	smdDevStateOut    = "OUT"
+	smdDevStateNew    = "NEW"
+)
+
 func queryRank(reqRank uint32, srvRank system.Rank) bool {
 	rr := system.Rank(reqRank)
 	if rr.Equals(system.NilRank) {
@@ -91,18 +98,26 @@ func (svc *ControlService) querySmdDevices(ctx context.Context, req *ctlpb.SmdQu
 			}
 		}
 
-		if !req.IncludeBioHealth {
-			continue
-		}
-
 		for _, dev := range rResp.Devices {
-			health, err := srv.getBioHealth(ctx, &ctlpb.BioHealthReq{
-				DevUuid: dev.Uuid,
-			})
-			if err != nil {
-				return errors.Wrapf(err, "device %s", dev)
+			if req.IncludeBioHealth {
+				health, err := srv.getBioHealth(ctx, &ctlpb.BioHealthReq{
+					DevUuid: dev.Uuid,
+				})
+				if err != nil {
+					return errors.Wrapf(err, "device %s", dev)
+				}
+				dev.Health = health
+			}
+
+			if req.IncludeHistory {
+				history, err := svc.nvmeHealth.deviceHistory(srv, dev.Uuid)
+				if err != nil {
+					return errors.Wrapf(err, "device %s", dev)
+				}
+				if err := convert.Types(history, &dev.History); err != nil {
+					return errors.Wrap(err, "failed to convert device health history")
+				}
 			}
-			dev.Health = health
 		}
 	}
 	return nil
@@ -209,22 +224,11 @@ func (svc *ControlService) smdSetFaulty(ctx context.Context, req *ctlpb.SmdQuery
 
 	svc.log.Debugf("calling set-faulty on rank %d for %s", rank, req.Uuid)
 
-	dresp, err := srvs[0].CallDrpc(ctx, drpc.MethodSetFaultyState, &ctlpb.DevStateReq{
-		DevUuid: req.Uuid,
-	})
+	dsr, err := srvs[0].setDeviceFaulty(ctx, req.Uuid)
 	if err != nil {
 		return nil, err
 	}
 
-	dsr := &ctlpb.DevStateResp{}
-	if err = proto.Unmarshal(dresp.Body, dsr); err != nil {
-		return nil, errors.Wrap(err, "unmarshal StorageSetFaulty response")
-	}
-
-	if dsr.Status != 0 {
-		return nil, errors.Wrap(drpc.DaosStatus(dsr.Status), "smdSetFaulty failed")
-	}
-
 	return &ctlpb.SmdQueryResp{
 		Ranks: []*ctlpb.SmdQueryResp_RankResp{
 			{
@@ -293,6 +297,85 @@ func (svc *ControlService) smdReplace(ctx context.Context, req *ctlpb.SmdQueryRe
 	}, nil
 }
 
+// smdResolveAutoReplace sets the devices of an automatic replace request.
+// The device to replace is the one with the requested UUID or, if no UUID is
+// supplied, the only FAULTY or removed device. The replacement is the new
+// device detected in the PCI slot of the device to replace.
+func (svc *ControlService) smdResolveAutoReplace(ctx context.Context, req *ctlpb.SmdQueryReq) error {
+	type replaceCandidate struct {
+		srv     *EngineInstance
+		dev     *ctlpb.SmdDevResp_Device
+		devices []*ctlpb.SmdDevResp_Device
+	}
+
+	var candidates []*replaceCandidate
+	for _, srv := range svc.harness.Instances() {
+		if !srv.isReady() {
+			continue
+		}
+
+		resp, err := srv.listSmdDevices(ctx, new(ctlpb.SmdDevReq))
+		if err != nil {
+			return err
+		}
+		for _, dev := range resp.GetDevices() {
+			switch {
+			case req.Uuid != "" && dev.GetUuid() != req.Uuid:
+				continue
+			case req.Uuid == "" && dev.GetState() != smdDevStateFaulty && dev.GetState() != smdDevStateOut:
+				continue
+			}
+			candidates = append(candidates, &replaceCandidate{srv, dev, resp.GetDevices()})
+		}
+	}
+
+	switch {
+	case len(candidates) == 0 && req.Uuid != "":
+		return errors.Errorf("smdReplace on %s did not match any devices", req.Uuid)
+	case len(candidates) == 0:
+		return errors.New("no FAULTY or removed device to replace")
+	case len(candidates) > 1:
+		return errors.Errorf("%d FAULTY or removed devices found, specify the device to replace",
+			len(candidates))
+	}
+	old := candidates[0]
+
+	slot := old.dev.GetTrAddr()
+	if slot == "" {
+		var err error
+		if slot, err = svc.nvmeHealth.deviceSlot(old.srv, old.dev.GetUuid()); err != nil {
+			return err
+		}
+		if slot == "" {
+			return errors.Errorf("PCI address of device %s is unknown, specify the new device",
+				old.dev.GetUuid())
+		}
+	}
+
+	var newDevs []string
+	for _, dev := range old.devices {
+		if dev.GetState() == smdDevStateNew && dev.GetTrAddr() == slot {
+			newDevs = append(newDevs, dev.GetUuid())
+		}
+	}
+	switch len(newDevs) {
+	case 0:
+		return errors.Errorf("no new device detected in PCI slot %s of device %s", slot,
+			old.dev.GetUuid())
+	case 1:
+	default:
+		return errors.Errorf("%d new devices detected in PCI slot %s, specify the new device",
+			len(newDevs), slot)
+	}
+
+	svc.log.Infof("replacing device %s with new device %s detected in PCI slot %s",
+		old.dev.GetUuid(), newDevs[0], slot)
+	req.Uuid = old.dev.GetUuid()
+	req.ReplaceUUID = newDevs[0]
+
+	return nil
+}
+
 func (svc *ControlService) smdIdentify(ctx context.Context, req *ctlpb.SmdQueryReq) (*ctlpb.SmdQueryResp, error) {
 	req.Rank = uint32(system.NilRank)
 	rank, device, err := svc.smdQueryDevice(ctx, req)
@@ -361,6 +444,12 @@ func (svc *ControlService) SmdQuery(ctx context.Context, req *ctlpb.SmdQueryReq)
 		return svc.smdSetFaulty(ctx, req)
 	}
 
+	if req.AutoReplace {
+		if err := svc.smdResolveAutoReplace(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
 	if req.ReplaceUUID != "" {
 		return svc.smdReplace(ctx, req)
 	}
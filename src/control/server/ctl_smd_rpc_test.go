@@ -19,15 +19,25 @@ import (
 	"github.com/mjmac/soad/src/control/logging"
 	"github.com/mjmac/soad/src/control/server/config"
 	"github.com/mjmac/soad/src/control/server/engine"
+	"github.com/mjmac/soad/src/control/server/storage"
 	"github.com/mjmac/soad/src/control/system"
 )
 
 func TestServer_CtlSvc_SmdQuery(t *testing.T) {
+	autoReplaceDevices := &ctlpb.SmdDevResp{
+		Devices: []*ctlpb.SmdDevResp_Device{
+			{Uuid: common.MockUUID(0), State: "FAULTY", TrAddr: "0000:80:00.0"},
+			{Uuid: common.MockUUID(1), State: "NEW", TrAddr: "0000:80:00.0"},
+			{Uuid: common.MockUUID(2), State: "NORMAL", TrAddr: "0000:81:00.0"},
+		},
+	}
+
 	for name, tc := range map[string]struct {
 		setupAP        bool
 		req            *ctlpb.SmdQueryReq
 		junkResp       bool
 		drpcResps      map[int][]*mockDrpcResponse
+		histories      map[uint32]*nvmeHealthHistory
 		harnessStopped bool
 		ioStopped      bool
 		expResp        *ctlpb.SmdQueryResp
@@ -112,6 +122,130 @@ func TestServer_CtlSvc_SmdQuery(t *testing.T) {
 			},
 			expErr: drpc.DaosInvalidInput,
 		},
+		"auto replace": {
+			req: &ctlpb.SmdQueryReq{AutoReplace: true},
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					{Message: autoReplaceDevices},
+					{Message: autoReplaceDevices},
+					{
+						Message: &ctlpb.DevReplaceResp{
+							NewDevUuid: common.MockUUID(1),
+							DevState:   "NORMAL",
+						},
+					},
+				},
+			},
+			expResp: &ctlpb.SmdQueryResp{
+				Ranks: []*ctlpb.SmdQueryResp_RankResp{
+					{
+						Devices: []*ctlpb.SmdQueryResp_Device{
+							{
+								Uuid:  common.MockUUID(1),
+								State: "NORMAL",
+							},
+						},
+					},
+				},
+			},
+		},
+		"auto replace (removed device)": {
+			req: &ctlpb.SmdQueryReq{AutoReplace: true},
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					{
+						Message: &ctlpb.SmdDevResp{
+							Devices: []*ctlpb.SmdDevResp_Device{
+								{Uuid: common.MockUUID(0), State: "OUT"},
+								{Uuid: common.MockUUID(1), State: "NEW", TrAddr: "0000:80:00.0"},
+							},
+						},
+					},
+					{Message: autoReplaceDevices},
+					{
+						Message: &ctlpb.DevReplaceResp{
+							NewDevUuid: common.MockUUID(1),
+							DevState:   "NORMAL",
+						},
+					},
+				},
+			},
+			histories: map[uint32]*nvmeHealthHistory{
+				0: {Slots: map[string]string{common.MockUUID(0): "0000:80:00.0"}},
+			},
+			expResp: &ctlpb.SmdQueryResp{
+				Ranks: []*ctlpb.SmdQueryResp_RankResp{
+					{
+						Devices: []*ctlpb.SmdQueryResp_Device{
+							{
+								Uuid:  common.MockUUID(1),
+								State: "NORMAL",
+							},
+						},
+					},
+				},
+			},
+		},
+		"auto replace (removed device slot unknown)": {
+			req: &ctlpb.SmdQueryReq{AutoReplace: true, Uuid: common.MockUUID(0)},
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					{
+						Message: &ctlpb.SmdDevResp{
+							Devices: []*ctlpb.SmdDevResp_Device{
+								{Uuid: common.MockUUID(0), State: "OUT"},
+								{Uuid: common.MockUUID(1), State: "NEW", TrAddr: "0000:80:00.0"},
+							},
+						},
+					},
+				},
+			},
+			histories: map[uint32]*nvmeHealthHistory{
+				0: {Slots: map[string]string{}},
+			},
+			expErr: errors.New("PCI address of device " + common.MockUUID(0) + " is unknown"),
+		},
+		"auto replace (no new device)": {
+			req: &ctlpb.SmdQueryReq{AutoReplace: true},
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					{
+						Message: &ctlpb.SmdDevResp{
+							Devices: []*ctlpb.SmdDevResp_Device{
+								{Uuid: common.MockUUID(0), State: "FAULTY", TrAddr: "0000:80:00.0"},
+								{Uuid: common.MockUUID(2), State: "NEW", TrAddr: "0000:81:00.0"},
+							},
+						},
+					},
+				},
+			},
+			expErr: errors.New("no new device detected in PCI slot 0000:80:00.0"),
+		},
+		"auto replace (multiple faulty devices)": {
+			req: &ctlpb.SmdQueryReq{AutoReplace: true},
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					{
+						Message: &ctlpb.SmdDevResp{
+							Devices: []*ctlpb.SmdDevResp_Device{
+								{Uuid: common.MockUUID(0), State: "FAULTY", TrAddr: "0000:80:00.0"},
+								{Uuid: common.MockUUID(2), State: "OUT"},
+							},
+						},
+					},
+				},
+			},
+			expErr: errors.New("2 FAULTY or removed devices found"),
+		},
+		"auto replace (unknown device)": {
+			req: &ctlpb.SmdQueryReq{AutoReplace: true, Uuid: common.MockUUID(5)},
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					{Message: autoReplaceDevices},
+				},
+			},
+			expErr: errors.New("did not match any devices"),
+		},
 		"list-pools": {
 			req: &ctlpb.SmdQueryReq{
 				OmitDevices: true,
@@ -433,6 +567,52 @@ func TestServer_CtlSvc_SmdQuery(t *testing.T) {
 				},
 			},
 		},
+		"device-health with history": {
+			req: &ctlpb.SmdQueryReq{
+				OmitPools:      true,
+				Rank:           uint32(system.NilRank),
+				Uuid:           common.MockUUID(1),
+				IncludeHistory: true,
+			},
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					{
+						Message: &ctlpb.SmdDevResp{
+							Devices: []*ctlpb.SmdDevResp_Device{
+								{
+									Uuid: common.MockUUID(1),
+								},
+							},
+						},
+					},
+				},
+			},
+			histories: map[uint32]*nvmeHealthHistory{
+				0: {
+					Devices: map[string][]*storage.NvmeHealth{
+						common.MockUUID(1): {
+							{Timestamp: 1, Temperature: 300, AvailSpare: 100},
+							{Timestamp: 2, Temperature: 310, AvailSpare: 99, MediaErrors: 1},
+						},
+					},
+				},
+			},
+			expResp: &ctlpb.SmdQueryResp{
+				Ranks: []*ctlpb.SmdQueryResp_RankResp{
+					{
+						Devices: []*ctlpb.SmdQueryResp_Device{
+							{
+								Uuid: common.MockUUID(1),
+								History: []*ctlpb.BioHealthResp{
+									{Timestamp: 1, Temperature: 300, AvailSpare: 100},
+									{Timestamp: 2, Temperature: 310, AvailSpare: 99, MediaErrs: 1},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"device-health (DAOS Failure)": {
 			req: &ctlpb.SmdQueryReq{
 				OmitPools:        true,
@@ -607,6 +787,9 @@ func TestServer_CtlSvc_SmdQuery(t *testing.T) {
 				srv.setDrpcClient(newMockDrpcClient(cfg))
 				srv.ready.SetTrue()
 			}
+			for idx, hist := range tc.histories {
+				svc.nvmeHealth.histories[idx] = hist
+			}
 			if tc.harnessStopped {
 				svc.harness.started.SetFalse()
 			}
@@ -311,6 +311,11 @@ func (c *ControlService) getScmUsage(ssr *scm.ScanResponse) (*scm.ScanResponse,
 		if err != nil {
 			return nil, err
 		}
+		// Record the rank using the mount so that usage can be
+		// attributed to individual ranks when placing pools.
+		if rank, err := srv.GetRank(); err == nil {
+			mount.Rank = rank
+		}
 
 		switch cfg.Class {
 		case storage.ScmClassRAM: // generate fake namespace for emulated ramdisk mounts
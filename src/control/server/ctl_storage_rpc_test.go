@@ -384,20 +384,23 @@ func TestServer_CtlSvc_StorageScan_PostIOStart(t *testing.T) {
 	}
 
 	mockPbScmMount := proto.MockScmMountPoint()
+	mockPbScmMount.Rank = 1 // usage is reported with the rank of the first instance
 	mockPbScmNamespace := proto.MockScmNamespace()
 	mockPbScmNamespace.Mount = mockPbScmMount
 
 	for name, tc := range map[string]struct {
-		req       *StorageScanReq
-		bmbc      *bdev.MockBackendConfig
-		smbc      *scm.MockBackendConfig
-		smsc      *scm.MockSysConfig
-		cfg       *config.Server
-		scanTwice bool
-		junkResp  bool
-		drpcResps map[int][]*mockDrpcResponse
-		expErr    error
-		expResp   StorageScanResp
+		req           *StorageScanReq
+		bmbc          *bdev.MockBackendConfig
+		smbc          *scm.MockBackendConfig
+		smsc          *scm.MockSysConfig
+		cfg           *config.Server
+		bdevEmulation bool
+		scanTwice     bool
+		junkResp      bool
+		drpcResps     map[int][]*mockDrpcResponse
+		expErr        error
+		expResp       StorageScanResp
+		expLog        string
 	}{
 		"scan bdev health with io servers up": {
 			req: &StorageScanReq{
@@ -447,6 +450,72 @@ func TestServer_CtlSvc_StorageScan_PostIOStart(t *testing.T) {
 				Scm: &ScanScmResp{State: new(ResponseState)},
 			},
 		},
+		"scan bdev meta with aio bdevs": {
+			req: &StorageScanReq{
+				Scm:  new(ScanScmReq),
+				Nvme: &ScanNvmeReq{Meta: true},
+			},
+			bdevEmulation: true,
+			bmbc: &bdev.MockBackendConfig{
+				ScanRes: &bdev.ScanResponse{
+					Controllers: storage.NvmeControllers{newCtrlr(1)},
+				},
+			},
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					{Message: &ctlpb.SmdDevResp{
+						Devices: []*ctlpb.SmdDevResp_Device{
+							{Uuid: common.MockUUID(1), TgtIds: []int32{0}},
+						},
+					}},
+				},
+			},
+			expResp: StorageScanResp{
+				Nvme: &ScanNvmeResp{
+					Ctrlrs: proto.NvmeControllers{func() *NvmeController {
+						// AIO bdevs can't be matched to controllers
+						c := newCtrlrPBwMeta(1)
+						c.Smddevices = nil
+						return c
+					}()},
+					State: new(ResponseState),
+				},
+				Scm: &ScanScmResp{State: new(ResponseState)},
+			},
+		},
+		"scan bdev meta with unaddressed nvme bdev": {
+			req: &StorageScanReq{
+				Scm:  new(ScanScmReq),
+				Nvme: &ScanNvmeReq{Meta: true},
+			},
+			bmbc: &bdev.MockBackendConfig{
+				ScanRes: &bdev.ScanResponse{
+					Controllers: storage.NvmeControllers{newCtrlr(1)},
+				},
+			},
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					{Message: &ctlpb.SmdDevResp{
+						Devices: []*ctlpb.SmdDevResp_Device{
+							{Uuid: common.MockUUID(1), TgtIds: []int32{0}},
+						},
+					}},
+				},
+			},
+			expResp: StorageScanResp{
+				Nvme: &ScanNvmeResp{
+					Ctrlrs: proto.NvmeControllers{func() *NvmeController {
+						// bdev can't be matched to a controller
+						c := newCtrlrPBwMeta(1)
+						c.Smddevices = nil
+						return c
+					}()},
+					State: new(ResponseState),
+				},
+				Scm: &ScanScmResp{State: new(ResponseState)},
+			},
+			expLog: "NVMe bdev has no transport address",
+		},
 		"scan bdev health with multiple io servers up": {
 			req: &StorageScanReq{
 				Scm:  new(ScanScmReq),
@@ -717,6 +786,7 @@ func TestServer_CtlSvc_StorageScan_PostIOStart(t *testing.T) {
 								Path:       mockPbScmMount.Path,
 								TotalBytes: mockPbScmMount.TotalBytes,
 								AvailBytes: mockPbScmMount.AvailBytes,
+								Rank:       mockPbScmMount.Rank,
 							},
 						},
 					},
@@ -745,7 +815,8 @@ func TestServer_CtlSvc_StorageScan_PostIOStart(t *testing.T) {
 			for i := range cs.harness.instances {
 				// replace harness instance with mock I/O Engine
 				// to enable mocking of harness instance drpc channel
-				newSrv := newTestEngine(log, false, tc.cfg.Engines[i])
+				newSrv := newTestEngine(log, false, tc.cfg.Engines[i]).
+					WithBdevEmulation(tc.bdevEmulation)
 				newSrv.scmProvider = cs.scm
 				cs.harness.instances[i] = newSrv
 
@@ -795,6 +866,9 @@ func TestServer_CtlSvc_StorageScan_PostIOStart(t *testing.T) {
 			if diff := cmp.Diff(tc.expResp, *resp, cmpOpts...); diff != "" {
 				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
 			}
+			if tc.expLog != "" && !strings.Contains(buf.String(), tc.expLog) {
+				t.Fatalf("expected %q in log output", tc.expLog)
+			}
 		})
 	}
 }
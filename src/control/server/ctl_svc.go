@@ -18,9 +18,13 @@ import (
 // ctlpb.CtlSvcServer, and is the data container for the service.
 type ControlService struct {
 	StorageControlService
-	harness *EngineHarness
-	srvCfg  *config.Server
-	events  *events.PubSub
+	harness     *EngineHarness
+	srvCfg      *config.Server
+	events      *events.PubSub
+	nvmeHealth  *nvmeHealthMonitor
+	nvmeFaulty  *nvmeAutoFaulty
+	logSettings logSettings
+	reloader    configReloader
 }
 
 // NewControlService returns ControlService to be used as gRPC control service
@@ -31,10 +35,16 @@ func NewControlService(log logging.Logger, h *EngineHarness,
 
 	scs := NewStorageControlService(log, bp, sp, cfg.Engines)
 
+	nvmeHealth := newNvmeHealthMonitor(log, cfg.NvmeHealth, h, e)
+	nvmeFaulty := newNvmeAutoFaulty(log, cfg.NvmeAutoFaulty, h, e)
+	nvmeHealth.onThreshold = nvmeFaulty.healthWarning
+
 	return &ControlService{
 		StorageControlService: *scs,
 		harness:               h,
 		srvCfg:                cfg,
 		events:                e,
+		nvmeHealth:            nvmeHealth,
+		nvmeFaulty:            nvmeFaulty,
 	}
 }
@@ -42,6 +42,7 @@ func mockControlService(t *testing.T, log logging.Logger, cfg *config.Server, bm
 		},
 		events: events.NewPubSub(context.TODO(), log),
 	}
+	cs.nvmeHealth = newNvmeHealthMonitor(log, cfg.NvmeHealth, cs.harness, cs.events)
 
 	for _, srvCfg := range cfg.Engines {
 		bp, err := bdev.NewClassProvider(log, "", &srvCfg.Storage.Bdev)
@@ -0,0 +1,52 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+// RestoreDatabase seeds the local MS replica's raft directory with the
+// system database backup read from the supplied reader, as created by
+// "dmg system db-backup". It is intended for use when all MS replicas
+// have been lost, and must be run on a configured access point while
+// daos_server is stopped and the SCM containing the raft directory is
+// mounted.
+func RestoreDatabase(log logging.Logger, cfg *config.Server, r io.Reader) (*system.DatabaseBackup, error) {
+	if cfg == nil {
+		return nil, errors.New("nil server config")
+	}
+
+	dbCfg, err := sysdbConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if dbCfg.RaftDir == "" {
+		return nil, errors.New("no engines configured; unable to determine raft directory")
+	}
+
+	db, err := system.NewDatabase(log, dbCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create system database")
+	}
+
+	backup, err := system.DecodeBackup(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.RestoreBackup(backup); err != nil {
+		return nil, errors.Wrap(err, "failed to restore system database")
+	}
+
+	return backup, nil
+}
@@ -0,0 +1,99 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/server/engine"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+func TestServer_RestoreDatabase(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	srcDB := system.MockDatabase(t, log)
+	if err := srcDB.AddMember(system.MockMember(t, 0, system.MemberStateJoined)); err != nil {
+		t.Fatal(err)
+	}
+	backupData, err := srcDB.Backup()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		noEngines   bool
+		accessPoint string
+		systemName  string
+		data        []byte
+		expErr      error
+	}{
+		"no engines": {
+			noEngines: true,
+			expErr:    errors.New("no engines configured"),
+		},
+		"bad access point": {
+			accessPoint: "foo:bar",
+			expErr:      config.FaultConfigBadAccessPoints,
+		},
+		"not a replica": {
+			accessPoint: "10.254.254.254:10001",
+			expErr:      errors.New("replica"),
+		},
+		"bad backup": {
+			data:   []byte("garbage"),
+			expErr: errors.New("failed to decode system database backup"),
+		},
+		"wrong system name": {
+			systemName: "quack",
+			expErr:     errors.New("does not match configured system name"),
+		},
+		"success": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := common.CreateTestDir(t)
+			defer cleanup()
+
+			if tc.accessPoint == "" {
+				tc.accessPoint = "127.0.0.1:10001"
+			}
+			cfg := config.DefaultServer().WithAccessPoints(tc.accessPoint)
+			if tc.systemName != "" {
+				cfg = cfg.WithSystemName(tc.systemName)
+			}
+			if !tc.noEngines {
+				cfg = cfg.WithEngines(engine.NewConfig().WithScmMountPoint(testDir))
+			}
+			if tc.data == nil {
+				tc.data = backupData
+			}
+
+			backup, gotErr := RestoreDatabase(log, cfg, bytes.NewReader(tc.data))
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			common.AssertEqual(t, uint32(1), backup.MapVersion, "unexpected map version")
+			if _, err := os.Stat(filepath.Join(raftDir(cfg), "snapshots")); err != nil {
+				t.Fatalf("expected raft snapshot: %s", err)
+			}
+		})
+	}
+}
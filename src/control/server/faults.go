@@ -105,6 +105,33 @@ func FaultPoolDuplicateLabel(dupe string) *fault.Fault {
 	)
 }
 
+func FaultPoolInvalidLabel(label, reason string) *fault.Fault {
+	return serverFault(
+		code.ServerPoolInvalidLabel,
+		fmt.Sprintf("pool label %q is invalid: %s", label, reason),
+		"retry the request with a valid pool label",
+	)
+}
+
+func FaultPoolInsufficientCapacity(nRanks, nCandidates int, scmBytes, nvmeBytes uint64) *fault.Fault {
+	return serverFault(
+		code.ServerPoolInsufficientCapacity,
+		fmt.Sprintf("pool request requires %d ranks with %s SCM and %s NVMe free; only %d available",
+			nRanks, humanize.Bytes(scmBytes), humanize.Bytes(nvmeBytes), nCandidates),
+		"retry the request with a smaller pool size or fewer ranks",
+	)
+}
+
+func FaultPoolQuotaExceeded(quota *system.Quota, used, requested uint64) *fault.Fault {
+	return serverFault(
+		code.ServerPoolQuotaExceeded,
+		fmt.Sprintf("request for %s of pool capacity would exceed the %s quota for %s (%s of %s used)",
+			humanize.Bytes(requested), quota.Type, quota.Name,
+			humanize.Bytes(used), humanize.Bytes(quota.Limit)),
+		fmt.Sprintf("retry the request with a smaller pool size or increase the %s quota", quota.Type),
+	)
+}
+
 func FaultInsufficientFreeHugePages(free, requested int) *fault.Fault {
 	return serverFault(
 		code.ServerInsufficientFreeHugePages,
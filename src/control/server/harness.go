@@ -55,6 +55,18 @@ func (h *EngineHarness) WithFaultDomain(fd *system.FaultDomain) *EngineHarness {
 	return h
 }
 
+// setFaultDomain updates the fault domain of the EngineHarness and of each of
+// its EngineInstances.
+func (h *EngineHarness) setFaultDomain(fd *system.FaultDomain) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.faultDomain = fd
+	for _, srv := range h.instances {
+		srv.setHostFaultDomain(fd)
+	}
+}
+
 // isStarted indicates whether the EngineHarness is in a running state.
 func (h *EngineHarness) isStarted() bool {
 	return h.started.Load()
@@ -31,6 +31,7 @@ type (
 	onStorageReadyFn func(context.Context) error
 	onReadyFn        func(context.Context) error
 	onInstanceExitFn func(context.Context, system.Rank, error) error
+	onBioErrorFn     func(*srvpb.BioErrorReq)
 )
 
 // EngineInstance encapsulates control-plane specific configuration
@@ -53,10 +54,12 @@ type EngineInstance struct {
 	startLoop         chan bool // restart loop
 	fsRoot            string
 	hostFaultDomain   *system.FaultDomain
+	bdevEmulation     bool
 	joinSystem        systemJoinFn
 	onStorageReady    []onStorageReadyFn
 	onReady           []onReadyFn
 	onInstanceExit    []onInstanceExitFn
+	onBioError        []onBioErrorFn
 
 	sync.RWMutex
 	// these must be protected by a mutex in order to
@@ -64,6 +67,7 @@ type EngineInstance struct {
 	_drpcClient drpc.DomainSocketClient
 	_superblock *Superblock
 	_lastErr    error // populated when harness receives signal
+	_starts     uint32
 }
 
 // NewEngineInstance returns an *EngineInstance initialized with
@@ -91,6 +95,32 @@ func (srv *EngineInstance) WithHostFaultDomain(fd *system.FaultDomain) *EngineIn
 	return srv
 }
 
+// WithBdevEmulation indicates whether the NVMe controllers assigned to this
+// instance are emulated with files.
+func (srv *EngineInstance) WithBdevEmulation(emulated bool) *EngineInstance {
+	srv.bdevEmulation = emulated
+	return srv
+}
+
+// setHostFaultDomain updates the fault domain for the host this instance is
+// running on. The new fault domain is reported the next time the instance
+// joins the system.
+func (srv *EngineInstance) setHostFaultDomain(fd *system.FaultDomain) {
+	srv.Lock()
+	defer srv.Unlock()
+
+	srv.hostFaultDomain = fd
+}
+
+// getHostFaultDomain returns the fault domain for the host this instance is
+// running on.
+func (srv *EngineInstance) getHostFaultDomain() *system.FaultDomain {
+	srv.RLock()
+	defer srv.RUnlock()
+
+	return srv.hostFaultDomain
+}
+
 // isAwaitingFormat indicates whether EngineInstance is waiting
 // for an administrator action to trigger a format.
 func (srv *EngineInstance) isAwaitingFormat() bool {
@@ -128,6 +158,21 @@ func (srv *EngineInstance) OnInstanceExit(fns ...onInstanceExitFn) {
 	srv.onInstanceExit = append(srv.onInstanceExit, fns...)
 }
 
+// OnBioError adds a list of callbacks to invoke when the instance reports
+// a blob I/O error.
+func (srv *EngineInstance) OnBioError(fns ...onBioErrorFn) {
+	srv.onBioError = append(srv.onBioError, fns...)
+}
+
+// StartCount returns the number of times the instance runner has been
+// started.
+func (srv *EngineInstance) StartCount() uint32 {
+	srv.RLock()
+	defer srv.RUnlock()
+
+	return srv._starts
+}
+
 // LocalState returns local perspective of the current instance state
 // (doesn't consider state info held by the global system membership).
 func (srv *EngineInstance) LocalState() system.MemberState {
@@ -190,7 +235,7 @@ func (srv *EngineInstance) determineRank(ctx context.Context, ready *srvpb.Notif
 		Rank:        r,
 		URI:         ready.GetUri(),
 		NumContexts: ready.GetNctxs(),
-		FaultDomain: srv.hostFaultDomain,
+		FaultDomain: srv.getHostFaultDomain(),
 		InstanceIdx: srv.Index(),
 	})
 	if err != nil {
@@ -311,9 +356,13 @@ func (srv *EngineInstance) callSetUp(ctx context.Context) error {
 	return nil
 }
 
-// BioErrorNotify logs a blob I/O error.
+// BioErrorNotify logs a blob I/O error and invokes the registered callbacks.
 func (srv *EngineInstance) BioErrorNotify(bio *srvpb.BioErrorReq) {
 
 	srv.log.Errorf("I/O Engine instance %d (target %d) has detected blob I/O error! %v",
 		srv.Index(), bio.TgtId, bio)
+
+	for _, fn := range srv.onBioError {
+		fn(bio)
+	}
 }
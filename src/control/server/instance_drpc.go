@@ -193,6 +193,45 @@ func (srv *EngineInstance) listSmdDevices(ctx context.Context, req *ctlpb.SmdDev
 	return resp, nil
 }
 
+// setDeviceFaulty sets the state of the device to FAULTY.
+func (srv *EngineInstance) setDeviceFaulty(ctx context.Context, uuid string) (*ctlpb.DevStateResp, error) {
+	dresp, err := srv.CallDrpc(ctx, drpc.MethodSetFaultyState, &ctlpb.DevStateReq{DevUuid: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(ctlpb.DevStateResp)
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal StorageSetFaulty response")
+	}
+
+	if resp.Status != 0 {
+		return nil, errors.Wrap(drpc.DaosStatus(resp.Status), "smdSetFaulty failed")
+	}
+
+	return resp, nil
+}
+
+// setLogMasks sets the log masks of the engine, restoring the masks in effect
+// at startup if the supplied masks string is empty.
+func (srv *EngineInstance) setLogMasks(ctx context.Context, masks string) error {
+	dresp, err := srv.CallDrpc(ctx, drpc.MethodSetLogMasks, &mgmtpb.SetLogMasksReq{Masks: masks})
+	if err != nil {
+		return err
+	}
+
+	resp := new(mgmtpb.DaosResp)
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return errors.Wrap(err, "unmarshal SetLogMasks response")
+	}
+
+	if resp.Status != 0 {
+		return errors.Wrap(drpc.DaosStatus(resp.Status), "setLogMasks failed")
+	}
+
+	return nil
+}
+
 // updateInUseBdevs updates-in-place the input list of controllers with
 // new NVMe health stats and SMD metadata info.
 //
@@ -211,6 +250,17 @@ func (srv *EngineInstance) updateInUseBdevs(ctx context.Context, ctrlrMap map[st
 		msg := fmt.Sprintf("instance %d: smd %s with transport address %s",
 			srv.Index(), dev.GetUuid(), dev.GetTrAddr())
 
+		if dev.GetTrAddr() == "" {
+			// The AIO bdevs used by emulated controllers have no
+			// transport address to match.
+			if srv.bdevEmulation {
+				srv.log.Debugf("%s: emulated bdev, skipping", msg)
+				continue
+			}
+			srv.log.Errorf("%s: NVMe bdev has no transport address, dropping it from scan results", msg)
+			continue
+		}
+
 		ctrlr, exists := ctrlrMap[dev.GetTrAddr()]
 		if !exists {
 			return errors.Errorf("%s: didn't match any known controllers", msg)
@@ -68,6 +68,10 @@ func (srv *EngineInstance) start(ctx context.Context, errChan chan<- error) erro
 		srv.log.Errorf("instance %d: unable to log SCM storage stats: %s", srv.Index(), err)
 	}
 
+	srv.Lock()
+	srv._starts++
+	srv.Unlock()
+
 	// async call returns immediately, runner sends on errChan when ctx.Done()
 	return srv.runner.Start(ctx, errChan)
 }
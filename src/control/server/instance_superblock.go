@@ -51,13 +51,18 @@ func (sb *Superblock) Unmarshal(raw []byte) error {
 	return yaml.Unmarshal(raw, sb)
 }
 
-func (srv *EngineInstance) superblockPath() string {
+// storagePath returns the path of the instance's SCM mount.
+func (srv *EngineInstance) storagePath() string {
 	scmConfig := srv.scmConfig()
 	storagePath := scmConfig.MountPoint
 	if storagePath == "" {
 		storagePath = defaultStoragePath
 	}
-	return filepath.Join(srv.fsRoot, storagePath, "superblock")
+	return filepath.Join(srv.fsRoot, storagePath)
+}
+
+func (srv *EngineInstance) superblockPath() string {
+	return filepath.Join(srv.storagePath(), "superblock")
 }
 
 func (srv *EngineInstance) setSuperblock(sb *Superblock) {
@@ -7,6 +7,7 @@
 package server
 
 import (
+	"crypto/x509"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -19,27 +20,45 @@ import (
 
 	"github.com/mjmac/soad/src/control/common/proto"
 	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/logging"
 	"github.com/mjmac/soad/src/control/security"
 )
 
-func checkAccess(ctx context.Context, FullMethod string) error {
-	component, err := componentFromContext(ctx)
+// accessChecker authorizes gRPC method calls based on the verified client
+// certificate and records any denials.
+type accessChecker struct {
+	log  logging.Logger
+	auth *security.Authorizer
+}
 
+func (ac *accessChecker) checkAccess(ctx context.Context, FullMethod string) error {
+	cert, err := certFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
-	hasAccess := component.HasAccess(FullMethod)
-
-	if !hasAccess {
-		errMsg := fmt.Sprintf("%s does not have permission to call %s", component, FullMethod)
+	if !ac.auth.HasAccess(cert, FullMethod) {
+		comp := security.CommonNameToComponent(cert.Subject.CommonName)
+		principal := comp.String()
+		if ac.auth.RBACEnabled() && comp != security.ComponentAgent && comp != security.ComponentServer {
+			principal = fmt.Sprintf("roles %v", ac.auth.Roles(cert))
+		}
+
+		addr := "unknown"
+		if clientPeer, ok := peer.FromContext(ctx); ok && clientPeer.Addr != nil {
+			addr = clientPeer.Addr.String()
+		}
+		ac.log.Infof("access denied: %s (CN=%q, %s) called %s", addr,
+			cert.Subject.CommonName, principal, FullMethod)
+
+		errMsg := fmt.Sprintf("%s does not have permission to call %s", principal, FullMethod)
 		return status.Error(codes.PermissionDenied, errMsg)
 	}
 
 	return nil
 }
 
-func componentFromContext(ctx context.Context) (comp *security.Component, err error) {
+func certFromContext(ctx context.Context) (*x509.Certificate, error) {
 	clientPeer, ok := peer.FromContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "no peer information found")
@@ -56,16 +75,12 @@ func componentFromContext(ctx context.Context) (comp *security.Component, err er
 		return nil, status.Error(codes.Unauthenticated, "unable to verify client certificates")
 	}
 
-	peerCert := certs[0][0]
-
-	component := security.CommonNameToComponent(peerCert.Subject.CommonName)
-
-	return &component, nil
+	return certs[0][0], nil
 }
 
-func unaryAccessInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+func (ac *accessChecker) unaryAccessInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 
-	err := checkAccess(ctx, info.FullMethod)
+	err := ac.checkAccess(ctx, info.FullMethod)
 
 	if err != nil {
 		return nil, err
@@ -74,9 +89,9 @@ func unaryAccessInterceptor(ctx context.Context, req interface{}, info *grpc.Una
 	return handler(ctx, req)
 }
 
-func streamAccessInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+func (ac *accessChecker) streamAccessInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 	ctx := ss.Context()
-	err := checkAccess(ctx, info.FullMethod)
+	err := ac.checkAccess(ctx, info.FullMethod)
 
 	if err != nil {
 		return err
@@ -85,7 +100,19 @@ func streamAccessInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.S
 	return handler(srv, ss)
 }
 
-func unaryInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.UnaryServerInterceptor, error) {
+func newAccessChecker(log logging.Logger, cfg *security.TransportConfig) (*accessChecker, error) {
+	auth, err := security.NewAuthorizer(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to configure access control")
+	}
+	if auth.RBACEnabled() {
+		log.Infof("role-based access control enabled with %d role mappings", len(cfg.RoleMappings))
+	}
+
+	return &accessChecker{log: log, auth: auth}, nil
+}
+
+func unaryInterceptorForTransportConfig(log logging.Logger, cfg *security.TransportConfig) (grpc.UnaryServerInterceptor, error) {
 	if cfg == nil {
 		return nil, errors.New("nil TransportConfig")
 	}
@@ -94,10 +121,15 @@ func unaryInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.Una
 		return nil, nil
 	}
 
-	return unaryAccessInterceptor, nil
+	ac, err := newAccessChecker(log, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return ac.unaryAccessInterceptor, nil
 }
 
-func streamInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.StreamServerInterceptor, error) {
+func streamInterceptorForTransportConfig(log logging.Logger, cfg *security.TransportConfig) (grpc.StreamServerInterceptor, error) {
 	if cfg == nil {
 		return nil, errors.New("nil TransportConfig")
 	}
@@ -106,7 +138,12 @@ func streamInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.St
 		return nil, nil
 	}
 
-	return streamAccessInterceptor, nil
+	ac, err := newAccessChecker(log, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return ac.streamAccessInterceptor, nil
 }
 
 func unaryErrorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
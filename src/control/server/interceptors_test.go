@@ -8,13 +8,24 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/mjmac/soad/src/control/common"
 	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/security"
 )
 
 type testStatus struct {
@@ -64,3 +75,83 @@ func TestServer_unaryStatusInterceptor(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_accessChecker_checkAccess(t *testing.T) {
+	peerCtx := func(cert *x509.Certificate) context.Context {
+		return peer.NewContext(context.TODO(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 4242},
+			AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{
+					VerifiedChains: [][]*x509.Certificate{{cert}},
+				},
+			},
+		})
+	}
+	opsCert := &x509.Certificate{
+		Subject: pkix.Name{CommonName: "admin", OrganizationalUnit: []string{"ops"}},
+	}
+
+	for name, tc := range map[string]struct {
+		ctx       context.Context
+		cfg       *security.TransportConfig
+		method    string
+		expErr    error
+		expLogged string
+	}{
+		"no peer": {
+			ctx:    context.TODO(),
+			cfg:    &security.TransportConfig{},
+			method: "/mgmt.MgmtSvc/SystemQuery",
+			expErr: status.Error(codes.Unauthenticated, "no peer information found"),
+		},
+		"legacy admin": {
+			ctx:    peerCtx(opsCert),
+			cfg:    &security.TransportConfig{},
+			method: "/mgmt.MgmtSvc/PoolDestroy",
+		},
+		"legacy admin denied": {
+			ctx:       peerCtx(opsCert),
+			cfg:       &security.TransportConfig{},
+			method:    "/mgmt.MgmtSvc/Join",
+			expErr:    status.Error(codes.PermissionDenied, "admin does not have permission to call /mgmt.MgmtSvc/Join"),
+			expLogged: `access denied: 10.0.0.1:4242 (CN="admin", admin) called /mgmt.MgmtSvc/Join`,
+		},
+		"role allowed": {
+			ctx: peerCtx(opsCert),
+			cfg: &security.TransportConfig{
+				RoleMappings: []*security.RoleMapping{
+					{Role: security.RoleOperator, OrganizationalUnit: "ops"},
+				},
+			},
+			method: "/mgmt.MgmtSvc/SystemQuery",
+		},
+		"role denied": {
+			ctx: peerCtx(opsCert),
+			cfg: &security.TransportConfig{
+				RoleMappings: []*security.RoleMapping{
+					{Role: security.RoleOperator, OrganizationalUnit: "ops"},
+				},
+			},
+			method:    "/mgmt.MgmtSvc/PoolDestroy",
+			expErr:    status.Error(codes.PermissionDenied, "roles [operator] does not have permission to call /mgmt.MgmtSvc/PoolDestroy"),
+			expLogged: `access denied: 10.0.0.1:4242 (CN="admin", roles [operator]) called /mgmt.MgmtSvc/PoolDestroy`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			ac, err := newAccessChecker(log, tc.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotErr := ac.checkAccess(tc.ctx, tc.method)
+			common.CmpErr(t, tc.expErr, gotErr)
+
+			if tc.expLogged != "" && !strings.Contains(buf.String(), tc.expLogged) {
+				t.Fatalf("expected %q to be logged, got:\n%s", tc.expLogged, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	"github.com/mjmac/soad/src/control/lib/metrics"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+const (
+	metricsPath            = "/metrics"
+	metricsShutdownTimeout = 5 * time.Second
+)
+
+// memberStates is the set of states reported in system member counts.
+var memberStates = []system.MemberState{
+	system.MemberStateAwaitFormat,
+	system.MemberStateStarting,
+	system.MemberStateReady,
+	system.MemberStateJoined,
+	system.MemberStateStopping,
+	system.MemberStateStopped,
+	system.MemberStateEvicted,
+	system.MemberStateErrored,
+	system.MemberStateUnresponsive,
+}
+
+// metricsCollector implements the metrics.Collector interface and gathers
+// control plane metrics from the local engine instances, the host and, on
+// MS replicas, the system database.
+type metricsCollector struct {
+	log          logging.Logger
+	harness      *EngineHarness
+	sysdb        *system.Database
+	hugePageInfo func() (*hugePageInfo, error)
+}
+
+func newMetricsCollector(log logging.Logger, harness *EngineHarness, sysdb *system.Database) *metricsCollector {
+	return &metricsCollector{
+		log:          log,
+		harness:      harness,
+		sysdb:        sysdb,
+		hugePageInfo: getHugePageInfo,
+	}
+}
+
+// collectErrors accumulates errors from independent metric sources so that
+// a failure in one source does not prevent the others from being reported.
+type collectErrors []string
+
+func (ce *collectErrors) add(err error) {
+	*ce = append(*ce, err.Error())
+}
+
+func (ce collectErrors) err() error {
+	if len(ce) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(ce, "; "))
+}
+
+func (mc *metricsCollector) collectSystem(errs *collectErrors) []*metrics.Family {
+	if !mc.sysdb.IsReplica() {
+		return nil
+	}
+
+	status, err := mc.sysdb.RaftStatus()
+	if err != nil {
+		errs.add(errors.Wrap(err, "raft status"))
+		return nil
+	}
+
+	isLeader := 0.0
+	if mc.sysdb.IsLeader() {
+		isLeader = 1
+	}
+	families := []*metrics.Family{
+		metrics.NewGauge("daos_system_raft_leader",
+			"Whether this MS replica is the current leader (1) or not (0)").
+			Add(isLeader, metrics.Labels{"leader": status.Leader}),
+		metrics.NewGauge("daos_system_raft_term",
+			"Current raft term of this MS replica").
+			Add(float64(status.Term), nil),
+	}
+
+	// Only the leader reports member counts in order to avoid
+	// duplicate (and possibly stale) counts from other replicas.
+	if isLeader == 0 {
+		return families
+	}
+
+	members, err := mc.sysdb.AllMembers()
+	if err != nil {
+		errs.add(errors.Wrap(err, "system members"))
+		return families
+	}
+	counts := make(map[system.MemberState]int)
+	for _, m := range members {
+		counts[m.State()]++
+	}
+	memberFam := metrics.NewGauge("daos_system_members",
+		"Number of DAOS system members in each state")
+	for _, state := range memberStates {
+		memberFam.Add(float64(counts[state]), metrics.Labels{"state": strings.ToLower(state.String())})
+	}
+
+	return append(families, memberFam)
+}
+
+func (mc *metricsCollector) collectHugePages(errs *collectErrors) []*metrics.Family {
+	hpi, err := mc.hugePageInfo()
+	if err != nil {
+		errs.add(errors.Wrap(err, "hugepage info"))
+		return nil
+	}
+
+	return []*metrics.Family{
+		metrics.NewGauge("daos_server_hugepages_total", "Total number of hugepages").
+			Add(float64(hpi.Total), nil),
+		metrics.NewGauge("daos_server_hugepages_free", "Number of free hugepages").
+			Add(float64(hpi.Free), nil),
+		metrics.NewGauge("daos_server_hugepages_reserved", "Number of reserved hugepages").
+			Add(float64(hpi.Reserved), nil),
+		metrics.NewGauge("daos_server_hugepages_surplus", "Number of surplus hugepages").
+			Add(float64(hpi.Surplus), nil),
+		metrics.NewGauge("daos_server_hugepage_size_bytes", "Size of a hugepage in bytes").
+			Add(float64(hpi.PageSizeKb)*1024, nil),
+	}
+}
+
+// nvmeHealthGauges describes the BIO health counters exported for each
+// NVMe device.
+var nvmeHealthGauges = []struct {
+	name  string
+	help  string
+	value func(*ctlpb.BioHealthResp) float64
+}{
+	{"daos_nvme_temperature_kelvin", "NVMe device temperature",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetTemperature()) }},
+	{"daos_nvme_power_cycles", "NVMe device power cycles",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetPowerCycles()) }},
+	{"daos_nvme_power_on_hours", "NVMe device power-on hours",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetPowerOnHours()) }},
+	{"daos_nvme_unsafe_shutdowns", "NVMe device unsafe shutdowns",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetUnsafeShutdowns()) }},
+	{"daos_nvme_media_errors", "NVMe device media errors",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetMediaErrs()) }},
+	{"daos_nvme_error_log_entries", "NVMe device error log entries",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetErrLogEntries()) }},
+	{"daos_nvme_bio_read_errors", "BIO read errors on NVMe device",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetBioReadErrs()) }},
+	{"daos_nvme_bio_write_errors", "BIO write errors on NVMe device",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetBioWriteErrs()) }},
+	{"daos_nvme_bio_unmap_errors", "BIO unmap errors on NVMe device",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetBioUnmapErrs()) }},
+	{"daos_nvme_checksum_errors", "Checksum errors on NVMe device",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetChecksumErrs()) }},
+	{"daos_nvme_total_bytes", "Size of NVMe device blobstore",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetTotalBytes()) }},
+	{"daos_nvme_avail_bytes", "Free space in NVMe device blobstore",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetAvailBytes()) }},
+	{"daos_nvme_avail_spare_percent", "Remaining spare capacity of NVMe device",
+		func(h *ctlpb.BioHealthResp) float64 { return float64(h.GetAvailSpare()) }},
+}
+
+func (mc *metricsCollector) collectEngines(ctx context.Context, errs *collectErrors) []*metrics.Family {
+	stateFam := metrics.NewGauge("daos_engine_state",
+		"Local state of each I/O Engine instance")
+	restartFam := metrics.NewCounter("daos_engine_restarts_total",
+		"Number of times each I/O Engine instance has been restarted")
+	devStateFam := metrics.NewGauge("daos_nvme_device_state",
+		"BIO state of each NVMe device managed by an I/O Engine instance")
+	healthFams := make([]*metrics.Family, len(nvmeHealthGauges))
+	for i, hg := range nvmeHealthGauges {
+		healthFams[i] = metrics.NewGauge(hg.name, hg.help)
+	}
+
+	for _, srv := range mc.harness.Instances() {
+		labels := metrics.Labels{"instance": strconv.Itoa(int(srv.Index()))}
+		if rank, err := srv.GetRank(); err == nil {
+			labels["rank"] = rank.String()
+		}
+		withLabels := func(extra metrics.Labels) metrics.Labels {
+			out := make(metrics.Labels, len(labels)+len(extra))
+			for k, v := range labels {
+				out[k] = v
+			}
+			for k, v := range extra {
+				out[k] = v
+			}
+			return out
+		}
+
+		state := srv.LocalState()
+		stateFam.Add(1, withLabels(metrics.Labels{"state": strings.ToLower(state.String())}))
+
+		restarts := 0.0
+		if starts := srv.StartCount(); starts > 1 {
+			restarts = float64(starts - 1)
+		}
+		restartFam.Add(restarts, labels)
+
+		// SMD and BIO health information is only available from
+		// running instances.
+		if !srv.isReady() {
+			continue
+		}
+
+		smdResp, err := srv.listSmdDevices(ctx, new(ctlpb.SmdDevReq))
+		if err != nil {
+			errs.add(errors.Wrapf(err, "instance %d smd devices", srv.Index()))
+			continue
+		}
+		for _, dev := range smdResp.GetDevices() {
+			devStateFam.Add(1, withLabels(metrics.Labels{
+				"device":  dev.GetUuid(),
+				"tr_addr": dev.GetTrAddr(),
+				"state":   dev.GetState(),
+			}))
+
+			health, err := srv.getBioHealth(ctx, &ctlpb.BioHealthReq{DevUuid: dev.GetUuid()})
+			if err != nil {
+				errs.add(errors.Wrapf(err, "instance %d device %s health", srv.Index(), dev.GetUuid()))
+				continue
+			}
+			for i, hg := range nvmeHealthGauges {
+				healthFams[i].Add(hg.value(health), withLabels(metrics.Labels{"device": dev.GetUuid()}))
+			}
+		}
+	}
+
+	return append([]*metrics.Family{stateFam, restartFam, devStateFam}, healthFams...)
+}
+
+// Collect implements the metrics.Collector interface.
+func (mc *metricsCollector) Collect(ctx context.Context) ([]*metrics.Family, error) {
+	var errs collectErrors
+
+	var families []*metrics.Family
+	families = append(families, mc.collectSystem(&errs)...)
+	families = append(families, mc.collectHugePages(&errs)...)
+	families = append(families, mc.collectEngines(ctx, &errs)...)
+
+	return families, errs.err()
+}
+
+// serveMetrics serves metrics gathered from the supplied collectors over
+// HTTP on the listener until the context is canceled.
+func serveMetrics(ctx context.Context, log logging.Logger, lis net.Listener, collectors ...metrics.Collector) {
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, metrics.NewHandler(log, collectors...))
+	srv := &http.Server{Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), metricsShutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Errorf("metrics listener shutdown: %s", err)
+		}
+	}()
+
+	go func() {
+		if err := srv.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.Errorf("metrics listener failed: %s", err)
+		}
+	}()
+}
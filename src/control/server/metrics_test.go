@@ -0,0 +1,205 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	"github.com/mjmac/soad/src/control/lib/metrics"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/engine"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+func TestServer_metricsCollector(t *testing.T) {
+	testDevUUID := common.MockUUID(1)
+	hpi := &hugePageInfo{
+		Total:      1024,
+		Free:       512,
+		PageSizeKb: 2048,
+	}
+
+	for name, tc := range map[string]struct {
+		notReplica  bool
+		notReady    bool
+		starts      uint32
+		members     system.Members
+		drpcResps   []*mockDrpcResponse
+		hpiErr      error
+		expLines    []string
+		notExpLines []string
+		expErr      error
+	}{
+		"not replica": {
+			notReplica: true,
+			notReady:   true,
+			expLines: []string{
+				`daos_engine_state{instance="0",rank="0",state="stopped"} 1`,
+				`daos_engine_restarts_total{instance="0",rank="0"} 0`,
+				`daos_server_hugepages_total 1024`,
+				`daos_server_hugepages_free 512`,
+				`daos_server_hugepage_size_bytes 2.097152e+06`,
+			},
+			notExpLines: []string{
+				"# TYPE daos_system_raft_term gauge",
+				"# TYPE daos_system_members gauge",
+				"# TYPE daos_nvme_device_state gauge",
+			},
+		},
+		"leader with devices": {
+			starts: 3,
+			members: system.Members{
+				system.MockMember(t, 0, system.MemberStateJoined),
+				system.MockMember(t, 1, system.MemberStateJoined),
+				system.MockMember(t, 2, system.MemberStateErrored),
+			},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &ctlpb.SmdDevResp{
+					Devices: []*ctlpb.SmdDevResp_Device{
+						{Uuid: testDevUUID, TrAddr: "0000:8a:00.0", State: "NORMAL"},
+					},
+				}},
+				{Message: &ctlpb.BioHealthResp{
+					DevUuid:     testDevUUID,
+					Temperature: 300,
+					MediaErrs:   2,
+					BioReadErrs: 5,
+					AvailSpare:  97,
+				}},
+			},
+			expLines: []string{
+				`daos_system_raft_leader{leader=""} 1`,
+				`daos_system_raft_term 0`,
+				`daos_system_members{state="joined"} 2`,
+				`daos_system_members{state="errored"} 1`,
+				`daos_system_members{state="stopped"} 0`,
+				`daos_engine_state{instance="0",rank="0",state="ready"} 1`,
+				`daos_engine_restarts_total{instance="0",rank="0"} 2`,
+				`daos_nvme_device_state{device="` + testDevUUID + `",instance="0",rank="0",state="NORMAL",tr_addr="0000:8a:00.0"} 1`,
+				`daos_nvme_temperature_kelvin{device="` + testDevUUID + `",instance="0",rank="0"} 300`,
+				`daos_nvme_media_errors{device="` + testDevUUID + `",instance="0",rank="0"} 2`,
+				`daos_nvme_bio_read_errors{device="` + testDevUUID + `",instance="0",rank="0"} 5`,
+				`daos_nvme_avail_spare_percent{device="` + testDevUUID + `",instance="0",rank="0"} 97`,
+			},
+		},
+		"partial failure": {
+			hpiErr: errors.New("no meminfo"),
+			drpcResps: []*mockDrpcResponse{
+				{Message: &ctlpb.SmdDevResp{Status: -1}},
+			},
+			expLines: []string{
+				`daos_system_raft_leader{leader=""} 1`,
+				`daos_engine_state{instance="0",rank="0",state="ready"} 1`,
+			},
+			notExpLines: []string{
+				"# TYPE daos_server_hugepages_total gauge",
+				"# TYPE daos_nvme_device_state gauge",
+			},
+			expErr: errors.New("hugepage info: no meminfo; instance 0 smd devices"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			srv := newTestEngine(log, false)
+			if tc.notReady {
+				srv = NewEngineInstance(log, nil, nil, nil,
+					engine.NewTestRunner(nil, engine.NewConfig()))
+				srv.setSuperblock(&Superblock{Rank: system.NewRankPtr(0)})
+			}
+			srv._starts = tc.starts
+			cfg := new(mockDrpcClientConfig)
+			cfg.setSendMsgResponseList(t, tc.drpcResps...)
+			srv.setDrpcClient(newMockDrpcClient(cfg))
+
+			harness := NewEngineHarness(log)
+			if err := harness.AddInstance(srv); err != nil {
+				t.Fatal(err)
+			}
+
+			var db *system.Database
+			if tc.notReplica {
+				db = system.MockDatabaseWithAddr(t, log, nil)
+			} else {
+				db = system.MockDatabase(t, log)
+			}
+			for _, m := range tc.members {
+				if err := db.AddMember(m); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			mc := newMetricsCollector(log, harness, db)
+			mc.hugePageInfo = func() (*hugePageInfo, error) {
+				return hpi, tc.hpiErr
+			}
+
+			families, gotErr := mc.Collect(context.Background())
+			common.CmpErr(t, tc.expErr, gotErr)
+
+			var out strings.Builder
+			if err := metrics.Write(&out, families); err != nil {
+				t.Fatal(err)
+			}
+			gotLines := make(map[string]bool)
+			for _, line := range strings.Split(out.String(), "\n") {
+				gotLines[line] = true
+			}
+			for _, line := range tc.expLines {
+				if !gotLines[line] {
+					t.Errorf("expected line %q not found in output:\n%s", line, out.String())
+				}
+			}
+			for _, line := range tc.notExpLines {
+				if gotLines[line] {
+					t.Errorf("unexpected line %q found in output:\n%s", line, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestServer_serveMetrics(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	serveMetrics(ctx, log, lis, metrics.CollectorFunc(func(_ context.Context) ([]*metrics.Family, error) {
+		return []*metrics.Family{metrics.NewGauge("test_gauge", "a test").Add(42, nil)}, nil
+	}))
+
+	resp, err := http.Get("http://" + lis.Addr().String() + metricsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	common.AssertEqual(t, http.StatusOK, resp.StatusCode, "unexpected status")
+	common.AssertEqual(t, "# HELP test_gauge a test\n# TYPE test_gauge gauge\ntest_gauge 42\n",
+		string(body), "unexpected body")
+}
@@ -7,6 +7,9 @@
 package server
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
@@ -15,6 +18,34 @@ import (
 	"github.com/mjmac/soad/src/control/drpc"
 )
 
+// contServiceReq is implemented by requests that are forwarded to the pool
+// service hosting a container.
+type contServiceReq interface {
+	proto.Message
+	GetPoolUUID() string
+	SetPoolUUID(id string)
+	GetSvcRanks() []uint32
+	SetSvcRanks(rl []uint32)
+}
+
+func (svc *mgmtSvc) makeContServiceCall(ctx context.Context, method drpc.Method, req contServiceReq) (*drpc.Response, error) {
+	ps, err := svc.resolvePoolID(req.GetPoolUUID())
+	if err != nil {
+		return nil, err
+	}
+	req.SetPoolUUID(ps.PoolUUID.String())
+
+	if len(req.GetSvcRanks()) == 0 {
+		rl, err := svc.getPoolServiceRanks(ps)
+		if err != nil {
+			return nil, err
+		}
+		req.SetSvcRanks(rl)
+	}
+
+	return svc.harness.CallDrpc(ctx, method, req)
+}
+
 // ListContainers forwards a gRPC request to the DAOS I/O Engine to retrieve a pool's
 // list of containers.
 func (svc *mgmtSvc) ListContainers(ctx context.Context, req *mgmtpb.ListContReq) (*mgmtpb.ListContResp, error) {
@@ -23,7 +54,7 @@ func (svc *mgmtSvc) ListContainers(ctx context.Context, req *mgmtpb.ListContReq)
 	}
 	svc.log.Debugf("MgmtSvc.ListContainers dispatch, req:%+v\n", *req)
 
-	dresp, err := svc.harness.CallDrpc(ctx, drpc.MethodListContainers, req)
+	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodListContainers, req)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +76,7 @@ func (svc *mgmtSvc) ContSetOwner(ctx context.Context, req *mgmtpb.ContSetOwnerRe
 	}
 	svc.log.Debugf("MgmtSvc.ContSetOwner dispatch, req:%+v\n", *req)
 
-	dresp, err := svc.harness.CallDrpc(ctx, drpc.MethodContSetOwner, req)
+	dresp, err := svc.makeContServiceCall(ctx, drpc.MethodContSetOwner, req)
 	if err != nil {
 		return nil, err
 	}
@@ -59,3 +90,318 @@ func (svc *mgmtSvc) ContSetOwner(ctx context.Context, req *mgmtpb.ContSetOwnerRe
 
 	return resp, nil
 }
+
+// ContQuery forwards a gRPC request to the DAOS I/O Engine to query a container's
+// ownership and usage.
+func (svc *mgmtSvc) ContQuery(ctx context.Context, req *mgmtpb.ContQueryReq) (*mgmtpb.ContQueryResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("MgmtSvc.ContQuery dispatch, req:%+v\n", req)
+
+	dresp, err := svc.makeContServiceCall(ctx, drpc.MethodContQuery, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.ContQueryResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal ContQuery response")
+	}
+
+	svc.log.Debugf("MgmtSvc.ContQuery dispatch, resp:%+v\n", resp)
+
+	return resp, nil
+}
+
+// ContDestroy forwards a gRPC request to the DAOS I/O Engine to destroy a container.
+func (svc *mgmtSvc) ContDestroy(ctx context.Context, req *mgmtpb.ContDestroyReq) (*mgmtpb.ContDestroyResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("MgmtSvc.ContDestroy dispatch, req:%+v\n", req)
+
+	dresp, err := svc.makeContServiceCall(ctx, drpc.MethodContDestroy, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.ContDestroyResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal ContDestroy response")
+	}
+
+	svc.log.Debugf("MgmtSvc.ContDestroy dispatch, resp:%+v\n", resp)
+
+	return resp, nil
+}
+
+// contProperties lists the container properties that may be retrieved by
+// name, in the order in which they are reported.
+var contProperties = []struct {
+	name   string
+	number uint32
+}{
+	{"label", drpc.ContPropertyLabel},
+	{"layout_type", drpc.ContPropertyLayoutType},
+	{"layout_version", drpc.ContPropertyLayoutVersion},
+	{"cksum", drpc.ContPropertyChecksum},
+	{"cksum_size", drpc.ContPropertyChecksumSize},
+	{"srv_cksum", drpc.ContPropertyServerChecksum},
+	{"rf", drpc.ContPropertyRedundancyFactor},
+	{"rf_lvl", drpc.ContPropertyRedundancyLevel},
+	{"max_snapshot", drpc.ContPropertySnapshotMax},
+	{"compression", drpc.ContPropertyCompression},
+	{"encryption", drpc.ContPropertyEncryption},
+	{"owner", drpc.ContPropertyOwner},
+	{"group", drpc.ContPropertyOwnerGroup},
+	{"dedup", drpc.ContPropertyDedup},
+	{"dedup_th", drpc.ContPropertyDedupThreshold},
+	{"status", drpc.ContPropertyStatus},
+	{"alloc_oid", drpc.ContPropertyAllocatedOID},
+}
+
+var (
+	contLayoutNames = map[uint64]string{
+		drpc.ContLayoutUnknown: "unknown",
+		drpc.ContLayoutPOSIX:   "posix",
+		drpc.ContLayoutHDF5:    "hdf5",
+	}
+	contChecksumNames = map[uint64]string{
+		drpc.ContChecksumOff:     "off",
+		drpc.ContChecksumCRC16:   "crc16",
+		drpc.ContChecksumCRC32:   "crc32",
+		drpc.ContChecksumCRC64:   "crc64",
+		drpc.ContChecksumSHA1:    "sha1",
+		drpc.ContChecksumSHA256:  "sha256",
+		drpc.ContChecksumSHA512:  "sha512",
+		drpc.ContChecksumAdler32: "adler32",
+	}
+	contDedupNames = map[uint64]string{
+		drpc.ContDedupOff:    "off",
+		drpc.ContDedupMemcmp: "memcmp",
+		drpc.ContDedupHash:   "hash",
+	}
+	contCompressNames = map[uint64]string{
+		drpc.ContCompressOff:      "off",
+		drpc.ContCompressLZ4:      "lz4",
+		drpc.ContCompressDeflate:  "deflate",
+		drpc.ContCompressDeflate1: "deflate1",
+		drpc.ContCompressDeflate2: "deflate2",
+		drpc.ContCompressDeflate3: "deflate3",
+		drpc.ContCompressDeflate4: "deflate4",
+	}
+	contEncryptNames = map[uint64]string{
+		drpc.ContEncryptOff:       "off",
+		drpc.ContEncryptAESXTS128: "aes-xts128",
+		drpc.ContEncryptAESXTS256: "aes-xts256",
+		drpc.ContEncryptAESCBC128: "aes-cbc128",
+		drpc.ContEncryptAESCBC192: "aes-cbc192",
+		drpc.ContEncryptAESCBC256: "aes-cbc256",
+		drpc.ContEncryptAESGCM128: "aes-gcm128",
+		drpc.ContEncryptAESGCM256: "aes-gcm256",
+	}
+	contRedunLevelNames = map[uint64]string{
+		drpc.ContRedunLevelRack: "rack",
+		drpc.ContRedunLevelNode: "node",
+	}
+	contStatusNames = map[uint64]string{
+		drpc.ContStatusHealthy: "healthy",
+		drpc.ContStatusUnclean: "unclean",
+	}
+)
+
+// resolveContPropNames converts a list of property names into a list of
+// property numbers. All known properties are returned if no names are given.
+func resolveContPropNames(names []string) ([]uint32, error) {
+	if len(names) == 0 {
+		numbers := make([]uint32, 0, len(contProperties))
+		for _, prop := range contProperties {
+			numbers = append(numbers, prop.number)
+		}
+		return numbers, nil
+	}
+
+	numbers := make([]uint32, 0, len(names))
+	seen := make(map[uint32]struct{})
+	for _, name := range names {
+		propName := strings.ToLower(strings.TrimSpace(name))
+		found := false
+		for _, prop := range contProperties {
+			if prop.name != propName {
+				continue
+			}
+			found = true
+			if _, dupe := seen[prop.number]; !dupe {
+				seen[prop.number] = struct{}{}
+				numbers = append(numbers, prop.number)
+			}
+			break
+		}
+		if !found {
+			return nil, errors.Errorf("unhandled container property %q", name)
+		}
+	}
+
+	return numbers, nil
+}
+
+func lookupContPropValue(names map[uint64]string, val uint64) string {
+	if name, found := names[val]; found {
+		return name
+	}
+	return fmt.Sprintf("unknown (%d)", val)
+}
+
+// resolveContPropString sets the name and a human-readable string value
+// for a property returned by the I/O Engine.
+func resolveContPropString(prop *mgmtpb.ContProperty) error {
+	for _, p := range contProperties {
+		if p.number == prop.GetNumber() {
+			prop.Name = p.name
+			break
+		}
+	}
+	if prop.Name == "" {
+		return errors.Errorf("unhandled container property number %d", prop.GetNumber())
+	}
+
+	switch prop.GetNumber() {
+	case drpc.ContPropertyLabel, drpc.ContPropertyOwner, drpc.ContPropertyOwnerGroup:
+	case drpc.ContPropertyLayoutType:
+		prop.Strval = lookupContPropValue(contLayoutNames, prop.GetNumval())
+	case drpc.ContPropertyChecksum:
+		prop.Strval = lookupContPropValue(contChecksumNames, prop.GetNumval())
+	case drpc.ContPropertyServerChecksum:
+		prop.Strval = "off"
+		if prop.GetNumval() != 0 {
+			prop.Strval = "on"
+		}
+	case drpc.ContPropertyRedundancyFactor:
+		prop.Strval = fmt.Sprintf("rf%d", prop.GetNumval())
+	case drpc.ContPropertyRedundancyLevel:
+		prop.Strval = lookupContPropValue(contRedunLevelNames, prop.GetNumval())
+	case drpc.ContPropertyCompression:
+		prop.Strval = lookupContPropValue(contCompressNames, prop.GetNumval())
+	case drpc.ContPropertyEncryption:
+		prop.Strval = lookupContPropValue(contEncryptNames, prop.GetNumval())
+	case drpc.ContPropertyDedup:
+		prop.Strval = lookupContPropValue(contDedupNames, prop.GetNumval())
+	case drpc.ContPropertyStatus:
+		// The upper 32 bits hold the status; the lower hold the pool map version.
+		prop.Strval = lookupContPropValue(contStatusNames, prop.GetNumval()>>32)
+	default:
+		prop.Strval = fmt.Sprintf("%d", prop.GetNumval())
+	}
+
+	return nil
+}
+
+// ContGetProp forwards a gRPC request to the DAOS I/O Engine to get container properties.
+func (svc *mgmtSvc) ContGetProp(ctx context.Context, req *mgmtpb.ContGetPropReq) (*mgmtpb.ContGetPropResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("MgmtSvc.ContGetProp dispatch, req:%+v\n", req)
+
+	numbers, err := resolveContPropNames(req.GetNames())
+	if err != nil {
+		return nil, err
+	}
+	newReq := &mgmtpb.ContGetPropReq{
+		ContUUID: req.GetContUUID(),
+		PoolUUID: req.GetPoolUUID(),
+		Numbers:  numbers,
+		SvcRanks: req.GetSvcRanks(),
+	}
+
+	dresp, err := svc.makeContServiceCall(ctx, drpc.MethodContGetProp, newReq)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.ContGetPropResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal ContGetProp response")
+	}
+
+	svc.log.Debugf("MgmtSvc.ContGetProp dispatch, resp:%+v\n", resp)
+
+	if resp.GetStatus() != 0 {
+		return resp, nil
+	}
+
+	for _, prop := range resp.GetProperties() {
+		if err := resolveContPropString(prop); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// resolveContPropVal resolves string-based property names and values to their
+// C equivalents. Only properties that may be changed after container creation
+// are accepted.
+func resolveContPropVal(in *mgmtpb.ContProperty) (*mgmtpb.ContProperty, error) {
+	propName := strings.ToLower(strings.TrimSpace(in.GetName()))
+	out := &mgmtpb.ContProperty{Name: propName}
+
+	switch propName {
+	case "label":
+		out.Number = drpc.ContPropertyLabel
+		out.Strval = in.GetStrval()
+	case "status":
+		out.Number = drpc.ContPropertyStatus
+
+		status := strings.ToLower(strings.TrimSpace(in.GetStrval()))
+		if status != contStatusNames[drpc.ContStatusHealthy] {
+			return nil, errors.Errorf("invalid status value %q (valid values: healthy)",
+				in.GetStrval())
+		}
+		out.Numval = uint64(drpc.ContStatusHealthy) << 32
+	default:
+		return nil, errors.Errorf("unhandled container property %q", in.GetName())
+	}
+
+	return out, nil
+}
+
+// ContSetProp forwards a gRPC request to the DAOS I/O Engine to set container properties.
+func (svc *mgmtSvc) ContSetProp(ctx context.Context, req *mgmtpb.ContSetPropReq) (*mgmtpb.ContSetPropResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("MgmtSvc.ContSetProp dispatch, req:%+v\n", req)
+
+	if len(req.GetProperties()) == 0 {
+		return nil, errors.New("no container properties to set")
+	}
+
+	newReq := &mgmtpb.ContSetPropReq{
+		ContUUID: req.GetContUUID(),
+		PoolUUID: req.GetPoolUUID(),
+		SvcRanks: req.GetSvcRanks(),
+	}
+	for _, prop := range req.GetProperties() {
+		newProp, err := resolveContPropVal(prop)
+		if err != nil {
+			return nil, err
+		}
+		newReq.Properties = append(newReq.Properties, newProp)
+	}
+
+	dresp, err := svc.makeContServiceCall(ctx, drpc.MethodContSetProp, newReq)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.ContSetPropResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal ContSetProp response")
+	}
+
+	svc.log.Debugf("MgmtSvc.ContSetProp dispatch, resp:%+v\n", resp)
+
+	return resp, nil
+}
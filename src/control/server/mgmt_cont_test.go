@@ -10,12 +10,14 @@ import (
 	"context"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
 	"github.com/mjmac/soad/src/control/build"
 	"github.com/mjmac/soad/src/control/common"
 	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
 	"github.com/mjmac/soad/src/control/events"
 	"github.com/mjmac/soad/src/control/logging"
 	"github.com/mjmac/soad/src/control/system"
@@ -36,7 +38,7 @@ func makeBadBytes(count int) (badBytes []byte) {
 func newTestListContReq() *mgmtpb.ListContReq {
 	return &mgmtpb.ListContReq{
 		Sys:  build.DefaultSystemName,
-		Uuid: "12345678-1234-1234-1234-123456789abc",
+		Uuid: mockUUID,
 	}
 }
 
@@ -45,6 +47,7 @@ func TestListCont_NoMS(t *testing.T) {
 	defer common.ShowBufferOnFailure(t, buf)
 
 	ms, db := system.MockMembership(t, log, mockTCPResolver)
+	addTestPools(t, db, mockUUID)
 	svc := newMgmtSvc(NewEngineHarness(log), ms, db, nil,
 		events.NewPubSub(context.Background(), log))
 
@@ -62,6 +65,7 @@ func TestListCont_DrpcFailed(t *testing.T) {
 	defer common.ShowBufferOnFailure(t, buf)
 
 	svc := newTestMgmtSvc(t, log)
+	addTestPools(t, svc.sysdb, mockUUID)
 	expectedErr := errors.New("mock error")
 	setupMockDrpcClient(svc, nil, expectedErr)
 
@@ -79,6 +83,7 @@ func TestPoolListCont_BadDrpcResp(t *testing.T) {
 	defer common.ShowBufferOnFailure(t, buf)
 
 	svc := newTestMgmtSvc(t, log)
+	addTestPools(t, svc.sysdb, mockUUID)
 	// dRPC call returns junk in the message body
 	badBytes := makeBadBytes(12)
 
@@ -98,6 +103,7 @@ func TestListCont_ZeroContSuccess(t *testing.T) {
 	defer common.ShowBufferOnFailure(t, buf)
 
 	svc := newTestMgmtSvc(t, log)
+	addTestPools(t, svc.sysdb, mockUUID)
 
 	expectedResp := &mgmtpb.ListContResp{}
 	setupMockDrpcClient(svc, expectedResp, nil)
@@ -119,6 +125,7 @@ func TestListCont_ManyContSuccess(t *testing.T) {
 	defer common.ShowBufferOnFailure(t, buf)
 
 	svc := newTestMgmtSvc(t, log)
+	addTestPools(t, svc.sysdb, mockUUID)
 
 	expectedResp := &mgmtpb.ListContResp{
 		Containers: []*mgmtpb.ListContResp_Cont{
@@ -146,7 +153,7 @@ func newTestContSetOwnerReq() *mgmtpb.ContSetOwnerReq {
 	return &mgmtpb.ContSetOwnerReq{
 		Sys:        build.DefaultSystemName,
 		ContUUID:   "contUUID",
-		PoolUUID:   "poolUUID",
+		PoolUUID:   mockUUID,
 		Owneruser:  "user@",
 		Ownergroup: "group@",
 	}
@@ -157,6 +164,7 @@ func TestContSetOwner_NoMS(t *testing.T) {
 	defer common.ShowBufferOnFailure(t, buf)
 
 	ms, db := system.MockMembership(t, log, mockTCPResolver)
+	addTestPools(t, db, mockUUID)
 	svc := newMgmtSvc(NewEngineHarness(log), ms, db, nil,
 		events.NewPubSub(context.Background(), log))
 
@@ -174,6 +182,7 @@ func TestContSetOwner_DrpcFailed(t *testing.T) {
 	defer common.ShowBufferOnFailure(t, buf)
 
 	svc := newTestMgmtSvc(t, log)
+	addTestPools(t, svc.sysdb, mockUUID)
 	expectedErr := errors.New("mock error")
 	setupMockDrpcClient(svc, nil, expectedErr)
 
@@ -191,6 +200,7 @@ func TestContSetOwner_BadDrpcResp(t *testing.T) {
 	defer common.ShowBufferOnFailure(t, buf)
 
 	svc := newTestMgmtSvc(t, log)
+	addTestPools(t, svc.sysdb, mockUUID)
 	// dRPC call returns junk in the message body
 	badBytes := makeBadBytes(16)
 
@@ -210,6 +220,7 @@ func TestContSetOwner_Success(t *testing.T) {
 	defer common.ShowBufferOnFailure(t, buf)
 
 	svc := newTestMgmtSvc(t, log)
+	addTestPools(t, svc.sysdb, mockUUID)
 
 	expectedResp := &mgmtpb.ContSetOwnerResp{
 		Status: 0,
@@ -227,3 +238,312 @@ func TestContSetOwner_Success(t *testing.T) {
 		t.Fatalf("bad response (-want, +got): \n%s\n", diff)
 	}
 }
+
+func TestServer_MgmtSvc_ContQuery(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req      *mgmtpb.ContQueryReq
+		drpcResp *mgmtpb.ContQueryResp
+		drpcErr  error
+		expResp  *mgmtpb.ContQueryResp
+		expErr   error
+	}{
+		"wrong system": {
+			req:    &mgmtpb.ContQueryReq{Sys: "bad", PoolUUID: mockUUID},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"unknown pool": {
+			req:    &mgmtpb.ContQueryReq{PoolUUID: common.MockUUID(1)},
+			expErr: errors.New("unable to find pool service"),
+		},
+		"dRPC failure": {
+			req:     &mgmtpb.ContQueryReq{PoolUUID: mockUUID},
+			drpcErr: errors.New("mock error"),
+			expErr:  errors.New("mock error"),
+		},
+		"success": {
+			req: &mgmtpb.ContQueryReq{PoolUUID: mockUUID, ContUUID: common.MockUUID(2)},
+			drpcResp: &mgmtpb.ContQueryResp{
+				Label:      "mycont",
+				Owneruser:  "alice@",
+				Ownergroup: "admins@",
+				NumHandles: 2,
+				NumObjects: 42,
+			},
+			expResp: &mgmtpb.ContQueryResp{
+				Label:      "mycont",
+				Owneruser:  "alice@",
+				Ownergroup: "admins@",
+				NumHandles: 2,
+				NumObjects: 42,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPools(t, svc.sysdb, mockUUID)
+			setupMockDrpcClient(svc, tc.drpcResp, tc.drpcErr)
+
+			if tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+			gotResp, gotErr := svc.ContQuery(context.TODO(), tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_ContDestroy(t *testing.T) {
+	lastCall := func(svc *mgmtSvc) *drpc.Call {
+		return svc.harness.instances[0]._drpcClient.(*mockDrpcClient).SendMsgInputCall
+	}
+
+	for name, tc := range map[string]struct {
+		req      *mgmtpb.ContDestroyReq
+		drpcResp *mgmtpb.ContDestroyResp
+		expResp  *mgmtpb.ContDestroyResp
+		expErr   error
+	}{
+		"wrong system": {
+			req:    &mgmtpb.ContDestroyReq{Sys: "bad", PoolUUID: mockUUID},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"busy": {
+			req:      &mgmtpb.ContDestroyReq{PoolUUID: mockUUID, ContUUID: common.MockUUID(2)},
+			drpcResp: &mgmtpb.ContDestroyResp{Status: int32(drpc.DaosBusy)},
+			expResp:  &mgmtpb.ContDestroyResp{Status: int32(drpc.DaosBusy)},
+		},
+		"forced": {
+			req:      &mgmtpb.ContDestroyReq{PoolUUID: mockUUID, ContUUID: common.MockUUID(2), Force: true},
+			drpcResp: &mgmtpb.ContDestroyResp{},
+			expResp:  &mgmtpb.ContDestroyResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPools(t, svc.sysdb, mockUUID)
+			setupMockDrpcClient(svc, tc.drpcResp, nil)
+
+			if tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+			gotResp, gotErr := svc.ContDestroy(context.TODO(), tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+
+			gotReq := new(mgmtpb.ContDestroyReq)
+			if err := proto.Unmarshal(lastCall(svc).Body, gotReq); err != nil {
+				t.Fatal(err)
+			}
+			if gotReq.Force != tc.req.Force {
+				t.Fatalf("expected force %t, got %t", tc.req.Force, gotReq.Force)
+			}
+			if len(gotReq.SvcRanks) == 0 {
+				t.Fatal("expected pool service ranks to be set")
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_ContGetProp(t *testing.T) {
+	lastCall := func(svc *mgmtSvc) *drpc.Call {
+		return svc.harness.instances[0]._drpcClient.(*mockDrpcClient).SendMsgInputCall
+	}
+
+	allNumbers := make([]uint32, 0, len(contProperties))
+	for _, prop := range contProperties {
+		allNumbers = append(allNumbers, prop.number)
+	}
+
+	for name, tc := range map[string]struct {
+		req        *mgmtpb.ContGetPropReq
+		expNumbers []uint32
+		drpcResp   *mgmtpb.ContGetPropResp
+		expResp    *mgmtpb.ContGetPropResp
+		expErr     error
+	}{
+		"unhandled property": {
+			req:    &mgmtpb.ContGetPropReq{Names: []string{"label", "acl"}},
+			expErr: errors.New("unhandled container property \"acl\""),
+		},
+		"unhandled response property": {
+			req: &mgmtpb.ContGetPropReq{Names: []string{"label"}},
+			drpcResp: &mgmtpb.ContGetPropResp{
+				Properties: []*mgmtpb.ContProperty{
+					{Number: 4242},
+				},
+			},
+			expErr: errors.New("unhandled container property number 4242"),
+		},
+		"engine failure": {
+			req:        &mgmtpb.ContGetPropReq{Names: []string{"label"}},
+			expNumbers: []uint32{drpc.ContPropertyLabel},
+			drpcResp:   &mgmtpb.ContGetPropResp{Status: int32(drpc.DaosNonexistant)},
+			expResp:    &mgmtpb.ContGetPropResp{Status: int32(drpc.DaosNonexistant)},
+		},
+		"selected properties": {
+			req:        &mgmtpb.ContGetPropReq{Names: []string{" Cksum", "rf", "cksum", "status", "dedup_th"}},
+			expNumbers: []uint32{drpc.ContPropertyChecksum, drpc.ContPropertyRedundancyFactor, drpc.ContPropertyStatus, drpc.ContPropertyDedupThreshold},
+			drpcResp: &mgmtpb.ContGetPropResp{
+				Properties: []*mgmtpb.ContProperty{
+					{Number: drpc.ContPropertyChecksum, Numval: drpc.ContChecksumCRC64},
+					{Number: drpc.ContPropertyRedundancyFactor, Numval: 2},
+					{Number: drpc.ContPropertyStatus, Numval: uint64(drpc.ContStatusUnclean)<<32 | 7},
+					{Number: drpc.ContPropertyDedupThreshold, Numval: 4096},
+				},
+			},
+			expResp: &mgmtpb.ContGetPropResp{
+				Properties: []*mgmtpb.ContProperty{
+					{Name: "cksum", Number: drpc.ContPropertyChecksum, Numval: drpc.ContChecksumCRC64, Strval: "crc64"},
+					{Name: "rf", Number: drpc.ContPropertyRedundancyFactor, Numval: 2, Strval: "rf2"},
+					{Name: "status", Number: drpc.ContPropertyStatus, Numval: uint64(drpc.ContStatusUnclean)<<32 | 7, Strval: "unclean"},
+					{Name: "dedup_th", Number: drpc.ContPropertyDedupThreshold, Numval: 4096, Strval: "4096"},
+				},
+			},
+		},
+		"all properties": {
+			req:        &mgmtpb.ContGetPropReq{},
+			expNumbers: allNumbers,
+			drpcResp: &mgmtpb.ContGetPropResp{
+				Properties: []*mgmtpb.ContProperty{
+					{Number: drpc.ContPropertyLabel, Strval: "mycont"},
+					{Number: drpc.ContPropertyLayoutType, Numval: drpc.ContLayoutPOSIX},
+					{Number: drpc.ContPropertyEncryption, Numval: 4242},
+				},
+			},
+			expResp: &mgmtpb.ContGetPropResp{
+				Properties: []*mgmtpb.ContProperty{
+					{Name: "label", Number: drpc.ContPropertyLabel, Strval: "mycont"},
+					{Name: "layout_type", Number: drpc.ContPropertyLayoutType, Numval: drpc.ContLayoutPOSIX, Strval: "posix"},
+					{Name: "encryption", Number: drpc.ContPropertyEncryption, Numval: 4242, Strval: "unknown (4242)"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPools(t, svc.sysdb, mockUUID)
+			setupMockDrpcClient(svc, tc.drpcResp, nil)
+
+			tc.req.Sys = build.DefaultSystemName
+			tc.req.PoolUUID = mockUUID
+			gotResp, gotErr := svc.ContGetProp(context.TODO(), tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+
+			gotReq := new(mgmtpb.ContGetPropReq)
+			if err := proto.Unmarshal(lastCall(svc).Body, gotReq); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expNumbers, gotReq.Numbers); diff != "" {
+				t.Fatalf("unexpected dRPC call (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_ContSetProp(t *testing.T) {
+	lastCall := func(svc *mgmtSvc) *drpc.Call {
+		return svc.harness.instances[0]._drpcClient.(*mockDrpcClient).SendMsgInputCall
+	}
+
+	for name, tc := range map[string]struct {
+		props    []*mgmtpb.ContProperty
+		drpcResp *mgmtpb.ContSetPropResp
+		expProps []*mgmtpb.ContProperty
+		expResp  *mgmtpb.ContSetPropResp
+		expErr   error
+	}{
+		"no properties": {
+			expErr: errors.New("no container properties"),
+		},
+		"read-only property": {
+			props:  []*mgmtpb.ContProperty{{Name: "cksum", Strval: "crc32"}},
+			expErr: errors.New("unhandled container property \"cksum\""),
+		},
+		"bad status": {
+			props:  []*mgmtpb.ContProperty{{Name: "status", Strval: "unclean"}},
+			expErr: errors.New("invalid status value"),
+		},
+		"engine failure": {
+			props:    []*mgmtpb.ContProperty{{Name: "label", Strval: "newlabel"}},
+			drpcResp: &mgmtpb.ContSetPropResp{Status: int32(drpc.DaosNoPermission)},
+			expProps: []*mgmtpb.ContProperty{
+				{Name: "label", Number: drpc.ContPropertyLabel, Strval: "newlabel"},
+			},
+			expResp: &mgmtpb.ContSetPropResp{Status: int32(drpc.DaosNoPermission)},
+		},
+		"label and status": {
+			props: []*mgmtpb.ContProperty{
+				{Name: "Label", Strval: "newlabel"},
+				{Name: "status", Strval: " Healthy"},
+			},
+			drpcResp: &mgmtpb.ContSetPropResp{},
+			expProps: []*mgmtpb.ContProperty{
+				{Name: "label", Number: drpc.ContPropertyLabel, Strval: "newlabel"},
+				{Name: "status", Number: drpc.ContPropertyStatus, Numval: uint64(drpc.ContStatusHealthy) << 32},
+			},
+			expResp: &mgmtpb.ContSetPropResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPools(t, svc.sysdb, mockUUID)
+			setupMockDrpcClient(svc, tc.drpcResp, nil)
+
+			req := &mgmtpb.ContSetPropReq{
+				Sys:        build.DefaultSystemName,
+				PoolUUID:   mockUUID,
+				ContUUID:   common.MockUUID(2),
+				Properties: tc.props,
+			}
+			gotResp, gotErr := svc.ContSetProp(context.TODO(), req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+
+			gotReq := new(mgmtpb.ContSetPropReq)
+			if err := proto.Unmarshal(lastCall(svc).Body, gotReq); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expProps, gotReq.Properties, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected dRPC call (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
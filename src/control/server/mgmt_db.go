@@ -0,0 +1,60 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bytes"
+
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+// dbBackupChunkSize is the maximum size of the backup data sent in each
+// stream message, chosen to stay well under the default gRPC message size
+// limit.
+var dbBackupChunkSize = 64 * 1024
+
+// SystemDbBackup implements the method defined for the Management Service.
+//
+// Create a consistent backup of the system database on the MS leader and
+// stream it to the client in chunks.
+func (svc *mgmtSvc) SystemDbBackup(req *mgmtpb.SystemDbBackupReq, stream mgmtpb.MgmtSvc_SystemDbBackupServer) error {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return err
+	}
+	svc.log.Debug("Received SystemDbBackup RPC")
+
+	data, err := svc.sysdb.Backup()
+	if err != nil {
+		return err
+	}
+	// Decode the backup in order to verify it and to report the map
+	// version that it actually contains.
+	backup, err := system.DecodeBackup(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	mapVersion := backup.MapVersion
+
+	for start := 0; start < len(data); start += dbBackupChunkSize {
+		end := start + dbBackupChunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		if err := stream.Send(&mgmtpb.SystemDbBackupResp{
+			Data:       data[start:end],
+			MapVersion: mapVersion,
+			Size:       uint64(len(data)),
+		}); err != nil {
+			return err
+		}
+	}
+
+	svc.log.Debugf("SystemDbBackup sent %d bytes (map version %d)", len(data), mapVersion)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+type mockSystemDbBackupServer struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sent    []*mgmtpb.SystemDbBackupResp
+	sendErr error
+}
+
+func (m *mockSystemDbBackupServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockSystemDbBackupServer) Send(resp *mgmtpb.SystemDbBackupResp) error {
+	m.sent = append(m.sent, resp)
+	return m.sendErr
+}
+
+func TestServer_MgmtSvc_SystemDbBackup(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req        *mgmtpb.SystemDbBackupReq
+		notReplica bool
+		chunkSize  int
+		sendErr    error
+		expChunks  int
+		expErr     error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.SystemDbBackupReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"not replica": {
+			req:        &mgmtpb.SystemDbBackupReq{},
+			notReplica: true,
+			expErr:     errors.New("replica"),
+		},
+		"send fails": {
+			req:     &mgmtpb.SystemDbBackupReq{},
+			sendErr: errors.New("send failed"),
+			expErr:  errors.New("send failed"),
+		},
+		"single chunk": {
+			req:       &mgmtpb.SystemDbBackupReq{},
+			expChunks: 1,
+		},
+		"multiple chunks": {
+			req:       &mgmtpb.SystemDbBackupReq{},
+			chunkSize: 64,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			if tc.chunkSize != 0 {
+				defer func(size int) {
+					dbBackupChunkSize = size
+				}(dbBackupChunkSize)
+				dbBackupChunkSize = tc.chunkSize
+			}
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.notReplica {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			} else {
+				for _, rank := range []uint32{0, 1} {
+					m := system.MockMember(t, rank, system.MemberStateJoined)
+					if _, err := svc.membership.Add(m); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			stream := &mockSystemDbBackupServer{
+				ctx:     context.Background(),
+				sendErr: tc.sendErr,
+			}
+			gotErr := svc.SystemDbBackup(tc.req, stream)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if tc.expChunks != 0 {
+				common.AssertEqual(t, tc.expChunks, len(stream.sent), "unexpected chunk count")
+			} else if len(stream.sent) < 2 {
+				t.Fatalf("expected multiple chunks, got %d", len(stream.sent))
+			}
+
+			var data bytes.Buffer
+			for _, resp := range stream.sent {
+				if tc.chunkSize != 0 && len(resp.Data) > tc.chunkSize {
+					t.Fatalf("chunk size %d > %d", len(resp.Data), tc.chunkSize)
+				}
+				common.AssertEqual(t, stream.sent[0].Size, resp.Size, "inconsistent backup size")
+				data.Write(resp.Data)
+			}
+			common.AssertEqual(t, stream.sent[0].Size, uint64(data.Len()), "unexpected backup size")
+
+			backup, err := system.DecodeBackup(&data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			common.AssertEqual(t, backup.MapVersion, stream.sent[0].MapVersion, "unexpected map version")
+			common.AssertEqual(t, uint32(2), backup.MapVersion, "unexpected backup map version")
+		})
+	}
+}
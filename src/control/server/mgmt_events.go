@@ -0,0 +1,206 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/events"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+const (
+	// eventStreamQueueSize is the number of events that may be queued
+	// for delivery to an event stream subscriber. Once the queue is
+	// full, further events are dropped for that subscriber until it
+	// catches up.
+	eventStreamQueueSize = 256
+)
+
+type (
+	// eventSubscriber holds the state of a single live event stream.
+	eventSubscriber struct {
+		filter  *system.EventFilter
+		queue   chan *system.EventLogEntry
+		dropped uint64
+	}
+
+	// eventStreamer implements the events.Handler interface and fans out
+	// events received by the MS leader to live event stream subscribers.
+	//
+	// Events are queued for each subscriber without blocking, so a slow
+	// subscriber may miss events but can never stall the event loop.
+	eventStreamer struct {
+		sync.RWMutex
+		log         logging.Logger
+		active      bool
+		subscribers map[*eventSubscriber]struct{}
+	}
+)
+
+func newEventStreamer(log logging.Logger) *eventStreamer {
+	return &eventStreamer{
+		log:         log,
+		subscribers: make(map[*eventSubscriber]struct{}),
+	}
+}
+
+// takeDropped returns the number of events dropped for the subscriber
+// since the last call and resets the count.
+func (sub *eventSubscriber) takeDropped() uint64 {
+	return atomic.SwapUint64(&sub.dropped, 0)
+}
+
+// start allows new subscriptions to be created.
+func (es *eventStreamer) start() {
+	es.Lock()
+	defer es.Unlock()
+
+	es.active = true
+}
+
+// stop closes all existing subscriptions and prevents new ones from
+// being created.
+func (es *eventStreamer) stop() {
+	es.Lock()
+	defer es.Unlock()
+
+	es.active = false
+	for sub := range es.subscribers {
+		close(sub.queue)
+		delete(es.subscribers, sub)
+	}
+}
+
+// subscribe creates a new subscription for events matching the supplied
+// filter.
+func (es *eventStreamer) subscribe(filter *system.EventFilter) (*eventSubscriber, error) {
+	es.Lock()
+	defer es.Unlock()
+
+	if !es.active {
+		return nil, errors.New("event streaming is not active")
+	}
+
+	sub := &eventSubscriber{
+		filter: filter,
+		queue:  make(chan *system.EventLogEntry, eventStreamQueueSize),
+	}
+	es.subscribers[sub] = struct{}{}
+
+	return sub, nil
+}
+
+// unsubscribe removes the subscription, if it has not already been
+// closed.
+func (es *eventStreamer) unsubscribe(sub *eventSubscriber) {
+	es.Lock()
+	defer es.Unlock()
+
+	if _, found := es.subscribers[sub]; !found {
+		return
+	}
+	close(sub.queue)
+	delete(es.subscribers, sub)
+}
+
+// OnEvent implements the events.Handler interface.
+func (es *eventStreamer) OnEvent(_ context.Context, evt *events.RASEvent) {
+	ele := &system.EventLogEntry{
+		Time:  time.Now(),
+		Event: evt,
+	}
+
+	es.RLock()
+	defer es.RUnlock()
+
+	for sub := range es.subscribers {
+		if !sub.filter.Matches(ele) {
+			continue
+		}
+
+		select {
+		case sub.queue <- ele:
+		default:
+			if atomic.AddUint64(&sub.dropped, 1) == 1 {
+				es.log.Debugf("event stream subscriber queue full; dropping %s event",
+					evt.ID)
+			}
+		}
+	}
+}
+
+// SubscribeEvents implements the method defined for the Management Service.
+//
+// Stream RAS events received by the MS leader that match the criteria
+// supplied in the request until the client cancels the stream or this
+// server is no longer the leader.
+func (svc *mgmtSvc) SubscribeEvents(req *mgmtpb.SubscribeEventsReq, stream mgmtpb.MgmtSvc_SubscribeEventsServer) error {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return err
+	}
+	svc.log.Debug("Received SubscribeEvents RPC")
+
+	filter, err := eventFilterFromReq(req)
+	if err != nil {
+		return err
+	}
+
+	sub, err := svc.eventStreams.subscribe(filter)
+	if err != nil {
+		if lErr := svc.sysdb.CheckLeader(); lErr != nil {
+			return lErr
+		}
+		return err
+	}
+	defer svc.eventStreams.unsubscribe(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			svc.log.Debug("SubscribeEvents stream closed by client")
+			return nil
+		case ele, open := <-sub.queue:
+			if !open {
+				// The subscription is closed when this
+				// server loses MS leadership.
+				if err := svc.sysdb.CheckLeader(); err != nil {
+					return err
+				}
+				return errors.New("event stream closed")
+			}
+
+			pbEvt, err := ele.Event.ToProto()
+			if err != nil {
+				svc.log.Errorf("failed to convert %s event for streaming: %s",
+					ele.Event.ID, err)
+				continue
+			}
+
+			mapVersion, err := svc.sysdb.CurMapVersion()
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(&mgmtpb.SubscribeEventsResp{
+				Time:       ele.Time.UnixNano(),
+				Event:      pbEvt,
+				Dropped:    sub.takeDropped(),
+				MapVersion: mapVersion,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,230 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/events"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+type mockSubscribeEventsServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *mgmtpb.SubscribeEventsResp
+}
+
+func (m *mockSubscribeEventsServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockSubscribeEventsServer) Send(resp *mgmtpb.SubscribeEventsResp) error {
+	m.sent <- resp
+	return nil
+}
+
+func TestServer_eventStreamer(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	es := newEventStreamer(log)
+
+	if _, err := es.subscribe(nil); err == nil {
+		t.Fatal("expected subscribe to fail before start")
+	}
+	es.start()
+
+	rankSub, err := es.subscribe(&system.EventFilter{Ranks: []system.Rank{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	allSub, err := es.subscribe(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rankDown := events.NewRankDownEvent("foo", 0, 1, common.ExitStatus("test"))
+	otherDown := events.NewRankDownEvent("foo", 1, 2, common.ExitStatus("test"))
+
+	es.OnEvent(context.TODO(), rankDown)
+	es.OnEvent(context.TODO(), otherDown)
+
+	common.AssertEqual(t, 1, len(rankSub.queue), "unexpected filtered queue length")
+	common.AssertEqual(t, 2, len(allSub.queue), "unexpected unfiltered queue length")
+
+	// Fill the queue in order to verify that excess events are dropped
+	// rather than blocking the caller.
+	for i := 0; i < eventStreamQueueSize; i++ {
+		es.OnEvent(context.TODO(), rankDown)
+	}
+	common.AssertEqual(t, eventStreamQueueSize, len(rankSub.queue), "unexpected queue length")
+	common.AssertEqual(t, uint64(1), rankSub.takeDropped(), "unexpected dropped count")
+	common.AssertEqual(t, uint64(0), rankSub.takeDropped(), "dropped count not reset")
+
+	es.unsubscribe(allSub)
+	es.unsubscribe(allSub) // should be idempotent
+	common.AssertEqual(t, 1, len(es.subscribers), "unexpected number of subscribers")
+
+	es.stop()
+	common.AssertEqual(t, 0, len(es.subscribers), "unexpected number of subscribers")
+	for range rankSub.queue {
+	}
+	if _, err := es.subscribe(nil); err == nil {
+		t.Fatal("expected subscribe to fail after stop")
+	}
+}
+
+func TestServer_MgmtSvc_SubscribeEvents(t *testing.T) {
+	rankDown := events.NewRankDownEvent("foo", 0, 1, common.ExitStatus("test"))
+	sysStop := events.New(&events.RASEvent{
+		ID:       events.RASSystemStop,
+		Type:     events.RASTypeInfoOnly,
+		Severity: events.RASSeverityInfo,
+		Hostname: "bar",
+		Rank:     uint32(system.NilRank),
+	})
+
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		inactive   bool
+		req        *mgmtpb.SubscribeEventsReq
+		expEvents  []*events.RASEvent
+		expErr     error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SubscribeEventsReq{},
+			expErr:     errors.New("replica"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.SubscribeEventsReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"bad hostset": {
+			req:    &mgmtpb.SubscribeEventsReq{Hosts: "foo["},
+			expErr: errors.New("invalid range"),
+		},
+		"streaming inactive": {
+			inactive: true,
+			req:      &mgmtpb.SubscribeEventsReq{},
+			expErr:   errors.New("not active"),
+		},
+		"unfiltered": {
+			req:       &mgmtpb.SubscribeEventsReq{},
+			expEvents: []*events.RASEvent{rankDown, sysStop},
+		},
+		"filtered by type": {
+			req: &mgmtpb.SubscribeEventsReq{
+				Types: []uint32{events.RASTypeInfoOnly.Uint32()},
+			},
+			expEvents: []*events.RASEvent{sysStop},
+		},
+		"filtered by severity and id": {
+			req: &mgmtpb.SubscribeEventsReq{
+				Severities: []uint32{events.RASSeverityError.Uint32()},
+				Ids:        []uint32{events.RASRankDown.Uint32()},
+			},
+			expEvents: []*events.RASEvent{rankDown},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonReplica {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			}
+			if !tc.inactive {
+				svc.eventStreams.start()
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			stream := &mockSubscribeEventsServer{
+				ctx:  ctx,
+				sent: make(chan *mgmtpb.SubscribeEventsResp, eventStreamQueueSize),
+			}
+
+			errCh := make(chan error)
+			go func() {
+				errCh <- svc.SubscribeEvents(tc.req, stream)
+			}()
+
+			if tc.expErr != nil {
+				common.CmpErr(t, tc.expErr, <-errCh)
+				return
+			}
+
+			// Wait for the subscription to be registered before
+			// publishing events.
+			for {
+				svc.eventStreams.RLock()
+				numSubs := len(svc.eventStreams.subscribers)
+				svc.eventStreams.RUnlock()
+				if numSubs > 0 {
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
+			svc.eventStreams.OnEvent(ctx, rankDown)
+			svc.eventStreams.OnEvent(ctx, sysStop)
+
+			expMapVersion, err := svc.sysdb.CurMapVersion()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var gotEvents []*events.RASEvent
+			for range tc.expEvents {
+				select {
+				case <-time.After(5 * time.Second):
+					t.Fatal("timed out waiting for event")
+				case resp := <-stream.sent:
+					common.AssertEqual(t, expMapVersion, resp.MapVersion,
+						"unexpected map version")
+					evt, err := events.NewFromProto(resp.Event)
+					if err != nil {
+						t.Fatal(err)
+					}
+					gotEvents = append(gotEvents, evt)
+				}
+			}
+
+			// Losing leadership should terminate the stream.
+			svc.eventStreams.stop()
+			common.CmpErr(t, errors.New("event stream closed"), <-errCh)
+
+			cmpOpts := []cmp.Option{
+				cmp.Comparer(func(x, y *events.RASEvent) bool {
+					return x.ID == y.ID && x.Hostname == y.Hostname && x.Rank == y.Rank
+				}),
+			}
+			if diff := cmp.Diff(tc.expEvents, gotEvents, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected events (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
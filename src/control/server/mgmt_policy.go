@@ -0,0 +1,248 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/events"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+type (
+	// autoExcludeFn is called when the grace period for a dead rank
+	// has expired without the rank having recovered.
+	autoExcludeFn func(context.Context, system.Rank)
+
+	// autoExcluder implements the events.Handler interface and
+	// schedules the exclusion of dead ranks from the pools that
+	// have storage on them.
+	//
+	// Exclusion is deferred for a configurable grace period in order
+	// to give a rank that has been restarted a chance to rejoin the
+	// system before its pools are rebuilt without it.
+	autoExcluder struct {
+		sync.Mutex
+		log     logging.Logger
+		sysdb   *system.Database
+		cfg     config.AutoExcludeConfig
+		ctx     context.Context
+		pending map[system.Rank]*time.Timer
+		exclude autoExcludeFn
+	}
+)
+
+func newAutoExcluder(log logging.Logger, sysdb *system.Database, exclude autoExcludeFn) *autoExcluder {
+	return &autoExcluder{
+		log:     log,
+		sysdb:   sysdb,
+		pending: make(map[system.Rank]*time.Timer),
+		exclude: exclude,
+	}
+}
+
+// configure sets the policy configuration.
+func (ae *autoExcluder) configure(cfg config.AutoExcludeConfig) {
+	ae.Lock()
+	defer ae.Unlock()
+
+	ae.cfg = cfg
+}
+
+// start enables scheduling of exclusions until the supplied context
+// is canceled, at which point any pending exclusions are abandoned.
+func (ae *autoExcluder) start(ctx context.Context) {
+	ae.Lock()
+	ae.ctx = ctx
+	ae.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		ae.Lock()
+		defer ae.Unlock()
+		if ae.ctx != ctx {
+			// Restarted with a new context.
+			return
+		}
+		ae.ctx = nil
+		ae.cancelPending()
+	}()
+}
+
+// cancelPending cancels any pending exclusions. Must be called with
+// the lock held.
+func (ae *autoExcluder) cancelPending() {
+	for rank, timer := range ae.pending {
+		timer.Stop()
+		delete(ae.pending, rank)
+		ae.log.Debugf("canceled pending auto-exclusion of rank %d", rank)
+	}
+}
+
+// pause cancels any pending exclusions.
+func (ae *autoExcluder) pause() {
+	ae.Lock()
+	defer ae.Unlock()
+
+	ae.cancelPending()
+}
+
+// pendingRanks returns the set of ranks awaiting exclusion.
+func (ae *autoExcluder) pendingRanks() *system.RankSet {
+	ae.Lock()
+	defer ae.Unlock()
+
+	ranks := make([]system.Rank, 0, len(ae.pending))
+	for rank := range ae.pending {
+		ranks = append(ranks, rank)
+	}
+	return system.RankSetFromRanks(ranks)
+}
+
+func (ae *autoExcluder) isPaused() bool {
+	ps, err := ae.sysdb.PolicyState()
+	if err != nil {
+		ae.log.Errorf("failed to retrieve policy state: %s", err)
+		return true
+	}
+	return ps.AutoExcludePaused
+}
+
+// schedule arranges for the rank to be excluded once the grace period
+// has expired, unless an exclusion is already pending for the rank.
+func (ae *autoExcluder) schedule(rank system.Rank) {
+	ae.Lock()
+	defer ae.Unlock()
+
+	if ae.ctx == nil || !ae.cfg.Enabled {
+		return
+	}
+	if _, found := ae.pending[rank]; found {
+		return
+	}
+
+	ae.log.Infof("rank %d will be excluded from its pools in %s unless it recovers",
+		rank, ae.cfg.GracePeriod)
+
+	var timer *time.Timer
+	timer = time.AfterFunc(ae.cfg.GracePeriod, func() {
+		ae.Lock()
+		if ae.pending[rank] != timer {
+			ae.Unlock()
+			return
+		}
+		delete(ae.pending, rank)
+		ctx := ae.ctx
+		ae.Unlock()
+
+		ae.exclude(ctx, rank)
+	})
+	ae.pending[rank] = timer
+}
+
+// OnEvent implements the events.Handler interface.
+func (ae *autoExcluder) OnEvent(_ context.Context, evt *events.RASEvent) {
+	switch evt.ID {
+	case events.RASRankDown, events.RASSwimRankDead:
+		if system.Rank(evt.Rank) == system.NilRank || ae.isPaused() {
+			return
+		}
+		ae.schedule(system.Rank(evt.Rank))
+	}
+}
+
+// autoExcludeRank excludes the rank from all ready pools that have storage
+// or service replicas on it, unless the policy has been paused or the rank
+// has since recovered. A RAS event is published to record the outcome for
+// each pool.
+func (svc *mgmtSvc) autoExcludeRank(ctx context.Context, rank system.Rank) {
+	if svc.autoExclude.isPaused() {
+		svc.log.Infof("auto-exclusion paused; not excluding rank %d", rank)
+		return
+	}
+
+	member, err := svc.membership.Get(rank)
+	if err != nil {
+		svc.log.Errorf("auto-exclusion of rank %d: %s", rank, err)
+		return
+	}
+	if member.State()&system.AvailableMemberFilter != 0 {
+		svc.log.Infof("rank %d recovered (%s); not excluding", rank, member.State())
+		return
+	}
+
+	pools, err := svc.sysdb.FindPoolServicesByRank(rank)
+	if err != nil {
+		svc.log.Errorf("auto-exclusion of rank %d: %s", rank, err)
+		return
+	}
+
+	for _, ps := range pools {
+		if ps.State != system.PoolServiceStateReady {
+			continue
+		}
+
+		resp, err := svc.PoolExclude(ctx, &mgmtpb.PoolExcludeReq{
+			Sys:  svc.sysdb.SystemName(),
+			Uuid: ps.PoolUUID.String(),
+			Rank: uint32(rank),
+		})
+		if err == nil && resp.GetStatus() != 0 {
+			err = drpc.DaosStatus(resp.GetStatus())
+		}
+		if err != nil {
+			svc.log.Errorf("failed to exclude rank %d from pool %s: %s", rank, ps.PoolUUID, err)
+		} else {
+			svc.log.Infof("excluded rank %d from pool %s", rank, ps.PoolUUID)
+		}
+
+		svc.events.Publish(events.NewPoolAutoExcludeEvent(hostname(), uint32(rank),
+			ps.PoolUUID.String(), err))
+	}
+}
+
+// SystemSetPolicy implements the method defined for the Management Service.
+//
+// Update the runtime state of system management policies and return
+// the resulting state.
+func (svc *mgmtSvc) SystemSetPolicy(ctx context.Context, req *mgmtpb.SystemSetPolicyReq) (*mgmtpb.SystemSetPolicyResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("Received SystemSetPolicy RPC: %+v", req)
+
+	if err := svc.sysdb.SetAutoExcludePaused(req.GetAutoExcludePaused()); err != nil {
+		return nil, errors.Wrap(err, "failed to update auto-exclude policy state")
+	}
+	if req.GetAutoExcludePaused() {
+		svc.autoExclude.pause()
+	}
+
+	svc.autoExclude.Lock()
+	cfg := svc.autoExclude.cfg
+	svc.autoExclude.Unlock()
+
+	resp := &mgmtpb.SystemSetPolicyResp{
+		AutoExcludeEnabled:     cfg.Enabled,
+		AutoExcludePaused:      req.GetAutoExcludePaused(),
+		AutoExcludeGracePeriod: uint64(cfg.GracePeriod.Seconds()),
+		AutoExcludePending:     svc.autoExclude.pendingRanks().String(),
+	}
+
+	svc.log.Debugf("Responding to SystemSetPolicy RPC: %+v", resp)
+
+	return resp, nil
+}
@@ -0,0 +1,345 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/events"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+func TestServer_autoExcluder(t *testing.T) {
+	rankDown := events.NewRankDownEvent("foo", 0, 1, common.ExitStatus("test"))
+	swimDead := events.New(&events.RASEvent{
+		ID:       events.RASSwimRankDead,
+		Type:     events.RASTypeStateChange,
+		Severity: events.RASSeverityError,
+		Hostname: "foo",
+		Rank:     2,
+	})
+	otherEvt := events.NewPoolSvcReplicasUpdateEvent("foo", 3, common.MockUUID(), []uint32{0, 3}, 1)
+
+	for name, tc := range map[string]struct {
+		cfg        config.AutoExcludeConfig
+		paused     bool
+		notStarted bool
+		events     []*events.RASEvent
+		expRanks   []system.Rank
+	}{
+		"disabled": {
+			events: []*events.RASEvent{rankDown},
+		},
+		"paused": {
+			cfg:    config.AutoExcludeConfig{Enabled: true},
+			paused: true,
+			events: []*events.RASEvent{rankDown},
+		},
+		"not started": {
+			cfg:        config.AutoExcludeConfig{Enabled: true},
+			notStarted: true,
+			events:     []*events.RASEvent{rankDown},
+		},
+		"irrelevant event": {
+			cfg:    config.AutoExcludeConfig{Enabled: true},
+			events: []*events.RASEvent{otherEvt},
+		},
+		"rank down and swim dead": {
+			cfg:      config.AutoExcludeConfig{Enabled: true},
+			events:   []*events.RASEvent{rankDown, swimDead},
+			expRanks: []system.Rank{1, 2},
+		},
+		"duplicate events": {
+			cfg:      config.AutoExcludeConfig{Enabled: true},
+			events:   []*events.RASEvent{rankDown, rankDown},
+			expRanks: []system.Rank{1},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			db := system.MockDatabase(t, log)
+			if err := db.SetAutoExcludePaused(tc.paused); err != nil {
+				t.Fatal(err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			// Hold the exclusions until all events have been
+			// delivered in order to verify de-duplication.
+			release := make(chan struct{})
+			excluded := make(chan system.Rank, len(tc.events))
+			ae := newAutoExcluder(log, db, func(_ context.Context, rank system.Rank) {
+				<-release
+				excluded <- rank
+			})
+			ae.configure(tc.cfg)
+			if !tc.notStarted {
+				ae.start(ctx)
+			}
+
+			for _, evt := range tc.events {
+				ae.OnEvent(ctx, evt)
+			}
+			close(release)
+
+			var gotRanks []system.Rank
+			for range tc.expRanks {
+				select {
+				case <-time.After(5 * time.Second):
+					t.Fatal("timed out waiting for exclusion")
+				case rank := <-excluded:
+					gotRanks = append(gotRanks, rank)
+				}
+			}
+			select {
+			case rank := <-excluded:
+				t.Fatalf("unexpected exclusion of rank %d", rank)
+			case <-time.After(10 * time.Millisecond):
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.Transformer("Sort", func(in []system.Rank) *system.RankSet {
+					return system.RankSetFromRanks(in)
+				}),
+				cmp.Comparer(func(x, y *system.RankSet) bool {
+					return x.String() == y.String()
+				}),
+			}
+			if diff := cmp.Diff(tc.expRanks, gotRanks, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected excluded ranks (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_autoExcluder_Cancel(t *testing.T) {
+	rankDown := events.NewRankDownEvent("foo", 0, 1, common.ExitStatus("test"))
+
+	for name, tc := range map[string]struct {
+		cancelFn func(context.CancelFunc, *autoExcluder)
+	}{
+		"paused": {
+			cancelFn: func(_ context.CancelFunc, ae *autoExcluder) {
+				ae.pause()
+			},
+		},
+		"leadership lost": {
+			cancelFn: func(cancel context.CancelFunc, ae *autoExcluder) {
+				cancel()
+				for {
+					ae.Lock()
+					stopped := ae.ctx == nil
+					ae.Unlock()
+					if stopped {
+						return
+					}
+					time.Sleep(time.Millisecond)
+				}
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			ae := newAutoExcluder(log, system.MockDatabase(t, log), func(_ context.Context, rank system.Rank) {
+				t.Errorf("unexpected exclusion of rank %d", rank)
+			})
+			ae.configure(config.AutoExcludeConfig{
+				Enabled:     true,
+				GracePeriod: time.Hour,
+			})
+			ae.start(ctx)
+
+			ae.OnEvent(ctx, rankDown)
+			common.AssertEqual(t, "1", ae.pendingRanks().String(), "unexpected pending ranks")
+
+			tc.cancelFn(cancel, ae)
+			common.AssertEqual(t, "", ae.pendingRanks().String(), "unexpected pending ranks")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_autoExcludeRank(t *testing.T) {
+	testPoolService := &system.PoolService{
+		PoolUUID: uuid.MustParse(mockUUID),
+		State:    system.PoolServiceStateReady,
+		Replicas: []system.Rank{0},
+		Storage: &system.PoolServiceStorage{
+			CurrentRankStr: "[0-1]",
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		paused      bool
+		memberState system.MemberState
+		drpcResp    *mgmtpb.PoolExcludeResp
+		drpcErr     error
+		expExcluded bool
+		expEventID  events.RASID
+	}{
+		"paused": {
+			paused:      true,
+			memberState: system.MemberStateErrored,
+		},
+		"rank recovered": {
+			memberState: system.MemberStateJoined,
+		},
+		"exclude fails": {
+			memberState: system.MemberStateErrored,
+			drpcResp:    &mgmtpb.PoolExcludeResp{Status: int32(drpc.DaosBusy)},
+			expExcluded: true,
+			expEventID:  events.RASPoolAutoExcludeFailed,
+		},
+		"exclude succeeds": {
+			memberState: system.MemberStateErrored,
+			drpcResp:    &mgmtpb.PoolExcludeResp{},
+			expExcluded: true,
+			expEventID:  events.RASPoolAutoExclude,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPoolService(t, svc.sysdb, testPoolService)
+			if err := svc.sysdb.AddMember(system.MockMember(t, 1, tc.memberState)); err != nil {
+				t.Fatal(err)
+			}
+			if err := svc.sysdb.SetAutoExcludePaused(tc.paused); err != nil {
+				t.Fatal(err)
+			}
+			setupMockDrpcClient(svc, tc.drpcResp, tc.drpcErr)
+
+			published := make(chan *events.RASEvent, 1)
+			svc.events.Subscribe(events.RASTypeInfoOnly, events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+				published <- evt
+			}))
+
+			svc.autoExcludeRank(context.Background(), 1)
+
+			mdc := svc.harness.instances[0]._drpcClient.(*mockDrpcClient)
+			var expMethods []drpc.Method
+			if tc.expExcluded {
+				expMethods = append(expMethods, drpc.MethodPoolExclude)
+			}
+			if diff := cmp.Diff(expMethods, mdc.CalledMethods()); diff != "" {
+				t.Fatalf("unexpected dRPC calls (-want, +got)\n%s\n", diff)
+			}
+
+			if !tc.expExcluded {
+				return
+			}
+
+			select {
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for event")
+			case evt := <-published:
+				common.AssertEqual(t, tc.expEventID, evt.ID, "unexpected event ID")
+				common.AssertEqual(t, uint32(1), evt.Rank, "unexpected event rank")
+				common.AssertEqual(t, mockUUID, evt.PoolUUID, "unexpected event pool")
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemSetPolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		req        *mgmtpb.SystemSetPolicyReq
+		expResp    *mgmtpb.SystemSetPolicyResp
+		expErr     error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemSetPolicyReq{},
+			expErr:     errors.New("replica"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.SystemSetPolicyReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"pause": {
+			req: &mgmtpb.SystemSetPolicyReq{AutoExcludePaused: true},
+			expResp: &mgmtpb.SystemSetPolicyResp{
+				AutoExcludeEnabled:     true,
+				AutoExcludePaused:      true,
+				AutoExcludeGracePeriod: 3600,
+			},
+		},
+		"resume": {
+			req: &mgmtpb.SystemSetPolicyReq{},
+			expResp: &mgmtpb.SystemSetPolicyResp{
+				AutoExcludeEnabled:     true,
+				AutoExcludeGracePeriod: 3600,
+				AutoExcludePending:     "1",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonReplica {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			}
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			svc.autoExclude.configure(config.AutoExcludeConfig{
+				Enabled:     true,
+				GracePeriod: time.Hour,
+			})
+			svc.autoExclude.start(ctx)
+			svc.autoExclude.schedule(1)
+
+			gotResp, gotErr := svc.SystemSetPolicy(ctx, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			ps, err := svc.sysdb.PolicyState()
+			if err != nil {
+				t.Fatal(err)
+			}
+			common.AssertEqual(t, tc.req.AutoExcludePaused, ps.AutoExcludePaused,
+				"unexpected paused state")
+		})
+	}
+}
@@ -7,10 +7,8 @@
 package server
 
 import (
-	"math/rand"
-	"sort"
+	"fmt"
 	"strings"
-	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/google/uuid"
@@ -34,18 +32,30 @@ const (
 	// MaxPoolServiceReps defines the maximum number of pool service
 	// replicas that may be configured when creating a pool.
 	MaxPoolServiceReps = 13
+	// maxPoolLabelLen defines the maximum length of a pool label
+	// (DAOS_PROP_LABEL_MAX_LEN).
+	maxPoolLabelLen = 256
 )
 
 type poolServiceReq interface {
 	proto.Message
 	GetUuid() string
+	SetUUID(id string)
 	GetSvcRanks() []uint32
 	SetSvcRanks(rl []uint32)
 }
 
 func (svc *mgmtSvc) makePoolServiceCall(ctx context.Context, method drpc.Method, req poolServiceReq) (*drpc.Response, error) {
+	ps, err := svc.resolvePoolID(req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+	// The I/O Engine only understands pool UUIDs, so replace any
+	// label supplied by the caller.
+	req.SetUUID(ps.PoolUUID.String())
+
 	if len(req.GetSvcRanks()) == 0 {
-		rl, err := svc.getPoolServiceRanks(req.GetUuid())
+		rl, err := svc.getPoolServiceRanks(ps)
 		if err != nil {
 			return nil, err
 		}
@@ -55,17 +65,36 @@ func (svc *mgmtSvc) makePoolServiceCall(ctx context.Context, method drpc.Method,
 	return svc.harness.CallDrpc(ctx, method, req)
 }
 
-func (svc *mgmtSvc) getPoolServiceRanks(uuidStr string) ([]uint32, error) {
-	uuid, err := uuid.Parse(uuidStr)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to parse request uuid %q", uuidStr)
+// resolvePoolID looks up the pool service identified by the supplied
+// pool label or UUID string.
+func (svc *mgmtSvc) resolvePoolID(id string) (*system.PoolService, error) {
+	if id == "" {
+		return nil, errors.New("no pool label or UUID supplied")
 	}
 
-	ps, err := svc.sysdb.FindPoolServiceByUUID(uuid)
-	if err != nil {
-		return nil, err
+	if poolUUID, err := uuid.Parse(id); err == nil {
+		return svc.sysdb.FindPoolServiceByUUID(poolUUID)
+	}
+
+	return svc.sysdb.FindPoolServiceByLabel(id)
+}
+
+// checkPoolLabel verifies that the supplied string may be used as a pool
+// label. Labels must not be parseable as UUIDs, as that would make pool
+// identifiers ambiguous.
+func checkPoolLabel(label string) error {
+	if _, err := uuid.Parse(label); err == nil {
+		return FaultPoolInvalidLabel(label, "label must not be a UUID")
+	}
+	if len(label) > maxPoolLabelLen {
+		return FaultPoolInvalidLabel(label,
+			fmt.Sprintf("label must not be longer than %d characters", maxPoolLabelLen))
 	}
 
+	return nil
+}
+
+func (svc *mgmtSvc) getPoolServiceRanks(ps *system.PoolService) ([]uint32, error) {
 	if ps.State != system.PoolServiceStateReady {
 		return nil, drpc.DaosTryAgain
 	}
@@ -83,22 +112,23 @@ func (svc *mgmtSvc) getPoolServiceRanks(uuidStr string) ([]uint32, error) {
 	}
 
 	if len(readyRanks) == 0 {
-		return nil, errors.Errorf("unable to find any available service ranks for pool %s", uuid)
+		return nil, errors.Errorf("unable to find any available service ranks for pool %s", ps.PoolUUID)
 	}
 
 	return system.RanksToUint32(readyRanks), nil
 }
 
 // calculateCreateStorage determines the amount of SCM/NVMe storage to
-// allocate per engine in order to fulfill the create request, if those
-// values are not already supplied as part of the request.
-func (svc *mgmtSvc) calculateCreateStorage(req *mgmtpb.PoolCreateReq) error {
+// allocate per engine in order to fulfill the create request across the
+// given number of ranks, if those values are not already supplied as part
+// of the request.
+func (svc *mgmtSvc) calculateCreateStorage(req *mgmtpb.PoolCreateReq, nRanks int) error {
 	instances := svc.harness.Instances()
 	if len(instances) < 1 {
 		return errors.New("harness has no managed instances")
 	}
 
-	if len(req.GetRanks()) == 0 {
+	if nRanks == 0 {
 		return errors.New("zero ranks in calculateCreateStorage()")
 	}
 	if req.GetScmratio() == 0 {
@@ -106,7 +136,7 @@ func (svc *mgmtSvc) calculateCreateStorage(req *mgmtpb.PoolCreateReq) error {
 	}
 
 	storagePerRank := func(total uint64) uint64 {
-		return total / uint64(len(req.GetRanks()))
+		return total / uint64(nRanks)
 	}
 
 	switch {
@@ -171,57 +201,60 @@ func (svc *mgmtSvc) PoolCreate(ctx context.Context, req *mgmtpb.PoolCreateReq) (
 		return nil, err
 	}
 
-	if _, err := svc.sysdb.FindPoolServiceByLabel(req.GetName()); err == nil {
-		return nil, FaultPoolDuplicateLabel(req.GetName())
+	if req.GetName() != "" {
+		if err := checkPoolLabel(req.GetName()); err != nil {
+			return nil, err
+		}
+		if _, err := svc.sysdb.FindPoolServiceByLabel(req.GetName()); err == nil {
+			return nil, FaultPoolDuplicateLabel(req.GetName())
+		}
 	}
 
-	allRanks, err := svc.sysdb.MemberRanks(system.AvailableMemberFilter)
+	allMembers, err := svc.sysdb.AllMembers()
 	if err != nil {
 		return nil, err
 	}
+	var members []*system.Member
+	domains := make(map[system.Rank]*system.FaultDomain)
+	for _, m := range allMembers {
+		if m.State()&system.AvailableMemberFilter == 0 {
+			continue
+		}
+		members = append(members, m)
+		domains[m.Rank] = m.FaultDomain
+	}
 
-	if len(req.GetRanks()) > 0 {
-		// If the request supplies a specific rank list, use it. Note that
-		// the rank list may include downed ranks, in which case the create
-		// will fail with an error.
-		reqRanks := system.RanksFromUint32(req.GetRanks())
+	// If the request supplies a specific rank list, use it. Note that
+	// the rank list may include downed ranks, in which case the create
+	// will fail with an error. Otherwise, create the pool across the
+	// requested number of available ranks in the system (if the request
+	// does not specify a number of ranks, all are used).
+	var poolRanks []system.Rank
+	nRanks := len(members)
+	switch {
+	case len(req.GetRanks()) > 0:
 		// Create a RankSet to sort/dedupe the ranks.
-		reqRanks = system.RankSetFromRanks(reqRanks).Ranks()
-		if err != nil {
-			return nil, err
-		}
+		poolRanks = system.RankSetFromRanks(system.RanksFromUint32(req.GetRanks())).Ranks()
 
-		if invalid := system.CheckRankMembership(allRanks, reqRanks); len(invalid) > 0 {
-			return nil, FaultPoolInvalidRanks(invalid)
+		allRanks := make([]system.Rank, 0, len(members))
+		for _, m := range members {
+			allRanks = append(allRanks, m.Rank)
 		}
-
-		req.Ranks = system.RanksToUint32(reqRanks)
-	} else {
-		// Otherwise, create the pool across the requested number of
-		// available ranks in the system (if the request does not
-		// specify a number of ranks, all are used).
-		nRanks := len(allRanks)
-		if req.GetNumranks() > 0 {
-			nRanks = int(req.GetNumranks())
-
-			// TODO (DAOS-6263): Improve rank selection algorithm.
-			// In the short term, we can just randomize the set of
-			// available ranks in order to avoid always choosing the
-			// first N ranks.
-			rand.Seed(time.Now().UnixNano())
-			rand.Shuffle(len(allRanks), func(i, j int) {
-				allRanks[i], allRanks[j] = allRanks[j], allRanks[i]
-			})
+		if invalid := system.CheckRankMembership(allRanks, poolRanks); len(invalid) > 0 {
+			return nil, FaultPoolInvalidRanks(invalid)
 		}
-
-		req.Ranks = make([]uint32, nRanks)
-		for i := 0; i < nRanks; i++ {
-			req.Ranks[i] = allRanks[i].Uint32()
+		nRanks = len(poolRanks)
+	case req.GetNumranks() > 0:
+		nRanks = int(req.GetNumranks())
+	default:
+		allRanks := make([]system.Rank, 0, len(members))
+		for _, m := range members {
+			allRanks = append(allRanks, m.Rank)
 		}
-		sort.Slice(req.Ranks, func(i, j int) bool { return req.Ranks[i] < req.Ranks[j] })
+		poolRanks = system.RankSetFromRanks(allRanks).Ranks()
 	}
 
-	if len(req.GetRanks()) == 0 {
+	if nRanks == 0 {
 		return nil, errors.New("pool request contains zero target ranks")
 	}
 
@@ -231,24 +264,59 @@ func (svc *mgmtSvc) PoolCreate(ctx context.Context, req *mgmtpb.PoolCreateReq) (
 	// 2N+1 resiliency model.
 	if req.GetNumsvcreps() == 0 {
 		req.Numsvcreps = DefaultPoolServiceReps
-		if len(req.GetRanks()) < DefaultPoolServiceReps {
+		if nRanks < DefaultPoolServiceReps {
 			req.Numsvcreps = 1
 		}
 	} else if req.GetNumsvcreps() > MaxPoolServiceReps {
 		return nil, FaultPoolInvalidServiceReps
 	}
 
-	// I/O Engine needs the fault domain tree for placement purposes
-	req.FaultDomains = svc.sysdb.FaultDomainTree().ToProto()
-
-	if err := svc.calculateCreateStorage(req); err != nil {
+	if err := svc.calculateCreateStorage(req, nRanks); err != nil {
 		return nil, err
 	}
 
+	// When only a number of ranks is requested, choose the ranks with
+	// enough free capacity to satisfy the per-rank storage sizes.
+	if poolRanks == nil {
+		capacity := svc.getRankCapacity(ctx, members)
+		poolRanks, err = selectPoolRanks(members, capacity, nRanks, req.GetScmbytes(), req.GetNvmebytes())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Spread the pool service replicas across fault domains and order the
+	// ranks so that the engine places the replicas on the chosen ranks.
+	svcRanks := selectSvcRanks(domains, poolRanks, int(req.GetNumsvcreps()))
+	req.Ranks = orderPoolRanks(poolRanks, svcRanks)
+
 	ps = system.NewPoolService(uuid, req.GetScmbytes(), req.GetNvmebytes(), system.RanksFromUint32(req.GetRanks()))
 	ps.PoolLabel = req.GetName()
+	ps.OwnerUser = req.GetUser()
+	ps.OwnerGroup = req.GetUsergroup()
+
+	if req.GetDryrun() {
+		if err := svc.sysdb.CheckPoolQuotas(ps); err != nil {
+			return nil, poolQuotaFault(err)
+		}
+
+		resp.SvcReps = system.RanksToUint32(svcRanks)
+		resp.TgtRanks = system.RanksToUint32(poolRanks)
+		resp.ScmBytes = req.GetScmbytes()
+		resp.NvmeBytes = req.GetNvmebytes()
+		return resp, nil
+	}
+
+	// I/O Engine needs the fault domain tree for placement purposes
+	req.FaultDomains = svc.sysdb.FaultDomainTree().ToProto()
+
+	// The pool's capacity is checked against the owner's quotas and
+	// reserved atomically when the pool service entry is added.
 	if err := svc.sysdb.AddPoolService(ps); err != nil {
-		return nil, err
+		if system.IsPoolLabelExists(err) {
+			return nil, FaultPoolDuplicateLabel(ps.PoolLabel)
+		}
+		return nil, poolQuotaFault(err)
 	}
 
 	defer func() {
@@ -305,7 +373,7 @@ func (svc *mgmtSvc) PoolCreate(ctx context.Context, req *mgmtpb.PoolCreateReq) (
 		return resp, nil
 	}
 	// let the caller know what was actually created
-	resp.TgtRanks = req.GetRanks()
+	resp.TgtRanks = system.RanksToUint32(poolRanks)
 	resp.ScmBytes = req.Scmbytes
 	resp.NvmeBytes = req.Nvmebytes
 
@@ -360,19 +428,15 @@ func (svc *mgmtSvc) PoolDestroy(ctx context.Context, req *mgmtpb.PoolDestroyReq)
 	}
 	svc.log.Debugf("MgmtSvc.PoolDestroy dispatch, req:%+v\n", req)
 
-	uuid, err := uuid.Parse(req.GetUuid())
-	if err != nil {
-		return nil, err
-	}
-
 	// FIXME: There are some potential races here. We may want
 	// to somehow do all of this under a lock, to prevent multiple
 	// gRPC callers from modifying the same pool concurrently.
 
-	ps, err := svc.sysdb.FindPoolServiceByUUID(uuid)
+	ps, err := svc.resolvePoolID(req.GetUuid())
 	if err != nil {
 		return nil, err
 	}
+	req.Uuid = ps.PoolUUID.String()
 
 	lastState := ps.State
 	if ps.State == system.PoolServiceStateDestroying {
@@ -399,8 +463,8 @@ func (svc *mgmtSvc) PoolDestroy(ctx context.Context, req *mgmtpb.PoolDestroyReq)
 
 	switch drpc.DaosStatus(resp.Status) {
 	case drpc.DaosSuccess:
-		if err := svc.sysdb.RemovePoolService(uuid); err != nil {
-			return nil, errors.Wrapf(err, "failed to remove pool %s", uuid)
+		if err := svc.sysdb.RemovePoolService(ps.PoolUUID); err != nil {
+			return nil, errors.Wrapf(err, "failed to remove pool %s", ps.PoolUUID)
 		}
 	// TODO: Identify errors that should leave the pool in a "Destroying"
 	// state and enumerate them here.
@@ -490,19 +554,58 @@ func (svc *mgmtSvc) PoolExtend(ctx context.Context, req *mgmtpb.PoolExtendReq) (
 
 	svc.log.Debugf("MgmtSvc.PoolExtend dispatch, req:%+v\n", req)
 
+	ps, err := svc.resolvePoolID(req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	// Capacity is allocated on the new ranks with the same per-rank
+	// sizes as those used when the pool was created. The capacity is
+	// checked against the owner's quotas and reserved atomically by
+	// recording the new ranks before the pool is extended, and released
+	// again if the extend fails.
+	var reserved bool
+	if ps.Storage != nil {
+		curRanks := make(map[system.Rank]bool)
+		for _, rank := range ps.Storage.CurrentRanks() {
+			curRanks[rank] = true
+		}
+		var newRanks []system.Rank
+		for _, rank := range system.RankSetFromRanks(system.RanksFromUint32(req.GetRanks())).Ranks() {
+			if !curRanks[rank] {
+				newRanks = append(newRanks, rank)
+			}
+		}
+
+		if len(newRanks) > 0 {
+			if err := svc.updatePoolStorageRanks(ps, append(ps.Storage.CurrentRanks(), newRanks...)); err != nil {
+				return nil, poolQuotaFault(err)
+			}
+			reserved = true
+		}
+	}
+
 	// the I/O Engine needs the domain tree for placement purposes
 	req.FaultDomains = svc.sysdb.FaultDomainTree().ToProto()
 
 	svc.log.Debugf("MgmtSvc.PoolExtend forwarding modified req:%+v\n", req)
 
+	resp := &mgmtpb.PoolExtendResp{}
 	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolExtend, req)
-	if err != nil {
-		return nil, err
+	if err == nil {
+		if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+			err = errors.Wrap(err, "unmarshal PoolExtend response")
+		}
 	}
 
-	resp := &mgmtpb.PoolExtendResp{}
-	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
-		return nil, errors.Wrap(err, "unmarshal PoolExtend response")
+	if reserved && (err != nil || resp.GetStatus() != 0) {
+		if relErr := svc.updatePoolStorageRanks(ps, ps.Storage.CurrentRanks()); relErr != nil {
+			svc.log.Errorf("failed to release capacity reserved for pool %s: %s",
+				ps.PoolUUID, relErr)
+		}
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	svc.log.Debugf("MgmtSvc.PoolExtend dispatch, resp:%+v\n", resp)
@@ -510,6 +613,23 @@ func (svc *mgmtSvc) PoolExtend(ctx context.Context, req *mgmtpb.PoolExtendReq) (
 	return resp, nil
 }
 
+// updatePoolStorageRanks records the given set of ranks as the current
+// ranks of the pool, so that the pool's capacity is accounted for correctly.
+func (svc *mgmtSvc) updatePoolStorageRanks(ps *system.PoolService, ranks []system.Rank) error {
+	cur, err := svc.sysdb.FindPoolServiceByUUID(ps.PoolUUID)
+	if err != nil {
+		return err
+	}
+
+	cur.Storage = &system.PoolServiceStorage{
+		CreationRankStr: ps.Storage.CreationRankStr,
+		CurrentRankStr:  system.RankSetFromRanks(ranks).RangedString(),
+		ScmPerRank:      ps.Storage.ScmPerRank,
+		NVMePerRank:     ps.Storage.NVMePerRank,
+	}
+	return svc.sysdb.UpdatePoolService(cur)
+}
+
 // PoolReintegrate implements the method defined for the Management Service.
 func (svc *mgmtSvc) PoolReintegrate(ctx context.Context, req *mgmtpb.PoolReintegrateReq) (*mgmtpb.PoolReintegrateResp, error) {
 	if err := svc.checkLeaderRequest(req); err != nil {
@@ -611,7 +731,7 @@ func resolvePoolPropVal(req *mgmtpb.PoolSetPropReq) (*mgmtpb.PoolSetPropReq, err
 }
 
 // PoolSetProp forwards a request to the I/O Engine to set a pool property.
-func (svc *mgmtSvc) PoolSetProp(ctx context.Context, req *mgmtpb.PoolSetPropReq) (*mgmtpb.PoolSetPropResp, error) {
+func (svc *mgmtSvc) PoolSetProp(ctx context.Context, req *mgmtpb.PoolSetPropReq) (resp *mgmtpb.PoolSetPropResp, err error) {
 	if err := svc.checkLeaderRequest(req); err != nil {
 		return nil, err
 	}
@@ -625,47 +745,58 @@ func (svc *mgmtSvc) PoolSetProp(ctx context.Context, req *mgmtpb.PoolSetPropReq)
 	svc.log.Debugf("MgmtSvc.PoolSetProp dispatch, req (converted):%+v", newReq)
 
 	// Label is a special case, in that we need to ensure that it's unique
-	// and also to update the pool service entry.
+	// and also to update the pool service entry. The label is reserved in
+	// the MS database before the request is forwarded, so that concurrent
+	// requests for the same label cannot both succeed, and the previous
+	// label is restored if the I/O Engine fails to apply the change.
 	if newReq.GetNumber() == drpc.PoolPropertyLabel {
-		uuidStr := newReq.GetUuid()
 		label := newReq.GetStrval()
-
-		uuid, err := uuid.Parse(uuidStr)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to parse request uuid %q", uuidStr)
+		if label != "" {
+			if err := checkPoolLabel(label); err != nil {
+				return nil, err
+			}
 		}
-		ps, err := svc.sysdb.FindPoolServiceByUUID(uuid)
+
+		// NB: Avoid shadowing err, as the deferred rollback below
+		// depends on it.
+		var ps *system.PoolService
+		ps, err = svc.resolvePoolID(newReq.GetUuid())
 		if err != nil {
 			return nil, err
 		}
+		// Pin the request to the UUID, as the supplied ID may be the
+		// label that is about to be replaced.
+		newReq.Uuid = ps.PoolUUID.String()
 
-		if label != "" {
-			// If we're setting a label, first check to see
-			// if a pool has already had the label applied.
-			found, err := svc.sysdb.FindPoolServiceByLabel(label)
-			if found != nil && found.PoolUUID != ps.PoolUUID {
-				// If we find a pool with this label but the
-				// UUID differs, then we should fail the request.
+		prevLabel := ps.PoolLabel
+		ps.PoolLabel = label
+		if err := svc.sysdb.UpdatePoolService(ps); err != nil {
+			if system.IsPoolLabelExists(err) {
 				return nil, FaultPoolDuplicateLabel(label)
 			}
-			if err != nil && !system.IsPoolNotFound(err) {
-				// If the query failed, then we should fail
-				// the request.
-				return nil, err
-			}
-			// Otherwise, allow the label to be set again on the same
-			// pool for idempotency.
+			return nil, err
 		}
 
 		defer func() {
-			if ps == nil || err != nil {
+			if err == nil && resp.GetStatus() == 0 {
 				return
 			}
 
-			// Persist the label update in the MS DB if the
-			// dRPC call succeeded.
-			ps.PoolLabel = label
-			err = svc.sysdb.UpdatePoolService(ps)
+			// Re-read the pool service so that only the label is
+			// restored, and concurrent updates to the rest of the
+			// entry (e.g. its replicas) are kept.
+			cur, rbErr := svc.sysdb.FindPoolServiceByUUID(ps.PoolUUID)
+			if rbErr == nil {
+				if cur.PoolLabel != label {
+					return
+				}
+				cur.PoolLabel = prevLabel
+				rbErr = svc.sysdb.UpdatePoolService(cur)
+			}
+			if rbErr != nil {
+				svc.log.Errorf("failed to restore label %q for pool %s: %s",
+					prevLabel, ps.PoolUUID, rbErr)
+			}
 		}()
 	}
 
@@ -675,7 +806,7 @@ func (svc *mgmtSvc) PoolSetProp(ctx context.Context, req *mgmtpb.PoolSetPropReq)
 		return nil, err
 	}
 
-	resp := new(mgmtpb.PoolSetPropResp)
+	resp = new(mgmtpb.PoolSetPropResp)
 	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
 		return nil, errors.Wrap(err, "unmarshal PoolSetProp response")
 	}
@@ -707,6 +838,145 @@ func (svc *mgmtSvc) PoolSetProp(ctx context.Context, req *mgmtpb.PoolSetPropReq)
 	return resp, nil
 }
 
+// poolProperties lists the pool properties that may be retrieved by name, in
+// the order in which they are reported.
+var poolProperties = []struct {
+	name   string
+	number uint32
+}{
+	{"label", drpc.PoolPropertyLabel},
+	{"reclaim", drpc.PoolPropertySpaceReclaim},
+	{"space_rb", drpc.PoolPropertyReservedSpace},
+	{"self_heal", drpc.PoolPropertySelfHealing},
+	{"owner", drpc.PoolPropertyOwner},
+	{"group", drpc.PoolPropertyOwnerGroup},
+}
+
+// resolvePoolPropNames converts a list of property names into a list of
+// property numbers. All known properties are returned if no names are given.
+func resolvePoolPropNames(names []string) ([]uint32, error) {
+	if len(names) == 0 {
+		numbers := make([]uint32, 0, len(poolProperties))
+		for _, prop := range poolProperties {
+			numbers = append(numbers, prop.number)
+		}
+		return numbers, nil
+	}
+
+	numbers := make([]uint32, 0, len(names))
+	seen := make(map[uint32]struct{})
+	for _, name := range names {
+		propName := strings.ToLower(strings.TrimSpace(name))
+		found := false
+		for _, prop := range poolProperties {
+			if prop.name != propName {
+				continue
+			}
+			found = true
+			if _, dupe := seen[prop.number]; !dupe {
+				seen[prop.number] = struct{}{}
+				numbers = append(numbers, prop.number)
+			}
+			break
+		}
+		if !found {
+			return nil, errors.Errorf("unhandled pool property %q", name)
+		}
+	}
+
+	return numbers, nil
+}
+
+// resolvePoolPropString sets the name and a human-readable string value
+// for a property returned by the I/O Engine.
+func resolvePoolPropString(prop *mgmtpb.PoolProperty) error {
+	for _, p := range poolProperties {
+		if p.number == prop.GetNumber() {
+			prop.Name = p.name
+			break
+		}
+	}
+	if prop.Name == "" {
+		return errors.Errorf("unhandled pool property number %d", prop.GetNumber())
+	}
+
+	switch prop.GetNumber() {
+	case drpc.PoolPropertySpaceReclaim:
+		switch prop.GetNumval() {
+		case drpc.PoolSpaceReclaimDisabled:
+			prop.Strval = "disabled"
+		case drpc.PoolSpaceReclaimLazy:
+			prop.Strval = "lazy"
+		case drpc.PoolSpaceReclaimSnapshot:
+			prop.Strval = "snapshot"
+		case drpc.PoolSpaceReclaimBatch:
+			prop.Strval = "batch"
+		case drpc.PoolSpaceReclaimTime:
+			prop.Strval = "time"
+		default:
+			prop.Strval = fmt.Sprintf("unknown (%d)", prop.GetNumval())
+		}
+	case drpc.PoolPropertyReservedSpace:
+		prop.Strval = fmt.Sprintf("%d%%", prop.GetNumval())
+	case drpc.PoolPropertySelfHealing:
+		var heal []string
+		if prop.GetNumval()&drpc.PoolSelfHealingAutoExclude != 0 {
+			heal = append(heal, "exclude")
+		}
+		if prop.GetNumval()&drpc.PoolSelfHealingAutoRebuild != 0 {
+			heal = append(heal, "rebuild")
+		}
+		if len(heal) == 0 {
+			heal = append(heal, "none")
+		}
+		prop.Strval = strings.Join(heal, ",")
+	}
+
+	return nil
+}
+
+// PoolGetProp forwards a request to the I/O Engine to get pool properties.
+func (svc *mgmtSvc) PoolGetProp(ctx context.Context, req *mgmtpb.PoolGetPropReq) (*mgmtpb.PoolGetPropResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("MgmtSvc.PoolGetProp dispatch, req:%+v", req)
+
+	numbers, err := resolvePoolPropNames(req.GetNames())
+	if err != nil {
+		return nil, err
+	}
+	newReq := &mgmtpb.PoolGetPropReq{
+		Uuid:     req.GetUuid(),
+		Numbers:  numbers,
+		SvcRanks: req.GetSvcRanks(),
+	}
+
+	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolGetProp, newReq)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.PoolGetPropResp)
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal PoolGetProp response")
+	}
+
+	svc.log.Debugf("MgmtSvc.PoolGetProp dispatch, resp:%+v", resp)
+
+	if resp.GetStatus() != 0 {
+		return resp, nil
+	}
+
+	for _, prop := range resp.GetProperties() {
+		if err := resolvePoolPropString(prop); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
 // PoolGetACL forwards a request to the I/O Engine to fetch a pool's Access Control List
 func (svc *mgmtSvc) PoolGetACL(ctx context.Context, req *mgmtpb.GetACLReq) (*mgmtpb.ACLResp, error) {
 	if err := svc.checkReplicaRequest(req); err != nil {
@@ -0,0 +1,213 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+type (
+	// rankCapacity records the free SCM and NVMe capacity reported
+	// by a rank.
+	rankCapacity struct {
+		scmFree  uint64
+		nvmeFree uint64
+	}
+
+	// rankCapacityMap maps ranks to their reported free capacity.
+	rankCapacityMap map[system.Rank]*rankCapacity
+)
+
+func (rcm rankCapacityMap) get(rank system.Rank) *rankCapacity {
+	if _, found := rcm[rank]; !found {
+		rcm[rank] = new(rankCapacity)
+	}
+	return rcm[rank]
+}
+
+// getRankCapacity requests storage usage from the hosts of the given
+// members and returns the free capacity reported for each rank. If usage
+// could not be retrieved from any host, nil is returned and pool placement
+// does not take capacity into account.
+func (svc *mgmtSvc) getRankCapacity(ctx context.Context, members []*system.Member) rankCapacityMap {
+	if svc.rpcClient == nil || len(members) == 0 {
+		return nil
+	}
+
+	hostSet := make(map[string]struct{})
+	var hosts []string
+	for _, m := range members {
+		addr := m.Addr.String()
+		if _, found := hostSet[addr]; found {
+			continue
+		}
+		hostSet[addr] = struct{}{}
+		hosts = append(hosts, addr)
+	}
+
+	req := &control.StorageScanReq{Usage: true}
+	req.SetHostList(hosts)
+	resp, err := control.StorageScan(ctx, svc.rpcClient, req)
+	if err != nil {
+		svc.log.Errorf("failed to get storage usage for pool placement: %s", err)
+		return nil
+	}
+	if resp.Errors() != nil {
+		svc.log.Debugf("storage usage not available from all hosts: %s", resp.Errors())
+	}
+
+	capacity := make(rankCapacityMap)
+	for _, key := range resp.HostStorage.Keys() {
+		hs := resp.HostStorage[key].HostStorage
+		for _, ns := range hs.ScmNamespaces {
+			if ns.Mount == nil {
+				continue
+			}
+			capacity.get(ns.Mount.Rank).scmFree += ns.Mount.AvailBytes
+		}
+		for _, ctrlr := range hs.NvmeDevices {
+			for _, dev := range ctrlr.SmdDevices {
+				capacity.get(dev.Rank).nvmeFree += dev.AvailBytes
+			}
+		}
+	}
+
+	if len(capacity) == 0 {
+		svc.log.Errorf("no storage usage reported for pool placement")
+		return nil
+	}
+
+	return capacity
+}
+
+// selectPoolRanks chooses nRanks of the given members on which to create
+// a pool that requires the given SCM and NVMe capacity on each rank. Ranks
+// with the most free capacity are preferred and ranks without enough free
+// capacity are not used. If capacity is nil, the ranks are chosen at random.
+func selectPoolRanks(members []*system.Member, capacity rankCapacityMap, nRanks int, scmBytes, nvmeBytes uint64) ([]system.Rank, error) {
+	if nRanks > len(members) {
+		return nil, errors.Errorf("pool request requires %d ranks; only %d available",
+			nRanks, len(members))
+	}
+
+	candidates := make([]system.Rank, 0, len(members))
+	for _, m := range members {
+		if capacity != nil {
+			rc, found := capacity[m.Rank]
+			if !found || rc.scmFree < scmBytes || rc.nvmeFree < nvmeBytes {
+				continue
+			}
+		}
+		candidates = append(candidates, m.Rank)
+	}
+
+	if capacity == nil {
+		rand.Seed(time.Now().UnixNano())
+		rand.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+	} else {
+		if len(candidates) < nRanks {
+			return nil, FaultPoolInsufficientCapacity(nRanks, len(candidates), scmBytes, nvmeBytes)
+		}
+
+		// Prefer the ranks with the most free capacity of the
+		// storage class that the pool will mostly be using.
+		sort.Slice(candidates, func(i, j int) bool {
+			ci, cj := capacity[candidates[i]], capacity[candidates[j]]
+			if nvmeBytes > 0 && ci.nvmeFree != cj.nvmeFree {
+				return ci.nvmeFree > cj.nvmeFree
+			}
+			if ci.scmFree != cj.scmFree {
+				return ci.scmFree > cj.scmFree
+			}
+			return candidates[i] < candidates[j]
+		})
+	}
+
+	ranks := candidates[:nRanks]
+	sort.Slice(ranks, func(i, j int) bool { return ranks[i] < ranks[j] })
+
+	return ranks, nil
+}
+
+// selectSvcRanks chooses up to nSvc of the given pool ranks to host the
+// pool service replicas, spreading them across as many top-level fault
+// domains as possible. The engine will not place a service replica on
+// rank 0 unless it is the only rank, so it is not chosen in that case.
+func selectSvcRanks(domains map[system.Rank]*system.FaultDomain, ranks []system.Rank, nSvc int) []system.Rank {
+	if len(ranks) == 1 {
+		return ranks
+	}
+
+	byDomain := make(map[string][]system.Rank)
+	var domainNames []string
+	for _, rank := range system.RankSetFromRanks(ranks).Ranks() {
+		if rank == 0 {
+			continue
+		}
+		top := domains[rank].TopLevel()
+		if _, found := byDomain[top]; !found {
+			domainNames = append(domainNames, top)
+		}
+		byDomain[top] = append(byDomain[top], rank)
+	}
+	sort.Strings(domainNames)
+
+	var svcRanks []system.Rank
+	for len(svcRanks) < nSvc {
+		added := false
+		for _, name := range domainNames {
+			if len(svcRanks) == nSvc || len(byDomain[name]) == 0 {
+				continue
+			}
+			svcRanks = append(svcRanks, byDomain[name][0])
+			byDomain[name] = byDomain[name][1:]
+			added = true
+		}
+		if !added {
+			break
+		}
+	}
+	sort.Slice(svcRanks, func(i, j int) bool { return svcRanks[i] < svcRanks[j] })
+
+	return svcRanks
+}
+
+// orderPoolRanks returns the pool ranks in the order that will cause the
+// engine to place the pool service replicas on the chosen service ranks,
+// as the engine uses the first non-zero ranks in the list. Rank 0 is kept
+// first so that the order is unchanged when the service ranks are the
+// lowest non-zero ranks.
+func orderPoolRanks(ranks, svcRanks []system.Rank) []uint32 {
+	isSvc := make(map[system.Rank]bool)
+	for _, rank := range svcRanks {
+		isSvc[rank] = true
+	}
+
+	ordered := system.RankSetFromRanks(ranks).Ranks()
+	sort.SliceStable(ordered, func(i, j int) bool {
+		switch {
+		case ordered[i] == 0 || ordered[j] == 0:
+			return ordered[i] == 0
+		case isSvc[ordered[i]] != isSvc[ordered[j]]:
+			return isSvc[ordered[i]]
+		default:
+			return ordered[i] < ordered[j]
+		}
+	})
+
+	return system.RanksToUint32(ordered)
+}
@@ -0,0 +1,321 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+func mockPlanMembers(t *testing.T, domains ...string) []*system.Member {
+	t.Helper()
+
+	members := make([]*system.Member, len(domains))
+	for i, domain := range domains {
+		members[i] = system.MockMember(t, uint32(i), system.MemberStateJoined).
+			WithFaultDomain(system.MustCreateFaultDomainFromString(domain))
+	}
+	return members
+}
+
+func mockPlanDomains(members []*system.Member) map[system.Rank]*system.FaultDomain {
+	domains := make(map[system.Rank]*system.FaultDomain)
+	for _, m := range members {
+		domains[m.Rank] = m.FaultDomain
+	}
+	return domains
+}
+
+func TestServer_MgmtSvc_getRankCapacity(t *testing.T) {
+	mockScanResp := func(rank uint32, scmFree, nvmeFree uint64) *ctlpb.StorageScanResp {
+		return &ctlpb.StorageScanResp{
+			Scm: &ctlpb.ScanScmResp{
+				Namespaces: []*ctlpb.ScmNamespace{
+					{
+						Blockdev: "pmem0",
+						Mount: &ctlpb.ScmNamespace_Mount{
+							Path:       "/mnt/daos",
+							AvailBytes: scmFree,
+							Rank:       rank,
+						},
+					},
+				},
+			},
+			Nvme: &ctlpb.ScanNvmeResp{
+				Ctrlrs: []*ctlpb.NvmeController{
+					{
+						Pciaddr: "0000:80:00.0",
+						Smddevices: []*ctlpb.NvmeController_SmdDevice{
+							{Uuid: common.MockUUID(int32(rank)), Rank: rank, AvailBytes: nvmeFree / 2},
+						},
+					},
+					{
+						Pciaddr: "0000:81:00.0",
+						Smddevices: []*ctlpb.NvmeController_SmdDevice{
+							{Uuid: common.MockUUID(int32(rank + 10)), Rank: rank, AvailBytes: nvmeFree / 2},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		noClient    bool
+		hostResps   []*control.HostResponse
+		expCapacity rankCapacityMap
+	}{
+		"no client": {
+			noClient: true,
+		},
+		"scan fails on all hosts": {
+			hostResps: []*control.HostResponse{
+				{Addr: "127.0.0.1:10001", Error: errors.New("scan failed")},
+				{Addr: "127.0.0.2:10001", Error: errors.New("scan failed")},
+			},
+		},
+		"scan fails on one host": {
+			hostResps: []*control.HostResponse{
+				{Addr: "127.0.0.1:10001", Message: mockScanResp(0, 4*humanize.GiByte, 8*humanize.TByte)},
+				{Addr: "127.0.0.2:10001", Error: errors.New("scan failed")},
+			},
+			expCapacity: rankCapacityMap{
+				0: {scmFree: 4 * humanize.GiByte, nvmeFree: 8 * humanize.TByte},
+			},
+		},
+		"success": {
+			hostResps: []*control.HostResponse{
+				{Addr: "127.0.0.1:10001", Message: mockScanResp(0, 4*humanize.GiByte, 8*humanize.TByte)},
+				{Addr: "127.0.0.2:10001", Message: mockScanResp(1, 2*humanize.GiByte, 2*humanize.TByte)},
+			},
+			expCapacity: rankCapacityMap{
+				0: {scmFree: 4 * humanize.GiByte, nvmeFree: 8 * humanize.TByte},
+				1: {scmFree: 2 * humanize.GiByte, nvmeFree: 2 * humanize.TByte},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if !tc.noClient {
+				svc.rpcClient = control.NewMockInvoker(log, &control.MockInvokerConfig{
+					UnaryResponse: &control.UnaryResponse{Responses: tc.hostResps},
+				})
+			}
+
+			members := []*system.Member{
+				system.MockMember(t, 1, system.MemberStateJoined),
+				system.MockMember(t, 2, system.MemberStateJoined),
+			}
+
+			gotCapacity := svc.getRankCapacity(context.TODO(), members)
+			cmpOpts := []cmp.Option{cmp.AllowUnexported(rankCapacity{})}
+			if diff := cmp.Diff(tc.expCapacity, gotCapacity, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected capacity (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_selectPoolRanks(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nMembers  int
+		capacity  rankCapacityMap
+		nRanks    int
+		scmBytes  uint64
+		nvmeBytes uint64
+		expRanks  []system.Rank
+		expErr    error
+	}{
+		"too many ranks": {
+			nMembers: 2,
+			nRanks:   3,
+			expErr:   errors.New("requires 3 ranks; only 2 available"),
+		},
+		"no capacity; all ranks": {
+			nMembers: 3,
+			nRanks:   3,
+			expRanks: []system.Rank{0, 1, 2},
+		},
+		"most free nvme preferred": {
+			nMembers: 4,
+			capacity: rankCapacityMap{
+				0: {scmFree: 8, nvmeFree: 100},
+				1: {scmFree: 4, nvmeFree: 400},
+				2: {scmFree: 2, nvmeFree: 300},
+				3: {scmFree: 9, nvmeFree: 200},
+			},
+			nRanks:    2,
+			scmBytes:  2,
+			nvmeBytes: 100,
+			expRanks:  []system.Rank{1, 2},
+		},
+		"most free scm preferred without nvme": {
+			nMembers: 4,
+			capacity: rankCapacityMap{
+				0: {scmFree: 8},
+				1: {scmFree: 4},
+				2: {scmFree: 2},
+				3: {scmFree: 9},
+			},
+			nRanks:   2,
+			scmBytes: 2,
+			expRanks: []system.Rank{0, 3},
+		},
+		"ties broken by rank": {
+			nMembers: 3,
+			capacity: rankCapacityMap{
+				0: {scmFree: 8, nvmeFree: 100},
+				1: {scmFree: 8, nvmeFree: 100},
+				2: {scmFree: 8, nvmeFree: 100},
+			},
+			nRanks:    2,
+			scmBytes:  2,
+			nvmeBytes: 100,
+			expRanks:  []system.Rank{0, 1},
+		},
+		"ranks without capacity excluded": {
+			nMembers: 4,
+			capacity: rankCapacityMap{
+				0: {scmFree: 1, nvmeFree: 400},
+				1: {scmFree: 4, nvmeFree: 50},
+				3: {scmFree: 4, nvmeFree: 200},
+			},
+			nRanks:    1,
+			scmBytes:  2,
+			nvmeBytes: 100,
+			expRanks:  []system.Rank{3},
+		},
+		"insufficient capacity": {
+			nMembers: 3,
+			capacity: rankCapacityMap{
+				0: {scmFree: 8, nvmeFree: 50},
+				1: {scmFree: 8, nvmeFree: 100},
+				2: {scmFree: 1, nvmeFree: 100},
+			},
+			nRanks:    2,
+			scmBytes:  2,
+			nvmeBytes: 100,
+			expErr:    FaultPoolInsufficientCapacity(2, 1, 2, 100),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var members []*system.Member
+			for i := 0; i < tc.nMembers; i++ {
+				members = append(members, system.MockMember(t, uint32(i), system.MemberStateJoined))
+			}
+
+			gotRanks, gotErr := selectPoolRanks(members, tc.capacity, tc.nRanks, tc.scmBytes, tc.nvmeBytes)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expRanks, gotRanks); diff != "" {
+				t.Fatalf("unexpected ranks (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_selectSvcRanks(t *testing.T) {
+	for name, tc := range map[string]struct {
+		domains     []string
+		ranks       []system.Rank
+		nSvc        int
+		expSvcRanks []system.Rank
+	}{
+		"single rank": {
+			domains:     []string{"/a/n0"},
+			ranks:       []system.Rank{0},
+			nSvc:        1,
+			expSvcRanks: []system.Rank{0},
+		},
+		"rank 0 not chosen": {
+			domains:     []string{"/a/n0", "/a/n1"},
+			ranks:       []system.Rank{0, 1},
+			nSvc:        3,
+			expSvcRanks: []system.Rank{1},
+		},
+		"single domain": {
+			domains:     []string{"/", "/", "/", "/", "/"},
+			ranks:       []system.Rank{0, 1, 2, 3, 4},
+			nSvc:        3,
+			expSvcRanks: []system.Rank{1, 2, 3},
+		},
+		"spread across domains": {
+			domains:     []string{"/a/n0", "/a/n1", "/a/n2", "/b/n3", "/b/n4", "/c/n5"},
+			ranks:       []system.Rank{0, 1, 2, 3, 4, 5},
+			nSvc:        3,
+			expSvcRanks: []system.Rank{1, 3, 5},
+		},
+		"more replicas than domains": {
+			domains:     []string{"/a/n0", "/a/n1", "/a/n2", "/b/n3", "/b/n4", "/b/n5"},
+			ranks:       []system.Rank{0, 1, 2, 3, 4, 5},
+			nSvc:        5,
+			expSvcRanks: []system.Rank{1, 2, 3, 4, 5},
+		},
+		"subset of ranks": {
+			domains:     []string{"/a/n0", "/a/n1", "/b/n2", "/b/n3", "/c/n4"},
+			ranks:       []system.Rank{1, 3},
+			nSvc:        3,
+			expSvcRanks: []system.Rank{1, 3},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			domains := mockPlanDomains(mockPlanMembers(t, tc.domains...))
+
+			gotSvcRanks := selectSvcRanks(domains, tc.ranks, tc.nSvc)
+			if diff := cmp.Diff(tc.expSvcRanks, gotSvcRanks); diff != "" {
+				t.Fatalf("unexpected service ranks (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_orderPoolRanks(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ranks    []system.Rank
+		svcRanks []system.Rank
+		expRanks []uint32
+	}{
+		"lowest ranks": {
+			ranks:    []system.Rank{3, 2, 1, 0},
+			svcRanks: []system.Rank{1, 2},
+			expRanks: []uint32{0, 1, 2, 3},
+		},
+		"service ranks first": {
+			ranks:    []system.Rank{0, 1, 2, 3, 4, 5},
+			svcRanks: []system.Rank{2, 5},
+			expRanks: []uint32{0, 2, 5, 1, 3, 4},
+		},
+		"without rank 0": {
+			ranks:    []system.Rank{1, 2, 3, 4},
+			svcRanks: []system.Rank{4},
+			expRanks: []uint32{4, 1, 2, 3},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotRanks := orderPoolRanks(tc.ranks, tc.svcRanks)
+			if diff := cmp.Diff(tc.expRanks, gotRanks); diff != "" {
+				t.Fatalf("unexpected ranks (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
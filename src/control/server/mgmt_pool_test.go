@@ -218,7 +218,7 @@ func TestServer_MgmtSvc_calculateCreateStorage(t *testing.T) {
 			svc := newTestMgmtSvc(t, log)
 			svc.harness.instances[0] = newTestEngine(log, false, srvCfg)
 
-			gotErr := svc.calculateCreateStorage(tc.in)
+			gotErr := svc.calculateCreateStorage(tc.in, len(tc.in.GetRanks()))
 			common.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
 				return
@@ -241,6 +241,7 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 		mgmtSvc       *mgmtSvc
 		setupMockDrpc func(_ *mgmtSvc, _ error)
 		targetCount   int
+		quotas        []*system.Quota
 		req           *mgmtpb.PoolCreateReq
 		expResp       *mgmtpb.PoolCreateResp
 		expErr        error
@@ -252,6 +253,15 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 			req:    &mgmtpb.PoolCreateReq{Uuid: mockUUID, Sys: "bad"},
 			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
 		},
+		"label is a UUID": {
+			targetCount: 8,
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:     mockUUID,
+				Name:     common.MockUUID(1),
+				Scmbytes: 100 * humanize.GiByte,
+			},
+			expErr: FaultPoolInvalidLabel(common.MockUUID(1), "label must not be a UUID"),
+		},
 		"missing superblock": {
 			mgmtSvc:     missingSB,
 			targetCount: 8,
@@ -343,6 +353,48 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 				TgtRanks:  []uint32{0, 1},
 			},
 		},
+		"dry run": {
+			targetCount: 8,
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:      common.MockUUID(0),
+				Scmbytes:  100 * humanize.GiByte,
+				Nvmebytes: 10 * humanize.TByte,
+				Dryrun:    true,
+			},
+			setupMockDrpc: func(svc *mgmtSvc, err error) {
+				setupMockDrpcClient(svc, nil, errors.New("unexpected dRPC call"))
+			},
+			expResp: &mgmtpb.PoolCreateResp{
+				SvcReps:   []uint32{1},
+				ScmBytes:  (100 * humanize.GiByte),
+				NvmeBytes: (10 * humanize.TByte),
+				TgtRanks:  []uint32{0, 1},
+			},
+		},
+		"group quota exceeded": {
+			targetCount: 8,
+			quotas: []*system.Quota{
+				{Type: system.QuotaTypeGroup, Name: "grp@", Limit: 200 * humanize.GiByte},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:      common.MockUUID(0),
+				User:      "foo@",
+				Usergroup: "grp@",
+				Scmbytes:  100 * humanize.GiByte,
+				Nvmebytes: 10 * humanize.TByte,
+			},
+			expErr: errors.New("would exceed the group quota"),
+		},
+		"too many ranks requested": {
+			targetCount: 8,
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:      common.MockUUID(0),
+				Scmbytes:  100 * humanize.GiByte,
+				Nvmebytes: 10 * humanize.TByte,
+				Numranks:  3,
+			},
+			expErr: errors.New("requires 3 ranks"),
+		},
 		"failed creation invalid ranks": {
 			targetCount: 1,
 			req: &mgmtpb.PoolCreateReq{
@@ -400,6 +452,11 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 					t.Fatal(err)
 				}
 			}
+			for _, q := range tc.quotas {
+				if err := tc.mgmtSvc.sysdb.SetQuota(q); err != nil {
+					t.Fatal(err)
+				}
+			}
 
 			if tc.setupMockDrpc == nil {
 				tc.setupMockDrpc = func(svc *mgmtSvc, err error) {
@@ -536,7 +593,7 @@ func TestServer_MgmtSvc_PoolDestroy(t *testing.T) {
 		},
 		"missing uuid": {
 			req:    &mgmtpb.PoolDestroyReq{},
-			expErr: errors.New("invalid UUID"),
+			expErr: errors.New("no pool label or UUID"),
 		},
 		"successful destroy": {
 			req:     &mgmtpb.PoolDestroyReq{Uuid: mockUUID},
@@ -634,7 +691,7 @@ func TestServer_MgmtSvc_PoolDrain(t *testing.T) {
 		},
 		"missing uuid": {
 			req:    &mgmtpb.PoolDrainReq{Rank: 2, Targetidx: []uint32{1, 2}},
-			expErr: errors.New("invalid UUID"),
+			expErr: errors.New("no pool label or UUID"),
 		},
 		"successful drained": {
 			req:     &mgmtpb.PoolDrainReq{Uuid: mockUUID, Rank: 2, Targetidx: []uint32{1, 2}},
@@ -681,9 +738,10 @@ func TestServer_MgmtSvc_PoolEvict(t *testing.T) {
 	missingSB.harness.instances[0]._superblock = nil
 	notAP := newTestMgmtSvc(t, testLog)
 	testPoolService := &system.PoolService{
-		PoolUUID: uuid.MustParse(mockUUID),
-		State:    system.PoolServiceStateReady,
-		Replicas: []system.Rank{0},
+		PoolUUID:  uuid.MustParse(mockUUID),
+		PoolLabel: "test-pool",
+		State:     system.PoolServiceStateReady,
+		Replicas:  []system.Rank{0},
 	}
 
 	for name, tc := range map[string]struct {
@@ -726,12 +784,20 @@ func TestServer_MgmtSvc_PoolEvict(t *testing.T) {
 		},
 		"missing uuid": {
 			req:    &mgmtpb.PoolEvictReq{},
-			expErr: errors.New("invalid UUID"),
+			expErr: errors.New("no pool label or UUID"),
+		},
+		"unknown label": {
+			req:    &mgmtpb.PoolEvictReq{Uuid: "unknown"},
+			expErr: errors.New("unable to find pool service with label"),
 		},
 		"successful evicted": {
 			req:     &mgmtpb.PoolEvictReq{Uuid: mockUUID},
 			expResp: &mgmtpb.PoolEvictResp{},
 		},
+		"successful evicted by label": {
+			req:     &mgmtpb.PoolEvictReq{Uuid: "test-pool"},
+			expResp: &mgmtpb.PoolEvictResp{},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -763,6 +829,17 @@ func TestServer_MgmtSvc_PoolEvict(t *testing.T) {
 			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
 				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
 			}
+
+			// Labels must be resolved to UUIDs before the request
+			// is forwarded to the I/O Engine.
+			mdc := tc.mgmtSvc.harness.instances[0]._drpcClient.(*mockDrpcClient)
+			gotReq := new(mgmtpb.PoolEvictReq)
+			if err := proto.Unmarshal(mdc.SendMsgInputCall.Body, gotReq); err != nil {
+				t.Fatal(err)
+			}
+			if gotReq.Uuid != mockUUID {
+				t.Fatalf("expected forwarded pool UUID %s, got %q", mockUUID, gotReq.Uuid)
+			}
 		})
 	}
 }
@@ -1367,21 +1444,56 @@ func TestServer_MgmtSvc_PoolSetProp_Label(t *testing.T) {
 	defaultLabel := "test-label"
 
 	for name, tc := range map[string]struct {
-		poolUUID string
-		label    string
-		expErr   error
+		poolID     string
+		label      string
+		drpcStatus int32
+		drpcErr    error
+		expErr     error
+		expStatus  int32
+		expLabel   string
 	}{
 		"labels must be unique": {
-			poolUUID: common.MockUUID(3),
+			poolID:   common.MockUUID(3),
 			label:    defaultLabel,
 			expErr:   FaultPoolDuplicateLabel(defaultLabel),
+			expLabel: defaultLabel,
 		},
 		"success": {
-			label: "unique-label",
+			label:    "unique-label",
+			expLabel: "unique-label",
+		},
+		"rename by label": {
+			poolID:   defaultLabel,
+			label:    "unique-label",
+			expLabel: "unique-label",
+		},
+		"unknown pool label": {
+			poolID:   "unknown",
+			label:    "unique-label",
+			expErr:   errors.New("unable to find pool service with label"),
+			expLabel: defaultLabel,
+		},
+		"label may not be a UUID": {
+			label:    common.MockUUID(4),
+			expErr:   FaultPoolInvalidLabel(common.MockUUID(4), "label must not be a UUID"),
+			expLabel: defaultLabel,
+		},
+		"label restored on dRPC failure": {
+			label:    "unique-label",
+			drpcErr:  errors.New("send failure"),
+			expErr:   errors.New("send failure"),
+			expLabel: defaultLabel,
+		},
+		"label restored on engine failure": {
+			label:      "unique-label",
+			drpcStatus: int32(drpc.DaosNoPermission),
+			expStatus:  int32(drpc.DaosNoPermission),
+			expLabel:   defaultLabel,
 		},
 		"pool label application should be idempotent": {
-			poolUUID: mockUUID,
+			poolID:   mockUUID,
 			label:    defaultLabel,
+			expLabel: defaultLabel,
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
@@ -1390,15 +1502,16 @@ func TestServer_MgmtSvc_PoolSetProp_Label(t *testing.T) {
 
 			ms := newTestMgmtSvc(t, log)
 			setupMockDrpcClient(ms, &mgmtpb.PoolSetPropResp{
+				Status: tc.drpcStatus,
 				Property: &mgmtpb.PoolSetPropResp_Number{
 					Number: drpc.PoolPropertyLabel,
 				},
 				Value: &mgmtpb.PoolSetPropResp_Strval{
 					Strval: tc.label,
-				}}, nil)
+				}}, tc.drpcErr)
 			addTestPools(t, ms.sysdb, mockUUID)
-			if tc.poolUUID != "" && tc.poolUUID != mockUUID {
-				addTestPools(t, ms.sysdb, tc.poolUUID)
+			if _, err := uuid.Parse(tc.poolID); err == nil && tc.poolID != mockUUID {
+				addTestPools(t, ms.sysdb, tc.poolID)
 			}
 			ps, err := ms.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
 			if err != nil {
@@ -1410,7 +1523,7 @@ func TestServer_MgmtSvc_PoolSetProp_Label(t *testing.T) {
 			}
 
 			req := propWithStrVal(propWithName(new(mgmtpb.PoolSetPropReq), "label"), tc.label)
-			req.Uuid = tc.poolUUID
+			req.Uuid = tc.poolID
 			if req.Uuid == "" {
 				req.Uuid = mockUUID
 			}
@@ -1418,13 +1531,13 @@ func TestServer_MgmtSvc_PoolSetProp_Label(t *testing.T) {
 				req.Sys = build.DefaultSystemName
 			}
 
-			_, gotErr := ms.PoolSetProp(context.TODO(), req)
+			gotResp, gotErr := ms.PoolSetProp(context.TODO(), req)
 			common.CmpErr(t, tc.expErr, gotErr)
-			if tc.expErr != nil {
-				return
+			if tc.expErr == nil && gotResp.GetStatus() != tc.expStatus {
+				t.Fatalf("expected status %d, got %d", tc.expStatus, gotResp.GetStatus())
 			}
 
-			found, err := ms.sysdb.FindPoolServiceByLabel(tc.label)
+			found, err := ms.sysdb.FindPoolServiceByLabel(tc.expLabel)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1436,6 +1549,68 @@ func TestServer_MgmtSvc_PoolSetProp_Label(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_PoolSetProp_LabelRollbackKeepsUpdates(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	ms := newTestMgmtSvc(t, log)
+	addTestPools(t, ms.sysdb, mockUUID)
+	ps, err := ms.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps.PoolLabel = "old-label"
+	if err := ms.sysdb.UpdatePoolService(ps); err != nil {
+		t.Fatal(err)
+	}
+
+	// The engine fails to apply the label after a delay, during which
+	// the pool service replicas are updated.
+	respBytes, err := proto.Marshal(&mgmtpb.PoolSetPropResp{Status: int32(drpc.DaosNoPermission)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := new(mockDrpcClientConfig)
+	cfg.setSendMsgResponse(drpc.Status_SUCCESS, respBytes, nil)
+	cfg.setResponseDelay(500 * time.Millisecond)
+	ms.harness.instances[0].setDrpcClient(newMockDrpcClient(cfg))
+
+	updated := make(chan error, 1)
+	go func() {
+		for {
+			cur, err := ms.sysdb.FindPoolServiceByLabel("new-label")
+			if err != nil {
+				time.Sleep(time.Millisecond)
+				continue
+			}
+			cur.Replicas = []system.Rank{1, 2, 3}
+			updated <- ms.sysdb.UpdatePoolService(cur)
+			return
+		}
+	}()
+
+	req := propWithStrVal(propWithName(new(mgmtpb.PoolSetPropReq), "label"), "new-label")
+	req.Uuid = mockUUID
+	req.Sys = build.DefaultSystemName
+	resp, err := ms.PoolSetProp(context.TODO(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, int32(drpc.DaosNoPermission), resp.GetStatus(), "unexpected status")
+	if err := <-updated; err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ms.sysdb.FindPoolServiceByUUID(ps.PoolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, "old-label", got.PoolLabel, "label not restored")
+	if diff := cmp.Diff([]system.Rank{1, 2, 3}, got.Replicas); diff != "" {
+		t.Fatalf("concurrent replicas update lost (-want, +got)\n%s\n", diff)
+	}
+}
+
 func TestServer_MgmtSvc_PoolSetProp(t *testing.T) {
 	lastCall := func(svc *mgmtSvc) *drpc.Call {
 		mi := svc.harness.instances[0]
@@ -1737,3 +1912,155 @@ func TestServer_MgmtSvc_PoolSetProp(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_MgmtSvc_PoolGetProp(t *testing.T) {
+	lastCall := func(svc *mgmtSvc) *drpc.Call {
+		mi := svc.harness.instances[0]
+		if mi == nil || mi._drpcClient == nil {
+			return nil
+		}
+		return mi._drpcClient.(*mockDrpcClient).SendMsgInputCall
+	}
+
+	allNumbers := []uint32{
+		drpc.PoolPropertyLabel,
+		drpc.PoolPropertySpaceReclaim,
+		drpc.PoolPropertyReservedSpace,
+		drpc.PoolPropertySelfHealing,
+		drpc.PoolPropertyOwner,
+		drpc.PoolPropertyOwnerGroup,
+	}
+
+	for name, tc := range map[string]struct {
+		setupMockDrpc func(_ *mgmtSvc, _ error)
+		req           *mgmtpb.PoolGetPropReq
+		expNumbers    []uint32
+		drpcResp      *mgmtpb.PoolGetPropResp
+		expResp       *mgmtpb.PoolGetPropResp
+		expErr        error
+	}{
+		"wrong system": {
+			req:    &mgmtpb.PoolGetPropReq{Uuid: mockUUID, Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"garbage resp": {
+			req: &mgmtpb.PoolGetPropReq{},
+			setupMockDrpc: func(svc *mgmtSvc, err error) {
+				setupMockDrpcClientBytes(svc, makeBadBytes(42), err)
+			},
+			expErr: errors.New("unmarshal"),
+		},
+		"unhandled property": {
+			req:    &mgmtpb.PoolGetPropReq{Names: []string{"label", "unknown"}},
+			expErr: errors.New("unhandled pool property \"unknown\""),
+		},
+		"unhandled response property": {
+			req: &mgmtpb.PoolGetPropReq{Names: []string{"label"}},
+			drpcResp: &mgmtpb.PoolGetPropResp{
+				Properties: []*mgmtpb.PoolProperty{
+					{Number: 4242},
+				},
+			},
+			expErr: errors.New("unhandled pool property number 4242"),
+		},
+		"engine failure": {
+			req:        &mgmtpb.PoolGetPropReq{Names: []string{"label"}},
+			expNumbers: []uint32{drpc.PoolPropertyLabel},
+			drpcResp:   &mgmtpb.PoolGetPropResp{Status: int32(drpc.DaosNonexistant)},
+			expResp:    &mgmtpb.PoolGetPropResp{Status: int32(drpc.DaosNonexistant)},
+		},
+		"selected properties": {
+			req:        &mgmtpb.PoolGetPropReq{Names: []string{" Reclaim", "space_rb", "reclaim"}},
+			expNumbers: []uint32{drpc.PoolPropertySpaceReclaim, drpc.PoolPropertyReservedSpace},
+			drpcResp: &mgmtpb.PoolGetPropResp{
+				Properties: []*mgmtpb.PoolProperty{
+					{Number: drpc.PoolPropertySpaceReclaim, Numval: drpc.PoolSpaceReclaimTime},
+					{Number: drpc.PoolPropertyReservedSpace, Numval: 5},
+				},
+			},
+			expResp: &mgmtpb.PoolGetPropResp{
+				Properties: []*mgmtpb.PoolProperty{
+					{Name: "reclaim", Number: drpc.PoolPropertySpaceReclaim, Numval: drpc.PoolSpaceReclaimTime, Strval: "time"},
+					{Name: "space_rb", Number: drpc.PoolPropertyReservedSpace, Numval: 5, Strval: "5%"},
+				},
+			},
+		},
+		"all properties": {
+			req:        &mgmtpb.PoolGetPropReq{},
+			expNumbers: allNumbers,
+			drpcResp: &mgmtpb.PoolGetPropResp{
+				Properties: []*mgmtpb.PoolProperty{
+					{Number: drpc.PoolPropertyLabel, Strval: "mypool"},
+					{Number: drpc.PoolPropertySpaceReclaim, Numval: drpc.PoolSpaceReclaimLazy},
+					{Number: drpc.PoolPropertyReservedSpace},
+					{Number: drpc.PoolPropertySelfHealing, Numval: drpc.PoolSelfHealingAutoExclude | drpc.PoolSelfHealingAutoRebuild},
+					{Number: drpc.PoolPropertyOwner, Strval: "alice@"},
+					{Number: drpc.PoolPropertyOwnerGroup, Strval: "admins@"},
+				},
+			},
+			expResp: &mgmtpb.PoolGetPropResp{
+				Properties: []*mgmtpb.PoolProperty{
+					{Name: "label", Number: drpc.PoolPropertyLabel, Strval: "mypool"},
+					{Name: "reclaim", Number: drpc.PoolPropertySpaceReclaim, Numval: drpc.PoolSpaceReclaimLazy, Strval: "lazy"},
+					{Name: "space_rb", Number: drpc.PoolPropertyReservedSpace, Strval: "0%"},
+					{Name: "self_heal", Number: drpc.PoolPropertySelfHealing, Numval: drpc.PoolSelfHealingAutoExclude | drpc.PoolSelfHealingAutoRebuild, Strval: "exclude,rebuild"},
+					{Name: "owner", Number: drpc.PoolPropertyOwner, Strval: "alice@"},
+					{Name: "group", Number: drpc.PoolPropertyOwnerGroup, Strval: "admins@"},
+				},
+			},
+		},
+		"self_heal none": {
+			req:        &mgmtpb.PoolGetPropReq{Names: []string{"self_heal"}},
+			expNumbers: []uint32{drpc.PoolPropertySelfHealing},
+			drpcResp: &mgmtpb.PoolGetPropResp{
+				Properties: []*mgmtpb.PoolProperty{
+					{Number: drpc.PoolPropertySelfHealing},
+				},
+			},
+			expResp: &mgmtpb.PoolGetPropResp{
+				Properties: []*mgmtpb.PoolProperty{
+					{Name: "self_heal", Number: drpc.PoolPropertySelfHealing, Strval: "none"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			ms := newTestMgmtSvc(t, log)
+			addTestPools(t, ms.sysdb, mockUUID)
+			if tc.setupMockDrpc == nil {
+				tc.setupMockDrpc = func(svc *mgmtSvc, err error) {
+					setupMockDrpcClient(svc, tc.drpcResp, tc.expErr)
+				}
+			}
+			tc.setupMockDrpc(ms, tc.expErr)
+
+			if tc.req.GetUuid() == "" {
+				tc.req.Uuid = mockUUID
+			}
+			if tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+			gotResp, gotErr := ms.PoolGetProp(context.TODO(), tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+
+			// Also verify that the property names are resolved to C identifiers.
+			gotReq := new(mgmtpb.PoolGetPropReq)
+			if err := proto.Unmarshal(lastCall(ms).Body, gotReq); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expNumbers, gotReq.Numbers); diff != "" {
+				t.Fatalf("unexpected dRPC call (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+// quotaPrincipal returns the quota type and principal name for a request
+// that must supply exactly one of a user or a group.
+func quotaPrincipal(user, group string) (system.QuotaType, string, error) {
+	switch {
+	case user != "" && group != "":
+		return 0, "", errors.New("quota request may not specify both user and group")
+	case user != "":
+		return system.QuotaTypeUser, user, nil
+	case group != "":
+		return system.QuotaTypeGroup, group, nil
+	default:
+		return 0, "", errors.New("quota request must specify a user or group")
+	}
+}
+
+// getQuota returns the quota and current usage for a user or group. The
+// limit is zero if no quota has been set.
+func (svc *mgmtSvc) getQuota(qt system.QuotaType, name string) (*mgmtpb.Quota, error) {
+	quota, err := svc.sysdb.FindQuota(qt, name)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := svc.sysdb.QuotaUsage(qt, name)
+	if err != nil {
+		return nil, err
+	}
+
+	pbQuota := &mgmtpb.Quota{
+		Pools:    uint32(usage.Pools),
+		ScmUsed:  usage.SCM,
+		NvmeUsed: usage.NVMe,
+	}
+	if quota != nil {
+		pbQuota.Limit = quota.Limit
+	}
+	if qt == system.QuotaTypeGroup {
+		pbQuota.Group = name
+	} else {
+		pbQuota.User = name
+	}
+
+	return pbQuota, nil
+}
+
+// poolQuotaFault converts a quota error returned by the system database
+// into the fault reported to the user. Other errors are returned unchanged.
+func poolQuotaFault(err error) error {
+	if e, ok := errors.Cause(err).(*system.ErrQuotaExceeded); ok {
+		return FaultPoolQuotaExceeded(e.Quota, e.Used, e.Requested)
+	}
+	return err
+}
+
+// QuotaSet implements the method defined for the Management Service.
+//
+// Set the limit on the total pool capacity that may be owned by a user or
+// group. A zero limit removes the quota.
+func (svc *mgmtSvc) QuotaSet(ctx context.Context, req *mgmtpb.QuotaSetReq) (*mgmtpb.QuotaResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("Received QuotaSet RPC: %+v", req)
+
+	qt, name, err := quotaPrincipal(req.GetUser(), req.GetGroup())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.sysdb.SetQuota(&system.Quota{
+		Type:  qt,
+		Name:  name,
+		Limit: req.GetLimit(),
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to set %s quota", qt)
+	}
+
+	quota, err := svc.getQuota(qt, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.QuotaResp{Quotas: []*mgmtpb.Quota{quota}}, nil
+}
+
+// QuotaGet implements the method defined for the Management Service.
+//
+// Return the quota and current usage for a user or group.
+func (svc *mgmtSvc) QuotaGet(ctx context.Context, req *mgmtpb.QuotaGetReq) (*mgmtpb.QuotaResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("Received QuotaGet RPC: %+v", req)
+
+	qt, name, err := quotaPrincipal(req.GetUser(), req.GetGroup())
+	if err != nil {
+		return nil, err
+	}
+
+	quota, err := svc.getQuota(qt, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.QuotaResp{Quotas: []*mgmtpb.Quota{quota}}, nil
+}
+
+// QuotaList implements the method defined for the Management Service.
+//
+// Return all quotas along with their current usage.
+func (svc *mgmtSvc) QuotaList(ctx context.Context, req *mgmtpb.QuotaListReq) (*mgmtpb.QuotaResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debug("Received QuotaList RPC")
+
+	quotas, err := svc.sysdb.QuotaList()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.QuotaResp)
+	for _, q := range quotas {
+		quota, err := svc.getQuota(q.Type, q.Name)
+		if err != nil {
+			return nil, err
+		}
+		resp.Quotas = append(resp.Quotas, quota)
+	}
+
+	return resp, nil
+}
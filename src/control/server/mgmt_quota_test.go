@@ -0,0 +1,281 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+// addQuotaTestPool adds a ready pool service owned by the given user and
+// group with 1 byte of SCM and 10 bytes of NVMe on each of ranks 0 and 1.
+func addQuotaTestPool(t *testing.T, svc *mgmtSvc, user, group string) *system.PoolService {
+	t.Helper()
+
+	ps := system.NewPoolService(uuid.New(), 1, 10, []system.Rank{0, 1})
+	ps.PoolLabel = ps.PoolUUID.String()
+	ps.OwnerUser = user
+	ps.OwnerGroup = group
+	ps.State = system.PoolServiceStateReady
+	ps.Replicas = []system.Rank{0}
+	if err := svc.sysdb.AddPoolService(ps); err != nil {
+		t.Fatal(err)
+	}
+	return ps
+}
+
+func TestServer_MgmtSvc_QuotaSet(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *mgmtpb.QuotaSetReq
+		expResp *mgmtpb.QuotaResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.QuotaSetReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"no principal": {
+			req:    &mgmtpb.QuotaSetReq{Limit: 1},
+			expErr: errors.New("must specify a user or group"),
+		},
+		"both user and group": {
+			req:    &mgmtpb.QuotaSetReq{User: "foo@", Group: "grp@", Limit: 1},
+			expErr: errors.New("may not specify both"),
+		},
+		"user quota": {
+			req: &mgmtpb.QuotaSetReq{User: "foo@", Limit: 100},
+			expResp: &mgmtpb.QuotaResp{
+				Quotas: []*mgmtpb.Quota{
+					{User: "foo@", Limit: 100, Pools: 1, ScmUsed: 2, NvmeUsed: 20},
+				},
+			},
+		},
+		"group quota": {
+			req: &mgmtpb.QuotaSetReq{Group: "grp@", Limit: 100},
+			expResp: &mgmtpb.QuotaResp{
+				Quotas: []*mgmtpb.Quota{
+					{Group: "grp@", Limit: 100, Pools: 1, ScmUsed: 2, NvmeUsed: 20},
+				},
+			},
+		},
+		"remove quota": {
+			req: &mgmtpb.QuotaSetReq{User: "foo@"},
+			expResp: &mgmtpb.QuotaResp{
+				Quotas: []*mgmtpb.Quota{
+					{User: "foo@", Pools: 1, ScmUsed: 2, NvmeUsed: 20},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addQuotaTestPool(t, svc, "foo@", "grp@")
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.QuotaSet(context.TODO(), tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_QuotaGetList(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	addQuotaTestPool(t, svc, "foo@", "grp@")
+	addQuotaTestPool(t, svc, "bar@", "grp@")
+	for _, q := range []*system.Quota{
+		{Type: system.QuotaTypeGroup, Name: "grp@", Limit: 1000},
+		{Type: system.QuotaTypeUser, Name: "foo@", Limit: 100},
+	} {
+		if err := svc.sysdb.SetQuota(q); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req     *mgmtpb.QuotaGetReq
+		expResp *mgmtpb.QuotaResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no principal": {
+			req:    &mgmtpb.QuotaGetReq{},
+			expErr: errors.New("must specify a user or group"),
+		},
+		"user with quota": {
+			req: &mgmtpb.QuotaGetReq{User: "foo@"},
+			expResp: &mgmtpb.QuotaResp{
+				Quotas: []*mgmtpb.Quota{
+					{User: "foo@", Limit: 100, Pools: 1, ScmUsed: 2, NvmeUsed: 20},
+				},
+			},
+		},
+		"user without quota": {
+			req: &mgmtpb.QuotaGetReq{User: "bar@"},
+			expResp: &mgmtpb.QuotaResp{
+				Quotas: []*mgmtpb.Quota{
+					{User: "bar@", Pools: 1, ScmUsed: 2, NvmeUsed: 20},
+				},
+			},
+		},
+		"group with quota": {
+			req: &mgmtpb.QuotaGetReq{Group: "grp@"},
+			expResp: &mgmtpb.QuotaResp{
+				Quotas: []*mgmtpb.Quota{
+					{Group: "grp@", Limit: 1000, Pools: 2, ScmUsed: 4, NvmeUsed: 40},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.QuotaGet(context.TODO(), tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+
+	gotResp, err := svc.QuotaList(context.TODO(), &mgmtpb.QuotaListReq{Sys: build.DefaultSystemName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expResp := &mgmtpb.QuotaResp{
+		Quotas: []*mgmtpb.Quota{
+			{User: "foo@", Limit: 100, Pools: 1, ScmUsed: 2, NvmeUsed: 20},
+			{Group: "grp@", Limit: 1000, Pools: 2, ScmUsed: 4, NvmeUsed: 40},
+		},
+	}
+	if diff := cmp.Diff(expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("unexpected list response (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestServer_MgmtSvc_PoolExtendQuota(t *testing.T) {
+	for name, tc := range map[string]struct {
+		limit       uint64
+		ranks       []uint32
+		drpcResp    *mgmtpb.PoolExtendResp
+		drpcErr     error
+		expErr      error
+		expStatus   int32
+		expCurRanks string
+	}{
+		"quota exceeded": {
+			limit:       30,
+			ranks:       []uint32{2},
+			expErr:      errors.New("would exceed the user quota"),
+			expCurRanks: "[0-1]",
+		},
+		"reservation released on dRPC failure": {
+			limit:       33,
+			ranks:       []uint32{2},
+			drpcErr:     errors.New("remote failed"),
+			expErr:      errors.New("remote failed"),
+			expCurRanks: "[0-1]",
+		},
+		"reservation released on extend failure": {
+			limit:       33,
+			ranks:       []uint32{2},
+			drpcResp:    &mgmtpb.PoolExtendResp{Status: -1},
+			expStatus:   -1,
+			expCurRanks: "[0-1]",
+		},
+		"existing ranks not counted": {
+			limit:       33,
+			ranks:       []uint32{1, 2},
+			expCurRanks: "[0-2]",
+		},
+		"no quota": {
+			ranks:       []uint32{2, 3},
+			expCurRanks: "[0-3]",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for i := uint32(0); i < 4; i++ {
+				if _, err := svc.membership.Add(system.MockMember(t, i, system.MemberStateJoined)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			ps := addQuotaTestPool(t, svc, "foo@", "grp@")
+			if tc.limit > 0 {
+				if err := svc.sysdb.SetQuota(&system.Quota{
+					Type:  system.QuotaTypeUser,
+					Name:  "foo@",
+					Limit: tc.limit,
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.drpcResp == nil {
+				tc.drpcResp = &mgmtpb.PoolExtendResp{}
+			}
+			setupMockDrpcClient(svc, tc.drpcResp, tc.drpcErr)
+
+			gotResp, gotErr := svc.PoolExtend(context.TODO(), &mgmtpb.PoolExtendReq{
+				Sys:   build.DefaultSystemName,
+				Uuid:  ps.PoolUUID.String(),
+				Ranks: tc.ranks,
+			})
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr == nil {
+				common.AssertEqual(t, tc.expStatus, gotResp.GetStatus(), "unexpected status")
+			}
+
+			gotPs, err := svc.sysdb.FindPoolServiceByUUID(ps.PoolUUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gotPs.Storage.CurrentRankStr != tc.expCurRanks {
+				t.Fatalf("expected current ranks %s, got %s",
+					tc.expCurRanks, gotPs.Storage.CurrentRankStr)
+			}
+		})
+	}
+}
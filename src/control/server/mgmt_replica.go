@@ -0,0 +1,161 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/build"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+// replicaStartFn starts a MS replica on the local server with the supplied
+// replica set.
+type replicaStartFn func(replicas []*net.TCPAddr) error
+
+func (svc *mgmtSvc) replicaResp() (*mgmtpb.SystemReplicaResp, error) {
+	leader, replicas, err := svc.sysdb.ReplicaList()
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.SystemReplicaResp{
+		Leader:   leader,
+		Replicas: replicas,
+	}, nil
+}
+
+// SystemReplicaList implements the method defined for the Management Service.
+//
+// List the current MS replicas, as recorded in the raft configuration.
+func (svc *mgmtSvc) SystemReplicaList(ctx context.Context, req *mgmtpb.SystemReplicaListReq) (*mgmtpb.SystemReplicaResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debug("Received SystemReplicaList RPC")
+
+	return svc.replicaResp()
+}
+
+// SystemReplicaAdd implements the method defined for the Management Service.
+//
+// Add a system member's server to the set of MS replicas. A replica is
+// first started on the server and then added to the raft configuration,
+// after which it will receive a copy of the system database.
+func (svc *mgmtSvc) SystemReplicaAdd(ctx context.Context, req *mgmtpb.SystemReplicaReq) (*mgmtpb.SystemReplicaResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("Received SystemReplicaAdd RPC: %+v", req)
+
+	addr, err := net.ResolveTCPAddr("tcp", req.GetAddr())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid replica address %q", req.GetAddr())
+	}
+
+	// Only servers that are already running system members may be added,
+	// as the replica is started by the server and its system database is
+	// stored on the SCM of its first engine.
+	if _, err := svc.sysdb.FindMembersByAddr(addr); err != nil {
+		return nil, errors.Wrapf(err, "cannot add %s as a %s replica", addr,
+			build.ManagementServiceName)
+	}
+
+	_, replicas, err := svc.sysdb.LeaderQuery()
+	if err != nil {
+		return nil, err
+	}
+	for _, replica := range replicas {
+		if replica == addr.String() {
+			return nil, errors.Errorf("%s is already a %s replica", addr,
+				build.ManagementServiceName)
+		}
+	}
+
+	startReq := &control.ReplicaStartReq{
+		Replicas: append(replicas, addr.String()),
+	}
+	startReq.SetHostList([]string{addr.String()})
+	startReq.SetSystem(svc.sysdb.SystemName())
+	if err := control.ReplicaStart(ctx, svc.rpcClient, startReq); err != nil {
+		return nil, errors.Wrapf(err, "failed to start %s replica on %s",
+			build.ManagementServiceName, addr)
+	}
+
+	if err := svc.sysdb.AddReplica(addr); err != nil {
+		return nil, err
+	}
+	svc.log.Infof("added %s as a %s replica", addr, build.ManagementServiceName)
+
+	return svc.replicaResp()
+}
+
+// SystemReplicaRemove implements the method defined for the Management Service.
+//
+// Remove a server from the set of MS replicas. The current leader may not
+// be removed.
+func (svc *mgmtSvc) SystemReplicaRemove(ctx context.Context, req *mgmtpb.SystemReplicaReq) (*mgmtpb.SystemReplicaResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("Received SystemReplicaRemove RPC: %+v", req)
+
+	addr, err := net.ResolveTCPAddr("tcp", req.GetAddr())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid replica address %q", req.GetAddr())
+	}
+
+	if err := svc.sysdb.RemoveReplica(addr); err != nil {
+		return nil, err
+	}
+	svc.log.Infof("removed %s as a %s replica", addr, build.ManagementServiceName)
+
+	return svc.replicaResp()
+}
+
+// ReplicaStart implements the method defined for the Management Service.
+//
+// Start a MS replica on this server in preparation for it being added to
+// the set of MS replicas by the leader.
+func (svc *mgmtSvc) ReplicaStart(ctx context.Context, req *mgmtpb.ReplicaStartReq) (*mgmtpb.ReplicaStartResp, error) {
+	if err := svc.checkSystemRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("Received ReplicaStart RPC: %+v", req)
+
+	if svc.startReplica == nil {
+		return nil, errors.New("replica start not supported")
+	}
+
+	replicas := make([]*net.TCPAddr, 0, len(req.GetReplicas()))
+	for _, replica := range req.GetReplicas() {
+		addr, err := net.ResolveTCPAddr("tcp", replica)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid replica address %q", replica)
+		}
+		replicas = append(replicas, addr)
+	}
+
+	if err := svc.startReplica(replicas); err != nil {
+		return nil, errors.Wrap(err, "failed to start system db")
+	}
+
+	return new(mgmtpb.ReplicaStartResp), nil
+}
+
+// newReplicaStarter returns a replicaStartFn that starts the system db
+// replica with the lifetime of the supplied context.
+func newReplicaStarter(ctx context.Context, sysdb *system.Database) replicaStartFn {
+	return func(replicas []*net.TCPAddr) error {
+		return sysdb.StartReplica(ctx, replicas)
+	}
+}
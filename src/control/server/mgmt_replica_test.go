@@ -0,0 +1,292 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+func TestServer_MgmtSvc_SystemReplicaList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req        *mgmtpb.SystemReplicaListReq
+		notReplica bool
+		expResp    *mgmtpb.SystemReplicaResp
+		expErr     error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.SystemReplicaListReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"not replica": {
+			req:        &mgmtpb.SystemReplicaListReq{},
+			notReplica: true,
+			expErr:     errors.New("replica"),
+		},
+		"success": {
+			req: &mgmtpb.SystemReplicaListReq{},
+			expResp: &mgmtpb.SystemReplicaResp{
+				Replicas: []string{"127.0.0.1:10001"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.notReplica {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.SystemReplicaList(context.TODO(), tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemReplicaAdd(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req      *mgmtpb.SystemReplicaReq
+		startErr error
+		expResp  *mgmtpb.SystemReplicaResp
+		expErr   error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"bad address": {
+			req:    &mgmtpb.SystemReplicaReq{Addr: "foo:bar"},
+			expErr: errors.New("invalid replica address"),
+		},
+		"not a member": {
+			req:    &mgmtpb.SystemReplicaReq{Addr: "127.0.0.3:10001"},
+			expErr: errors.New("cannot add"),
+		},
+		"already a replica": {
+			req:    &mgmtpb.SystemReplicaReq{Addr: "127.0.0.1:10001"},
+			expErr: errors.New("already a"),
+		},
+		"replica start fails": {
+			req:      &mgmtpb.SystemReplicaReq{Addr: "127.0.0.2:10001"},
+			startErr: errors.New("start failed"),
+			expErr:   errors.New("start failed"),
+		},
+		"success": {
+			req: &mgmtpb.SystemReplicaReq{Addr: "127.0.0.2:10001"},
+			expResp: &mgmtpb.SystemReplicaResp{
+				Replicas: []string{"127.0.0.1:10001", "127.0.0.2:10001"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for _, rank := range []uint32{1, 2} {
+				m := system.MockMember(t, rank, system.MemberStateJoined)
+				if _, err := svc.membership.Add(m); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var startMsg proto.Message = &mgmtpb.ReplicaStartResp{}
+			if tc.startErr != nil {
+				startMsg = nil
+			}
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: &control.UnaryResponse{
+					Responses: []*control.HostResponse{
+						{
+							Addr:    "127.0.0.2:10001",
+							Message: startMsg,
+							Error:   tc.startErr,
+						},
+					},
+				},
+			})
+			svc.rpcClient = mi
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.SystemReplicaAdd(context.TODO(), tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemReplicaRemove(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *mgmtpb.SystemReplicaReq
+		expResp *mgmtpb.SystemReplicaResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"bad address": {
+			req:    &mgmtpb.SystemReplicaReq{Addr: "foo:bar"},
+			expErr: errors.New("invalid replica address"),
+		},
+		"not a replica": {
+			req:    &mgmtpb.SystemReplicaReq{Addr: "127.0.0.3:10001"},
+			expErr: errors.New("is not a"),
+		},
+		"current leader": {
+			req:    &mgmtpb.SystemReplicaReq{Addr: "127.0.0.1:10001"},
+			expErr: errors.New("cannot remove the current"),
+		},
+		"success": {
+			req: &mgmtpb.SystemReplicaReq{Addr: "127.0.0.2:10001"},
+			expResp: &mgmtpb.SystemReplicaResp{
+				Replicas: []string{"127.0.0.1:10001"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			svc.rpcClient = control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: &control.UnaryResponse{
+					Responses: []*control.HostResponse{
+						{Addr: "127.0.0.2:10001", Message: &mgmtpb.ReplicaStartResp{}},
+					},
+				},
+			})
+			m := system.MockMember(t, 2, system.MemberStateJoined)
+			if _, err := svc.membership.Add(m); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := svc.SystemReplicaAdd(context.TODO(), &mgmtpb.SystemReplicaReq{
+				Sys:  build.DefaultSystemName,
+				Addr: "127.0.0.2:10001",
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.SystemReplicaRemove(context.TODO(), tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, common.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_ReplicaStart(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req         *mgmtpb.ReplicaStartReq
+		noStarter   bool
+		startErr    error
+		expReplicas []string
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.ReplicaStartReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"not supported": {
+			req:       &mgmtpb.ReplicaStartReq{},
+			noStarter: true,
+			expErr:    errors.New("not supported"),
+		},
+		"bad address": {
+			req:    &mgmtpb.ReplicaStartReq{Replicas: []string{"foo:bar"}},
+			expErr: errors.New("invalid replica address"),
+		},
+		"start fails": {
+			req:      &mgmtpb.ReplicaStartReq{Replicas: []string{"127.0.0.1:10001"}},
+			startErr: errors.New("start failed"),
+			expErr:   errors.New("start failed"),
+		},
+		"success": {
+			req: &mgmtpb.ReplicaStartReq{
+				Replicas: []string{"127.0.0.1:10001", "127.0.0.2:10001"},
+			},
+			expReplicas: []string{"127.0.0.1:10001", "127.0.0.2:10001"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvcNonReplica(t, log)
+			var gotReplicas []string
+			if !tc.noStarter {
+				svc.startReplica = func(replicas []*net.TCPAddr) error {
+					for _, replica := range replicas {
+						gotReplicas = append(gotReplicas, replica.String())
+					}
+					return tc.startErr
+				}
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			_, gotErr := svc.ReplicaStart(context.TODO(), tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expReplicas, gotReplicas); diff != "" {
+				t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
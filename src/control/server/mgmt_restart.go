@@ -0,0 +1,360 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common/proto/convert"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+const (
+	// defaultRestartStepTimeout is the time allowed for the ranks in a
+	// restart step to rejoin the system and for rebuild of the affected
+	// pools to complete, if not specified in the request.
+	defaultRestartStepTimeout = 10 * time.Minute
+
+	restartPhasePrep    = "prep shutdown"
+	restartPhaseStop    = "stop"
+	restartPhaseStart   = "start"
+	restartPhaseJoin    = "join"
+	restartPhaseRebuild = "rebuild"
+	restartPhaseDone    = "done"
+	restartPhaseAborted = "aborted"
+)
+
+// restartPollInterval is the interval at which member and pool state are
+// checked while waiting for a restart step to settle.
+var restartPollInterval = time.Second
+
+type (
+	// restartStep describes a set of ranks to be restarted together.
+	restartStep struct {
+		domain string
+		ranks  *system.RankSet
+	}
+
+	// restartReportFn is called to report the progress of a restart step.
+	restartReportFn func(phase string, results system.MemberResults) error
+)
+
+// rankFromDomain returns the rank represented by a rank fault domain leaf.
+func rankFromDomain(fd *system.FaultDomain) (system.Rank, bool) {
+	var rank uint32
+	if _, err := fmt.Sscanf(fd.BottomLevel(), "rank%d", &rank); err != nil {
+		return system.NilRank, false
+	}
+	return system.Rank(rank), true
+}
+
+// restartSteps splits the supplied ranks into the steps of a restart.
+//
+// A non-rolling restart consists of a single step. A rolling restart
+// works through the fault domain tree, restarting the ranks in one
+// lowest-level fault domain (usually a host) per step. If a maximum
+// number of ranks per step is given, ranks are instead taken in fault
+// domain order in batches of that size.
+func restartSteps(tree *system.FaultDomainTree, ranks *system.RankSet, rolling bool, perStep int) []*restartStep {
+	if !rolling {
+		return []*restartStep{{domain: "", ranks: ranks}}
+	}
+
+	requested := make(map[system.Rank]bool)
+	for _, rank := range ranks.Ranks() {
+		requested[rank] = true
+	}
+
+	var steps []*restartStep
+	var walk func(node *system.FaultDomainTree)
+	walk = func(node *system.FaultDomainTree) {
+		var domainRanks []system.Rank
+		for _, child := range node.Children {
+			rank, isRank := rankFromDomain(child.Domain)
+			if !isRank {
+				walk(child)
+				continue
+			}
+			if !requested[rank] {
+				continue
+			}
+			delete(requested, rank)
+
+			// Ranks attached directly to the root have no fault
+			// domain information, so restart them one at a time.
+			if node.IsRoot() {
+				steps = append(steps, &restartStep{
+					domain: node.Domain.String(),
+					ranks:  system.RankSetFromRanks([]system.Rank{rank}),
+				})
+				continue
+			}
+			domainRanks = append(domainRanks, rank)
+		}
+		if len(domainRanks) > 0 {
+			steps = append(steps, &restartStep{
+				domain: node.Domain.String(),
+				ranks:  system.RankSetFromRanks(domainRanks),
+			})
+		}
+	}
+	if tree != nil {
+		walk(tree)
+	}
+
+	// Any ranks not found in the tree are restarted individually.
+	for _, rank := range ranks.Ranks() {
+		if requested[rank] {
+			steps = append(steps, &restartStep{
+				ranks: system.RankSetFromRanks([]system.Rank{rank}),
+			})
+		}
+	}
+
+	if perStep <= 0 {
+		return steps
+	}
+
+	var batched []*restartStep
+	var batch []system.Rank
+	for _, step := range steps {
+		for _, rank := range step.ranks.Ranks() {
+			batch = append(batch, rank)
+			if len(batch) == perStep {
+				batched = append(batched, &restartStep{ranks: system.RankSetFromRanks(batch)})
+				batch = nil
+			}
+		}
+	}
+	if len(batch) > 0 {
+		batched = append(batched, &restartStep{ranks: system.RankSetFromRanks(batch)})
+	}
+
+	return batched
+}
+
+// waitForJoin blocks until all of the supplied ranks have rejoined the
+// system or the context is done.
+func (svc *mgmtSvc) waitForJoin(ctx context.Context, ranks *system.RankSet) error {
+	for {
+		var notJoined []system.Rank
+		for _, rank := range ranks.Ranks() {
+			m, err := svc.membership.Get(rank)
+			if err != nil {
+				return err
+			}
+			if m.State() != system.MemberStateJoined {
+				notJoined = append(notJoined, rank)
+			}
+		}
+		if len(notJoined) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "ranks %s did not rejoin",
+				system.RankSetFromRanks(notJoined))
+		case <-time.After(restartPollInterval):
+		}
+	}
+}
+
+// waitForRebuild blocks until no rebuild is in progress for any ready pool
+// with storage or service replicas on the supplied ranks, or the context
+// is done.
+func (svc *mgmtSvc) waitForRebuild(ctx context.Context, ranks *system.RankSet) error {
+	pools := make(map[uuid.UUID]bool)
+	for _, rank := range ranks.Ranks() {
+		found, err := svc.sysdb.FindPoolServicesByRank(rank)
+		if err != nil {
+			return err
+		}
+		for _, ps := range found {
+			if ps.State == system.PoolServiceStateReady {
+				pools[ps.PoolUUID] = true
+			}
+		}
+	}
+
+	for len(pools) > 0 {
+		for poolUUID := range pools {
+			resp, err := svc.PoolQuery(ctx, &mgmtpb.PoolQueryReq{
+				Sys:  svc.sysdb.SystemName(),
+				Uuid: poolUUID.String(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "pool %s query failed", poolUUID)
+			}
+			if resp.GetStatus() != 0 {
+				return errors.Wrapf(drpc.DaosStatus(resp.GetStatus()), "pool %s query failed", poolUUID)
+			}
+
+			rb := resp.GetRebuild()
+			if rb.GetStatus() != 0 {
+				return errors.Wrapf(drpc.DaosStatus(rb.GetStatus()), "pool %s rebuild failed", poolUUID)
+			}
+			if rb.GetState() != mgmtpb.PoolRebuildStatus_BUSY {
+				delete(pools, poolUUID)
+			}
+		}
+		if len(pools) == 0 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "rebuild of %d pool(s) did not complete", len(pools))
+		case <-time.After(restartPollInterval):
+		}
+	}
+
+	return nil
+}
+
+// restartRanks stops and restarts the ranks in the step, then waits for them
+// to rejoin the system and for any resulting pool rebuilds to complete.
+func (svc *mgmtSvc) restartRanks(ctx context.Context, step *restartStep, force bool, timeout time.Duration, report restartReportFn) error {
+	fanReq := fanoutRequest{
+		Ranks: step.ranks.String(),
+		Force: force,
+	}
+
+	for _, phase := range []struct {
+		name         string
+		method       systemRanksFunc
+		ignoreErrors bool
+	}{
+		{name: restartPhasePrep, method: control.PrepShutdownRanks, ignoreErrors: force},
+		{name: restartPhaseStop, method: control.StopRanks},
+		{name: restartPhaseStart, method: control.StartRanks},
+	} {
+		fanReq.Method = phase.method
+		fanResp, _, err := svc.rpcFanout(ctx, fanReq, false)
+		if err != nil {
+			return err
+		}
+		if err := report(phase.name, fanResp.Results); err != nil {
+			return err
+		}
+		if !phase.ignoreErrors && fanResp.Results.HasErrors() {
+			return errors.Errorf("%s failed on one or more ranks", phase.name)
+		}
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := svc.waitForJoin(waitCtx, step.ranks); err != nil {
+		return err
+	}
+	if err := report(restartPhaseJoin, nil); err != nil {
+		return err
+	}
+
+	if err := svc.waitForRebuild(waitCtx, step.ranks); err != nil {
+		return err
+	}
+	return report(restartPhaseRebuild, nil)
+}
+
+// SystemRestart implements the method defined for the Management Service.
+//
+// Restart the selected ranks, either all at once or one fault domain (or
+// a fixed number of ranks) at a time. Each step waits for its ranks to
+// rejoin the system and for any pool rebuilds to complete before the next
+// step is started. Progress is streamed to the client, and the restart is
+// aborted at the first step that fails.
+func (svc *mgmtSvc) SystemRestart(req *mgmtpb.SystemRestartReq, stream mgmtpb.MgmtSvc_SystemRestartServer) error {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return err
+	}
+	svc.log.Debugf("Received SystemRestart RPC: %+v", req)
+
+	hitRanks, missRanks, missHosts, err := svc.resolveRanks(req.GetHosts(), req.GetRanks())
+	if err != nil {
+		return err
+	}
+	if missRanks.Count() > 0 || missHosts.Count() > 0 {
+		return errors.Errorf("cannot restart absent ranks %q or hosts %q",
+			missRanks.String(), missHosts.String())
+	}
+	if hitRanks.Count() == 0 {
+		return errors.New("no ranks to restart")
+	}
+
+	timeout := time.Duration(req.GetStepTimeout()) * time.Second
+	if timeout == 0 {
+		timeout = defaultRestartStepTimeout
+	}
+
+	steps := restartSteps(svc.sysdb.FaultDomainTree(), hitRanks, req.GetRolling(),
+		int(req.GetRanksPerStep()))
+
+	var completed []system.Rank
+	ctx := stream.Context()
+	for i, step := range steps {
+		newResp := func(phase string) *mgmtpb.SystemRestartResp {
+			done := make(map[system.Rank]bool)
+			for _, rank := range completed {
+				done[rank] = true
+			}
+			var remaining []system.Rank
+			for _, rank := range hitRanks.Ranks() {
+				if !done[rank] {
+					remaining = append(remaining, rank)
+				}
+			}
+
+			return &mgmtpb.SystemRestartResp{
+				Step:      uint32(i + 1),
+				NumSteps:  uint32(len(steps)),
+				Domain:    step.domain,
+				Ranks:     step.ranks.String(),
+				Phase:     phase,
+				Completed: system.RankSetFromRanks(completed).String(),
+				Remaining: system.RankSetFromRanks(remaining).String(),
+			}
+		}
+		report := func(phase string, results system.MemberResults) error {
+			resp := newResp(phase)
+			if err := convert.Types(results, &resp.Results); err != nil {
+				return err
+			}
+			for _, result := range resp.Results {
+				result.Action = phase
+			}
+			return stream.Send(resp)
+		}
+
+		svc.log.Infof("restart step %d/%d: restarting ranks %s", i+1, len(steps), step.ranks)
+		if err := svc.restartRanks(ctx, step, req.GetForce(), timeout, report); err != nil {
+			svc.log.Errorf("restart aborted at step %d/%d: %s", i+1, len(steps), err)
+
+			resp := newResp(restartPhaseAborted)
+			resp.Error = err.Error()
+			return stream.Send(resp)
+		}
+
+		completed = append(completed, step.ranks.Ranks()...)
+		if err := report(restartPhaseDone, nil); err != nil {
+			return err
+		}
+	}
+
+	svc.log.Debug("SystemRestart completed")
+
+	return nil
+}
@@ -0,0 +1,316 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/common"
+	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	sharedpb "github.com/mjmac/soad/src/control/common/proto/shared"
+	"github.com/mjmac/soad/src/control/drpc"
+	"github.com/mjmac/soad/src/control/lib/control"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+type mockSystemRestartServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*mgmtpb.SystemRestartResp
+}
+
+func (m *mockSystemRestartServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockSystemRestartServer) Send(resp *mgmtpb.SystemRestartResp) error {
+	m.sent = append(m.sent, resp)
+	return nil
+}
+
+func TestServer_restartSteps(t *testing.T) {
+	hostDomain := func(host string) *system.FaultDomain {
+		return system.MustCreateFaultDomain("rack0", host)
+	}
+	members := system.Members{
+		system.MockMember(t, 0, system.MemberStateJoined).WithFaultDomain(hostDomain("host1")),
+		system.MockMember(t, 1, system.MemberStateJoined).WithFaultDomain(hostDomain("host1")),
+		system.MockMember(t, 2, system.MemberStateJoined).WithFaultDomain(hostDomain("host2")),
+		system.MockMember(t, 3, system.MemberStateJoined).WithFaultDomain(hostDomain("host2")),
+		system.MockMember(t, 4, system.MemberStateJoined),
+	}
+	tree := system.NewFaultDomainTree()
+	for _, m := range members {
+		if err := tree.AddDomain(m.RankFaultDomain()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	type step struct {
+		Domain string
+		Ranks  string
+	}
+
+	for name, tc := range map[string]struct {
+		ranks    string
+		rolling  bool
+		perStep  int
+		expSteps []step
+	}{
+		"not rolling": {
+			ranks:    "0-4",
+			expSteps: []step{{Ranks: "0-4"}},
+		},
+		"rolling by fault domain": {
+			ranks:   "0-4",
+			rolling: true,
+			expSteps: []step{
+				{Domain: "/rack0/host1", Ranks: "0-1"},
+				{Domain: "/rack0/host2", Ranks: "2-3"},
+				{Domain: "/", Ranks: "4"},
+			},
+		},
+		"rolling subset": {
+			ranks:   "1-2",
+			rolling: true,
+			expSteps: []step{
+				{Domain: "/rack0/host1", Ranks: "1"},
+				{Domain: "/rack0/host2", Ranks: "2"},
+			},
+		},
+		"rolling by rank count": {
+			ranks:   "0-4",
+			rolling: true,
+			perStep: 3,
+			expSteps: []step{
+				{Ranks: "0-2"},
+				{Ranks: "3-4"},
+			},
+		},
+		"rolling with rank not in tree": {
+			ranks:   "3,5",
+			rolling: true,
+			expSteps: []step{
+				{Domain: "/rack0/host2", Ranks: "3"},
+				{Ranks: "5"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rs, err := system.CreateRankSet(tc.ranks)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var gotSteps []step
+			for _, s := range restartSteps(tree, rs, tc.rolling, tc.perStep) {
+				gotSteps = append(gotSteps, step{Domain: s.domain, Ranks: s.ranks.String()})
+			}
+
+			if diff := cmp.Diff(tc.expSteps, gotSteps); diff != "" {
+				t.Fatalf("unexpected steps (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemRestart(t *testing.T) {
+	hostResults := func(addrIdx int32, state system.MemberState, errored bool, ranks ...uint32) *control.HostResponse {
+		resp := &mgmtpb.SystemStopResp{}
+		for _, rank := range ranks {
+			resp.Results = append(resp.Results, &sharedpb.RankResult{
+				Rank:    rank,
+				State:   stateString(state),
+				Errored: errored,
+			})
+		}
+		return &control.HostResponse{
+			Addr:    common.MockHostAddr(addrIdx).String(),
+			Message: resp,
+		}
+	}
+	stepResps := func(addrIdx int32, startState system.MemberState, startErr bool, ranks ...uint32) []*control.UnaryResponse {
+		return []*control.UnaryResponse{
+			{Responses: []*control.HostResponse{hostResults(addrIdx, system.MemberStateStopping, false, ranks...)}},
+			{Responses: []*control.HostResponse{hostResults(addrIdx, system.MemberStateStopped, false, ranks...)}},
+			{Responses: []*control.HostResponse{hostResults(addrIdx, startState, startErr, ranks...)}},
+		}
+	}
+	type progress struct {
+		Step      uint32
+		Ranks     string
+		Phase     string
+		Completed string
+		Remaining string
+		Error     string
+	}
+	stepProgress := func(step uint32, ranks, completed, remaining string) []progress {
+		var out []progress
+		for _, phase := range []string{restartPhasePrep, restartPhaseStop, restartPhaseStart,
+			restartPhaseJoin, restartPhaseRebuild} {
+			out = append(out, progress{step, ranks, phase, completed, remaining, ""})
+		}
+		return out
+	}
+	testPool := &system.PoolService{
+		PoolUUID: uuid.MustParse(mockUUID),
+		State:    system.PoolServiceStateReady,
+		Replicas: []system.Rank{0},
+		Storage: &system.PoolServiceStorage{
+			CurrentRankStr: "[0-3]",
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		req         *mgmtpb.SystemRestartReq
+		pool        *system.PoolService
+		uResps      []*control.UnaryResponse
+		drpcResps   []*mockDrpcResponse
+		expProgress []progress
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.SystemRestartReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"absent ranks": {
+			req:    &mgmtpb.SystemRestartReq{Ranks: "0-5"},
+			expErr: errors.New("cannot restart absent ranks"),
+		},
+		"rolling success": {
+			req: &mgmtpb.SystemRestartReq{Rolling: true},
+			uResps: append(
+				stepResps(1, system.MemberStateJoined, false, 0, 1),
+				stepResps(2, system.MemberStateJoined, false, 2, 3)...),
+			expProgress: append(append(append(
+				stepProgress(1, "0-1", "", "0-3"),
+				progress{1, "0-1", restartPhaseDone, "0-1", "2-3", ""}),
+				stepProgress(2, "2-3", "0-1", "2-3")...),
+				progress{2, "2-3", restartPhaseDone, "0-3", "", ""}),
+		},
+		"start fails": {
+			req: &mgmtpb.SystemRestartReq{Rolling: true},
+			uResps: append(
+				stepResps(1, system.MemberStateStopped, true, 0, 1),
+				stepResps(2, system.MemberStateJoined, false, 2, 3)...),
+			expProgress: append(
+				stepProgress(1, "0-1", "", "0-3")[:3],
+				progress{1, "0-1", restartPhaseAborted, "", "0-3",
+					"start failed on one or more ranks"}),
+		},
+		"join timeout": {
+			req:    &mgmtpb.SystemRestartReq{Ranks: "0-1", StepTimeout: 1},
+			uResps: stepResps(1, system.MemberStateReady, false, 0, 1),
+			expProgress: append(
+				stepProgress(1, "0-1", "", "0-1")[:3],
+				progress{1, "0-1", restartPhaseAborted, "", "0-1",
+					"ranks 0-1 did not rejoin: context deadline exceeded"}),
+		},
+		"waits for rebuild": {
+			req:    &mgmtpb.SystemRestartReq{Ranks: "0-1"},
+			pool:   testPool,
+			uResps: stepResps(1, system.MemberStateJoined, false, 0, 1),
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolQueryResp{
+					Rebuild: &mgmtpb.PoolRebuildStatus{State: mgmtpb.PoolRebuildStatus_BUSY},
+				}},
+				{Message: &mgmtpb.PoolQueryResp{
+					Rebuild: &mgmtpb.PoolRebuildStatus{State: mgmtpb.PoolRebuildStatus_DONE},
+				}},
+			},
+			expProgress: append(
+				stepProgress(1, "0-1", "", "0-1"),
+				progress{1, "0-1", restartPhaseDone, "0-1", "", ""}),
+		},
+		"rebuild fails": {
+			req:    &mgmtpb.SystemRestartReq{Ranks: "0-1"},
+			pool:   testPool,
+			uResps: stepResps(1, system.MemberStateJoined, false, 0, 1),
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolQueryResp{
+					Rebuild: &mgmtpb.PoolRebuildStatus{Status: -1},
+				}},
+			},
+			expProgress: append(
+				stepProgress(1, "0-1", "", "0-1")[:4],
+				progress{1, "0-1", restartPhaseAborted, "", "0-1",
+					"pool " + mockUUID + " rebuild failed: " + drpc.DaosStatus(-1).Error()}),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			defer func(interval time.Duration) {
+				restartPollInterval = interval
+			}(restartPollInterval)
+			restartPollInterval = time.Millisecond
+
+			// The restart steps are derived from the fault domain
+			// tree in the system database, so the membership must
+			// share it.
+			svc := mgmtSystemTestSetup(t, log, nil, nil)
+			svc.membership, svc.sysdb = system.MockMembership(t, log, nil)
+			members := system.Members{
+				mockMember(t, 0, 1, "joined").WithFaultDomain(system.MustCreateFaultDomain("host1")),
+				mockMember(t, 1, 1, "joined").WithFaultDomain(system.MustCreateFaultDomain("host1")),
+				mockMember(t, 2, 2, "joined").WithFaultDomain(system.MustCreateFaultDomain("host2")),
+				mockMember(t, 3, 2, "joined").WithFaultDomain(system.MustCreateFaultDomain("host2")),
+			}
+			for _, m := range members {
+				if _, err := svc.membership.Add(m); err != nil {
+					t.Fatal(err)
+				}
+			}
+			svc.rpcClient = control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+			})
+			if tc.pool != nil {
+				if err := svc.sysdb.AddPoolService(tc.pool); err != nil {
+					t.Fatal(err)
+				}
+			}
+			cfg := new(mockDrpcClientConfig)
+			cfg.setSendMsgResponseList(t, tc.drpcResps...)
+			svc.harness.instances[0].setDrpcClient(newMockDrpcClient(cfg))
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			stream := &mockSystemRestartServer{ctx: context.Background()}
+			gotErr := svc.SystemRestart(tc.req, stream)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotProgress []progress
+			for _, resp := range stream.sent {
+				gotProgress = append(gotProgress, progress{
+					resp.Step, resp.Ranks, resp.Phase,
+					resp.Completed, resp.Remaining, resp.Error,
+				})
+			}
+			if diff := cmp.Diff(tc.expProgress, gotProgress); diff != "" {
+				t.Fatalf("unexpected progress (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
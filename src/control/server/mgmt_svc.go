@@ -27,21 +27,29 @@ type mgmtSvc struct {
 	sysdb            *system.Database
 	rpcClient        control.UnaryInvoker
 	events           *events.PubSub
+	eventStreams     *eventStreamer
+	autoExclude      *autoExcluder
 	clientNetworkCfg *config.ClientNetworkCfg
 	joinReqs         joinReqChan
+	startReplica     replicaStartFn
+	lastMapUpdate    uint32 // last map version announced by the join loop
 }
 
 func newMgmtSvc(h *EngineHarness, m *system.Membership, s *system.Database, c control.UnaryInvoker, p *events.PubSub) *mgmtSvc {
-	return &mgmtSvc{
+	svc := &mgmtSvc{
 		log:              h.log,
 		harness:          h,
 		membership:       m,
 		sysdb:            s,
 		rpcClient:        c,
 		events:           p,
+		eventStreams:     newEventStreamer(h.log),
 		clientNetworkCfg: new(config.ClientNetworkCfg),
 		joinReqs:         make(joinReqChan),
 	}
+	svc.autoExclude = newAutoExcluder(h.log, s, svc.autoExcludeRank)
+
+	return svc
 }
 
 // checkSystemRequest sanity checks that a request is not nil and
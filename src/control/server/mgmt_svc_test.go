@@ -57,7 +57,9 @@ func TestServer_MgmtSvc_GetAttachInfo(t *testing.T) {
 						Uri:  nonReplica.FabricURI,
 					},
 				},
-				MsRanks: []uint32{0},
+				MsRanks:    []uint32{0},
+				MsReplicas: []string{msReplica.Addr.String()},
+				MapVersion: 2,
 			},
 		},
 		"Server uses sockets + Ethernet": {
@@ -86,7 +88,9 @@ func TestServer_MgmtSvc_GetAttachInfo(t *testing.T) {
 						Uri:  nonReplica.FabricURI,
 					},
 				},
-				MsRanks: []uint32{0},
+				MsRanks:    []uint32{0},
+				MsReplicas: []string{msReplica.Addr.String()},
+				MapVersion: 2,
 			},
 		},
 	} {
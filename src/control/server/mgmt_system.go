@@ -63,6 +63,13 @@ func (svc *mgmtSvc) GetAttachInfo(ctx context.Context, req *mgmtpb.GetAttachInfo
 	resp.CrtTimeout = svc.clientNetworkCfg.CrtTimeout
 	resp.NetDevClass = svc.clientNetworkCfg.NetDevClass
 	resp.MsRanks = system.RanksToUint32(groupMap.MSRanks)
+	resp.MapVersion = groupMap.Version
+
+	// Supply the current set of MS replicas so that agents can follow
+	// changes made to the replica set while the system is running.
+	if _, resp.MsReplicas, err = svc.sysdb.ReplicaList(); err != nil {
+		return nil, err
+	}
 
 	// For resp.RankUris may be large, we make a resp copy with a limited
 	// number of rank URIs, to avoid flooding the debug log.
@@ -318,6 +325,14 @@ func (svc *mgmtSvc) doGroupUpdate(ctx context.Context) error {
 	if resp.GetStatus() != 0 {
 		return drpc.DaosStatus(resp.GetStatus())
 	}
+
+	// Announce new map versions so that subscribers (e.g. agents caching
+	// attach info) can tell that their copy of the map is stale.
+	if gm.Version > svc.lastMapUpdate {
+		svc.lastMapUpdate = gm.Version
+		svc.events.Publish(events.NewSystemMapUpdateEvent(hostname(), gm.Version))
+	}
+
 	return nil
 }
 
@@ -742,3 +757,93 @@ func (svc *mgmtSvc) ClusterEvent(ctx context.Context, req *sharedpb.ClusterEvent
 
 	return resp, nil
 }
+
+// eventFilterReq defines the criteria common to requests that select
+// RAS events.
+type eventFilterReq interface {
+	GetTypes() []uint32
+	GetIds() []uint32
+	GetSeverities() []uint32
+	GetRanks() string
+	GetHosts() string
+	GetPoolUuid() string
+}
+
+// eventFilterFromReq builds a system event filter from the criteria
+// supplied in the request.
+func eventFilterFromReq(req eventFilterReq) (*system.EventFilter, error) {
+	filter := &system.EventFilter{
+		PoolUUID: req.GetPoolUuid(),
+	}
+
+	for _, typ := range req.GetTypes() {
+		filter.Types = append(filter.Types, events.RASTypeID(typ))
+	}
+	for _, id := range req.GetIds() {
+		filter.IDs = append(filter.IDs, events.RASID(id))
+	}
+	for _, sev := range req.GetSeverities() {
+		filter.Severities = append(filter.Severities, events.RASSeverityID(sev))
+	}
+	if req.GetRanks() != "" {
+		rs, err := system.CreateRankSet(req.GetRanks())
+		if err != nil {
+			return nil, err
+		}
+		filter.Ranks = rs.Ranks()
+	}
+	if req.GetHosts() != "" {
+		hs, err := hostlist.CreateSet(req.GetHosts())
+		if err != nil {
+			return nil, err
+		}
+		filter.Hosts = hs.Slice()
+	}
+
+	return filter, nil
+}
+
+// ListEvents implements the method defined for the Management Service.
+//
+// Return the entries in the system event log that match the criteria
+// supplied in the request.
+func (svc *mgmtSvc) ListEvents(ctx context.Context, req *mgmtpb.ListEventsReq) (*mgmtpb.ListEventsResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debug("Received ListEvents RPC")
+
+	filter, err := eventFilterFromReq(req)
+	if err != nil {
+		return nil, err
+	}
+	filter.Limit = int(req.GetLimit())
+	if req.GetSince() != 0 {
+		filter.Since = time.Unix(0, req.GetSince())
+	}
+	if req.GetUntil() != 0 {
+		filter.Until = time.Unix(0, req.GetUntil())
+	}
+
+	entries, err := svc.sysdb.EventLogEntries(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.ListEventsResp)
+	for _, ele := range entries {
+		pbEvt, err := ele.Event.ToProto()
+		if err != nil {
+			return nil, errors.Wrapf(err, "converting event %d", ele.Sequence)
+		}
+		resp.Entries = append(resp.Entries, &mgmtpb.EventLogEntry{
+			Sequence: ele.Sequence,
+			Time:     ele.Time.UnixNano(),
+			Event:    pbEvt,
+		})
+	}
+
+	svc.log.Debugf("Responding to ListEvents RPC: %d entries", len(resp.Entries))
+
+	return resp, nil
+}
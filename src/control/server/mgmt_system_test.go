@@ -22,6 +22,7 @@ import (
 	"github.com/mjmac/soad/src/control/common"
 	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
 	sharedpb "github.com/mjmac/soad/src/control/common/proto/shared"
+	"github.com/mjmac/soad/src/control/drpc"
 	"github.com/mjmac/soad/src/control/events"
 	"github.com/mjmac/soad/src/control/lib/control"
 	"github.com/mjmac/soad/src/control/logging"
@@ -285,6 +286,74 @@ func mgmtSystemTestSetup(t *testing.T, l logging.Logger, mbs system.Members, r [
 	return mgmtSvc
 }
 
+func TestServer_MgmtSvc_doGroupUpdate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		lastMapUpdate uint32
+		drpcStatus    int32
+		expErr        error
+		expPublished  bool
+	}{
+		"new map version announced": {
+			expPublished: true,
+		},
+		"map version already announced": {
+			lastMapUpdate: 2,
+		},
+		"engine update failed": {
+			drpcStatus: int32(drpc.DaosInvalidInput),
+			expErr:     drpc.DaosInvalidInput,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			svc := newTestMgmtSvc(t, log)
+			svc.events = events.NewPubSub(ctx, log)
+			defer svc.events.Close()
+			svc.lastMapUpdate = tc.lastMapUpdate
+
+			published := make(chan *events.RASEvent, 2)
+			svc.events.Subscribe(events.RASTypeInfoOnly, events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+				published <- evt
+			}))
+
+			for _, m := range []*system.Member{
+				system.MockMember(t, 0, system.MemberStateJoined),
+				system.MockMember(t, 1, system.MemberStateJoined),
+			} {
+				if _, err := svc.membership.Add(m); err != nil {
+					t.Fatal(err)
+				}
+			}
+			setupMockDrpcClient(svc, &mgmtpb.GroupUpdateResp{Status: tc.drpcStatus}, nil)
+
+			gotErr := svc.doGroupUpdate(ctx)
+			common.CmpErr(t, tc.expErr, gotErr)
+
+			if !tc.expPublished {
+				select {
+				case evt := <-published:
+					t.Fatalf("unexpected %s event published", evt.ID)
+				case <-time.After(100 * time.Millisecond):
+				}
+				return
+			}
+
+			select {
+			case evt := <-published:
+				common.AssertEqual(t, events.RASSystemMapUpdate, evt.ID, "unexpected event published")
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for map update event")
+			}
+			common.AssertEqual(t, uint32(2), svc.lastMapUpdate, "unexpected last map update")
+		})
+	}
+}
+
 func TestServer_MgmtSvc_rpcFanout(t *testing.T) {
 	for name, tc := range map[string]struct {
 		members        system.Members
@@ -1675,3 +1744,124 @@ func TestServer_MgmtSvc_SystemResetFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_MgmtSvc_ListEvents(t *testing.T) {
+	rankDown := events.NewRankDownEvent("foo", 0, 1, common.ExitStatus("test"))
+	psrUpdate := events.NewPoolSvcReplicasUpdateEvent("bar", 2, common.MockUUID(), []uint32{0, 2}, 1)
+	sysStop := events.New(&events.RASEvent{
+		ID:       events.RASSystemStop,
+		Type:     events.RASTypeInfoOnly,
+		Hostname: "bar",
+		Rank:     uint32(system.NilRank),
+	})
+
+	eventsToProto := func(t *testing.T, seqs []uint64, evts ...*events.RASEvent) []*mgmtpb.EventLogEntry {
+		t.Helper()
+
+		var entries []*mgmtpb.EventLogEntry
+		for i, evt := range evts {
+			pbEvt, err := evt.ToProto()
+			if err != nil {
+				t.Fatal(err)
+			}
+			entries = append(entries, &mgmtpb.EventLogEntry{
+				Sequence: seqs[i],
+				Event:    pbEvt,
+			})
+		}
+		return entries
+	}
+
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		req        *mgmtpb.ListEventsReq
+		expResp    *mgmtpb.ListEventsResp
+		expErr     error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.ListEventsReq{},
+			expErr:     errors.New("replica"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.ListEventsReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"bad rankset": {
+			req:    &mgmtpb.ListEventsReq{Ranks: "foo"},
+			expErr: errors.New("unexpected alphabetic character(s)"),
+		},
+		"unfiltered": {
+			req: &mgmtpb.ListEventsReq{},
+			expResp: &mgmtpb.ListEventsResp{
+				Entries: eventsToProto(t, []uint64{1, 2, 3}, rankDown, psrUpdate, sysStop),
+			},
+		},
+		"filtered by host and id": {
+			req: &mgmtpb.ListEventsReq{
+				Hosts: "bar",
+				Ids:   []uint32{events.RASSystemStop.Uint32()},
+			},
+			expResp: &mgmtpb.ListEventsResp{
+				Entries: eventsToProto(t, []uint64{3}, sysStop),
+			},
+		},
+		"filtered by rank": {
+			req: &mgmtpb.ListEventsReq{
+				Ranks: "1-2",
+			},
+			expResp: &mgmtpb.ListEventsResp{
+				Entries: eventsToProto(t, []uint64{1, 2}, rankDown, psrUpdate),
+			},
+		},
+		"filtered by time": {
+			req: &mgmtpb.ListEventsReq{
+				Since: time.Now().Add(time.Hour).UnixNano(),
+			},
+			expResp: &mgmtpb.ListEventsResp{},
+		},
+		"limited": {
+			req: &mgmtpb.ListEventsReq{
+				Limit: 1,
+			},
+			expResp: &mgmtpb.ListEventsResp{
+				Entries: eventsToProto(t, []uint64{3}, sysStop),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for _, evt := range []*events.RASEvent{rankDown, psrUpdate, sysStop} {
+				if err := svc.sysdb.AddEvent(evt); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.nonReplica {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.ListEvents(context.TODO(), tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := append(common.DefaultCmpOpts(),
+				cmpopts.IgnoreFields(mgmtpb.EventLogEntry{}, "Time"),
+			)
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
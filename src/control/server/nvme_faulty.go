@@ -0,0 +1,229 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	srvpb "github.com/mjmac/soad/src/control/common/proto/srv"
+	"github.com/mjmac/soad/src/control/events"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+// nvmeFaultyQueueLen is the number of pending requests to mark a device
+// FAULTY that can be queued before further requests are dropped.
+const nvmeFaultyQueueLen = 32
+
+// bioErrorKey identifies a type of BIO error reported for a target of an
+// instance.
+type bioErrorKey struct {
+	instance uint32
+	target   int32
+	errType  string
+}
+
+// nvmeFaultyReq is a request to mark the device used by a target, or the
+// device with the given UUID, FAULTY.
+type nvmeFaultyReq struct {
+	srv     *EngineInstance
+	devUUID string
+	target  int32
+	detail  string
+}
+
+// nvmeAutoFaulty marks the NVMe devices used by the local I/O Engine
+// instances FAULTY when the BIO errors reported for their targets, or their
+// health statistics, reach the configured thresholds. A RAS event is raised
+// for each device marked FAULTY.
+type nvmeAutoFaulty struct {
+	sync.Mutex
+	log     logging.Logger
+	cfg     config.NvmeAutoFaultyConfig
+	harness *EngineHarness
+	events  *events.PubSub
+	// bioErrors records the times of the recent BIO errors of each type
+	// reported for each target.
+	bioErrors map[bioErrorKey][]time.Time
+	pending   chan *nvmeFaultyReq
+	now       func() time.Time
+}
+
+func newNvmeAutoFaulty(log logging.Logger, cfg config.NvmeAutoFaultyConfig, harness *EngineHarness, ps *events.PubSub) *nvmeAutoFaulty {
+	return &nvmeAutoFaulty{
+		log:       log,
+		cfg:       cfg,
+		harness:   harness,
+		events:    ps,
+		bioErrors: make(map[bioErrorKey][]time.Time),
+		pending:   make(chan *nvmeFaultyReq, nvmeFaultyQueueLen),
+		now:       time.Now,
+	}
+}
+
+func (af *nvmeAutoFaulty) enabled() bool {
+	return af.cfg.ReadErrorsThreshold != 0 || af.cfg.WriteErrorsThreshold != 0 ||
+		af.cfg.UnmapErrorsThreshold != 0 || af.cfg.OnHealthWarning
+}
+
+// start processes the queued requests until the context is canceled.
+func (af *nvmeAutoFaulty) start(ctx context.Context) {
+	if !af.enabled() {
+		af.log.Debug("automatic NVMe FAULTY marking disabled")
+		return
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case req := <-af.pending:
+				if err := af.setFaulty(ctx, req); err != nil {
+					af.log.Errorf("instance %d: failed to set NVMe device FAULTY (%s): %s",
+						req.srv.Index(), req.detail, err)
+				}
+			}
+		}
+	}()
+}
+
+func (af *nvmeAutoFaulty) queue(req *nvmeFaultyReq) {
+	select {
+	case af.pending <- req:
+	default:
+		af.log.Errorf("instance %d: dropped request to set NVMe device FAULTY (%s)",
+			req.srv.Index(), req.detail)
+	}
+}
+
+// bioError counts the BIO errors reported by the instance and requests that
+// the device used by the target is marked FAULTY if the number of errors of
+// a type reported within the error window reaches its threshold.
+func (af *nvmeAutoFaulty) bioError(srv *EngineInstance, bio *srvpb.BioErrorReq) {
+	af.Lock()
+	defer af.Unlock()
+
+	now := af.now()
+	for _, et := range []struct {
+		name      string
+		reported  bool
+		threshold uint32
+	}{
+		{"read", bio.ReadErr, af.cfg.ReadErrorsThreshold},
+		{"write", bio.WriteErr, af.cfg.WriteErrorsThreshold},
+		{"unmap", bio.UnmapErr, af.cfg.UnmapErrorsThreshold},
+	} {
+		if !et.reported || et.threshold == 0 {
+			continue
+		}
+
+		key := bioErrorKey{instance: srv.Index(), target: bio.TgtId, errType: et.name}
+		var recent []time.Time
+		for _, t := range af.bioErrors[key] {
+			if af.cfg.ErrorWindow == 0 || now.Sub(t) < af.cfg.ErrorWindow {
+				recent = append(recent, t)
+			}
+		}
+		recent = append(recent, now)
+
+		if uint32(len(recent)) < et.threshold {
+			af.bioErrors[key] = recent
+			continue
+		}
+		delete(af.bioErrors, key)
+
+		af.queue(&nvmeFaultyReq{
+			srv:    srv,
+			target: bio.TgtId,
+			detail: fmt.Sprintf("%d %s errors reported for target %d reached threshold %d",
+				len(recent), et.name, bio.TgtId, et.threshold),
+		})
+	}
+}
+
+// healthWarning requests that a device is marked FAULTY when one of its
+// health statistics has reached its threshold.
+func (af *nvmeAutoFaulty) healthWarning(rank system.Rank, devUUID, detail string) {
+	if !af.cfg.OnHealthWarning {
+		return
+	}
+
+	srvs, err := af.harness.FilterInstancesByRankSet(fmt.Sprintf("%d", rank))
+	if err != nil || len(srvs) == 0 {
+		af.log.Errorf("NVMe device %s: no instance found for rank %d", devUUID, rank)
+		return
+	}
+
+	af.queue(&nvmeFaultyReq{srv: srvs[0], devUUID: devUUID, detail: detail})
+}
+
+func deviceHasTarget(dev *ctlpb.SmdDevResp_Device, target int32) bool {
+	for _, tgtID := range dev.GetTgtIds() {
+		if tgtID == target {
+			return true
+		}
+	}
+	return false
+}
+
+// setFaulty marks the requested device FAULTY unless it already is, raises
+// a RAS event and forgets the BIO errors counted for the device's targets.
+func (af *nvmeAutoFaulty) setFaulty(ctx context.Context, req *nvmeFaultyReq) error {
+	smdResp, err := req.srv.listSmdDevices(ctx, new(ctlpb.SmdDevReq))
+	if err != nil {
+		return err
+	}
+
+	var dev *ctlpb.SmdDevResp_Device
+	for _, d := range smdResp.GetDevices() {
+		if (req.devUUID != "" && d.GetUuid() == req.devUUID) ||
+			(req.devUUID == "" && deviceHasTarget(d, req.target)) {
+			dev = d
+			break
+		}
+	}
+	switch {
+	case dev == nil && req.devUUID != "":
+		return errors.Errorf("device %s not found", req.devUUID)
+	case dev == nil:
+		return errors.Errorf("no device found for target %d", req.target)
+	case dev.GetState() == smdDevStateFaulty:
+		af.log.Debugf("NVMe device %s is already FAULTY", dev.GetUuid())
+		return nil
+	}
+
+	if _, err := req.srv.setDeviceFaulty(ctx, dev.GetUuid()); err != nil {
+		return err
+	}
+
+	rank := system.NilRank
+	if r, err := req.srv.GetRank(); err == nil {
+		rank = r
+	}
+	af.log.Errorf("NVMe device %s (%s) on rank %d automatically set FAULTY: %s",
+		dev.GetUuid(), dev.GetTrAddr(), rank, req.detail)
+	af.events.Publish(events.NewNvmeAutoFaultyEvent(hostname(), rank.Uint32(), dev.GetUuid(),
+		req.detail))
+
+	af.Lock()
+	defer af.Unlock()
+	for key := range af.bioErrors {
+		if key.instance == req.srv.Index() && deviceHasTarget(dev, key.target) {
+			delete(af.bioErrors, key)
+		}
+	}
+
+	return nil
+}
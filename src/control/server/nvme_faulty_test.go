@@ -0,0 +1,290 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	srvpb "github.com/mjmac/soad/src/control/common/proto/srv"
+	"github.com/mjmac/soad/src/control/events"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/server/engine"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+func TestServer_nvmeAutoFaulty_bioError(t *testing.T) {
+	readErr := &srvpb.BioErrorReq{TgtId: 1, ReadErr: true}
+	writeErr := &srvpb.BioErrorReq{TgtId: 1, WriteErr: true}
+	otherTgtErr := &srvpb.BioErrorReq{TgtId: 2, ReadErr: true}
+
+	for name, tc := range map[string]struct {
+		cfg        config.NvmeAutoFaultyConfig
+		reports    []*srvpb.BioErrorReq
+		interval   time.Duration
+		expDetails []string
+	}{
+		"disabled": {
+			reports: []*srvpb.BioErrorReq{readErr, readErr, readErr},
+		},
+		"below threshold": {
+			cfg:     config.NvmeAutoFaultyConfig{ReadErrorsThreshold: 3},
+			reports: []*srvpb.BioErrorReq{readErr, readErr, writeErr, otherTgtErr},
+		},
+		"threshold reached": {
+			cfg:     config.NvmeAutoFaultyConfig{ReadErrorsThreshold: 2},
+			reports: []*srvpb.BioErrorReq{readErr, writeErr, readErr},
+			expDetails: []string{
+				"2 read errors reported for target 1 reached threshold 2",
+			},
+		},
+		"counts restart after threshold reached": {
+			cfg:     config.NvmeAutoFaultyConfig{WriteErrorsThreshold: 2},
+			reports: []*srvpb.BioErrorReq{writeErr, writeErr, writeErr, writeErr},
+			expDetails: []string{
+				"2 write errors reported for target 1 reached threshold 2",
+				"2 write errors reported for target 1 reached threshold 2",
+			},
+		},
+		"multiple error types in one report": {
+			cfg: config.NvmeAutoFaultyConfig{
+				ReadErrorsThreshold:  1,
+				UnmapErrorsThreshold: 1,
+			},
+			reports: []*srvpb.BioErrorReq{{TgtId: 3, ReadErr: true, UnmapErr: true}},
+			expDetails: []string{
+				"1 read errors reported for target 3 reached threshold 1",
+				"1 unmap errors reported for target 3 reached threshold 1",
+			},
+		},
+		"errors outside window expire": {
+			cfg: config.NvmeAutoFaultyConfig{
+				ReadErrorsThreshold: 2,
+				ErrorWindow:         time.Minute,
+			},
+			reports:  []*srvpb.BioErrorReq{readErr, readErr, readErr},
+			interval: 2 * time.Minute,
+		},
+		"errors inside window counted": {
+			cfg: config.NvmeAutoFaultyConfig{
+				ReadErrorsThreshold: 3,
+				ErrorWindow:         time.Hour,
+			},
+			reports:  []*srvpb.BioErrorReq{readErr, readErr, readErr},
+			interval: 2 * time.Minute,
+			expDetails: []string{
+				"3 read errors reported for target 1 reached threshold 3",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			srv := newTestEngine(log, false, engine.NewConfig())
+			af := newNvmeAutoFaulty(log, tc.cfg, nil, nil)
+			now := time.Unix(1000, 0)
+			af.now = func() time.Time {
+				now = now.Add(tc.interval)
+				return now
+			}
+
+			// Reports are delivered through the instance callbacks.
+			srv.OnBioError(func(bio *srvpb.BioErrorReq) {
+				af.bioError(srv, bio)
+			})
+			for _, bio := range tc.reports {
+				srv.BioErrorNotify(bio)
+			}
+
+			var gotDetails []string
+			for len(af.pending) > 0 {
+				req := <-af.pending
+				common.AssertEqual(t, srv, req.srv, "unexpected instance")
+				gotDetails = append(gotDetails, req.detail)
+			}
+			if diff := cmp.Diff(tc.expDetails, gotDetails); diff != "" {
+				t.Fatalf("unexpected requests (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_nvmeAutoFaulty_healthWarning(t *testing.T) {
+	for name, tc := range map[string]struct {
+		onHealthWarning bool
+		rank            system.Rank
+		expQueued       bool
+	}{
+		"disabled": {
+			rank: 1,
+		},
+		"enabled": {
+			onHealthWarning: true,
+			rank:            1,
+			expQueued:       true,
+		},
+		"unknown rank": {
+			onHealthWarning: true,
+			rank:            2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			srv := newTestEngine(log, false, engine.NewConfig())
+			srv.setSuperblock(&Superblock{Rank: system.NewRankPtr(1)})
+			harness := NewEngineHarness(log)
+			if err := harness.AddInstance(srv); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg := config.NvmeAutoFaultyConfig{OnHealthWarning: tc.onHealthWarning}
+			af := newNvmeAutoFaulty(log, cfg, harness, nil)
+			af.healthWarning(tc.rank, common.MockUUID(), "media errors 1 reached threshold 1")
+
+			common.AssertEqual(t, tc.expQueued, len(af.pending) == 1, "unexpected queued request")
+			if tc.expQueued {
+				req := <-af.pending
+				common.AssertEqual(t, common.MockUUID(), req.devUUID, "unexpected device")
+				common.AssertEqual(t, srv, req.srv, "unexpected instance")
+			}
+		})
+	}
+}
+
+func TestServer_nvmeAutoFaulty_setFaulty(t *testing.T) {
+	devices := &ctlpb.SmdDevResp{
+		Devices: []*ctlpb.SmdDevResp_Device{
+			{Uuid: common.MockUUID(0), TgtIds: []int32{0, 1}, State: "NORMAL", TrAddr: "0000:80:00.0"},
+			{Uuid: common.MockUUID(1), TgtIds: []int32{2, 3}, State: "FAULTY", TrAddr: "0000:81:00.0"},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		req       *nvmeFaultyReq
+		drpcResps []*mockDrpcResponse
+		expEvent  bool
+		expUUID   string
+		expErr    error
+	}{
+		"device of target set faulty": {
+			req: &nvmeFaultyReq{target: 1, detail: "read errors"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: devices},
+				{Message: &ctlpb.DevStateResp{DevUuid: common.MockUUID(0), DevState: "FAULTY"}},
+			},
+			expEvent: true,
+			expUUID:  common.MockUUID(0),
+		},
+		"device set faulty by uuid": {
+			req: &nvmeFaultyReq{devUUID: common.MockUUID(0), detail: "media errors"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: devices},
+				{Message: &ctlpb.DevStateResp{DevUuid: common.MockUUID(0), DevState: "FAULTY"}},
+			},
+			expEvent: true,
+			expUUID:  common.MockUUID(0),
+		},
+		"device already faulty": {
+			req: &nvmeFaultyReq{target: 3, detail: "read errors"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: devices},
+			},
+		},
+		"unknown target": {
+			req: &nvmeFaultyReq{target: 7, detail: "read errors"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: devices},
+			},
+			expErr: errors.New("no device found for target 7"),
+		},
+		"unknown device": {
+			req: &nvmeFaultyReq{devUUID: common.MockUUID(5), detail: "media errors"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: devices},
+			},
+			expErr: errors.New("not found"),
+		},
+		"set faulty fails": {
+			req: &nvmeFaultyReq{target: 0, detail: "read errors"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: devices},
+				{Message: &ctlpb.DevStateResp{Status: -1}},
+			},
+			expErr: errors.New("smdSetFaulty failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			srv := newTestEngine(log, false, engine.NewConfig())
+			srv.setSuperblock(&Superblock{Rank: system.NewRankPtr(1)})
+			dcc := new(mockDrpcClientConfig)
+			dcc.setSendMsgResponseList(t, tc.drpcResps...)
+			srv.setDrpcClient(newMockDrpcClient(dcc))
+
+			ps := events.NewPubSub(ctx, log)
+			defer ps.Close()
+			published := make(chan *events.RASEvent, 1)
+			ps.Subscribe(events.RASTypeAny, events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+				published <- evt
+			}))
+
+			af := newNvmeAutoFaulty(log, config.NvmeAutoFaultyConfig{ReadErrorsThreshold: 5}, nil, ps)
+			counted := bioErrorKey{instance: srv.Index(), target: 1, errType: "read"}
+			otherDev := bioErrorKey{instance: srv.Index(), target: 2, errType: "read"}
+			af.bioErrors[counted] = []time.Time{time.Unix(1, 0)}
+			af.bioErrors[otherDev] = []time.Time{time.Unix(1, 0)}
+
+			tc.req.srv = srv
+			gotErr := af.setFaulty(ctx, tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if !tc.expEvent {
+				select {
+				case evt := <-published:
+					t.Fatalf("unexpected %s event published", evt.ID)
+				case <-time.After(100 * time.Millisecond):
+				}
+				return
+			}
+
+			select {
+			case evt := <-published:
+				common.AssertEqual(t, events.RASNvmeAutoFaulty, evt.ID, "unexpected event published")
+				common.AssertEqual(t, tc.expUUID, evt.HWID, "unexpected device")
+				common.AssertEqual(t, uint32(1), evt.Rank, "unexpected rank")
+				common.AssertEqual(t, tc.req.detail, string(*evt.GetStrInfo()), "unexpected event info")
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for event")
+			}
+
+			// The errors counted against the targets of the device are
+			// forgotten.
+			_, found := af.bioErrors[counted]
+			common.AssertFalse(t, found, "errors of faulty device not cleared")
+			_, found = af.bioErrors[otherDev]
+			common.AssertTrue(t, found, "errors of other device cleared")
+		})
+	}
+}
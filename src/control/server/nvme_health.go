@@ -0,0 +1,445 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/common/proto/convert"
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	"github.com/mjmac/soad/src/control/events"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/server/storage"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+const (
+	nvmeHealthFile = "nvme_health.json"
+	// minTrendSamples is the number of samples required before the trend
+	// of a health statistic is used to predict device failure.
+	minTrendSamples = 3
+)
+
+// nvmeHealthCheck describes a device health statistic that is checked
+// against a threshold.
+type nvmeHealthCheck struct {
+	name  string
+	value func(*storage.NvmeHealth) float64
+	// threshold returns the threshold of the statistic, zero disables
+	// the check.
+	threshold func(*config.NvmeHealthConfig, *storage.NvmeHealth) float64
+	// falling is set if the statistic degrades as it decreases.
+	falling bool
+	// trend is set if the trend of the statistic is used to predict
+	// when it will reach its threshold.
+	trend bool
+	// transient is set if reaching the threshold does not indicate that
+	// the device is failing.
+	transient bool
+}
+
+func (hc *nvmeHealthCheck) reached(value, threshold float64) bool {
+	if hc.falling {
+		return value <= threshold
+	}
+	return value >= threshold
+}
+
+var nvmeHealthChecks = []*nvmeHealthCheck{
+	{
+		name: "temperature",
+		value: func(h *storage.NvmeHealth) float64 {
+			return math.Round(float64(h.TempC())*100) / 100
+		},
+		threshold: func(cfg *config.NvmeHealthConfig, _ *storage.NvmeHealth) float64 {
+			return float64(cfg.TemperatureThreshold)
+		},
+		transient: true,
+	},
+	{
+		name:  "available spare",
+		value: func(h *storage.NvmeHealth) float64 { return float64(h.AvailSpare) },
+		threshold: func(cfg *config.NvmeHealthConfig, h *storage.NvmeHealth) float64 {
+			if cfg.AvailSpareThreshold != 0 {
+				return float64(cfg.AvailSpareThreshold)
+			}
+			return float64(h.AvailSpareThresh)
+		},
+		falling: true,
+		trend:   true,
+	},
+	{
+		name:  "media errors",
+		value: func(h *storage.NvmeHealth) float64 { return float64(h.MediaErrors) },
+		threshold: func(cfg *config.NvmeHealthConfig, _ *storage.NvmeHealth) float64 {
+			return float64(cfg.MediaErrorsThreshold)
+		},
+		trend: true,
+	},
+	{
+		name:  "unsafe shutdowns",
+		value: func(h *storage.NvmeHealth) float64 { return float64(h.UnsafeShutdowns) },
+		threshold: func(cfg *config.NvmeHealthConfig, _ *storage.NvmeHealth) float64 {
+			return float64(cfg.UnsafeShutdownsThreshold)
+		},
+		trend: true,
+	},
+	{
+		name: "BIO errors",
+		value: func(h *storage.NvmeHealth) float64 {
+			return float64(h.ReadErrors) + float64(h.WriteErrors) + float64(h.UnmapErrors)
+		},
+		threshold: func(cfg *config.NvmeHealthConfig, _ *storage.NvmeHealth) float64 {
+			return float64(cfg.BioErrorsThreshold)
+		},
+		trend: true,
+	},
+}
+
+// linearFit returns the intercept and slope of the least-squares line
+// through the supplied points.
+func linearFit(xs, ys []float64) (float64, float64) {
+	n := float64(len(xs))
+	var sumX, sumY, sumXX, sumXY float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+		sumXX += xs[i] * xs[i]
+		sumXY += xs[i] * ys[i]
+	}
+
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 {
+		return sumY / n, 0
+	}
+	slope := (n*sumXY - sumX*sumY) / denom
+	return (sumY - slope*sumX) / n, slope
+}
+
+// predictCrossing fits a line through the history of the statistic and
+// returns the time from the latest sample until the line reaches the
+// threshold. False is returned if the trend is not towards the threshold or
+// the threshold will not be reached within the horizon.
+func (hc *nvmeHealthCheck) predictCrossing(history []*storage.NvmeHealth, threshold float64, horizon time.Duration) (time.Duration, bool) {
+	latest := history[len(history)-1].Timestamp
+	xs := make([]float64, len(history))
+	ys := make([]float64, len(history))
+	for i, sample := range history {
+		xs[i] = float64(sample.Timestamp) - float64(latest)
+		ys[i] = hc.value(sample)
+	}
+
+	intercept, slope := linearFit(xs, ys)
+	if (hc.falling && slope >= 0) || (!hc.falling && slope <= 0) {
+		return 0, false
+	}
+	secs := (threshold - intercept) / slope
+	if secs > horizon.Seconds() {
+		return 0, false
+	}
+	if secs < 0 {
+		secs = 0
+	}
+	return time.Duration(secs) * time.Second, true
+}
+
+// nvmeHealthHistory is the rolling history of health samples, oldest first,
+// of the NVMe devices used by an I/O Engine instance, along with the PCI
+// address at which each device was last seen.
+type nvmeHealthHistory struct {
+	Devices map[string][]*storage.NvmeHealth `json:"devices"`
+	Slots   map[string]string                `json:"slots,omitempty"`
+}
+
+// nvmeHealthMonitor periodically samples the health of the NVMe devices used
+// by the local I/O Engine instances. A rolling history of the samples is kept
+// on the SCM mount of each instance, and RAS events are raised when a health
+// statistic reaches its threshold or is predicted to reach it within the
+// configured horizon.
+type nvmeHealthMonitor struct {
+	sync.Mutex
+	log       logging.Logger
+	cfg       config.NvmeHealthConfig
+	harness   *EngineHarness
+	events    *events.PubSub
+	histories map[uint32]*nvmeHealthHistory
+	// raised records the conditions reported for each device, so that
+	// events are only raised again once the condition has cleared.
+	raised map[string]map[string]bool
+	// onThreshold is invoked when a health statistic of a device that
+	// indicates device failure first reaches its threshold.
+	onThreshold func(rank system.Rank, devUUID, detail string)
+	now         func() time.Time
+}
+
+func newNvmeHealthMonitor(log logging.Logger, cfg config.NvmeHealthConfig, harness *EngineHarness, ps *events.PubSub) *nvmeHealthMonitor {
+	return &nvmeHealthMonitor{
+		log:       log,
+		cfg:       cfg,
+		harness:   harness,
+		events:    ps,
+		histories: make(map[uint32]*nvmeHealthHistory),
+		raised:    make(map[string]map[string]bool),
+		now:       time.Now,
+	}
+}
+
+func nvmeHealthPath(srv *EngineInstance) string {
+	return filepath.Join(srv.storagePath(), nvmeHealthFile)
+}
+
+// start samples device health at the configured interval until the context
+// is canceled. Monitoring is disabled if the interval is zero.
+func (hm *nvmeHealthMonitor) start(ctx context.Context) {
+	if hm.cfg.SampleInterval <= 0 {
+		hm.log.Debug("NVMe health monitoring disabled")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(hm.cfg.SampleInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				hm.sample(ctx)
+			}
+		}
+	}()
+}
+
+// sample records the health of the devices used by each ready instance.
+func (hm *nvmeHealthMonitor) sample(ctx context.Context) {
+	for _, srv := range hm.harness.Instances() {
+		if !srv.isReady() {
+			continue
+		}
+		if err := hm.sampleInstance(ctx, srv); err != nil {
+			hm.log.Errorf("instance %d: NVMe health sampling failed: %s", srv.Index(), err)
+		}
+	}
+}
+
+// loadHistory returns the health history of the instance, reading it from
+// the instance's SCM mount if it has not already been loaded. Must be called
+// with the monitor locked.
+func (hm *nvmeHealthMonitor) loadHistory(srv *EngineInstance) (*nvmeHealthHistory, error) {
+	if hist, found := hm.histories[srv.Index()]; found {
+		return hist, nil
+	}
+
+	hist := &nvmeHealthHistory{
+		Devices: make(map[string][]*storage.NvmeHealth),
+		Slots:   make(map[string]string),
+	}
+	data, err := ioutil.ReadFile(nvmeHealthPath(srv))
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, errors.Wrap(err, "reading NVMe health history")
+	default:
+		if err := json.Unmarshal(data, hist); err != nil {
+			hm.log.Errorf("instance %d: discarding corrupt NVMe health history: %s", srv.Index(), err)
+		}
+		if hist.Devices == nil {
+			hist.Devices = make(map[string][]*storage.NvmeHealth)
+		}
+		if hist.Slots == nil {
+			hist.Slots = make(map[string]string)
+		}
+	}
+	hm.histories[srv.Index()] = hist
+
+	return hist, nil
+}
+
+func (hm *nvmeHealthMonitor) sampleInstance(ctx context.Context, srv *EngineInstance) error {
+	smdResp, err := srv.listSmdDevices(ctx, new(ctlpb.SmdDevReq))
+	if err != nil {
+		return err
+	}
+
+	samples := make(map[string]*storage.NvmeHealth)
+	timestamp := uint64(hm.now().Unix())
+	for _, dev := range smdResp.GetDevices() {
+		pbHealth, err := srv.getBioHealth(ctx, &ctlpb.BioHealthReq{DevUuid: dev.GetUuid()})
+		if err != nil {
+			hm.log.Errorf("instance %d: device %s health: %s", srv.Index(), dev.GetUuid(), err)
+			continue
+		}
+		health := new(storage.NvmeHealth)
+		if err := convert.Types(pbHealth, health); err != nil {
+			return errors.Wrap(err, "converting device health")
+		}
+		health.Timestamp = timestamp
+		samples[dev.GetUuid()] = health
+	}
+
+	hm.Lock()
+	hist, err := hm.loadHistory(srv)
+	if err != nil {
+		hm.Unlock()
+		return err
+	}
+	for uuid := range hist.Devices {
+		if !deviceListed(smdResp, uuid) {
+			delete(hist.Devices, uuid)
+			delete(hm.raised, uuid)
+		}
+	}
+	for uuid := range hist.Slots {
+		if !deviceListed(smdResp, uuid) {
+			delete(hist.Slots, uuid)
+		}
+	}
+	for _, dev := range smdResp.GetDevices() {
+		if dev.GetTrAddr() != "" {
+			hist.Slots[dev.GetUuid()] = dev.GetTrAddr()
+		}
+	}
+	for uuid, health := range samples {
+		devHist := append(hist.Devices[uuid], health)
+		if len(devHist) > hm.cfg.HistoryLength {
+			devHist = devHist[len(devHist)-hm.cfg.HistoryLength:]
+		}
+		hist.Devices[uuid] = devHist
+	}
+	data, err := json.Marshal(hist)
+	hm.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "marshaling NVMe health history")
+	}
+
+	if err := common.WriteFileAtomic(nvmeHealthPath(srv), data, 0600); err != nil {
+		return errors.Wrap(err, "writing NVMe health history")
+	}
+
+	rank := system.NilRank
+	if r, err := srv.GetRank(); err == nil {
+		rank = r
+	}
+	for uuid := range samples {
+		history, err := hm.deviceHistory(srv, uuid)
+		if err != nil {
+			return err
+		}
+		hm.checkDevice(rank, uuid, history)
+	}
+
+	return nil
+}
+
+func deviceListed(resp *ctlpb.SmdDevResp, uuid string) bool {
+	for _, dev := range resp.GetDevices() {
+		if dev.GetUuid() == uuid {
+			return true
+		}
+	}
+	return false
+}
+
+// deviceHistory returns a copy of the health history of a device used by
+// the instance.
+func (hm *nvmeHealthMonitor) deviceHistory(srv *EngineInstance, uuid string) ([]*storage.NvmeHealth, error) {
+	hm.Lock()
+	defer hm.Unlock()
+
+	hist, err := hm.loadHistory(srv)
+	if err != nil {
+		return nil, err
+	}
+	return append([]*storage.NvmeHealth(nil), hist.Devices[uuid]...), nil
+}
+
+// deviceSlot returns the PCI address at which a device used by the instance
+// was last seen, or an empty string if it is unknown.
+func (hm *nvmeHealthMonitor) deviceSlot(srv *EngineInstance, uuid string) (string, error) {
+	hm.Lock()
+	defer hm.Unlock()
+
+	hist, err := hm.loadHistory(srv)
+	if err != nil {
+		return "", err
+	}
+	return hist.Slots[uuid], nil
+}
+
+// checkDevice raises events for the health conditions of the device that
+// have not already been reported, and forgets the conditions that have
+// cleared.
+func (hm *nvmeHealthMonitor) checkDevice(rank system.Rank, uuid string, history []*storage.NvmeHealth) {
+	if len(history) == 0 {
+		return
+	}
+	latest := history[len(history)-1]
+
+	hm.Lock()
+	defer hm.Unlock()
+
+	active := make(map[string]bool)
+	for _, hc := range nvmeHealthChecks {
+		threshold := hc.threshold(&hm.cfg, latest)
+		if threshold == 0 {
+			continue
+		}
+		value := hc.value(latest)
+
+		if hc.reached(value, threshold) {
+			key := "threshold:" + hc.name
+			active[key] = true
+			if hm.raised[uuid][key] {
+				continue
+			}
+			detail := fmt.Sprintf("%s %g reached threshold %g", hc.name, value, threshold)
+			hm.log.Errorf("NVMe device %s: %s", uuid, detail)
+			hm.events.Publish(events.NewNvmeHealthWarningEvent(hostname(), rank.Uint32(), uuid, detail))
+			if !hc.transient && hm.onThreshold != nil {
+				hm.onThreshold(rank, uuid, detail)
+			}
+			continue
+		}
+
+		if !hc.trend || len(history) < minTrendSamples {
+			continue
+		}
+		eta, ok := hc.predictCrossing(history, threshold, hm.cfg.PredictionHorizon)
+		if !ok {
+			continue
+		}
+		key := "trend:" + hc.name
+		active[key] = true
+		if hm.raised[uuid][key] {
+			continue
+		}
+		detail := fmt.Sprintf("%s %g predicted to reach threshold %g in %s", hc.name, value, threshold,
+			eta.Round(time.Minute))
+		hm.log.Errorf("NVMe device %s: %s", uuid, detail)
+		hm.events.Publish(events.NewNvmeFailurePredictedEvent(hostname(), rank.Uint32(), uuid, detail))
+	}
+
+	for key := range hm.raised[uuid] {
+		if !active[key] {
+			hm.log.Infof("NVMe device %s: %s condition cleared", uuid, key)
+		}
+	}
+	hm.raised[uuid] = active
+}
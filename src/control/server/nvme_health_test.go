@@ -0,0 +1,315 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mjmac/soad/src/control/common"
+	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
+	"github.com/mjmac/soad/src/control/events"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/config"
+	"github.com/mjmac/soad/src/control/server/engine"
+	"github.com/mjmac/soad/src/control/server/storage"
+	"github.com/mjmac/soad/src/control/system"
+)
+
+func TestServer_nvmeHealthCheck_predictCrossing(t *testing.T) {
+	mediaErrs := nvmeHealthChecks[2]
+	spare := nvmeHealthChecks[1]
+	samples := func(field func(*storage.NvmeHealth, uint64), values ...uint64) []*storage.NvmeHealth {
+		history := make([]*storage.NvmeHealth, len(values))
+		for i, v := range values {
+			history[i] = &storage.NvmeHealth{Timestamp: uint64(i) * 3600}
+			field(history[i], v)
+		}
+		return history
+	}
+	setMedia := func(h *storage.NvmeHealth, v uint64) { h.MediaErrors = v }
+	setSpare := func(h *storage.NvmeHealth, v uint64) { h.AvailSpare = uint32(v) }
+
+	for name, tc := range map[string]struct {
+		check     *nvmeHealthCheck
+		history   []*storage.NvmeHealth
+		threshold float64
+		horizon   time.Duration
+		expETA    time.Duration
+		expOK     bool
+	}{
+		"flat": {
+			check:     mediaErrs,
+			history:   samples(setMedia, 2, 2, 2),
+			threshold: 10,
+			horizon:   time.Hour * 24,
+		},
+		"improving": {
+			check:     spare,
+			history:   samples(setSpare, 50, 60, 70),
+			threshold: 10,
+			horizon:   time.Hour * 24,
+		},
+		"rising within horizon": {
+			check:     mediaErrs,
+			history:   samples(setMedia, 0, 1, 2),
+			threshold: 10,
+			horizon:   time.Hour * 24,
+			expETA:    8 * time.Hour,
+			expOK:     true,
+		},
+		"rising beyond horizon": {
+			check:     mediaErrs,
+			history:   samples(setMedia, 0, 1, 2),
+			threshold: 10,
+			horizon:   time.Hour * 4,
+		},
+		"falling within horizon": {
+			check:     spare,
+			history:   samples(setSpare, 30, 25, 20),
+			threshold: 10,
+			horizon:   time.Hour * 24,
+			expETA:    2 * time.Hour,
+			expOK:     true,
+		},
+		"same timestamps": {
+			check: mediaErrs,
+			history: []*storage.NvmeHealth{
+				{Timestamp: 1, MediaErrors: 1},
+				{Timestamp: 1, MediaErrors: 5},
+			},
+			threshold: 10,
+			horizon:   time.Hour,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotETA, gotOK := tc.check.predictCrossing(tc.history, tc.threshold, tc.horizon)
+
+			common.AssertEqual(t, tc.expOK, gotOK, "unexpected prediction result")
+			common.AssertEqual(t, tc.expETA, gotETA, "unexpected prediction")
+		})
+	}
+}
+
+func TestServer_nvmeHealthMonitor_checkDevice(t *testing.T) {
+	cfg := config.NvmeHealthConfig{
+		PredictionHorizon:    24 * time.Hour,
+		TemperatureThreshold: 70,
+		MediaErrorsThreshold: 10,
+		BioErrorsThreshold:   5,
+	}
+	healthy := &storage.NvmeHealth{Timestamp: 0, Temperature: 300, AvailSpare: 100, AvailSpareThresh: 10}
+	hot := &storage.NvmeHealth{Timestamp: 3600, Temperature: 350, AvailSpare: 100, AvailSpareThresh: 10}
+	lowSpare := &storage.NvmeHealth{Timestamp: 7200, Temperature: 300, AvailSpare: 5, AvailSpareThresh: 10}
+
+	for name, tc := range map[string]struct {
+		checks       [][]*storage.NvmeHealth
+		expEvents    []events.RASID
+		expInfo      []string
+		expThreshold []string
+	}{
+		"healthy": {
+			checks: [][]*storage.NvmeHealth{{healthy}},
+		},
+		"temperature threshold raised once": {
+			checks: [][]*storage.NvmeHealth{
+				{healthy, hot},
+				{healthy, hot},
+			},
+			expEvents: []events.RASID{events.RASNvmeHealthWarning},
+			expInfo:   []string{"temperature 76.85 reached threshold 70"},
+		},
+		"raised again after clearing": {
+			checks: [][]*storage.NvmeHealth{
+				{hot},
+				{healthy},
+				{hot},
+			},
+			expEvents: []events.RASID{events.RASNvmeHealthWarning, events.RASNvmeHealthWarning},
+		},
+		"device spare threshold": {
+			checks:       [][]*storage.NvmeHealth{{healthy, lowSpare}},
+			expEvents:    []events.RASID{events.RASNvmeHealthWarning},
+			expInfo:      []string{"available spare 5 reached threshold 10"},
+			expThreshold: []string{"available spare 5 reached threshold 10"},
+		},
+		"trend needs enough samples": {
+			checks: [][]*storage.NvmeHealth{{
+				{Timestamp: 0, MediaErrors: 0},
+				{Timestamp: 3600, MediaErrors: 4},
+			}},
+		},
+		"failure predicted": {
+			checks: [][]*storage.NvmeHealth{{
+				{Timestamp: 0, MediaErrors: 0},
+				{Timestamp: 3600, MediaErrors: 2},
+				{Timestamp: 7200, MediaErrors: 4},
+			}},
+			expEvents: []events.RASID{events.RASNvmeFailurePredicted},
+			expInfo:   []string{"media errors 4 predicted to reach threshold 10 in 3h0m0s"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			ps := events.NewPubSub(ctx, log)
+			defer ps.Close()
+			published := make(chan *events.RASEvent, 10)
+			ps.Subscribe(events.RASTypeAny, events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+				published <- evt
+			}))
+
+			hm := newNvmeHealthMonitor(log, cfg, nil, ps)
+			var gotThreshold []string
+			hm.onThreshold = func(rank system.Rank, uuid, detail string) {
+				common.AssertEqual(t, system.Rank(1), rank, "unexpected rank")
+				common.AssertEqual(t, common.MockUUID(), uuid, "unexpected device")
+				gotThreshold = append(gotThreshold, detail)
+			}
+			for _, history := range tc.checks {
+				hm.checkDevice(system.Rank(1), common.MockUUID(), history)
+			}
+			if diff := cmp.Diff(tc.expThreshold, gotThreshold); diff != "" {
+				t.Fatalf("unexpected threshold callbacks (-want, +got):\n%s\n", diff)
+			}
+
+			for i, expID := range tc.expEvents {
+				select {
+				case evt := <-published:
+					common.AssertEqual(t, expID, evt.ID, "unexpected event published")
+					common.AssertEqual(t, common.MockUUID(), evt.HWID, "unexpected device")
+					common.AssertEqual(t, uint32(1), evt.Rank, "unexpected rank")
+					if i < len(tc.expInfo) {
+						common.AssertEqual(t, tc.expInfo[i], string(*evt.GetStrInfo()), "unexpected event info")
+					}
+				case <-time.After(5 * time.Second):
+					t.Fatalf("timed out waiting for %s event", expID)
+				}
+			}
+			select {
+			case evt := <-published:
+				t.Fatalf("unexpected %s event published", evt.ID)
+			case <-time.After(100 * time.Millisecond):
+			}
+		})
+	}
+}
+
+func TestServer_nvmeHealthMonitor_sample(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestEngine(log, false, engine.NewConfig().WithTargetCount(1).WithScmMountPoint("/mnt/daos"))
+	srv.fsRoot = testDir
+	if err := os.MkdirAll(srv.storagePath(), 0755); err != nil {
+		t.Fatal(err)
+	}
+	devResp := &mockDrpcResponse{Message: &ctlpb.SmdDevResp{
+		Devices: []*ctlpb.SmdDevResp_Device{{Uuid: common.MockUUID(0), TrAddr: "0000:80:00.0"}},
+	}}
+	var drpcResps []*mockDrpcResponse
+	for i := 0; i < 3; i++ {
+		drpcResps = append(drpcResps, devResp, &mockDrpcResponse{Message: &ctlpb.BioHealthResp{
+			DevUuid:     common.MockUUID(0),
+			Temperature: 300 + uint32(i),
+			AvailSpare:  100,
+			MediaErrs:   uint64(i),
+		}})
+	}
+	dcc := new(mockDrpcClientConfig)
+	dcc.setSendMsgResponseList(t, drpcResps...)
+	srv.setDrpcClient(newMockDrpcClient(dcc))
+
+	harness := NewEngineHarness(log)
+	if err := harness.AddInstance(srv); err != nil {
+		t.Fatal(err)
+	}
+
+	// A history left by a device that has since been removed is dropped.
+	stale := &nvmeHealthHistory{
+		Devices: map[string][]*storage.NvmeHealth{
+			common.MockUUID(1): {{Timestamp: 1}},
+		},
+		Slots: map[string]string{
+			common.MockUUID(1): "0000:81:00.0",
+		},
+	}
+	data, err := json.Marshal(stale)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(srv.storagePath(), nvmeHealthFile), data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ps := events.NewPubSub(ctx, log)
+	defer ps.Close()
+	cfg := config.NvmeHealthConfig{SampleInterval: time.Minute, HistoryLength: 2}
+	hm := newNvmeHealthMonitor(log, cfg, harness, ps)
+	now := time.Unix(1000, 0)
+	hm.now = func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+	for i := 0; i < 3; i++ {
+		hm.sample(ctx)
+	}
+
+	expHistory := []*storage.NvmeHealth{
+		{Timestamp: 1120, Temperature: 301, AvailSpare: 100, MediaErrors: 1},
+		{Timestamp: 1180, Temperature: 302, AvailSpare: 100, MediaErrors: 2},
+	}
+	gotHistory, err := hm.deviceHistory(srv, common.MockUUID(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expHistory, gotHistory); diff != "" {
+		t.Fatalf("unexpected history (-want, +got):\n%s\n", diff)
+	}
+
+	// The history is reloaded from the SCM mount by a new monitor.
+	reloaded, err := newNvmeHealthMonitor(log, cfg, harness, ps).deviceHistory(srv, common.MockUUID(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expHistory, reloaded); diff != "" {
+		t.Fatalf("unexpected reloaded history (-want, +got):\n%s\n", diff)
+	}
+	removed, err := hm.deviceHistory(srv, common.MockUUID(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, 0, len(removed), "history of removed device not dropped")
+	slot, err := hm.deviceSlot(srv, common.MockUUID(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, "", slot, "slot of removed device not dropped")
+
+	slot, err = hm.deviceSlot(srv, common.MockUUID(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, "0000:80:00.0", slot, "unexpected device slot")
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/mjmac/soad/src/control/build"
 	ctlpb "github.com/mjmac/soad/src/control/common/proto/ctl"
 	mgmtpb "github.com/mjmac/soad/src/control/common/proto/mgmt"
+	srvpb "github.com/mjmac/soad/src/control/common/proto/srv"
 	"github.com/mjmac/soad/src/control/events"
 	"github.com/mjmac/soad/src/control/lib/control"
 	"github.com/mjmac/soad/src/control/lib/netdetect"
@@ -71,6 +72,25 @@ func raftDir(cfg *config.Server) string {
 	return filepath.Join(cfg.Engines[0].Storage.SCM.MountPoint, "control_raft")
 }
 
+// sysdbConfig returns the system database configuration derived from the
+// server configuration.
+func sysdbConfig(cfg *config.Server) (*system.DatabaseConfig, error) {
+	var dbReplicas []*net.TCPAddr
+	for _, ap := range cfg.AccessPoints {
+		apAddr, err := net.ResolveTCPAddr("tcp", ap)
+		if err != nil {
+			return nil, config.FaultConfigBadAccessPoints
+		}
+		dbReplicas = append(dbReplicas, apAddr)
+	}
+
+	return &system.DatabaseConfig{
+		Replicas:   dbReplicas,
+		RaftDir:    raftDir(cfg),
+		SystemName: cfg.SystemName,
+	}, nil
+}
+
 func hostname() string {
 	hn, err := os.Hostname()
 	if err != nil {
@@ -94,6 +114,13 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 	// Backup active config.
 	config.SaveActiveConfig(log, cfg)
 
+	// Keep the config file contents so that changes can be identified on
+	// reload.
+	startupCfgData, err := ioutil.ReadFile(cfg.Path)
+	if err != nil {
+		log.Debugf("config reload disabled: %s", err)
+	}
+
 	if cfg.HelperLogFile != "" {
 		if err := os.Setenv(pbin.DaosAdminLogFileEnvVar, cfg.HelperLogFile); err != nil {
 			return errors.Wrap(err, "unable to configure privileged helper logging")
@@ -124,6 +151,11 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 	}
 
 	bdevProvider := bdev.DefaultProvider(log)
+	if cfg.NvmeEmulation.Enabled() {
+		log.Infof("emulating %d NVMe controllers with files in %s",
+			cfg.NvmeEmulation.ControllerCount, cfg.NvmeEmulation.Dir)
+		bdevProvider = bdev.NewEmulatedProvider(log, cfg.NvmeEmulation)
+	}
 	runningUser, err := user.Current()
 	if err != nil {
 		return errors.Wrap(err, "unable to lookup current user")
@@ -148,8 +180,9 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 		prepReq.HugePageCount = cfg.NrHugepages * len(cfg.Engines)
 
 		// Perform these checks to avoid even trying a prepare if the system
-		// isn't configured properly.
-		if runningUser.Uid != "0" {
+		// isn't configured properly. Emulated controllers are not bound
+		// to VFIO.
+		if runningUser.Uid != "0" && !cfg.NvmeEmulation.Enabled() {
 			if cfg.DisableVFIO {
 				return FaultVfioDisabled
 			}
@@ -177,27 +210,24 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 		}
 	}
 
-	var dbReplicas []*net.TCPAddr
-	for _, ap := range cfg.AccessPoints {
-		apAddr, err := net.ResolveTCPAddr("tcp", ap)
-		if err != nil {
-			return config.FaultConfigBadAccessPoints
-		}
-		dbReplicas = append(dbReplicas, apAddr)
+	dbCfg, err := sysdbConfig(cfg)
+	if err != nil {
+		return err
 	}
 
 	// If this daos_server instance ends up being the MS leader,
 	// this will record the DAOS system membership.
-	sysdb, err := system.NewDatabase(log, &system.DatabaseConfig{
-		Replicas:   dbReplicas,
-		RaftDir:    raftDir(cfg),
-		SystemName: cfg.SystemName,
-	})
+	sysdb, err := system.NewDatabase(log, dbCfg)
 	if err != nil {
 		return errors.Wrap(err, "failed to create system database")
 	}
 	membership := system.NewMembership(log, sysdb)
 	scmProvider := scm.DefaultProvider(log)
+	if cfg.ScmEmulation.Enabled() {
+		log.Infof("emulating %d SCM modules with state in %s",
+			cfg.ScmEmulation.ModuleCount, cfg.ScmEmulation.StateDir)
+		scmProvider = scm.NewEmulatedProvider(log, cfg.ScmEmulation)
+	}
 	harness := NewEngineHarness(log).WithFaultDomain(faultDomain)
 
 	// Create rpcClient for inter-server communication.
@@ -214,9 +244,13 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 	// Init management RPC subsystem.
 	mgmtSvc := newMgmtSvc(harness, membership, sysdb, rpcClient, eventPubSub)
 
+	// Create control service.
+	controlService := NewControlService(log, harness, bdevProvider, scmProvider, cfg, eventPubSub)
+
 	// Forward published actionable events (type RASTypeStateChange) to the
 	// management service leader, behavior is updated on leadership change.
 	eventForwarder := control.NewEventForwarder(rpcClient, cfg.AccessPoints)
+	controlService.OnAccessPointsChanged(eventForwarder.SetAccessPoints)
 	eventPubSub.Subscribe(events.RASTypeStateChange, eventForwarder)
 	// Log events on the host that they were raised (and first published) on.
 	eventLogger := control.NewEventLogger(log)
@@ -239,7 +273,7 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 
 	// Create a closure to be used for joining engine instances.
 	joinInstance := func(ctx context.Context, req *control.SystemJoinReq) (*control.SystemJoinResp, error) {
-		req.SetHostList(cfg.AccessPoints)
+		req.SetHostList(controlService.accessPoints())
 		req.SetSystem(cfg.SystemName)
 		req.ControlAddr = controlAddr
 		return control.SystemJoin(ctx, rpcClient, req)
@@ -274,18 +308,35 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 		// Indicate whether VMD devices have been detected and can be used.
 		srvCfg.Storage.Bdev.VmdDisabled = bdevProvider.IsVMDDisabled()
 
-		bp, err := bdev.NewClassProvider(log, srvCfg.Storage.SCM.MountPoint, &srvCfg.Storage.Bdev)
+		bdevCfg := &srvCfg.Storage.Bdev
+		if cfg.NvmeEmulation.Enabled() {
+			// The engine accesses emulated controllers through AIO on
+			// the files backing their namespaces.
+			bdevCfg, err = bdev.EmulatedEngineConfig(cfg.NvmeEmulation, bdevCfg)
+			if err != nil {
+				return errors.Wrapf(err, "engine %d", idx)
+			}
+		}
+
+		bp, err := bdev.NewClassProvider(log, srvCfg.Storage.SCM.MountPoint, bdevCfg)
 		if err != nil {
 			return err
 		}
+		srvCfg.Storage.Bdev.VosEnv = bdevCfg.VosEnv
+		srvCfg.Storage.Bdev.ConfigPath = bdevCfg.ConfigPath
 
 		srv := NewEngineInstance(log, bp, scmProvider, joinInstance, engine.NewRunner(log, srvCfg)).
-			WithHostFaultDomain(faultDomain)
+			WithHostFaultDomain(faultDomain).
+			WithBdevEmulation(cfg.NvmeEmulation.Enabled())
 		if err := harness.AddInstance(srv); err != nil {
 			return err
 		}
 		// Register callback to publish I/O Engine process exit events.
 		srv.OnInstanceExit(publishInstanceExitFn(eventPubSub.Publish, hostname(), srv.Index()))
+		// Register callback to count BIO errors against the FAULTY thresholds.
+		srv.OnBioError(func(bio *srvpb.BioErrorReq) {
+			controlService.nvmeFaulty.bioError(srv, bio)
+		})
 
 		if idx == 0 {
 			netDevClass, err = cfg.GetDeviceClassFn(srvCfg.Fabric.Interface)
@@ -328,11 +379,13 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 		}
 	}
 
-	// Create and setup control service.
-	controlService := NewControlService(log, harness, bdevProvider, scmProvider, cfg, eventPubSub)
+	// Setup control service.
 	if err := controlService.Setup(); err != nil {
 		return errors.Wrap(err, "setup control service")
 	}
+	if startupCfgData != nil {
+		controlService.enableConfigReload(startupCfgData)
+	}
 
 	// Create and start listener on management network.
 	lis, err := net.Listen("tcp4", controlAddr.String())
@@ -354,14 +407,14 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 	}
 	srvOpts := []grpc.ServerOption{tcOpt}
 
-	uintOpt, err := unaryInterceptorForTransportConfig(cfg.TransportConfig)
+	uintOpt, err := unaryInterceptorForTransportConfig(log, cfg.TransportConfig)
 	if err != nil {
 		return err
 	}
 	if uintOpt != nil {
 		unaryInterceptors = append(unaryInterceptors, uintOpt)
 	}
-	sintOpt, err := streamInterceptorForTransportConfig(cfg.TransportConfig)
+	sintOpt, err := streamInterceptorForTransportConfig(log, cfg.TransportConfig)
 	if err != nil {
 		return err
 	}
@@ -382,6 +435,8 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 		CrtTimeout:      cfg.Fabric.CrtTimeout,
 		NetDevClass:     netDevClass,
 	}
+	mgmtSvc.autoExclude.configure(cfg.AutoExclude)
+	mgmtSvc.startReplica = newReplicaStarter(ctx, sysdb)
 	mgmtpb.RegisterMgmtSvcServer(grpcServer, mgmtSvc)
 
 	tSec, err := security.DialOptionForTransportConfig(cfg.TransportConfig)
@@ -398,7 +453,17 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 		eventPubSub.Reset()
 		eventPubSub.Subscribe(events.RASTypeAny, eventLogger)
 		eventPubSub.Subscribe(events.RASTypeStateChange, membership)
-		eventPubSub.Subscribe(events.RASTypeStateChange, sysdb)
+		// Record events received by the MS in the system event log.
+		eventPubSub.Subscribe(events.RASTypeAny, sysdb)
+		// Deliver all events received by the MS to live event
+		// stream subscribers.
+		mgmtSvc.eventStreams.start()
+		eventPubSub.Subscribe(events.RASTypeAny, mgmtSvc.eventStreams)
+		// Exclude dead ranks from their pools if the policy is
+		// enabled. Pending exclusions are abandoned when
+		// leadership is lost.
+		mgmtSvc.autoExclude.start(ctx)
+		eventPubSub.Subscribe(events.RASTypeStateChange, mgmtSvc.autoExclude)
 		eventPubSub.Subscribe(events.RASTypeStateChange, events.HandlerFunc(func(ctx context.Context, evt *events.RASEvent) {
 			switch evt.ID {
 			case events.RASSwimRankDead:
@@ -422,6 +487,10 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 	sysdb.OnLeadershipLost(func() error {
 		log.Infof("MS leader no longer running on %s", hostname())
 
+		// Close live event streams so that subscribers can
+		// reconnect to the new MS leader.
+		mgmtSvc.eventStreams.stop()
+
 		// Stop handling received forwarded (in addition to local)
 		// events and start forwarding events to the new MS leader.
 		eventPubSub.Reset()
@@ -436,6 +505,18 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 	}()
 	defer grpcServer.Stop()
 
+	controlService.nvmeHealth.start(ctx)
+	controlService.nvmeFaulty.start(ctx)
+
+	if cfg.MetricsAddress != "" {
+		metricsLis, err := net.Listen("tcp", cfg.MetricsAddress)
+		if err != nil {
+			return errors.Wrap(err, "unable to listen on metrics address")
+		}
+		serveMetrics(ctx, log, metricsLis, newMetricsCollector(log, harness, sysdb))
+		log.Infof("metrics available at http://%s%s", metricsLis.Addr(), metricsPath)
+	}
+
 	log.Infof("%s v%s (pid %d) listening on %s", build.ControlPlaneName, build.DaosVersion, os.Getpid(), controlAddr)
 
 	sigChan := make(chan os.Signal)
@@ -449,5 +530,22 @@ func Start(log *logging.LeveledLogger, cfg *config.Server) error {
 		shutdown()
 	}()
 
+	// Reload the config file and apply safe changes on SIGHUP.
+	hupChan := make(chan os.Signal, 1)
+	signal.Notify(hupChan, syscall.SIGHUP)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hupChan:
+				log.Infof("Caught signal: %s, reloading %s", syscall.SIGHUP, cfg.Path)
+				if _, err := controlService.reloadConfig(); err != nil {
+					log.Errorf("config reload failed: %s", err)
+				}
+			}
+		}
+	}()
+
 	return errors.Wrapf(harness.Start(ctx, sysdb, eventPubSub, cfg), "%s exited with error", build.DataPlaneName)
 }
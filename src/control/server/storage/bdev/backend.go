@@ -227,16 +227,7 @@ func (b *spdkBackend) Format(req FormatRequest) (*FormatResponse, error) {
 	// TODO (DAOS-3844): Kick off device formats parallel?
 	switch req.Class {
 	case storage.BdevClassKdev, storage.BdevClassFile, storage.BdevClassMalloc:
-		resp := &FormatResponse{
-			DeviceResponses: make(DeviceFormatResponses),
-		}
-
-		for _, device := range req.DeviceList {
-			resp.DeviceResponses[device] = new(DeviceFormatResponse)
-			b.log.Debugf("%s format for non-NVMe bdev skipped on %s", req.Class, device)
-		}
-
-		return resp, nil
+		return skipFormat(b.log, req), nil
 	case storage.BdevClassNvme:
 		if len(req.DeviceList) == 0 {
 			return nil, errors.New("empty pci address list in nvme format request")
@@ -248,6 +239,21 @@ func (b *spdkBackend) Format(req FormatRequest) (*FormatResponse, error) {
 	}
 }
 
+// skipFormat returns a response for a format of non-NVMe bdevs, which
+// require no formatting.
+func skipFormat(log logging.Logger, req FormatRequest) *FormatResponse {
+	resp := &FormatResponse{
+		DeviceResponses: make(DeviceFormatResponses),
+	}
+
+	for _, device := range req.DeviceList {
+		resp.DeviceResponses[device] = new(DeviceFormatResponse)
+		log.Debugf("%s format for non-NVMe bdev skipped on %s", req.Class, device)
+	}
+
+	return resp
+}
+
 // detectVMD returns whether VMD devices have been found and a slice of VMD
 // PCI addresses if found.
 func detectVMD() ([]string, error) {
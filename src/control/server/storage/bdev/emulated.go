@@ -0,0 +1,428 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package bdev
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/storage"
+)
+
+const (
+	emulatedStateFile    = "nvme_emulation.json"
+	emulatedFirstBus     = 0x80
+	emulatedModel        = "DAOS emulated NVMe"
+	emulatedFwRev        = "EMU1.0"
+	emulatedFwSlots      = 3
+	emulatedFwRevMaxLen  = 8 // length of the NVMe firmware revision field
+	emulatedTemperature  = 300
+	emulatedSpareThresh  = 10
+	emulatedAvailSpare   = 100
+	emulatedStateVersion = 1
+)
+
+type (
+	// emulatedNamespace is a namespace of an emulated controller, backed
+	// by a sparse file.
+	emulatedNamespace struct {
+		ID   uint32 `json:"id"`
+		Size uint64 `json:"size"`
+		Path string `json:"path"`
+	}
+
+	// emulatedController is the persisted state of an emulated controller.
+	emulatedController struct {
+		PciAddr     string               `json:"pci_addr"`
+		Serial      string               `json:"serial"`
+		FwSlots     []string             `json:"fw_slots"`
+		ActiveSlot  int                  `json:"active_slot"` // 1-based, as in NVMe
+		Namespaces  []*emulatedNamespace `json:"namespaces"`
+		PowerCycles uint64               `json:"power_cycles"`
+		Created     time.Time            `json:"created"`
+		Health      storage.NvmeHealth   `json:"health"`
+	}
+
+	// emulatedState is the persisted state of all emulated controllers.
+	emulatedState struct {
+		Version     int                   `json:"version"`
+		Controllers []*emulatedController `json:"controllers"`
+	}
+
+	// emulatedBackend is a Backend that emulates NVMe controllers with
+	// files, so that scan, format and firmware update can be exercised on
+	// hosts without NVMe SSDs and without privileges. The state of the
+	// controllers is kept in a file so that it persists across restarts.
+	emulatedBackend struct {
+		sync.Mutex
+		log         logging.Logger
+		cfg         storage.BdevEmulationConfig
+		vmdDisabled bool
+		now         func() time.Time
+	}
+)
+
+// NewEmulatedProvider returns a Provider for the NVMe controllers emulated
+// as described by the configuration. Forwarding to the privileged helper is
+// disabled as the emulated controllers are only files.
+func NewEmulatedProvider(log logging.Logger, cfg storage.BdevEmulationConfig) *Provider {
+	return NewProvider(log, newEmulatedBackend(log, cfg)).WithForwardingDisabled()
+}
+
+func newEmulatedBackend(log logging.Logger, cfg storage.BdevEmulationConfig) *emulatedBackend {
+	return &emulatedBackend{
+		log:         log,
+		cfg:         cfg,
+		vmdDisabled: true,
+		now:         time.Now,
+	}
+}
+
+func emulatedPciAddr(idx int) string {
+	return fmt.Sprintf("0000:%02x:00.0", emulatedFirstBus+idx)
+}
+
+func emulatedNamespacePath(cfg storage.BdevEmulationConfig, pciAddr string, nsID int) string {
+	return filepath.Join(cfg.Dir,
+		fmt.Sprintf("nvme_%s_ns%d", strings.ReplaceAll(pciAddr, ":", "."), nsID))
+}
+
+func emulatedNamespaceSize(cfg storage.BdevEmulationConfig) uint64 {
+	// requested size aligned with block size
+	return uint64((int64(cfg.NamespaceSize*gbyte) / int64(blkSize)) * int64(blkSize))
+}
+
+// EmulatedEngineConfig returns a copy of an engine's block device
+// configuration in which the PCI addresses of emulated controllers are
+// replaced by the files backing their namespaces, to be used through AIO.
+func EmulatedEngineConfig(cfg storage.BdevEmulationConfig, bdevCfg *storage.BdevConfig) (*storage.BdevConfig, error) {
+	out := *bdevCfg
+	if bdevCfg.Class != storage.BdevClassNvme || len(bdevCfg.DeviceList) == 0 {
+		return &out, nil
+	}
+
+	emulated := make(map[string]bool)
+	for i := 0; i < cfg.ControllerCount; i++ {
+		emulated[emulatedPciAddr(i)] = true
+	}
+
+	out.Class = storage.BdevClassKdev
+	out.DeviceList = nil
+	for _, addr := range bdevCfg.DeviceList {
+		if !emulated[addr] {
+			return nil, FaultPCIAddrNotFound(addr)
+		}
+		for nsID := 1; nsID <= cfg.NamespaceCount; nsID++ {
+			out.DeviceList = append(out.DeviceList, emulatedNamespacePath(cfg, addr, nsID))
+		}
+	}
+
+	return &out, nil
+}
+
+func (b *emulatedBackend) statePath() string {
+	return filepath.Join(b.cfg.Dir, emulatedStateFile)
+}
+
+func (b *emulatedBackend) newController(idx int) *emulatedController {
+	ctrlr := &emulatedController{
+		PciAddr:    emulatedPciAddr(idx),
+		Serial:     fmt.Sprintf("EMUL%08d", idx),
+		FwSlots:    make([]string, emulatedFwSlots),
+		ActiveSlot: 1,
+		Created:    b.now(),
+		Health: storage.NvmeHealth{
+			Temperature:      emulatedTemperature,
+			AvailSpare:       emulatedAvailSpare,
+			AvailSpareThresh: emulatedSpareThresh,
+		},
+	}
+	ctrlr.FwSlots[0] = emulatedFwRev
+
+	for nsID := 1; nsID <= b.cfg.NamespaceCount; nsID++ {
+		ctrlr.Namespaces = append(ctrlr.Namespaces, &emulatedNamespace{
+			ID:   uint32(nsID),
+			Size: emulatedNamespaceSize(b.cfg),
+			Path: emulatedNamespacePath(b.cfg, ctrlr.PciAddr, nsID),
+		})
+	}
+
+	return ctrlr
+}
+
+// loadState reads the state of the emulated controllers, creating any
+// controllers and backing files that are missing.
+func (b *emulatedBackend) loadState() (*emulatedState, error) {
+	state := &emulatedState{Version: emulatedStateVersion}
+
+	data, err := ioutil.ReadFile(b.statePath())
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, state); err != nil {
+			return nil, errors.Wrapf(err, "parse %s", b.statePath())
+		}
+	case os.IsNotExist(err):
+		if err := os.MkdirAll(b.cfg.Dir, 0755); err != nil {
+			return nil, errors.Wrap(err, "create nvme emulation directory")
+		}
+	default:
+		return nil, errors.Wrap(err, "read nvme emulation state")
+	}
+
+	changed := len(state.Controllers) != b.cfg.ControllerCount
+	if len(state.Controllers) > b.cfg.ControllerCount {
+		state.Controllers = state.Controllers[:b.cfg.ControllerCount]
+	}
+	for i := len(state.Controllers); i < b.cfg.ControllerCount; i++ {
+		b.log.Debugf("creating emulated nvme controller %s", emulatedPciAddr(i))
+		state.Controllers = append(state.Controllers, b.newController(i))
+	}
+
+	for _, ctrlr := range state.Controllers {
+		if ctrlr.ActiveSlot < 1 || ctrlr.ActiveSlot > len(ctrlr.FwSlots) {
+			return nil, errors.Errorf("%s: emulated nvme device at %s has invalid active firmware slot %d",
+				b.statePath(), ctrlr.PciAddr, ctrlr.ActiveSlot)
+		}
+		for _, ns := range ctrlr.Namespaces {
+			if _, err := os.Stat(ns.Path); err == nil {
+				continue
+			}
+			if err := truncateFile(ns.Path, ns.Size); err != nil {
+				return nil, errors.Wrapf(err, "create backing file of %s", ctrlr.PciAddr)
+			}
+		}
+	}
+
+	if changed {
+		if err := b.saveState(state); err != nil {
+			return nil, err
+		}
+	}
+
+	return state, nil
+}
+
+func (b *emulatedBackend) saveState(state *emulatedState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshal nvme emulation state")
+	}
+
+	return errors.Wrap(common.WriteFileAtomic(b.statePath(), data, 0644),
+		"write nvme emulation state")
+}
+
+// truncateFile creates or truncates a sparse file of the given size,
+// discarding any existing content.
+func truncateFile(path string, size uint64) error {
+	f, err := common.TruncFile(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return f.Truncate(int64(size))
+}
+
+func findEmulatedController(state *emulatedState, pciAddr string) *emulatedController {
+	for _, ctrlr := range state.Controllers {
+		if ctrlr.PciAddr == pciAddr {
+			return ctrlr
+		}
+	}
+	return nil
+}
+
+// toController returns the controller details reported by scan, with the
+// power-on hours derived from the time the controller was created.
+func (c *emulatedController) toController(now time.Time) *storage.NvmeController {
+	health := c.Health
+	health.Timestamp = uint64(now.Unix())
+	health.PowerCycles = c.PowerCycles
+	health.PowerOnHours = uint64(now.Sub(c.Created) / time.Hour)
+
+	ctrlr := &storage.NvmeController{
+		Model:       emulatedModel,
+		Serial:      c.Serial,
+		PciAddr:     c.PciAddr,
+		FwRev:       c.FwSlots[c.ActiveSlot-1],
+		HealthStats: &health,
+	}
+	for _, ns := range c.Namespaces {
+		ctrlr.Namespaces = append(ctrlr.Namespaces, &storage.NvmeNamespace{
+			ID:   ns.ID,
+			Size: ns.Size,
+		})
+	}
+
+	return ctrlr
+}
+
+// DisableVMD turns off VMD device awareness.
+func (b *emulatedBackend) DisableVMD() {
+	b.vmdDisabled = true
+}
+
+// IsVMDDisabled checks for VMD device awareness.
+func (b *emulatedBackend) IsVMDDisabled() bool {
+	return b.vmdDisabled
+}
+
+// PrepareReset is a no-op as emulated controllers are never bound to a
+// user-space driver.
+func (b *emulatedBackend) PrepareReset() error {
+	return nil
+}
+
+// Prepare creates the emulated controllers and their backing files if they
+// don't exist yet, and counts a power cycle for each controller.
+func (b *emulatedBackend) Prepare(_ PrepareRequest) (*PrepareResponse, error) {
+	b.Lock()
+	defer b.Unlock()
+
+	state, err := b.loadState()
+	if err != nil {
+		return nil, err
+	}
+	for _, ctrlr := range state.Controllers {
+		ctrlr.PowerCycles++
+	}
+
+	return &PrepareResponse{}, b.saveState(state)
+}
+
+// Scan returns the emulated controllers in the request device list, or all
+// of them if the list is empty.
+func (b *emulatedBackend) Scan(req ScanRequest) (*ScanResponse, error) {
+	b.Lock()
+	defer b.Unlock()
+
+	state, err := b.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ScanResponse{Controllers: make(storage.NvmeControllers, 0, len(state.Controllers))}
+	now := b.now()
+	for _, ctrlr := range state.Controllers {
+		resp.Controllers = append(resp.Controllers, ctrlr.toController(now))
+	}
+	_, resp = resp.filter(req.DeviceList...)
+
+	return resp, nil
+}
+
+// Format discards the content of the files backing the namespaces of the
+// emulated controllers in the request device list.
+func (b *emulatedBackend) Format(req FormatRequest) (*FormatResponse, error) {
+	switch req.Class {
+	case storage.BdevClassKdev, storage.BdevClassFile, storage.BdevClassMalloc:
+		return skipFormat(b.log, req), nil
+	case storage.BdevClassNvme:
+		if len(req.DeviceList) == 0 {
+			return nil, errors.New("empty pci address list in nvme format request")
+		}
+	default:
+		return nil, FaultFormatUnknownClass(req.Class.String())
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	state, err := b.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &FormatResponse{
+		DeviceResponses: make(DeviceFormatResponses),
+	}
+	for _, addr := range req.DeviceList {
+		devResp := new(DeviceFormatResponse)
+		resp.DeviceResponses[addr] = devResp
+
+		ctrlr := findEmulatedController(state, addr)
+		if ctrlr == nil {
+			devResp.Error = FaultFormatError(addr, FaultPCIAddrNotFound(addr))
+			continue
+		}
+
+		var formatted []uint32
+		for _, ns := range ctrlr.Namespaces {
+			if err := truncateFile(ns.Path, ns.Size); err != nil {
+				devResp.Error = FaultFormatError(addr, errors.Wrapf(err,
+					"namespace %d", ns.ID))
+				break
+			}
+			formatted = append(formatted, ns.ID)
+		}
+		if devResp.Error != nil {
+			continue
+		}
+
+		b.log.Debugf("formatted namespaces %v on emulated nvme device at %s", formatted, addr)
+		devResp.Formatted = true
+	}
+
+	return resp, nil
+}
+
+// UpdateFirmware stores the revision named by the firmware image file in a
+// firmware slot of the emulated controller and activates it. The revision
+// is the base name of the file without extension, truncated to the length
+// of the NVMe firmware revision field. Slot 0 selects the slot after the
+// active one.
+func (b *emulatedBackend) UpdateFirmware(pciAddr string, path string, slot int32) error {
+	if pciAddr == "" {
+		return FaultBadPCIAddr("")
+	}
+	if _, err := os.Stat(path); err != nil {
+		return errors.Wrap(err, "firmware image")
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	state, err := b.loadState()
+	if err != nil {
+		return err
+	}
+
+	ctrlr := findEmulatedController(state, pciAddr)
+	if ctrlr == nil {
+		return FaultPCIAddrNotFound(pciAddr)
+	}
+
+	switch {
+	case slot < 0 || int(slot) > len(ctrlr.FwSlots):
+		return errors.Errorf("invalid firmware slot %d (1-%d)", slot, len(ctrlr.FwSlots))
+	case slot == 0:
+		slot = int32(ctrlr.ActiveSlot%len(ctrlr.FwSlots)) + 1
+	}
+
+	rev := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if len(rev) > emulatedFwRevMaxLen {
+		rev = rev[:emulatedFwRevMaxLen]
+	}
+
+	b.log.Debugf("updating emulated nvme device at %s to firmware %q in slot %d",
+		pciAddr, rev, slot)
+	ctrlr.FwSlots[slot-1] = rev
+	ctrlr.ActiveSlot = int(slot)
+
+	return b.saveState(state)
+}
@@ -0,0 +1,383 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package bdev
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/storage"
+)
+
+func newTestEmulatedBackend(t *testing.T, log logging.Logger, dir string) *emulatedBackend {
+	t.Helper()
+
+	b := newEmulatedBackend(log, storage.BdevEmulationConfig{
+		Dir:             dir,
+		ControllerCount: 2,
+		NamespaceCount:  2,
+		NamespaceSize:   1,
+	})
+	created := time.Unix(1000, 0)
+	b.now = func() time.Time { return created }
+
+	return b
+}
+
+func TestBdev_emulatedBackend_Scan(t *testing.T) {
+	nsSize := uint64((gbyte / blkSize) * blkSize)
+
+	for name, tc := range map[string]struct {
+		req         ScanRequest
+		hoursLater  int
+		expPciAddrs []string
+	}{
+		"all controllers": {
+			expPciAddrs: []string{"0000:80:00.0", "0000:81:00.0"},
+		},
+		"filtered": {
+			req:         ScanRequest{DeviceList: []string{"0000:81:00.0"}},
+			expPciAddrs: []string{"0000:81:00.0"},
+		},
+		"power-on hours": {
+			hoursLater:  5,
+			expPciAddrs: []string{"0000:80:00.0", "0000:81:00.0"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := common.CreateTestDir(t)
+			defer cleanup()
+
+			b := newTestEmulatedBackend(t, log, testDir)
+			if _, err := b.Scan(ScanRequest{}); err != nil {
+				t.Fatal(err)
+			}
+			later := time.Unix(1000, 0).Add(time.Duration(tc.hoursLater) * time.Hour)
+			b.now = func() time.Time { return later }
+
+			resp, err := b.Scan(tc.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var gotPciAddrs []string
+			for _, ctrlr := range resp.Controllers {
+				gotPciAddrs = append(gotPciAddrs, ctrlr.PciAddr)
+
+				common.AssertEqual(t, emulatedModel, ctrlr.Model, "unexpected model")
+				common.AssertEqual(t, emulatedFwRev, ctrlr.FwRev, "unexpected firmware")
+				common.AssertEqual(t, uint64(tc.hoursLater), ctrlr.HealthStats.PowerOnHours,
+					"unexpected power-on hours")
+				common.AssertEqual(t, uint32(emulatedTemperature), ctrlr.HealthStats.Temperature,
+					"unexpected temperature")
+
+				expNamespaces := []*storage.NvmeNamespace{
+					{ID: 1, Size: nsSize},
+					{ID: 2, Size: nsSize},
+				}
+				if diff := cmp.Diff(expNamespaces, ctrlr.Namespaces); diff != "" {
+					t.Fatalf("unexpected namespaces (-want, +got):\n%s\n", diff)
+				}
+				for _, ns := range ctrlr.Namespaces {
+					fi, err := os.Stat(emulatedNamespacePath(b.cfg, ctrlr.PciAddr, int(ns.ID)))
+					if err != nil {
+						t.Fatal(err)
+					}
+					common.AssertEqual(t, int64(nsSize), fi.Size(), "unexpected backing file size")
+				}
+			}
+			if diff := cmp.Diff(tc.expPciAddrs, gotPciAddrs); diff != "" {
+				t.Fatalf("unexpected controllers (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestBdev_emulatedBackend_Prepare(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+
+	b := newTestEmulatedBackend(t, log, testDir)
+	for i := 0; i < 2; i++ {
+		if _, err := b.Prepare(PrepareRequest{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The state persists across backend instances.
+	b = newTestEmulatedBackend(t, log, testDir)
+	resp, err := b.Scan(ScanRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ctrlr := range resp.Controllers {
+		common.AssertEqual(t, uint64(2), ctrlr.HealthStats.PowerCycles,
+			"unexpected power cycles")
+	}
+}
+
+func TestBdev_emulatedBackend_Format(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     FormatRequest
+		expResp *FormatResponse
+		expErr  error
+	}{
+		"formatted": {
+			req: FormatRequest{
+				Class:      storage.BdevClassNvme,
+				DeviceList: []string{"0000:80:00.0"},
+			},
+			expResp: &FormatResponse{
+				DeviceResponses: DeviceFormatResponses{
+					"0000:80:00.0": {Formatted: true},
+				},
+			},
+		},
+		"unknown controller": {
+			req: FormatRequest{
+				Class:      storage.BdevClassNvme,
+				DeviceList: []string{"0000:80:00.0", "0000:90:00.0"},
+			},
+			expResp: &FormatResponse{
+				DeviceResponses: DeviceFormatResponses{
+					"0000:80:00.0": {Formatted: true},
+					"0000:90:00.0": {
+						Error: FaultFormatError("0000:90:00.0",
+							FaultPCIAddrNotFound("0000:90:00.0")),
+					},
+				},
+			},
+		},
+		"empty device list": {
+			req:    FormatRequest{Class: storage.BdevClassNvme},
+			expErr: errors.New("empty pci address list"),
+		},
+		"non-nvme class skipped": {
+			req: FormatRequest{
+				Class:      storage.BdevClassFile,
+				DeviceList: []string{"/tmp/daos-bdev"},
+			},
+			expResp: &FormatResponse{
+				DeviceResponses: DeviceFormatResponses{
+					"/tmp/daos-bdev": new(DeviceFormatResponse),
+				},
+			},
+		},
+		"unknown class": {
+			req: FormatRequest{
+				Class:      storage.BdevClass("whoops"),
+				DeviceList: []string{"0000:80:00.0"},
+			},
+			expErr: FaultFormatUnknownClass("whoops"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := common.CreateTestDir(t)
+			defer cleanup()
+
+			b := newTestEmulatedBackend(t, log, testDir)
+			if _, err := b.Prepare(PrepareRequest{}); err != nil {
+				t.Fatal(err)
+			}
+			nsPath := emulatedNamespacePath(b.cfg, "0000:80:00.0", 1)
+			if err := ioutil.WriteFile(nsPath, []byte("blobstore"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			gotResp, gotErr := b.Format(tc.req)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, defCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+
+			if devResp, found := gotResp.DeviceResponses["0000:80:00.0"]; !found || !devResp.Formatted {
+				return
+			}
+			data, err := ioutil.ReadFile(nsPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			common.AssertEqual(t, emulatedNamespaceSize(b.cfg), uint64(len(data)),
+				"unexpected backing file size")
+			common.AssertTrue(t, data[0] == 0, "backing file content not discarded")
+		})
+	}
+}
+
+func TestBdev_emulatedBackend_UpdateFirmware(t *testing.T) {
+	for name, tc := range map[string]struct {
+		pciAddr   string
+		imageName string
+		noImage   bool
+		slots     []int32
+		expRev    string
+		expErr    error
+	}{
+		"no pci address": {
+			imageName: "FW2.0.bin",
+			slots:     []int32{0},
+			expErr:    FaultBadPCIAddr(""),
+		},
+		"unknown controller": {
+			pciAddr:   "0000:90:00.0",
+			imageName: "FW2.0.bin",
+			slots:     []int32{0},
+			expErr:    FaultPCIAddrNotFound("0000:90:00.0"),
+		},
+		"missing image": {
+			pciAddr:   "0000:80:00.0",
+			imageName: "FW2.0.bin",
+			noImage:   true,
+			slots:     []int32{0},
+			expErr:    errors.New("no such file"),
+		},
+		"invalid slot": {
+			pciAddr:   "0000:80:00.0",
+			imageName: "FW2.0.bin",
+			slots:     []int32{emulatedFwSlots + 1},
+			expErr:    errors.New("invalid firmware slot"),
+		},
+		"next slot": {
+			pciAddr:   "0000:80:00.0",
+			imageName: "FW2.0.bin",
+			slots:     []int32{0},
+			expRev:    "FW2.0",
+		},
+		"long revision truncated": {
+			pciAddr:   "0000:80:00.0",
+			imageName: "REVISION123.img",
+			slots:     []int32{2},
+			expRev:    "REVISION",
+		},
+		"slots wrap around": {
+			pciAddr:   "0000:81:00.0",
+			imageName: "FW3.0.bin",
+			slots:     []int32{0, 0, 0},
+			expRev:    "FW3.0",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := common.CreateTestDir(t)
+			defer cleanup()
+
+			b := newTestEmulatedBackend(t, log, filepath.Join(testDir, "emul"))
+			image := filepath.Join(testDir, tc.imageName)
+			if !tc.noImage {
+				if err := ioutil.WriteFile(image, []byte("firmware"), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var gotErr error
+			for _, slot := range tc.slots {
+				if gotErr = b.UpdateFirmware(tc.pciAddr, image, slot); gotErr != nil {
+					break
+				}
+			}
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			resp, err := b.Scan(ScanRequest{DeviceList: []string{tc.pciAddr}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			common.AssertEqual(t, tc.expRev, resp.Controllers[0].FwRev, "unexpected firmware")
+		})
+	}
+}
+
+func TestBdev_EmulatedEngineConfig(t *testing.T) {
+	emulCfg := storage.BdevEmulationConfig{
+		Dir:             "/tmp/daos_nvme",
+		ControllerCount: 2,
+		NamespaceCount:  2,
+		NamespaceSize:   1,
+	}
+
+	for name, tc := range map[string]struct {
+		bdevCfg *storage.BdevConfig
+		expCfg  *storage.BdevConfig
+		expErr  error
+	}{
+		"no devices": {
+			bdevCfg: &storage.BdevConfig{Class: storage.BdevClassNvme},
+			expCfg:  &storage.BdevConfig{Class: storage.BdevClassNvme},
+		},
+		"non-nvme class unchanged": {
+			bdevCfg: &storage.BdevConfig{
+				Class:      storage.BdevClassFile,
+				DeviceList: []string{"/tmp/daos-bdev"},
+				FileSize:   4,
+			},
+			expCfg: &storage.BdevConfig{
+				Class:      storage.BdevClassFile,
+				DeviceList: []string{"/tmp/daos-bdev"},
+				FileSize:   4,
+			},
+		},
+		"emulated controllers": {
+			bdevCfg: &storage.BdevConfig{
+				Class:      storage.BdevClassNvme,
+				DeviceList: []string{"0000:81:00.0"},
+				Hostname:   "foo",
+			},
+			expCfg: &storage.BdevConfig{
+				Class: storage.BdevClassKdev,
+				DeviceList: []string{
+					"/tmp/daos_nvme/nvme_0000.81.00.0_ns1",
+					"/tmp/daos_nvme/nvme_0000.81.00.0_ns2",
+				},
+				Hostname: "foo",
+			},
+		},
+		"controller not emulated": {
+			bdevCfg: &storage.BdevConfig{
+				Class:      storage.BdevClassNvme,
+				DeviceList: []string{"0000:82:00.0"},
+			},
+			expErr: FaultPCIAddrNotFound("0000:82:00.0"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotCfg, gotErr := EmulatedEngineConfig(emulCfg, tc.bdevCfg)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expCfg, gotCfg); diff != "" {
+				t.Fatalf("unexpected config (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
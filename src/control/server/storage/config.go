@@ -6,7 +6,11 @@
 
 package storage
 
-import "github.com/pkg/errors"
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
 
 const (
 	// MinNVMeStorage defines the minimum per-target allocation
@@ -94,6 +98,43 @@ func (sc *ScmConfig) Validate() error {
 	return nil
 }
 
+// ScmEmulationConfig describes SCM modules that are emulated so that SCM
+// preparation, scan and firmware update can be exercised on hosts without
+// DCPM modules or ipmctl. Emulation is enabled when ModuleCount is non-zero.
+type ScmEmulationConfig struct {
+	StateDir    string `yaml:"state_dir,omitempty"`
+	ModuleCount int    `yaml:"module_count,omitempty"`
+	SocketCount int    `yaml:"socket_count,omitempty"`
+	ModuleSize  int    `yaml:"module_size,omitempty"` // GiB
+}
+
+// Enabled returns true if SCM modules are to be emulated.
+func (ec *ScmEmulationConfig) Enabled() bool {
+	return ec.ModuleCount > 0
+}
+
+func (ec *ScmEmulationConfig) Validate() error {
+	if !ec.Enabled() {
+		return nil
+	}
+
+	if !filepath.IsAbs(ec.StateDir) {
+		return errors.Errorf("state_dir %q must be an absolute path", ec.StateDir)
+	}
+	if ec.SocketCount < 1 {
+		return errors.New("socket_count must be greater than 0")
+	}
+	if ec.ModuleCount%ec.SocketCount != 0 {
+		return errors.Errorf("module_count %d must be a multiple of socket_count %d",
+			ec.ModuleCount, ec.SocketCount)
+	}
+	if ec.ModuleSize < 1 {
+		return errors.New("module_size must be greater than 0")
+	}
+
+	return nil
+}
+
 const (
 	BdevClassNone   BdevClass = ""
 	BdevClassNvme   BdevClass = "nvme"
@@ -153,3 +194,45 @@ func (bc *BdevConfig) GetNvmeDevs() []string {
 
 	return []string{}
 }
+
+const (
+	// maxEmulatedControllers is the number of PCI buses available to
+	// emulated NVMe controllers, starting at bus 0x80.
+	maxEmulatedControllers = 0x80
+)
+
+// BdevEmulationConfig describes NVMe controllers that are emulated with
+// files so that storage operations can be exercised on hosts without NVMe
+// SSDs. Emulation is enabled when ControllerCount is non-zero.
+type BdevEmulationConfig struct {
+	Dir             string `yaml:"emulation_dir,omitempty"`
+	ControllerCount int    `yaml:"controller_count,omitempty"`
+	NamespaceCount  int    `yaml:"namespace_count,omitempty"`
+	NamespaceSize   int    `yaml:"namespace_size,omitempty"` // GB
+}
+
+// Enabled returns true if NVMe controllers are to be emulated.
+func (ec *BdevEmulationConfig) Enabled() bool {
+	return ec.ControllerCount > 0
+}
+
+func (ec *BdevEmulationConfig) Validate() error {
+	if !ec.Enabled() {
+		return nil
+	}
+
+	if !filepath.IsAbs(ec.Dir) {
+		return errors.Errorf("emulation_dir %q must be an absolute path", ec.Dir)
+	}
+	if ec.ControllerCount > maxEmulatedControllers {
+		return errors.Errorf("controller_count may be at most %d", maxEmulatedControllers)
+	}
+	if ec.NamespaceCount < 1 {
+		return errors.New("namespace_count must be greater than 0")
+	}
+	if ec.NamespaceSize < 1 {
+		return errors.New("namespace_size must be greater than 0")
+	}
+
+	return nil
+}
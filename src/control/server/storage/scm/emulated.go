@@ -0,0 +1,433 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package scm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/storage"
+)
+
+const (
+	emulatedStateFile      = "scm_emulation.json"
+	emulatedPartNumber     = "NMEMUL%dG"
+	emulatedFwRev          = "01.00.00.0001"
+	emulatedFwImageMaxSize = 0x10000 * 4096
+	bootIDPath             = "/proc/sys/kernel/random/boot_id"
+)
+
+// emulatedGoal is a memory allocation goal applied on the next reboot.
+type emulatedGoal string
+
+const (
+	emulatedGoalNone       emulatedGoal = ""
+	emulatedGoalAppDirect  emulatedGoal = "AppDirect"
+	emulatedGoalMemoryMode emulatedGoal = "MemoryMode"
+)
+
+type (
+	// emulatedModule is the persisted state of an emulated module.
+	emulatedModule struct {
+		storage.ScmModule
+		StagedFwRev string                          `json:"staged_fw_rev"`
+		FwStatus    storage.ScmFirmwareUpdateStatus `json:"fw_status"`
+	}
+
+	// emulatedRegion is an AppDirect region interleaved across the
+	// modules of a socket.
+	emulatedRegion struct {
+		SocketID     uint32 `json:"socket_id"`
+		Capacity     uint64 `json:"capacity"`
+		FreeCapacity uint64 `json:"free_capacity"`
+	}
+
+	// emulatedState is the persisted state of the emulated modules.
+	emulatedState struct {
+		// BootID identifies the boot of the host in which the state
+		// was last read, the pending goal and staged firmware are
+		// applied when it changes.
+		BootID     string                `json:"boot_id"`
+		Goal       emulatedGoal          `json:"goal"`
+		Modules    []*emulatedModule     `json:"modules"`
+		Regions    []*emulatedRegion     `json:"regions"`
+		Namespaces storage.ScmNamespaces `json:"namespaces"`
+	}
+
+	// emulatedBackend is a Backend that emulates DCPM modules, so that SCM
+	// preparation, scan and firmware update can be exercised on hosts
+	// without DCPM modules, ipmctl or ndctl. The state of the modules is
+	// kept in a file so that it persists across restarts, and as with real
+	// modules, memory allocation goals and staged firmware take effect
+	// when the host reboots.
+	emulatedBackend struct {
+		sync.Mutex
+		log    logging.Logger
+		cfg    storage.ScmEmulationConfig
+		bootID func() (string, error)
+	}
+)
+
+// NewEmulatedProvider returns a Provider for the SCM modules emulated as
+// described by the configuration. Requests served by the emulated backend
+// are not forwarded to the privileged helper, mounts and formats still are.
+func NewEmulatedProvider(log logging.Logger, cfg storage.ScmEmulationConfig) *Provider {
+	p := NewProvider(log, newEmulatedBackend(log, cfg), newDefaultSystemProvider())
+	p.localBackend = true
+	return p
+}
+
+func newEmulatedBackend(log logging.Logger, cfg storage.ScmEmulationConfig) *emulatedBackend {
+	return &emulatedBackend{
+		log:    log,
+		cfg:    cfg,
+		bootID: readBootID,
+	}
+}
+
+func readBootID() (string, error) {
+	data, err := ioutil.ReadFile(bootIDPath)
+	if err != nil {
+		return "", errors.Wrap(err, "read boot id")
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func (b *emulatedBackend) statePath() string {
+	return filepath.Join(b.cfg.StateDir, emulatedStateFile)
+}
+
+func (b *emulatedBackend) newModules() []*emulatedModule {
+	perSocket := b.cfg.ModuleCount / b.cfg.SocketCount
+	modules := make([]*emulatedModule, 0, b.cfg.ModuleCount)
+
+	for i := 0; i < b.cfg.ModuleCount; i++ {
+		modules = append(modules, &emulatedModule{
+			ScmModule: storage.ScmModule{
+				SocketID:         uint32(i / perSocket),
+				ControllerID:     uint32(i%perSocket) % 2,
+				ChannelID:        uint32(i%perSocket) / 2,
+				PhysicalID:       uint32(i),
+				Capacity:         uint64(b.cfg.ModuleSize) * humanize.GiByte,
+				UID:              fmt.Sprintf("8089-a2-0000-%08x", i),
+				PartNumber:       fmt.Sprintf(emulatedPartNumber, b.cfg.ModuleSize),
+				FirmwareRevision: emulatedFwRev,
+			},
+		})
+	}
+
+	return modules
+}
+
+// reboot applies the pending memory allocation goal and activates staged
+// firmware.
+func (b *emulatedBackend) reboot(state *emulatedState) {
+	switch state.Goal {
+	case emulatedGoalAppDirect:
+		b.log.Debug("emulated scm: creating AppDirect regions")
+		state.Regions = nil
+		for _, mod := range state.Modules {
+			var region *emulatedRegion
+			for _, r := range state.Regions {
+				if r.SocketID == mod.SocketID {
+					region = r
+				}
+			}
+			if region == nil {
+				region = &emulatedRegion{SocketID: mod.SocketID}
+				state.Regions = append(state.Regions, region)
+			}
+			region.Capacity += mod.Capacity
+			region.FreeCapacity += mod.Capacity
+		}
+		state.Namespaces = nil
+	case emulatedGoalMemoryMode:
+		b.log.Debug("emulated scm: removing regions")
+		state.Regions = nil
+		state.Namespaces = nil
+	}
+	state.Goal = emulatedGoalNone
+
+	for _, mod := range state.Modules {
+		if mod.StagedFwRev == "" {
+			continue
+		}
+		b.log.Debugf("emulated scm: activating firmware %s on module %s",
+			mod.StagedFwRev, mod.UID)
+		mod.FirmwareRevision = mod.StagedFwRev
+		mod.StagedFwRev = ""
+		mod.FwStatus = storage.ScmUpdateStatusSuccess
+	}
+}
+
+// loadState reads the state of the emulated modules, creating it if it
+// doesn't exist, and applies pending changes if the host has rebooted
+// since it was last read.
+func (b *emulatedBackend) loadState() (*emulatedState, error) {
+	bootID, err := b.bootID()
+	if err != nil {
+		return nil, err
+	}
+
+	state := new(emulatedState)
+	data, err := ioutil.ReadFile(b.statePath())
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, state); err != nil {
+			return nil, errors.Wrapf(err, "parse %s", b.statePath())
+		}
+	case os.IsNotExist(err):
+		if err := os.MkdirAll(b.cfg.StateDir, 0755); err != nil {
+			return nil, errors.Wrap(err, "create scm emulation directory")
+		}
+		b.log.Debugf("creating %d emulated scm modules", b.cfg.ModuleCount)
+		state.BootID = bootID
+		state.Modules = b.newModules()
+		if err := b.saveState(state); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Wrap(err, "read scm emulation state")
+	}
+
+	if state.BootID != bootID {
+		b.reboot(state)
+		state.BootID = bootID
+		if err := b.saveState(state); err != nil {
+			return nil, err
+		}
+	}
+
+	return state, nil
+}
+
+func (b *emulatedBackend) saveState(state *emulatedState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshal scm emulation state")
+	}
+
+	return errors.Wrap(common.WriteFileAtomic(b.statePath(), data, 0644),
+		"write scm emulation state")
+}
+
+func (s *emulatedState) findModule(uid string) (*emulatedModule, error) {
+	for _, mod := range s.Modules {
+		if mod.UID == uid {
+			return mod, nil
+		}
+	}
+
+	return nil, errors.Errorf("no module found with UID %q", uid)
+}
+
+func (s *emulatedState) pmemState() storage.ScmState {
+	if len(s.Regions) == 0 {
+		return storage.ScmStateNoRegions
+	}
+	for _, region := range s.Regions {
+		if region.FreeCapacity > 0 {
+			return storage.ScmStateFreeCapacity
+		}
+	}
+
+	return storage.ScmStateNoCapacity
+}
+
+// Discover returns the emulated modules.
+func (b *emulatedBackend) Discover() (storage.ScmModules, error) {
+	b.Lock()
+	defer b.Unlock()
+
+	state, err := b.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	modules := make(storage.ScmModules, 0, len(state.Modules))
+	for _, mod := range state.Modules {
+		m := mod.ScmModule
+		modules = append(modules, &m)
+	}
+	b.log.Debugf("discovered %d emulated DCPM modules", len(modules))
+
+	return modules, nil
+}
+
+// GetPmemState establishes the state of the emulated regions.
+func (b *emulatedBackend) GetPmemState() (storage.ScmState, error) {
+	b.Lock()
+	defer b.Unlock()
+
+	state, err := b.loadState()
+	if err != nil {
+		return storage.ScmStateUnknown, err
+	}
+
+	return state.pmemState(), nil
+}
+
+// GetPmemNamespaces returns the namespaces created on the emulated regions.
+func (b *emulatedBackend) GetPmemNamespaces() (storage.ScmNamespaces, error) {
+	b.Lock()
+	defer b.Unlock()
+
+	state, err := b.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	return state.Namespaces, nil
+}
+
+// Prep transitions the emulated modules to the next state of preparation,
+// as the ipmctl/ndctl backend does:
+// * no regions -> set an AppDirect goal (needs reboot)
+// * regions with free capacity -> create a namespace per region
+// * regions without free capacity -> no-op, return namespaces
+func (b *emulatedBackend) Prep(scmState storage.ScmState) (bool, storage.ScmNamespaces, error) {
+	b.Lock()
+	defer b.Unlock()
+
+	state, err := b.loadState()
+	if err != nil {
+		return false, nil, err
+	}
+
+	b.log.Debugf("emulated scm in state %s", scmState)
+
+	switch scmState {
+	case storage.ScmStateNoRegions:
+		state.Goal = emulatedGoalAppDirect
+		return true, nil, b.saveState(state)
+	case storage.ScmStateFreeCapacity:
+		var created storage.ScmNamespaces
+		for i, region := range state.Regions {
+			if region.FreeCapacity == 0 {
+				continue
+			}
+			ns := &storage.ScmNamespace{
+				UUID:        uuid.New().String(),
+				BlockDevice: fmt.Sprintf("pmem%d", i),
+				Name:        fmt.Sprintf("namespace%d.0", i),
+				NumaNode:    region.SocketID,
+				Size:        region.FreeCapacity,
+			}
+			region.FreeCapacity = 0
+			state.Namespaces = append(state.Namespaces, ns)
+			created = append(created, ns)
+		}
+		return false, created, b.saveState(state)
+	case storage.ScmStateNoCapacity:
+		return false, state.Namespaces, nil
+	case storage.ScmStateUnknown:
+		return false, nil, errors.New("unknown scm state")
+	default:
+		return false, nil, errors.Errorf("unhandled scm state %q", scmState)
+	}
+}
+
+// PrepReset removes the namespaces of the emulated regions and sets a goal
+// to remove the regions on the next reboot.
+func (b *emulatedBackend) PrepReset(scmState storage.ScmState) (bool, error) {
+	b.Lock()
+	defer b.Unlock()
+
+	state, err := b.loadState()
+	if err != nil {
+		return false, err
+	}
+
+	b.log.Debugf("emulated scm in state %s", scmState)
+
+	switch scmState {
+	case storage.ScmStateNoRegions:
+		b.log.Info("SCM is already reset\n")
+		return false, nil
+	case storage.ScmStateFreeCapacity, storage.ScmStateNoCapacity:
+	case storage.ScmStateUnknown:
+		return false, errors.New("unknown scm state")
+	default:
+		return false, errors.Errorf("unhandled scm state %q", scmState)
+	}
+
+	for _, region := range state.Regions {
+		region.FreeCapacity = region.Capacity
+	}
+	state.Namespaces = nil
+	state.Goal = emulatedGoalMemoryMode
+
+	return true, b.saveState(state)
+}
+
+// GetFirmwareStatus gets the current firmware status of an emulated module.
+func (b *emulatedBackend) GetFirmwareStatus(deviceUID string) (*storage.ScmFirmwareInfo, error) {
+	b.Lock()
+	defer b.Unlock()
+
+	state, err := b.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	mod, err := state.findModule(deviceUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.ScmFirmwareInfo{
+		ActiveVersion:     mod.FirmwareRevision,
+		StagedVersion:     mod.StagedFwRev,
+		ImageMaxSizeBytes: emulatedFwImageMaxSize,
+		UpdateStatus:      mod.FwStatus,
+	}, nil
+}
+
+// UpdateFirmware stages the firmware version named by the image file on an
+// emulated module, to be activated on the next reboot. The version is the
+// base name of the file without extension.
+func (b *emulatedBackend) UpdateFirmware(deviceUID string, firmwarePath string) error {
+	fi, err := os.Stat(firmwarePath)
+	if err != nil {
+		return errors.Wrap(err, "firmware image")
+	}
+	if fi.Size() > emulatedFwImageMaxSize {
+		return errors.Errorf("firmware image %s exceeds maximum size %d",
+			firmwarePath, emulatedFwImageMaxSize)
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	state, err := b.loadState()
+	if err != nil {
+		return err
+	}
+
+	mod, err := state.findModule(deviceUID)
+	if err != nil {
+		return err
+	}
+
+	mod.StagedFwRev = strings.TrimSuffix(filepath.Base(firmwarePath),
+		filepath.Ext(firmwarePath))
+	mod.FwStatus = storage.ScmUpdateStatusStaged
+	b.log.Debugf("emulated scm: staged firmware %s on module %s", mod.StagedFwRev, deviceUID)
+
+	return b.saveState(state)
+}
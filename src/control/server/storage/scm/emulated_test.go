@@ -0,0 +1,316 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package scm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/logging"
+	"github.com/mjmac/soad/src/control/server/storage"
+)
+
+// testHost emulates the reboots of a host by changing its boot ID.
+type testHost struct {
+	boot int
+}
+
+func (h *testHost) bootID() (string, error) {
+	return common.MockUUID(int32(h.boot)), nil
+}
+
+func (h *testHost) reboot() {
+	h.boot++
+}
+
+func newTestEmulatedBackend(log logging.Logger, dir string, host *testHost) *emulatedBackend {
+	b := newEmulatedBackend(log, storage.ScmEmulationConfig{
+		StateDir:    dir,
+		ModuleCount: 4,
+		SocketCount: 2,
+		ModuleSize:  16,
+	})
+	b.bootID = host.bootID
+
+	return b
+}
+
+func TestScm_emulatedBackend_Discover(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+
+	b := newTestEmulatedBackend(log, testDir, new(testHost))
+	modules, err := b.Discover()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expModules := storage.ScmModules{
+		{SocketID: 0, ControllerID: 0, ChannelID: 0, PhysicalID: 0, UID: "8089-a2-0000-00000000"},
+		{SocketID: 0, ControllerID: 1, ChannelID: 0, PhysicalID: 1, UID: "8089-a2-0000-00000001"},
+		{SocketID: 1, ControllerID: 0, ChannelID: 0, PhysicalID: 2, UID: "8089-a2-0000-00000002"},
+		{SocketID: 1, ControllerID: 1, ChannelID: 0, PhysicalID: 3, UID: "8089-a2-0000-00000003"},
+	}
+	for _, mod := range expModules {
+		mod.Capacity = 16 * humanize.GiByte
+		mod.PartNumber = "NMEMUL16G"
+		mod.FirmwareRevision = emulatedFwRev
+	}
+	if diff := cmp.Diff(expModules, modules); diff != "" {
+		t.Fatalf("unexpected modules (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestScm_emulatedBackend_Prep(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+
+	host := new(testHost)
+	b := newTestEmulatedBackend(log, testDir, host)
+
+	checkState := func(t *testing.T, exp storage.ScmState) {
+		t.Helper()
+
+		state, err := b.GetPmemState()
+		if err != nil {
+			t.Fatal(err)
+		}
+		common.AssertEqual(t, exp, state, "unexpected scm state")
+	}
+
+	checkState(t, storage.ScmStateNoRegions)
+
+	// Reset has nothing to do without regions.
+	needsReboot, err := b.PrepReset(storage.ScmStateNoRegions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertFalse(t, needsReboot, "unexpected reboot required")
+
+	// The AppDirect goal only takes effect on reboot.
+	needsReboot, _, err = b.Prep(storage.ScmStateNoRegions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertTrue(t, needsReboot, "expected reboot required")
+	checkState(t, storage.ScmStateNoRegions)
+
+	host.reboot()
+	checkState(t, storage.ScmStateFreeCapacity)
+
+	// A namespace is created on the region of each socket.
+	needsReboot, created, err := b.Prep(storage.ScmStateFreeCapacity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertFalse(t, needsReboot, "unexpected reboot required")
+	expNamespaces := storage.ScmNamespaces{
+		{BlockDevice: "pmem0", Name: "namespace0.0", NumaNode: 0, Size: 32 * humanize.GiByte},
+		{BlockDevice: "pmem1", Name: "namespace1.0", NumaNode: 1, Size: 32 * humanize.GiByte},
+	}
+	ignoreUUID := cmpopts.IgnoreFields(storage.ScmNamespace{}, "UUID")
+	if diff := cmp.Diff(expNamespaces, created, ignoreUUID); diff != "" {
+		t.Fatalf("unexpected namespaces (-want, +got):\n%s\n", diff)
+	}
+	checkState(t, storage.ScmStateNoCapacity)
+
+	// Namespaces persist across backend instances and reboots.
+	host.reboot()
+	b = newTestEmulatedBackend(log, testDir, host)
+	_, namespaces, err := b.Prep(storage.ScmStateNoCapacity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(created, namespaces); diff != "" {
+		t.Fatalf("unexpected namespaces (-want, +got):\n%s\n", diff)
+	}
+
+	// Reset removes the namespaces at once and the regions on reboot.
+	needsReboot, err = b.PrepReset(storage.ScmStateNoCapacity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertTrue(t, needsReboot, "expected reboot required")
+	namespaces, err = b.GetPmemNamespaces()
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, 0, len(namespaces), "namespaces not removed")
+	checkState(t, storage.ScmStateFreeCapacity)
+
+	host.reboot()
+	checkState(t, storage.ScmStateNoRegions)
+
+	_, _, err = b.Prep(storage.ScmStateUnknown)
+	common.CmpErr(t, errors.New("unknown scm state"), err)
+}
+
+func TestScm_emulatedBackend_UpdateFirmware(t *testing.T) {
+	for name, tc := range map[string]struct {
+		uid          string
+		imageName    string
+		imageSize    int
+		reboot       bool
+		expInfo      *storage.ScmFirmwareInfo
+		expModuleRev string
+		expErr       error
+	}{
+		"unknown module": {
+			uid:       "8089-a2-0000-000000ff",
+			imageName: "01.02.00.5435.bin",
+			expErr:    errors.New("no module found"),
+		},
+		"missing image": {
+			uid:    "8089-a2-0000-00000001",
+			expErr: errors.New("no such file"),
+		},
+		"image too large": {
+			uid:       "8089-a2-0000-00000001",
+			imageName: "01.02.00.5435.bin",
+			imageSize: emulatedFwImageMaxSize + 1,
+			expErr:    errors.New("exceeds maximum size"),
+		},
+		"staged": {
+			uid:       "8089-a2-0000-00000001",
+			imageName: "01.02.00.5435.bin",
+			expInfo: &storage.ScmFirmwareInfo{
+				ActiveVersion:     emulatedFwRev,
+				StagedVersion:     "01.02.00.5435",
+				ImageMaxSizeBytes: emulatedFwImageMaxSize,
+				UpdateStatus:      storage.ScmUpdateStatusStaged,
+			},
+			expModuleRev: emulatedFwRev,
+		},
+		"activated on reboot": {
+			uid:       "8089-a2-0000-00000001",
+			imageName: "01.02.00.5435.bin",
+			reboot:    true,
+			expInfo: &storage.ScmFirmwareInfo{
+				ActiveVersion:     "01.02.00.5435",
+				ImageMaxSizeBytes: emulatedFwImageMaxSize,
+				UpdateStatus:      storage.ScmUpdateStatusSuccess,
+			},
+			expModuleRev: "01.02.00.5435",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := common.CreateTestDir(t)
+			defer cleanup()
+
+			host := new(testHost)
+			b := newTestEmulatedBackend(log, filepath.Join(testDir, "emul"), host)
+			image := filepath.Join(testDir, "missing.bin")
+			if tc.imageName != "" {
+				image = filepath.Join(testDir, tc.imageName)
+				if err := ioutil.WriteFile(image, nil, 0644); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.Truncate(image, int64(tc.imageSize)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotErr := b.UpdateFirmware(tc.uid, image)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if tc.reboot {
+				host.reboot()
+			}
+
+			gotInfo, err := b.GetFirmwareStatus(tc.uid)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expInfo, gotInfo); diff != "" {
+				t.Fatalf("unexpected firmware info (-want, +got):\n%s\n", diff)
+			}
+
+			modules, err := b.Discover()
+			if err != nil {
+				t.Fatal(err)
+			}
+			common.AssertEqual(t, tc.expModuleRev, modules[1].FirmwareRevision,
+				"unexpected module firmware revision")
+		})
+	}
+}
+
+func TestScm_EmulatedProvider_Prepare(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := common.CreateTestDir(t)
+	defer cleanup()
+
+	host := new(testHost)
+	newProvider := func() *Provider {
+		p := NewEmulatedProvider(log, storage.ScmEmulationConfig{
+			StateDir:    testDir,
+			ModuleCount: 2,
+			SocketCount: 1,
+			ModuleSize:  16,
+		})
+		p.backend.(*emulatedBackend).bootID = host.bootID
+		p.sys = DefaultMockSysProvider()
+		return p
+	}
+
+	// Requests served by the emulated backend aren't forwarded to the
+	// privileged helper, which isn't available in tests.
+	p := newProvider()
+	resp, err := p.Prepare(PrepareRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertTrue(t, resp.RebootRequired, "expected reboot required")
+	common.AssertEqual(t, storage.ScmStateNoRegions, resp.State, "unexpected state")
+
+	host.reboot()
+	p = newProvider()
+	resp, err = p.Prepare(PrepareRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertFalse(t, resp.RebootRequired, "unexpected reboot required")
+	common.AssertEqual(t, storage.ScmStateNoCapacity, resp.State, "unexpected state")
+	common.AssertEqual(t, 1, len(resp.Namespaces), "unexpected namespaces")
+
+	scanResp, err := p.Scan(ScanRequest{Rescan: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, 2, len(scanResp.Modules), "unexpected modules")
+	if diff := cmp.Diff(resp.Namespaces, scanResp.Namespaces); diff != "" {
+		t.Fatalf("unexpected namespaces (-want, +got):\n%s\n", diff)
+	}
+
+	resp, err = p.Prepare(PrepareRequest{Reset: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertTrue(t, resp.RebootRequired, "expected reboot required")
+}
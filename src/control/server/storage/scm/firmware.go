@@ -85,7 +85,7 @@ func (p *Provider) setupFirmwareProvider(log logging.Logger) {
 
 // QueryFirmware fetches the status of SCM device firmware.
 func (p *Provider) QueryFirmware(req FirmwareQueryRequest) (*FirmwareQueryResponse, error) {
-	if p.shouldForward(req) {
+	if p.shouldForwardBackend(req) {
 		return p.fwFwd.Query(req)
 	}
 
@@ -168,7 +168,7 @@ func filterModules(modules storage.ScmModules, fwRev string, modelID string) sto
 
 // UpdateFirmware updates the SCM device firmware.
 func (p *Provider) UpdateFirmware(req FirmwareUpdateRequest) (*FirmwareUpdateResponse, error) {
-	if p.shouldForward(req) {
+	if p.shouldForwardBackend(req) {
 		return p.fwFwd.Update(req)
 	}
 
@@ -161,6 +161,9 @@ type (
 		backend Backend
 		sys     SystemProvider
 		fwd     *AdminForwarder
+		// localBackend is set when the backend doesn't need privileges,
+		// so that requests served by it are not forwarded.
+		localBackend bool
 		firmwareProvider
 	}
 )
@@ -324,13 +327,15 @@ func parseFsType(input string) (string, error) {
 	return fsTypeNone, errors.Errorf("unable to determine fs type from %q", input)
 }
 
+func newDefaultSystemProvider() *defaultSystemProvider {
+	return &defaultSystemProvider{
+		LinuxProvider: *system.DefaultProvider(),
+	}
+}
+
 // DefaultProvider returns an initialized *Provider suitable for use with production code.
 func DefaultProvider(log logging.Logger) *Provider {
-	lp := system.DefaultProvider()
-	p := &defaultSystemProvider{
-		LinuxProvider: *lp,
-	}
-	return NewProvider(log, defaultCmdRunner(log), p)
+	return NewProvider(log, defaultCmdRunner(log), newDefaultSystemProvider())
 }
 
 // NewProvider returns an initialized *Provider.
@@ -354,6 +359,12 @@ func (p *Provider) shouldForward(req pbin.ForwardChecker) bool {
 	return !p.fwd.Disabled && !req.IsForwarded()
 }
 
+// shouldForwardBackend returns true if a request served by the backend
+// should be forwarded.
+func (p *Provider) shouldForwardBackend(req pbin.ForwardChecker) bool {
+	return !p.localBackend && p.shouldForward(req)
+}
+
 func (p *Provider) isInitialized() bool {
 	p.RLock()
 	defer p.RUnlock()
@@ -407,7 +418,7 @@ func (p *Provider) Scan(req ScanRequest) (*ScanResponse, error) {
 		return p.createScanResponse(), nil
 	}
 
-	if p.shouldForward(req) {
+	if p.shouldForwardBackend(req) {
 		res, err := p.fwd.Scan(req)
 		if err != nil {
 			return nil, err
@@ -471,7 +482,7 @@ func (p *Provider) Prepare(req PrepareRequest) (res *PrepareResponse, err error)
 		return res, nil
 	}
 
-	if p.shouldForward(req) {
+	if p.shouldForwardBackend(req) {
 		return p.fwd.Prepare(req)
 	}
 
@@ -56,10 +56,11 @@ type (
 
 	// ScmMountPoint represents location SCM filesystem is mounted.
 	ScmMountPoint struct {
-		Info       string `json:"info"`
-		Path       string `json:"path"`
-		TotalBytes uint64 `json:"total_bytes"`
-		AvailBytes uint64 `json:"avail_bytes"`
+		Info       string      `json:"info"`
+		Path       string      `json:"path"`
+		TotalBytes uint64      `json:"total_bytes"`
+		AvailBytes uint64      `json:"avail_bytes"`
+		Rank       system.Rank `json:"rank"`
 	}
 
 	// ScmMountPoints is a type alias for []ScmMountPoint that implements fmt.Stringer.
@@ -92,25 +93,27 @@ type (
 	// NvmeHealth represents a set of health statistics for a NVMe device
 	// and mirrors C.struct_nvme_stats.
 	NvmeHealth struct {
-		Timestamp       uint64 `json:"timestamp"`
-		TempWarnTime    uint32 `json:"warn_temp_time"`
-		TempCritTime    uint32 `json:"crit_temp_time"`
-		CtrlBusyTime    uint64 `json:"ctrl_busy_time"`
-		PowerCycles     uint64 `json:"power_cycles"`
-		PowerOnHours    uint64 `json:"power_on_hours"`
-		UnsafeShutdowns uint64 `json:"unsafe_shutdowns"`
-		MediaErrors     uint64 `json:"media_errs"`
-		ErrorLogEntries uint64 `json:"err_log_entries"`
-		ReadErrors      uint32 `json:"bio_read_errs"`
-		WriteErrors     uint32 `json:"bio_write_errs"`
-		UnmapErrors     uint32 `json:"bio_unmap_errs"`
-		ChecksumErrors  uint32 `json:"checksum_errs"`
-		Temperature     uint32 `json:"temperature"`
-		TempWarn        bool   `json:"temp_warn"`
-		AvailSpareWarn  bool   `json:"avail_spare_warn"`
-		ReliabilityWarn bool   `json:"dev_reliability_warn"`
-		ReadOnlyWarn    bool   `json:"read_only_warn"`
-		VolatileWarn    bool   `json:"volatile_mem_warn"`
+		Timestamp        uint64 `json:"timestamp"`
+		TempWarnTime     uint32 `json:"warn_temp_time"`
+		TempCritTime     uint32 `json:"crit_temp_time"`
+		CtrlBusyTime     uint64 `json:"ctrl_busy_time"`
+		PowerCycles      uint64 `json:"power_cycles"`
+		PowerOnHours     uint64 `json:"power_on_hours"`
+		UnsafeShutdowns  uint64 `json:"unsafe_shutdowns"`
+		MediaErrors      uint64 `json:"media_errs"`
+		ErrorLogEntries  uint64 `json:"err_log_entries"`
+		ReadErrors       uint32 `json:"bio_read_errs"`
+		WriteErrors      uint32 `json:"bio_write_errs"`
+		UnmapErrors      uint32 `json:"bio_unmap_errs"`
+		ChecksumErrors   uint32 `json:"checksum_errs"`
+		Temperature      uint32 `json:"temperature"`
+		AvailSpare       uint32 `json:"avail_spare"`
+		AvailSpareThresh uint32 `json:"avail_spare_thresh"`
+		TempWarn         bool   `json:"temp_warn"`
+		AvailSpareWarn   bool   `json:"avail_spare_warn"`
+		ReliabilityWarn  bool   `json:"dev_reliability_warn"`
+		ReadOnlyWarn     bool   `json:"read_only_warn"`
+		VolatileWarn     bool   `json:"volatile_mem_warn"`
 	}
 
 	// NvmeNamespace represents an individual NVMe namespace on a device and
@@ -121,16 +124,17 @@ type (
 	}
 
 	// SmdDevice contains DAOS storage device information, including
-	// health details if requested.
+	// health details and health history if requested.
 	SmdDevice struct {
-		UUID       string      `json:"uuid"`
-		TargetIDs  []int32     `hash:"set" json:"tgt_ids"`
-		State      string      `json:"state"`
-		Rank       system.Rank `json:"rank"`
-		TotalBytes uint64      `json:"total_bytes"`
-		AvailBytes uint64      `json:"avail_bytes"`
-		Health     *NvmeHealth `json:"health"`
-		TrAddr     string      `json:"tr_addr"`
+		UUID       string        `json:"uuid"`
+		TargetIDs  []int32       `hash:"set" json:"tgt_ids"`
+		State      string        `json:"state"`
+		Rank       system.Rank   `json:"rank"`
+		TotalBytes uint64        `json:"total_bytes"`
+		AvailBytes uint64        `json:"avail_bytes"`
+		Health     *NvmeHealth   `json:"health"`
+		History    []*NvmeHealth `json:"history,omitempty"`
+		TrAddr     string        `json:"tr_addr"`
 	}
 
 	// NvmeController represents a NVMe device controller which includes health
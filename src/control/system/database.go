@@ -11,6 +11,7 @@ import (
 	"net"
 	"os"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -43,6 +44,8 @@ type (
 		LeadershipTransfer() raft.Future
 		Shutdown() raft.Future
 		State() raft.RaftState
+		Stats() map[string]string
+		GetConfiguration() raft.ConfigurationFuture
 	}
 
 	// syncRaft provides a wrapper for synchronized access to the
@@ -64,6 +67,9 @@ type (
 		MapVersion    uint32
 		Members       *MemberDatabase
 		Pools         *PoolDatabase
+		Events        *EventLog
+		Policy        *PolicyState
+		Quotas        QuotaMap
 		SchemaVersion uint
 	}
 
@@ -82,6 +88,7 @@ type (
 		log                logging.Logger
 		cfg                *DatabaseConfig
 		replicaAddr        *syncTCPAddr
+		replicas           syncReplicas
 		raft               syncRaft
 		raftTransport      raft.Transport
 		raftLeaderNotifyCh chan bool
@@ -126,8 +133,8 @@ func (sr *syncRaft) withReadLock(fn func(raftService) error) error {
 	return fn(sr.svc)
 }
 
-func (cfg *DatabaseConfig) stringReplicas(excludeAddr *net.TCPAddr) (replicas []string) {
-	for _, r := range cfg.Replicas {
+func (db *Database) stringReplicas(excludeAddr *net.TCPAddr) (replicas []string) {
+	for _, r := range db.replicas.get() {
 		if common.CmpTCPAddr(r, excludeAddr) {
 			continue
 		}
@@ -186,11 +193,25 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 				Uuids:  make(PoolUuidMap),
 				Labels: make(PoolLabelMap),
 			},
+			Events:        newEventLog(),
+			Policy:        new(PolicyState),
+			Quotas:        make(QuotaMap),
 			SchemaVersion: CurrentSchemaVersion,
 		},
 	}
 
-	for _, repAddr := range db.cfg.Replicas {
+	db.replicas.set(cfg.Replicas)
+	// A replica set saved after an online change to the MS replicas
+	// takes precedence over the configured set.
+	saved, err := loadReplicas(cfg.RaftDir)
+	if err != nil {
+		return nil, err
+	}
+	if saved != nil {
+		db.replicas.set(saved)
+	}
+
+	for _, repAddr := range db.replicas.get() {
 		if !common.IsLocalAddr(repAddr) {
 			continue
 		}
@@ -203,7 +224,7 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 // isReplica returns true if the supplied address matches
 // a known replica address.
 func (db *Database) isReplica(ctrlAddr *net.TCPAddr) bool {
-	for _, candidate := range db.cfg.Replicas {
+	for _, candidate := range db.replicas.get() {
 		if common.CmpTCPAddr(ctrlAddr, candidate) {
 			return true
 		}
@@ -220,17 +241,17 @@ func (db *Database) SystemName() string {
 // LeaderQuery returns the system leader, if known.
 func (db *Database) LeaderQuery() (leader string, replicas []string, err error) {
 	if !db.IsReplica() {
-		return "", nil, &ErrNotReplica{db.cfg.stringReplicas(nil)}
+		return "", nil, &ErrNotReplica{db.stringReplicas(nil)}
 	}
 
-	return db.leaderHint(), db.cfg.stringReplicas(nil), nil
+	return db.leaderHint(), db.stringReplicas(nil), nil
 }
 
 // ReplicaAddr returns the system's replica address if
 // the system is configured as a MS replica.
 func (db *Database) ReplicaAddr() (*net.TCPAddr, error) {
 	if !db.IsReplica() {
-		return nil, &ErrNotReplica{db.cfg.stringReplicas(nil)}
+		return nil, &ErrNotReplica{db.stringReplicas(nil)}
 	}
 	return db.getReplica(), nil
 }
@@ -260,13 +281,13 @@ func (db *Database) IsBootstrap() bool {
 	}
 	// Only the first replica should bootstrap. All the others
 	// should be added as voters.
-	return common.CmpTCPAddr(db.cfg.Replicas[0], db.getReplica())
+	return len(db.cfg.Replicas) > 0 && common.CmpTCPAddr(db.cfg.Replicas[0], db.getReplica())
 }
 
 // CheckReplica returns an error if the node is not a replica.
 func (db *Database) CheckReplica() error {
 	if !db.IsReplica() {
-		return &ErrNotReplica{db.cfg.stringReplicas(nil)}
+		return &ErrNotReplica{db.stringReplicas(nil)}
 	}
 
 	return nil
@@ -284,7 +305,7 @@ func (db *Database) CheckLeader() error {
 		if svc.State() != raft.Leader {
 			return &ErrNotLeader{
 				LeaderHint: db.leaderHint(),
-				Replicas:   db.cfg.stringReplicas(db.getReplica()),
+				Replicas:   db.stringReplicas(db.getReplica()),
 			}
 		}
 		return nil
@@ -310,6 +331,39 @@ func (db *Database) IsLeader() bool {
 	return db.CheckLeader() == nil
 }
 
+// RaftStatus describes the state of the local MS replica's raft instance.
+type RaftStatus struct {
+	State  string
+	Leader string
+	Term   uint64
+}
+
+// RaftStatus returns the current state, known leader and term of the local
+// MS replica.
+func (db *Database) RaftStatus() (*RaftStatus, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+
+	status := new(RaftStatus)
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		status.State = svc.State().String()
+		status.Leader = string(svc.Leader())
+
+		term, err := strconv.ParseUint(svc.Stats()["term"], 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse raft term")
+		}
+		status.Term = term
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
 // OnLeadershipGained registers callbacks to be run when this instance
 // gains the leadership role.
 func (db *Database) OnLeadershipGained(fns ...onLeadershipGainedFn) {
@@ -785,7 +839,32 @@ func (db *Database) FindPoolServiceByLabel(label string) (*PoolService, error) {
 	return nil, &ErrPoolNotFound{byLabel: &label}
 }
 
+// checkPoolLabel verifies that the supplied pool service's label is not
+// already in use by a different pool. Must be called with the database
+// lock held in order to ensure that the check and subsequent update are
+// performed atomically.
+func (db *Database) checkPoolLabel(ps *PoolService) error {
+	if ps.PoolLabel == "" {
+		return nil
+	}
+
+	p, err := db.FindPoolServiceByLabel(ps.PoolLabel)
+	if err != nil {
+		if IsPoolNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if p.PoolUUID != ps.PoolUUID {
+		return &ErrPoolLabelExists{Label: ps.PoolLabel}
+	}
+
+	return nil
+}
+
 // AddPoolService creates an entry for a new pool service in the pool database.
+// An error is returned if the pool's capacity would exceed the quota of its
+// owning user or group.
 func (db *Database) AddPoolService(ps *PoolService) error {
 	if err := db.CheckLeader(); err != nil {
 		return err
@@ -797,6 +876,14 @@ func (db *Database) AddPoolService(ps *PoolService) error {
 		return errors.Errorf("pool %s already exists", p.PoolUUID)
 	}
 
+	if err := db.checkPoolLabel(ps); err != nil {
+		return err
+	}
+
+	if err := db.checkPoolQuotas(ps); err != nil {
+		return err
+	}
+
 	if err := db.submitPoolUpdate(raftOpAddPoolService, ps); err != nil {
 		return err
 	}
@@ -824,7 +911,9 @@ func (db *Database) RemovePoolService(uuid uuid.UUID) error {
 	return nil
 }
 
-// UpdatePoolService updates an existing pool database entry.
+// UpdatePoolService updates an existing pool database entry. An error is
+// returned if the update would increase the pool's capacity beyond the quota
+// of its owning user or group.
 func (db *Database) UpdatePoolService(ps *PoolService) error {
 	if err := db.CheckLeader(); err != nil {
 		return err
@@ -837,6 +926,14 @@ func (db *Database) UpdatePoolService(ps *PoolService) error {
 		return errors.Wrapf(err, "failed to retrieve pool %s", ps.PoolUUID)
 	}
 
+	if err := db.checkPoolLabel(ps); err != nil {
+		return err
+	}
+
+	if err := db.checkPoolQuotas(ps); err != nil {
+		return err
+	}
+
 	if err := db.submitPoolUpdate(raftOpUpdatePoolService, ps); err != nil {
 		return err
 	}
@@ -877,6 +974,12 @@ func (db *Database) handlePoolRepsUpdate(evt *events.RASEvent) {
 
 // OnEvent handles events and updates system database accordingly.
 func (db *Database) OnEvent(_ context.Context, evt *events.RASEvent) {
+	if !isTransientEvent(evt) {
+		if err := db.AddEvent(evt); err != nil {
+			db.log.Errorf("failed to record event %s in system event log: %s", evt.ID, err)
+		}
+	}
+
 	switch evt.ID {
 	case events.RASPoolRepsUpdate:
 		db.handlePoolRepsUpdate(evt)
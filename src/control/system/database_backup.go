@@ -0,0 +1,171 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+)
+
+const (
+	// backupSnapshotIndex and backupSnapshotTerm are the raft log index
+	// and term assigned to a snapshot seeded from a database backup.
+	backupSnapshotIndex = 1
+	backupSnapshotTerm  = 1
+)
+
+// DatabaseBackup is a self-describing, point-in-time copy of the system
+// database, suitable for seeding a new MS after the loss of all replicas.
+type DatabaseBackup struct {
+	SystemName    string          `json:"system_name"`
+	SchemaVersion uint            `json:"schema_version"`
+	MapVersion    uint32          `json:"map_version"`
+	Created       time.Time       `json:"created"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// Backup returns a serialized DatabaseBackup containing a consistent copy
+// of the system database. Only the MS leader may create a backup.
+func (db *Database) Backup() ([]byte, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	data, err := json.Marshal(db.data)
+	mapVersion := db.data.MapVersion
+	schemaVersion := db.data.SchemaVersion
+	db.data.RUnlock()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize system database")
+	}
+
+	db.log.Debugf("created system database backup (map version %d)", mapVersion)
+
+	return json.Marshal(&DatabaseBackup{
+		SystemName:    db.SystemName(),
+		SchemaVersion: schemaVersion,
+		MapVersion:    mapVersion,
+		Created:       time.Now(),
+		Data:          data,
+	})
+}
+
+// DecodeBackup reads a DatabaseBackup from the supplied reader and verifies
+// that its contents are consistent and usable by this version of the
+// database.
+func DecodeBackup(r io.Reader) (*DatabaseBackup, error) {
+	backup := new(DatabaseBackup)
+	if err := json.NewDecoder(r).Decode(backup); err != nil {
+		return nil, errors.Wrap(err, "failed to decode system database backup")
+	}
+
+	if backup.SystemName == "" {
+		return nil, errors.New("backup does not specify a system name")
+	}
+	if backup.SchemaVersion != CurrentSchemaVersion {
+		return nil, errors.Errorf("backup schema version %d != %d",
+			backup.SchemaVersion, CurrentSchemaVersion)
+	}
+
+	data, err := backup.decodeData()
+	if err != nil {
+		return nil, err
+	}
+	if data.SchemaVersion != backup.SchemaVersion {
+		return nil, errors.Errorf("backup data schema version %d != %d",
+			data.SchemaVersion, backup.SchemaVersion)
+	}
+	if data.MapVersion == 0 {
+		return nil, errors.New("backup data has invalid map version 0")
+	}
+	if data.MapVersion != backup.MapVersion {
+		return nil, errors.Errorf("backup data map version %d != %d",
+			data.MapVersion, backup.MapVersion)
+	}
+
+	return backup, nil
+}
+
+func (b *DatabaseBackup) decodeData() (*dbData, error) {
+	tmp, _ := NewDatabase(nil, nil)
+	if err := json.Unmarshal(b.Data, tmp.data); err != nil {
+		return nil, errors.Wrap(err, "failed to decode system database backup data")
+	}
+	return tmp.data, nil
+}
+
+// RestoreBackup seeds a new raft directory for the local MS replica with
+// the contents of the supplied backup. The replica will start with the
+// restored database as its only voter, and other replicas will be added
+// as they join. This must be performed while the control plane server is
+// stopped, and the raft directory must not already exist.
+func (db *Database) RestoreBackup(backup *DatabaseBackup) error {
+	if err := db.CheckReplica(); err != nil {
+		return err
+	}
+	if backup == nil {
+		return errors.New("nil backup")
+	}
+	if backup.SystemName != db.SystemName() {
+		return errors.Errorf("backup system name %q does not match configured system name %q",
+			backup.SystemName, db.SystemName())
+	}
+
+	if _, err := os.Stat(db.cfg.RaftDir); err == nil {
+		return errors.Errorf("raft directory %s already exists; remove it before restoring",
+			db.cfg.RaftDir)
+	} else if !os.IsNotExist(err) {
+		return errors.Wrapf(err, "can't Stat() %s", db.cfg.RaftDir)
+	}
+	if err := os.Mkdir(db.cfg.RaftDir, 0700); err != nil {
+		return errors.Wrapf(err, "failed to Mkdir() %s", db.cfg.RaftDir)
+	}
+
+	snaps, err := raft.NewFileSnapshotStoreWithLogger(db.cfg.RaftDir, 2, newHcLogger(db.log))
+	if err != nil {
+		return err
+	}
+
+	cfg := raft.Configuration{
+		Servers: []raft.Server{
+			{
+				Suffrage: raft.Voter,
+				ID:       raft.ServerID(db.serverAddress()),
+				Address:  db.serverAddress(),
+			},
+		},
+	}
+	// The transport is only used to encode the peer addresses in the
+	// snapshot metadata, which is done identically by all transports.
+	_, trans := raft.NewInmemTransport(db.serverAddress())
+	defer trans.Close()
+
+	sink, err := snaps.Create(raft.SnapshotVersionMax, backupSnapshotIndex, backupSnapshotTerm,
+		cfg, backupSnapshotIndex, trans)
+	if err != nil {
+		return errors.Wrap(err, "failed to create raft snapshot")
+	}
+	if _, err := io.Copy(sink, bytes.NewReader(backup.Data)); err != nil {
+		_ = sink.Cancel()
+		return errors.Wrap(err, "failed to write raft snapshot")
+	}
+	if err := sink.Close(); err != nil {
+		return errors.Wrap(err, "failed to write raft snapshot")
+	}
+
+	db.log.Infof("restored system database (map version %d) to %s",
+		backup.MapVersion, db.cfg.RaftDir)
+
+	return nil
+}
@@ -0,0 +1,225 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func mockBackup(t *testing.T, log logging.Logger) []byte {
+	t.Helper()
+
+	db := MockDatabase(t, log)
+	for i := 0; i < 3; i++ {
+		if err := db.AddMember(MockMember(t, uint32(i), MemberStateJoined)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.AddPoolService(&PoolService{
+		PoolUUID: uuid.MustParse(common.MockUUID(1)),
+		State:    PoolServiceStateReady,
+		Replicas: []Rank{0},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := db.Backup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestSystem_Database_Backup(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	notLeader := MockDatabase(t, log)
+	notLeader.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+		State: raft.Follower,
+	}, (*fsm)(notLeader)))
+	_, err := notLeader.Backup()
+	common.CmpErr(t, errors.Errorf("not the %s leader", build.ManagementServiceName), err)
+
+	backup, err := DecodeBackup(bytes.NewReader(mockBackup(t, log)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, build.DefaultSystemName, backup.SystemName, "unexpected system name")
+	common.AssertEqual(t, uint32(4), backup.MapVersion, "unexpected map version")
+
+	data, err := backup.decodeData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, 3, len(data.Members.Ranks), "unexpected member count")
+	common.AssertEqual(t, 1, len(data.Pools.Uuids), "unexpected pool count")
+}
+
+func TestSystem_DecodeBackup(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	goodData := mockBackup(t, log)
+	modify := func(fn func(*DatabaseBackup)) []byte {
+		backup := new(DatabaseBackup)
+		if err := json.Unmarshal(goodData, backup); err != nil {
+			t.Fatal(err)
+		}
+		fn(backup)
+		data, err := json.Marshal(backup)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+
+	for name, tc := range map[string]struct {
+		data   []byte
+		expErr error
+	}{
+		"garbage": {
+			data:   []byte("not a backup"),
+			expErr: errors.New("failed to decode system database backup"),
+		},
+		"missing system name": {
+			data: modify(func(b *DatabaseBackup) {
+				b.SystemName = ""
+			}),
+			expErr: errors.New("does not specify a system name"),
+		},
+		"bad schema version": {
+			data: modify(func(b *DatabaseBackup) {
+				b.SchemaVersion = 1024
+			}),
+			expErr: errors.New("backup schema version 1024 != 0"),
+		},
+		"bad data": {
+			data: modify(func(b *DatabaseBackup) {
+				b.Data = json.RawMessage(`"foo"`)
+			}),
+			expErr: errors.New("failed to decode system database backup data"),
+		},
+		"zero map version": {
+			data: modify(func(b *DatabaseBackup) {
+				b.MapVersion = 0
+				b.Data = json.RawMessage(`{"MapVersion":0}`)
+			}),
+			expErr: errors.New("invalid map version 0"),
+		},
+		"map version mismatch": {
+			data: modify(func(b *DatabaseBackup) {
+				b.MapVersion = 42
+			}),
+			expErr: errors.New("backup data map version 4 != 42"),
+		},
+		"success": {
+			data: goodData,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, gotErr := DecodeBackup(bytes.NewReader(tc.data))
+			common.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestSystem_Database_RestoreBackup(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	backup, err := DecodeBackup(bytes.NewReader(mockBackup(t, log)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		notReplica bool
+		raftExists bool
+		systemName string
+		expErr     error
+	}{
+		"not replica": {
+			notReplica: true,
+			expErr:     errors.New("replica"),
+		},
+		"wrong system name": {
+			systemName: "quack",
+			expErr:     errors.New("does not match configured system name"),
+		},
+		"raft dir exists": {
+			raftExists: true,
+			expErr:     errors.New("already exists"),
+		},
+		"success": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			db, cleanup := TestDatabase(t, log)
+			defer cleanup()
+			if tc.notReplica {
+				db.replicaAddr.set(nil)
+			}
+			if tc.systemName != "" {
+				db.cfg.SystemName = tc.systemName
+			}
+			if tc.raftExists {
+				if err := os.Mkdir(db.cfg.RaftDir, 0700); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotErr := db.RestoreBackup(backup)
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			// The restored replica should elect itself leader
+			// and load the database from the seeded snapshot.
+			if err := db.Start(ctx); err != nil {
+				t.Fatal(err)
+			}
+			waitForLeadership(ctx, t, db, true, 10*time.Second)
+
+			gotRanks, err := db.MemberRanks()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff([]Rank{0, 1, 2}, gotRanks); diff != "" {
+				t.Fatalf("unexpected restored ranks (-want, +got):\n%s\n", diff)
+			}
+			gotVersion, err := db.CurMapVersion()
+			if err != nil {
+				t.Fatal(err)
+			}
+			common.AssertEqual(t, backup.MapVersion, gotVersion, "unexpected map version")
+			if _, err := db.FindPoolServiceByUUID(uuid.MustParse(common.MockUUID(1))); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	sharedpb "github.com/mjmac/soad/src/control/common/proto/shared"
+	"github.com/mjmac/soad/src/control/events"
+)
+
+const (
+	// DefaultEventLogSize is the maximum number of entries retained
+	// in the system event log. Once the log is full, the oldest
+	// entries are discarded to make room for new ones.
+	DefaultEventLogSize = 4096
+)
+
+type (
+	// EventLogEntry is a RAS event recorded in the system event log.
+	EventLogEntry struct {
+		Sequence uint64
+		Time     time.Time
+		Event    *events.RASEvent
+	}
+
+	// EventLog is a bounded journal of RAS events received by the
+	// management service. It is replicated along with the rest of
+	// the system database.
+	EventLog struct {
+		NextSequence uint64
+		Entries      []*EventLogEntry
+	}
+
+	// EventFilter specifies the criteria used to select entries from
+	// the system event log. Zero-valued fields match all entries.
+	EventFilter struct {
+		Types      []events.RASTypeID
+		IDs        []events.RASID
+		Severities []events.RASSeverityID
+		Ranks      []Rank
+		Hosts      []string
+		PoolUUID   string
+		Since      time.Time
+		Until      time.Time
+		Limit      int
+	}
+)
+
+// MarshalJSON serializes the entry to JSON. The event is encoded in its
+// protobuf wire format in order to preserve any extended info.
+func (ele *EventLogEntry) MarshalJSON() ([]byte, error) {
+	if ele == nil || ele.Event == nil {
+		return nil, errors.New("nil event log entry")
+	}
+
+	pbEvt, err := ele.Event.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	evtData, err := proto.Marshal(pbEvt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode event")
+	}
+
+	type toJSON EventLogEntry
+	return json.Marshal(&struct {
+		Event []byte
+		*toJSON
+	}{
+		Event:  evtData,
+		toJSON: (*toJSON)(ele),
+	})
+}
+
+// UnmarshalJSON deserializes the entry from JSON.
+func (ele *EventLogEntry) UnmarshalJSON(data []byte) error {
+	type fromJSON EventLogEntry
+	from := &struct {
+		Event []byte
+		*fromJSON
+	}{
+		fromJSON: (*fromJSON)(ele),
+	}
+	if err := json.Unmarshal(data, from); err != nil {
+		return err
+	}
+
+	pbEvt := new(sharedpb.RASEvent)
+	if err := proto.Unmarshal(from.Event, pbEvt); err != nil {
+		return errors.Wrap(err, "failed to decode event")
+	}
+
+	evt, err := events.NewFromProto(pbEvt)
+	if err != nil {
+		return err
+	}
+	ele.Event = evt
+
+	return nil
+}
+
+// newEventLog returns an empty event log.
+func newEventLog() *EventLog {
+	return &EventLog{
+		NextSequence: 1,
+	}
+}
+
+// addEntry appends the entry to the log, assigning it the next sequence
+// number and discarding the oldest entries if necessary.
+func (el *EventLog) addEntry(ele *EventLogEntry) {
+	ele.Sequence = el.NextSequence
+	el.NextSequence++
+
+	el.Entries = append(el.Entries, ele)
+	if excess := len(el.Entries) - DefaultEventLogSize; excess > 0 {
+		// Copy the retained entries so that the discarded
+		// ones can be garbage-collected.
+		el.Entries = append([]*EventLogEntry(nil), el.Entries[excess:]...)
+	}
+}
+
+func (ef *EventFilter) matchType(typ events.RASTypeID) bool {
+	if len(ef.Types) == 0 {
+		return true
+	}
+	for _, ft := range ef.Types {
+		if ft == typ {
+			return true
+		}
+	}
+	return false
+}
+
+func (ef *EventFilter) matchID(id events.RASID) bool {
+	if len(ef.IDs) == 0 {
+		return true
+	}
+	for _, fid := range ef.IDs {
+		if fid == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (ef *EventFilter) matchSeverity(sev events.RASSeverityID) bool {
+	if len(ef.Severities) == 0 {
+		return true
+	}
+	for _, fsev := range ef.Severities {
+		if fsev == sev {
+			return true
+		}
+	}
+	return false
+}
+
+func (ef *EventFilter) matchRank(rank uint32) bool {
+	if len(ef.Ranks) == 0 {
+		return true
+	}
+	for _, fr := range ef.Ranks {
+		if fr == Rank(rank) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ef *EventFilter) matchHost(host string) bool {
+	if len(ef.Hosts) == 0 {
+		return true
+	}
+	for _, fh := range ef.Hosts {
+		if fh == host {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns true if the supplied entry satisfies the filter.
+func (ef *EventFilter) Matches(ele *EventLogEntry) bool {
+	if ef == nil {
+		return true
+	}
+	if ele == nil || ele.Event == nil {
+		return false
+	}
+
+	switch {
+	case !ef.Since.IsZero() && ele.Time.Before(ef.Since):
+		return false
+	case !ef.Until.IsZero() && ele.Time.After(ef.Until):
+		return false
+	case ef.PoolUUID != "" && ef.PoolUUID != ele.Event.PoolUUID:
+		return false
+	}
+
+	return ef.matchType(ele.Event.Type) &&
+		ef.matchID(ele.Event.ID) &&
+		ef.matchSeverity(ele.Event.Severity) &&
+		ef.matchRank(ele.Event.Rank) &&
+		ef.matchHost(ele.Event.Hostname)
+}
+
+// copyEventLogEntry makes a copy of the supplied EventLogEntry pointer
+// for safe use outside of the database.
+func copyEventLogEntry(in *EventLogEntry) *EventLogEntry {
+	out := new(EventLogEntry)
+	*out = *in
+	return out
+}
+
+// transientEvents are only of interest to live event subscribers and are
+// not recorded in the system event log. System map updates are published for
+// every map version and would otherwise crowd out the other entries.
+var transientEvents = map[events.RASID]bool{
+	events.RASSystemMapUpdate: true,
+}
+
+func isTransientEvent(evt *events.RASEvent) bool {
+	return evt != nil && transientEvents[evt.ID]
+}
+
+// AddEvent records the supplied event in the system event log.
+func (db *Database) AddEvent(evt *events.RASEvent) error {
+	if evt == nil {
+		return errors.New("nil event")
+	}
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	return db.submitEventUpdate(&EventLogEntry{
+		Time:  time.Now(),
+		Event: evt,
+	})
+}
+
+// EventLogEntries returns the entries in the system event log that match
+// the supplied filter, ordered from oldest to newest. If the filter specifies
+// a limit, only the most recent matching entries are returned.
+func (db *Database) EventLogEntries(filter *EventFilter) ([]*EventLogEntry, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	var result []*EventLogEntry
+	for _, ele := range db.data.Events.Entries {
+		if filter.Matches(ele) {
+			result = append(result, copyEventLogEntry(ele))
+		}
+	}
+
+	if filter != nil && filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[len(result)-filter.Limit:]
+	}
+
+	return result, nil
+}
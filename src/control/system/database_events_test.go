@@ -0,0 +1,281 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/events"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+var eventLogCmpOpts = []cmp.Option{
+	cmpopts.IgnoreUnexported(events.RASEvent{}),
+	cmpopts.EquateApproxTime(0),
+}
+
+func mockEventLogEntry(t *testing.T, ts time.Time, evt *events.RASEvent) *EventLogEntry {
+	t.Helper()
+
+	return &EventLogEntry{
+		Time:  ts,
+		Event: evt,
+	}
+}
+
+func raftUpdateTestEvent(t *testing.T, db *Database, ele *EventLogEntry) {
+	t.Helper()
+
+	data, err := createRaftUpdate(raftOpAddEvent, ele)
+	if err != nil {
+		t.Fatal(err)
+	}
+	(*fsm)(db).Apply(&raft.Log{Data: data})
+}
+
+func TestSystem_EventLogEntry_JSON(t *testing.T) {
+	for name, tc := range map[string]struct {
+		event *events.RASEvent
+	}{
+		"rank down": {
+			event: events.NewRankDownEvent("foo", 1, 2, common.ExitStatus("test")),
+		},
+		"pool svc replicas update": {
+			event: events.NewPoolSvcReplicasUpdateEvent("foo", 1, common.MockUUID(), []uint32{0, 1}, 1),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			in := &EventLogEntry{
+				Sequence: 42,
+				Time:     time.Now(),
+				Event:    tc.event,
+			}
+
+			data, err := json.Marshal(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			out := new(EventLogEntry)
+			if err := json.Unmarshal(data, out); err != nil {
+				t.Fatal(err)
+			}
+
+			cmpOpts := append(eventLogCmpOpts, cmp.Comparer(func(x, y error) bool {
+				return x.Error() == y.Error()
+			}))
+			if diff := cmp.Diff(in, out, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected entry (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_EventLog_addEntry(t *testing.T) {
+	el := newEventLog()
+
+	total := DefaultEventLogSize + 10
+	for i := 0; i < total; i++ {
+		el.addEntry(&EventLogEntry{Event: events.New(nil)})
+	}
+
+	common.AssertEqual(t, DefaultEventLogSize, len(el.Entries), "unexpected log size")
+	common.AssertEqual(t, uint64(total+1), el.NextSequence, "unexpected next sequence")
+	common.AssertEqual(t, uint64(11), el.Entries[0].Sequence, "unexpected oldest sequence")
+	common.AssertEqual(t, uint64(total), el.Entries[len(el.Entries)-1].Sequence, "unexpected newest sequence")
+}
+
+func TestSystem_Database_EventLogEntries(t *testing.T) {
+	poolUUID := common.MockUUID(1)
+	baseTime := time.Now().Add(-time.Hour)
+
+	rankDown := events.NewRankDownEvent("host1", 0, 1, common.ExitStatus("test"))
+	swimDead := events.New(&events.RASEvent{
+		ID:       events.RASSwimRankDead,
+		Type:     events.RASTypeStateChange,
+		Severity: events.RASSeverityError,
+		Hostname: "host2",
+		Rank:     2,
+	})
+	psrUpdate := events.NewPoolSvcReplicasUpdateEvent("host1", 3, poolUUID, []uint32{0, 3}, 1)
+	sysStop := events.New(&events.RASEvent{
+		ID:       events.RASSystemStop,
+		Type:     events.RASTypeInfoOnly,
+		Hostname: "host3",
+		Rank:     uint32(NilRank),
+	})
+
+	allEvents := []*events.RASEvent{rankDown, swimDead, psrUpdate, sysStop}
+
+	for name, tc := range map[string]struct {
+		filter    *EventFilter
+		expEvents []*events.RASEvent
+		expSeqs   []uint64
+	}{
+		"nil filter": {
+			expEvents: allEvents,
+			expSeqs:   []uint64{1, 2, 3, 4},
+		},
+		"empty filter": {
+			filter:    &EventFilter{},
+			expEvents: allEvents,
+			expSeqs:   []uint64{1, 2, 3, 4},
+		},
+		"by type": {
+			filter: &EventFilter{
+				Types: []events.RASTypeID{events.RASTypeInfoOnly},
+			},
+			expEvents: []*events.RASEvent{sysStop},
+			expSeqs:   []uint64{4},
+		},
+		"by id": {
+			filter: &EventFilter{
+				IDs: []events.RASID{events.RASSwimRankDead, events.RASSystemStop},
+			},
+			expEvents: []*events.RASEvent{swimDead, sysStop},
+			expSeqs:   []uint64{2, 4},
+		},
+		"by severity": {
+			filter: &EventFilter{
+				Severities: []events.RASSeverityID{events.RASSeverityWarn, events.RASSeverityInfo},
+			},
+			expEvents: []*events.RASEvent{sysStop},
+			expSeqs:   []uint64{4},
+		},
+		"by rank": {
+			filter: &EventFilter{
+				Ranks: []Rank{1, 3},
+			},
+			expEvents: []*events.RASEvent{rankDown, psrUpdate},
+			expSeqs:   []uint64{1, 3},
+		},
+		"by host": {
+			filter: &EventFilter{
+				Hosts: []string{"host1"},
+			},
+			expEvents: []*events.RASEvent{rankDown, psrUpdate},
+			expSeqs:   []uint64{1, 3},
+		},
+		"by pool": {
+			filter: &EventFilter{
+				PoolUUID: poolUUID,
+			},
+			expEvents: []*events.RASEvent{psrUpdate},
+			expSeqs:   []uint64{3},
+		},
+		"by time range": {
+			filter: &EventFilter{
+				Since: baseTime.Add(time.Minute),
+				Until: baseTime.Add(2 * time.Minute),
+			},
+			expEvents: []*events.RASEvent{swimDead, psrUpdate},
+			expSeqs:   []uint64{2, 3},
+		},
+		"with limit": {
+			filter: &EventFilter{
+				Limit: 2,
+			},
+			expEvents: []*events.RASEvent{psrUpdate, sysStop},
+			expSeqs:   []uint64{3, 4},
+		},
+		"combined with limit": {
+			filter: &EventFilter{
+				Hosts: []string{"host1", "host2"},
+				Limit: 1,
+			},
+			expEvents: []*events.RASEvent{psrUpdate},
+			expSeqs:   []uint64{3},
+		},
+		"no match": {
+			filter: &EventFilter{
+				Hosts: []string{"host4"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			for i, evt := range allEvents {
+				raftUpdateTestEvent(t, db, mockEventLogEntry(t,
+					baseTime.Add(time.Duration(i)*time.Minute), evt))
+			}
+
+			entries, err := db.EventLogEntries(tc.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var gotEvents []*events.RASEvent
+			var gotSeqs []uint64
+			for _, ele := range entries {
+				gotEvents = append(gotEvents, ele.Event)
+				gotSeqs = append(gotSeqs, ele.Sequence)
+			}
+
+			cmpOpts := append(eventLogCmpOpts, cmp.Comparer(func(x, y error) bool {
+				return x.Error() == y.Error()
+			}))
+			if diff := cmp.Diff(tc.expEvents, gotEvents, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expSeqs, gotSeqs); diff != "" {
+				t.Fatalf("unexpected sequences (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_AddEvent(t *testing.T) {
+	for name, tc := range map[string]struct {
+		notLeader  bool
+		event      *events.RASEvent
+		expEntries int
+		expErr     error
+	}{
+		"nil event": {
+			expErr: errors.New("nil event"),
+		},
+		"not leader": {
+			notLeader: true,
+			event:     events.New(nil),
+			expErr:    errors.Errorf("not the %s leader", build.ManagementServiceName),
+		},
+		"success": {
+			event:      events.New(nil),
+			expEntries: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			if tc.notLeader {
+				db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+					State: raft.Follower,
+				}, (*fsm)(db)))
+			}
+
+			err := db.AddEvent(tc.event)
+			common.CmpErr(t, tc.expErr, err)
+
+			common.AssertEqual(t, tc.expEntries, len(db.data.Events.Entries),
+				"unexpected number of log entries")
+		})
+	}
+}
@@ -0,0 +1,104 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// PolicyState holds the runtime state of system-wide management
+	// policies. It is replicated along with the rest of the system
+	// database so that it survives a change of MS leader.
+	PolicyState struct {
+		AutoExcludePaused bool
+	}
+)
+
+// submitPolicyUpdate submits the given policy state to the raft service.
+func (db *Database) submitPolicyUpdate(ps *PolicyState) error {
+	data, err := createRaftUpdate(raftOpUpdatePolicyState, ps)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// applyPolicyUpdate is responsible for replacing the database's
+// policy state.
+func (d *dbData) applyPolicyUpdate(data []byte, panicFn func(error)) {
+	ps := new(PolicyState)
+	if err := json.Unmarshal(data, ps); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode policy state update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.Policy = ps
+}
+
+// PolicyState returns a copy of the current system policy state.
+func (db *Database) PolicyState() (*PolicyState, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	ps := new(PolicyState)
+	if db.data.Policy != nil {
+		*ps = *db.data.Policy
+	}
+	return ps, nil
+}
+
+// SetAutoExcludePaused updates the paused state of the automatic pool
+// exclusion policy.
+func (db *Database) SetAutoExcludePaused(paused bool) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	ps, err := db.PolicyState()
+	if err != nil {
+		return err
+	}
+	ps.AutoExcludePaused = paused
+
+	return db.submitPolicyUpdate(ps)
+}
+
+// FindPoolServicesByRank returns the pool services that have storage
+// or service replicas on the supplied rank.
+func (db *Database) FindPoolServicesByRank(rank Rank) ([]*PoolService, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	var result []*PoolService
+	for _, ps := range db.data.Pools.Uuids {
+		var storageRanks []Rank
+		if ps.Storage != nil {
+			storageRanks = ps.Storage.CurrentRanks()
+		}
+		for _, r := range append(storageRanks, ps.Replicas...) {
+			if r == rank {
+				result = append(result, copyPoolService(ps))
+				break
+			}
+		}
+	}
+	return result, nil
+}
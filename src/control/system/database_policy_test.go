@@ -0,0 +1,131 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func TestSystem_Database_SetAutoExcludePaused(t *testing.T) {
+	for name, tc := range map[string]struct {
+		notLeader bool
+		paused    bool
+		expState  *PolicyState
+		expErr    error
+	}{
+		"not leader": {
+			notLeader: true,
+			paused:    true,
+			expErr:    errors.Errorf("not the %s leader", build.ManagementServiceName),
+		},
+		"pause": {
+			paused:   true,
+			expState: &PolicyState{AutoExcludePaused: true},
+		},
+		"resume": {
+			expState: &PolicyState{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			if tc.notLeader {
+				db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+					State: raft.Follower,
+				}, (*fsm)(db)))
+			}
+
+			err := db.SetAutoExcludePaused(tc.paused)
+			common.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotState, err := db.PolicyState()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expState, gotState); diff != "" {
+				t.Fatalf("unexpected policy state (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_FindPoolServicesByRank(t *testing.T) {
+	storagePool := &PoolService{
+		PoolUUID: uuid.MustParse(common.MockUUID(1)),
+		State:    PoolServiceStateReady,
+		Replicas: []Rank{0},
+		Storage: &PoolServiceStorage{
+			CurrentRankStr: "[0-2]",
+		},
+	}
+	replicaPool := &PoolService{
+		PoolUUID: uuid.MustParse(common.MockUUID(2)),
+		State:    PoolServiceStateReady,
+		Replicas: []Rank{3},
+		Storage: &PoolServiceStorage{
+			CurrentRankStr: "[4-5]",
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		rank     Rank
+		expUUIDs []uuid.UUID
+	}{
+		"storage rank": {
+			rank:     1,
+			expUUIDs: []uuid.UUID{storagePool.PoolUUID},
+		},
+		"replica rank": {
+			rank:     3,
+			expUUIDs: []uuid.UUID{replicaPool.PoolUUID},
+		},
+		"unused rank": {
+			rank: 6,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			for _, ps := range []*PoolService{storagePool, replicaPool} {
+				data, err := createRaftUpdate(raftOpAddPoolService, ps)
+				if err != nil {
+					t.Fatal(err)
+				}
+				(*fsm)(db).Apply(&raft.Log{Data: data})
+			}
+
+			found, err := db.FindPoolServicesByRank(tc.rank)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var gotUUIDs []uuid.UUID
+			for _, ps := range found {
+				gotUUIDs = append(gotUUIDs, ps.PoolUUID)
+			}
+			if diff := cmp.Diff(tc.expUUIDs, gotUUIDs); diff != "" {
+				t.Fatalf("unexpected pools (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
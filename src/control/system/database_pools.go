@@ -43,11 +43,13 @@ type (
 	// PoolService represents a pool service created to manage metadata
 	// for a DAOS Pool.
 	PoolService struct {
-		PoolUUID  uuid.UUID
-		PoolLabel string
-		State     PoolServiceState
-		Replicas  []Rank
-		Storage   *PoolServiceStorage
+		PoolUUID   uuid.UUID
+		PoolLabel  string
+		OwnerUser  string // owning user, format name@domain
+		OwnerGroup string // owning group, format name@domain
+		State      PoolServiceState
+		Replicas   []Rank
+		Storage    *PoolServiceStorage
 	}
 
 	// PoolRankMap provides a map of Rank->[]*PoolService.
@@ -247,6 +249,9 @@ func (pdb *PoolDatabase) updateService(cur, new *PoolService) {
 
 	// TODO: Update svc rank map
 	cur.Replicas = new.Replicas
+	if new.Storage != nil {
+		cur.Storage = new.Storage
+	}
 
 	if cur.PoolLabel != "" {
 		delete(pdb.Labels, cur.PoolLabel)
@@ -0,0 +1,273 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// QuotaTypeUser indicates a quota on the pools owned by a user.
+	QuotaTypeUser QuotaType = iota
+	// QuotaTypeGroup indicates a quota on the pools owned by a group.
+	QuotaTypeGroup
+)
+
+type (
+	// QuotaType indicates whether a quota applies to a user or a group.
+	QuotaType uint32
+
+	// Quota records a limit on the total pool capacity (SCM and NVMe)
+	// that may be owned by a user or group.
+	Quota struct {
+		Type  QuotaType
+		Name  string // principal, format name@domain
+		Limit uint64 // total pool capacity in bytes
+	}
+
+	// QuotaMap provides a map of quota keys to *Quota.
+	QuotaMap map[string]*Quota
+
+	// QuotaUsage records the pool capacity currently owned by a user
+	// or group.
+	QuotaUsage struct {
+		Pools int
+		SCM   uint64
+		NVMe  uint64
+	}
+)
+
+func (qt QuotaType) String() string {
+	switch qt {
+	case QuotaTypeUser:
+		return "user"
+	case QuotaTypeGroup:
+		return "group"
+	default:
+		return fmt.Sprintf("unknown quota type %d", qt)
+	}
+}
+
+func quotaKey(qt QuotaType, name string) string {
+	return qt.String() + ":" + name
+}
+
+func (q *Quota) key() string {
+	return quotaKey(q.Type, q.Name)
+}
+
+// Total returns the total pool capacity in use.
+func (qu *QuotaUsage) Total() uint64 {
+	return qu.SCM + qu.NVMe
+}
+
+// submitQuotaUpdate submits the given quota to the raft service.
+func (db *Database) submitQuotaUpdate(q *Quota) error {
+	data, err := createRaftUpdate(raftOpUpdateQuota, q)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// applyQuotaUpdate is responsible for adding, replacing or removing (if
+// the limit is zero) a quota in the database.
+func (d *dbData) applyQuotaUpdate(data []byte, panicFn func(error)) {
+	q := new(Quota)
+	if err := json.Unmarshal(data, q); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode quota update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	if d.Quotas == nil {
+		d.Quotas = make(QuotaMap)
+	}
+	if q.Limit == 0 {
+		delete(d.Quotas, q.key())
+		return
+	}
+	d.Quotas[q.key()] = q
+}
+
+// SetQuota adds or replaces the quota for a user or group. A quota with
+// a zero limit removes any existing quota.
+func (db *Database) SetQuota(q *Quota) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	if q == nil {
+		return errors.New("nil quota")
+	}
+	if q.Type != QuotaTypeUser && q.Type != QuotaTypeGroup {
+		return errors.Errorf("invalid quota type %d", q.Type)
+	}
+	if q.Name == "" {
+		return errors.Errorf("empty %s name in quota", q.Type)
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	return db.submitQuotaUpdate(q)
+}
+
+// FindQuota returns the quota for the given user or group, or nil if
+// no quota has been set.
+func (db *Database) FindQuota(qt QuotaType, name string) (*Quota, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	q, found := db.data.Quotas[quotaKey(qt, name)]
+	if !found {
+		return nil, nil
+	}
+	qc := new(Quota)
+	*qc = *q
+	return qc, nil
+}
+
+// QuotaList returns copies of all quotas, ordered by type and name.
+func (db *Database) QuotaList() ([]*Quota, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	quotas := make([]*Quota, 0, len(db.data.Quotas))
+	for _, q := range db.data.Quotas {
+		qc := new(Quota)
+		*qc = *q
+		quotas = append(quotas, qc)
+	}
+	sort.Slice(quotas, func(i, j int) bool {
+		if quotas[i].Type != quotas[j].Type {
+			return quotas[i].Type < quotas[j].Type
+		}
+		return quotas[i].Name < quotas[j].Name
+	})
+
+	return quotas, nil
+}
+
+// QuotaUsage returns the total pool capacity owned by the given user or
+// group, aggregated over all pools in the system.
+func (db *Database) QuotaUsage(qt QuotaType, name string) (*QuotaUsage, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	usage := new(QuotaUsage)
+	for _, ps := range db.data.Pools.Uuids {
+		owner := ps.OwnerUser
+		if qt == QuotaTypeGroup {
+			owner = ps.OwnerGroup
+		}
+		if owner != name || ps.Storage == nil {
+			continue
+		}
+		usage.Pools++
+		usage.SCM += ps.Storage.TotalSCM()
+		usage.NVMe += ps.Storage.TotalNVMe()
+	}
+
+	return usage, nil
+}
+
+// poolCapacity returns the total pool capacity allocated to the pool.
+func poolCapacity(ps *PoolService) uint64 {
+	if ps == nil || ps.Storage == nil {
+		return 0
+	}
+	return ps.Storage.TotalSCM() + ps.Storage.TotalNVMe()
+}
+
+// checkPoolQuotas verifies that adding or updating the supplied pool
+// service would not take the capacity owned by its user or group over
+// their quota. Only growth in the pool's capacity is counted against the
+// quota, so that a pool owned by a principal whose quota has since been
+// reduced may still be updated. Must be called with the database lock held
+// in order to ensure that the check and subsequent update are performed
+// atomically.
+func (db *Database) checkPoolQuotas(ps *PoolService) error {
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	newCap := poolCapacity(ps)
+	for _, owner := range []struct {
+		qt   QuotaType
+		name string
+	}{
+		{QuotaTypeUser, ps.OwnerUser},
+		{QuotaTypeGroup, ps.OwnerGroup},
+	} {
+		if owner.name == "" {
+			continue
+		}
+		quota, found := db.data.Quotas[quotaKey(owner.qt, owner.name)]
+		if !found {
+			continue
+		}
+
+		var used, curCap uint64
+		for _, p := range db.data.Pools.Uuids {
+			pOwner := p.OwnerUser
+			if owner.qt == QuotaTypeGroup {
+				pOwner = p.OwnerGroup
+			}
+			if pOwner != owner.name {
+				continue
+			}
+			used += poolCapacity(p)
+			if p.PoolUUID == ps.PoolUUID {
+				curCap = poolCapacity(p)
+			}
+		}
+
+		if newCap <= curCap {
+			continue
+		}
+		requested := newCap - curCap
+		if used+requested > quota.Limit {
+			qc := new(Quota)
+			*qc = *quota
+			return &ErrQuotaExceeded{
+				Quota:     qc,
+				Used:      used,
+				Requested: requested,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckPoolQuotas returns an error if adding or updating the supplied pool
+// service would exceed the quota of its owning user or group. The check is
+// advisory (e.g. for a dry run); AddPoolService and UpdatePoolService repeat
+// it atomically with the update.
+func (db *Database) CheckPoolQuotas(ps *PoolService) error {
+	if err := db.CheckReplica(); err != nil {
+		return err
+	}
+	if ps == nil {
+		return errors.New("nil pool service")
+	}
+
+	return db.checkPoolQuotas(ps)
+}
@@ -0,0 +1,287 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func TestSystem_Database_SetQuota(t *testing.T) {
+	for name, tc := range map[string]struct {
+		quotas    []*Quota
+		expErr    error
+		expQuotas []*Quota
+	}{
+		"nil quota": {
+			quotas: []*Quota{nil},
+			expErr: errors.New("nil quota"),
+		},
+		"bad type": {
+			quotas: []*Quota{{Type: 42, Name: "foo@", Limit: 1}},
+			expErr: errors.New("invalid quota type"),
+		},
+		"empty name": {
+			quotas: []*Quota{{Type: QuotaTypeGroup, Limit: 1}},
+			expErr: errors.New("empty group name"),
+		},
+		"set": {
+			quotas: []*Quota{
+				{Type: QuotaTypeGroup, Name: "grp@", Limit: 2},
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 1},
+				{Type: QuotaTypeUser, Name: "bar@", Limit: 3},
+			},
+			expQuotas: []*Quota{
+				{Type: QuotaTypeUser, Name: "bar@", Limit: 3},
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 1},
+				{Type: QuotaTypeGroup, Name: "grp@", Limit: 2},
+			},
+		},
+		"replace": {
+			quotas: []*Quota{
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 1},
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 5},
+			},
+			expQuotas: []*Quota{
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 5},
+			},
+		},
+		"remove": {
+			quotas: []*Quota{
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 1},
+				{Type: QuotaTypeGroup, Name: "foo@", Limit: 1},
+				{Type: QuotaTypeUser, Name: "foo@"},
+			},
+			expQuotas: []*Quota{
+				{Type: QuotaTypeGroup, Name: "foo@", Limit: 1},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+
+			var gotErr error
+			for _, q := range tc.quotas {
+				if gotErr = db.SetQuota(q); gotErr != nil {
+					break
+				}
+			}
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotQuotas, err := db.QuotaList()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expQuotas, gotQuotas); diff != "" {
+				t.Fatalf("unexpected quotas (-want, +got):\n%s\n", diff)
+			}
+
+			for _, q := range tc.expQuotas {
+				gotQuota, err := db.FindQuota(q.Type, q.Name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(q, gotQuota); diff != "" {
+					t.Fatalf("unexpected quota (-want, +got):\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSystem_Database_QuotaUsage(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	for i, owner := range [][2]string{
+		{"foo@", "grp@"},
+		{"bar@", "grp@"},
+		{"foo@", "other@"},
+	} {
+		ps := NewPoolService(uuid.New(), 1, 10, []Rank{0, 1})
+		ps.PoolLabel = string(rune('a' + i))
+		ps.OwnerUser = owner[0]
+		ps.OwnerGroup = owner[1]
+		if err := db.AddPoolService(ps); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		qt       QuotaType
+		name     string
+		expUsage *QuotaUsage
+	}{
+		"user": {
+			qt:       QuotaTypeUser,
+			name:     "foo@",
+			expUsage: &QuotaUsage{Pools: 2, SCM: 4, NVMe: 40},
+		},
+		"group": {
+			qt:       QuotaTypeGroup,
+			name:     "grp@",
+			expUsage: &QuotaUsage{Pools: 2, SCM: 4, NVMe: 40},
+		},
+		"group with same name as user": {
+			qt:       QuotaTypeGroup,
+			name:     "foo@",
+			expUsage: &QuotaUsage{},
+		},
+		"no pools": {
+			qt:       QuotaTypeUser,
+			name:     "baz@",
+			expUsage: &QuotaUsage{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotUsage, err := db.QuotaUsage(tc.qt, tc.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expUsage, gotUsage); diff != "" {
+				t.Fatalf("unexpected usage (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_PoolQuotas(t *testing.T) {
+	existing := NewPoolService(uuid.New(), 1, 10, []Rank{0, 1})
+	existing.PoolLabel = "existing"
+	existing.OwnerUser = "foo@"
+	existing.OwnerGroup = "grp@"
+
+	// newPool returns a pool with 1 byte of SCM and 10 bytes of NVMe
+	// on each of the given ranks.
+	newPool := func(user, group string, ranks ...Rank) *PoolService {
+		ps := NewPoolService(uuid.New(), 1, 10, ranks)
+		ps.OwnerUser = user
+		ps.OwnerGroup = group
+		return ps
+	}
+	// grownPool returns a copy of the existing pool on the given ranks.
+	grownPool := func(ranks ...Rank) *PoolService {
+		ps := NewPoolService(existing.PoolUUID, 1, 10, ranks)
+		ps.PoolLabel = existing.PoolLabel
+		ps.OwnerUser = existing.OwnerUser
+		ps.OwnerGroup = existing.OwnerGroup
+		return ps
+	}
+
+	for name, tc := range map[string]struct {
+		quotas []*Quota
+		add    *PoolService
+		update *PoolService
+		expErr error
+	}{
+		"no quotas": {
+			add: newPool("foo@", "grp@", 0, 1, 2, 3),
+		},
+		"within quotas": {
+			quotas: []*Quota{
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 66},
+				{Type: QuotaTypeGroup, Name: "grp@", Limit: 66},
+			},
+			add: newPool("foo@", "grp@", 0, 1, 2, 3),
+		},
+		"user quota exceeded": {
+			quotas: []*Quota{
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 65},
+			},
+			add: newPool("foo@", "grp@", 0, 1, 2, 3),
+			expErr: &ErrQuotaExceeded{
+				Quota:     &Quota{Type: QuotaTypeUser, Name: "foo@", Limit: 65},
+				Used:      22,
+				Requested: 44,
+			},
+		},
+		"group quota exceeded": {
+			quotas: []*Quota{
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 1000},
+				{Type: QuotaTypeGroup, Name: "grp@", Limit: 43},
+			},
+			add: newPool("bar@", "grp@", 0, 1),
+			expErr: &ErrQuotaExceeded{
+				Quota:     &Quota{Type: QuotaTypeGroup, Name: "grp@", Limit: 43},
+				Used:      22,
+				Requested: 22,
+			},
+		},
+		"other owner": {
+			quotas: []*Quota{
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 1},
+			},
+			add: newPool("bar@", "other@", 0, 1, 2, 3),
+		},
+		"growth counted on update": {
+			quotas: []*Quota{
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 32},
+			},
+			update: grownPool(0, 1, 2),
+			expErr: &ErrQuotaExceeded{
+				Quota:     &Quota{Type: QuotaTypeUser, Name: "foo@", Limit: 32},
+				Used:      22,
+				Requested: 11,
+			},
+		},
+		"growth within quota on update": {
+			quotas: []*Quota{
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 33},
+			},
+			update: grownPool(0, 1, 2),
+		},
+		"update without growth over reduced quota": {
+			quotas: []*Quota{
+				{Type: QuotaTypeUser, Name: "foo@", Limit: 1},
+			},
+			update: grownPool(0, 1),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			if err := db.AddPoolService(existing); err != nil {
+				t.Fatal(err)
+			}
+			for _, q := range tc.quotas {
+				if err := db.SetQuota(q); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var gotErr error
+			if tc.add != nil {
+				common.CmpErr(t, tc.expErr, db.CheckPoolQuotas(tc.add))
+				gotErr = db.AddPoolService(tc.add)
+			} else {
+				gotErr = db.UpdatePoolService(tc.update)
+			}
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr == nil {
+				return
+			}
+			if !IsQuotaExceeded(gotErr) {
+				t.Fatalf("expected quota exceeded error, got %v", gotErr)
+			}
+		})
+	}
+}
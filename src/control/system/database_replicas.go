@@ -0,0 +1,273 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/common"
+)
+
+const (
+	// replicasFile records the MS replica set in the raft directory
+	// after it has been changed at runtime, so that the change survives
+	// a restart of the control plane server.
+	replicasFile = "replicas.json"
+)
+
+type (
+	// syncReplicas protects the list of MS replica addresses with a
+	// mutex in order to allow the replica set to be changed while the
+	// system is running.
+	syncReplicas struct {
+		sync.RWMutex
+		addrs []*net.TCPAddr
+	}
+
+	// replicasUpdate records a change to the MS replica set so that
+	// it can be applied by all replicas.
+	replicasUpdate struct {
+		Replicas []string
+	}
+)
+
+func (sr *syncReplicas) get() []*net.TCPAddr {
+	sr.RLock()
+	defer sr.RUnlock()
+	return append([]*net.TCPAddr{}, sr.addrs...)
+}
+
+func (sr *syncReplicas) set(addrs []*net.TCPAddr) {
+	sr.Lock()
+	defer sr.Unlock()
+	sr.addrs = append([]*net.TCPAddr{}, addrs...)
+}
+
+func resolveReplicas(replicas []string) ([]*net.TCPAddr, error) {
+	addrs := make([]*net.TCPAddr, 0, len(replicas))
+	for _, replica := range replicas {
+		addr, err := net.ResolveTCPAddr("tcp", replica)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid replica address %q", replica)
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// loadReplicas returns the replica set saved in the raft directory, if
+// the set has been changed at runtime, or nil otherwise.
+func loadReplicas(raftDir string) ([]*net.TCPAddr, error) {
+	if raftDir == "" {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(raftDir, replicasFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to read saved replica set")
+	}
+
+	var replicas []string
+	if err := json.Unmarshal(data, &replicas); err != nil {
+		return nil, errors.Wrap(err, "failed to decode saved replica set")
+	}
+	return resolveReplicas(replicas)
+}
+
+// saveReplicas records the current replica set in the raft directory.
+func (db *Database) saveReplicas() error {
+	if db.cfg.RaftDir == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(db.stringReplicas(nil))
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(db.cfg.RaftDir, replicasFile)
+	if err := ioutil.WriteFile(path+".tmp", data, 0600); err != nil {
+		return errors.Wrap(err, "failed to write replica set")
+	}
+	return errors.Wrap(os.Rename(path+".tmp", path), "failed to write replica set")
+}
+
+// submitReplicasUpdate submits the given replica set to the raft service.
+func (db *Database) submitReplicasUpdate(replicas []*net.TCPAddr) error {
+	ru := new(replicasUpdate)
+	for _, addr := range replicas {
+		ru.Replicas = append(ru.Replicas, addr.String())
+	}
+
+	data, err := createRaftUpdate(raftOpUpdateReplicas, ru)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// applyReplicasUpdate is responsible for replacing the replica set.
+// The updated set is saved so that it is used when the control plane
+// server is restarted.
+func (db *Database) applyReplicasUpdate(data []byte, panicFn func(error)) {
+	ru := new(replicasUpdate)
+	if err := json.Unmarshal(data, ru); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode replicas update"))
+		return
+	}
+
+	replicas, err := resolveReplicas(ru.Replicas)
+	if err != nil {
+		panicFn(err)
+		return
+	}
+	db.replicas.set(replicas)
+
+	if err := db.saveReplicas(); err != nil {
+		db.log.Errorf("failed to save %s replica set: %s", build.ManagementServiceName, err)
+	}
+}
+
+// ReplicaList returns the current leader and the set of replicas in the
+// raft configuration.
+func (db *Database) ReplicaList() (leader string, replicas []string, err error) {
+	if err := db.CheckReplica(); err != nil {
+		return "", nil, err
+	}
+
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		future := svc.GetConfiguration()
+		if err := future.Error(); err != nil {
+			return err
+		}
+		for _, srv := range future.Configuration().Servers {
+			if srv.Suffrage != raft.Voter {
+				continue
+			}
+			replicas = append(replicas, string(srv.Address))
+		}
+		return nil
+	}); err != nil {
+		return "", nil, errors.Wrap(err, "failed to get raft configuration")
+	}
+	sort.Strings(replicas)
+
+	return db.leaderHint(), replicas, nil
+}
+
+// AddReplica adds the server with the given control address to the set
+// of MS replicas. A replica must already have been started on the server
+// with StartReplica.
+func (db *Database) AddReplica(addr *net.TCPAddr) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	if db.isReplica(addr) {
+		return errors.Errorf("%s is already a %s replica", addr, build.ManagementServiceName)
+	}
+
+	db.log.Debugf("adding %s as a new raft voter", addr)
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		return svc.AddVoter(raft.ServerID(addr.String()), raft.ServerAddress(addr.String()), 0, 0).Error()
+	}); err != nil {
+		return errors.Wrapf(err, "failed to add %q as raft replica", addr)
+	}
+
+	return db.submitReplicasUpdate(append(db.replicas.get(), addr))
+}
+
+// RemoveReplica removes the server with the given control address from
+// the set of MS replicas. The current leader may not be removed.
+func (db *Database) RemoveReplica(addr *net.TCPAddr) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	if !db.isReplica(addr) {
+		return errors.Errorf("%s is not a %s replica", addr, build.ManagementServiceName)
+	}
+	if common.CmpTCPAddr(addr, db.getReplica()) {
+		return errors.Errorf("cannot remove the current %s leader", build.ManagementServiceName)
+	}
+
+	cur := db.replicas.get()
+	var remaining []*net.TCPAddr
+	for _, replica := range cur {
+		if !common.CmpTCPAddr(replica, addr) {
+			remaining = append(remaining, replica)
+		}
+	}
+
+	// Record the new replica set before removing the replica so that
+	// the replica being removed has a chance to apply the update.
+	if err := db.submitReplicasUpdate(remaining); err != nil {
+		return err
+	}
+
+	db.log.Debugf("removing %s as a raft voter", addr)
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		return svc.RemoveServer(raft.ServerID(addr.String()), 0, 0).Error()
+	}); err != nil {
+		if rbErr := db.submitReplicasUpdate(cur); rbErr != nil {
+			db.log.Errorf("failed to restore %s replica set: %s", build.ManagementServiceName, rbErr)
+		}
+		return errors.Wrapf(err, "failed to remove %q as raft replica", addr)
+	}
+
+	return nil
+}
+
+// StartReplica starts a MS replica on a server that is not currently a
+// replica, in preparation for it being added to the replica set by the
+// leader with AddReplica. The supplied replica set must include one of
+// the server's local addresses.
+func (db *Database) StartReplica(ctx context.Context, replicas []*net.TCPAddr) error {
+	if db.IsReplica() {
+		return errors.Errorf("already a %s replica", build.ManagementServiceName)
+	}
+
+	var localAddr *net.TCPAddr
+	for _, addr := range replicas {
+		if common.IsLocalAddr(addr) {
+			localAddr = addr
+			break
+		}
+	}
+	if localAddr == nil {
+		return errors.New("replica set does not include a local address")
+	}
+
+	prev := db.replicas.get()
+	db.replicas.set(replicas)
+	db.setReplica(localAddr)
+	if err := db.Start(ctx); err != nil {
+		db.replicas.set(prev)
+		db.setReplica(nil)
+		return err
+	}
+
+	return db.saveReplicas()
+}
@@ -0,0 +1,239 @@
+//
+// (C) Copyright 2021 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/mjmac/soad/src/control/build"
+	"github.com/mjmac/soad/src/control/common"
+	"github.com/mjmac/soad/src/control/logging"
+)
+
+func TestSystem_Database_AddReplica(t *testing.T) {
+	for name, tc := range map[string]struct {
+		notLeader   bool
+		addr        *net.TCPAddr
+		voterErr    error
+		expErr      error
+		expReplicas []string
+	}{
+		"not leader": {
+			notLeader: true,
+			addr:      mockControlAddr(t, 2),
+			expErr:    errors.Errorf("not the %s leader", build.ManagementServiceName),
+		},
+		"already a replica": {
+			addr:   mockControlAddr(t, 1),
+			expErr: errors.New("already a"),
+		},
+		"add voter fails": {
+			addr:     mockControlAddr(t, 2),
+			voterErr: errors.New("voter failed"),
+			expErr:   errors.New("voter failed"),
+		},
+		"success": {
+			addr:        mockControlAddr(t, 2),
+			expReplicas: []string{"127.0.0.1:10001", "127.0.0.2:10001"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			var cleanup func()
+			db.cfg.RaftDir, cleanup = common.CreateTestDir(t)
+			defer cleanup()
+			mockCfg := &mockRaftServiceConfig{
+				State:    raft.Leader,
+				VoterErr: tc.voterErr,
+				Servers: []raft.Server{
+					{
+						Suffrage: raft.Voter,
+						ID:       raft.ServerID(db.serverAddress()),
+						Address:  db.serverAddress(),
+					},
+				},
+			}
+			if tc.notLeader {
+				mockCfg.State = raft.Follower
+			}
+			db.raft.setSvc(newMockRaftService(mockCfg, (*fsm)(db)))
+
+			err := db.AddReplica(tc.addr)
+			common.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			_, gotReplicas, err := db.ReplicaList()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expReplicas, gotReplicas); diff != "" {
+				t.Fatalf("unexpected raft replicas (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expReplicas, db.stringReplicas(nil)); diff != "" {
+				t.Fatalf("unexpected db replicas (-want, +got):\n%s\n", diff)
+			}
+
+			// The updated replica set should be used on restart.
+			saved, err := loadReplicas(db.cfg.RaftDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(db.replicas.get(), saved); diff != "" {
+				t.Fatalf("unexpected saved replicas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_RemoveReplica(t *testing.T) {
+	for name, tc := range map[string]struct {
+		addr        *net.TCPAddr
+		voterErr    error
+		expErr      error
+		expReplicas []string
+	}{
+		"not a replica": {
+			addr:   mockControlAddr(t, 3),
+			expErr: errors.New("is not a"),
+		},
+		"current leader": {
+			addr:   mockControlAddr(t, 1),
+			expErr: errors.New("cannot remove the current"),
+		},
+		"remove server fails": {
+			addr:        mockControlAddr(t, 2),
+			voterErr:    errors.New("remove failed"),
+			expErr:      errors.New("remove failed"),
+			expReplicas: []string{"127.0.0.1:10001", "127.0.0.2:10001"},
+		},
+		"success": {
+			addr:        mockControlAddr(t, 2),
+			expReplicas: []string{"127.0.0.1:10001"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			mockCfg := &mockRaftServiceConfig{State: raft.Leader}
+			db.replicas.set([]*net.TCPAddr{mockControlAddr(t, 1), mockControlAddr(t, 2)})
+			for _, replica := range db.replicas.get() {
+				mockCfg.Servers = append(mockCfg.Servers, raft.Server{
+					Suffrage: raft.Voter,
+					ID:       raft.ServerID(replica.String()),
+					Address:  raft.ServerAddress(replica.String()),
+				})
+			}
+			mockCfg.VoterErr = tc.voterErr
+			db.raft.setSvc(newMockRaftService(mockCfg, (*fsm)(db)))
+
+			err := db.RemoveReplica(tc.addr)
+			common.CmpErr(t, tc.expErr, err)
+			if tc.expReplicas == nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expReplicas, db.stringReplicas(nil)); diff != "" {
+				t.Fatalf("unexpected db replicas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_StartReplica(t *testing.T) {
+	for name, tc := range map[string]struct {
+		replica  *net.TCPAddr
+		replicas []*net.TCPAddr
+		expErr   error
+	}{
+		"already a replica": {
+			replica:  mockControlAddr(t, 1),
+			replicas: []*net.TCPAddr{mockControlAddr(t, 1)},
+			expErr:   errors.New("already a"),
+		},
+		"no local address": {
+			replicas: []*net.TCPAddr{
+				{IP: net.ParseIP("192.0.2.1"), Port: build.DefaultControlPort},
+			},
+			expErr: errors.New("does not include a local address"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabaseWithAddr(t, log, tc.replica)
+
+			err := db.StartReplica(context.Background(), tc.replicas)
+			common.CmpErr(t, tc.expErr, err)
+			if db.IsReplica() != (tc.replica != nil) {
+				t.Fatal("unexpected change to replica state")
+			}
+		})
+	}
+}
+
+func TestSystem_loadReplicas(t *testing.T) {
+	for name, tc := range map[string]struct {
+		noRaftDir bool
+		data      string
+		expAddrs  []*net.TCPAddr
+		expErr    error
+	}{
+		"no raft dir": {
+			noRaftDir: true,
+		},
+		"no saved replicas": {},
+		"bad json": {
+			data:   "{",
+			expErr: errors.New("failed to decode"),
+		},
+		"bad address": {
+			data:   `["foo:bar"]`,
+			expErr: errors.New("invalid replica address"),
+		},
+		"saved replicas": {
+			data:     `["127.0.0.1:10001","127.0.0.2:10001"]`,
+			expAddrs: []*net.TCPAddr{mockControlAddr(t, 1), mockControlAddr(t, 2)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var raftDir string
+			if !tc.noRaftDir {
+				var cleanup func()
+				raftDir, cleanup = common.CreateTestDir(t)
+				defer cleanup()
+			}
+			if tc.data != "" {
+				path := filepath.Join(raftDir, replicasFile)
+				if err := ioutil.WriteFile(path, []byte(tc.data), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotAddrs, err := loadReplicas(raftDir)
+			common.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expAddrs, gotAddrs); diff != "" {
+				t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -230,6 +230,7 @@ func (tss *testSnapshotSink) Reader() io.ReadCloser {
 func TestSystem_Database_SnapshotRestore(t *testing.T) {
 	maxRanks := 2048
 	maxPools := 1024
+	maxEvents := 512
 
 	log, buf := logging.NewTestLogger(t.Name())
 	defer common.ShowBufferOnFailure(t, buf)
@@ -286,6 +287,25 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 		(*fsm)(db0).Apply(rl)
 	}
 
+	for i := 0; i < maxEvents; i++ {
+		raftUpdateTestEvent(t, db0, &EventLogEntry{
+			Time:  time.Now(),
+			Event: events.NewRankDownEvent("foo", 0, uint32(i), common.ExitStatus("test")),
+		})
+	}
+
+	data, err := createRaftUpdate(raftOpUpdatePolicyState, &PolicyState{AutoExcludePaused: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	(*fsm)(db0).Apply(&raft.Log{Data: data})
+
+	data, err = createRaftUpdate(raftOpUpdateQuota, &Quota{Type: QuotaTypeGroup, Name: "grp@", Limit: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	(*fsm)(db0).Apply(&raft.Log{Data: data})
+
 	snap, err := (*fsm)(db0).Snapshot()
 	if err != nil {
 		t.Fatal(err)
@@ -303,7 +323,7 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 	}
 
 	cmpOpts := []cmp.Option{
-		cmpopts.IgnoreUnexported(dbData{}, Member{}, PoolServiceStorage{}),
+		cmpopts.IgnoreUnexported(dbData{}, Member{}, PoolServiceStorage{}, events.RASEvent{}),
 		cmpopts.IgnoreFields(dbData{}, "RWMutex"),
 		cmpopts.IgnoreFields(PoolServiceStorage{}, "Mutex"),
 	}
@@ -591,14 +611,19 @@ func TestSystem_Database_OnEvent(t *testing.T) {
 	puuidAnother := uuid.New()
 
 	for name, tc := range map[string]struct {
-		poolSvcs    []*PoolService
-		event       *events.RASEvent
-		expPoolSvcs []*PoolService
+		poolSvcs     []*PoolService
+		event        *events.RASEvent
+		expPoolSvcs  []*PoolService
+		expLogLength int
 	}{
 		"nil event": {
 			event:       nil,
 			expPoolSvcs: []*PoolService{},
 		},
+		"system map update not recorded": {
+			event:       events.NewSystemMapUpdateEvent("foo", 42),
+			expPoolSvcs: []*PoolService{},
+		},
 		"pool svc replicas update miss": {
 			poolSvcs: []*PoolService{
 				{
@@ -618,6 +643,7 @@ func TestSystem_Database_OnEvent(t *testing.T) {
 					Replicas:  []Rank{1, 2, 3, 4, 5},
 				},
 			},
+			expLogLength: 1,
 		},
 		"pool svc replicas update hit": {
 			poolSvcs: []*PoolService{
@@ -638,6 +664,7 @@ func TestSystem_Database_OnEvent(t *testing.T) {
 					Replicas:  []Rank{2, 3, 5, 6, 7},
 				},
 			},
+			expLogLength: 1,
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
@@ -674,6 +701,176 @@ func TestSystem_Database_OnEvent(t *testing.T) {
 			if diff := cmp.Diff(tc.expPoolSvcs, poolSvcs, cmpOpts...); diff != "" {
 				t.Errorf("unexpected pool service replicas (-want, +got):\n%s\n", diff)
 			}
+
+			entries, err := db.EventLogEntries(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			common.AssertEqual(t, tc.expLogLength, len(entries), "unexpected event log length")
+		})
+	}
+}
+
+func TestSystem_Database_PoolLabelUniqueness(t *testing.T) {
+	puuid := uuid.New()
+	puuidAnother := uuid.New()
+
+	for name, tc := range map[string]struct {
+		poolSvcs  []*PoolService
+		add       *PoolService
+		update    *PoolService
+		expErr    error
+		expLabels map[string]uuid.UUID
+	}{
+		"add with unique label": {
+			poolSvcs: []*PoolService{
+				{PoolUUID: puuid, PoolLabel: "pool0001"},
+			},
+			add: &PoolService{PoolUUID: puuidAnother, PoolLabel: "pool0002"},
+			expLabels: map[string]uuid.UUID{
+				"pool0001": puuid,
+				"pool0002": puuidAnother,
+			},
+		},
+		"add with duplicate label": {
+			poolSvcs: []*PoolService{
+				{PoolUUID: puuid, PoolLabel: "pool0001"},
+			},
+			add:    &PoolService{PoolUUID: puuidAnother, PoolLabel: "pool0001"},
+			expErr: &ErrPoolLabelExists{Label: "pool0001"},
+			expLabels: map[string]uuid.UUID{
+				"pool0001": puuid,
+			},
+		},
+		"add without label": {
+			poolSvcs: []*PoolService{
+				{PoolUUID: puuid},
+			},
+			add:       &PoolService{PoolUUID: puuidAnother},
+			expLabels: map[string]uuid.UUID{},
+		},
+		"relabel": {
+			poolSvcs: []*PoolService{
+				{PoolUUID: puuid, PoolLabel: "pool0001"},
+			},
+			update: &PoolService{PoolUUID: puuid, PoolLabel: "renamed"},
+			expLabels: map[string]uuid.UUID{
+				"renamed": puuid,
+			},
+		},
+		"relabel with same label": {
+			poolSvcs: []*PoolService{
+				{PoolUUID: puuid, PoolLabel: "pool0001"},
+			},
+			update: &PoolService{PoolUUID: puuid, PoolLabel: "pool0001"},
+			expLabels: map[string]uuid.UUID{
+				"pool0001": puuid,
+			},
+		},
+		"relabel with duplicate label": {
+			poolSvcs: []*PoolService{
+				{PoolUUID: puuid, PoolLabel: "pool0001"},
+				{PoolUUID: puuidAnother, PoolLabel: "pool0002"},
+			},
+			update: &PoolService{PoolUUID: puuidAnother, PoolLabel: "pool0001"},
+			expErr: &ErrPoolLabelExists{Label: "pool0001"},
+			expLabels: map[string]uuid.UUID{
+				"pool0001": puuid,
+				"pool0002": puuidAnother,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			for _, ps := range tc.poolSvcs {
+				if err := db.AddPoolService(ps); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var gotErr error
+			switch {
+			case tc.add != nil:
+				gotErr = db.AddPoolService(tc.add)
+			case tc.update != nil:
+				gotErr = db.UpdatePoolService(tc.update)
+			}
+			common.CmpErr(t, tc.expErr, gotErr)
+
+			gotLabels := make(map[string]uuid.UUID)
+			for label, ps := range db.data.Pools.Labels {
+				gotLabels[label] = ps.PoolUUID
+			}
+			if diff := cmp.Diff(tc.expLabels, gotLabels); diff != "" {
+				t.Fatalf("unexpected pool labels (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_RaftStatus(t *testing.T) {
+	for name, tc := range map[string]struct {
+		notReplica bool
+		raftCfg    *mockRaftServiceConfig
+		expStatus  *RaftStatus
+		expErr     error
+	}{
+		"not replica": {
+			notReplica: true,
+			expErr:     errors.New("replica"),
+		},
+		"leader": {
+			raftCfg: &mockRaftServiceConfig{
+				State:         raft.Leader,
+				ServerAddress: "127.0.0.1:10001",
+				Term:          3,
+			},
+			expStatus: &RaftStatus{
+				State:  "Leader",
+				Leader: "127.0.0.1:10001",
+				Term:   3,
+			},
+		},
+		"follower": {
+			raftCfg: &mockRaftServiceConfig{
+				State:         raft.Follower,
+				ServerAddress: "127.0.0.2:10001",
+				Term:          7,
+			},
+			expStatus: &RaftStatus{
+				State:  "Follower",
+				Leader: "127.0.0.2:10001",
+				Term:   7,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)
+
+			var db *Database
+			if tc.notReplica {
+				var err error
+				if db, err = NewDatabase(log, nil); err != nil {
+					t.Fatal(err)
+				}
+			} else {
+				db = MockDatabase(t, log)
+				db.raft.setSvc(newMockRaftService(tc.raftCfg, (*fsm)(db)))
+			}
+
+			gotStatus, gotErr := db.RaftStatus()
+			common.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expStatus, gotStatus); diff != "" {
+				t.Fatalf("unexpected raft status (-want, +got):\n%s\n", diff)
+			}
 		})
 	}
 }
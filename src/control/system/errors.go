@@ -149,3 +149,40 @@ func IsPoolNotFound(err error) bool {
 	_, ok := errors.Cause(err).(*ErrPoolNotFound)
 	return ok
 }
+
+// ErrQuotaExceeded indicates that allocating the requested pool capacity
+// would exceed the quota of the pool's owning user or group.
+type ErrQuotaExceeded struct {
+	Quota     *Quota
+	Used      uint64
+	Requested uint64
+}
+
+func (err *ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("request for %d bytes of pool capacity would exceed the %s quota for %s (%d of %d bytes used)",
+		err.Requested, err.Quota.Type, err.Quota.Name, err.Used, err.Quota.Limit)
+}
+
+// IsQuotaExceeded returns a boolean indicating whether or not the
+// supplied error is an instance of ErrQuotaExceeded.
+func IsQuotaExceeded(err error) bool {
+	_, ok := errors.Cause(err).(*ErrQuotaExceeded)
+	return ok
+}
+
+// ErrPoolLabelExists indicates the failure of an operation that
+// expected the given pool label to be unused.
+type ErrPoolLabelExists struct {
+	Label string
+}
+
+func (err *ErrPoolLabelExists) Error() string {
+	return fmt.Sprintf("pool label %q is already in use", err.Label)
+}
+
+// IsPoolLabelExists returns a boolean indicating whether or not the
+// supplied error is an instance of ErrPoolLabelExists.
+func IsPoolLabelExists(err error) bool {
+	_, ok := errors.Cause(err).(*ErrPoolLabelExists)
+	return ok
+}
@@ -9,6 +9,7 @@ package system
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"testing"
 	"time"
 
@@ -64,11 +65,15 @@ type (
 		err      error
 		index    uint64
 		response interface{}
+		config   raft.Configuration
 	}
 	mockRaftServiceConfig struct {
 		LeaderCh      <-chan bool
 		ServerAddress raft.ServerAddress
 		State         raft.RaftState
+		Term          uint64
+		Servers       []raft.Server
+		VoterErr      error
 	}
 	mockRaftService struct {
 		cfg mockRaftServiceConfig
@@ -76,22 +81,46 @@ type (
 	}
 )
 
-// mockRaftFuture implements raft.Future, raft.IndexFuture, and raft.ApplyFuture
-func (mrf *mockRaftFuture) Error() error          { return mrf.err }
-func (mrf *mockRaftFuture) Index() uint64         { return mrf.index }
-func (mrf *mockRaftFuture) Response() interface{} { return mrf.response }
+// mockRaftFuture implements raft.Future, raft.IndexFuture, raft.ApplyFuture
+// and raft.ConfigurationFuture
+func (mrf *mockRaftFuture) Error() error                      { return mrf.err }
+func (mrf *mockRaftFuture) Index() uint64                     { return mrf.index }
+func (mrf *mockRaftFuture) Response() interface{}             { return mrf.response }
+func (mrf *mockRaftFuture) Configuration() raft.Configuration { return mrf.config }
 
 func (mrs *mockRaftService) Apply(cmd []byte, timeout time.Duration) raft.ApplyFuture {
 	mrs.fsm.Apply(&raft.Log{Data: cmd})
 	return &mockRaftFuture{}
 }
 
-func (mr *mockRaftService) AddVoter(_ raft.ServerID, _ raft.ServerAddress, _ uint64, _ time.Duration) raft.IndexFuture {
-	return &mockRaftFuture{}
+func (mr *mockRaftService) AddVoter(id raft.ServerID, addr raft.ServerAddress, _ uint64, _ time.Duration) raft.IndexFuture {
+	if mr.cfg.VoterErr == nil {
+		mr.cfg.Servers = append(mr.cfg.Servers, raft.Server{
+			Suffrage: raft.Voter,
+			ID:       id,
+			Address:  addr,
+		})
+	}
+	return &mockRaftFuture{err: mr.cfg.VoterErr}
 }
 
-func (mr *mockRaftService) RemoveServer(_ raft.ServerID, _ uint64, _ time.Duration) raft.IndexFuture {
-	return &mockRaftFuture{}
+func (mr *mockRaftService) RemoveServer(id raft.ServerID, _ uint64, _ time.Duration) raft.IndexFuture {
+	if mr.cfg.VoterErr == nil {
+		var servers []raft.Server
+		for _, srv := range mr.cfg.Servers {
+			if srv.ID != id {
+				servers = append(servers, srv)
+			}
+		}
+		mr.cfg.Servers = servers
+	}
+	return &mockRaftFuture{err: mr.cfg.VoterErr}
+}
+
+func (mrs *mockRaftService) GetConfiguration() raft.ConfigurationFuture {
+	return &mockRaftFuture{
+		config: raft.Configuration{Servers: mrs.cfg.Servers},
+	}
 }
 
 func (mrs *mockRaftService) BootstrapCluster(cfg raft.Configuration) raft.Future {
@@ -119,6 +148,13 @@ func (mrs *mockRaftService) State() raft.RaftState {
 	return mrs.cfg.State
 }
 
+func (mrs *mockRaftService) Stats() map[string]string {
+	return map[string]string{
+		"state": mrs.cfg.State.String(),
+		"term":  strconv.FormatUint(mrs.cfg.Term, 10),
+	}
+}
+
 func newMockRaftService(cfg *mockRaftServiceConfig, fsm raft.FSM) *mockRaftService {
 	if cfg == nil {
 		cfg = &mockRaftServiceConfig{
@@ -146,9 +182,19 @@ func MockDatabaseWithAddr(t *testing.T, log logging.Logger, addr *net.TCPAddr) *
 		t.Fatal(err)
 	}
 	db.replicaAddr.Addr = addr
-	db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+	mockCfg := &mockRaftServiceConfig{
 		State: raft.Leader,
-	}, (*fsm)(db)))
+	}
+	if addr != nil {
+		mockCfg.Servers = []raft.Server{
+			{
+				Suffrage: raft.Voter,
+				ID:       raft.ServerID(addr.String()),
+				Address:  raft.ServerAddress(addr.String()),
+			},
+		}
+	}
+	db.raft.setSvc(newMockRaftService(mockCfg, (*fsm)(db)))
 
 	return db
 }
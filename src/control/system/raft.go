@@ -38,6 +38,10 @@ const (
 	raftOpAddPoolService
 	raftOpUpdatePoolService
 	raftOpRemovePoolService
+	raftOpAddEvent
+	raftOpUpdatePolicyState
+	raftOpUpdateReplicas
+	raftOpUpdateQuota
 
 	sysDBFile = "daos_system.db"
 )
@@ -71,6 +75,10 @@ func (ro raftOp) String() string {
 		"addPoolService",
 		"updatePoolService",
 		"removePoolService",
+		"addEvent",
+		"updatePolicyState",
+		"updateReplicas",
+		"updateQuota",
 	}[ro]
 }
 
@@ -182,7 +190,15 @@ func (db *Database) startRaft(newDB bool) error {
 // being developed.
 type loggingTransport struct {
 	raft.Transport
-	log logging.Logger
+	log       logging.Logger
+	localAddr func() raft.ServerAddress
+}
+
+// LocalAddr returns the current local replica address rather than the
+// address at the time that the transport was created, as a replica may
+// be started on a node after it has been added to the replica set.
+func (dt *loggingTransport) LocalAddr() raft.ServerAddress {
+	return dt.localAddr()
 }
 
 /*
@@ -220,6 +236,7 @@ func (db *Database) ConfigureTransport(srv *grpc.Server, dialOpts ...grpc.DialOp
 	db.raftTransport = &loggingTransport{
 		Transport: tm.Transport(),
 		log:       db.log,
+		localAddr: db.serverAddress,
 	}
 }
 
@@ -263,6 +280,15 @@ func (db *Database) submitPoolUpdate(op raftOp, ps *PoolService) error {
 	return db.submitRaftUpdate(data)
 }
 
+// submitEventUpdate submits the given event log entry to the raft service.
+func (db *Database) submitEventUpdate(ele *EventLogEntry) error {
+	data, err := createRaftUpdate(raftOpAddEvent, ele)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
 // submitRaftUpdate submits the serialized operation to the raft service.
 func (db *Database) submitRaftUpdate(data []byte) error {
 	return db.raft.withReadLock(func(svc raftService) error {
@@ -306,6 +332,14 @@ func (f *fsm) Apply(l *raft.Log) interface{} {
 		f.data.applyMemberUpdate(c.Op, c.Data, f.EmergencyShutdown)
 	case raftOpAddPoolService, raftOpUpdatePoolService, raftOpRemovePoolService:
 		f.data.applyPoolUpdate(c.Op, c.Data, f.EmergencyShutdown)
+	case raftOpAddEvent:
+		f.data.applyEventUpdate(c.Data, f.EmergencyShutdown)
+	case raftOpUpdatePolicyState:
+		f.data.applyPolicyUpdate(c.Data, f.EmergencyShutdown)
+	case raftOpUpdateReplicas:
+		(*Database)(f).applyReplicasUpdate(c.Data, f.EmergencyShutdown)
+	case raftOpUpdateQuota:
+		f.data.applyQuotaUpdate(c.Data, f.EmergencyShutdown)
 	default:
 		f.EmergencyShutdown(errors.Errorf("unhandled Apply operation: %d", c.Op))
 		return nil
@@ -376,6 +410,21 @@ func (d *dbData) applyPoolUpdate(op raftOp, data []byte, panicFn func(error)) {
 	d.MapVersion++
 }
 
+// applyEventUpdate is responsible for appending the event log entry
+// to the database's event log.
+func (d *dbData) applyEventUpdate(data []byte, panicFn func(error)) {
+	ele := new(EventLogEntry)
+	if err := json.Unmarshal(data, ele); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode event log update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.Events.addEntry(ele)
+}
+
 // Snapshot is called to support log compaction, so that we don't have to keep
 // every log entry from the start of the system. Instead, the raft service periodically
 // creates a point-in-time snapshot which can be used to restore the current state, or
@@ -407,6 +456,9 @@ func (f *fsm) Restore(rc io.ReadCloser) error {
 
 	f.data.Members = db.data.Members
 	f.data.Pools = db.data.Pools
+	f.data.Events = db.data.Events
+	f.data.Policy = db.data.Policy
+	f.data.Quotas = db.data.Quotas
 	f.data.NextRank = db.data.NextRank
 	f.data.MapVersion = db.data.MapVersion
 	f.log.Debugf("db snapshot loaded (map version %d)", db.data.MapVersion)
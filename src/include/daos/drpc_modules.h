@@ -22,6 +22,7 @@ enum drpc_module {
 	DRPC_MODULE_MGMT		= 2,	/* daos_server mgmt */
 	DRPC_MODULE_SRV			= 3,	/* daos_server */
 	DRPC_MODULE_SEC			= 4,	/* daos_server security */
+	DRPC_MODULE_AGENT_ADMIN		= 5,	/* daos_agent administration */
 
 	NUM_DRPC_MODULES			/* Must be last */
 };
@@ -69,6 +70,12 @@ enum drpc_mgmt_method {
 	DRPC_METHOD_MGMT_DEV_IDENTIFY		= 234,
 	DRPC_METHOD_MGMT_NOTIFY_POOL_CONNECT	= 235,
 	DRPC_METHOD_MGMT_NOTIFY_POOL_DISCONNECT	= 236,
+	DRPC_METHOD_MGMT_POOL_GET_PROP		= 237,
+	DRPC_METHOD_MGMT_CONT_QUERY		= 238,
+	DRPC_METHOD_MGMT_CONT_DESTROY		= 239,
+	DRPC_METHOD_MGMT_CONT_GET_PROP		= 240,
+	DRPC_METHOD_MGMT_CONT_SET_PROP		= 241,
+	DRPC_METHOD_MGMT_SET_LOG_MASKS		= 242,
 
 	NUM_DRPC_MGMT_METHODS			/* Must be last */
 };
@@ -88,4 +95,13 @@ enum drpc_sec_method {
 	NUM_DRPC_SEC_METHODS			/* Must be last */
 };
 
+enum drpc_agent_admin_method {
+	DRPC_METHOD_AGENT_ADMIN_CACHE_REFRESH	= 501,
+	DRPC_METHOD_AGENT_ADMIN_STATUS		= 502,
+	DRPC_METHOD_AGENT_ADMIN_HANDLES		= 503,
+	DRPC_METHOD_AGENT_ADMIN_CACHE_DUMP	= 504,
+
+	NUM_DRPC_AGENT_ADMIN_METHODS		/* Must be last */
+};
+
 #endif /* __DAOS_DRPC_MODULES_H__ */
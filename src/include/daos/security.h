@@ -20,6 +20,8 @@
  *
  * The DAOS agent must be alive and listening on the configured agent socket.
  *
+ * \param[in]	sys		DAOS system the credential is requested for,
+ *				or NULL for the agent's default system.
  * \param[out]	creds		Returned security credentials for current user.
  *
  * \return	0		Success. The security credential has
@@ -31,6 +33,6 @@
  *		-DER_NOREPLY	No response from agent
  *		-DER_MISC	Invalid response from agent
  */
-int dc_sec_request_creds(d_iov_t *creds);
+int dc_sec_request_creds(const char *sys, d_iov_t *creds);
 
 #endif /* __DAOS_SECURITY_INT_H__ */
@@ -36,6 +36,22 @@ void ds_cont_svc_step_down(struct cont_svc *svc);
 int ds_cont_svc_set_prop(uuid_t pool_uuid, uuid_t cont_uuid,
 			      d_rank_list_t *ranks, daos_prop_t *prop);
 
+/** Container usage returned by ds_cont_svc_query() */
+struct ds_cont_svc_info {
+	/** # of objects in all targets */
+	uint64_t	csi_nobjs;
+	/** Highest aggregated epoch */
+	daos_epoch_t	csi_hae;
+	/** # of open container handles */
+	uint32_t	csi_nhandles;
+};
+
+int ds_cont_svc_query(uuid_t pool_uuid, uuid_t cont_uuid,
+		      d_rank_list_t *ranks, struct ds_cont_svc_info *info,
+		      daos_prop_t **prop_out);
+int ds_cont_svc_destroy(uuid_t pool_uuid, uuid_t cont_uuid,
+			d_rank_list_t *ranks, bool force);
+
 int ds_cont_list(uuid_t pool_uuid, struct daos_pool_cont_info **conts,
 		 uint64_t *ncont);
 
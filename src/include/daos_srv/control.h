@@ -80,6 +80,9 @@ struct nvme_stats {
 	uint32_t	 bio_unmap_errs;
 	uint32_t	 checksum_errs;
 	uint16_t	 temperature; /* in Kelvin */
+	/* Remaining spare capacity, as a percentage */
+	uint8_t		 avail_spare;
+	uint8_t		 avail_spare_thresh;
 	/* Critical warnings */
 	bool		 temp_warn;
 	bool		 avail_spare_warn;
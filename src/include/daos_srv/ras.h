@@ -52,6 +52,14 @@
 	X(RAS_SWIM_RANK_DEAD,		"swim_rank_dead")		\
 	X(RAS_CONT_DF_INCOMPAT,						\
 	  "container_durable_format_incompatible")			\
+	X(RAS_POOL_AUTO_EXCLUDE,	"pool_auto_exclude")		\
+	X(RAS_POOL_AUTO_EXCLUDE_FAILED,					\
+	  "pool_auto_exclude_failed")					\
+	X(RAS_SYSTEM_MAP_UPDATE,	"system_map_updated")		\
+	X(RAS_NVME_HEALTH_WARNING,	"nvme_health_warning")		\
+	X(RAS_NVME_FAILURE_PREDICTED,					\
+	  "nvme_failure_predicted")					\
+	X(RAS_NVME_AUTO_FAULTY,		"nvme_auto_faulty")		\
 	X(RAS_RDB_DF_INCOMPAT,						\
 	  "rdb_durable_format_incompatible")
 
@@ -97,6 +97,411 @@ void   mgmt__cont_set_owner_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__cont_set_owner_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__cont_property__init
+                     (Mgmt__ContProperty         *message)
+{
+  static const Mgmt__ContProperty init_value = MGMT__CONT_PROPERTY__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_property__get_packed_size
+                     (const Mgmt__ContProperty *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_property__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_property__pack
+                     (const Mgmt__ContProperty *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_property__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_property__pack_to_buffer
+                     (const Mgmt__ContProperty *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_property__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContProperty *
+       mgmt__cont_property__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContProperty *)
+     protobuf_c_message_unpack (&mgmt__cont_property__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_property__free_unpacked
+                     (Mgmt__ContProperty *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_property__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_query_req__init
+                     (Mgmt__ContQueryReq         *message)
+{
+  static const Mgmt__ContQueryReq init_value = MGMT__CONT_QUERY_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_query_req__get_packed_size
+                     (const Mgmt__ContQueryReq *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_query_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_query_req__pack
+                     (const Mgmt__ContQueryReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_query_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_query_req__pack_to_buffer
+                     (const Mgmt__ContQueryReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_query_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContQueryReq *
+       mgmt__cont_query_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContQueryReq *)
+     protobuf_c_message_unpack (&mgmt__cont_query_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_query_req__free_unpacked
+                     (Mgmt__ContQueryReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_query_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_query_resp__init
+                     (Mgmt__ContQueryResp         *message)
+{
+  static const Mgmt__ContQueryResp init_value = MGMT__CONT_QUERY_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_query_resp__get_packed_size
+                     (const Mgmt__ContQueryResp *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_query_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_query_resp__pack
+                     (const Mgmt__ContQueryResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_query_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_query_resp__pack_to_buffer
+                     (const Mgmt__ContQueryResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_query_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContQueryResp *
+       mgmt__cont_query_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContQueryResp *)
+     protobuf_c_message_unpack (&mgmt__cont_query_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_query_resp__free_unpacked
+                     (Mgmt__ContQueryResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_query_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_destroy_req__init
+                     (Mgmt__ContDestroyReq         *message)
+{
+  static const Mgmt__ContDestroyReq init_value = MGMT__CONT_DESTROY_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_destroy_req__get_packed_size
+                     (const Mgmt__ContDestroyReq *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_destroy_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_destroy_req__pack
+                     (const Mgmt__ContDestroyReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_destroy_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_destroy_req__pack_to_buffer
+                     (const Mgmt__ContDestroyReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_destroy_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContDestroyReq *
+       mgmt__cont_destroy_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContDestroyReq *)
+     protobuf_c_message_unpack (&mgmt__cont_destroy_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_destroy_req__free_unpacked
+                     (Mgmt__ContDestroyReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_destroy_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_destroy_resp__init
+                     (Mgmt__ContDestroyResp         *message)
+{
+  static const Mgmt__ContDestroyResp init_value = MGMT__CONT_DESTROY_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_destroy_resp__get_packed_size
+                     (const Mgmt__ContDestroyResp *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_destroy_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_destroy_resp__pack
+                     (const Mgmt__ContDestroyResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_destroy_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_destroy_resp__pack_to_buffer
+                     (const Mgmt__ContDestroyResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_destroy_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContDestroyResp *
+       mgmt__cont_destroy_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContDestroyResp *)
+     protobuf_c_message_unpack (&mgmt__cont_destroy_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_destroy_resp__free_unpacked
+                     (Mgmt__ContDestroyResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_destroy_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_get_prop_req__init
+                     (Mgmt__ContGetPropReq         *message)
+{
+  static const Mgmt__ContGetPropReq init_value = MGMT__CONT_GET_PROP_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_get_prop_req__get_packed_size
+                     (const Mgmt__ContGetPropReq *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_get_prop_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_get_prop_req__pack
+                     (const Mgmt__ContGetPropReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_get_prop_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_get_prop_req__pack_to_buffer
+                     (const Mgmt__ContGetPropReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_get_prop_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContGetPropReq *
+       mgmt__cont_get_prop_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContGetPropReq *)
+     protobuf_c_message_unpack (&mgmt__cont_get_prop_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_get_prop_req__free_unpacked
+                     (Mgmt__ContGetPropReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_get_prop_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_get_prop_resp__init
+                     (Mgmt__ContGetPropResp         *message)
+{
+  static const Mgmt__ContGetPropResp init_value = MGMT__CONT_GET_PROP_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_get_prop_resp__get_packed_size
+                     (const Mgmt__ContGetPropResp *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_get_prop_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_get_prop_resp__pack
+                     (const Mgmt__ContGetPropResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_get_prop_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_get_prop_resp__pack_to_buffer
+                     (const Mgmt__ContGetPropResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_get_prop_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContGetPropResp *
+       mgmt__cont_get_prop_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContGetPropResp *)
+     protobuf_c_message_unpack (&mgmt__cont_get_prop_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_get_prop_resp__free_unpacked
+                     (Mgmt__ContGetPropResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_get_prop_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_set_prop_req__init
+                     (Mgmt__ContSetPropReq         *message)
+{
+  static const Mgmt__ContSetPropReq init_value = MGMT__CONT_SET_PROP_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_set_prop_req__get_packed_size
+                     (const Mgmt__ContSetPropReq *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_set_prop_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_set_prop_req__pack
+                     (const Mgmt__ContSetPropReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_set_prop_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_set_prop_req__pack_to_buffer
+                     (const Mgmt__ContSetPropReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_set_prop_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContSetPropReq *
+       mgmt__cont_set_prop_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContSetPropReq *)
+     protobuf_c_message_unpack (&mgmt__cont_set_prop_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_set_prop_req__free_unpacked
+                     (Mgmt__ContSetPropReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_set_prop_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_set_prop_resp__init
+                     (Mgmt__ContSetPropResp         *message)
+{
+  static const Mgmt__ContSetPropResp init_value = MGMT__CONT_SET_PROP_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_set_prop_resp__get_packed_size
+                     (const Mgmt__ContSetPropResp *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_set_prop_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_set_prop_resp__pack
+                     (const Mgmt__ContSetPropResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_set_prop_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_set_prop_resp__pack_to_buffer
+                     (const Mgmt__ContSetPropResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_set_prop_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContSetPropResp *
+       mgmt__cont_set_prop_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContSetPropResp *)
+     protobuf_c_message_unpack (&mgmt__cont_set_prop_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_set_prop_resp__free_unpacked
+                     (Mgmt__ContSetPropResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_set_prop_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 static const ProtobufCFieldDescriptor mgmt__cont_set_owner_req__field_descriptors[6] =
 {
   {
@@ -238,3 +643,670 @@ const ProtobufCMessageDescriptor mgmt__cont_set_owner_resp__descriptor =
   (ProtobufCMessageInit) mgmt__cont_set_owner_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__cont_property__field_descriptors[4] =
+{
+  {
+    "number",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContProperty, number),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "strval",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContProperty, strval),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "numval",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContProperty, numval),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "name",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContProperty, name),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_property__field_indices_by_name[] = {
+  3,   /* field[3] = name */
+  0,   /* field[0] = number */
+  2,   /* field[2] = numval */
+  1,   /* field[1] = strval */
+};
+static const ProtobufCIntRange mgmt__cont_property__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 4 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_property__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContProperty",
+  "ContProperty",
+  "Mgmt__ContProperty",
+  "mgmt",
+  sizeof(Mgmt__ContProperty),
+  4,
+  mgmt__cont_property__field_descriptors,
+  mgmt__cont_property__field_indices_by_name,
+  1,  mgmt__cont_property__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_property__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_query_req__field_descriptors[4] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "contUUID",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryReq, contuuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "poolUUID",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryReq, pooluuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    4,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__ContQueryReq, n_svc_ranks),
+    offsetof(Mgmt__ContQueryReq, svc_ranks),
+    NULL,
+    NULL,
+    0 | PROTOBUF_C_FIELD_FLAG_PACKED,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_query_req__field_indices_by_name[] = {
+  1,   /* field[1] = contUUID */
+  2,   /* field[2] = poolUUID */
+  3,   /* field[3] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__cont_query_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 4 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_query_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContQueryReq",
+  "ContQueryReq",
+  "Mgmt__ContQueryReq",
+  "mgmt",
+  sizeof(Mgmt__ContQueryReq),
+  4,
+  mgmt__cont_query_req__field_descriptors,
+  mgmt__cont_query_req__field_indices_by_name,
+  1,  mgmt__cont_query_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_query_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_query_resp__field_descriptors[6] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "label",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, label),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "owneruser",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, owneruser),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "ownergroup",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, ownergroup),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "num_handles",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, num_handles),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "num_objects",
+    6,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, num_objects),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_query_resp__field_indices_by_name[] = {
+  1,   /* field[1] = label */
+  4,   /* field[4] = num_handles */
+  5,   /* field[5] = num_objects */
+  3,   /* field[3] = ownergroup */
+  2,   /* field[2] = owneruser */
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange mgmt__cont_query_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 6 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_query_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContQueryResp",
+  "ContQueryResp",
+  "Mgmt__ContQueryResp",
+  "mgmt",
+  sizeof(Mgmt__ContQueryResp),
+  6,
+  mgmt__cont_query_resp__field_descriptors,
+  mgmt__cont_query_resp__field_indices_by_name,
+  1,  mgmt__cont_query_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_query_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_destroy_req__field_descriptors[5] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContDestroyReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "contUUID",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContDestroyReq, contuuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "poolUUID",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContDestroyReq, pooluuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "force",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BOOL,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContDestroyReq, force),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    5,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__ContDestroyReq, n_svc_ranks),
+    offsetof(Mgmt__ContDestroyReq, svc_ranks),
+    NULL,
+    NULL,
+    0 | PROTOBUF_C_FIELD_FLAG_PACKED,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_destroy_req__field_indices_by_name[] = {
+  1,   /* field[1] = contUUID */
+  3,   /* field[3] = force */
+  2,   /* field[2] = poolUUID */
+  4,   /* field[4] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__cont_destroy_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 5 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_destroy_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContDestroyReq",
+  "ContDestroyReq",
+  "Mgmt__ContDestroyReq",
+  "mgmt",
+  sizeof(Mgmt__ContDestroyReq),
+  5,
+  mgmt__cont_destroy_req__field_descriptors,
+  mgmt__cont_destroy_req__field_indices_by_name,
+  1,  mgmt__cont_destroy_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_destroy_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_destroy_resp__field_descriptors[1] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContDestroyResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_destroy_resp__field_indices_by_name[] = {
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange mgmt__cont_destroy_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 1 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_destroy_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContDestroyResp",
+  "ContDestroyResp",
+  "Mgmt__ContDestroyResp",
+  "mgmt",
+  sizeof(Mgmt__ContDestroyResp),
+  1,
+  mgmt__cont_destroy_resp__field_descriptors,
+  mgmt__cont_destroy_resp__field_indices_by_name,
+  1,  mgmt__cont_destroy_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_destroy_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_get_prop_req__field_descriptors[6] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContGetPropReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "contUUID",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContGetPropReq, contuuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "poolUUID",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContGetPropReq, pooluuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "names",
+    4,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_STRING,
+    offsetof(Mgmt__ContGetPropReq, n_names),
+    offsetof(Mgmt__ContGetPropReq, names),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "numbers",
+    5,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__ContGetPropReq, n_numbers),
+    offsetof(Mgmt__ContGetPropReq, numbers),
+    NULL,
+    NULL,
+    0 | PROTOBUF_C_FIELD_FLAG_PACKED,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    6,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__ContGetPropReq, n_svc_ranks),
+    offsetof(Mgmt__ContGetPropReq, svc_ranks),
+    NULL,
+    NULL,
+    0 | PROTOBUF_C_FIELD_FLAG_PACKED,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_get_prop_req__field_indices_by_name[] = {
+  1,   /* field[1] = contUUID */
+  3,   /* field[3] = names */
+  4,   /* field[4] = numbers */
+  2,   /* field[2] = poolUUID */
+  5,   /* field[5] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__cont_get_prop_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 6 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_get_prop_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContGetPropReq",
+  "ContGetPropReq",
+  "Mgmt__ContGetPropReq",
+  "mgmt",
+  sizeof(Mgmt__ContGetPropReq),
+  6,
+  mgmt__cont_get_prop_req__field_descriptors,
+  mgmt__cont_get_prop_req__field_indices_by_name,
+  1,  mgmt__cont_get_prop_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_get_prop_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_get_prop_resp__field_descriptors[2] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContGetPropResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "properties",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Mgmt__ContGetPropResp, n_properties),
+    offsetof(Mgmt__ContGetPropResp, properties),
+    &mgmt__cont_property__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_get_prop_resp__field_indices_by_name[] = {
+  1,   /* field[1] = properties */
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange mgmt__cont_get_prop_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_get_prop_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContGetPropResp",
+  "ContGetPropResp",
+  "Mgmt__ContGetPropResp",
+  "mgmt",
+  sizeof(Mgmt__ContGetPropResp),
+  2,
+  mgmt__cont_get_prop_resp__field_descriptors,
+  mgmt__cont_get_prop_resp__field_indices_by_name,
+  1,  mgmt__cont_get_prop_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_get_prop_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_set_prop_req__field_descriptors[5] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContSetPropReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "contUUID",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContSetPropReq, contuuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "poolUUID",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContSetPropReq, pooluuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "properties",
+    4,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Mgmt__ContSetPropReq, n_properties),
+    offsetof(Mgmt__ContSetPropReq, properties),
+    &mgmt__cont_property__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    5,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__ContSetPropReq, n_svc_ranks),
+    offsetof(Mgmt__ContSetPropReq, svc_ranks),
+    NULL,
+    NULL,
+    0 | PROTOBUF_C_FIELD_FLAG_PACKED,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_set_prop_req__field_indices_by_name[] = {
+  1,   /* field[1] = contUUID */
+  2,   /* field[2] = poolUUID */
+  3,   /* field[3] = properties */
+  4,   /* field[4] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__cont_set_prop_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 5 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_set_prop_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContSetPropReq",
+  "ContSetPropReq",
+  "Mgmt__ContSetPropReq",
+  "mgmt",
+  sizeof(Mgmt__ContSetPropReq),
+  5,
+  mgmt__cont_set_prop_req__field_descriptors,
+  mgmt__cont_set_prop_req__field_indices_by_name,
+  1,  mgmt__cont_set_prop_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_set_prop_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_set_prop_resp__field_descriptors[1] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContSetPropResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_set_prop_resp__field_indices_by_name[] = {
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange mgmt__cont_set_prop_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 1 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_set_prop_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContSetPropResp",
+  "ContSetPropResp",
+  "Mgmt__ContSetPropResp",
+  "mgmt",
+  sizeof(Mgmt__ContSetPropResp),
+  1,
+  mgmt__cont_set_prop_resp__field_descriptors,
+  mgmt__cont_set_prop_resp__field_indices_by_name,
+  1,  mgmt__cont_set_prop_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_set_prop_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
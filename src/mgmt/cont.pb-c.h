@@ -17,6 +17,15 @@ PROTOBUF_C__BEGIN_DECLS
 
 typedef struct _Mgmt__ContSetOwnerReq Mgmt__ContSetOwnerReq;
 typedef struct _Mgmt__ContSetOwnerResp Mgmt__ContSetOwnerResp;
+typedef struct _Mgmt__ContProperty Mgmt__ContProperty;
+typedef struct _Mgmt__ContQueryReq Mgmt__ContQueryReq;
+typedef struct _Mgmt__ContQueryResp Mgmt__ContQueryResp;
+typedef struct _Mgmt__ContDestroyReq Mgmt__ContDestroyReq;
+typedef struct _Mgmt__ContDestroyResp Mgmt__ContDestroyResp;
+typedef struct _Mgmt__ContGetPropReq Mgmt__ContGetPropReq;
+typedef struct _Mgmt__ContGetPropResp Mgmt__ContGetPropResp;
+typedef struct _Mgmt__ContSetPropReq Mgmt__ContSetPropReq;
+typedef struct _Mgmt__ContSetPropResp Mgmt__ContSetPropResp;
 
 
 /* --- enums --- */
@@ -77,6 +86,258 @@ struct  _Mgmt__ContSetOwnerResp
     , 0 }
 
 
+/*
+ * ContProperty represents a container property and its value.
+ */
+struct  _Mgmt__ContProperty
+{
+  ProtobufCMessage base;
+  /*
+   * container property enum
+   */
+  uint32_t number;
+  /*
+   * container property string value
+   */
+  char *strval;
+  /*
+   * container property numeric value
+   */
+  uint64_t numval;
+  /*
+   * container property name (set by control plane)
+   */
+  char *name;
+};
+#define MGMT__CONT_PROPERTY__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_property__descriptor) \
+    , 0, (char *)protobuf_c_empty_string, 0, (char *)protobuf_c_empty_string }
+
+
+/*
+ * ContQueryReq represents a request to query a container without a handle.
+ */
+struct  _Mgmt__ContQueryReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * UUID of the container
+   */
+  char *contuuid;
+  /*
+   * UUID of the pool that the container is in
+   */
+  char *pooluuid;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+};
+#define MGMT__CONT_QUERY_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_query_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL }
+
+
+/*
+ * ContQueryResp returns container ownership and usage.
+ */
+struct  _Mgmt__ContQueryResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * container label
+   */
+  char *label;
+  /*
+   * formatted owner user
+   */
+  char *owneruser;
+  /*
+   * formatted owner group
+   */
+  char *ownergroup;
+  /*
+   * number of open container handles
+   */
+  uint32_t num_handles;
+  /*
+   * number of objects in all targets
+   */
+  uint64_t num_objects;
+};
+#define MGMT__CONT_QUERY_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_query_resp__descriptor) \
+    , 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0 }
+
+
+/*
+ * ContDestroyReq represents a request to destroy a container.
+ */
+struct  _Mgmt__ContDestroyReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * UUID of the container
+   */
+  char *contuuid;
+  /*
+   * UUID of the pool that the container is in
+   */
+  char *pooluuid;
+  /*
+   * evict any open handles
+   */
+  protobuf_c_boolean force;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+};
+#define MGMT__CONT_DESTROY_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_destroy_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0,NULL }
+
+
+/*
+ * ContDestroyResp returns the result of destroying a container.
+ */
+struct  _Mgmt__ContDestroyResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+};
+#define MGMT__CONT_DESTROY_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_destroy_resp__descriptor) \
+    , 0 }
+
+
+/*
+ * ContGetPropReq represents a request to get container properties.
+ */
+struct  _Mgmt__ContGetPropReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * UUID of the container
+   */
+  char *contuuid;
+  /*
+   * UUID of the pool that the container is in
+   */
+  char *pooluuid;
+  /*
+   * container property names (all if empty)
+   */
+  size_t n_names;
+  char **names;
+  /*
+   * container property enums (resolved from names)
+   */
+  size_t n_numbers;
+  uint32_t *numbers;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+};
+#define MGMT__CONT_GET_PROP_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_get_prop_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0,NULL }
+
+
+/*
+ * ContGetPropResp represents the result of getting container properties.
+ */
+struct  _Mgmt__ContGetPropResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * container properties
+   */
+  size_t n_properties;
+  Mgmt__ContProperty **properties;
+};
+#define MGMT__CONT_GET_PROP_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_get_prop_resp__descriptor) \
+    , 0, 0,NULL }
+
+
+/*
+ * ContSetPropReq represents a request to set container properties.
+ */
+struct  _Mgmt__ContSetPropReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * UUID of the container
+   */
+  char *contuuid;
+  /*
+   * UUID of the pool that the container is in
+   */
+  char *pooluuid;
+  /*
+   * container properties to set
+   */
+  size_t n_properties;
+  Mgmt__ContProperty **properties;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+};
+#define MGMT__CONT_SET_PROP_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_set_prop_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL }
+
+
+/*
+ * ContSetPropResp represents the result of setting container properties.
+ */
+struct  _Mgmt__ContSetPropResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+};
+#define MGMT__CONT_SET_PROP_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_set_prop_resp__descriptor) \
+    , 0 }
+
+
 /* Mgmt__ContSetOwnerReq methods */
 void   mgmt__cont_set_owner_req__init
                      (Mgmt__ContSetOwnerReq         *message);
@@ -115,6 +376,177 @@ Mgmt__ContSetOwnerResp *
 void   mgmt__cont_set_owner_resp__free_unpacked
                      (Mgmt__ContSetOwnerResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__ContProperty methods */
+void   mgmt__cont_property__init
+                     (Mgmt__ContProperty         *message);
+size_t mgmt__cont_property__get_packed_size
+                     (const Mgmt__ContProperty   *message);
+size_t mgmt__cont_property__pack
+                     (const Mgmt__ContProperty   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_property__pack_to_buffer
+                     (const Mgmt__ContProperty   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContProperty *
+       mgmt__cont_property__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_property__free_unpacked
+                     (Mgmt__ContProperty *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContQueryReq methods */
+void   mgmt__cont_query_req__init
+                     (Mgmt__ContQueryReq         *message);
+size_t mgmt__cont_query_req__get_packed_size
+                     (const Mgmt__ContQueryReq   *message);
+size_t mgmt__cont_query_req__pack
+                     (const Mgmt__ContQueryReq   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_query_req__pack_to_buffer
+                     (const Mgmt__ContQueryReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContQueryReq *
+       mgmt__cont_query_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_query_req__free_unpacked
+                     (Mgmt__ContQueryReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContQueryResp methods */
+void   mgmt__cont_query_resp__init
+                     (Mgmt__ContQueryResp         *message);
+size_t mgmt__cont_query_resp__get_packed_size
+                     (const Mgmt__ContQueryResp   *message);
+size_t mgmt__cont_query_resp__pack
+                     (const Mgmt__ContQueryResp   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_query_resp__pack_to_buffer
+                     (const Mgmt__ContQueryResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContQueryResp *
+       mgmt__cont_query_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_query_resp__free_unpacked
+                     (Mgmt__ContQueryResp *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContDestroyReq methods */
+void   mgmt__cont_destroy_req__init
+                     (Mgmt__ContDestroyReq         *message);
+size_t mgmt__cont_destroy_req__get_packed_size
+                     (const Mgmt__ContDestroyReq   *message);
+size_t mgmt__cont_destroy_req__pack
+                     (const Mgmt__ContDestroyReq   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_destroy_req__pack_to_buffer
+                     (const Mgmt__ContDestroyReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContDestroyReq *
+       mgmt__cont_destroy_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_destroy_req__free_unpacked
+                     (Mgmt__ContDestroyReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContDestroyResp methods */
+void   mgmt__cont_destroy_resp__init
+                     (Mgmt__ContDestroyResp         *message);
+size_t mgmt__cont_destroy_resp__get_packed_size
+                     (const Mgmt__ContDestroyResp   *message);
+size_t mgmt__cont_destroy_resp__pack
+                     (const Mgmt__ContDestroyResp   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_destroy_resp__pack_to_buffer
+                     (const Mgmt__ContDestroyResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContDestroyResp *
+       mgmt__cont_destroy_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_destroy_resp__free_unpacked
+                     (Mgmt__ContDestroyResp *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContGetPropReq methods */
+void   mgmt__cont_get_prop_req__init
+                     (Mgmt__ContGetPropReq         *message);
+size_t mgmt__cont_get_prop_req__get_packed_size
+                     (const Mgmt__ContGetPropReq   *message);
+size_t mgmt__cont_get_prop_req__pack
+                     (const Mgmt__ContGetPropReq   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_get_prop_req__pack_to_buffer
+                     (const Mgmt__ContGetPropReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContGetPropReq *
+       mgmt__cont_get_prop_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_get_prop_req__free_unpacked
+                     (Mgmt__ContGetPropReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContGetPropResp methods */
+void   mgmt__cont_get_prop_resp__init
+                     (Mgmt__ContGetPropResp         *message);
+size_t mgmt__cont_get_prop_resp__get_packed_size
+                     (const Mgmt__ContGetPropResp   *message);
+size_t mgmt__cont_get_prop_resp__pack
+                     (const Mgmt__ContGetPropResp   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_get_prop_resp__pack_to_buffer
+                     (const Mgmt__ContGetPropResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContGetPropResp *
+       mgmt__cont_get_prop_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_get_prop_resp__free_unpacked
+                     (Mgmt__ContGetPropResp *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContSetPropReq methods */
+void   mgmt__cont_set_prop_req__init
+                     (Mgmt__ContSetPropReq         *message);
+size_t mgmt__cont_set_prop_req__get_packed_size
+                     (const Mgmt__ContSetPropReq   *message);
+size_t mgmt__cont_set_prop_req__pack
+                     (const Mgmt__ContSetPropReq   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_set_prop_req__pack_to_buffer
+                     (const Mgmt__ContSetPropReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContSetPropReq *
+       mgmt__cont_set_prop_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_set_prop_req__free_unpacked
+                     (Mgmt__ContSetPropReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContSetPropResp methods */
+void   mgmt__cont_set_prop_resp__init
+                     (Mgmt__ContSetPropResp         *message);
+size_t mgmt__cont_set_prop_resp__get_packed_size
+                     (const Mgmt__ContSetPropResp   *message);
+size_t mgmt__cont_set_prop_resp__pack
+                     (const Mgmt__ContSetPropResp   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_set_prop_resp__pack_to_buffer
+                     (const Mgmt__ContSetPropResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContSetPropResp *
+       mgmt__cont_set_prop_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_set_prop_resp__free_unpacked
+                     (Mgmt__ContSetPropResp *message,
+                      ProtobufCAllocator *allocator);
 /* --- per-message closures --- */
 
 typedef void (*Mgmt__ContSetOwnerReq_Closure)
@@ -123,6 +555,33 @@ typedef void (*Mgmt__ContSetOwnerReq_Closure)
 typedef void (*Mgmt__ContSetOwnerResp_Closure)
                  (const Mgmt__ContSetOwnerResp *message,
                   void *closure_data);
+typedef void (*Mgmt__ContProperty_Closure)
+                 (const Mgmt__ContProperty *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContQueryReq_Closure)
+                 (const Mgmt__ContQueryReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContQueryResp_Closure)
+                 (const Mgmt__ContQueryResp *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContDestroyReq_Closure)
+                 (const Mgmt__ContDestroyReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContDestroyResp_Closure)
+                 (const Mgmt__ContDestroyResp *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContGetPropReq_Closure)
+                 (const Mgmt__ContGetPropReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContGetPropResp_Closure)
+                 (const Mgmt__ContGetPropResp *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContSetPropReq_Closure)
+                 (const Mgmt__ContSetPropReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContSetPropResp_Closure)
+                 (const Mgmt__ContSetPropResp *message,
+                  void *closure_data);
 
 /* --- services --- */
 
@@ -131,6 +590,15 @@ typedef void (*Mgmt__ContSetOwnerResp_Closure)
 
 extern const ProtobufCMessageDescriptor mgmt__cont_set_owner_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__cont_set_owner_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_property__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_query_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_query_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_destroy_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_destroy_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_get_prop_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_get_prop_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_set_prop_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_set_prop_resp__descriptor;
 
 PROTOBUF_C__END_DECLS
 
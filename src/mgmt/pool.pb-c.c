@@ -1234,6 +1234,141 @@ void   mgmt__pool_set_prop_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__pool_set_prop_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__pool_property__init
+                     (Mgmt__PoolProperty         *message)
+{
+  static const Mgmt__PoolProperty init_value = MGMT__POOL_PROPERTY__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_property__get_packed_size
+                     (const Mgmt__PoolProperty *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_property__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_property__pack
+                     (const Mgmt__PoolProperty *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_property__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_property__pack_to_buffer
+                     (const Mgmt__PoolProperty *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_property__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolProperty *
+       mgmt__pool_property__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolProperty *)
+     protobuf_c_message_unpack (&mgmt__pool_property__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_property__free_unpacked
+                     (Mgmt__PoolProperty *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_property__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_get_prop_req__init
+                     (Mgmt__PoolGetPropReq         *message)
+{
+  static const Mgmt__PoolGetPropReq init_value = MGMT__POOL_GET_PROP_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_get_prop_req__get_packed_size
+                     (const Mgmt__PoolGetPropReq *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_get_prop_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_get_prop_req__pack
+                     (const Mgmt__PoolGetPropReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_get_prop_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_get_prop_req__pack_to_buffer
+                     (const Mgmt__PoolGetPropReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_get_prop_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolGetPropReq *
+       mgmt__pool_get_prop_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolGetPropReq *)
+     protobuf_c_message_unpack (&mgmt__pool_get_prop_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_get_prop_req__free_unpacked
+                     (Mgmt__PoolGetPropReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_get_prop_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_get_prop_resp__init
+                     (Mgmt__PoolGetPropResp         *message)
+{
+  static const Mgmt__PoolGetPropResp init_value = MGMT__POOL_GET_PROP_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_get_prop_resp__get_packed_size
+                     (const Mgmt__PoolGetPropResp *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_get_prop_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_get_prop_resp__pack
+                     (const Mgmt__PoolGetPropResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_get_prop_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_get_prop_resp__pack_to_buffer
+                     (const Mgmt__PoolGetPropResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_get_prop_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolGetPropResp *
+       mgmt__pool_get_prop_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolGetPropResp *)
+     protobuf_c_message_unpack (&mgmt__pool_get_prop_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_get_prop_resp__free_unpacked
+                     (Mgmt__PoolGetPropResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_get_prop_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 static const ProtobufCFieldDescriptor mgmt__fault_domain__field_descriptors[3] =
 {
   {
@@ -1298,7 +1433,7 @@ const ProtobufCMessageDescriptor mgmt__fault_domain__descriptor =
   (ProtobufCMessageInit) mgmt__fault_domain__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mgmt__pool_create_req__field_descriptors[14] =
+static const ProtobufCFieldDescriptor mgmt__pool_create_req__field_descriptors[15] =
 {
   {
     "uuid",
@@ -1468,9 +1603,22 @@ static const ProtobufCFieldDescriptor mgmt__pool_create_req__field_descriptors[1
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "dryrun",
+    15,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BOOL,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolCreateReq, dryrun),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__pool_create_req__field_indices_by_name[] = {
   5,   /* field[5] = acl */
+  14,   /* field[14] = dryrun */
   6,   /* field[6] = faultDomains */
   1,   /* field[1] = name */
   10,   /* field[10] = numranks */
@@ -1488,7 +1636,7 @@ static const unsigned mgmt__pool_create_req__field_indices_by_name[] = {
 static const ProtobufCIntRange mgmt__pool_create_req__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 14 }
+  { 0, 15 }
 };
 const ProtobufCMessageDescriptor mgmt__pool_create_req__descriptor =
 {
@@ -1498,7 +1646,7 @@ const ProtobufCMessageDescriptor mgmt__pool_create_req__descriptor =
   "Mgmt__PoolCreateReq",
   "mgmt",
   sizeof(Mgmt__PoolCreateReq),
-  14,
+  15,
   mgmt__pool_create_req__field_descriptors,
   mgmt__pool_create_req__field_indices_by_name,
   1,  mgmt__pool_create_req__number_ranges,
@@ -3380,3 +3528,221 @@ const ProtobufCMessageDescriptor mgmt__pool_set_prop_resp__descriptor =
   (ProtobufCMessageInit) mgmt__pool_set_prop_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__pool_property__field_descriptors[4] =
+{
+  {
+    "number",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolProperty, number),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "strval",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolProperty, strval),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "numval",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolProperty, numval),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "name",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolProperty, name),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_property__field_indices_by_name[] = {
+  3,   /* field[3] = name */
+  0,   /* field[0] = number */
+  2,   /* field[2] = numval */
+  1,   /* field[1] = strval */
+};
+static const ProtobufCIntRange mgmt__pool_property__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 4 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_property__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolProperty",
+  "PoolProperty",
+  "Mgmt__PoolProperty",
+  "mgmt",
+  sizeof(Mgmt__PoolProperty),
+  4,
+  mgmt__pool_property__field_descriptors,
+  mgmt__pool_property__field_indices_by_name,
+  1,  mgmt__pool_property__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_property__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_get_prop_req__field_descriptors[5] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolGetPropReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "uuid",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolGetPropReq, uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "names",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_STRING,
+    offsetof(Mgmt__PoolGetPropReq, n_names),
+    offsetof(Mgmt__PoolGetPropReq, names),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "numbers",
+    4,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolGetPropReq, n_numbers),
+    offsetof(Mgmt__PoolGetPropReq, numbers),
+    NULL,
+    NULL,
+    0 | PROTOBUF_C_FIELD_FLAG_PACKED,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    5,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolGetPropReq, n_svc_ranks),
+    offsetof(Mgmt__PoolGetPropReq, svc_ranks),
+    NULL,
+    NULL,
+    0 | PROTOBUF_C_FIELD_FLAG_PACKED,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_get_prop_req__field_indices_by_name[] = {
+  2,   /* field[2] = names */
+  3,   /* field[3] = numbers */
+  4,   /* field[4] = svc_ranks */
+  0,   /* field[0] = sys */
+  1,   /* field[1] = uuid */
+};
+static const ProtobufCIntRange mgmt__pool_get_prop_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 5 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_get_prop_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolGetPropReq",
+  "PoolGetPropReq",
+  "Mgmt__PoolGetPropReq",
+  "mgmt",
+  sizeof(Mgmt__PoolGetPropReq),
+  5,
+  mgmt__pool_get_prop_req__field_descriptors,
+  mgmt__pool_get_prop_req__field_indices_by_name,
+  1,  mgmt__pool_get_prop_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_get_prop_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_get_prop_resp__field_descriptors[2] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolGetPropResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "properties",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Mgmt__PoolGetPropResp, n_properties),
+    offsetof(Mgmt__PoolGetPropResp, properties),
+    &mgmt__pool_property__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_get_prop_resp__field_indices_by_name[] = {
+  1,   /* field[1] = properties */
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange mgmt__pool_get_prop_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_get_prop_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolGetPropResp",
+  "PoolGetPropResp",
+  "Mgmt__PoolGetPropResp",
+  "mgmt",
+  sizeof(Mgmt__PoolGetPropResp),
+  2,
+  mgmt__pool_get_prop_resp__field_descriptors,
+  mgmt__pool_get_prop_resp__field_indices_by_name,
+  1,  mgmt__pool_get_prop_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_get_prop_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
@@ -44,6 +44,9 @@ typedef struct _Mgmt__PoolRebuildStatus Mgmt__PoolRebuildStatus;
 typedef struct _Mgmt__PoolQueryResp Mgmt__PoolQueryResp;
 typedef struct _Mgmt__PoolSetPropReq Mgmt__PoolSetPropReq;
 typedef struct _Mgmt__PoolSetPropResp Mgmt__PoolSetPropResp;
+typedef struct _Mgmt__PoolProperty Mgmt__PoolProperty;
+typedef struct _Mgmt__PoolGetPropReq Mgmt__PoolGetPropReq;
+typedef struct _Mgmt__PoolGetPropResp Mgmt__PoolGetPropResp;
 
 
 /* --- enums --- */
@@ -148,10 +151,14 @@ struct  _Mgmt__PoolCreateReq
    * NVMe size in bytes (manual config)
    */
   uint64_t nvmebytes;
+  /*
+   * Plan pool placement without creating the pool
+   */
+  protobuf_c_boolean dryrun;
 };
 #define MGMT__POOL_CREATE_REQ__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_create_req__descriptor) \
-    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0, 0, 0, 0, 0,NULL, 0, 0 }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0, 0, 0, 0, 0,NULL, 0, 0, 0 }
 
 
 /*
@@ -865,6 +872,90 @@ struct  _Mgmt__PoolSetPropResp
     , 0, MGMT__POOL_SET_PROP_RESP__PROPERTY__NOT_SET, {0}, MGMT__POOL_SET_PROP_RESP__VALUE__NOT_SET, {0} }
 
 
+/*
+ * PoolProperty represents a pool property and its value.
+ */
+struct  _Mgmt__PoolProperty
+{
+  ProtobufCMessage base;
+  /*
+   * pool property enum
+   */
+  uint32_t number;
+  /*
+   * pool property string value
+   */
+  char *strval;
+  /*
+   * pool property numeric value
+   */
+  uint64_t numval;
+  /*
+   * pool property name (set by control plane)
+   */
+  char *name;
+};
+#define MGMT__POOL_PROPERTY__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_property__descriptor) \
+    , 0, (char *)protobuf_c_empty_string, 0, (char *)protobuf_c_empty_string }
+
+
+/*
+ * PoolGetPropReq represents a request to get pool properties.
+ */
+struct  _Mgmt__PoolGetPropReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * uuid of pool to query
+   */
+  char *uuid;
+  /*
+   * pool property names (all if empty)
+   */
+  size_t n_names;
+  char **names;
+  /*
+   * pool property enums (resolved from names)
+   */
+  size_t n_numbers;
+  uint32_t *numbers;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+};
+#define MGMT__POOL_GET_PROP_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_get_prop_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0,NULL }
+
+
+/*
+ * PoolGetPropResp represents the result of getting pool properties.
+ */
+struct  _Mgmt__PoolGetPropResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * pool properties
+   */
+  size_t n_properties;
+  Mgmt__PoolProperty **properties;
+};
+#define MGMT__POOL_GET_PROP_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_get_prop_resp__descriptor) \
+    , 0, 0,NULL }
+
+
 /* Mgmt__FaultDomain methods */
 void   mgmt__fault_domain__init
                      (Mgmt__FaultDomain         *message);
@@ -1384,6 +1475,63 @@ Mgmt__PoolSetPropResp *
 void   mgmt__pool_set_prop_resp__free_unpacked
                      (Mgmt__PoolSetPropResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__PoolProperty methods */
+void   mgmt__pool_property__init
+                     (Mgmt__PoolProperty         *message);
+size_t mgmt__pool_property__get_packed_size
+                     (const Mgmt__PoolProperty   *message);
+size_t mgmt__pool_property__pack
+                     (const Mgmt__PoolProperty   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_property__pack_to_buffer
+                     (const Mgmt__PoolProperty   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolProperty *
+       mgmt__pool_property__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_property__free_unpacked
+                     (Mgmt__PoolProperty *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolGetPropReq methods */
+void   mgmt__pool_get_prop_req__init
+                     (Mgmt__PoolGetPropReq         *message);
+size_t mgmt__pool_get_prop_req__get_packed_size
+                     (const Mgmt__PoolGetPropReq   *message);
+size_t mgmt__pool_get_prop_req__pack
+                     (const Mgmt__PoolGetPropReq   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_get_prop_req__pack_to_buffer
+                     (const Mgmt__PoolGetPropReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolGetPropReq *
+       mgmt__pool_get_prop_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_get_prop_req__free_unpacked
+                     (Mgmt__PoolGetPropReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolGetPropResp methods */
+void   mgmt__pool_get_prop_resp__init
+                     (Mgmt__PoolGetPropResp         *message);
+size_t mgmt__pool_get_prop_resp__get_packed_size
+                     (const Mgmt__PoolGetPropResp   *message);
+size_t mgmt__pool_get_prop_resp__pack
+                     (const Mgmt__PoolGetPropResp   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_get_prop_resp__pack_to_buffer
+                     (const Mgmt__PoolGetPropResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolGetPropResp *
+       mgmt__pool_get_prop_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_get_prop_resp__free_unpacked
+                     (Mgmt__PoolGetPropResp *message,
+                      ProtobufCAllocator *allocator);
 /* --- per-message closures --- */
 
 typedef void (*Mgmt__FaultDomain_Closure)
@@ -1473,6 +1621,15 @@ typedef void (*Mgmt__PoolSetPropReq_Closure)
 typedef void (*Mgmt__PoolSetPropResp_Closure)
                  (const Mgmt__PoolSetPropResp *message,
                   void *closure_data);
+typedef void (*Mgmt__PoolProperty_Closure)
+                 (const Mgmt__PoolProperty *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolGetPropReq_Closure)
+                 (const Mgmt__PoolGetPropReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolGetPropResp_Closure)
+                 (const Mgmt__PoolGetPropResp *message,
+                  void *closure_data);
 
 /* --- services --- */
 
@@ -1509,6 +1666,9 @@ extern const ProtobufCEnumDescriptor    mgmt__pool_rebuild_status__state__descri
 extern const ProtobufCMessageDescriptor mgmt__pool_query_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_set_prop_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_set_prop_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_property__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_get_prop_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_get_prop_resp__descriptor;
 
 PROTOBUF_C__END_DECLS
 
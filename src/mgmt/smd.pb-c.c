@@ -718,7 +718,7 @@ const ProtobufCMessageDescriptor ctl__bio_health_req__descriptor =
   (ProtobufCMessageInit) ctl__bio_health_req__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor ctl__bio_health_resp__field_descriptors[23] =
+static const ProtobufCFieldDescriptor ctl__bio_health_resp__field_descriptors[25] =
 {
   {
     "timestamp",
@@ -996,9 +996,35 @@ static const ProtobufCFieldDescriptor ctl__bio_health_resp__field_descriptors[23
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "avail_spare",
+    27,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BioHealthResp, avail_spare),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "avail_spare_thresh",
+    28,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BioHealthResp, avail_spare_thresh),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned ctl__bio_health_resp__field_indices_by_name[] = {
   22,   /* field[22] = avail_bytes */
+  23,   /* field[23] = avail_spare */
+  24,   /* field[24] = avail_spare_thresh */
   15,   /* field[15] = avail_spare_warn */
   9,   /* field[9] = bio_read_errs */
   11,   /* field[11] = bio_unmap_errs */
@@ -1026,7 +1052,7 @@ static const ProtobufCIntRange ctl__bio_health_resp__number_ranges[2 + 1] =
 {
   { 3, 0 },
   { 5, 1 },
-  { 0, 23 }
+  { 0, 25 }
 };
 const ProtobufCMessageDescriptor ctl__bio_health_resp__descriptor =
 {
@@ -1036,7 +1062,7 @@ const ProtobufCMessageDescriptor ctl__bio_health_resp__descriptor =
   "Ctl__BioHealthResp",
   "ctl",
   sizeof(Ctl__BioHealthResp),
-  23,
+  25,
   ctl__bio_health_resp__field_descriptors,
   ctl__bio_health_resp__field_indices_by_name,
   2,  ctl__bio_health_resp__number_ranges,
@@ -1654,7 +1680,7 @@ const ProtobufCMessageDescriptor ctl__dev_identify_resp__descriptor =
   (ProtobufCMessageInit) ctl__dev_identify_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor ctl__smd_query_req__field_descriptors[10] =
+static const ProtobufCFieldDescriptor ctl__smd_query_req__field_descriptors[12] =
 {
   {
     "omitDevices",
@@ -1776,10 +1802,36 @@ static const ProtobufCFieldDescriptor ctl__smd_query_req__field_descriptors[10]
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "includeHistory",
+    11,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BOOL,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__SmdQueryReq, includehistory),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "autoReplace",
+    12,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BOOL,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__SmdQueryReq, autoreplace),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned ctl__smd_query_req__field_indices_by_name[] = {
+  11,   /* field[11] = autoReplace */
   9,   /* field[9] = identify */
   2,   /* field[2] = includeBioHealth */
+  10,   /* field[10] = includeHistory */
   8,   /* field[8] = noReint */
   0,   /* field[0] = omitDevices */
   1,   /* field[1] = omitPools */
@@ -1792,7 +1844,7 @@ static const unsigned ctl__smd_query_req__field_indices_by_name[] = {
 static const ProtobufCIntRange ctl__smd_query_req__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 10 }
+  { 0, 12 }
 };
 const ProtobufCMessageDescriptor ctl__smd_query_req__descriptor =
 {
@@ -1802,14 +1854,14 @@ const ProtobufCMessageDescriptor ctl__smd_query_req__descriptor =
   "Ctl__SmdQueryReq",
   "ctl",
   sizeof(Ctl__SmdQueryReq),
-  10,
+  12,
   ctl__smd_query_req__field_descriptors,
   ctl__smd_query_req__field_indices_by_name,
   1,  ctl__smd_query_req__number_ranges,
   (ProtobufCMessageInit) ctl__smd_query_req__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor ctl__smd_query_resp__device__field_descriptors[5] =
+static const ProtobufCFieldDescriptor ctl__smd_query_resp__device__field_descriptors[6] =
 {
   {
     "uuid",
@@ -1871,9 +1923,22 @@ static const ProtobufCFieldDescriptor ctl__smd_query_resp__device__field_descrip
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "history",
+    6,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Ctl__SmdQueryResp__Device, n_history),
+    offsetof(Ctl__SmdQueryResp__Device, history),
+    &ctl__bio_health_resp__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned ctl__smd_query_resp__device__field_indices_by_name[] = {
   4,   /* field[4] = health */
+  5,   /* field[5] = history */
   2,   /* field[2] = state */
   1,   /* field[1] = tgt_ids */
   3,   /* field[3] = tr_addr */
@@ -1882,7 +1947,7 @@ static const unsigned ctl__smd_query_resp__device__field_indices_by_name[] = {
 static const ProtobufCIntRange ctl__smd_query_resp__device__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 5 }
+  { 0, 6 }
 };
 const ProtobufCMessageDescriptor ctl__smd_query_resp__device__descriptor =
 {
@@ -1892,7 +1957,7 @@ const ProtobufCMessageDescriptor ctl__smd_query_resp__device__descriptor =
   "Ctl__SmdQueryResp__Device",
   "ctl",
   sizeof(Ctl__SmdQueryResp__Device),
-  5,
+  6,
   ctl__smd_query_resp__device__field_descriptors,
   ctl__smd_query_resp__device__field_indices_by_name,
   1,  ctl__smd_query_resp__device__number_ranges,
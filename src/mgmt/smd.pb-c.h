@@ -111,10 +111,18 @@ struct  _Ctl__BioHealthResp
    * free space in blobstore
    */
   uint64_t avail_bytes;
+  /*
+   * remaining spare capacity (%)
+   */
+  uint32_t avail_spare;
+  /*
+   * spare capacity warning threshold (%)
+   */
+  uint32_t avail_spare_thresh;
 };
 #define CTL__BIO_HEALTH_RESP__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&ctl__bio_health_resp__descriptor) \
-    , 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, (char *)protobuf_c_empty_string, 0, 0 }
+    , 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, (char *)protobuf_c_empty_string, 0, 0, 0, 0 }
 
 
 struct  _Ctl__SmdDevReq
@@ -361,10 +369,18 @@ struct  _Ctl__SmdQueryReq
    * set the VMD LED state to quickly blink
    */
   protobuf_c_boolean identify;
+  /*
+   * query should include BIO health history for devices
+   */
+  protobuf_c_boolean includehistory;
+  /*
+   * detect the new device to replace storage with
+   */
+  protobuf_c_boolean autoreplace;
 };
 #define CTL__SMD_QUERY_REQ__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&ctl__smd_query_req__descriptor) \
-    , 0, 0, 0, 0, (char *)protobuf_c_empty_string, 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0 }
+    , 0, 0, 0, 0, (char *)protobuf_c_empty_string, 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0, 0, 0 }
 
 
 struct  _Ctl__SmdQueryResp__Device
@@ -391,10 +407,15 @@ struct  _Ctl__SmdQueryResp__Device
    * optional BIO health
    */
   Ctl__BioHealthResp *health;
+  /*
+   * optional BIO health history, oldest first
+   */
+  size_t n_history;
+  Ctl__BioHealthResp **history;
 };
 #define CTL__SMD_QUERY_RESP__DEVICE__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&ctl__smd_query_resp__device__descriptor) \
-    , (char *)protobuf_c_empty_string, 0,NULL, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, NULL }
+    , (char *)protobuf_c_empty_string, 0,NULL, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, NULL, 0,NULL }
 
 
 struct  _Ctl__SmdQueryResp__Pool
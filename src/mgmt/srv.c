@@ -134,12 +134,30 @@ process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	case DRPC_METHOD_MGMT_POOL_SET_PROP:
 		ds_mgmt_drpc_pool_set_prop(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_POOL_GET_PROP:
+		ds_mgmt_drpc_pool_get_prop(drpc_req, drpc_resp);
+		break;
 	case DRPC_METHOD_MGMT_POOL_QUERY:
 		ds_mgmt_drpc_pool_query(drpc_req, drpc_resp);
 		break;
 	case DRPC_METHOD_MGMT_CONT_SET_OWNER:
 		ds_mgmt_drpc_cont_set_owner(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_CONT_QUERY:
+		ds_mgmt_drpc_cont_query(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_CONT_DESTROY:
+		ds_mgmt_drpc_cont_destroy(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_CONT_GET_PROP:
+		ds_mgmt_drpc_cont_get_prop(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_CONT_SET_PROP:
+		ds_mgmt_drpc_cont_set_prop(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_SET_LOG_MASKS:
+		ds_mgmt_drpc_set_log_masks(drpc_req, drpc_resp);
+		break;
 	case DRPC_METHOD_MGMT_GROUP_UPDATE:
 		ds_mgmt_drpc_group_update(drpc_req, drpc_resp);
 		break;
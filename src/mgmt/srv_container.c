@@ -13,12 +13,15 @@
 
 #include "srv_internal.h"
 
-static int
-cont_set_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
-	      uuid_t cont_uuid, daos_prop_t *prop)
+int
+ds_mgmt_cont_set_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+		      uuid_t cont_uuid, daos_prop_t *prop)
 {
 	int		rc = 0;
 
+	D_DEBUG(DB_MGMT, "Setting properties for container "DF_UUID" in pool "
+		DF_UUID"\n", DP_UUID(cont_uuid), DP_UUID(pool_uuid));
+
 	rc = ds_cont_svc_set_prop(pool_uuid, cont_uuid, svc_ranks, prop);
 	if (rc != 0)
 		goto out;
@@ -71,8 +74,71 @@ ds_mgmt_cont_set_owner(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
 		i++;
 	}
 
-	rc = cont_set_prop(pool_uuid, svc_ranks, cont_uuid, prop);
+	rc = ds_mgmt_cont_set_prop(pool_uuid, svc_ranks, cont_uuid, prop);
 out_prop:
 	daos_prop_free(prop);
 	return rc;
 }
+
+int
+ds_mgmt_cont_query(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+		   uuid_t cont_uuid, struct ds_cont_svc_info *info,
+		   daos_prop_t **prop)
+{
+	D_DEBUG(DB_MGMT, "Querying container "DF_UUID" in pool "DF_UUID"\n",
+		DP_UUID(cont_uuid), DP_UUID(pool_uuid));
+
+	return ds_cont_svc_query(pool_uuid, cont_uuid, svc_ranks, info, prop);
+}
+
+int
+ds_mgmt_cont_destroy(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+		     uuid_t cont_uuid, bool force)
+{
+	D_DEBUG(DB_MGMT, "Destroying container "DF_UUID" in pool "DF_UUID
+		", force=%d\n", DP_UUID(cont_uuid), DP_UUID(pool_uuid), force);
+
+	return ds_cont_svc_destroy(pool_uuid, cont_uuid, svc_ranks, force);
+}
+
+int
+ds_mgmt_cont_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+		      uuid_t cont_uuid, uint32_t *prop_types,
+		      size_t nr_types, daos_prop_t **result)
+{
+	daos_prop_t	*all_props = NULL;
+	daos_prop_t	*prop;
+	size_t		 i;
+	int		 rc;
+
+	if (prop_types == NULL || nr_types == 0) {
+		D_ERROR("no properties requested\n");
+		return -DER_INVAL;
+	}
+
+	D_DEBUG(DB_MGMT, "Getting properties for container "DF_UUID" in pool "
+		DF_UUID"\n", DP_UUID(cont_uuid), DP_UUID(pool_uuid));
+
+	rc = ds_cont_svc_query(pool_uuid, cont_uuid, svc_ranks, NULL,
+			       &all_props);
+	if (rc != 0)
+		return rc;
+
+	prop = daos_prop_alloc(nr_types);
+	if (prop == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	for (i = 0; i < nr_types; i++)
+		prop->dpp_entries[i].dpe_type = prop_types[i];
+
+	rc = daos_prop_copy(prop, all_props);
+	if (rc != 0) {
+		daos_prop_free(prop);
+		goto out;
+	}
+
+	*result = prop;
+out:
+	daos_prop_free(all_props);
+	return rc;
+}
@@ -125,6 +125,84 @@ ds_mgmt_drpc_set_rank(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	mgmt__set_rank_req__free_unpacked(req, &alloc.alloc);
 }
 
+/* Log masks in effect before the first runtime change, used for restore. */
+static char	*startup_log_masks;
+
+static int
+save_startup_log_masks(void)
+{
+	char	*masks;
+	int	 len;
+	int	 rc;
+
+	/* Probe for the length of the masks, including the terminating NUL */
+	len = d_log_getmasks(NULL, 0, 0, 0);
+	if (len <= 0)
+		return -DER_UNINIT;
+
+	D_ALLOC(masks, len);
+	if (masks == NULL)
+		return -DER_NOMEM;
+
+	/* Don't save a truncated copy if the masks grew since the probe */
+	rc = d_log_getmasks(masks, 0, len, 0);
+	if (rc <= 0 || masks[rc - 1] != '\0') {
+		D_FREE(masks);
+		return -DER_TRUNC;
+	}
+
+	startup_log_masks = masks;
+	return 0;
+}
+
+void
+ds_mgmt_drpc_set_log_masks(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc	 alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__SetLogMasksReq	*req = NULL;
+	Mgmt__DaosResp		 resp = MGMT__DAOS_RESP__INIT;
+	char			*masks;
+	int			 rc;
+
+	/* Unpack the inner request from the drpc call body */
+	req = mgmt__set_log_masks_req__unpack(&alloc.alloc,
+					      drpc_req->body.len,
+					      drpc_req->body.data);
+	if (alloc.oom || req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		D_ERROR("Failed to unpack req (set log masks)\n");
+		return;
+	}
+
+	if (startup_log_masks == NULL) {
+		rc = save_startup_log_masks();
+		if (rc != 0) {
+			D_ERROR("Failed to save startup log masks: "DF_RC"\n",
+				DP_RC(rc));
+			goto out;
+		}
+	}
+
+	masks = req->masks;
+	if (strlen(masks) == 0)
+		masks = startup_log_masks;
+
+	D_INFO("Received request to set log masks to %s\n", masks);
+
+	rc = d_log_setmasks(masks, -1);
+	if (rc < 0) {
+		D_ERROR("Failed to set log masks to %s\n", masks);
+		rc = -DER_INVAL;
+	} else {
+		rc = 0;
+	}
+
+out:
+	resp.status = rc;
+	pack_daos_response(&resp, drpc_resp);
+	mgmt__set_log_masks_req__free_unpacked(req, &alloc.alloc);
+}
+
 void
 ds_mgmt_drpc_group_update(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 {
@@ -905,6 +983,131 @@ out:
 	mgmt__pool_set_prop_req__free_unpacked(req, &alloc.alloc);
 }
 
+static void
+free_pool_props(Mgmt__PoolProperty **props, size_t n_props)
+{
+	size_t	i;
+
+	if (props == NULL)
+		return;
+
+	for (i = 0; i < n_props; i++) {
+		if (props[i] == NULL)
+			continue;
+		if (props[i]->strval != protobuf_c_empty_string)
+			D_FREE(props[i]->strval);
+		D_FREE(props[i]);
+	}
+	D_FREE(props);
+}
+
+void
+ds_mgmt_drpc_pool_get_prop(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc	alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__PoolGetPropReq	*req = NULL;
+	Mgmt__PoolGetPropResp	 resp = MGMT__POOL_GET_PROP_RESP__INIT;
+	daos_prop_t		*result = NULL;
+	struct daos_prop_entry	*entry;
+	uuid_t			 uuid;
+	d_rank_list_t		*svc_ranks = NULL;
+	uint8_t			*body;
+	size_t			 len;
+	size_t			 i;
+	int			 rc;
+
+	/* Unpack the inner request from the drpc call body */
+	req = mgmt__pool_get_prop_req__unpack(&alloc.alloc, drpc_req->body.len,
+					      drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		D_ERROR("Failed to unpack req (pool getprop)\n");
+		return;
+	}
+
+	rc = uuid_parse(req->uuid, uuid);
+	if (rc != 0) {
+		D_ERROR("Couldn't parse '%s' to UUID\n", req->uuid);
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	D_INFO(DF_UUID": received request to get pool properties\n",
+	       DP_UUID(uuid));
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_pool_get_prop(uuid, svc_ranks, req->numbers,
+				   req->n_numbers, &result);
+	if (rc != 0) {
+		D_ERROR("Failed to get pool properties on "DF_UUID": "DF_RC"\n",
+			DP_UUID(uuid), DP_RC(rc));
+		goto out_ranks;
+	}
+
+	D_ALLOC_ARRAY(resp.properties, result->dpp_nr);
+	if (resp.properties == NULL)
+		D_GOTO(out_result, rc = -DER_NOMEM);
+	resp.n_properties = result->dpp_nr;
+
+	for (i = 0; i < result->dpp_nr; i++) {
+		entry = &result->dpp_entries[i];
+
+		D_ALLOC_PTR(resp.properties[i]);
+		if (resp.properties[i] == NULL)
+			D_GOTO(out_result, rc = -DER_NOMEM);
+		mgmt__pool_property__init(resp.properties[i]);
+		resp.properties[i]->number = entry->dpe_type;
+
+		switch (entry->dpe_type) {
+		case DAOS_PROP_PO_LABEL:
+		case DAOS_PROP_PO_OWNER:
+		case DAOS_PROP_PO_OWNER_GROUP:
+			if (entry->dpe_str == NULL)
+				break;
+			D_STRNDUP(resp.properties[i]->strval, entry->dpe_str,
+				  DAOS_PROP_LABEL_MAX_LEN);
+			if (resp.properties[i]->strval == NULL)
+				D_GOTO(out_result, rc = -DER_NOMEM);
+			break;
+		case DAOS_PROP_PO_ACL:
+			D_ERROR("Pool property %d is not supported\n",
+				entry->dpe_type);
+			D_GOTO(out_result, rc = -DER_INVAL);
+		default:
+			resp.properties[i]->numval = entry->dpe_val;
+		}
+	}
+
+out_result:
+	daos_prop_free(result);
+out_ranks:
+	d_rank_list_free(svc_ranks);
+out:
+	if (rc != 0) {
+		free_pool_props(resp.properties, resp.n_properties);
+		resp.properties = NULL;
+		resp.n_properties = 0;
+	}
+
+	resp.status = rc;
+	len = mgmt__pool_get_prop_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+		D_ERROR("Failed to allocate drpc response body\n");
+	} else {
+		mgmt__pool_get_prop_resp__pack(&resp, body);
+		drpc_resp->body.len  = len;
+		drpc_resp->body.data = body;
+	}
+
+	free_pool_props(resp.properties, resp.n_properties);
+	mgmt__pool_get_prop_req__free_unpacked(req, &alloc.alloc);
+}
+
 static void
 free_ace_list(char **list, size_t len)
 {
@@ -1659,6 +1862,8 @@ ds_mgmt_drpc_bio_health_query(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	resp->volatile_mem_warn = stats.volatile_mem_warn;
 	resp->total_bytes = stats.total_bytes;
 	resp->avail_bytes = stats.avail_bytes;
+	resp->avail_spare = stats.avail_spare;
+	resp->avail_spare_thresh = stats.avail_spare_thresh;
 out:
 	resp->status = rc;
 	len = ctl__bio_health_resp__get_packed_size(resp);
@@ -2068,3 +2273,386 @@ out:
 
 	mgmt__cont_set_owner_req__free_unpacked(req, &alloc.alloc);
 }
+
+void
+ds_mgmt_drpc_cont_query(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc	 alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__ContQueryReq	*req = NULL;
+	Mgmt__ContQueryResp	 resp = MGMT__CONT_QUERY_RESP__INIT;
+	struct ds_cont_svc_info	 info = {0};
+	daos_prop_t		*prop = NULL;
+	struct daos_prop_entry	*entry;
+	uint8_t			*body;
+	size_t			 len;
+	uuid_t			 pool_uuid, cont_uuid;
+	d_rank_list_t		*svc_ranks = NULL;
+	int			 rc = 0;
+
+	req = mgmt__cont_query_req__unpack(&alloc.alloc, drpc_req->body.len,
+					   drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		D_ERROR("Failed to unpack req (cont query)\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		return;
+	}
+
+	D_INFO("Received request to query container\n");
+
+	if (uuid_parse(req->contuuid, cont_uuid) != 0) {
+		D_ERROR("Container UUID is invalid\n");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	if (uuid_parse(req->pooluuid, pool_uuid) != 0) {
+		D_ERROR("Pool UUID is invalid\n");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_cont_query(pool_uuid, svc_ranks, cont_uuid, &info, &prop);
+	if (rc != 0) {
+		D_ERROR("Failed to query container "DF_UUID": "DF_RC"\n",
+			DP_UUID(cont_uuid), DP_RC(rc));
+		goto out_ranks;
+	}
+
+	resp.num_handles = info.csi_nhandles;
+	resp.num_objects = info.csi_nobjs;
+
+	entry = daos_prop_entry_get(prop, DAOS_PROP_CO_LABEL);
+	if (entry != NULL && entry->dpe_str != NULL) {
+		D_STRNDUP(resp.label, entry->dpe_str, DAOS_PROP_LABEL_MAX_LEN);
+		if (resp.label == NULL)
+			D_GOTO(out_prop, rc = -DER_NOMEM);
+	}
+
+	entry = daos_prop_entry_get(prop, DAOS_PROP_CO_OWNER);
+	if (entry != NULL && entry->dpe_str != NULL) {
+		D_STRNDUP(resp.owneruser, entry->dpe_str,
+			  DAOS_ACL_MAX_PRINCIPAL_LEN);
+		if (resp.owneruser == NULL)
+			D_GOTO(out_prop, rc = -DER_NOMEM);
+	}
+
+	entry = daos_prop_entry_get(prop, DAOS_PROP_CO_OWNER_GROUP);
+	if (entry != NULL && entry->dpe_str != NULL) {
+		D_STRNDUP(resp.ownergroup, entry->dpe_str,
+			  DAOS_ACL_MAX_PRINCIPAL_LEN);
+		if (resp.ownergroup == NULL)
+			D_GOTO(out_prop, rc = -DER_NOMEM);
+	}
+
+out_prop:
+	daos_prop_free(prop);
+out_ranks:
+	d_rank_list_free(svc_ranks);
+out:
+	resp.status = rc;
+	len = mgmt__cont_query_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		D_ERROR("Failed to allocate response body\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__cont_query_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	if (resp.label != protobuf_c_empty_string)
+		D_FREE(resp.label);
+	if (resp.owneruser != protobuf_c_empty_string)
+		D_FREE(resp.owneruser);
+	if (resp.ownergroup != protobuf_c_empty_string)
+		D_FREE(resp.ownergroup);
+	mgmt__cont_query_req__free_unpacked(req, &alloc.alloc);
+}
+
+void
+ds_mgmt_drpc_cont_destroy(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc	alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__ContDestroyReq	*req = NULL;
+	Mgmt__ContDestroyResp	 resp = MGMT__CONT_DESTROY_RESP__INIT;
+	uint8_t			*body;
+	size_t			 len;
+	uuid_t			 pool_uuid, cont_uuid;
+	d_rank_list_t		*svc_ranks = NULL;
+	int			 rc = 0;
+
+	req = mgmt__cont_destroy_req__unpack(&alloc.alloc, drpc_req->body.len,
+					     drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		D_ERROR("Failed to unpack req (cont destroy)\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		return;
+	}
+
+	D_INFO("Received request to destroy container\n");
+
+	if (uuid_parse(req->contuuid, cont_uuid) != 0) {
+		D_ERROR("Container UUID is invalid\n");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	if (uuid_parse(req->pooluuid, pool_uuid) != 0) {
+		D_ERROR("Pool UUID is invalid\n");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_cont_destroy(pool_uuid, svc_ranks, cont_uuid, req->force);
+	if (rc != 0)
+		D_ERROR("Failed to destroy container "DF_UUID": "DF_RC"\n",
+			DP_UUID(cont_uuid), DP_RC(rc));
+
+	d_rank_list_free(svc_ranks);
+
+out:
+	resp.status = rc;
+	len = mgmt__cont_destroy_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		D_ERROR("Failed to allocate response body\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__cont_destroy_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	mgmt__cont_destroy_req__free_unpacked(req, &alloc.alloc);
+}
+
+static void
+free_cont_props(Mgmt__ContProperty **props, size_t n_props)
+{
+	size_t	i;
+
+	if (props == NULL)
+		return;
+
+	for (i = 0; i < n_props; i++) {
+		if (props[i] == NULL)
+			continue;
+		if (props[i]->strval != protobuf_c_empty_string)
+			D_FREE(props[i]->strval);
+		D_FREE(props[i]);
+	}
+	D_FREE(props);
+}
+
+void
+ds_mgmt_drpc_cont_get_prop(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc	alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__ContGetPropReq	*req = NULL;
+	Mgmt__ContGetPropResp	 resp = MGMT__CONT_GET_PROP_RESP__INIT;
+	daos_prop_t		*result = NULL;
+	struct daos_prop_entry	*entry;
+	uuid_t			 pool_uuid, cont_uuid;
+	d_rank_list_t		*svc_ranks = NULL;
+	uint8_t			*body;
+	size_t			 len;
+	size_t			 i;
+	int			 rc = 0;
+
+	req = mgmt__cont_get_prop_req__unpack(&alloc.alloc, drpc_req->body.len,
+					      drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		D_ERROR("Failed to unpack req (cont getprop)\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		return;
+	}
+
+	D_INFO("Received request to get container properties\n");
+
+	if (uuid_parse(req->contuuid, cont_uuid) != 0) {
+		D_ERROR("Container UUID is invalid\n");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	if (uuid_parse(req->pooluuid, pool_uuid) != 0) {
+		D_ERROR("Pool UUID is invalid\n");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_cont_get_prop(pool_uuid, svc_ranks, cont_uuid,
+				   req->numbers, req->n_numbers, &result);
+	if (rc != 0) {
+		D_ERROR("Failed to get container properties on "DF_UUID": "
+			DF_RC"\n", DP_UUID(cont_uuid), DP_RC(rc));
+		goto out_ranks;
+	}
+
+	D_ALLOC_ARRAY(resp.properties, result->dpp_nr);
+	if (resp.properties == NULL)
+		D_GOTO(out_result, rc = -DER_NOMEM);
+	resp.n_properties = result->dpp_nr;
+
+	for (i = 0; i < result->dpp_nr; i++) {
+		entry = &result->dpp_entries[i];
+
+		D_ALLOC_PTR(resp.properties[i]);
+		if (resp.properties[i] == NULL)
+			D_GOTO(out_result, rc = -DER_NOMEM);
+		mgmt__cont_property__init(resp.properties[i]);
+		resp.properties[i]->number = entry->dpe_type;
+
+		switch (entry->dpe_type) {
+		case DAOS_PROP_CO_LABEL:
+		case DAOS_PROP_CO_OWNER:
+		case DAOS_PROP_CO_OWNER_GROUP:
+			if (entry->dpe_str == NULL)
+				break;
+			D_STRNDUP(resp.properties[i]->strval, entry->dpe_str,
+				  DAOS_PROP_LABEL_MAX_LEN);
+			if (resp.properties[i]->strval == NULL)
+				D_GOTO(out_result, rc = -DER_NOMEM);
+			break;
+		case DAOS_PROP_CO_ACL:
+		case DAOS_PROP_CO_ROOTS:
+			D_ERROR("Container property %d is not supported\n",
+				entry->dpe_type);
+			D_GOTO(out_result, rc = -DER_INVAL);
+		default:
+			resp.properties[i]->numval = entry->dpe_val;
+		}
+	}
+
+out_result:
+	daos_prop_free(result);
+out_ranks:
+	d_rank_list_free(svc_ranks);
+out:
+	if (rc != 0) {
+		free_cont_props(resp.properties, resp.n_properties);
+		resp.properties = NULL;
+		resp.n_properties = 0;
+	}
+
+	resp.status = rc;
+	len = mgmt__cont_get_prop_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		D_ERROR("Failed to allocate response body\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__cont_get_prop_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	free_cont_props(resp.properties, resp.n_properties);
+	mgmt__cont_get_prop_req__free_unpacked(req, &alloc.alloc);
+}
+
+void
+ds_mgmt_drpc_cont_set_prop(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc	alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__ContSetPropReq	*req = NULL;
+	Mgmt__ContSetPropResp	 resp = MGMT__CONT_SET_PROP_RESP__INIT;
+	daos_prop_t		*prop = NULL;
+	struct daos_prop_entry	*entry;
+	uuid_t			 pool_uuid, cont_uuid;
+	d_rank_list_t		*svc_ranks = NULL;
+	uint8_t			*body;
+	size_t			 len;
+	size_t			 i;
+	int			 rc = 0;
+
+	req = mgmt__cont_set_prop_req__unpack(&alloc.alloc, drpc_req->body.len,
+					      drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		D_ERROR("Failed to unpack req (cont setprop)\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		return;
+	}
+
+	D_INFO("Received request to set container properties\n");
+
+	if (uuid_parse(req->contuuid, cont_uuid) != 0) {
+		D_ERROR("Container UUID is invalid\n");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	if (uuid_parse(req->pooluuid, pool_uuid) != 0) {
+		D_ERROR("Pool UUID is invalid\n");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	if (req->n_properties == 0) {
+		D_ERROR("No container properties to set\n");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	prop = daos_prop_alloc(req->n_properties);
+	if (prop == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	for (i = 0; i < req->n_properties; i++) {
+		entry = &prop->dpp_entries[i];
+		entry->dpe_type = req->properties[i]->number;
+
+		switch (entry->dpe_type) {
+		case DAOS_PROP_CO_LABEL:
+		case DAOS_PROP_CO_OWNER:
+		case DAOS_PROP_CO_OWNER_GROUP:
+			D_STRNDUP(entry->dpe_str, req->properties[i]->strval,
+				  DAOS_PROP_LABEL_MAX_LEN);
+			if (entry->dpe_str == NULL)
+				D_GOTO(out_prop, rc = -DER_NOMEM);
+			break;
+		case DAOS_PROP_CO_ACL:
+		case DAOS_PROP_CO_ROOTS:
+			D_ERROR("Container property %d is not supported\n",
+				entry->dpe_type);
+			D_GOTO(out_prop, rc = -DER_INVAL);
+		default:
+			entry->dpe_val = req->properties[i]->numval;
+		}
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out_prop, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_cont_set_prop(pool_uuid, svc_ranks, cont_uuid, prop);
+	if (rc != 0)
+		D_ERROR("Failed to set container properties on "DF_UUID": "
+			DF_RC"\n", DP_UUID(cont_uuid), DP_RC(rc));
+
+	d_rank_list_free(svc_ranks);
+out_prop:
+	daos_prop_free(prop);
+out:
+	resp.status = rc;
+	len = mgmt__cont_set_prop_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		D_ERROR("Failed to allocate response body\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__cont_set_prop_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	mgmt__cont_set_prop_req__free_unpacked(req, &alloc.alloc);
+}
@@ -17,6 +17,7 @@
 #include <daos/common.h>
 #include <daos/rpc.h>
 #include <daos/rsvc.h>
+#include <daos_srv/container.h>
 #include <daos_srv/daos_engine.h>
 #include <daos_srv/rdb.h>
 #include <daos_srv/rsvc.h>
@@ -82,6 +83,9 @@ int ds_mgmt_pool_extend(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
 			size_t scm_size, size_t nvme_size);
 int ds_mgmt_pool_set_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
 			  daos_prop_t *prop, daos_prop_t **result);
+int ds_mgmt_pool_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+			  uint32_t *types, size_t nr_types,
+			  daos_prop_t **result);
 int ds_mgmt_pool_get_acl(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
 			 daos_prop_t **access_prop);
 int ds_mgmt_pool_overwrite_acl(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
@@ -98,6 +102,16 @@ int ds_mgmt_pool_query(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
 int ds_mgmt_cont_set_owner(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
 			   uuid_t cont_uuid, const char *user,
 			   const char *group);
+int ds_mgmt_cont_query(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+		       uuid_t cont_uuid, struct ds_cont_svc_info *info,
+		       daos_prop_t **prop);
+int ds_mgmt_cont_destroy(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+			 uuid_t cont_uuid, bool force);
+int ds_mgmt_cont_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+			  uuid_t cont_uuid, uint32_t *prop_types,
+			  size_t nr_types, daos_prop_t **result);
+int ds_mgmt_cont_set_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+			  uuid_t cont_uuid, daos_prop_t *prop);
 
 /** srv_query.c */
 
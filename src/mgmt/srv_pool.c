@@ -662,3 +662,36 @@ ds_mgmt_pool_set_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
 out:
 	return rc;
 }
+
+int
+ds_mgmt_pool_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+		      uint32_t *types, size_t nr_types, daos_prop_t **result)
+{
+	int		 rc;
+	size_t		 i;
+	daos_prop_t	*prop;
+
+	if (types == NULL || nr_types == 0) {
+		D_ERROR("no properties requested\n");
+		return -DER_INVAL;
+	}
+
+	D_DEBUG(DB_MGMT, "Getting properties for pool "DF_UUID"\n",
+		DP_UUID(pool_uuid));
+
+	prop = daos_prop_alloc(nr_types);
+	if (prop == NULL)
+		return -DER_NOMEM;
+
+	for (i = 0; i < nr_types; i++)
+		prop->dpp_entries[i].dpe_type = types[i];
+
+	rc = ds_pool_svc_get_prop(pool_uuid, svc_ranks, prop);
+	if (rc != 0) {
+		daos_prop_free(prop);
+		return rc;
+	}
+
+	*result = prop;
+	return 0;
+}
@@ -604,6 +604,51 @@ void   mgmt__pool_monitor_req__free_unpacked
   assert(message->base.descriptor == &mgmt__pool_monitor_req__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__set_log_masks_req__init
+                     (Mgmt__SetLogMasksReq         *message)
+{
+  static const Mgmt__SetLogMasksReq init_value = MGMT__SET_LOG_MASKS_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__set_log_masks_req__get_packed_size
+                     (const Mgmt__SetLogMasksReq *message)
+{
+  assert(message->base.descriptor == &mgmt__set_log_masks_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__set_log_masks_req__pack
+                     (const Mgmt__SetLogMasksReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__set_log_masks_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__set_log_masks_req__pack_to_buffer
+                     (const Mgmt__SetLogMasksReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__set_log_masks_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__SetLogMasksReq *
+       mgmt__set_log_masks_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__SetLogMasksReq *)
+     protobuf_c_message_unpack (&mgmt__set_log_masks_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__set_log_masks_req__free_unpacked
+                     (Mgmt__SetLogMasksReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__set_log_masks_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 static const ProtobufCFieldDescriptor mgmt__daos_resp__field_descriptors[1] =
 {
   {
@@ -1220,7 +1265,7 @@ const ProtobufCMessageDescriptor mgmt__get_attach_info_resp__rank_uri__descripto
   (ProtobufCMessageInit) mgmt__get_attach_info_resp__rank_uri__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mgmt__get_attach_info_resp__field_descriptors[9] =
+static const ProtobufCFieldDescriptor mgmt__get_attach_info_resp__field_descriptors[11] =
 {
   {
     "status",
@@ -1330,13 +1375,39 @@ static const ProtobufCFieldDescriptor mgmt__get_attach_info_resp__field_descript
     0 | PROTOBUF_C_FIELD_FLAG_PACKED,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "ms_replicas",
+    10,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_STRING,
+    offsetof(Mgmt__GetAttachInfoResp, n_ms_replicas),
+    offsetof(Mgmt__GetAttachInfoResp, ms_replicas),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "map_version",
+    11,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__GetAttachInfoResp, map_version),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__get_attach_info_resp__field_indices_by_name[] = {
   5,   /* field[5] = crt_ctx_share_addr */
   6,   /* field[6] = crt_timeout */
   4,   /* field[4] = domain */
   3,   /* field[3] = interface */
+  10,   /* field[10] = map_version */
   8,   /* field[8] = ms_ranks */
+  9,   /* field[9] = ms_replicas */
   7,   /* field[7] = net_dev_class */
   2,   /* field[2] = provider */
   1,   /* field[1] = rank_uris */
@@ -1345,7 +1416,7 @@ static const unsigned mgmt__get_attach_info_resp__field_indices_by_name[] = {
 static const ProtobufCIntRange mgmt__get_attach_info_resp__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 9 }
+  { 0, 11 }
 };
 const ProtobufCMessageDescriptor mgmt__get_attach_info_resp__descriptor =
 {
@@ -1355,7 +1426,7 @@ const ProtobufCMessageDescriptor mgmt__get_attach_info_resp__descriptor =
   "Mgmt__GetAttachInfoResp",
   "mgmt",
   sizeof(Mgmt__GetAttachInfoResp),
-  9,
+  11,
   mgmt__get_attach_info_resp__field_descriptors,
   mgmt__get_attach_info_resp__field_indices_by_name,
   1,  mgmt__get_attach_info_resp__number_ranges,
@@ -1553,3 +1624,41 @@ const ProtobufCMessageDescriptor mgmt__pool_monitor_req__descriptor =
   (ProtobufCMessageInit) mgmt__pool_monitor_req__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__set_log_masks_req__field_descriptors[1] =
+{
+  {
+    "masks",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__SetLogMasksReq, masks),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__set_log_masks_req__field_indices_by_name[] = {
+  0,   /* field[0] = masks */
+};
+static const ProtobufCIntRange mgmt__set_log_masks_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 1 }
+};
+const ProtobufCMessageDescriptor mgmt__set_log_masks_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.SetLogMasksReq",
+  "SetLogMasksReq",
+  "Mgmt__SetLogMasksReq",
+  "mgmt",
+  sizeof(Mgmt__SetLogMasksReq),
+  1,
+  mgmt__set_log_masks_req__field_descriptors,
+  mgmt__set_log_masks_req__field_indices_by_name,
+  1,  mgmt__set_log_masks_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__set_log_masks_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
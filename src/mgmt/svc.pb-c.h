@@ -30,6 +30,7 @@ typedef struct _Mgmt__PrepShutdownReq Mgmt__PrepShutdownReq;
 typedef struct _Mgmt__PingRankReq Mgmt__PingRankReq;
 typedef struct _Mgmt__SetRankReq Mgmt__SetRankReq;
 typedef struct _Mgmt__PoolMonitorReq Mgmt__PoolMonitorReq;
+typedef struct _Mgmt__SetLogMasksReq Mgmt__SetLogMasksReq;
 
 
 /* --- enums --- */
@@ -268,10 +269,19 @@ struct  _Mgmt__GetAttachInfoResp
    */
   size_t n_ms_ranks;
   uint32_t *ms_ranks;
+  /*
+   * Control addresses of MS replicas
+   */
+  size_t n_ms_replicas;
+  char **ms_replicas;
+  /*
+   * System map version
+   */
+  uint32_t map_version;
 };
 #define MGMT__GET_ATTACH_INFO_RESP__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__get_attach_info_resp__descriptor) \
-    , 0, 0,NULL, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0, 0, 0,NULL }
+    , 0, 0,NULL, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0, 0, 0,NULL, 0,NULL, 0 }
 
 
 struct  _Mgmt__PrepShutdownReq
@@ -338,6 +348,19 @@ struct  _Mgmt__PoolMonitorReq
     , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string }
 
 
+struct  _Mgmt__SetLogMasksReq
+{
+  ProtobufCMessage base;
+  /*
+   * Log masks in D_LOG_MASK format; if empty, the masks in effect at startup are restored
+   */
+  char *masks;
+};
+#define MGMT__SET_LOG_MASKS_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__set_log_masks_req__descriptor) \
+    , (char *)protobuf_c_empty_string }
+
+
 /* Mgmt__DaosResp methods */
 void   mgmt__daos_resp__init
                      (Mgmt__DaosResp         *message);
@@ -591,6 +614,25 @@ Mgmt__PoolMonitorReq *
 void   mgmt__pool_monitor_req__free_unpacked
                      (Mgmt__PoolMonitorReq *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__SetLogMasksReq methods */
+void   mgmt__set_log_masks_req__init
+                     (Mgmt__SetLogMasksReq         *message);
+size_t mgmt__set_log_masks_req__get_packed_size
+                     (const Mgmt__SetLogMasksReq   *message);
+size_t mgmt__set_log_masks_req__pack
+                     (const Mgmt__SetLogMasksReq   *message,
+                      uint8_t             *out);
+size_t mgmt__set_log_masks_req__pack_to_buffer
+                     (const Mgmt__SetLogMasksReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__SetLogMasksReq *
+       mgmt__set_log_masks_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__set_log_masks_req__free_unpacked
+                     (Mgmt__SetLogMasksReq *message,
+                      ProtobufCAllocator *allocator);
 /* --- per-message closures --- */
 
 typedef void (*Mgmt__DaosResp_Closure)
@@ -638,6 +680,9 @@ typedef void (*Mgmt__SetRankReq_Closure)
 typedef void (*Mgmt__PoolMonitorReq_Closure)
                  (const Mgmt__PoolMonitorReq *message,
                   void *closure_data);
+typedef void (*Mgmt__SetLogMasksReq_Closure)
+                 (const Mgmt__SetLogMasksReq *message,
+                  void *closure_data);
 
 /* --- services --- */
 
@@ -660,6 +705,7 @@ extern const ProtobufCMessageDescriptor mgmt__prep_shutdown_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__ping_rank_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__set_rank_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_monitor_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__set_log_masks_req__descriptor;
 
 PROTOBUF_C__END_DECLS
 
@@ -200,6 +200,35 @@ mock_ds_mgmt_pool_set_prop_teardown(void)
 	daos_prop_free(ds_mgmt_pool_set_prop_prop);
 }
 
+int		ds_mgmt_pool_get_prop_return;
+daos_prop_t	*ds_mgmt_pool_get_prop_result;
+int
+ds_mgmt_pool_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+		      uint32_t *types, size_t nr_types, daos_prop_t **result)
+{
+	if (result != NULL && ds_mgmt_pool_get_prop_result != NULL) {
+		size_t len = ds_mgmt_pool_get_prop_result->dpp_nr;
+
+		*result = daos_prop_alloc(len);
+		daos_prop_copy(*result, ds_mgmt_pool_get_prop_result);
+	}
+
+	return ds_mgmt_pool_get_prop_return;
+}
+
+void
+mock_ds_mgmt_pool_get_prop_setup(void)
+{
+	ds_mgmt_pool_get_prop_return = 0;
+	ds_mgmt_pool_get_prop_result = NULL;
+}
+
+void
+mock_ds_mgmt_pool_get_prop_teardown(void)
+{
+	daos_prop_free(ds_mgmt_pool_get_prop_result);
+}
+
 /*
  * Mock ds_mgmt_pool_list_cont
  */
@@ -313,6 +342,128 @@ void mock_ds_mgmt_cont_set_owner_teardown(void)
 	D_FREE(ds_mgmt_cont_set_owner_group);
 }
 
+int			ds_mgmt_cont_query_return;
+uuid_t			ds_mgmt_cont_query_pool;
+uuid_t			ds_mgmt_cont_query_cont;
+struct ds_cont_svc_info	ds_mgmt_cont_query_info_out;
+daos_prop_t		*ds_mgmt_cont_query_prop_out;
+int
+ds_mgmt_cont_query(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+		   uuid_t cont_uuid, struct ds_cont_svc_info *info,
+		   daos_prop_t **prop)
+{
+	uuid_copy(ds_mgmt_cont_query_pool, pool_uuid);
+	uuid_copy(ds_mgmt_cont_query_cont, cont_uuid);
+	if (info != NULL)
+		*info = ds_mgmt_cont_query_info_out;
+	if (prop != NULL && ds_mgmt_cont_query_prop_out != NULL) {
+		size_t len = ds_mgmt_cont_query_prop_out->dpp_nr;
+
+		*prop = daos_prop_alloc(len);
+		daos_prop_copy(*prop, ds_mgmt_cont_query_prop_out);
+	}
+
+	return ds_mgmt_cont_query_return;
+}
+
+void
+mock_ds_mgmt_cont_query_setup(void)
+{
+	ds_mgmt_cont_query_return = 0;
+	uuid_clear(ds_mgmt_cont_query_pool);
+	uuid_clear(ds_mgmt_cont_query_cont);
+	memset(&ds_mgmt_cont_query_info_out, 0,
+	       sizeof(ds_mgmt_cont_query_info_out));
+	ds_mgmt_cont_query_prop_out = NULL;
+}
+
+void
+mock_ds_mgmt_cont_query_teardown(void)
+{
+	daos_prop_free(ds_mgmt_cont_query_prop_out);
+}
+
+int	ds_mgmt_cont_destroy_return;
+uuid_t	ds_mgmt_cont_destroy_pool;
+uuid_t	ds_mgmt_cont_destroy_cont;
+bool	ds_mgmt_cont_destroy_force;
+int
+ds_mgmt_cont_destroy(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+		     uuid_t cont_uuid, bool force)
+{
+	uuid_copy(ds_mgmt_cont_destroy_pool, pool_uuid);
+	uuid_copy(ds_mgmt_cont_destroy_cont, cont_uuid);
+	ds_mgmt_cont_destroy_force = force;
+
+	return ds_mgmt_cont_destroy_return;
+}
+
+void
+mock_ds_mgmt_cont_destroy_setup(void)
+{
+	ds_mgmt_cont_destroy_return = 0;
+	uuid_clear(ds_mgmt_cont_destroy_pool);
+	uuid_clear(ds_mgmt_cont_destroy_cont);
+	ds_mgmt_cont_destroy_force = false;
+}
+
+int		ds_mgmt_cont_get_prop_return;
+daos_prop_t	*ds_mgmt_cont_get_prop_result;
+int
+ds_mgmt_cont_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+		      uuid_t cont_uuid, uint32_t *prop_types,
+		      size_t nr_types, daos_prop_t **result)
+{
+	if (result != NULL && ds_mgmt_cont_get_prop_result != NULL) {
+		size_t len = ds_mgmt_cont_get_prop_result->dpp_nr;
+
+		*result = daos_prop_alloc(len);
+		daos_prop_copy(*result, ds_mgmt_cont_get_prop_result);
+	}
+
+	return ds_mgmt_cont_get_prop_return;
+}
+
+void
+mock_ds_mgmt_cont_get_prop_setup(void)
+{
+	ds_mgmt_cont_get_prop_return = 0;
+	ds_mgmt_cont_get_prop_result = NULL;
+}
+
+void
+mock_ds_mgmt_cont_get_prop_teardown(void)
+{
+	daos_prop_free(ds_mgmt_cont_get_prop_result);
+}
+
+int		ds_mgmt_cont_set_prop_return;
+daos_prop_t	*ds_mgmt_cont_set_prop_prop;
+int
+ds_mgmt_cont_set_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
+		      uuid_t cont_uuid, daos_prop_t *prop)
+{
+	if (prop != NULL) {
+		ds_mgmt_cont_set_prop_prop = daos_prop_alloc(prop->dpp_nr);
+		daos_prop_copy(ds_mgmt_cont_set_prop_prop, prop);
+	}
+
+	return ds_mgmt_cont_set_prop_return;
+}
+
+void
+mock_ds_mgmt_cont_set_prop_setup(void)
+{
+	ds_mgmt_cont_set_prop_return = 0;
+	ds_mgmt_cont_set_prop_prop = NULL;
+}
+
+void
+mock_ds_mgmt_cont_set_prop_teardown(void)
+{
+	daos_prop_free(ds_mgmt_cont_set_prop_prop);
+}
+
 int     ds_mgmt_target_update_return;
 uuid_t  ds_mgmt_target_update_uuid;
 int
@@ -14,6 +14,7 @@
 #include <gurt/types.h>
 #include <daos_types.h>
 #include <daos_security.h>
+#include <daos_srv/container.h>
 #include "../rpc.h"
 
 /*
@@ -83,6 +84,15 @@ extern daos_prop_t	*ds_mgmt_pool_set_prop_result;
 void mock_ds_mgmt_pool_set_prop_setup(void);
 void mock_ds_mgmt_pool_set_prop_teardown(void);
 
+/*
+ * Mock ds_mgmt_pool_get_prop
+ */
+extern int		ds_mgmt_pool_get_prop_return;
+extern daos_prop_t	*ds_mgmt_pool_get_prop_result;
+
+void mock_ds_mgmt_pool_get_prop_setup(void);
+void mock_ds_mgmt_pool_get_prop_teardown(void);
+
 /*
  * Mock ds_mgmt_pool_extend
  */
@@ -125,5 +135,41 @@ extern char	*ds_mgmt_cont_set_owner_group;
 void mock_ds_mgmt_cont_set_owner_setup(void);
 void mock_ds_mgmt_cont_set_owner_teardown(void);
 
+/*
+ * Mock ds_mgmt_cont_query
+ */
+extern int			ds_mgmt_cont_query_return;
+extern uuid_t			ds_mgmt_cont_query_pool;
+extern uuid_t			ds_mgmt_cont_query_cont;
+extern struct ds_cont_svc_info	ds_mgmt_cont_query_info_out;
+extern daos_prop_t		*ds_mgmt_cont_query_prop_out;
+void mock_ds_mgmt_cont_query_setup(void);
+void mock_ds_mgmt_cont_query_teardown(void);
+
+/*
+ * Mock ds_mgmt_cont_destroy
+ */
+extern int	ds_mgmt_cont_destroy_return;
+extern uuid_t	ds_mgmt_cont_destroy_pool;
+extern uuid_t	ds_mgmt_cont_destroy_cont;
+extern bool	ds_mgmt_cont_destroy_force;
+void mock_ds_mgmt_cont_destroy_setup(void);
+
+/*
+ * Mock ds_mgmt_cont_get_prop
+ */
+extern int		ds_mgmt_cont_get_prop_return;
+extern daos_prop_t	*ds_mgmt_cont_get_prop_result;
+void mock_ds_mgmt_cont_get_prop_setup(void);
+void mock_ds_mgmt_cont_get_prop_teardown(void);
+
+/*
+ * Mock ds_mgmt_cont_set_prop
+ */
+extern int		ds_mgmt_cont_set_prop_return;
+extern daos_prop_t	*ds_mgmt_cont_set_prop_prop;
+void mock_ds_mgmt_cont_set_prop_setup(void);
+void mock_ds_mgmt_cont_set_prop_teardown(void);
+
 
 #endif /* __MGMT_TESTS_MOCKS_H__ */
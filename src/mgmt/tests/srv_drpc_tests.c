@@ -107,7 +107,13 @@ test_mgmt_drpc_handlers_bad_call_payload(void **state)
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_bio_health_query);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_list_cont);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_set_prop);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_get_prop);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_cont_set_owner);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_cont_query);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_cont_destroy);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_cont_get_prop);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_cont_set_prop);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_set_log_masks);
 }
 
 static daos_prop_t *
@@ -947,6 +953,154 @@ test_drpc_pool_set_prop_success(void **state)
 	D_FREE(resp.body.data);
 }
 
+/*
+ * dRPC Pool GetProp setup/teardown
+ */
+
+static int
+drpc_pool_get_prop_setup(void **state)
+{
+	mock_ds_mgmt_pool_get_prop_setup();
+
+	return 0;
+}
+
+static int
+drpc_pool_get_prop_teardown(void **state)
+{
+	mock_ds_mgmt_pool_get_prop_teardown();
+
+	return 0;
+}
+
+/*
+ * dRPC Pool GetProp tests
+ */
+
+static void
+setup_pool_get_prop_drpc_call(Drpc__Call *call, Mgmt__PoolGetPropReq *req)
+{
+	size_t			len;
+	uint8_t			*body;
+
+	len = mgmt__pool_get_prop_req__get_packed_size(req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__pool_get_prop_req__pack(req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+expect_drpc_pool_get_prop_resp_with_error(Drpc__Response *resp,
+					  int expected_err)
+{
+	Mgmt__PoolGetPropResp *get_prop_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	get_prop_resp = mgmt__pool_get_prop_resp__unpack(NULL,
+			resp->body.len, resp->body.data);
+	assert_non_null(get_prop_resp);
+	assert_int_equal(get_prop_resp->status, expected_err);
+	assert_int_equal(get_prop_resp->n_properties, 0);
+
+	mgmt__pool_get_prop_resp__free_unpacked(get_prop_resp, NULL);
+}
+
+static void
+test_drpc_pool_get_prop_bad_uuid(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__PoolGetPropReq	req = MGMT__POOL_GET_PROP_REQ__INIT;
+	uint32_t		numbers[] = { DAOS_PROP_PO_LABEL };
+
+	req.uuid = "wow this won't work";
+	req.numbers = numbers;
+	req.n_numbers = ARRAY_SIZE(numbers);
+	setup_pool_get_prop_drpc_call(&call, &req);
+
+	ds_mgmt_drpc_pool_get_prop(&call, &resp);
+
+	expect_drpc_pool_get_prop_resp_with_error(&resp, -DER_INVAL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_get_prop_mgmt_svc_fails(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__PoolGetPropReq	req = MGMT__POOL_GET_PROP_REQ__INIT;
+	uint32_t		numbers[] = { DAOS_PROP_PO_LABEL };
+
+	req.uuid = TEST_UUID;
+	req.numbers = numbers;
+	req.n_numbers = ARRAY_SIZE(numbers);
+	setup_pool_get_prop_drpc_call(&call, &req);
+	ds_mgmt_pool_get_prop_return = -DER_UNKNOWN;
+
+	ds_mgmt_drpc_pool_get_prop(&call, &resp);
+
+	expect_drpc_pool_get_prop_resp_with_error(&resp, -DER_UNKNOWN);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_get_prop_success(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__PoolGetPropReq	req = MGMT__POOL_GET_PROP_REQ__INIT;
+	Mgmt__PoolGetPropResp	*get_prop_resp = NULL;
+	uint32_t		numbers[] = { DAOS_PROP_PO_LABEL,
+					      DAOS_PROP_PO_RECLAIM };
+	daos_prop_t		*exp_result;
+
+	req.uuid = TEST_UUID;
+	req.numbers = numbers;
+	req.n_numbers = ARRAY_SIZE(numbers);
+	setup_pool_get_prop_drpc_call(&call, &req);
+
+	exp_result = daos_prop_alloc(2);
+	exp_result->dpp_entries[0].dpe_type = DAOS_PROP_PO_LABEL;
+	D_STRNDUP(exp_result->dpp_entries[0].dpe_str, "mypool",
+		  DAOS_PROP_LABEL_MAX_LEN);
+	exp_result->dpp_entries[1].dpe_type = DAOS_PROP_PO_RECLAIM;
+	exp_result->dpp_entries[1].dpe_val = DAOS_RECLAIM_LAZY;
+	ds_mgmt_pool_get_prop_result = exp_result;
+
+	ds_mgmt_drpc_pool_get_prop(&call, &resp);
+
+	assert_int_equal(resp.status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp.body.data);
+
+	get_prop_resp = mgmt__pool_get_prop_resp__unpack(NULL, resp.body.len,
+							 resp.body.data);
+	assert_non_null(get_prop_resp);
+	assert_int_equal(get_prop_resp->status, 0);
+	assert_int_equal(get_prop_resp->n_properties, 2);
+	assert_int_equal(get_prop_resp->properties[0]->number,
+			 DAOS_PROP_PO_LABEL);
+	assert_string_equal(get_prop_resp->properties[0]->strval, "mypool");
+	assert_int_equal(get_prop_resp->properties[1]->number,
+			 DAOS_PROP_PO_RECLAIM);
+	assert_int_equal(get_prop_resp->properties[1]->numval,
+			 DAOS_RECLAIM_LAZY);
+
+	mgmt__pool_get_prop_resp__free_unpacked(get_prop_resp, NULL);
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*
  * Pool query test setup
  */
@@ -2054,6 +2208,477 @@ test_drpc_cont_set_owner_success(void **state)
 	D_FREE(resp.body.data);
 }
 
+/*
+ * dRPC container query/destroy/get-prop/set-prop setup/teardown
+ */
+
+static int
+drpc_cont_query_setup(void **state)
+{
+	mock_ds_mgmt_cont_query_setup();
+
+	return 0;
+}
+
+static int
+drpc_cont_query_teardown(void **state)
+{
+	mock_ds_mgmt_cont_query_teardown();
+
+	return 0;
+}
+
+static int
+drpc_cont_destroy_setup(void **state)
+{
+	mock_ds_mgmt_cont_destroy_setup();
+
+	return 0;
+}
+
+static int
+drpc_cont_prop_setup(void **state)
+{
+	mock_ds_mgmt_cont_get_prop_setup();
+	mock_ds_mgmt_cont_set_prop_setup();
+
+	return 0;
+}
+
+static int
+drpc_cont_prop_teardown(void **state)
+{
+	mock_ds_mgmt_cont_get_prop_teardown();
+	mock_ds_mgmt_cont_set_prop_teardown();
+
+	return 0;
+}
+
+/*
+ * dRPC container query tests
+ */
+
+static void
+setup_cont_query_drpc_call(Drpc__Call *call, Mgmt__ContQueryReq *req)
+{
+	size_t	len;
+	uint8_t	*body;
+
+	len = mgmt__cont_query_req__get_packed_size(req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__cont_query_req__pack(req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+expect_drpc_cont_query_resp_with_status(Drpc__Response *resp,
+					int expected_err)
+{
+	Mgmt__ContQueryResp *payload_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	payload_resp = mgmt__cont_query_resp__unpack(NULL, resp->body.len,
+						     resp->body.data);
+	assert_non_null(payload_resp);
+	assert_int_equal(payload_resp->status, expected_err);
+
+	mgmt__cont_query_resp__free_unpacked(payload_resp, NULL);
+}
+
+static void
+test_drpc_cont_query_bad_cont_uuid(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContQueryReq	req = MGMT__CONT_QUERY_REQ__INIT;
+
+	req.pooluuid = TEST_UUID;
+	req.contuuid = "this isn't really a uuid";
+	setup_cont_query_drpc_call(&call, &req);
+
+	ds_mgmt_drpc_cont_query(&call, &resp);
+
+	expect_drpc_cont_query_resp_with_status(&resp, -DER_INVAL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_query_mgmt_svc_fails(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContQueryReq	req = MGMT__CONT_QUERY_REQ__INIT;
+
+	req.pooluuid = TEST_UUID;
+	req.contuuid = "11111111-1111-1111-1111-111111111111";
+	setup_cont_query_drpc_call(&call, &req);
+	ds_mgmt_cont_query_return = -DER_NONEXIST;
+
+	ds_mgmt_drpc_cont_query(&call, &resp);
+
+	expect_drpc_cont_query_resp_with_status(&resp, -DER_NONEXIST);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_query_success(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContQueryReq	req = MGMT__CONT_QUERY_REQ__INIT;
+	Mgmt__ContQueryResp	*query_resp = NULL;
+	daos_prop_t		*prop;
+	uuid_t			cont_uuid;
+
+	req.pooluuid = TEST_UUID;
+	req.contuuid = "11111111-1111-1111-1111-111111111111";
+	setup_cont_query_drpc_call(&call, &req);
+
+	ds_mgmt_cont_query_info_out.csi_nhandles = 2;
+	ds_mgmt_cont_query_info_out.csi_nobjs = 42;
+	prop = daos_prop_alloc(3);
+	prop->dpp_entries[0].dpe_type = DAOS_PROP_CO_LABEL;
+	D_STRNDUP(prop->dpp_entries[0].dpe_str, "mycont",
+		  DAOS_PROP_LABEL_MAX_LEN);
+	prop->dpp_entries[1].dpe_type = DAOS_PROP_CO_OWNER;
+	D_STRNDUP(prop->dpp_entries[1].dpe_str, TEST_OWNER,
+		  DAOS_ACL_MAX_PRINCIPAL_LEN);
+	prop->dpp_entries[2].dpe_type = DAOS_PROP_CO_OWNER_GROUP;
+	D_STRNDUP(prop->dpp_entries[2].dpe_str, TEST_GROUP,
+		  DAOS_ACL_MAX_PRINCIPAL_LEN);
+	ds_mgmt_cont_query_prop_out = prop;
+
+	ds_mgmt_drpc_cont_query(&call, &resp);
+
+	assert_int_equal(resp.status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp.body.data);
+
+	query_resp = mgmt__cont_query_resp__unpack(NULL, resp.body.len,
+						   resp.body.data);
+	assert_non_null(query_resp);
+	assert_int_equal(query_resp->status, 0);
+	assert_string_equal(query_resp->label, "mycont");
+	assert_string_equal(query_resp->owneruser, TEST_OWNER);
+	assert_string_equal(query_resp->ownergroup, TEST_GROUP);
+	assert_int_equal(query_resp->num_handles, 2);
+	assert_int_equal(query_resp->num_objects, 42);
+
+	assert_int_equal(uuid_parse(req.contuuid, cont_uuid), 0);
+	assert_int_equal(uuid_compare(cont_uuid, ds_mgmt_cont_query_cont), 0);
+
+	mgmt__cont_query_resp__free_unpacked(query_resp, NULL);
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+/*
+ * dRPC container destroy tests
+ */
+
+static void
+setup_cont_destroy_drpc_call(Drpc__Call *call, Mgmt__ContDestroyReq *req)
+{
+	size_t	len;
+	uint8_t	*body;
+
+	len = mgmt__cont_destroy_req__get_packed_size(req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__cont_destroy_req__pack(req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+expect_drpc_cont_destroy_resp_with_status(Drpc__Response *resp,
+					  int expected_err)
+{
+	Mgmt__ContDestroyResp *payload_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	payload_resp = mgmt__cont_destroy_resp__unpack(NULL, resp->body.len,
+						       resp->body.data);
+	assert_non_null(payload_resp);
+	assert_int_equal(payload_resp->status, expected_err);
+
+	mgmt__cont_destroy_resp__free_unpacked(payload_resp, NULL);
+}
+
+static void
+test_drpc_cont_destroy_bad_pool_uuid(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContDestroyReq	req = MGMT__CONT_DESTROY_REQ__INIT;
+
+	req.pooluuid = "this isn't really a uuid";
+	req.contuuid = "11111111-1111-1111-1111-111111111111";
+	setup_cont_destroy_drpc_call(&call, &req);
+
+	ds_mgmt_drpc_cont_destroy(&call, &resp);
+
+	expect_drpc_cont_destroy_resp_with_status(&resp, -DER_INVAL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_destroy_busy(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContDestroyReq	req = MGMT__CONT_DESTROY_REQ__INIT;
+
+	req.pooluuid = TEST_UUID;
+	req.contuuid = "11111111-1111-1111-1111-111111111111";
+	setup_cont_destroy_drpc_call(&call, &req);
+	ds_mgmt_cont_destroy_return = -DER_BUSY;
+
+	ds_mgmt_drpc_cont_destroy(&call, &resp);
+
+	expect_drpc_cont_destroy_resp_with_status(&resp, -DER_BUSY);
+	assert_false(ds_mgmt_cont_destroy_force);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_destroy_force_success(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContDestroyReq	req = MGMT__CONT_DESTROY_REQ__INIT;
+	uuid_t			pool_uuid;
+	uuid_t			cont_uuid;
+
+	req.pooluuid = TEST_UUID;
+	req.contuuid = "11111111-1111-1111-1111-111111111111";
+	req.force = true;
+	setup_cont_destroy_drpc_call(&call, &req);
+
+	ds_mgmt_drpc_cont_destroy(&call, &resp);
+
+	expect_drpc_cont_destroy_resp_with_status(&resp, 0);
+
+	assert_int_equal(uuid_parse(req.pooluuid, pool_uuid), 0);
+	assert_int_equal(uuid_parse(req.contuuid, cont_uuid), 0);
+	assert_int_equal(uuid_compare(pool_uuid, ds_mgmt_cont_destroy_pool),
+			 0);
+	assert_int_equal(uuid_compare(cont_uuid, ds_mgmt_cont_destroy_cont),
+			 0);
+	assert_true(ds_mgmt_cont_destroy_force);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+/*
+ * dRPC container get-prop/set-prop tests
+ */
+
+static void
+setup_cont_get_prop_drpc_call(Drpc__Call *call, Mgmt__ContGetPropReq *req)
+{
+	size_t	len;
+	uint8_t	*body;
+
+	len = mgmt__cont_get_prop_req__get_packed_size(req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__cont_get_prop_req__pack(req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+test_drpc_cont_get_prop_mgmt_svc_fails(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContGetPropReq	req = MGMT__CONT_GET_PROP_REQ__INIT;
+	Mgmt__ContGetPropResp	*get_prop_resp = NULL;
+	uint32_t		numbers[] = { DAOS_PROP_CO_LABEL };
+
+	req.pooluuid = TEST_UUID;
+	req.contuuid = "11111111-1111-1111-1111-111111111111";
+	req.numbers = numbers;
+	req.n_numbers = ARRAY_SIZE(numbers);
+	setup_cont_get_prop_drpc_call(&call, &req);
+	ds_mgmt_cont_get_prop_return = -DER_UNKNOWN;
+
+	ds_mgmt_drpc_cont_get_prop(&call, &resp);
+
+	assert_int_equal(resp.status, DRPC__STATUS__SUCCESS);
+	get_prop_resp = mgmt__cont_get_prop_resp__unpack(NULL, resp.body.len,
+							 resp.body.data);
+	assert_non_null(get_prop_resp);
+	assert_int_equal(get_prop_resp->status, -DER_UNKNOWN);
+	assert_int_equal(get_prop_resp->n_properties, 0);
+
+	mgmt__cont_get_prop_resp__free_unpacked(get_prop_resp, NULL);
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_get_prop_success(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContGetPropReq	req = MGMT__CONT_GET_PROP_REQ__INIT;
+	Mgmt__ContGetPropResp	*get_prop_resp = NULL;
+	uint32_t		numbers[] = { DAOS_PROP_CO_LABEL,
+					      DAOS_PROP_CO_CSUM };
+	daos_prop_t		*exp_result;
+
+	req.pooluuid = TEST_UUID;
+	req.contuuid = "11111111-1111-1111-1111-111111111111";
+	req.numbers = numbers;
+	req.n_numbers = ARRAY_SIZE(numbers);
+	setup_cont_get_prop_drpc_call(&call, &req);
+
+	exp_result = daos_prop_alloc(2);
+	exp_result->dpp_entries[0].dpe_type = DAOS_PROP_CO_LABEL;
+	D_STRNDUP(exp_result->dpp_entries[0].dpe_str, "mycont",
+		  DAOS_PROP_LABEL_MAX_LEN);
+	exp_result->dpp_entries[1].dpe_type = DAOS_PROP_CO_CSUM;
+	exp_result->dpp_entries[1].dpe_val = DAOS_PROP_CO_CSUM_CRC32;
+	ds_mgmt_cont_get_prop_result = exp_result;
+
+	ds_mgmt_drpc_cont_get_prop(&call, &resp);
+
+	assert_int_equal(resp.status, DRPC__STATUS__SUCCESS);
+	get_prop_resp = mgmt__cont_get_prop_resp__unpack(NULL, resp.body.len,
+							 resp.body.data);
+	assert_non_null(get_prop_resp);
+	assert_int_equal(get_prop_resp->status, 0);
+	assert_int_equal(get_prop_resp->n_properties, 2);
+	assert_int_equal(get_prop_resp->properties[0]->number,
+			 DAOS_PROP_CO_LABEL);
+	assert_string_equal(get_prop_resp->properties[0]->strval, "mycont");
+	assert_int_equal(get_prop_resp->properties[1]->number,
+			 DAOS_PROP_CO_CSUM);
+	assert_int_equal(get_prop_resp->properties[1]->numval,
+			 DAOS_PROP_CO_CSUM_CRC32);
+
+	mgmt__cont_get_prop_resp__free_unpacked(get_prop_resp, NULL);
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+setup_cont_set_prop_drpc_call(Drpc__Call *call, Mgmt__ContSetPropReq *req)
+{
+	size_t	len;
+	uint8_t	*body;
+
+	len = mgmt__cont_set_prop_req__get_packed_size(req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__cont_set_prop_req__pack(req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+expect_drpc_cont_set_prop_resp_with_status(Drpc__Response *resp,
+					   int expected_err)
+{
+	Mgmt__ContSetPropResp *payload_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	payload_resp = mgmt__cont_set_prop_resp__unpack(NULL, resp->body.len,
+							resp->body.data);
+	assert_non_null(payload_resp);
+	assert_int_equal(payload_resp->status, expected_err);
+
+	mgmt__cont_set_prop_resp__free_unpacked(payload_resp, NULL);
+}
+
+static void
+test_drpc_cont_set_prop_no_props(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContSetPropReq	req = MGMT__CONT_SET_PROP_REQ__INIT;
+
+	req.pooluuid = TEST_UUID;
+	req.contuuid = "11111111-1111-1111-1111-111111111111";
+	setup_cont_set_prop_drpc_call(&call, &req);
+
+	ds_mgmt_drpc_cont_set_prop(&call, &resp);
+
+	expect_drpc_cont_set_prop_resp_with_status(&resp, -DER_INVAL);
+	assert_null(ds_mgmt_cont_set_prop_prop);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_set_prop_success(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContSetPropReq	req = MGMT__CONT_SET_PROP_REQ__INIT;
+	Mgmt__ContProperty	label = MGMT__CONT_PROPERTY__INIT;
+	Mgmt__ContProperty	status = MGMT__CONT_PROPERTY__INIT;
+	Mgmt__ContProperty	*props[] = { &label, &status };
+	struct daos_prop_entry	*entry;
+
+	label.number = DAOS_PROP_CO_LABEL;
+	label.strval = "newlabel";
+	status.number = DAOS_PROP_CO_STATUS;
+	status.numval = DAOS_PROP_CO_STATUS_VAL(DAOS_PROP_CO_HEALTHY, 0);
+
+	req.pooluuid = TEST_UUID;
+	req.contuuid = "11111111-1111-1111-1111-111111111111";
+	req.properties = props;
+	req.n_properties = ARRAY_SIZE(props);
+	setup_cont_set_prop_drpc_call(&call, &req);
+
+	ds_mgmt_drpc_cont_set_prop(&call, &resp);
+
+	expect_drpc_cont_set_prop_resp_with_status(&resp, 0);
+
+	assert_non_null(ds_mgmt_cont_set_prop_prop);
+	assert_int_equal(ds_mgmt_cont_set_prop_prop->dpp_nr, 2);
+	entry = daos_prop_entry_get(ds_mgmt_cont_set_prop_prop,
+				    DAOS_PROP_CO_LABEL);
+	assert_non_null(entry);
+	assert_string_equal(entry->dpe_str, "newlabel");
+	entry = daos_prop_entry_get(ds_mgmt_cont_set_prop_prop,
+				    DAOS_PROP_CO_STATUS);
+	assert_non_null(entry);
+	assert_int_equal(entry->dpe_val,
+			 DAOS_PROP_CO_STATUS_VAL(DAOS_PROP_CO_HEALTHY, 0));
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 #define ACL_TEST(x)	cmocka_unit_test_setup_teardown(x, \
 						drpc_pool_acl_setup, \
 						drpc_pool_acl_teardown)
@@ -2066,6 +2691,10 @@ test_drpc_cont_set_owner_success(void **state)
 						drpc_pool_set_prop_setup, \
 						drpc_pool_set_prop_teardown)
 
+#define POOL_GET_PROP_TEST(x) cmocka_unit_test_setup_teardown(x, \
+						drpc_pool_get_prop_setup, \
+						drpc_pool_get_prop_teardown)
+
 
 #define QUERY_TEST(x)	cmocka_unit_test_setup(x, \
 						drpc_pool_query_setup)
@@ -2095,6 +2724,17 @@ test_drpc_cont_set_owner_success(void **state)
 						drpc_cont_set_owner_setup, \
 						drpc_cont_set_owner_teardown)
 
+#define CONT_QUERY_TEST(x) cmocka_unit_test_setup_teardown(x, \
+						drpc_cont_query_setup, \
+						drpc_cont_query_teardown)
+
+#define CONT_DESTROY_TEST(x) cmocka_unit_test_setup(x, \
+						drpc_cont_destroy_setup)
+
+#define CONT_PROP_TEST(x) cmocka_unit_test_setup_teardown(x, \
+						drpc_cont_prop_setup, \
+						drpc_cont_prop_teardown)
+
 int
 main(void)
 {
@@ -2125,6 +2765,9 @@ main(void)
 			test_drpc_pool_set_prop_invalid_value_type),
 		POOL_SET_PROP_TEST(test_drpc_pool_set_prop_bad_uuid),
 		POOL_SET_PROP_TEST(test_drpc_pool_set_prop_success),
+		POOL_GET_PROP_TEST(test_drpc_pool_get_prop_bad_uuid),
+		POOL_GET_PROP_TEST(test_drpc_pool_get_prop_mgmt_svc_fails),
+		POOL_GET_PROP_TEST(test_drpc_pool_get_prop_success),
 		EXCLUDE_TEST(test_drpc_exclude_bad_uuid),
 		EXCLUDE_TEST(test_drpc_exclude_mgmt_svc_fails),
 		EXCLUDE_TEST(test_drpc_exclude_success),
@@ -2151,6 +2794,16 @@ main(void)
 		CONT_SET_OWNER_TEST(test_drpc_cont_set_owner_bad_pool_uuid),
 		CONT_SET_OWNER_TEST(test_drpc_cont_set_owner_failed),
 		CONT_SET_OWNER_TEST(test_drpc_cont_set_owner_success),
+		CONT_QUERY_TEST(test_drpc_cont_query_bad_cont_uuid),
+		CONT_QUERY_TEST(test_drpc_cont_query_mgmt_svc_fails),
+		CONT_QUERY_TEST(test_drpc_cont_query_success),
+		CONT_DESTROY_TEST(test_drpc_cont_destroy_bad_pool_uuid),
+		CONT_DESTROY_TEST(test_drpc_cont_destroy_busy),
+		CONT_DESTROY_TEST(test_drpc_cont_destroy_force_success),
+		CONT_PROP_TEST(test_drpc_cont_get_prop_mgmt_svc_fails),
+		CONT_PROP_TEST(test_drpc_cont_get_prop_success),
+		CONT_PROP_TEST(test_drpc_cont_set_prop_no_props),
+		CONT_PROP_TEST(test_drpc_cont_set_prop_success),
 	};
 
 	return cmocka_run_group_tests_name("mgmt_srv_drpc", tests, NULL, NULL);
@@ -522,7 +522,7 @@ dc_pool_connect(tse_task_t *task)
 	pci = crt_req_get(rpc);
 
 	/** request credentials */
-	rc = dc_sec_request_creds(&pci->pci_cred);
+	rc = dc_sec_request_creds(pool->dp_sys->sy_name, &pci->pci_cred);
 	if (rc != 0) {
 		D_ERROR("failed to obtain security credential: "DF_RC"\n",
 			DP_RC(rc));
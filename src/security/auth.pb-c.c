@@ -142,6 +142,51 @@ void   auth__credential__free_unpacked
   assert(message->base.descriptor == &auth__credential__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   auth__get_cred_req__init
+                     (Auth__GetCredReq         *message)
+{
+  static const Auth__GetCredReq init_value = AUTH__GET_CRED_REQ__INIT;
+  *message = init_value;
+}
+size_t auth__get_cred_req__get_packed_size
+                     (const Auth__GetCredReq *message)
+{
+  assert(message->base.descriptor == &auth__get_cred_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t auth__get_cred_req__pack
+                     (const Auth__GetCredReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &auth__get_cred_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t auth__get_cred_req__pack_to_buffer
+                     (const Auth__GetCredReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &auth__get_cred_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Auth__GetCredReq *
+       auth__get_cred_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Auth__GetCredReq *)
+     protobuf_c_message_unpack (&auth__get_cred_req__descriptor,
+                                allocator, len, data);
+}
+void   auth__get_cred_req__free_unpacked
+                     (Auth__GetCredReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &auth__get_cred_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 void   auth__get_cred_resp__init
                      (Auth__GetCredResp         *message)
 {
@@ -495,6 +540,44 @@ const ProtobufCMessageDescriptor auth__credential__descriptor =
   (ProtobufCMessageInit) auth__credential__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor auth__get_cred_req__field_descriptors[1] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Auth__GetCredReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned auth__get_cred_req__field_indices_by_name[] = {
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange auth__get_cred_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 1 }
+};
+const ProtobufCMessageDescriptor auth__get_cred_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "auth.GetCredReq",
+  "GetCredReq",
+  "Auth__GetCredReq",
+  "auth",
+  sizeof(Auth__GetCredReq),
+  1,
+  auth__get_cred_req__field_descriptors,
+  auth__get_cred_req__field_indices_by_name,
+  1,  auth__get_cred_req__number_ranges,
+  (ProtobufCMessageInit) auth__get_cred_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
 static const ProtobufCFieldDescriptor auth__get_cred_resp__field_descriptors[2] =
 {
   {
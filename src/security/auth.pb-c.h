@@ -18,6 +18,7 @@ PROTOBUF_C__BEGIN_DECLS
 typedef struct _Auth__Token Auth__Token;
 typedef struct _Auth__Sys Auth__Sys;
 typedef struct _Auth__Credential Auth__Credential;
+typedef struct _Auth__GetCredReq Auth__GetCredReq;
 typedef struct _Auth__GetCredResp Auth__GetCredResp;
 typedef struct _Auth__ValidateCredReq Auth__ValidateCredReq;
 typedef struct _Auth__ValidateCredResp Auth__ValidateCredResp;
@@ -114,6 +115,22 @@ struct  _Auth__Credential
     , NULL, NULL, (char *)protobuf_c_empty_string }
 
 
+/*
+ * GetCredReq represents a request to fetch authentication credentials.
+ */
+struct  _Auth__GetCredReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system the credential is requested for
+   */
+  char *sys;
+};
+#define AUTH__GET_CRED_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&auth__get_cred_req__descriptor) \
+    , (char *)protobuf_c_empty_string }
+
+
 /*
  * GetCredResp represents the result of a request to fetch authentication
  * credentials.
@@ -230,6 +247,25 @@ Auth__Credential *
 void   auth__credential__free_unpacked
                      (Auth__Credential *message,
                       ProtobufCAllocator *allocator);
+/* Auth__GetCredReq methods */
+void   auth__get_cred_req__init
+                     (Auth__GetCredReq         *message);
+size_t auth__get_cred_req__get_packed_size
+                     (const Auth__GetCredReq   *message);
+size_t auth__get_cred_req__pack
+                     (const Auth__GetCredReq   *message,
+                      uint8_t             *out);
+size_t auth__get_cred_req__pack_to_buffer
+                     (const Auth__GetCredReq   *message,
+                      ProtobufCBuffer     *buffer);
+Auth__GetCredReq *
+       auth__get_cred_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   auth__get_cred_req__free_unpacked
+                     (Auth__GetCredReq *message,
+                      ProtobufCAllocator *allocator);
 /* Auth__GetCredResp methods */
 void   auth__get_cred_resp__init
                      (Auth__GetCredResp         *message);
@@ -298,6 +334,9 @@ typedef void (*Auth__Sys_Closure)
 typedef void (*Auth__Credential_Closure)
                  (const Auth__Credential *message,
                   void *closure_data);
+typedef void (*Auth__GetCredReq_Closure)
+                 (const Auth__GetCredReq *message,
+                  void *closure_data);
 typedef void (*Auth__GetCredResp_Closure)
                  (const Auth__GetCredResp *message,
                   void *closure_data);
@@ -317,6 +356,7 @@ extern const ProtobufCEnumDescriptor    auth__flavor__descriptor;
 extern const ProtobufCMessageDescriptor auth__token__descriptor;
 extern const ProtobufCMessageDescriptor auth__sys__descriptor;
 extern const ProtobufCMessageDescriptor auth__credential__descriptor;
+extern const ProtobufCMessageDescriptor auth__get_cred_req__descriptor;
 extern const ProtobufCMessageDescriptor auth__get_cred_resp__descriptor;
 extern const ProtobufCMessageDescriptor auth__validate_cred_req__descriptor;
 extern const ProtobufCMessageDescriptor auth__validate_cred_resp__descriptor;
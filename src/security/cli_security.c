@@ -16,13 +16,14 @@
 #include "auth.pb-c.h"
 
 /* Prototypes for static helper functions */
-static int request_credentials_via_drpc(Drpc__Response **response);
+static int request_credentials_via_drpc(const char *sys,
+					Drpc__Response **response);
 static int process_credential_response(Drpc__Response *response,
 				       d_iov_t *creds);
 static int get_cred_from_response(Drpc__Response *response, d_iov_t *cred);
 
 int
-dc_sec_request_creds(d_iov_t *creds)
+dc_sec_request_creds(const char *sys, d_iov_t *creds)
 {
 	Drpc__Response	*response = NULL;
 	int		rc;
@@ -31,7 +32,7 @@ dc_sec_request_creds(d_iov_t *creds)
 		return -DER_INVAL;
 	}
 
-	rc = request_credentials_via_drpc(&response);
+	rc = request_credentials_via_drpc(sys, &response);
 	if (rc != DER_SUCCESS) {
 		return rc;
 	}
@@ -43,7 +44,30 @@ dc_sec_request_creds(d_iov_t *creds)
 }
 
 static int
-request_credentials_via_drpc(Drpc__Response **response)
+set_cred_request_body(Drpc__Call *request, const char *sys)
+{
+	Auth__GetCredReq	req = AUTH__GET_CRED_REQ__INIT;
+	uint8_t			*body;
+	size_t			len;
+
+	/* An empty request asks for the agent's default system */
+	if (sys == NULL)
+		return DER_SUCCESS;
+
+	req.sys = (char *)sys;
+	len = auth__get_cred_req__get_packed_size(&req);
+	D_ALLOC(body, len);
+	if (body == NULL)
+		return -DER_NOMEM;
+	auth__get_cred_req__pack(&req, body);
+	request->body.len = len;
+	request->body.data = body;
+
+	return DER_SUCCESS;
+}
+
+static int
+request_credentials_via_drpc(const char *sys, Drpc__Response **response)
 {
 	Drpc__Call	*request;
 	struct drpc	*agent_socket;
@@ -70,6 +94,13 @@ request_credentials_via_drpc(Drpc__Response **response)
 		return rc;
 	}
 
+	rc = set_cred_request_body(request, sys);
+	if (rc != DER_SUCCESS) {
+		drpc_close(agent_socket);
+		drpc_call_free(request);
+		return rc;
+	}
+
 	rc = drpc_call(agent_socket, R_SYNC, request, response);
 
 	drpc_close(agent_socket);
@@ -115,7 +115,7 @@ teardown_security_mocks(void **state)
 static void
 test_request_credentials_fails_with_null_creds(void **state)
 {
-	assert_int_equal(dc_sec_request_creds(NULL), -DER_INVAL);
+	assert_int_equal(dc_sec_request_creds(NULL, NULL), -DER_INVAL);
 }
 
 static void
@@ -125,7 +125,7 @@ test_request_credentials_succeeds_with_good_values(void **state)
 
 	memset(&creds, 0, sizeof(d_iov_t));
 
-	assert_int_equal(dc_sec_request_creds(&creds), DER_SUCCESS);
+	assert_int_equal(dc_sec_request_creds(NULL, &creds), DER_SUCCESS);
 
 	daos_iov_free(&creds);
 }
@@ -138,7 +138,7 @@ test_request_credentials_fails_if_drpc_connect_fails(void **state)
 	memset(&creds, 0, sizeof(d_iov_t));
 	free_drpc_connect_return(); /* drpc_connect returns NULL on failure */
 
-	assert_int_equal(dc_sec_request_creds(&creds), -DER_BADPATH);
+	assert_int_equal(dc_sec_request_creds(NULL, &creds), -DER_BADPATH);
 
 	daos_iov_free(&creds);
 }
@@ -150,7 +150,7 @@ test_request_credentials_connects_to_default_socket(void **state)
 
 	memset(&creds, 0, sizeof(d_iov_t));
 
-	dc_sec_request_creds(&creds);
+	dc_sec_request_creds(NULL, &creds);
 
 	assert_string_equal(drpc_connect_sockaddr,
 			DEFAULT_DAOS_AGENT_DRPC_SOCK);
@@ -166,7 +166,7 @@ test_request_credentials_fails_if_drpc_call_fails(void **state)
 	memset(&creds, 0, sizeof(d_iov_t));
 	drpc_call_return = -DER_BUSY;
 
-	assert_int_equal(dc_sec_request_creds(&creds),
+	assert_int_equal(dc_sec_request_creds(NULL, &creds),
 			drpc_call_return);
 
 	daos_iov_free(&creds);
@@ -179,7 +179,7 @@ test_request_credentials_calls_drpc_call(void **state)
 
 	memset(&creds, 0, sizeof(d_iov_t));
 
-	dc_sec_request_creds(&creds);
+	dc_sec_request_creds(NULL, &creds);
 
 	/* Used the drpc conn that we previously connected to */
 	assert_ptr_equal(drpc_call_ctx, drpc_connect_return);
@@ -203,6 +203,27 @@ test_request_credentials_calls_drpc_call(void **state)
 	daos_iov_free(&creds);
 }
 
+static void
+test_request_credentials_sends_system_name(void **state)
+{
+	d_iov_t			creds;
+	Auth__GetCredReq	*req;
+
+	memset(&creds, 0, sizeof(d_iov_t));
+
+	dc_sec_request_creds("daos_server", &creds);
+
+	/* Check that the body identifies the requested system */
+	req = auth__get_cred_req__unpack(NULL,
+					 drpc_call_msg_content.body.len,
+					 drpc_call_msg_content.body.data);
+	assert_non_null(req);
+	assert_string_equal(req->sys, "daos_server");
+
+	auth__get_cred_req__free_unpacked(req, NULL);
+	daos_iov_free(&creds);
+}
+
 static void
 test_request_credentials_closes_socket_when_call_ok(void **state)
 {
@@ -210,7 +231,7 @@ test_request_credentials_closes_socket_when_call_ok(void **state)
 
 	memset(&creds, 0, sizeof(d_iov_t));
 
-	dc_sec_request_creds(&creds);
+	dc_sec_request_creds(NULL, &creds);
 
 	assert_ptr_equal(drpc_close_ctx, drpc_connect_return);
 
@@ -225,7 +246,7 @@ test_request_credentials_closes_socket_when_call_fails(void **state)
 	memset(&creds, 0, sizeof(d_iov_t));
 	drpc_call_return = -DER_NOMEM;
 
-	dc_sec_request_creds(&creds);
+	dc_sec_request_creds(NULL, &creds);
 
 	assert_ptr_equal(drpc_close_ctx, drpc_connect_return);
 
@@ -240,7 +261,7 @@ test_request_credentials_fails_if_reply_null(void **state)
 	memset(&creds, 0, sizeof(d_iov_t));
 	drpc_call_resp_return_ptr = NULL;
 
-	assert_int_equal(dc_sec_request_creds(&creds), -DER_NOREPLY);
+	assert_int_equal(dc_sec_request_creds(NULL, &creds), -DER_NOREPLY);
 
 	daos_iov_free(&creds);
 }
@@ -253,7 +274,7 @@ test_request_credentials_fails_if_reply_status_failure(void **state)
 	memset(&creds, 0, sizeof(d_iov_t));
 	drpc_call_resp_return_content.status = DRPC__STATUS__FAILURE;
 
-	assert_int_equal(dc_sec_request_creds(&creds), -DER_MISC);
+	assert_int_equal(dc_sec_request_creds(NULL, &creds), -DER_MISC);
 
 	daos_iov_free(&creds);
 }
@@ -268,7 +289,7 @@ test_request_credentials_fails_if_reply_body_malformed(void **state)
 	D_ALLOC(drpc_call_resp_return_content.body.data, 1);
 	drpc_call_resp_return_content.body.len = 1;
 
-	assert_int_equal(dc_sec_request_creds(&creds), -DER_PROTO);
+	assert_int_equal(dc_sec_request_creds(NULL, &creds), -DER_PROTO);
 
 	daos_iov_free(&creds);
 }
@@ -281,7 +302,7 @@ test_request_credentials_fails_if_reply_cred_missing(void **state)
 	memset(&creds, 0, sizeof(d_iov_t));
 	init_drpc_resp_with_cred(NULL);
 
-	assert_int_equal(dc_sec_request_creds(&creds), -DER_PROTO);
+	assert_int_equal(dc_sec_request_creds(NULL, &creds), -DER_PROTO);
 
 	daos_iov_free(&creds);
 }
@@ -297,7 +318,7 @@ test_request_credentials_fails_if_reply_token_missing(void **state)
 	drpc_call_resp_return_auth_credential->token = NULL;
 	init_drpc_resp_with_cred(drpc_call_resp_return_auth_credential);
 
-	assert_int_equal(dc_sec_request_creds(&creds), -DER_PROTO);
+	assert_int_equal(dc_sec_request_creds(NULL, &creds), -DER_PROTO);
 
 	daos_iov_free(&creds);
 }
@@ -312,7 +333,7 @@ test_request_credentials_fails_if_reply_cred_status(void **state)
 	pack_get_cred_resp_in_drpc_call_resp_body(&resp);
 	memset(&creds, 0, sizeof(d_iov_t));
 
-	assert_int_equal(dc_sec_request_creds(&creds), -DER_UNKNOWN);
+	assert_int_equal(dc_sec_request_creds(NULL, &creds), -DER_UNKNOWN);
 }
 
 static void
@@ -333,7 +354,7 @@ test_request_credentials_returns_raw_bytes(void **state)
 	auth__credential__pack(drpc_call_resp_return_auth_credential,
 			expected_data);
 
-	assert_int_equal(dc_sec_request_creds(&creds), DER_SUCCESS);
+	assert_int_equal(dc_sec_request_creds(NULL, &creds), DER_SUCCESS);
 
 	assert_int_equal(creds.iov_buf_len, expected_len);
 	assert_int_equal(creds.iov_len, expected_len);
@@ -364,6 +385,8 @@ main(void)
 			test_request_credentials_fails_if_drpc_call_fails),
 		SECURITY_UTEST(
 			test_request_credentials_calls_drpc_call),
+		SECURITY_UTEST(
+			test_request_credentials_sends_system_name),
 		SECURITY_UTEST(
 			test_request_credentials_closes_socket_when_call_ok),
 		SECURITY_UTEST(
@@ -170,7 +170,7 @@ main(int argc, char **argv)
 
 	memset(&creds, 0, sizeof(d_iov_t));
 
-	ret = dc_sec_request_creds(&creds);
+	ret = dc_sec_request_creds(NULL, &creds);
 
 	if (ret != DER_SUCCESS) {
 		printf("Failed to obtain credentials with ret: %d\n", ret);